@@ -0,0 +1,72 @@
+// Command fakeproviders serves local stand-ins for the Slack, GitHub, Notion and Google APIs
+// Point the server at it through the endpoints.overrides configuration printed on startup
+// A fake SMTP and IMAP mailbox is served alongside for the email provider
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/endpoints/fakemail"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/endpoints/fakeprovider"
+)
+
+func main() {
+	addr := flag.String("addr", "127.0.0.1:8089", "listen address")
+	smtpAddr := flag.String("smtp-addr", "127.0.0.1:2525", "fake SMTP listen address, empty to disable")
+	imapAddr := flag.String("imap-addr", "127.0.0.1:1143", "fake IMAP listen address, empty to disable")
+	mailUser := flag.String("mail-user", "user@example.com", "fake mailbox username")
+	mailPassword := flag.String("mail-password", "secret", "fake mailbox password")
+	flag.Parse()
+
+	if *smtpAddr != "" || *imapAddr != "" {
+		mail := fakemail.New(*mailUser, *mailPassword)
+		defer mail.Close()
+		if *smtpAddr != "" {
+			bound, err := mail.ListenSMTP(*smtpAddr)
+			if err != nil {
+				log.Fatal(err)
+			}
+			log.Printf("fake smtp listening on %s (security none)", bound)
+		}
+		if *imapAddr != "" {
+			bound, err := mail.ListenIMAP(*imapAddr)
+			if err != nil {
+				log.Fatal(err)
+			}
+			log.Printf("fake imap listening on %s (security none)", bound)
+		}
+		log.Printf("fake mailbox credentials: %s / %s", *mailUser, *mailPassword)
+	}
+
+	server := fakeprovider.New()
+	overrides := server.Overrides("http://" + *addr)
+	names := make([]string, 0, len(overrides))
+	for name := range overrides {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	fmt.Println("endpoints:")
+	fmt.Println("  overrides:")
+	for _, name := range names {
+		fmt.Printf("    %s: %s\n", name, overrides[name])
+	}
+	if *smtpAddr != "" || *imapAddr != "" {
+		fmt.Println("  allowPrivateMailServers: true")
+	}
+
+	httpServer := &http.Server{
+		Addr:              *addr,
+		Handler:           server,
+		ReadHeaderTimeout: 5 * time.Second,
+	}
+	log.Printf("fake providers listening on %s", *addr)
+	if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		log.Fatal(err)
+	}
+}
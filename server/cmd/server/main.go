@@ -11,8 +11,10 @@ import (
 	"time"
 
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/inbound/http/router"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/mailbox"
 	loggerMailer "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/mailer/logger"
 	sendgridMailer "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/mailer/sendgrid"
+	smtpMailer "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/mailer/smtp"
 	oauthadapter "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/oauth"
 	actionpostgres "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/postgres/action"
 	areapostgres "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/postgres/area"
@@ -20,12 +22,15 @@ import (
 	componentpostgres "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/postgres/component"
 	executionpostgres "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/postgres/execution"
 	servicepostgres "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/postgres/service"
+	workspacepostgres "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/postgres/workspace"
 	dropboxexecutor "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/dropbox"
+	emailexecutor "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/email"
 	gcalendarexecutor "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/gcalendar"
 	gdriveexecutor "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/gdrive"
 	githubexecutor "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/github"
 	gitlabexecutor "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/gitlab"
 	gmailexecutor "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/gmail"
+	gsheetsexecutor "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/gsheets"
 	httpreaction "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/http"
 	linearexecutor "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/linear"
 	notionexecutor "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/notion"
@@ -39,9 +44,13 @@ import (
 	automation "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/automation"
 	componentapp "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/components"
 	monitorapp "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/monitoring"
+	quotaapp "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/quota"
+	workspaceapp "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/workspace"
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	userdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/user"
 	configviper "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/config/viper"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/database/postgres"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/endpoints"
 	ratelimitmw "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/httpmiddleware/ratelimit"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/httpserver"
 	ginhttp "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/httpserver/gin"
@@ -49,6 +58,7 @@ import (
 	projectlogger "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/logging/zap"
 	redisqueue "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/queue/redis"
 	cipherpkg "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/security/cipher"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/security/netguard"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/security/password"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/services/catalog"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
@@ -132,8 +142,21 @@ func run() error {
 		jobQueue          queueport.JobQueue
 		jobWorker         *automation.Worker
 		monitoringHandler *monitorapp.Handler
+		workspaceHandler  *workspaceapp.Handler
 	)
 
+	outboundEndpoints, err := endpoints.NewRegistry(cfg.Endpoints.Overrides)
+	if err != nil {
+		return fmt.Errorf("endpoints.NewRegistry: %w", err)
+	}
+	if !outboundEndpoints.Empty() {
+		logger.Warn("provider endpoints overridden", zap.Any("overrides", cfg.Endpoints.Overrides))
+	}
+	mailboxDialer := mailbox.Dialer{AllowPrivate: cfg.Endpoints.AllowPrivateMailServers}
+	if mailboxDialer.AllowPrivate {
+		logger.Warn("mailboxes may reach private mail servers")
+	}
+
 	dbCtx := context.Background()
 	db, dbErr := postgres.Open(dbCtx, cfg.Database)
 	if dbErr != nil {
@@ -169,7 +192,7 @@ func run() error {
 			authCfg,
 		)
 
-		oauthManager, managerErr := buildOAuthManager(cfg, logger)
+		oauthManager, managerErr := buildOAuthManager(cfg, logger, oauthadapter.WithProviderHTTPClient(outboundEndpoints.Client(15*time.Second)))
 		if managerErr != nil {
 			logger.Warn("failed to build oauth manager", zap.Error(managerErr))
 		} else if oauthManager != nil {
@@ -183,16 +206,19 @@ func run() error {
 				nil,
 				logger,
 				authCfg,
+				authapp.WithCredentialVerifier(mailbox.ProviderName, mailbox.NewVerifier(mailboxDialer)),
 			)
 		}
 
+		quotaService := quotaapp.NewService(buildQuotaPlans(cfg.Quotas), repo.Users(), areapostgres.NewUsageRepository(db), nil)
+
 		authHandler = authapp.NewHandler(authService, oauthService, authapp.CookieConfig{
 			Domain:   cfg.Security.Sessions.Domain,
 			Path:     cfg.Security.Sessions.Path,
 			Secure:   cfg.Security.Sessions.Secure,
 			HTTPOnly: cfg.Security.Sessions.HTTPOnly,
 			SameSite: parseSameSite(cfg.Security.Sessions.SameSite),
-		})
+		}, authapp.WithQuotaReporter(quotaService))
 
 		areaRepo := areapostgres.NewRepository(db)
 		componentRepo := componentpostgres.NewRepository(db)
@@ -222,7 +248,7 @@ func run() error {
 			}
 		}
 		pipeline := areaapp.NewExecutionPipeline(executionRepo, nil, jobQueue)
-		pollingProvisioner := areaapp.NewPollingProvisioner(actionRepo, nil)
+		pollingProvisioner := areaapp.NewPollingProvisioner(actionRepo, nil, areaapp.WithPollingIntervalLimiter(quotaService))
 		webhookProvisioner := areaapp.NewWebhookProvisioner(actionRepo, nil, nil, nil)
 		timerProvisioner := areaapp.NewTimerProvisioner(actionRepo, nil)
 		fallbackProvisioner := areaapp.ActionProvisionerFunc(func(ctx context.Context, area areadomain.Area) error {
@@ -237,60 +263,30 @@ func run() error {
 		provisionerRegistry := areaapp.NewRegistryProvisioner(areaapp.WithProvisionerFallback(fallbackProvisioner))
 		provisionerRegistry.Register("scheduler", "timer_interval", timerProvisioner)
 		provisionerRegistry.Register("scheduler", "", timerProvisioner)
-		areaService := areaapp.NewService(
-			areaRepo,
-			componentRepo,
-			serviceRepo.Subscriptions(),
-			actionRepo,
-			pipeline,
-			nil,
-			provisionerRegistry,
-		)
-
-		jobRepo := executionpostgres.NewJobRepository(db)
-		logRepo := executionpostgres.NewDeliveryLogRepository(db)
-
-		areaCookies := areaapp.CookieConfig{
-			Name:     cfg.Security.Sessions.CookieName,
-			Domain:   cfg.Security.Sessions.Domain,
-			Path:     cfg.Security.Sessions.Path,
-			Secure:   cfg.Security.Sessions.Secure,
-			HTTPOnly: cfg.Security.Sessions.HTTPOnly,
-			SameSite: parseSameSite(cfg.Security.Sessions.SameSite),
-		}
-		areaHandler = areaapp.NewHandler(areaService, authService, areaCookies, jobRepo)
-		webhookHandler = areaapp.NewWebhookHandler(areaService, logger)
-
-		componentHandler = componentapp.NewHandler(
-			componentapp.NewService(componentRepo, serviceRepo.Subscriptions()),
-			authService,
-			componentapp.CookieConfig{
-				Name:     cfg.Security.Sessions.CookieName,
-				Domain:   cfg.Security.Sessions.Domain,
-				Path:     cfg.Security.Sessions.Path,
-				Secure:   cfg.Security.Sessions.Secure,
-				HTTPOnly: cfg.Security.Sessions.HTTPOnly,
-				SameSite: parseSameSite(cfg.Security.Sessions.SameSite),
-			},
-		)
-
-		timerScheduler = areaapp.NewTimerScheduler(actionRepo, areaService, nil, areaapp.WithTimerLogger(logger))
 		pollingHandlers := []areaapp.ComponentPollingHandler{
-			areaapp.NewHTTPPollingHandler(&http.Client{Timeout: 20 * time.Second}, logger, repo.Identities(), oauthManager),
+			areaapp.NewHTTPPollingHandler(outboundEndpoints.Client(20*time.Second), logger, repo.Identities(), oauthManager,
+				areaapp.WithCustomEndpointClient(netguard.Client(20*time.Second)),
+				areaapp.WithPollingParamSecrets(tokenCipher),
+			),
+			areaapp.NewGmailPollingHandler(outboundEndpoints.Client(20*time.Second), logger, repo.Identities(), oauthManager),
+			areaapp.NewSheetsPollingHandler(outboundEndpoints.Client(20*time.Second), logger, repo.Identities(), oauthManager),
+			areaapp.NewNotionPollingHandler(outboundEndpoints.Client(20*time.Second), logger, repo.Identities(), oauthManager),
+			areaapp.NewLinearPollingHandler(outboundEndpoints.Client(20*time.Second), logger, repo.Identities(), oauthManager),
+			areaapp.NewSpotifyPollingHandler(outboundEndpoints.Client(20*time.Second), logger, repo.Identities(), oauthManager),
+			areaapp.NewIMAPPollingHandler(mailbox.NewReader(mailboxDialer), logger, repo.Identities(), oauthManager),
 		}
-		pollingRunner = areaapp.NewPollingRunner(actionRepo, componentRepo, areaService, nil, pollingHandlers, areaapp.WithPollingLogger(logger))
-
 		reactionHandlers := []areaapp.ComponentReactionHandler{
 			httpreaction.Executor{
-				Client: &http.Client{Timeout: 15 * time.Second},
+				Client: outboundEndpoints.Client(15 * time.Second),
 				Logger: logger,
 			},
+			emailexecutor.NewSendExecutor(repo.Identities(), mailboxDialer, nil, logger),
 		}
 		if oauthManager != nil {
 			gmailExecutor := gmailexecutor.NewExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
@@ -300,17 +296,37 @@ func run() error {
 			outlookExecutor := outlookexecutor.NewExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
 			if outlookExecutor != nil {
 				reactionHandlers = append(reactionHandlers, outlookExecutor)
 			}
+			teamsExecutor := outlookexecutor.NewTeamsMessageExecutor(
+				repo.Identities(),
+				oauthManager,
+				outboundEndpoints.Client(20*time.Second),
+				nil,
+				logger,
+			)
+			if teamsExecutor != nil {
+				reactionHandlers = append(reactionHandlers, teamsExecutor)
+			}
+			outlookCalendarExecutor := outlookexecutor.NewCalendarEventExecutor(
+				repo.Identities(),
+				oauthManager,
+				outboundEndpoints.Client(20*time.Second),
+				nil,
+				logger,
+			)
+			if outlookCalendarExecutor != nil {
+				reactionHandlers = append(reactionHandlers, outlookCalendarExecutor)
+			}
 			redditExecutor := redditexecutor.NewExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
@@ -320,77 +336,147 @@ func run() error {
 			githubExecutor := githubexecutor.NewIssueExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
 			if githubExecutor != nil {
 				reactionHandlers = append(reactionHandlers, githubExecutor)
 			}
+			githubRepositoryExecutor := githubexecutor.NewRepositoryExecutor(
+				repo.Identities(),
+				oauthManager,
+				outboundEndpoints.Client(20*time.Second),
+				nil,
+				logger,
+			)
+			if githubRepositoryExecutor != nil {
+				reactionHandlers = append(reactionHandlers, githubRepositoryExecutor)
+			}
 			gitlabExecutor := gitlabexecutor.NewIssueExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
 			if gitlabExecutor != nil {
 				reactionHandlers = append(reactionHandlers, gitlabExecutor)
 			}
+			gitlabProjectExecutor := gitlabexecutor.NewProjectExecutor(
+				repo.Identities(),
+				oauthManager,
+				outboundEndpoints.Client(20*time.Second),
+				nil,
+				logger,
+			)
+			if gitlabProjectExecutor != nil {
+				reactionHandlers = append(reactionHandlers, gitlabProjectExecutor)
+			}
 			dropboxExecutor := dropboxexecutor.NewFolderExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
 			if dropboxExecutor != nil {
 				reactionHandlers = append(reactionHandlers, dropboxExecutor)
 			}
+			dropboxFileExecutor := dropboxexecutor.NewFileExecutor(
+				repo.Identities(),
+				oauthManager,
+				outboundEndpoints.Client(10*time.Minute),
+				nil,
+				logger,
+			)
+			if dropboxFileExecutor != nil {
+				reactionHandlers = append(reactionHandlers, dropboxFileExecutor)
+			}
 			linearExecutor := linearexecutor.NewIssueExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
 			if linearExecutor != nil {
 				reactionHandlers = append(reactionHandlers, linearExecutor)
 			}
+			linearWorkflowExecutor := linearexecutor.NewWorkflowExecutor(
+				repo.Identities(),
+				oauthManager,
+				outboundEndpoints.Client(20*time.Second),
+				nil,
+				logger,
+			)
+			if linearWorkflowExecutor != nil {
+				reactionHandlers = append(reactionHandlers, linearWorkflowExecutor)
+			}
 			slackExecutor := slackexecutor.NewMessageExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
 			if slackExecutor != nil {
 				reactionHandlers = append(reactionHandlers, slackExecutor)
 			}
+			slackWorkspaceExecutor := slackexecutor.NewWorkspaceExecutor(
+				repo.Identities(),
+				oauthManager,
+				outboundEndpoints.Client(20*time.Second),
+				nil,
+				logger,
+			)
+			if slackWorkspaceExecutor != nil {
+				reactionHandlers = append(reactionHandlers, slackWorkspaceExecutor)
+			}
 			notionExecutor := notionexecutor.NewCreatePageExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
 			if notionExecutor != nil {
 				reactionHandlers = append(reactionHandlers, notionExecutor)
 			}
+			notionDatabaseExecutor := notionexecutor.NewDatabaseExecutor(
+				repo.Identities(),
+				oauthManager,
+				outboundEndpoints.Client(20*time.Second),
+				nil,
+				logger,
+			)
+			if notionDatabaseExecutor != nil {
+				reactionHandlers = append(reactionHandlers, notionDatabaseExecutor)
+			}
 			spotifyExecutor := spotifyexecutor.NewAddTrackExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
 			if spotifyExecutor != nil {
 				reactionHandlers = append(reactionHandlers, spotifyExecutor)
 			}
+			spotifyLibraryExecutor := spotifyexecutor.NewLibraryExecutor(
+				repo.Identities(),
+				oauthManager,
+				outboundEndpoints.Client(20*time.Second),
+				nil,
+				logger,
+			)
+			if spotifyLibraryExecutor != nil {
+				reactionHandlers = append(reactionHandlers, spotifyLibraryExecutor)
+			}
 			zoomExecutor := zoomexecutor.NewMeetingExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
@@ -400,7 +486,7 @@ func run() error {
 			gcalendarExecutor := gcalendarexecutor.NewExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
@@ -410,16 +496,107 @@ func run() error {
 			gdriveExecutor := gdriveexecutor.NewExecutor(
 				repo.Identities(),
 				oauthManager,
-				&http.Client{Timeout: 20 * time.Second},
+				outboundEndpoints.Client(20*time.Second),
 				nil,
 				logger,
 			)
 			if gdriveExecutor != nil {
 				reactionHandlers = append(reactionHandlers, gdriveExecutor)
 			}
+			gdriveFileExecutor := gdriveexecutor.NewFileExecutor(
+				repo.Identities(),
+				oauthManager,
+				outboundEndpoints.Client(10*time.Minute),
+				nil,
+				logger,
+			)
+			if gdriveFileExecutor != nil {
+				reactionHandlers = append(reactionHandlers, gdriveFileExecutor)
+			}
+			gsheetsExecutor := gsheetsexecutor.NewExecutor(
+				repo.Identities(),
+				oauthManager,
+				outboundEndpoints.Client(20*time.Second),
+				nil,
+				logger,
+			)
+			if gsheetsExecutor != nil {
+				reactionHandlers = append(reactionHandlers, gsheetsExecutor)
+			}
 		}
 		reactionExecutor := areaapp.NewCompositeReactionExecutor(nil, logger, reactionHandlers...)
 
+		componentService := componentapp.NewService(componentRepo, serviceRepo.Subscriptions())
+		workspaceRepo := workspacepostgres.NewRepository(db)
+
+		areaService := areaapp.NewService(
+			areaRepo,
+			componentRepo,
+			serviceRepo.Subscriptions(),
+			actionRepo,
+			pipeline,
+			nil,
+			provisionerRegistry,
+			areaapp.WithPollingHandlers(pollingHandlers...),
+			areaapp.WithWebhookBaseURL(cfg.App.BaseURL),
+			areaapp.WithIdentityRepository(repo.Identities()),
+			areaapp.WithRevisionRepository(areapostgres.NewRevisionRepository(db)),
+			areaapp.WithReactionPreviewer(reactionExecutor),
+			areaapp.WithComponentExamples(componentpostgres.NewExampleRepository(db)),
+			areaapp.WithTemplateRepository(areapostgres.NewTemplateRepository(db)),
+			areaapp.WithComponentCatalog(componentService),
+			areaapp.WithWorkspaceRepository(workspaceRepo),
+			areaapp.WithTagRepository(areapostgres.NewTagRepository(db)),
+			areaapp.WithFolderRepository(areapostgres.NewFolderRepository(db)),
+			areaapp.WithHealthRepository(areapostgres.NewHealthRepository(db)),
+			areaapp.WithSuspensionMailer(mailer, repo.Users()),
+			areaapp.WithQuotas(quotaService),
+			areaapp.WithParamSecrets(tokenCipher),
+		)
+
+		jobRepo := executionpostgres.NewJobRepository(db)
+		logRepo := executionpostgres.NewDeliveryLogRepository(db)
+
+		areaCookies := areaapp.CookieConfig{
+			Name:     cfg.Security.Sessions.CookieName,
+			Domain:   cfg.Security.Sessions.Domain,
+			Path:     cfg.Security.Sessions.Path,
+			Secure:   cfg.Security.Sessions.Secure,
+			HTTPOnly: cfg.Security.Sessions.HTTPOnly,
+			SameSite: parseSameSite(cfg.Security.Sessions.SameSite),
+		}
+		areaHandler = areaapp.NewHandler(areaService, authService, areaCookies, jobRepo)
+		webhookHandler = areaapp.NewWebhookHandler(areaService, logger)
+
+		componentHandler = componentapp.NewHandler(
+			componentService,
+			authService,
+			componentapp.CookieConfig{
+				Name:     cfg.Security.Sessions.CookieName,
+				Domain:   cfg.Security.Sessions.Domain,
+				Path:     cfg.Security.Sessions.Path,
+				Secure:   cfg.Security.Sessions.Secure,
+				HTTPOnly: cfg.Security.Sessions.HTTPOnly,
+				SameSite: parseSameSite(cfg.Security.Sessions.SameSite),
+			},
+		)
+
+		workspaceHandler = workspaceapp.NewHandler(
+			workspaceapp.NewService(workspaceRepo, repo.Users(), areaRepo),
+			authService,
+			workspaceapp.CookieConfig{
+				Name:     cfg.Security.Sessions.CookieName,
+				Domain:   cfg.Security.Sessions.Domain,
+				Path:     cfg.Security.Sessions.Path,
+				Secure:   cfg.Security.Sessions.Secure,
+				HTTPOnly: cfg.Security.Sessions.HTTPOnly,
+				SameSite: parseSameSite(cfg.Security.Sessions.SameSite),
+			},
+		)
+
+		timerScheduler = areaapp.NewTimerScheduler(actionRepo, areaService, nil, areaapp.WithTimerLogger(logger))
+		pollingRunner = areaapp.NewPollingRunner(actionRepo, componentRepo, areaService, nil, pollingHandlers, areaapp.WithPollingLogger(logger), areaapp.WithPollingActivityChecker(areaService))
+
 		jobWorker = automation.NewWorker(jobQueue, jobRepo, logRepo, areaService, reactionExecutor, logger)
 
 		monitorService := monitorapp.NewService(jobRepo, logRepo)
@@ -456,6 +633,7 @@ func run() error {
 		ComponentHandler:  componentHandler,
 		WebhookHandler:    webhookHandler,
 		MonitoringHandler: monitoringHandler,
+		WorkspaceHandler:  workspaceHandler,
 	}); err != nil {
 		return fmt.Errorf("router.Register: %w", err)
 	}
@@ -496,12 +674,27 @@ func buildMailer(cfg configviper.Config, logger *zap.Logger) outbound.Mailer {
 			FromName:  cfg.App.Name,
 			Sandbox:   cfg.Notifier.Mailer.SandboxMode,
 		}
+	case "smtp":
+		smtpCfg := cfg.Notifier.Mailer.SMTP
+		return smtpMailer.Mailer{
+			Server: mailbox.Server{
+				Host:     strings.TrimSpace(smtpCfg.Host),
+				Port:     smtpCfg.Port,
+				Security: mailbox.Security(strings.ToLower(strings.TrimSpace(smtpCfg.Security))),
+			},
+			Username:  smtpCfg.Username,
+			Password:  smtpCfg.Password,
+			FromEmail: cfg.Notifier.Mailer.FromEmail,
+			FromName:  cfg.App.Name,
+			// The relay is configured by the operator and commonly runs next to the server
+			Dialer: mailbox.Dialer{AllowPrivate: true},
+		}
 	default:
 		return loggerMailer.Mailer{Logger: logger}
 	}
 }
 
-func buildOAuthManager(cfg configviper.Config, logger *zap.Logger) (*oauthadapter.Manager, error) {
+func buildOAuthManager(cfg configviper.Config, logger *zap.Logger, opts ...oauthadapter.ProviderOption) (*oauthadapter.Manager, error) {
 	providerConfigs := make(map[string]oauthadapter.ProviderCredentials)
 	for name, provider := range cfg.OAuth.Providers {
 		key := strings.ToLower(strings.TrimSpace(name))
@@ -523,6 +716,8 @@ func buildOAuthManager(cfg configviper.Config, logger *zap.Logger) (*oauthadapte
 			ClientSecret: clientSecret,
 			RedirectURI:  redirectURI,
 			Scopes:       append([]string(nil), provider.Scopes...),
+			Type:         strings.TrimSpace(provider.Type),
+			BaseURL:      strings.TrimSpace(provider.BaseURL),
 		}
 		providerConfigs[key] = creds
 	}
@@ -543,13 +738,27 @@ func buildOAuthManager(cfg configviper.Config, logger *zap.Logger) (*oauthadapte
 		Providers: providerConfigs,
 	}
 
-	manager, err := oauthadapter.NewManager(oauthadapter.BuiltIn(), managerCfg)
+	manager, err := oauthadapter.NewManager(oauthadapter.BuiltIn(), managerCfg, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return manager, nil
 }
 
+func buildQuotaPlans(cfg configviper.QuotasConfig) map[userdomain.Role]userdomain.Quota {
+	plans := make(map[userdomain.Role]userdomain.Quota, len(cfg.Plans))
+	for role, plan := range cfg.Plans {
+		plans[userdomain.Role(strings.ToLower(strings.TrimSpace(role)))] = userdomain.Quota{
+			MaxAreas:             plan.MaxAreas,
+			MaxExecutionsPerHour: plan.MaxExecutionsPerHour,
+			MaxExecutionsPerDay:  plan.MaxExecutionsPerDay,
+			MinPollingInterval:   plan.MinPollingInterval,
+			MaxReactionsPerArea:  plan.MaxReactionsPerArea,
+		}
+	}
+	return plans
+}
+
 func parseSameSite(mode string) http.SameSite {
 	switch strings.ToLower(strings.TrimSpace(mode)) {
 	case "strict":
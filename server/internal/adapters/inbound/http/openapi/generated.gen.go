@@ -10,6 +10,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
@@ -27,6 +28,32 @@ const (
 	SessionAuthScopes = "sessionAuth.Scopes"
 )
 
+// Defines values for AreaActivityWindowDays.
+const (
+	Fri AreaActivityWindowDays = "fri"
+	Mon AreaActivityWindowDays = "mon"
+	Sat AreaActivityWindowDays = "sat"
+	Sun AreaActivityWindowDays = "sun"
+	Thu AreaActivityWindowDays = "thu"
+	Tue AreaActivityWindowDays = "tue"
+	Wed AreaActivityWindowDays = "wed"
+)
+
+// Defines values for AreaDocumentRetryPolicyStrategy.
+const (
+	Constant    AreaDocumentRetryPolicyStrategy = "constant"
+	Exponential AreaDocumentRetryPolicyStrategy = "exponential"
+	Linear      AreaDocumentRetryPolicyStrategy = "linear"
+)
+
+// Defines values for BulkTagActionRequestAction.
+const (
+	Archive BulkTagActionRequestAction = "archive"
+	Delete  BulkTagActionRequestAction = "delete"
+	Disable BulkTagActionRequestAction = "disable"
+	Enable  BulkTagActionRequestAction = "enable"
+)
+
 // Defines values for ComponentSummaryKind.
 const (
 	ComponentSummaryKindAction   ComponentSummaryKind = "action"
@@ -54,9 +81,43 @@ const (
 
 // Defines values for UpdateAreaStatusRequestStatus.
 const (
-	Archived UpdateAreaStatusRequestStatus = "archived"
-	Disabled UpdateAreaStatusRequestStatus = "disabled"
-	Enabled  UpdateAreaStatusRequestStatus = "enabled"
+	UpdateAreaStatusRequestStatusArchived UpdateAreaStatusRequestStatus = "archived"
+	UpdateAreaStatusRequestStatusDisabled UpdateAreaStatusRequestStatus = "disabled"
+	UpdateAreaStatusRequestStatusEnabled  UpdateAreaStatusRequestStatus = "enabled"
+)
+
+// Defines values for WorkspaceRole.
+const (
+	Editor WorkspaceRole = "editor"
+	Owner  WorkspaceRole = "owner"
+	Viewer WorkspaceRole = "viewer"
+)
+
+// Defines values for ListAreasParamsStatus.
+const (
+	ListAreasParamsStatusArchived          ListAreasParamsStatus = "archived"
+	ListAreasParamsStatusDisabled          ListAreasParamsStatus = "disabled"
+	ListAreasParamsStatusEnabled           ListAreasParamsStatus = "enabled"
+	ListAreasParamsStatusSuspendedBySystem ListAreasParamsStatus = "suspended_by_system"
+)
+
+// Defines values for ListAreasParamsSort.
+const (
+	CreatedAt ListAreasParamsSort = "createdAt"
+	LastRunAt ListAreasParamsSort = "lastRunAt"
+	UpdatedAt ListAreasParamsSort = "updatedAt"
+)
+
+// Defines values for ListAreasParamsOrder.
+const (
+	Asc  ListAreasParamsOrder = "asc"
+	Desc ListAreasParamsOrder = "desc"
+)
+
+// Defines values for ExportAreaParamsFormat.
+const (
+	Json ExportAreaParamsFormat = "json"
+	Yaml ExportAreaParamsFormat = "yaml"
 )
 
 // Defines values for ListComponentsParamsKind.
@@ -113,6 +174,15 @@ type AboutService struct {
 	Reactions []AboutComponent `json:"reactions"`
 }
 
+// AddWorkspaceMemberRequest defines model for AddWorkspaceMemberRequest.
+type AddWorkspaceMemberRequest struct {
+	// Email Email of a registered user.
+	Email openapi_types.Email `json:"email"`
+
+	// Role Viewers read shared automations, editors also change and run them, owners manage members and may delete.
+	Role WorkspaceRole `json:"role"`
+}
+
 // AdminResetPasswordRequest Payload for administrators to set a new user password.
 type AdminResetPasswordRequest struct {
 	// NewPassword Replacement password to assign to the user.
@@ -139,12 +209,24 @@ type Area struct {
 	// Action Action binding stored for an AREA automation.
 	Action *AreaAction `json:"action,omitempty"`
 
+	// Activity Summary of the recent executions of an automation.
+	Activity *AreaActivity `json:"activity,omitempty"`
+
+	// ActivitySchedule Periods during which the automation reacts to events, absent when it is always active.
+	ActivitySchedule *AreaActivitySchedule `json:"activitySchedule,omitempty"`
+
 	// CreatedAt Timestamp (UTC) when the automation was created.
 	CreatedAt time.Time `json:"createdAt"`
 
 	// Description Optional summary supplied by the user.
 	Description *string `json:"description"`
 
+	// FolderId Folder the automation is filed in.
+	FolderId *openapi_types.UUID `json:"folderId"`
+
+	// HealthPolicy Circuit breaker policy suspending the automation when its reactions keep failing, automations without a policy of their own report the default one. A zero threshold disables the matching rule.
+	HealthPolicy *AreaHealthPolicy `json:"healthPolicy,omitempty"`
+
 	// Id Unique identifier of the automation.
 	Id openapi_types.UUID `json:"id"`
 
@@ -152,11 +234,26 @@ type Area struct {
 	Name      string         `json:"name"`
 	Reactions []AreaReaction `json:"reactions"`
 
-	// Status Lifecycle status (`enabled`, `disabled`, or `archived`).
+	// Revision Number of the latest revision recorded for the automation.
+	Revision *int `json:"revision,omitempty"`
+
+	// RunAsUserId Member whose linked accounts and subscriptions run the automation.
+	RunAsUserId *openapi_types.UUID `json:"runAsUserId,omitempty"`
+
+	// Status Lifecycle status (`enabled`, `disabled`, `archived`, or `suspended_by_system` when the circuit breaker tripped).
 	Status string `json:"status"`
 
+	// Suspension Reason the circuit breaker suspended the automation.
+	Suspension *AreaSuspension `json:"suspension,omitempty"`
+
+	// Tags Tags attached to the automation.
+	Tags *[]Tag `json:"tags,omitempty"`
+
 	// UpdatedAt Timestamp (UTC) of the last update.
 	UpdatedAt time.Time `json:"updatedAt"`
+
+	// WorkspaceId Workspace the automation is shared in, null for personal automations.
+	WorkspaceId *openapi_types.UUID `json:"workspaceId"`
 }
 
 // AreaAction Action binding stored for an AREA automation.
@@ -177,6 +274,152 @@ type AreaAction struct {
 	Params *map[string]interface{} `json:"params,omitempty"`
 }
 
+// AreaActivity Summary of the recent executions of an automation.
+type AreaActivity struct {
+	// LastJobAt Timestamp (UTC) of the last update of the most recent reaction job.
+	LastJobAt *time.Time `json:"lastJobAt,omitempty"`
+
+	// LastJobStatus Status of the most recent reaction job.
+	LastJobStatus *string `json:"lastJobStatus,omitempty"`
+
+	// LastTriggeredAt Timestamp (UTC) of the last time the action fired.
+	LastTriggeredAt *time.Time `json:"lastTriggeredAt,omitempty"`
+
+	// RecentFailures Number of reaction jobs that failed during the last 24 hours.
+	RecentFailures int `json:"recentFailures"`
+}
+
+// AreaActivitySchedule Periods during which the automation reacts to events, absent when it is always active.
+type AreaActivitySchedule struct {
+	// EndsAt Timestamp from which the automation stays inactive.
+	EndsAt *time.Time `json:"endsAt,omitempty"`
+
+	// StartsAt Timestamp before which the automation stays inactive.
+	StartsAt *time.Time `json:"startsAt,omitempty"`
+
+	// TimeZone IANA time zone the windows are expressed in, UTC when omitted.
+	TimeZone *string `json:"timeZone,omitempty"`
+
+	// Windows Daily windows during which the automation is active, an empty list keeps it active all day.
+	Windows []AreaActivityWindow `json:"windows"`
+}
+
+// AreaActivityWindow Daily window, a window ending before it starts spans midnight and equal bounds cover the whole day.
+type AreaActivityWindow struct {
+	// Days Weekdays the window opens on, every day when omitted.
+	Days *[]AreaActivityWindowDays `json:"days,omitempty"`
+
+	// End Local time the window closes at.
+	End string `json:"end"`
+
+	// Start Local time the window opens at.
+	Start string `json:"start"`
+}
+
+// AreaActivityWindowDays defines model for AreaActivityWindow.Days.
+type AreaActivityWindowDays string
+
+// AreaDocument Portable automation referencing components by provider, name and version. Identity parameters are omitted and bound to the importing user's linked accounts. Password parameters such as tokens and API keys are omitted and must be filled in again before importing.
+type AreaDocument struct {
+	// Action Component reference and configuration inside an automation document.
+	Action      AreaDocumentComponent   `json:"action"`
+	Description *string                 `json:"description,omitempty"`
+	Name        string                  `json:"name"`
+	Reactions   []AreaDocumentComponent `json:"reactions"`
+
+	// Status Lifecycle status applied after import (`enabled`, `disabled`, or `archived`).
+	Status *string `json:"status,omitempty"`
+
+	// Version Document format version, currently 1.
+	Version int `json:"version"`
+}
+
+// AreaDocumentComponent Component reference and configuration inside an automation document.
+type AreaDocumentComponent struct {
+	// Component Component name, for example `github_new_issue`.
+	Component string `json:"component"`
+
+	// Name Optional nickname of the configuration.
+	Name   *string                 `json:"name,omitempty"`
+	Params *map[string]interface{} `json:"params,omitempty"`
+
+	// Provider Provider name, for example `github`.
+	Provider string `json:"provider"`
+
+	// RetryPolicy Retry behaviour of a reaction.
+	RetryPolicy *AreaDocumentRetryPolicy `json:"retryPolicy,omitempty"`
+
+	// Version Component version; the latest version is used when omitted.
+	Version *int `json:"version,omitempty"`
+}
+
+// AreaDocumentRetryPolicy Retry behaviour of a reaction.
+type AreaDocumentRetryPolicy struct {
+	BaseDelayMs *int                             `json:"baseDelayMs,omitempty"`
+	MaxDelayMs  *int                             `json:"maxDelayMs,omitempty"`
+	MaxRetries  int                              `json:"maxRetries"`
+	Strategy    *AreaDocumentRetryPolicyStrategy `json:"strategy,omitempty"`
+}
+
+// AreaDocumentRetryPolicyStrategy defines model for AreaDocumentRetryPolicy.Strategy.
+type AreaDocumentRetryPolicyStrategy string
+
+// AreaDryRunReaction Request a reaction would send for the sample event.
+type AreaDryRunReaction struct {
+	Component *string            `json:"component,omitempty"`
+	ConfigId  openapi_types.UUID `json:"configId"`
+	Endpoint  *string            `json:"endpoint,omitempty"`
+
+	// Error Why the reaction could not be prepared, for example a missing parameter.
+	Error      *string                 `json:"error,omitempty"`
+	Provider   *string                 `json:"provider,omitempty"`
+	ReactionId openapi_types.UUID      `json:"reactionId"`
+	Request    *map[string]interface{} `json:"request,omitempty"`
+
+	// Supported False when the executor cannot describe the reaction without performing it. Reactions sending a single request known upfront are previewed: webhooks, email, Slack, GitHub, GitLab, Notion page creation and block appends, Linear issue creation, Spotify playlist, album and active device commands, Dropbox folder creation and copies, Google Drive copies, Google Sheets rows without named columns, Outlook mail, calendar events and Teams messages. Reactions reading the provider first or chaining requests are not, such as Notion row upserts and property updates, the other Linear reactions, Spotify playlist creation and named devices, Dropbox and Google Drive uploads, shares and exports, Google Drive moves, GitHub label removals of several labels, as well as Gmail, Google Calendar, Reddit and Zoom reactions.
+	Supported bool `json:"supported"`
+}
+
+// AreaDryRunRequest Sample event used to test-fire an automation.
+type AreaDryRunRequest struct {
+	// Event Webhook event type for actions filtering on it, for example `push`.
+	Event *string `json:"event,omitempty"`
+
+	// Payload Event payload as the action would emit it.
+	Payload *map[string]interface{} `json:"payload,omitempty"`
+}
+
+// AreaDryRunResponse Outcome of a dry run.
+type AreaDryRunResponse struct {
+	// Accepted Whether the action filters let the event through.
+	Accepted bool `json:"accepted"`
+
+	// Payload Event after normalisation, as reactions would receive it.
+	Payload map[string]interface{} `json:"payload"`
+
+	// PayloadSource Where the sample came from (`request`, `example`, or `empty`).
+	PayloadSource string               `json:"payloadSource"`
+	Reactions     []AreaDryRunReaction `json:"reactions"`
+
+	// Reason Why the event was filtered out.
+	Reason *string `json:"reason,omitempty"`
+}
+
+// AreaHealthPolicy Circuit breaker policy suspending the automation when its reactions keep failing, automations without a policy of their own report the default one. A zero threshold disables the matching rule.
+type AreaHealthPolicy struct {
+	// ConsecutiveFailures Number of reaction jobs failing in a row that trips the breaker.
+	ConsecutiveFailures int `json:"consecutiveFailures"`
+
+	// FailureRate Share of failed reaction jobs within the window that trips the breaker.
+	FailureRate float64 `json:"failureRate"`
+
+	// MinJobs Number of reaction jobs that must finish within the window before the failure rate applies.
+	MinJobs int `json:"minJobs"`
+
+	// WindowSeconds Length of the window the failure rate is computed on.
+	WindowSeconds int `json:"windowSeconds"`
+}
+
 // AreaHistoryEntry Historical execution of a reaction within the automation.
 type AreaHistoryEntry struct {
 	// Attempt Attempt count for the execution.
@@ -240,6 +483,117 @@ type AreaReaction struct {
 	Params *map[string]interface{} `json:"params,omitempty"`
 }
 
+// AreaRevision Immutable snapshot of an automation recorded after a change.
+type AreaRevision struct {
+	// AuthorId User who made the change.
+	AuthorId *openapi_types.UUID `json:"authorId"`
+
+	// CreatedAt Timestamp (UTC) when the revision was recorded.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// Number Sequential revision number, starting at 1 for the creation.
+	Number int `json:"number"`
+
+	// Reason Operation that produced the revision (`created`, `updated`, `status`, or `rollback`).
+	Reason string `json:"reason"`
+
+	// Snapshot Editable state of the automation at the time of the revision.
+	Snapshot AreaRevisionSnapshot `json:"snapshot"`
+}
+
+// AreaRevisionChange Single field change between two revisions.
+type AreaRevisionChange struct {
+	// After Value in the compared revision, absent when the field was removed.
+	After interface{} `json:"after,omitempty"`
+
+	// Before Value in the base revision, absent when the field was added.
+	Before interface{} `json:"before,omitempty"`
+
+	// Path Dotted path of the field, for example `reactions[0].params.to`.
+	Path string `json:"path"`
+}
+
+// AreaRevisionDiffResponse Fields that differ between two revisions.
+type AreaRevisionDiffResponse struct {
+	Changes []AreaRevisionChange `json:"changes"`
+	From    int                  `json:"from"`
+	To      int                  `json:"to"`
+}
+
+// AreaRevisionLink Action or reaction configuration captured in a revision.
+type AreaRevisionLink struct {
+	ComponentId openapi_types.UUID      `json:"componentId"`
+	ConfigId    openapi_types.UUID      `json:"configId"`
+	Name        *string                 `json:"name,omitempty"`
+	Params      *map[string]interface{} `json:"params,omitempty"`
+	Position    int                     `json:"position"`
+
+	// Role Either `action` or `reaction`.
+	Role string `json:"role"`
+}
+
+// AreaRevisionListResponse Collection of revisions recorded for an automation.
+type AreaRevisionListResponse struct {
+	// Revisions Ordered list of revisions starting with the most recent.
+	Revisions []AreaRevision `json:"revisions"`
+}
+
+// AreaRevisionSnapshot Editable state of the automation at the time of the revision.
+type AreaRevisionSnapshot struct {
+	Description *string            `json:"description"`
+	Links       []AreaRevisionLink `json:"links"`
+	Name        string             `json:"name"`
+	Status      string             `json:"status"`
+}
+
+// AreaSuspension Reason the circuit breaker suspended the automation.
+type AreaSuspension struct {
+	Reason      string    `json:"reason"`
+	SuspendedAt time.Time `json:"suspendedAt"`
+}
+
+// AreaTemplate Automation blueprint published by administrators.
+type AreaTemplate struct {
+	// Action Catalog component used by a template with the params it presets.
+	Action      AreaTemplateSlot   `json:"action"`
+	CreatedAt   time.Time          `json:"createdAt"`
+	Description *string            `json:"description,omitempty"`
+	Id          openapi_types.UUID `json:"id"`
+	Name        string             `json:"name"`
+	Reactions   []AreaTemplateSlot `json:"reactions"`
+	UpdatedAt   time.Time          `json:"updatedAt"`
+}
+
+// AreaTemplateListResponse Collection of automation templates.
+type AreaTemplateListResponse struct {
+	Templates []AreaTemplate `json:"templates"`
+}
+
+// AreaTemplateSlot Catalog component used by a template with the params it presets.
+type AreaTemplateSlot struct {
+	// Component Minimal catalog metadata required by clients to render a component reference.
+	Component ComponentSummary `json:"component"`
+	Name      *string          `json:"name,omitempty"`
+
+	// Params Params preset by the template.
+	Params map[string]interface{} `json:"params"`
+
+	// UnresolvedParams Keys of the component parameters the user fills when instantiating the template.
+	UnresolvedParams []string `json:"unresolvedParams"`
+}
+
+// AreaWebhook Endpoint provisioned for an automation whose action is triggered by inbound webhooks.
+type AreaWebhook struct {
+	// Path Path component of the webhook URL.
+	Path string `json:"path"`
+
+	// Secret Shared secret expected in the X-Area-Webhook-Secret header.
+	Secret string `json:"secret"`
+
+	// Url Absolute URL to POST events to.
+	Url string `json:"url"`
+}
+
 // AuthSessionResponse Session descriptor mirroring the cookie issued by the backend.
 type AuthSessionResponse struct {
 	// ExpiresAt Expiration timestamp (UTC) aligning with the `area_session` cookie expiry.
@@ -255,6 +609,28 @@ type AuthSessionResponse struct {
 	User User `json:"user"`
 }
 
+// BulkTagActionFailure defines model for BulkTagActionFailure.
+type BulkTagActionFailure struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+
+	// Error Reason the action was not applied.
+	Error string `json:"error"`
+}
+
+// BulkTagActionRequest defines model for BulkTagActionRequest.
+type BulkTagActionRequest struct {
+	Action BulkTagActionRequestAction `json:"action"`
+}
+
+// BulkTagActionRequestAction defines model for BulkTagActionRequest.Action.
+type BulkTagActionRequestAction string
+
+// BulkTagActionResponse Automations the action was applied to and those it failed on.
+type BulkTagActionResponse struct {
+	Failed    []BulkTagActionFailure `json:"failed"`
+	Succeeded []openapi_types.UUID   `json:"succeeded"`
+}
+
 // ChangeEmailRequest Payload to update the email of the current user.
 type ChangeEmailRequest struct {
 	// Email New primary email address.
@@ -331,6 +707,29 @@ type CreateAreaRequest struct {
 	// Name Human readable name displayed across clients.
 	Name      string               `json:"name"`
 	Reactions []CreateAreaReaction `json:"reactions"`
+
+	// WorkspaceId Optional workspace the automation is shared in, the caller needs the editor role.
+	WorkspaceId *openapi_types.UUID `json:"workspaceId,omitempty"`
+}
+
+// CreateAreaTemplateRequest Payload used by administrators to publish a template.
+type CreateAreaTemplateRequest struct {
+	// Action Configuration of the action component that triggers the automation.
+	Action      CreateAreaAction     `json:"action"`
+	Description *string              `json:"description,omitempty"`
+	Name        string               `json:"name"`
+	Reactions   []CreateAreaReaction `json:"reactions"`
+}
+
+// CreateTagRequest defines model for CreateTagRequest.
+type CreateTagRequest struct {
+	Color *string `json:"color,omitempty"`
+	Name  string  `json:"name"`
+}
+
+// CreateWorkspaceRequest defines model for CreateWorkspaceRequest.
+type CreateWorkspaceRequest struct {
+	Name string `json:"name"`
 }
 
 // DuplicateAreaRequest Optional overrides applied when duplicating an automation.
@@ -351,6 +750,24 @@ type EmailChangeResponse struct {
 	VerificationExpiresAt *time.Time `json:"verificationExpiresAt"`
 }
 
+// Folder User-defined folder grouping automations.
+type Folder struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// FolderListResponse defines model for FolderListResponse.
+type FolderListResponse struct {
+	Folders []Folder `json:"folders"`
+}
+
+// FolderRequest defines model for FolderRequest.
+type FolderRequest struct {
+	Name string `json:"name"`
+}
+
 // IdentityListResponse defines model for IdentityListResponse.
 type IdentityListResponse struct {
 	Identities []IdentitySummary `json:"identities"`
@@ -366,9 +783,25 @@ type IdentitySummary struct {
 	Subject     string             `json:"subject"`
 }
 
-// ListAreasResponse Collection wrapper for automations returned to the client.
+// InstantiateAreaTemplateRequest Values supplied for the unresolved params of a template.
+type InstantiateAreaTemplateRequest struct {
+	// Action Values for the unresolved params of the action.
+	Action      *map[string]interface{} `json:"action,omitempty"`
+	Description *string                 `json:"description,omitempty"`
+
+	// Name Name of the automation, defaults to the template name.
+	Name *string `json:"name,omitempty"`
+
+	// Reactions Values for the unresolved params of each reaction, in template order.
+	Reactions *[]map[string]interface{} `json:"reactions,omitempty"`
+}
+
+// ListAreasResponse Page of automations returned to the client.
 type ListAreasResponse struct {
 	Areas []Area `json:"areas"`
+
+	// NextCursor Cursor of the next page, absent on the last page.
+	NextCursor *string `json:"nextCursor,omitempty"`
 }
 
 // LoginRequest Credential-based authentication payload.
@@ -380,6 +813,18 @@ type LoginRequest struct {
 	Password string `json:"password"`
 }
 
+// MoveAreaFolderRequest defines model for MoveAreaFolderRequest.
+type MoveAreaFolderRequest struct {
+	// FolderId Folder to file the automation in, null to take it out of its folder.
+	FolderId *openapi_types.UUID `json:"folderId"`
+}
+
+// MoveAreaWorkspaceRequest Target workspace of an automation.
+type MoveAreaWorkspaceRequest struct {
+	// WorkspaceId Workspace to share the automation in, null to make it personal.
+	WorkspaceId *openapi_types.UUID `json:"workspaceId"`
+}
+
 // OAuthAuthorizationRequest Optional parameters forwarded to the configured OAuth provider.
 type OAuthAuthorizationRequest struct {
 	// Prompt Provider-specific prompt parameter allowing the user experience to be tweaked.
@@ -431,6 +876,78 @@ type OAuthExchangeRequest struct {
 	State *string `json:"state,omitempty"`
 }
 
+// PreviewComponentRequest Parameters used to run a preview poll.
+type PreviewComponentRequest struct {
+	// Params Action parameters, validated against the component metadata.
+	Params map[string]interface{} `json:"params"`
+}
+
+// PreviewComponentResponse Events a polling action would emit.
+type PreviewComponentResponse struct {
+	// Events Events in emission order, capped at 20.
+	Events []PreviewEvent `json:"events"`
+
+	// Total Number of events produced before truncation.
+	Total int `json:"total"`
+
+	// Truncated Whether the events list was capped.
+	Truncated bool `json:"truncated"`
+}
+
+// PreviewEvent Event produced by a preview poll.
+type PreviewEvent struct {
+	// Fingerprint Deduplication key of the event.
+	Fingerprint string `json:"fingerprint"`
+
+	// OccurredAt Timestamp extracted from the item when available.
+	OccurredAt *time.Time `json:"occurredAt"`
+
+	// Payload Payload exposed to reactions.
+	Payload map[string]interface{} `json:"payload"`
+}
+
+// QuotaLimits Limits of a plan, a zero value means unlimited.
+type QuotaLimits struct {
+	// MaxAreas Maximum number of areas run by the account, archived areas excluded.
+	MaxAreas int `json:"maxAreas"`
+
+	// MaxExecutionsPerDay Maximum number of executions over a rolling day.
+	MaxExecutionsPerDay int `json:"maxExecutionsPerDay"`
+
+	// MaxExecutionsPerHour Maximum number of executions over a rolling hour.
+	MaxExecutionsPerHour int `json:"maxExecutionsPerHour"`
+
+	// MaxReactionsPerArea Maximum number of reactions chained by a single area.
+	MaxReactionsPerArea int `json:"maxReactionsPerArea"`
+
+	// MinPollingIntervalSeconds Shortest delay between two polls of an action source, shorter intervals are raised to it.
+	MinPollingIntervalSeconds int `json:"minPollingIntervalSeconds"`
+}
+
+// QuotaReport Plan limits applied to the account along with what it currently consumes.
+type QuotaReport struct {
+	// Limits Limits of a plan, a zero value means unlimited.
+	Limits QuotaLimits `json:"limits"`
+
+	// Plan Plan resolving the limits, derived from the account role.
+	Plan string `json:"plan"`
+
+	// Usage Resources currently consumed by the account.
+	Usage QuotaUsage `json:"usage"`
+}
+
+// QuotaUsage Resources currently consumed by the account.
+type QuotaUsage struct {
+	// Areas Areas run by the account, archived areas excluded.
+	Areas int `json:"areas"`
+
+	// ExecutionsLastDay Executions enqueued during the last 24 hours.
+	ExecutionsLastDay int `json:"executionsLastDay"`
+
+	// ExecutionsLastHour Executions enqueued during the last hour.
+	ExecutionsLastHour int `json:"executionsLastHour"`
+}
+
 // RegisterUserRequest Payload used to enrol a new AREA account prior to email verification.
 type RegisterUserRequest struct {
 	// Email Primary email address that receives activation and security alerts.
@@ -488,6 +1005,12 @@ type SessionAuth struct {
 // SessionAuthMethod Mechanism used to authenticate the session.
 type SessionAuthMethod string
 
+// SetAreaRunnerRequest Member selected to run a shared automation.
+type SetAreaRunnerRequest struct {
+	// UserId Identifier of a workspace member with the editor role.
+	UserId openapi_types.UUID `json:"userId"`
+}
+
 // SubscribeExchangeRequest defines model for SubscribeExchangeRequest.
 type SubscribeExchangeRequest struct {
 	Code         string  `json:"code"`
@@ -503,17 +1026,22 @@ type SubscribeExchangeResponse struct {
 
 // SubscribeServiceRequest defines model for SubscribeServiceRequest.
 type SubscribeServiceRequest struct {
-	Prompt      *string   `json:"prompt,omitempty"`
-	RedirectUri *string   `json:"redirectUri,omitempty"`
-	Scopes      *[]string `json:"scopes,omitempty"`
-	State       *string   `json:"state,omitempty"`
-	UsePkce     *bool     `json:"usePkce,omitempty"`
+	// Credentials Credentials of providers linked without OAuth. The email provider reads address, username,
+	// password, smtpHost, smtpPort, smtpSecurity (tls, starttls or none), imapHost, imapPort and
+	// imapSecurity.
+	Credentials *map[string]string `json:"credentials,omitempty"`
+	Prompt      *string            `json:"prompt,omitempty"`
+	RedirectUri *string            `json:"redirectUri,omitempty"`
+	Scopes      *[]string          `json:"scopes,omitempty"`
+	State       *string            `json:"state,omitempty"`
+	UsePkce     *bool              `json:"usePkce,omitempty"`
 }
 
 // SubscribeServiceResponse defines model for SubscribeServiceResponse.
 type SubscribeServiceResponse struct {
 	// Authorization Provider authorisation metadata.
 	Authorization *OAuthAuthorizationResponse    `json:"authorization,omitempty"`
+	Identity      *IdentitySummary               `json:"identity,omitempty"`
 	Status        SubscribeServiceResponseStatus `json:"status"`
 	Subscription  *SubscriptionSummary           `json:"subscription,omitempty"`
 }
@@ -537,6 +1065,21 @@ type SubscriptionSummary struct {
 	UpdatedAt   *time.Time          `json:"updatedAt,omitempty"`
 }
 
+// Tag User-defined label attached to automations.
+type Tag struct {
+	// Color Hex color code such as `#ff8800`.
+	Color     *string            `json:"color,omitempty"`
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+	UpdatedAt time.Time          `json:"updatedAt"`
+}
+
+// TagListResponse defines model for TagListResponse.
+type TagListResponse struct {
+	Tags []Tag `json:"tags"`
+}
+
 // UpdateAreaAction Partial update instructions for the automation action.
 type UpdateAreaAction struct {
 	// ConfigId Identifier of the existing action configuration to update.
@@ -549,6 +1092,18 @@ type UpdateAreaAction struct {
 	Params *map[string]interface{} `json:"params,omitempty"`
 }
 
+// UpdateAreaActivityScheduleRequest defines model for UpdateAreaActivityScheduleRequest.
+type UpdateAreaActivityScheduleRequest struct {
+	// Schedule Schedule to apply, null keeps the automation always active.
+	Schedule *AreaActivitySchedule `json:"schedule"`
+}
+
+// UpdateAreaHealthPolicyRequest defines model for UpdateAreaHealthPolicyRequest.
+type UpdateAreaHealthPolicyRequest struct {
+	// Policy Policy to apply, null restores the default policy.
+	Policy *AreaHealthPolicy `json:"policy"`
+}
+
 // UpdateAreaReaction Partial update instructions for a reaction configuration.
 type UpdateAreaReaction struct {
 	// ConfigId Identifier of the reaction configuration to update.
@@ -585,6 +1140,18 @@ type UpdateAreaStatusRequest struct {
 // UpdateAreaStatusRequestStatus Desired lifecycle status.
 type UpdateAreaStatusRequestStatus string
 
+// UpdateTagRequest Fields to change, an empty color removes it.
+type UpdateTagRequest struct {
+	Color *string `json:"color,omitempty"`
+	Name  *string `json:"name,omitempty"`
+}
+
+// UpdateWorkspaceMemberRequest defines model for UpdateWorkspaceMemberRequest.
+type UpdateWorkspaceMemberRequest struct {
+	// Role Viewers read shared automations, editors also change and run them, owners manage members and may delete.
+	Role WorkspaceRole `json:"role"`
+}
+
 // User Detailed user payload returned by authenticated endpoints.
 type User struct {
 	// CreatedAt Account creation timestamp in UTC.
@@ -611,6 +1178,9 @@ type User struct {
 
 // UserResponse Public representation of the authenticated user.
 type UserResponse struct {
+	// Quota Plan limits applied to the account along with what it currently consumes.
+	Quota *QuotaReport `json:"quota,omitempty"`
+
 	// SessionAuth Authentication metadata describing how the current session was issued.
 	SessionAuth *SessionAuth `json:"sessionAuth,omitempty"`
 
@@ -631,18 +1201,133 @@ type VerifyEmailRequest struct {
 	Token string `json:"token"`
 }
 
+// Workspace Team space sharing automations between its members.
+type Workspace struct {
+	CreatedAt time.Time          `json:"createdAt"`
+	Id        openapi_types.UUID `json:"id"`
+	Name      string             `json:"name"`
+
+	// Role Viewers read shared automations, editors also change and run them, owners manage members and may delete.
+	Role      WorkspaceRole `json:"role"`
+	UpdatedAt time.Time     `json:"updatedAt"`
+}
+
+// WorkspaceDetail Workspace with its members.
+type WorkspaceDetail struct {
+	Members []WorkspaceMember `json:"members"`
+
+	// Workspace Team space sharing automations between its members.
+	Workspace Workspace `json:"workspace"`
+}
+
+// WorkspaceListResponse Workspaces the authenticated user belongs to.
+type WorkspaceListResponse struct {
+	Workspaces []Workspace `json:"workspaces"`
+}
+
+// WorkspaceMember Member of a workspace and the role granted to them.
+type WorkspaceMember struct {
+	CreatedAt time.Time           `json:"createdAt"`
+	Email     openapi_types.Email `json:"email"`
+
+	// Role Viewers read shared automations, editors also change and run them, owners manage members and may delete.
+	Role   WorkspaceRole      `json:"role"`
+	UserId openapi_types.UUID `json:"userId"`
+}
+
+// WorkspaceRole Viewers read shared automations, editors also change and run them, owners manage members and may delete.
+type WorkspaceRole string
+
+// FolderId defines model for FolderId.
+type FolderId = openapi_types.UUID
+
 // OAuthProvider defines model for OAuthProvider.
 type OAuthProvider = string
 
+// TagId defines model for TagId.
+type TagId = openapi_types.UUID
+
+// TemplateId defines model for TemplateId.
+type TemplateId = openapi_types.UUID
+
 // UserId defines model for UserId.
 type UserId = openapi_types.UUID
 
+// WorkspaceId defines model for WorkspaceId.
+type WorkspaceId = openapi_types.UUID
+
+// ListAreasParams defines parameters for ListAreas.
+type ListAreasParams struct {
+	// Limit Maximum number of automations to return, enables pagination (default 50 when only a cursor is given)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor Cursor returned as `nextCursor` by the previous page
+	Cursor *string `form:"cursor,omitempty" json:"cursor,omitempty"`
+
+	// Status Keep automations in any of the given statuses
+	Status *[]ListAreasParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+
+	// Provider Keep automations using at least one component of the provider (for example `github`)
+	Provider *string `form:"provider,omitempty" json:"provider,omitempty"`
+
+	// ComponentId Keep automations using the catalog component
+	ComponentId *openapi_types.UUID `form:"componentId,omitempty" json:"componentId,omitempty"`
+
+	// TagId Keep automations carrying the tag
+	TagId *openapi_types.UUID `form:"tagId,omitempty" json:"tagId,omitempty"`
+
+	// FolderId Keep automations filed in the folder
+	FolderId *openapi_types.UUID `form:"folderId,omitempty" json:"folderId,omitempty"`
+
+	// Q Case-insensitive search on the automation name
+	Q *string `form:"q,omitempty" json:"q,omitempty"`
+
+	// Sort Sort key (default `createdAt`), automations that never ran sort last with `lastRunAt` in descending order
+	Sort *ListAreasParamsSort `form:"sort,omitempty" json:"sort,omitempty"`
+
+	// Order Sort direction (default `desc`)
+	Order *ListAreasParamsOrder `form:"order,omitempty" json:"order,omitempty"`
+}
+
+// ListAreasParamsStatus defines parameters for ListAreas.
+type ListAreasParamsStatus string
+
+// ListAreasParamsSort defines parameters for ListAreas.
+type ListAreasParamsSort string
+
+// ListAreasParamsOrder defines parameters for ListAreas.
+type ListAreasParamsOrder string
+
+// ExportAreaParams defines parameters for ExportArea.
+type ExportAreaParams struct {
+	// Format Serialization format of the document.
+	Format *ExportAreaParamsFormat `form:"format,omitempty" json:"format,omitempty"`
+}
+
+// ExportAreaParamsFormat defines parameters for ExportArea.
+type ExportAreaParamsFormat string
+
 // ListAreaHistoryParams defines parameters for ListAreaHistory.
 type ListAreaHistoryParams struct {
 	// Limit Maximum number of executions to return (default 50)
 	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
 }
 
+// ListAreaRevisionsParams defines parameters for ListAreaRevisions.
+type ListAreaRevisionsParams struct {
+	// Limit Maximum number of revisions to return (default 50)
+	Limit *int `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// DiffAreaRevisionsParams defines parameters for DiffAreaRevisions.
+type DiffAreaRevisionsParams struct {
+	// From Revision number used as the base of the comparison
+	From int `form:"from" json:"from"`
+
+	// To Revision number compared against the base
+	To int `form:"to" json:"to"`
+}
+
 // ListComponentsParams defines parameters for ListComponents.
 type ListComponentsParams struct {
 	// Kind Filter components by kind
@@ -667,6 +1352,9 @@ type ListAvailableComponentsParams struct {
 // ListAvailableComponentsParamsKind defines parameters for ListAvailableComponents.
 type ListAvailableComponentsParamsKind string
 
+// CreateAreaTemplateJSONRequestBody defines body for CreateAreaTemplate for application/json ContentType.
+type CreateAreaTemplateJSONRequestBody = CreateAreaTemplateRequest
+
 // AdminUpdateUserEmailJSONRequestBody defines body for AdminUpdateUserEmail for application/json ContentType.
 type AdminUpdateUserEmailJSONRequestBody = AdminUpdateEmailRequest
 
@@ -679,15 +1367,36 @@ type AdminUpdateUserStatusJSONRequestBody = AdminUpdateStatusRequest
 // CreateAreaJSONRequestBody defines body for CreateArea for application/json ContentType.
 type CreateAreaJSONRequestBody = CreateAreaRequest
 
+// ImportAreaJSONRequestBody defines body for ImportArea for application/json ContentType.
+type ImportAreaJSONRequestBody = AreaDocument
+
 // UpdateAreaJSONRequestBody defines body for UpdateArea for application/json ContentType.
 type UpdateAreaJSONRequestBody = UpdateAreaRequest
 
+// SetAreaActivityScheduleJSONRequestBody defines body for SetAreaActivitySchedule for application/json ContentType.
+type SetAreaActivityScheduleJSONRequestBody = UpdateAreaActivityScheduleRequest
+
+// DryRunAreaJSONRequestBody defines body for DryRunArea for application/json ContentType.
+type DryRunAreaJSONRequestBody = AreaDryRunRequest
+
 // DuplicateAreaJSONRequestBody defines body for DuplicateArea for application/json ContentType.
 type DuplicateAreaJSONRequestBody = DuplicateAreaRequest
 
+// MoveAreaFolderJSONRequestBody defines body for MoveAreaFolder for application/json ContentType.
+type MoveAreaFolderJSONRequestBody = MoveAreaFolderRequest
+
+// SetAreaHealthPolicyJSONRequestBody defines body for SetAreaHealthPolicy for application/json ContentType.
+type SetAreaHealthPolicyJSONRequestBody = UpdateAreaHealthPolicyRequest
+
+// SetAreaRunnerJSONRequestBody defines body for SetAreaRunner for application/json ContentType.
+type SetAreaRunnerJSONRequestBody = SetAreaRunnerRequest
+
 // UpdateAreaStatusJSONRequestBody defines body for UpdateAreaStatus for application/json ContentType.
 type UpdateAreaStatusJSONRequestBody = UpdateAreaStatusRequest
 
+// MoveAreaWorkspaceJSONRequestBody defines body for MoveAreaWorkspace for application/json ContentType.
+type MoveAreaWorkspaceJSONRequestBody = MoveAreaWorkspaceRequest
+
 // ChangeEmailJSONRequestBody defines body for ChangeEmail for application/json ContentType.
 type ChangeEmailJSONRequestBody = ChangeEmailRequest
 
@@ -700,6 +1409,15 @@ type ChangePasswordJSONRequestBody = ChangePasswordRequest
 // VerifyEmailJSONRequestBody defines body for VerifyEmail for application/json ContentType.
 type VerifyEmailJSONRequestBody = VerifyEmailRequest
 
+// PreviewComponentJSONRequestBody defines body for PreviewComponent for application/json ContentType.
+type PreviewComponentJSONRequestBody = PreviewComponentRequest
+
+// CreateFolderJSONRequestBody defines body for CreateFolder for application/json ContentType.
+type CreateFolderJSONRequestBody = FolderRequest
+
+// UpdateFolderJSONRequestBody defines body for UpdateFolder for application/json ContentType.
+type UpdateFolderJSONRequestBody = FolderRequest
+
 // AuthorizeOAuthJSONRequestBody defines body for AuthorizeOAuth for application/json ContentType.
 type AuthorizeOAuthJSONRequestBody = OAuthAuthorizationRequest
 
@@ -712,14 +1430,41 @@ type SubscribeServiceJSONRequestBody = SubscribeServiceRequest
 // SubscribeServiceExchangeJSONRequestBody defines body for SubscribeServiceExchange for application/json ContentType.
 type SubscribeServiceExchangeJSONRequestBody = SubscribeExchangeRequest
 
+// CreateTagJSONRequestBody defines body for CreateTag for application/json ContentType.
+type CreateTagJSONRequestBody = CreateTagRequest
+
+// UpdateTagJSONRequestBody defines body for UpdateTag for application/json ContentType.
+type UpdateTagJSONRequestBody = UpdateTagRequest
+
+// BulkTagActionJSONRequestBody defines body for BulkTagAction for application/json ContentType.
+type BulkTagActionJSONRequestBody = BulkTagActionRequest
+
+// InstantiateAreaTemplateJSONRequestBody defines body for InstantiateAreaTemplate for application/json ContentType.
+type InstantiateAreaTemplateJSONRequestBody = InstantiateAreaTemplateRequest
+
 // RegisterUserJSONRequestBody defines body for RegisterUser for application/json ContentType.
 type RegisterUserJSONRequestBody = RegisterUserRequest
 
+// CreateWorkspaceJSONRequestBody defines body for CreateWorkspace for application/json ContentType.
+type CreateWorkspaceJSONRequestBody = CreateWorkspaceRequest
+
+// AddWorkspaceMemberJSONRequestBody defines body for AddWorkspaceMember for application/json ContentType.
+type AddWorkspaceMemberJSONRequestBody = AddWorkspaceMemberRequest
+
+// UpdateWorkspaceMemberJSONRequestBody defines body for UpdateWorkspaceMember for application/json ContentType.
+type UpdateWorkspaceMemberJSONRequestBody = UpdateWorkspaceMemberRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Describe server capabilities
 	// (GET /about.json)
 	GetAbout(c *gin.Context)
+	// Publish an automation template
+	// (POST /v1/admin/templates)
+	CreateAreaTemplate(c *gin.Context)
+	// Unpublish an automation template
+	// (DELETE /v1/admin/templates/{templateId})
+	DeleteAreaTemplate(c *gin.Context, templateId TemplateId)
 	// Update user email
 	// (PATCH /v1/admin/users/{userId}/email)
 	AdminUpdateUserEmail(c *gin.Context, userId UserId)
@@ -729,12 +1474,15 @@ type ServerInterface interface {
 	// Update user status
 	// (PATCH /v1/admin/users/{userId}/status)
 	AdminUpdateUserStatus(c *gin.Context, userId UserId)
-	// List automations owned by the current user
+	// List automations owned by or shared with the current user
 	// (GET /v1/areas)
-	ListAreas(c *gin.Context)
+	ListAreas(c *gin.Context, params ListAreasParams)
 	// Create a new automation for the current user
 	// (POST /v1/areas)
 	CreateArea(c *gin.Context)
+	// Create an automation from a portable document produced by the export endpoint
+	// (POST /v1/areas/import)
+	ImportArea(c *gin.Context)
 	// Delete an automation owned by the current user
 	// (DELETE /v1/areas/{areaId})
 	DeleteArea(c *gin.Context, areaId openapi_types.UUID)
@@ -744,18 +1492,57 @@ type ServerInterface interface {
 	// Update an automation owned by the current user
 	// (PATCH /v1/areas/{areaId})
 	UpdateArea(c *gin.Context, areaId openapi_types.UUID)
+	// Restrict when an automation reacts to events
+	// (PUT /v1/areas/{areaId}/activity-schedule)
+	SetAreaActivitySchedule(c *gin.Context, areaId openapi_types.UUID)
+	// Test-fire an automation without side effects
+	// (POST /v1/areas/{areaId}/dry-run)
+	DryRunArea(c *gin.Context, areaId openapi_types.UUID)
 	// Duplicate an automation and persist the copy for the current user
 	// (POST /v1/areas/{areaId}/duplicate)
 	DuplicateArea(c *gin.Context, areaId openapi_types.UUID)
 	// Execute area reactions immediately
 	// (POST /v1/areas/{areaId}/execute)
 	ExecuteArea(c *gin.Context, areaId openapi_types.UUID)
+	// Export an automation as a portable JSON or YAML document
+	// (GET /v1/areas/{areaId}/export)
+	ExportArea(c *gin.Context, areaId openapi_types.UUID, params ExportAreaParams)
+	// File an automation in one of the current user's folders
+	// (PUT /v1/areas/{areaId}/folder)
+	MoveAreaFolder(c *gin.Context, areaId openapi_types.UUID)
+	// Configure the circuit breaker of an automation
+	// (PUT /v1/areas/{areaId}/health-policy)
+	SetAreaHealthPolicy(c *gin.Context, areaId openapi_types.UUID)
 	// List recent executions for an automation
 	// (GET /v1/areas/{areaId}/history)
 	ListAreaHistory(c *gin.Context, areaId openapi_types.UUID, params ListAreaHistoryParams)
+	// List revisions of an automation
+	// (GET /v1/areas/{areaId}/revisions)
+	ListAreaRevisions(c *gin.Context, areaId openapi_types.UUID, params ListAreaRevisionsParams)
+	// Compare two revisions of an automation
+	// (GET /v1/areas/{areaId}/revisions/diff)
+	DiffAreaRevisions(c *gin.Context, areaId openapi_types.UUID, params DiffAreaRevisionsParams)
+	// Roll an automation back to a revision
+	// (POST /v1/areas/{areaId}/revisions/{revision}/rollback)
+	RollbackArea(c *gin.Context, areaId openapi_types.UUID, revision int)
+	// Choose the member whose linked accounts run a shared automation
+	// (PUT /v1/areas/{areaId}/runner)
+	SetAreaRunner(c *gin.Context, areaId openapi_types.UUID)
 	// Update the lifecycle status of an automation
 	// (PATCH /v1/areas/{areaId}/status)
 	UpdateAreaStatus(c *gin.Context, areaId openapi_types.UUID)
+	// Detach one of the current user's tags from an automation
+	// (DELETE /v1/areas/{areaId}/tags/{tagId})
+	UntagArea(c *gin.Context, areaId openapi_types.UUID, tagId TagId)
+	// Attach one of the current user's tags to an automation
+	// (PUT /v1/areas/{areaId}/tags/{tagId})
+	TagArea(c *gin.Context, areaId openapi_types.UUID, tagId TagId)
+	// Retrieve the URL and secret receiving events for a webhook-triggered automation
+	// (GET /v1/areas/{areaId}/webhook)
+	GetAreaWebhook(c *gin.Context, areaId openapi_types.UUID)
+	// Move an automation into or out of a workspace
+	// (PUT /v1/areas/{areaId}/workspace)
+	MoveAreaWorkspace(c *gin.Context, areaId openapi_types.UUID)
 	// Change account email
 	// (PATCH /v1/auth/email)
 	ChangeEmail(c *gin.Context)
@@ -780,6 +1567,21 @@ type ServerInterface interface {
 	// List components available to the current user
 	// (GET /v1/components/available)
 	ListAvailableComponents(c *gin.Context, params ListAvailableComponentsParams)
+	// Preview the events emitted by a polling action
+	// (POST /v1/components/{componentId}/preview)
+	PreviewComponent(c *gin.Context, componentId openapi_types.UUID)
+	// List the folders of the current user
+	// (GET /v1/folders)
+	ListFolders(c *gin.Context)
+	// Create a folder for the current user
+	// (POST /v1/folders)
+	CreateFolder(c *gin.Context)
+	// Delete a folder
+	// (DELETE /v1/folders/{folderId})
+	DeleteFolder(c *gin.Context, folderId FolderId)
+	// Rename a folder
+	// (PATCH /v1/folders/{folderId})
+	UpdateFolder(c *gin.Context, folderId FolderId)
 	// List connected identities
 	// (GET /v1/identities)
 	ListIdentities(c *gin.Context)
@@ -804,9 +1606,54 @@ type ServerInterface interface {
 	// Revoke a service subscription
 	// (DELETE /v1/services/{provider}/subscription)
 	UnsubscribeService(c *gin.Context, provider OAuthProvider)
+	// List the tags of the current user
+	// (GET /v1/tags)
+	ListTags(c *gin.Context)
+	// Create a tag for the current user
+	// (POST /v1/tags)
+	CreateTag(c *gin.Context)
+	// Delete a tag
+	// (DELETE /v1/tags/{tagId})
+	DeleteTag(c *gin.Context, tagId TagId)
+	// Rename or recolor a tag
+	// (PATCH /v1/tags/{tagId})
+	UpdateTag(c *gin.Context, tagId TagId)
+	// Enable, disable, archive or delete every automation carrying a tag
+	// (POST /v1/tags/{tagId}/bulk)
+	BulkTagAction(c *gin.Context, tagId TagId)
+	// List automation templates
+	// (GET /v1/templates)
+	ListAreaTemplates(c *gin.Context)
+	// Get an automation template
+	// (GET /v1/templates/{templateId})
+	GetAreaTemplate(c *gin.Context, templateId TemplateId)
+	// Create an automation from a template
+	// (POST /v1/templates/{templateId}/instantiate)
+	InstantiateAreaTemplate(c *gin.Context, templateId TemplateId)
 	// Register a new user
 	// (POST /v1/users)
 	RegisterUser(c *gin.Context)
+	// List the workspaces of the current user
+	// (GET /v1/workspaces)
+	ListWorkspaces(c *gin.Context)
+	// Create a workspace owned by the current user
+	// (POST /v1/workspaces)
+	CreateWorkspace(c *gin.Context)
+	// Delete a workspace
+	// (DELETE /v1/workspaces/{workspaceId})
+	DeleteWorkspace(c *gin.Context, workspaceId WorkspaceId)
+	// Get a workspace and its members
+	// (GET /v1/workspaces/{workspaceId})
+	GetWorkspace(c *gin.Context, workspaceId WorkspaceId)
+	// Add a registered user to a workspace
+	// (POST /v1/workspaces/{workspaceId}/members)
+	AddWorkspaceMember(c *gin.Context, workspaceId WorkspaceId)
+	// Remove a member from a workspace
+	// (DELETE /v1/workspaces/{workspaceId}/members/{userId})
+	RemoveWorkspaceMember(c *gin.Context, workspaceId WorkspaceId, userId UserId)
+	// Change the role of a workspace member
+	// (PATCH /v1/workspaces/{workspaceId}/members/{userId})
+	UpdateWorkspaceMember(c *gin.Context, workspaceId WorkspaceId, userId UserId)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -831,6 +1678,47 @@ func (siw *ServerInterfaceWrapper) GetAbout(c *gin.Context) {
 	siw.Handler.GetAbout(c)
 }
 
+// CreateAreaTemplate operation middleware
+func (siw *ServerInterfaceWrapper) CreateAreaTemplate(c *gin.Context) {
+
+	c.Set(SessionAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.CreateAreaTemplate(c)
+}
+
+// DeleteAreaTemplate operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAreaTemplate(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "templateId" -------------
+	var templateId TemplateId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", c.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(SessionAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteAreaTemplate(c, templateId)
+}
+
 // AdminUpdateUserEmail operation middleware
 func (siw *ServerInterfaceWrapper) AdminUpdateUserEmail(c *gin.Context) {
 
@@ -912,8 +1800,93 @@ func (siw *ServerInterfaceWrapper) AdminUpdateUserStatus(c *gin.Context) {
 // ListAreas operation middleware
 func (siw *ServerInterfaceWrapper) ListAreas(c *gin.Context) {
 
+	var err error
+
 	c.Set(SessionAuthScopes, []string{})
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAreasParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", c.Request.URL.Query(), &params.Cursor)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter cursor: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", c.Request.URL.Query(), &params.Status)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter status: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "provider" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "provider", c.Request.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "componentId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "componentId", c.Request.URL.Query(), &params.ComponentId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter componentId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "tagId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "tagId", c.Request.URL.Query(), &params.TagId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "folderId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "folderId", c.Request.URL.Query(), &params.FolderId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter folderId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "q" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "q", c.Request.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter q: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "sort", c.Request.URL.Query(), &params.Sort)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter sort: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "order" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "order", c.Request.URL.Query(), &params.Order)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter order: %w", err), http.StatusBadRequest)
+		return
+	}
+
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -921,7 +1894,7 @@ func (siw *ServerInterfaceWrapper) ListAreas(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListAreas(c)
+	siw.Handler.ListAreas(c, params)
 }
 
 // CreateArea operation middleware
@@ -939,6 +1912,21 @@ func (siw *ServerInterfaceWrapper) CreateArea(c *gin.Context) {
 	siw.Handler.CreateArea(c)
 }
 
+// ImportArea operation middleware
+func (siw *ServerInterfaceWrapper) ImportArea(c *gin.Context) {
+
+	c.Set(SessionAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.ImportArea(c)
+}
+
 // DeleteArea operation middleware
 func (siw *ServerInterfaceWrapper) DeleteArea(c *gin.Context) {
 
@@ -1017,8 +2005,8 @@ func (siw *ServerInterfaceWrapper) UpdateArea(c *gin.Context) {
 	siw.Handler.UpdateArea(c, areaId)
 }
 
-// DuplicateArea operation middleware
-func (siw *ServerInterfaceWrapper) DuplicateArea(c *gin.Context) {
+// SetAreaActivitySchedule operation middleware
+func (siw *ServerInterfaceWrapper) SetAreaActivitySchedule(c *gin.Context) {
 
 	var err error
 
@@ -1040,11 +2028,11 @@ func (siw *ServerInterfaceWrapper) DuplicateArea(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.DuplicateArea(c, areaId)
+	siw.Handler.SetAreaActivitySchedule(c, areaId)
 }
 
-// ExecuteArea operation middleware
-func (siw *ServerInterfaceWrapper) ExecuteArea(c *gin.Context) {
+// DryRunArea operation middleware
+func (siw *ServerInterfaceWrapper) DryRunArea(c *gin.Context) {
 
 	var err error
 
@@ -1066,11 +2054,11 @@ func (siw *ServerInterfaceWrapper) ExecuteArea(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ExecuteArea(c, areaId)
+	siw.Handler.DryRunArea(c, areaId)
 }
 
-// ListAreaHistory operation middleware
-func (siw *ServerInterfaceWrapper) ListAreaHistory(c *gin.Context) {
+// DuplicateArea operation middleware
+func (siw *ServerInterfaceWrapper) DuplicateArea(c *gin.Context) {
 
 	var err error
 
@@ -1085,17 +2073,6 @@ func (siw *ServerInterfaceWrapper) ListAreaHistory(c *gin.Context) {
 
 	c.Set(SessionAuthScopes, []string{})
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListAreaHistoryParams
-
-	// ------------- Optional query parameter "limit" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
-		return
-	}
-
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
 		if c.IsAborted() {
@@ -1103,11 +2080,11 @@ func (siw *ServerInterfaceWrapper) ListAreaHistory(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListAreaHistory(c, areaId, params)
+	siw.Handler.DuplicateArea(c, areaId)
 }
 
-// UpdateAreaStatus operation middleware
-func (siw *ServerInterfaceWrapper) UpdateAreaStatus(c *gin.Context) {
+// ExecuteArea operation middleware
+func (siw *ServerInterfaceWrapper) ExecuteArea(c *gin.Context) {
 
 	var err error
 
@@ -1129,26 +2106,35 @@ func (siw *ServerInterfaceWrapper) UpdateAreaStatus(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UpdateAreaStatus(c, areaId)
+	siw.Handler.ExecuteArea(c, areaId)
 }
 
-// ChangeEmail operation middleware
-func (siw *ServerInterfaceWrapper) ChangeEmail(c *gin.Context) {
+// ExportArea operation middleware
+func (siw *ServerInterfaceWrapper) ExportArea(c *gin.Context) {
 
-	c.Set(SessionAuthScopes, []string{})
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.ChangeEmail(c)
-}
+	c.Set(SessionAuthScopes, []string{})
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ExportAreaParams
+
+	// ------------- Optional query parameter "format" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "format", c.Request.URL.Query(), &params.Format)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter format: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1157,11 +2143,22 @@ func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.Login(c)
+	siw.Handler.ExportArea(c, areaId, params)
 }
 
-// Logout operation middleware
-func (siw *ServerInterfaceWrapper) Logout(c *gin.Context) {
+// MoveAreaFolder operation middleware
+func (siw *ServerInterfaceWrapper) MoveAreaFolder(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(SessionAuthScopes, []string{})
 
@@ -1172,11 +2169,22 @@ func (siw *ServerInterfaceWrapper) Logout(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.Logout(c)
+	siw.Handler.MoveAreaFolder(c, areaId)
 }
 
-// GetCurrentUser operation middleware
-func (siw *ServerInterfaceWrapper) GetCurrentUser(c *gin.Context) {
+// SetAreaHealthPolicy operation middleware
+func (siw *ServerInterfaceWrapper) SetAreaHealthPolicy(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(SessionAuthScopes, []string{})
 
@@ -1187,26 +2195,35 @@ func (siw *ServerInterfaceWrapper) GetCurrentUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetCurrentUser(c)
+	siw.Handler.SetAreaHealthPolicy(c, areaId)
 }
 
-// ChangePassword operation middleware
-func (siw *ServerInterfaceWrapper) ChangePassword(c *gin.Context) {
+// ListAreaHistory operation middleware
+func (siw *ServerInterfaceWrapper) ListAreaHistory(c *gin.Context) {
 
-	c.Set(SessionAuthScopes, []string{})
+	var err error
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.ChangePassword(c)
-}
+	c.Set(SessionAuthScopes, []string{})
 
-// VerifyEmail operation middleware
-func (siw *ServerInterfaceWrapper) VerifyEmail(c *gin.Context) {
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAreaHistoryParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1215,32 +2232,33 @@ func (siw *ServerInterfaceWrapper) VerifyEmail(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.VerifyEmail(c)
+	siw.Handler.ListAreaHistory(c, areaId, params)
 }
 
-// ListComponents operation middleware
-func (siw *ServerInterfaceWrapper) ListComponents(c *gin.Context) {
+// ListAreaRevisions operation middleware
+func (siw *ServerInterfaceWrapper) ListAreaRevisions(c *gin.Context) {
 
 	var err error
 
-	c.Set(SessionAuthScopes, []string{})
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListComponentsParams
-
-	// ------------- Optional query parameter "kind" -------------
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
 
-	err = runtime.BindQueryParameter("form", true, false, "kind", c.Request.URL.Query(), &params.Kind)
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kind: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "provider" -------------
+	c.Set(SessionAuthScopes, []string{})
 
-	err = runtime.BindQueryParameter("form", true, false, "provider", c.Request.URL.Query(), &params.Provider)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAreaRevisionsParams
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", c.Request.URL.Query(), &params.Limit)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter limit: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1251,32 +2269,55 @@ func (siw *ServerInterfaceWrapper) ListComponents(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListComponents(c, params)
+	siw.Handler.ListAreaRevisions(c, areaId, params)
 }
 
-// ListAvailableComponents operation middleware
-func (siw *ServerInterfaceWrapper) ListAvailableComponents(c *gin.Context) {
+// DiffAreaRevisions operation middleware
+func (siw *ServerInterfaceWrapper) DiffAreaRevisions(c *gin.Context) {
 
 	var err error
 
-	c.Set(SessionAuthScopes, []string{})
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(SessionAuthScopes, []string{})
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params ListAvailableComponentsParams
+	var params DiffAreaRevisionsParams
 
-	// ------------- Optional query parameter "kind" -------------
+	// ------------- Required query parameter "from" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "kind", c.Request.URL.Query(), &params.Kind)
+	if paramValue := c.Query("from"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument from is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "from", c.Request.URL.Query(), &params.From)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kind: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter from: %w", err), http.StatusBadRequest)
 		return
 	}
 
-	// ------------- Optional query parameter "provider" -------------
+	// ------------- Required query parameter "to" -------------
 
-	err = runtime.BindQueryParameter("form", true, false, "provider", c.Request.URL.Query(), &params.Provider)
+	if paramValue := c.Query("to"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument to is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "to", c.Request.URL.Query(), &params.To)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter to: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1287,11 +2328,31 @@ func (siw *ServerInterfaceWrapper) ListAvailableComponents(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListAvailableComponents(c, params)
+	siw.Handler.DiffAreaRevisions(c, areaId, params)
 }
 
-// ListIdentities operation middleware
-func (siw *ServerInterfaceWrapper) ListIdentities(c *gin.Context) {
+// RollbackArea operation middleware
+func (siw *ServerInterfaceWrapper) RollbackArea(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "revision" -------------
+	var revision int
+
+	err = runtime.BindStyledParameterWithOptions("simple", "revision", c.Param("revision"), &revision, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter revision: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(SessionAuthScopes, []string{})
 
@@ -1302,20 +2363,20 @@ func (siw *ServerInterfaceWrapper) ListIdentities(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListIdentities(c)
+	siw.Handler.RollbackArea(c, areaId, revision)
 }
 
-// AuthorizeOAuth operation middleware
-func (siw *ServerInterfaceWrapper) AuthorizeOAuth(c *gin.Context) {
+// SetAreaRunner operation middleware
+func (siw *ServerInterfaceWrapper) SetAreaRunner(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "provider" -------------
-	var provider OAuthProvider
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1328,20 +2389,20 @@ func (siw *ServerInterfaceWrapper) AuthorizeOAuth(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.AuthorizeOAuth(c, provider)
+	siw.Handler.SetAreaRunner(c, areaId)
 }
 
-// ExchangeOAuth operation middleware
-func (siw *ServerInterfaceWrapper) ExchangeOAuth(c *gin.Context) {
+// UpdateAreaStatus operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAreaStatus(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "provider" -------------
-	var provider OAuthProvider
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1354,24 +2415,31 @@ func (siw *ServerInterfaceWrapper) ExchangeOAuth(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ExchangeOAuth(c, provider)
+	siw.Handler.UpdateAreaStatus(c, areaId)
 }
 
-// ListServiceProviders operation middleware
-func (siw *ServerInterfaceWrapper) ListServiceProviders(c *gin.Context) {
+// UntagArea operation middleware
+func (siw *ServerInterfaceWrapper) UntagArea(c *gin.Context) {
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		middleware(c)
-		if c.IsAborted() {
-			return
-		}
+	var err error
+
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	siw.Handler.ListServiceProviders(c)
-}
+	// ------------- Path parameter "tagId" -------------
+	var tagId TagId
 
-// ListServiceSubscriptions operation middleware
-func (siw *ServerInterfaceWrapper) ListServiceSubscriptions(c *gin.Context) {
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", c.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagId: %w", err), http.StatusBadRequest)
+		return
+	}
 
 	c.Set(SessionAuthScopes, []string{})
 
@@ -1382,20 +2450,29 @@ func (siw *ServerInterfaceWrapper) ListServiceSubscriptions(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.ListServiceSubscriptions(c)
+	siw.Handler.UntagArea(c, areaId, tagId)
 }
 
-// SubscribeService operation middleware
-func (siw *ServerInterfaceWrapper) SubscribeService(c *gin.Context) {
+// TagArea operation middleware
+func (siw *ServerInterfaceWrapper) TagArea(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "provider" -------------
-	var provider OAuthProvider
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "tagId" -------------
+	var tagId TagId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", c.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1408,20 +2485,20 @@ func (siw *ServerInterfaceWrapper) SubscribeService(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SubscribeService(c, provider)
+	siw.Handler.TagArea(c, areaId, tagId)
 }
 
-// SubscribeServiceExchange operation middleware
-func (siw *ServerInterfaceWrapper) SubscribeServiceExchange(c *gin.Context) {
+// GetAreaWebhook operation middleware
+func (siw *ServerInterfaceWrapper) GetAreaWebhook(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "provider" -------------
-	var provider OAuthProvider
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1434,20 +2511,20 @@ func (siw *ServerInterfaceWrapper) SubscribeServiceExchange(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.SubscribeServiceExchange(c, provider)
+	siw.Handler.GetAreaWebhook(c, areaId)
 }
 
-// UnsubscribeService operation middleware
-func (siw *ServerInterfaceWrapper) UnsubscribeService(c *gin.Context) {
+// MoveAreaWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) MoveAreaWorkspace(c *gin.Context) {
 
 	var err error
 
-	// ------------- Path parameter "provider" -------------
-	var provider OAuthProvider
+	// ------------- Path parameter "areaId" -------------
+	var areaId openapi_types.UUID
 
-	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	err = runtime.BindStyledParameterWithOptions("simple", "areaId", c.Param("areaId"), &areaId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter areaId: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -1460,11 +2537,13 @@ func (siw *ServerInterfaceWrapper) UnsubscribeService(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.UnsubscribeService(c, provider)
+	siw.Handler.MoveAreaWorkspace(c, areaId)
 }
 
-// RegisterUser operation middleware
-func (siw *ServerInterfaceWrapper) RegisterUser(c *gin.Context) {
+// ChangeEmail operation middleware
+func (siw *ServerInterfaceWrapper) ChangeEmail(c *gin.Context) {
+
+	c.Set(SessionAuthScopes, []string{})
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -1473,1404 +2552,4918 @@ func (siw *ServerInterfaceWrapper) RegisterUser(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.RegisterUser(c)
-}
-
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
+	siw.Handler.ChangeEmail(c)
 }
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
-}
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(c *gin.Context) {
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
 		}
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
-	}
-
-	router.GET(options.BaseURL+"/about.json", wrapper.GetAbout)
-	router.PATCH(options.BaseURL+"/v1/admin/users/:userId/email", wrapper.AdminUpdateUserEmail)
-	router.PATCH(options.BaseURL+"/v1/admin/users/:userId/password", wrapper.AdminResetUserPassword)
-	router.PATCH(options.BaseURL+"/v1/admin/users/:userId/status", wrapper.AdminUpdateUserStatus)
-	router.GET(options.BaseURL+"/v1/areas", wrapper.ListAreas)
-	router.POST(options.BaseURL+"/v1/areas", wrapper.CreateArea)
-	router.DELETE(options.BaseURL+"/v1/areas/:areaId", wrapper.DeleteArea)
-	router.GET(options.BaseURL+"/v1/areas/:areaId", wrapper.GetArea)
-	router.PATCH(options.BaseURL+"/v1/areas/:areaId", wrapper.UpdateArea)
-	router.POST(options.BaseURL+"/v1/areas/:areaId/duplicate", wrapper.DuplicateArea)
-	router.POST(options.BaseURL+"/v1/areas/:areaId/execute", wrapper.ExecuteArea)
-	router.GET(options.BaseURL+"/v1/areas/:areaId/history", wrapper.ListAreaHistory)
-	router.PATCH(options.BaseURL+"/v1/areas/:areaId/status", wrapper.UpdateAreaStatus)
-	router.PATCH(options.BaseURL+"/v1/auth/email", wrapper.ChangeEmail)
-	router.POST(options.BaseURL+"/v1/auth/login", wrapper.Login)
-	router.POST(options.BaseURL+"/v1/auth/logout", wrapper.Logout)
-	router.GET(options.BaseURL+"/v1/auth/me", wrapper.GetCurrentUser)
-	router.PATCH(options.BaseURL+"/v1/auth/password", wrapper.ChangePassword)
-	router.POST(options.BaseURL+"/v1/auth/verify", wrapper.VerifyEmail)
-	router.GET(options.BaseURL+"/v1/components", wrapper.ListComponents)
-	router.GET(options.BaseURL+"/v1/components/available", wrapper.ListAvailableComponents)
-	router.GET(options.BaseURL+"/v1/identities", wrapper.ListIdentities)
-	router.POST(options.BaseURL+"/v1/oauth/:provider/authorize", wrapper.AuthorizeOAuth)
-	router.POST(options.BaseURL+"/v1/oauth/:provider/exchange", wrapper.ExchangeOAuth)
-	router.GET(options.BaseURL+"/v1/services", wrapper.ListServiceProviders)
-	router.GET(options.BaseURL+"/v1/services/subscriptions", wrapper.ListServiceSubscriptions)
-	router.POST(options.BaseURL+"/v1/services/:provider/subscribe", wrapper.SubscribeService)
-	router.POST(options.BaseURL+"/v1/services/:provider/subscribe/exchange", wrapper.SubscribeServiceExchange)
-	router.DELETE(options.BaseURL+"/v1/services/:provider/subscription", wrapper.UnsubscribeService)
-	router.POST(options.BaseURL+"/v1/users", wrapper.RegisterUser)
-}
-
-type GetAboutRequestObject struct {
+	siw.Handler.Login(c)
 }
 
-type GetAboutResponseObject interface {
-	VisitGetAboutResponse(w http.ResponseWriter) error
-}
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(c *gin.Context) {
 
-type GetAbout200JSONResponse AboutResponse
+	c.Set(SessionAuthScopes, []string{})
 
-func (response GetAbout200JSONResponse) VisitGetAboutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.Logout(c)
 }
 
-type GetAbout404Response struct {
-}
+// GetCurrentUser operation middleware
+func (siw *ServerInterfaceWrapper) GetCurrentUser(c *gin.Context) {
 
-func (response GetAbout404Response) VisitGetAboutResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type AdminUpdateUserEmailRequestObject struct {
-	UserId UserId `json:"userId"`
-	Body   *AdminUpdateUserEmailJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type AdminUpdateUserEmailResponseObject interface {
-	VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error
+	siw.Handler.GetCurrentUser(c)
 }
 
-type AdminUpdateUserEmail200JSONResponse EmailChangeResponse
+// ChangePassword operation middleware
+func (siw *ServerInterfaceWrapper) ChangePassword(c *gin.Context) {
 
-func (response AdminUpdateUserEmail200JSONResponse) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	c.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type AdminUpdateUserEmail400Response struct {
+	siw.Handler.ChangePassword(c)
 }
 
-func (response AdminUpdateUserEmail400Response) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+// VerifyEmail operation middleware
+func (siw *ServerInterfaceWrapper) VerifyEmail(c *gin.Context) {
 
-type AdminUpdateUserEmail401Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response AdminUpdateUserEmail401Response) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	siw.Handler.VerifyEmail(c)
 }
 
-type AdminUpdateUserEmail403Response struct {
-}
+// ListComponents operation middleware
+func (siw *ServerInterfaceWrapper) ListComponents(c *gin.Context) {
 
-func (response AdminUpdateUserEmail403Response) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	var err error
 
-type AdminUpdateUserEmail404Response struct {
-}
+	c.Set(SessionAuthScopes, []string{})
 
-func (response AdminUpdateUserEmail404Response) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListComponentsParams
 
-type AdminUpdateUserEmail409Response struct {
-}
+	// ------------- Optional query parameter "kind" -------------
 
-func (response AdminUpdateUserEmail409Response) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "kind", c.Request.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kind: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AdminUpdateUserEmail500Response struct {
-}
+	// ------------- Optional query parameter "provider" -------------
 
-func (response AdminUpdateUserEmail500Response) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "provider", c.Request.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AdminResetUserPasswordRequestObject struct {
-	UserId UserId `json:"userId"`
-	Body   *AdminResetUserPasswordJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type AdminResetUserPasswordResponseObject interface {
-	VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error
+	siw.Handler.ListComponents(c, params)
 }
 
-type AdminResetUserPassword200JSONResponse UserResponse
-
-func (response AdminResetUserPassword200JSONResponse) VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListAvailableComponents operation middleware
+func (siw *ServerInterfaceWrapper) ListAvailableComponents(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	var err error
 
-type AdminResetUserPassword400Response struct {
-}
+	c.Set(SessionAuthScopes, []string{})
 
-func (response AdminResetUserPassword400Response) VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListAvailableComponentsParams
 
-type AdminResetUserPassword401Response struct {
-}
+	// ------------- Optional query parameter "kind" -------------
 
-func (response AdminResetUserPassword401Response) VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "kind", c.Request.URL.Query(), &params.Kind)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter kind: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AdminResetUserPassword403Response struct {
-}
+	// ------------- Optional query parameter "provider" -------------
 
-func (response AdminResetUserPassword403Response) VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	err = runtime.BindQueryParameter("form", true, false, "provider", c.Request.URL.Query(), &params.Provider)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AdminResetUserPassword404Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response AdminResetUserPassword404Response) VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	siw.Handler.ListAvailableComponents(c, params)
 }
 
-type AdminResetUserPassword500Response struct {
-}
+// PreviewComponent operation middleware
+func (siw *ServerInterfaceWrapper) PreviewComponent(c *gin.Context) {
 
-func (response AdminResetUserPassword500Response) VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	var err error
 
-type AdminUpdateUserStatusRequestObject struct {
-	UserId UserId `json:"userId"`
-	Body   *AdminUpdateUserStatusJSONRequestBody
-}
+	// ------------- Path parameter "componentId" -------------
+	var componentId openapi_types.UUID
 
-type AdminUpdateUserStatusResponseObject interface {
-	VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "componentId", c.Param("componentId"), &componentId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter componentId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type AdminUpdateUserStatus200JSONResponse UserResponse
+	c.Set(SessionAuthScopes, []string{})
 
-func (response AdminUpdateUserStatus200JSONResponse) VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PreviewComponent(c, componentId)
 }
 
-type AdminUpdateUserStatus400Response struct {
-}
+// ListFolders operation middleware
+func (siw *ServerInterfaceWrapper) ListFolders(c *gin.Context) {
 
-func (response AdminUpdateUserStatus400Response) VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type AdminUpdateUserStatus401Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response AdminUpdateUserStatus401Response) VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	siw.Handler.ListFolders(c)
 }
 
-type AdminUpdateUserStatus403Response struct {
-}
+// CreateFolder operation middleware
+func (siw *ServerInterfaceWrapper) CreateFolder(c *gin.Context) {
 
-func (response AdminUpdateUserStatus403Response) VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type AdminUpdateUserStatus404Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response AdminUpdateUserStatus404Response) VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	siw.Handler.CreateFolder(c)
 }
 
-type AdminUpdateUserStatus500Response struct {
-}
+// DeleteFolder operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFolder(c *gin.Context) {
 
-func (response AdminUpdateUserStatus500Response) VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	var err error
 
-type ListAreasRequestObject struct {
-}
+	// ------------- Path parameter "folderId" -------------
+	var folderId FolderId
 
-type ListAreasResponseObject interface {
-	VisitListAreasResponse(w http.ResponseWriter) error
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "folderId", c.Param("folderId"), &folderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter folderId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ListAreas200JSONResponse ListAreasResponse
+	c.Set(SessionAuthScopes, []string{})
 
-func (response ListAreas200JSONResponse) VisitListAreasResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.DeleteFolder(c, folderId)
 }
 
-type CreateAreaRequestObject struct {
-	Body *CreateAreaJSONRequestBody
-}
+// UpdateFolder operation middleware
+func (siw *ServerInterfaceWrapper) UpdateFolder(c *gin.Context) {
 
-type CreateAreaResponseObject interface {
-	VisitCreateAreaResponse(w http.ResponseWriter) error
-}
+	var err error
 
-type CreateArea201JSONResponse Area
+	// ------------- Path parameter "folderId" -------------
+	var folderId FolderId
 
-func (response CreateArea201JSONResponse) VisitCreateAreaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	err = runtime.BindStyledParameterWithOptions("simple", "folderId", c.Param("folderId"), &folderId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter folderId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type CreateArea400Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response CreateArea400Response) VisitCreateAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+	siw.Handler.UpdateFolder(c, folderId)
 }
 
-type CreateArea401Response struct {
-}
+// ListIdentities operation middleware
+func (siw *ServerInterfaceWrapper) ListIdentities(c *gin.Context) {
 
-func (response CreateArea401Response) VisitCreateAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type DeleteAreaRequestObject struct {
-	AreaId openapi_types.UUID `json:"areaId"`
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type DeleteAreaResponseObject interface {
-	VisitDeleteAreaResponse(w http.ResponseWriter) error
+	siw.Handler.ListIdentities(c)
 }
 
-type DeleteArea204Response struct {
-}
+// AuthorizeOAuth operation middleware
+func (siw *ServerInterfaceWrapper) AuthorizeOAuth(c *gin.Context) {
 
-func (response DeleteArea204Response) VisitDeleteAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+	var err error
 
-type DeleteArea401Response struct {
-}
+	// ------------- Path parameter "provider" -------------
+	var provider OAuthProvider
 
-func (response DeleteArea401Response) VisitDeleteAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type DeleteArea403Response struct {
-}
+	c.Set(SessionAuthScopes, []string{})
 
-func (response DeleteArea403Response) VisitDeleteAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type DeleteArea404Response struct {
+	siw.Handler.AuthorizeOAuth(c, provider)
 }
 
-func (response DeleteArea404Response) VisitDeleteAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+// ExchangeOAuth operation middleware
+func (siw *ServerInterfaceWrapper) ExchangeOAuth(c *gin.Context) {
 
-type GetAreaRequestObject struct {
-	AreaId openapi_types.UUID `json:"areaId"`
-}
+	var err error
 
-type GetAreaResponseObject interface {
-	VisitGetAreaResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "provider" -------------
+	var provider OAuthProvider
 
-type GetArea200JSONResponse Area
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response GetArea200JSONResponse) VisitGetAreaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	c.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type GetArea401Response struct {
+	siw.Handler.ExchangeOAuth(c, provider)
 }
 
-func (response GetArea401Response) VisitGetAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// ListServiceProviders operation middleware
+func (siw *ServerInterfaceWrapper) ListServiceProviders(c *gin.Context) {
 
-type GetArea403Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response GetArea403Response) VisitGetAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
+	siw.Handler.ListServiceProviders(c)
 }
 
-type GetArea404Response struct {
-}
+// ListServiceSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) ListServiceSubscriptions(c *gin.Context) {
 
-func (response GetArea404Response) VisitGetAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type UpdateAreaRequestObject struct {
-	AreaId openapi_types.UUID `json:"areaId"`
-	Body   *UpdateAreaJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type UpdateAreaResponseObject interface {
-	VisitUpdateAreaResponse(w http.ResponseWriter) error
+	siw.Handler.ListServiceSubscriptions(c)
 }
 
-type UpdateArea200JSONResponse Area
+// SubscribeService operation middleware
+func (siw *ServerInterfaceWrapper) SubscribeService(c *gin.Context) {
 
-func (response UpdateArea200JSONResponse) VisitUpdateAreaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "provider" -------------
+	var provider OAuthProvider
 
-type UpdateArea400Response struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response UpdateArea400Response) VisitUpdateAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type UpdateArea401Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response UpdateArea401Response) VisitUpdateAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	siw.Handler.SubscribeService(c, provider)
 }
 
-type UpdateArea403Response struct {
-}
+// SubscribeServiceExchange operation middleware
+func (siw *ServerInterfaceWrapper) SubscribeServiceExchange(c *gin.Context) {
 
-func (response UpdateArea403Response) VisitUpdateAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	var err error
 
-type UpdateArea404Response struct {
-}
+	// ------------- Path parameter "provider" -------------
+	var provider OAuthProvider
 
-func (response UpdateArea404Response) VisitUpdateAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type DuplicateAreaRequestObject struct {
-	AreaId openapi_types.UUID `json:"areaId"`
-	Body   *DuplicateAreaJSONRequestBody
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type DuplicateAreaResponseObject interface {
-	VisitDuplicateAreaResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.SubscribeServiceExchange(c, provider)
 }
 
-type DuplicateArea201JSONResponse Area
+// UnsubscribeService operation middleware
+func (siw *ServerInterfaceWrapper) UnsubscribeService(c *gin.Context) {
 
-func (response DuplicateArea201JSONResponse) VisitDuplicateAreaResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	var err error
 
-	return json.NewEncoder(w).Encode(response)
-}
+	// ------------- Path parameter "provider" -------------
+	var provider OAuthProvider
 
-type DuplicateArea400Response struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "provider", c.Param("provider"), &provider, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter provider: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response DuplicateArea400Response) VisitDuplicateAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type DuplicateArea401Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response DuplicateArea401Response) VisitDuplicateAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+	siw.Handler.UnsubscribeService(c, provider)
 }
 
-type DuplicateArea403Response struct {
-}
+// ListTags operation middleware
+func (siw *ServerInterfaceWrapper) ListTags(c *gin.Context) {
 
-func (response DuplicateArea403Response) VisitDuplicateAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type DuplicateArea404Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response DuplicateArea404Response) VisitDuplicateAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
+	siw.Handler.ListTags(c)
 }
 
-type ExecuteAreaRequestObject struct {
-	AreaId openapi_types.UUID `json:"areaId"`
-}
+// CreateTag operation middleware
+func (siw *ServerInterfaceWrapper) CreateTag(c *gin.Context) {
 
-type ExecuteAreaResponseObject interface {
-	VisitExecuteAreaResponse(w http.ResponseWriter) error
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type ExecuteArea202Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response ExecuteArea202Response) VisitExecuteAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(202)
-	return nil
+	siw.Handler.CreateTag(c)
 }
 
-type ExecuteArea401Response struct {
-}
+// DeleteTag operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTag(c *gin.Context) {
 
-func (response ExecuteArea401Response) VisitExecuteAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type ExecuteArea403Response struct {
-}
+	// ------------- Path parameter "tagId" -------------
+	var tagId TagId
 
-func (response ExecuteArea403Response) VisitExecuteAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", c.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ExecuteArea404Response struct {
-}
+	c.Set(SessionAuthScopes, []string{})
 
-func (response ExecuteArea404Response) VisitExecuteAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type ExecuteArea500Response struct {
+	siw.Handler.DeleteTag(c, tagId)
 }
 
-func (response ExecuteArea500Response) VisitExecuteAreaResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+// UpdateTag operation middleware
+func (siw *ServerInterfaceWrapper) UpdateTag(c *gin.Context) {
 
-type ListAreaHistoryRequestObject struct {
-	AreaId openapi_types.UUID `json:"areaId"`
-	Params ListAreaHistoryParams
-}
+	var err error
 
-type ListAreaHistoryResponseObject interface {
-	VisitListAreaHistoryResponse(w http.ResponseWriter) error
-}
+	// ------------- Path parameter "tagId" -------------
+	var tagId TagId
 
-type ListAreaHistory200JSONResponse AreaHistoryResponse
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", c.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListAreaHistory200JSONResponse) VisitListAreaHistoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	c.Set(SessionAuthScopes, []string{})
 
-	return json.NewEncoder(w).Encode(response)
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type ListAreaHistory401Response struct {
+	siw.Handler.UpdateTag(c, tagId)
 }
 
-func (response ListAreaHistory401Response) VisitListAreaHistoryResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// BulkTagAction operation middleware
+func (siw *ServerInterfaceWrapper) BulkTagAction(c *gin.Context) {
 
-type ListAreaHistory403Response struct {
-}
+	var err error
 
-func (response ListAreaHistory403Response) VisitListAreaHistoryResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	// ------------- Path parameter "tagId" -------------
+	var tagId TagId
 
-type ListAreaHistory404Response struct {
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "tagId", c.Param("tagId"), &tagId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter tagId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-func (response ListAreaHistory404Response) VisitListAreaHistoryResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type UpdateAreaStatusRequestObject struct {
-	AreaId openapi_types.UUID `json:"areaId"`
-	Body   *UpdateAreaStatusJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type UpdateAreaStatusResponseObject interface {
-	VisitUpdateAreaStatusResponse(w http.ResponseWriter) error
+	siw.Handler.BulkTagAction(c, tagId)
 }
 
-type UpdateAreaStatus200JSONResponse Area
-
-func (response UpdateAreaStatus200JSONResponse) VisitUpdateAreaStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// ListAreaTemplates operation middleware
+func (siw *ServerInterfaceWrapper) ListAreaTemplates(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type UpdateAreaStatus400Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response UpdateAreaStatus400Response) VisitUpdateAreaStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
+	siw.Handler.ListAreaTemplates(c)
 }
 
-type UpdateAreaStatus401Response struct {
-}
+// GetAreaTemplate operation middleware
+func (siw *ServerInterfaceWrapper) GetAreaTemplate(c *gin.Context) {
 
-func (response UpdateAreaStatus401Response) VisitUpdateAreaStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+	var err error
 
-type UpdateAreaStatus403Response struct {
-}
+	// ------------- Path parameter "templateId" -------------
+	var templateId TemplateId
 
-func (response UpdateAreaStatus403Response) VisitUpdateAreaStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", c.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type UpdateAreaStatus404Response struct {
-}
+	c.Set(SessionAuthScopes, []string{})
 
-func (response UpdateAreaStatus404Response) VisitUpdateAreaStatusResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type ChangeEmailRequestObject struct {
-	Body *ChangeEmailJSONRequestBody
+	siw.Handler.GetAreaTemplate(c, templateId)
 }
 
-type ChangeEmailResponseObject interface {
-	VisitChangeEmailResponse(w http.ResponseWriter) error
-}
+// InstantiateAreaTemplate operation middleware
+func (siw *ServerInterfaceWrapper) InstantiateAreaTemplate(c *gin.Context) {
 
-type ChangeEmail202JSONResponse EmailChangeResponse
+	var err error
 
-func (response ChangeEmail202JSONResponse) VisitChangeEmailResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	// ------------- Path parameter "templateId" -------------
+	var templateId TemplateId
 
-	return json.NewEncoder(w).Encode(response)
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "templateId", c.Param("templateId"), &templateId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter templateId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type ChangeEmail400Response struct {
-}
+	c.Set(SessionAuthScopes, []string{})
 
-func (response ChangeEmail400Response) VisitChangeEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type ChangeEmail401Response struct {
+	siw.Handler.InstantiateAreaTemplate(c, templateId)
 }
 
-func (response ChangeEmail401Response) VisitChangeEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
-}
+// RegisterUser operation middleware
+func (siw *ServerInterfaceWrapper) RegisterUser(c *gin.Context) {
 
-type ChangeEmail409Response struct {
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-func (response ChangeEmail409Response) VisitChangeEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
-	return nil
+	siw.Handler.RegisterUser(c)
 }
 
-type ChangeEmail500Response struct {
-}
+// ListWorkspaces operation middleware
+func (siw *ServerInterfaceWrapper) ListWorkspaces(c *gin.Context) {
 
-func (response ChangeEmail500Response) VisitChangeEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
-	return nil
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type LoginRequestObject struct {
-	Body *LoginJSONRequestBody
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type LoginResponseObject interface {
-	VisitLoginResponse(w http.ResponseWriter) error
+	siw.Handler.ListWorkspaces(c)
 }
 
-type Login200ResponseHeaders struct {
-	SetCookie string
-}
+// CreateWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) CreateWorkspace(c *gin.Context) {
 
-type Login200JSONResponse struct {
-	Body    AuthSessionResponse
-	Headers Login200ResponseHeaders
-}
+	c.Set(SessionAuthScopes, []string{})
 
-func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response.Body)
+	siw.Handler.CreateWorkspace(c)
 }
 
-type Login400Response struct {
-}
+// DeleteWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) DeleteWorkspace(c *gin.Context) {
 
-func (response Login400Response) VisitLoginResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
-	return nil
-}
+	var err error
 
-type Login403Response struct {
-}
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId WorkspaceId
 
-func (response Login403Response) VisitLoginResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", c.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter workspaceId: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type LogoutRequestObject struct {
-}
+	c.Set(SessionAuthScopes, []string{})
 
-type LogoutResponseObject interface {
-	VisitLogoutResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.DeleteWorkspace(c, workspaceId)
 }
 
-type Logout204Response struct {
+// GetWorkspace operation middleware
+func (siw *ServerInterfaceWrapper) GetWorkspace(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId WorkspaceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", c.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter workspaceId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(SessionAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetWorkspace(c, workspaceId)
 }
 
-func (response Logout204Response) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+// AddWorkspaceMember operation middleware
+func (siw *ServerInterfaceWrapper) AddWorkspaceMember(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId WorkspaceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", c.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter workspaceId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(SessionAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.AddWorkspaceMember(c, workspaceId)
 }
 
-type Logout401Response struct {
+// RemoveWorkspaceMember operation middleware
+func (siw *ServerInterfaceWrapper) RemoveWorkspaceMember(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId WorkspaceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", c.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter workspaceId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(SessionAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.RemoveWorkspaceMember(c, workspaceId, userId)
 }
 
-func (response Logout401Response) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
-	return nil
+// UpdateWorkspaceMember operation middleware
+func (siw *ServerInterfaceWrapper) UpdateWorkspaceMember(c *gin.Context) {
+
+	var err error
+
+	// ------------- Path parameter "workspaceId" -------------
+	var workspaceId WorkspaceId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "workspaceId", c.Param("workspaceId"), &workspaceId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter workspaceId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Path parameter "userId" -------------
+	var userId UserId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "userId", c.Param("userId"), &userId, runtime.BindStyledParameterOptions{Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter userId: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	c.Set(SessionAuthScopes, []string{})
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.UpdateWorkspaceMember(c, workspaceId, userId)
 }
 
-type GetCurrentUserRequestObject struct {
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
 }
 
-type GetCurrentUserResponseObject interface {
-	VisitGetCurrentUserResponse(w http.ResponseWriter) error
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
 }
 
-type GetCurrentUser200JSONResponse UserResponse
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
 
-func (response GetCurrentUser200JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.GET(options.BaseURL+"/about.json", wrapper.GetAbout)
+	router.POST(options.BaseURL+"/v1/admin/templates", wrapper.CreateAreaTemplate)
+	router.DELETE(options.BaseURL+"/v1/admin/templates/:templateId", wrapper.DeleteAreaTemplate)
+	router.PATCH(options.BaseURL+"/v1/admin/users/:userId/email", wrapper.AdminUpdateUserEmail)
+	router.PATCH(options.BaseURL+"/v1/admin/users/:userId/password", wrapper.AdminResetUserPassword)
+	router.PATCH(options.BaseURL+"/v1/admin/users/:userId/status", wrapper.AdminUpdateUserStatus)
+	router.GET(options.BaseURL+"/v1/areas", wrapper.ListAreas)
+	router.POST(options.BaseURL+"/v1/areas", wrapper.CreateArea)
+	router.POST(options.BaseURL+"/v1/areas/import", wrapper.ImportArea)
+	router.DELETE(options.BaseURL+"/v1/areas/:areaId", wrapper.DeleteArea)
+	router.GET(options.BaseURL+"/v1/areas/:areaId", wrapper.GetArea)
+	router.PATCH(options.BaseURL+"/v1/areas/:areaId", wrapper.UpdateArea)
+	router.PUT(options.BaseURL+"/v1/areas/:areaId/activity-schedule", wrapper.SetAreaActivitySchedule)
+	router.POST(options.BaseURL+"/v1/areas/:areaId/dry-run", wrapper.DryRunArea)
+	router.POST(options.BaseURL+"/v1/areas/:areaId/duplicate", wrapper.DuplicateArea)
+	router.POST(options.BaseURL+"/v1/areas/:areaId/execute", wrapper.ExecuteArea)
+	router.GET(options.BaseURL+"/v1/areas/:areaId/export", wrapper.ExportArea)
+	router.PUT(options.BaseURL+"/v1/areas/:areaId/folder", wrapper.MoveAreaFolder)
+	router.PUT(options.BaseURL+"/v1/areas/:areaId/health-policy", wrapper.SetAreaHealthPolicy)
+	router.GET(options.BaseURL+"/v1/areas/:areaId/history", wrapper.ListAreaHistory)
+	router.GET(options.BaseURL+"/v1/areas/:areaId/revisions", wrapper.ListAreaRevisions)
+	router.GET(options.BaseURL+"/v1/areas/:areaId/revisions/diff", wrapper.DiffAreaRevisions)
+	router.POST(options.BaseURL+"/v1/areas/:areaId/revisions/:revision/rollback", wrapper.RollbackArea)
+	router.PUT(options.BaseURL+"/v1/areas/:areaId/runner", wrapper.SetAreaRunner)
+	router.PATCH(options.BaseURL+"/v1/areas/:areaId/status", wrapper.UpdateAreaStatus)
+	router.DELETE(options.BaseURL+"/v1/areas/:areaId/tags/:tagId", wrapper.UntagArea)
+	router.PUT(options.BaseURL+"/v1/areas/:areaId/tags/:tagId", wrapper.TagArea)
+	router.GET(options.BaseURL+"/v1/areas/:areaId/webhook", wrapper.GetAreaWebhook)
+	router.PUT(options.BaseURL+"/v1/areas/:areaId/workspace", wrapper.MoveAreaWorkspace)
+	router.PATCH(options.BaseURL+"/v1/auth/email", wrapper.ChangeEmail)
+	router.POST(options.BaseURL+"/v1/auth/login", wrapper.Login)
+	router.POST(options.BaseURL+"/v1/auth/logout", wrapper.Logout)
+	router.GET(options.BaseURL+"/v1/auth/me", wrapper.GetCurrentUser)
+	router.PATCH(options.BaseURL+"/v1/auth/password", wrapper.ChangePassword)
+	router.POST(options.BaseURL+"/v1/auth/verify", wrapper.VerifyEmail)
+	router.GET(options.BaseURL+"/v1/components", wrapper.ListComponents)
+	router.GET(options.BaseURL+"/v1/components/available", wrapper.ListAvailableComponents)
+	router.POST(options.BaseURL+"/v1/components/:componentId/preview", wrapper.PreviewComponent)
+	router.GET(options.BaseURL+"/v1/folders", wrapper.ListFolders)
+	router.POST(options.BaseURL+"/v1/folders", wrapper.CreateFolder)
+	router.DELETE(options.BaseURL+"/v1/folders/:folderId", wrapper.DeleteFolder)
+	router.PATCH(options.BaseURL+"/v1/folders/:folderId", wrapper.UpdateFolder)
+	router.GET(options.BaseURL+"/v1/identities", wrapper.ListIdentities)
+	router.POST(options.BaseURL+"/v1/oauth/:provider/authorize", wrapper.AuthorizeOAuth)
+	router.POST(options.BaseURL+"/v1/oauth/:provider/exchange", wrapper.ExchangeOAuth)
+	router.GET(options.BaseURL+"/v1/services", wrapper.ListServiceProviders)
+	router.GET(options.BaseURL+"/v1/services/subscriptions", wrapper.ListServiceSubscriptions)
+	router.POST(options.BaseURL+"/v1/services/:provider/subscribe", wrapper.SubscribeService)
+	router.POST(options.BaseURL+"/v1/services/:provider/subscribe/exchange", wrapper.SubscribeServiceExchange)
+	router.DELETE(options.BaseURL+"/v1/services/:provider/subscription", wrapper.UnsubscribeService)
+	router.GET(options.BaseURL+"/v1/tags", wrapper.ListTags)
+	router.POST(options.BaseURL+"/v1/tags", wrapper.CreateTag)
+	router.DELETE(options.BaseURL+"/v1/tags/:tagId", wrapper.DeleteTag)
+	router.PATCH(options.BaseURL+"/v1/tags/:tagId", wrapper.UpdateTag)
+	router.POST(options.BaseURL+"/v1/tags/:tagId/bulk", wrapper.BulkTagAction)
+	router.GET(options.BaseURL+"/v1/templates", wrapper.ListAreaTemplates)
+	router.GET(options.BaseURL+"/v1/templates/:templateId", wrapper.GetAreaTemplate)
+	router.POST(options.BaseURL+"/v1/templates/:templateId/instantiate", wrapper.InstantiateAreaTemplate)
+	router.POST(options.BaseURL+"/v1/users", wrapper.RegisterUser)
+	router.GET(options.BaseURL+"/v1/workspaces", wrapper.ListWorkspaces)
+	router.POST(options.BaseURL+"/v1/workspaces", wrapper.CreateWorkspace)
+	router.DELETE(options.BaseURL+"/v1/workspaces/:workspaceId", wrapper.DeleteWorkspace)
+	router.GET(options.BaseURL+"/v1/workspaces/:workspaceId", wrapper.GetWorkspace)
+	router.POST(options.BaseURL+"/v1/workspaces/:workspaceId/members", wrapper.AddWorkspaceMember)
+	router.DELETE(options.BaseURL+"/v1/workspaces/:workspaceId/members/:userId", wrapper.RemoveWorkspaceMember)
+	router.PATCH(options.BaseURL+"/v1/workspaces/:workspaceId/members/:userId", wrapper.UpdateWorkspaceMember)
+}
+
+type GetAboutRequestObject struct {
+}
+
+type GetAboutResponseObject interface {
+	VisitGetAboutResponse(w http.ResponseWriter) error
+}
+
+type GetAbout200JSONResponse AboutResponse
+
+func (response GetAbout200JSONResponse) VisitGetAboutResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetCurrentUser401Response struct {
+type GetAbout404Response struct {
 }
 
-func (response GetCurrentUser401Response) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
+func (response GetAbout404Response) VisitGetAboutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
 	return nil
 }
 
-type ChangePasswordRequestObject struct {
-	Body *ChangePasswordJSONRequestBody
+type CreateAreaTemplateRequestObject struct {
+	Body *CreateAreaTemplateJSONRequestBody
 }
 
-type ChangePasswordResponseObject interface {
-	VisitChangePasswordResponse(w http.ResponseWriter) error
+type CreateAreaTemplateResponseObject interface {
+	VisitCreateAreaTemplateResponse(w http.ResponseWriter) error
 }
 
-type ChangePassword204Response struct {
-}
+type CreateAreaTemplate201JSONResponse AreaTemplate
 
-func (response ChangePassword204Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
+func (response CreateAreaTemplate201JSONResponse) VisitCreateAreaTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ChangePassword400Response struct {
+type CreateAreaTemplate400Response struct {
 }
 
-func (response ChangePassword400Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+func (response CreateAreaTemplate400Response) VisitCreateAreaTemplateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ChangePassword401Response struct {
+type CreateAreaTemplate401Response struct {
 }
 
-func (response ChangePassword401Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+func (response CreateAreaTemplate401Response) VisitCreateAreaTemplateResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type ChangePassword500Response struct {
+type CreateAreaTemplate403Response struct {
 }
 
-func (response ChangePassword500Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
+func (response CreateAreaTemplate403Response) VisitCreateAreaTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
 	return nil
 }
 
-type VerifyEmailRequestObject struct {
-	Body *VerifyEmailJSONRequestBody
+type CreateAreaTemplate409Response struct {
 }
 
-type VerifyEmailResponseObject interface {
-	VisitVerifyEmailResponse(w http.ResponseWriter) error
+func (response CreateAreaTemplate409Response) VisitCreateAreaTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
 }
 
-type VerifyEmail200ResponseHeaders struct {
-	SetCookie string
+type DeleteAreaTemplateRequestObject struct {
+	TemplateId TemplateId `json:"templateId"`
 }
 
-type VerifyEmail200JSONResponse struct {
-	Body    AuthSessionResponse
-	Headers VerifyEmail200ResponseHeaders
+type DeleteAreaTemplateResponseObject interface {
+	VisitDeleteAreaTemplateResponse(w http.ResponseWriter) error
 }
 
-func (response VerifyEmail200JSONResponse) VisitVerifyEmailResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
-	w.WriteHeader(200)
+type DeleteAreaTemplate204Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response.Body)
+func (response DeleteAreaTemplate204Response) VisitDeleteAreaTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
 }
 
-type VerifyEmail400Response struct {
+type DeleteAreaTemplate401Response struct {
 }
 
-func (response VerifyEmail400Response) VisitVerifyEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
+func (response DeleteAreaTemplate401Response) VisitDeleteAreaTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type VerifyEmail410Response struct {
+type DeleteAreaTemplate403Response struct {
 }
 
-func (response VerifyEmail410Response) VisitVerifyEmailResponse(w http.ResponseWriter) error {
-	w.WriteHeader(410)
+func (response DeleteAreaTemplate403Response) VisitDeleteAreaTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
 	return nil
 }
 
-type ListComponentsRequestObject struct {
-	Params ListComponentsParams
+type DeleteAreaTemplate404Response struct {
 }
 
-type ListComponentsResponseObject interface {
-	VisitListComponentsResponse(w http.ResponseWriter) error
+func (response DeleteAreaTemplate404Response) VisitDeleteAreaTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
 }
 
-type ListComponents200JSONResponse ComponentListResponse
+type AdminUpdateUserEmailRequestObject struct {
+	UserId UserId `json:"userId"`
+	Body   *AdminUpdateUserEmailJSONRequestBody
+}
 
-func (response ListComponents200JSONResponse) VisitListComponentsResponse(w http.ResponseWriter) error {
+type AdminUpdateUserEmailResponseObject interface {
+	VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error
+}
+
+type AdminUpdateUserEmail200JSONResponse EmailChangeResponse
+
+func (response AdminUpdateUserEmail200JSONResponse) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListComponents400Response struct {
+type AdminUpdateUserEmail400Response struct {
 }
 
-func (response ListComponents400Response) VisitListComponentsResponse(w http.ResponseWriter) error {
+func (response AdminUpdateUserEmail400Response) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ListComponents500Response struct {
+type AdminUpdateUserEmail401Response struct {
 }
 
-func (response ListComponents500Response) VisitListComponentsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
+func (response AdminUpdateUserEmail401Response) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type ListAvailableComponentsRequestObject struct {
-	Params ListAvailableComponentsParams
-}
-
-type ListAvailableComponentsResponseObject interface {
-	VisitListAvailableComponentsResponse(w http.ResponseWriter) error
+type AdminUpdateUserEmail403Response struct {
 }
 
-type ListAvailableComponents200JSONResponse ComponentListResponse
-
-func (response ListAvailableComponents200JSONResponse) VisitListAvailableComponentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (response AdminUpdateUserEmail403Response) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
 }
 
-type ListAvailableComponents400Response struct {
+type AdminUpdateUserEmail404Response struct {
 }
 
-func (response ListAvailableComponents400Response) VisitListAvailableComponentsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
+func (response AdminUpdateUserEmail404Response) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
 	return nil
 }
 
-type ListAvailableComponents401Response struct {
+type AdminUpdateUserEmail409Response struct {
 }
 
-func (response ListAvailableComponents401Response) VisitListAvailableComponentsResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
+func (response AdminUpdateUserEmail409Response) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
 	return nil
 }
 
-type ListAvailableComponents500Response struct {
+type AdminUpdateUserEmail500Response struct {
 }
 
-func (response ListAvailableComponents500Response) VisitListAvailableComponentsResponse(w http.ResponseWriter) error {
+func (response AdminUpdateUserEmail500Response) VisitAdminUpdateUserEmailResponse(w http.ResponseWriter) error {
 	w.WriteHeader(500)
 	return nil
 }
 
-type ListIdentitiesRequestObject struct {
+type AdminResetUserPasswordRequestObject struct {
+	UserId UserId `json:"userId"`
+	Body   *AdminResetUserPasswordJSONRequestBody
 }
 
-type ListIdentitiesResponseObject interface {
-	VisitListIdentitiesResponse(w http.ResponseWriter) error
+type AdminResetUserPasswordResponseObject interface {
+	VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error
 }
 
-type ListIdentities200JSONResponse IdentityListResponse
+type AdminResetUserPassword200JSONResponse UserResponse
 
-func (response ListIdentities200JSONResponse) VisitListIdentitiesResponse(w http.ResponseWriter) error {
+func (response AdminResetUserPassword200JSONResponse) VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListIdentities401Response struct {
+type AdminResetUserPassword400Response struct {
 }
 
-func (response ListIdentities401Response) VisitListIdentitiesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(401)
+func (response AdminResetUserPassword400Response) VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
 	return nil
 }
 
-type ListIdentities500Response struct {
+type AdminResetUserPassword401Response struct {
 }
 
-func (response ListIdentities500Response) VisitListIdentitiesResponse(w http.ResponseWriter) error {
-	w.WriteHeader(500)
+func (response AdminResetUserPassword401Response) VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type AuthorizeOAuthRequestObject struct {
-	Provider OAuthProvider `json:"provider"`
-	Body     *AuthorizeOAuthJSONRequestBody
-}
-
-type AuthorizeOAuthResponseObject interface {
-	VisitAuthorizeOAuthResponse(w http.ResponseWriter) error
-}
-
-type AuthorizeOAuth200JSONResponse OAuthAuthorizationResponse
-
-func (response AuthorizeOAuth200JSONResponse) VisitAuthorizeOAuthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type AuthorizeOAuth400Response struct {
+type AdminResetUserPassword403Response struct {
 }
 
-func (response AuthorizeOAuth400Response) VisitAuthorizeOAuthResponse(w http.ResponseWriter) error {
-	w.WriteHeader(400)
+func (response AdminResetUserPassword403Response) VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
 	return nil
 }
 
-type AuthorizeOAuth404Response struct {
+type AdminResetUserPassword404Response struct {
 }
 
-func (response AuthorizeOAuth404Response) VisitAuthorizeOAuthResponse(w http.ResponseWriter) error {
+func (response AdminResetUserPassword404Response) VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type AuthorizeOAuth501Response struct {
+type AdminResetUserPassword500Response struct {
 }
 
-func (response AuthorizeOAuth501Response) VisitAuthorizeOAuthResponse(w http.ResponseWriter) error {
-	w.WriteHeader(501)
+func (response AdminResetUserPassword500Response) VisitAdminResetUserPasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
 	return nil
 }
 
-type ExchangeOAuthRequestObject struct {
-	Provider OAuthProvider `json:"provider"`
-	Body     *ExchangeOAuthJSONRequestBody
-}
-
-type ExchangeOAuthResponseObject interface {
-	VisitExchangeOAuthResponse(w http.ResponseWriter) error
+type AdminUpdateUserStatusRequestObject struct {
+	UserId UserId `json:"userId"`
+	Body   *AdminUpdateUserStatusJSONRequestBody
 }
 
-type ExchangeOAuth200ResponseHeaders struct {
-	SetCookie string
+type AdminUpdateUserStatusResponseObject interface {
+	VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error
 }
 
-type ExchangeOAuth200JSONResponse struct {
-	Body    AuthSessionResponse
-	Headers ExchangeOAuth200ResponseHeaders
-}
+type AdminUpdateUserStatus200JSONResponse UserResponse
 
-func (response ExchangeOAuth200JSONResponse) VisitExchangeOAuthResponse(w http.ResponseWriter) error {
+func (response AdminUpdateUserStatus200JSONResponse) VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response.Body)
+	return json.NewEncoder(w).Encode(response)
 }
 
-type ExchangeOAuth400Response struct {
+type AdminUpdateUserStatus400Response struct {
 }
 
-func (response ExchangeOAuth400Response) VisitExchangeOAuthResponse(w http.ResponseWriter) error {
+func (response AdminUpdateUserStatus400Response) VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type ExchangeOAuth404Response struct {
+type AdminUpdateUserStatus401Response struct {
 }
 
-func (response ExchangeOAuth404Response) VisitExchangeOAuthResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
+func (response AdminUpdateUserStatus401Response) VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-type ExchangeOAuth501Response struct {
+type AdminUpdateUserStatus403Response struct {
 }
 
-func (response ExchangeOAuth501Response) VisitExchangeOAuthResponse(w http.ResponseWriter) error {
-	w.WriteHeader(501)
+func (response AdminUpdateUserStatus403Response) VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
 	return nil
 }
 
-type ExchangeOAuth502Response struct {
+type AdminUpdateUserStatus404Response struct {
 }
 
-func (response ExchangeOAuth502Response) VisitExchangeOAuthResponse(w http.ResponseWriter) error {
-	w.WriteHeader(502)
+func (response AdminUpdateUserStatus404Response) VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
 	return nil
 }
 
-type ListServiceProvidersRequestObject struct {
-}
-
-type ListServiceProvidersResponseObject interface {
-	VisitListServiceProvidersResponse(w http.ResponseWriter) error
+type AdminUpdateUserStatus500Response struct {
 }
 
-type ListServiceProviders200JSONResponse ServiceProviderListResponse
-
-func (response ListServiceProviders200JSONResponse) VisitListServiceProvidersResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+func (response AdminUpdateUserStatus500Response) VisitAdminUpdateUserStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
 }
 
-type ListServiceSubscriptionsRequestObject struct {
+type ListAreasRequestObject struct {
+	Params ListAreasParams
 }
 
-type ListServiceSubscriptionsResponseObject interface {
-	VisitListServiceSubscriptionsResponse(w http.ResponseWriter) error
+type ListAreasResponseObject interface {
+	VisitListAreasResponse(w http.ResponseWriter) error
 }
 
-type ListServiceSubscriptions200JSONResponse SubscriptionListResponse
+type ListAreas200JSONResponse ListAreasResponse
 
-func (response ListServiceSubscriptions200JSONResponse) VisitListServiceSubscriptionsResponse(w http.ResponseWriter) error {
+func (response ListAreas200JSONResponse) VisitListAreasResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type ListServiceSubscriptions401Response struct {
+type ListAreas400Response struct {
 }
 
-func (response ListServiceSubscriptions401Response) VisitListServiceSubscriptionsResponse(w http.ResponseWriter) error {
+func (response ListAreas400Response) VisitListAreasResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ListAreas401Response struct {
+}
+
+func (response ListAreas401Response) VisitListAreasResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type SubscribeServiceRequestObject struct {
-	Provider OAuthProvider `json:"provider"`
-	Body     *SubscribeServiceJSONRequestBody
+type CreateAreaRequestObject struct {
+	Body *CreateAreaJSONRequestBody
 }
 
-type SubscribeServiceResponseObject interface {
-	VisitSubscribeServiceResponse(w http.ResponseWriter) error
+type CreateAreaResponseObject interface {
+	VisitCreateAreaResponse(w http.ResponseWriter) error
 }
 
-type SubscribeService200JSONResponse SubscribeServiceResponse
+type CreateArea201JSONResponse Area
 
-func (response SubscribeService200JSONResponse) VisitSubscribeServiceResponse(w http.ResponseWriter) error {
+func (response CreateArea201JSONResponse) VisitCreateAreaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SubscribeService400Response struct {
+type CreateArea400Response struct {
 }
 
-func (response SubscribeService400Response) VisitSubscribeServiceResponse(w http.ResponseWriter) error {
+func (response CreateArea400Response) VisitCreateAreaResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type SubscribeService401Response struct {
+type CreateArea401Response struct {
 }
 
-func (response SubscribeService401Response) VisitSubscribeServiceResponse(w http.ResponseWriter) error {
+func (response CreateArea401Response) VisitCreateAreaResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type SubscribeService404Response struct {
-}
-
-func (response SubscribeService404Response) VisitSubscribeServiceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
-	return nil
-}
-
-type SubscribeService409Response struct {
-}
-
-func (response SubscribeService409Response) VisitSubscribeServiceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
-	return nil
-}
-
-type SubscribeService502Response struct {
+type CreateArea403Response struct {
 }
 
-func (response SubscribeService502Response) VisitSubscribeServiceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(502)
+func (response CreateArea403Response) VisitCreateAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
 	return nil
 }
 
-type SubscribeServiceExchangeRequestObject struct {
-	Provider OAuthProvider `json:"provider"`
-	Body     *SubscribeServiceExchangeJSONRequestBody
+type ImportAreaRequestObject struct {
+	JSONBody *ImportAreaJSONRequestBody
+	Body     io.Reader
 }
 
-type SubscribeServiceExchangeResponseObject interface {
-	VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error
+type ImportAreaResponseObject interface {
+	VisitImportAreaResponse(w http.ResponseWriter) error
 }
 
-type SubscribeServiceExchange200JSONResponse SubscribeExchangeResponse
+type ImportArea201JSONResponse Area
 
-func (response SubscribeServiceExchange200JSONResponse) VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error {
+func (response ImportArea201JSONResponse) VisitImportAreaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	w.WriteHeader(201)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type SubscribeServiceExchange400Response struct {
+type ImportArea400Response struct {
 }
 
-func (response SubscribeServiceExchange400Response) VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error {
+func (response ImportArea400Response) VisitImportAreaResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type SubscribeServiceExchange401Response struct {
+type ImportArea401Response struct {
 }
 
-func (response SubscribeServiceExchange401Response) VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error {
+func (response ImportArea401Response) VisitImportAreaResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type SubscribeServiceExchange404Response struct {
+type ImportArea403Response struct {
 }
 
-func (response SubscribeServiceExchange404Response) VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(404)
+func (response ImportArea403Response) VisitImportAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
 	return nil
 }
 
-type SubscribeServiceExchange409Response struct {
+type DeleteAreaRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
 }
 
-func (response SubscribeServiceExchange409Response) VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
-	return nil
+type DeleteAreaResponseObject interface {
+	VisitDeleteAreaResponse(w http.ResponseWriter) error
 }
 
-type SubscribeServiceExchange502Response struct {
+type DeleteArea204Response struct {
 }
 
-func (response SubscribeServiceExchange502Response) VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error {
-	w.WriteHeader(502)
+func (response DeleteArea204Response) VisitDeleteAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
 	return nil
 }
 
-type UnsubscribeServiceRequestObject struct {
-	Provider OAuthProvider `json:"provider"`
+type DeleteArea401Response struct {
 }
 
-type UnsubscribeServiceResponseObject interface {
-	VisitUnsubscribeServiceResponse(w http.ResponseWriter) error
+func (response DeleteArea401Response) VisitDeleteAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
 }
 
-type UnsubscribeService204Response struct {
+type DeleteArea403Response struct {
 }
 
-func (response UnsubscribeService204Response) VisitUnsubscribeServiceResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
+func (response DeleteArea403Response) VisitDeleteAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
 	return nil
 }
 
-type UnsubscribeService401Response struct {
+type DeleteArea404Response struct {
 }
 
-func (response UnsubscribeService401Response) VisitUnsubscribeServiceResponse(w http.ResponseWriter) error {
+func (response DeleteArea404Response) VisitDeleteAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetAreaRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+}
+
+type GetAreaResponseObject interface {
+	VisitGetAreaResponse(w http.ResponseWriter) error
+}
+
+type GetArea200JSONResponse Area
+
+func (response GetArea200JSONResponse) VisitGetAreaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetArea401Response struct {
+}
+
+func (response GetArea401Response) VisitGetAreaResponse(w http.ResponseWriter) error {
 	w.WriteHeader(401)
 	return nil
 }
 
-type UnsubscribeService404Response struct {
+type GetArea403Response struct {
 }
 
-func (response UnsubscribeService404Response) VisitUnsubscribeServiceResponse(w http.ResponseWriter) error {
+func (response GetArea403Response) VisitGetAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetArea404Response struct {
+}
+
+func (response GetArea404Response) VisitGetAreaResponse(w http.ResponseWriter) error {
 	w.WriteHeader(404)
 	return nil
 }
 
-type RegisterUserRequestObject struct {
-	Body *RegisterUserJSONRequestBody
+type UpdateAreaRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Body   *UpdateAreaJSONRequestBody
 }
 
-type RegisterUserResponseObject interface {
-	VisitRegisterUserResponse(w http.ResponseWriter) error
+type UpdateAreaResponseObject interface {
+	VisitUpdateAreaResponse(w http.ResponseWriter) error
 }
 
-type RegisterUser202JSONResponse RegisterUserResponse
+type UpdateArea200JSONResponse Area
 
-func (response RegisterUser202JSONResponse) VisitRegisterUserResponse(w http.ResponseWriter) error {
+func (response UpdateArea200JSONResponse) VisitUpdateAreaResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(202)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type RegisterUser400Response struct {
+type UpdateArea400Response struct {
 }
 
-func (response RegisterUser400Response) VisitRegisterUserResponse(w http.ResponseWriter) error {
+func (response UpdateArea400Response) VisitUpdateAreaResponse(w http.ResponseWriter) error {
 	w.WriteHeader(400)
 	return nil
 }
 
-type RegisterUser409Response struct {
+type UpdateArea401Response struct {
 }
 
-func (response RegisterUser409Response) VisitRegisterUserResponse(w http.ResponseWriter) error {
-	w.WriteHeader(409)
+func (response UpdateArea401Response) VisitUpdateAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
 	return nil
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Describe server capabilities
-	// (GET /about.json)
-	GetAbout(ctx context.Context, request GetAboutRequestObject) (GetAboutResponseObject, error)
-	// Update user email
-	// (PATCH /v1/admin/users/{userId}/email)
-	AdminUpdateUserEmail(ctx context.Context, request AdminUpdateUserEmailRequestObject) (AdminUpdateUserEmailResponseObject, error)
-	// Reset user password
-	// (PATCH /v1/admin/users/{userId}/password)
-	AdminResetUserPassword(ctx context.Context, request AdminResetUserPasswordRequestObject) (AdminResetUserPasswordResponseObject, error)
-	// Update user status
-	// (PATCH /v1/admin/users/{userId}/status)
-	AdminUpdateUserStatus(ctx context.Context, request AdminUpdateUserStatusRequestObject) (AdminUpdateUserStatusResponseObject, error)
-	// List automations owned by the current user
-	// (GET /v1/areas)
-	ListAreas(ctx context.Context, request ListAreasRequestObject) (ListAreasResponseObject, error)
-	// Create a new automation for the current user
-	// (POST /v1/areas)
-	CreateArea(ctx context.Context, request CreateAreaRequestObject) (CreateAreaResponseObject, error)
-	// Delete an automation owned by the current user
-	// (DELETE /v1/areas/{areaId})
-	DeleteArea(ctx context.Context, request DeleteAreaRequestObject) (DeleteAreaResponseObject, error)
-	// Retrieve an automation owned by the current user
-	// (GET /v1/areas/{areaId})
-	GetArea(ctx context.Context, request GetAreaRequestObject) (GetAreaResponseObject, error)
-	// Update an automation owned by the current user
-	// (PATCH /v1/areas/{areaId})
-	UpdateArea(ctx context.Context, request UpdateAreaRequestObject) (UpdateAreaResponseObject, error)
-	// Duplicate an automation and persist the copy for the current user
-	// (POST /v1/areas/{areaId}/duplicate)
-	DuplicateArea(ctx context.Context, request DuplicateAreaRequestObject) (DuplicateAreaResponseObject, error)
-	// Execute area reactions immediately
-	// (POST /v1/areas/{areaId}/execute)
-	ExecuteArea(ctx context.Context, request ExecuteAreaRequestObject) (ExecuteAreaResponseObject, error)
-	// List recent executions for an automation
-	// (GET /v1/areas/{areaId}/history)
-	ListAreaHistory(ctx context.Context, request ListAreaHistoryRequestObject) (ListAreaHistoryResponseObject, error)
-	// Update the lifecycle status of an automation
-	// (PATCH /v1/areas/{areaId}/status)
-	UpdateAreaStatus(ctx context.Context, request UpdateAreaStatusRequestObject) (UpdateAreaStatusResponseObject, error)
-	// Change account email
-	// (PATCH /v1/auth/email)
-	ChangeEmail(ctx context.Context, request ChangeEmailRequestObject) (ChangeEmailResponseObject, error)
-	// Authenticate using email and password
-	// (POST /v1/auth/login)
-	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
-	// Revoke the current session
-	// (POST /v1/auth/logout)
-	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
-	// Retrieve the authenticated user profile
-	// (GET /v1/auth/me)
-	GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error)
-	// Change account password
-	// (PATCH /v1/auth/password)
-	ChangePassword(ctx context.Context, request ChangePasswordRequestObject) (ChangePasswordResponseObject, error)
-	// Verify email address and create a session
-	// (POST /v1/auth/verify)
-	VerifyEmail(ctx context.Context, request VerifyEmailRequestObject) (VerifyEmailResponseObject, error)
-	// List service components
-	// (GET /v1/components)
-	ListComponents(ctx context.Context, request ListComponentsRequestObject) (ListComponentsResponseObject, error)
-	// List components available to the current user
-	// (GET /v1/components/available)
-	ListAvailableComponents(ctx context.Context, request ListAvailableComponentsRequestObject) (ListAvailableComponentsResponseObject, error)
-	// List connected identities
-	// (GET /v1/identities)
-	ListIdentities(ctx context.Context, request ListIdentitiesRequestObject) (ListIdentitiesResponseObject, error)
-	// Initiate OAuth authorization
-	// (POST /v1/oauth/{provider}/authorize)
-	AuthorizeOAuth(ctx context.Context, request AuthorizeOAuthRequestObject) (AuthorizeOAuthResponseObject, error)
-	// Exchange authorization code for session
-	// (POST /v1/oauth/{provider}/exchange)
-	ExchangeOAuth(ctx context.Context, request ExchangeOAuthRequestObject) (ExchangeOAuthResponseObject, error)
-	// List available service providers
-	// (GET /v1/services)
-	ListServiceProviders(ctx context.Context, request ListServiceProvidersRequestObject) (ListServiceProvidersResponseObject, error)
-	// List service subscriptions for the current user
-	// (GET /v1/services/subscriptions)
-	ListServiceSubscriptions(ctx context.Context, request ListServiceSubscriptionsRequestObject) (ListServiceSubscriptionsResponseObject, error)
-	// Subscribe current user to service provider
-	// (POST /v1/services/{provider}/subscribe)
-	SubscribeService(ctx context.Context, request SubscribeServiceRequestObject) (SubscribeServiceResponseObject, error)
-	// Complete service subscription exchange
-	// (POST /v1/services/{provider}/subscribe/exchange)
-	SubscribeServiceExchange(ctx context.Context, request SubscribeServiceExchangeRequestObject) (SubscribeServiceExchangeResponseObject, error)
-	// Revoke a service subscription
-	// (DELETE /v1/services/{provider}/subscription)
-	UnsubscribeService(ctx context.Context, request UnsubscribeServiceRequestObject) (UnsubscribeServiceResponseObject, error)
-	// Register a new user
-	// (POST /v1/users)
-	RegisterUser(ctx context.Context, request RegisterUserRequestObject) (RegisterUserResponseObject, error)
+type UpdateArea403Response struct {
+}
+
+func (response UpdateArea403Response) VisitUpdateAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type UpdateArea404Response struct {
+}
+
+func (response UpdateArea404Response) VisitUpdateAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SetAreaActivityScheduleRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Body   *SetAreaActivityScheduleJSONRequestBody
+}
+
+type SetAreaActivityScheduleResponseObject interface {
+	VisitSetAreaActivityScheduleResponse(w http.ResponseWriter) error
+}
+
+type SetAreaActivitySchedule200JSONResponse Area
+
+func (response SetAreaActivitySchedule200JSONResponse) VisitSetAreaActivityScheduleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetAreaActivitySchedule400Response struct {
+}
+
+func (response SetAreaActivitySchedule400Response) VisitSetAreaActivityScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SetAreaActivitySchedule401Response struct {
+}
+
+func (response SetAreaActivitySchedule401Response) VisitSetAreaActivityScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SetAreaActivitySchedule403Response struct {
+}
+
+func (response SetAreaActivitySchedule403Response) VisitSetAreaActivityScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type SetAreaActivitySchedule404Response struct {
+}
+
+func (response SetAreaActivitySchedule404Response) VisitSetAreaActivityScheduleResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DryRunAreaRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Body   *DryRunAreaJSONRequestBody
+}
+
+type DryRunAreaResponseObject interface {
+	VisitDryRunAreaResponse(w http.ResponseWriter) error
+}
+
+type DryRunArea200JSONResponse AreaDryRunResponse
+
+func (response DryRunArea200JSONResponse) VisitDryRunAreaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DryRunArea400Response struct {
+}
+
+func (response DryRunArea400Response) VisitDryRunAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type DryRunArea401Response struct {
+}
+
+func (response DryRunArea401Response) VisitDryRunAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DryRunArea403Response struct {
+}
+
+func (response DryRunArea403Response) VisitDryRunAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DryRunArea404Response struct {
+}
+
+func (response DryRunArea404Response) VisitDryRunAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DuplicateAreaRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Body   *DuplicateAreaJSONRequestBody
+}
+
+type DuplicateAreaResponseObject interface {
+	VisitDuplicateAreaResponse(w http.ResponseWriter) error
+}
+
+type DuplicateArea201JSONResponse Area
+
+func (response DuplicateArea201JSONResponse) VisitDuplicateAreaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DuplicateArea400Response struct {
+}
+
+func (response DuplicateArea400Response) VisitDuplicateAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type DuplicateArea401Response struct {
+}
+
+func (response DuplicateArea401Response) VisitDuplicateAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DuplicateArea403Response struct {
+}
+
+func (response DuplicateArea403Response) VisitDuplicateAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DuplicateArea404Response struct {
+}
+
+func (response DuplicateArea404Response) VisitDuplicateAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ExecuteAreaRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+}
+
+type ExecuteAreaResponseObject interface {
+	VisitExecuteAreaResponse(w http.ResponseWriter) error
+}
+
+type ExecuteArea202Response struct {
+}
+
+func (response ExecuteArea202Response) VisitExecuteAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type ExecuteArea401Response struct {
+}
+
+func (response ExecuteArea401Response) VisitExecuteAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ExecuteArea403Response struct {
+}
+
+func (response ExecuteArea403Response) VisitExecuteAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type ExecuteArea404Response struct {
+}
+
+func (response ExecuteArea404Response) VisitExecuteAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ExecuteArea429Response struct {
+}
+
+func (response ExecuteArea429Response) VisitExecuteAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(429)
+	return nil
+}
+
+type ExecuteArea500Response struct {
+}
+
+func (response ExecuteArea500Response) VisitExecuteAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ExportAreaRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Params ExportAreaParams
+}
+
+type ExportAreaResponseObject interface {
+	VisitExportAreaResponse(w http.ResponseWriter) error
+}
+
+type ExportArea200JSONResponse AreaDocument
+
+func (response ExportArea200JSONResponse) VisitExportAreaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ExportArea200ApplicationyamlResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response ExportArea200ApplicationyamlResponse) VisitExportAreaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/yaml")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+type ExportArea401Response struct {
+}
+
+func (response ExportArea401Response) VisitExportAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ExportArea403Response struct {
+}
+
+func (response ExportArea403Response) VisitExportAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type ExportArea404Response struct {
+}
+
+func (response ExportArea404Response) VisitExportAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type MoveAreaFolderRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Body   *MoveAreaFolderJSONRequestBody
+}
+
+type MoveAreaFolderResponseObject interface {
+	VisitMoveAreaFolderResponse(w http.ResponseWriter) error
+}
+
+type MoveAreaFolder200JSONResponse Area
+
+func (response MoveAreaFolder200JSONResponse) VisitMoveAreaFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveAreaFolder400Response struct {
+}
+
+func (response MoveAreaFolder400Response) VisitMoveAreaFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type MoveAreaFolder401Response struct {
+}
+
+func (response MoveAreaFolder401Response) VisitMoveAreaFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type MoveAreaFolder403Response struct {
+}
+
+func (response MoveAreaFolder403Response) VisitMoveAreaFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type MoveAreaFolder404Response struct {
+}
+
+func (response MoveAreaFolder404Response) VisitMoveAreaFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SetAreaHealthPolicyRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Body   *SetAreaHealthPolicyJSONRequestBody
+}
+
+type SetAreaHealthPolicyResponseObject interface {
+	VisitSetAreaHealthPolicyResponse(w http.ResponseWriter) error
+}
+
+type SetAreaHealthPolicy200JSONResponse Area
+
+func (response SetAreaHealthPolicy200JSONResponse) VisitSetAreaHealthPolicyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetAreaHealthPolicy400Response struct {
+}
+
+func (response SetAreaHealthPolicy400Response) VisitSetAreaHealthPolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SetAreaHealthPolicy401Response struct {
+}
+
+func (response SetAreaHealthPolicy401Response) VisitSetAreaHealthPolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SetAreaHealthPolicy403Response struct {
+}
+
+func (response SetAreaHealthPolicy403Response) VisitSetAreaHealthPolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type SetAreaHealthPolicy404Response struct {
+}
+
+func (response SetAreaHealthPolicy404Response) VisitSetAreaHealthPolicyResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListAreaHistoryRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Params ListAreaHistoryParams
+}
+
+type ListAreaHistoryResponseObject interface {
+	VisitListAreaHistoryResponse(w http.ResponseWriter) error
+}
+
+type ListAreaHistory200JSONResponse AreaHistoryResponse
+
+func (response ListAreaHistory200JSONResponse) VisitListAreaHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAreaHistory401Response struct {
+}
+
+func (response ListAreaHistory401Response) VisitListAreaHistoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListAreaHistory403Response struct {
+}
+
+func (response ListAreaHistory403Response) VisitListAreaHistoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type ListAreaHistory404Response struct {
+}
+
+func (response ListAreaHistory404Response) VisitListAreaHistoryResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListAreaRevisionsRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Params ListAreaRevisionsParams
+}
+
+type ListAreaRevisionsResponseObject interface {
+	VisitListAreaRevisionsResponse(w http.ResponseWriter) error
+}
+
+type ListAreaRevisions200JSONResponse AreaRevisionListResponse
+
+func (response ListAreaRevisions200JSONResponse) VisitListAreaRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAreaRevisions401Response struct {
+}
+
+func (response ListAreaRevisions401Response) VisitListAreaRevisionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListAreaRevisions403Response struct {
+}
+
+func (response ListAreaRevisions403Response) VisitListAreaRevisionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type ListAreaRevisions404Response struct {
+}
+
+func (response ListAreaRevisions404Response) VisitListAreaRevisionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type DiffAreaRevisionsRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Params DiffAreaRevisionsParams
+}
+
+type DiffAreaRevisionsResponseObject interface {
+	VisitDiffAreaRevisionsResponse(w http.ResponseWriter) error
+}
+
+type DiffAreaRevisions200JSONResponse AreaRevisionDiffResponse
+
+func (response DiffAreaRevisions200JSONResponse) VisitDiffAreaRevisionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DiffAreaRevisions400Response struct {
+}
+
+func (response DiffAreaRevisions400Response) VisitDiffAreaRevisionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type DiffAreaRevisions401Response struct {
+}
+
+func (response DiffAreaRevisions401Response) VisitDiffAreaRevisionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DiffAreaRevisions403Response struct {
+}
+
+func (response DiffAreaRevisions403Response) VisitDiffAreaRevisionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DiffAreaRevisions404Response struct {
+}
+
+func (response DiffAreaRevisions404Response) VisitDiffAreaRevisionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RollbackAreaRequestObject struct {
+	AreaId   openapi_types.UUID `json:"areaId"`
+	Revision int                `json:"revision"`
+}
+
+type RollbackAreaResponseObject interface {
+	VisitRollbackAreaResponse(w http.ResponseWriter) error
+}
+
+type RollbackArea200JSONResponse Area
+
+func (response RollbackArea200JSONResponse) VisitRollbackAreaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RollbackArea400Response struct {
+}
+
+func (response RollbackArea400Response) VisitRollbackAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type RollbackArea401Response struct {
+}
+
+func (response RollbackArea401Response) VisitRollbackAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RollbackArea403Response struct {
+}
+
+func (response RollbackArea403Response) VisitRollbackAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type RollbackArea404Response struct {
+}
+
+func (response RollbackArea404Response) VisitRollbackAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SetAreaRunnerRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Body   *SetAreaRunnerJSONRequestBody
+}
+
+type SetAreaRunnerResponseObject interface {
+	VisitSetAreaRunnerResponse(w http.ResponseWriter) error
+}
+
+type SetAreaRunner200JSONResponse Area
+
+func (response SetAreaRunner200JSONResponse) VisitSetAreaRunnerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetAreaRunner400Response struct {
+}
+
+func (response SetAreaRunner400Response) VisitSetAreaRunnerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SetAreaRunner401Response struct {
+}
+
+func (response SetAreaRunner401Response) VisitSetAreaRunnerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SetAreaRunner403Response struct {
+}
+
+func (response SetAreaRunner403Response) VisitSetAreaRunnerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type SetAreaRunner404Response struct {
+}
+
+func (response SetAreaRunner404Response) VisitSetAreaRunnerResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UpdateAreaStatusRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Body   *UpdateAreaStatusJSONRequestBody
+}
+
+type UpdateAreaStatusResponseObject interface {
+	VisitUpdateAreaStatusResponse(w http.ResponseWriter) error
+}
+
+type UpdateAreaStatus200JSONResponse Area
+
+func (response UpdateAreaStatus200JSONResponse) VisitUpdateAreaStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAreaStatus400Response struct {
+}
+
+func (response UpdateAreaStatus400Response) VisitUpdateAreaStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UpdateAreaStatus401Response struct {
+}
+
+func (response UpdateAreaStatus401Response) VisitUpdateAreaStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateAreaStatus403Response struct {
+}
+
+func (response UpdateAreaStatus403Response) VisitUpdateAreaStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type UpdateAreaStatus404Response struct {
+}
+
+func (response UpdateAreaStatus404Response) VisitUpdateAreaStatusResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UntagAreaRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	TagId  TagId              `json:"tagId"`
+}
+
+type UntagAreaResponseObject interface {
+	VisitUntagAreaResponse(w http.ResponseWriter) error
+}
+
+type UntagArea200JSONResponse Area
+
+func (response UntagArea200JSONResponse) VisitUntagAreaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UntagArea401Response struct {
+}
+
+func (response UntagArea401Response) VisitUntagAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UntagArea403Response struct {
+}
+
+func (response UntagArea403Response) VisitUntagAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type UntagArea404Response struct {
+}
+
+func (response UntagArea404Response) VisitUntagAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type TagAreaRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	TagId  TagId              `json:"tagId"`
+}
+
+type TagAreaResponseObject interface {
+	VisitTagAreaResponse(w http.ResponseWriter) error
+}
+
+type TagArea200JSONResponse Area
+
+func (response TagArea200JSONResponse) VisitTagAreaResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TagArea401Response struct {
+}
+
+func (response TagArea401Response) VisitTagAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type TagArea403Response struct {
+}
+
+func (response TagArea403Response) VisitTagAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type TagArea404Response struct {
+}
+
+func (response TagArea404Response) VisitTagAreaResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetAreaWebhookRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+}
+
+type GetAreaWebhookResponseObject interface {
+	VisitGetAreaWebhookResponse(w http.ResponseWriter) error
+}
+
+type GetAreaWebhook200JSONResponse AreaWebhook
+
+func (response GetAreaWebhook200JSONResponse) VisitGetAreaWebhookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAreaWebhook401Response struct {
+}
+
+func (response GetAreaWebhook401Response) VisitGetAreaWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetAreaWebhook403Response struct {
+}
+
+func (response GetAreaWebhook403Response) VisitGetAreaWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetAreaWebhook404Response struct {
+}
+
+func (response GetAreaWebhook404Response) VisitGetAreaWebhookResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type MoveAreaWorkspaceRequestObject struct {
+	AreaId openapi_types.UUID `json:"areaId"`
+	Body   *MoveAreaWorkspaceJSONRequestBody
+}
+
+type MoveAreaWorkspaceResponseObject interface {
+	VisitMoveAreaWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type MoveAreaWorkspace200JSONResponse Area
+
+func (response MoveAreaWorkspace200JSONResponse) VisitMoveAreaWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveAreaWorkspace400Response struct {
+}
+
+func (response MoveAreaWorkspace400Response) VisitMoveAreaWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type MoveAreaWorkspace401Response struct {
+}
+
+func (response MoveAreaWorkspace401Response) VisitMoveAreaWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type MoveAreaWorkspace403Response struct {
+}
+
+func (response MoveAreaWorkspace403Response) VisitMoveAreaWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type MoveAreaWorkspace404Response struct {
+}
+
+func (response MoveAreaWorkspace404Response) VisitMoveAreaWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ChangeEmailRequestObject struct {
+	Body *ChangeEmailJSONRequestBody
+}
+
+type ChangeEmailResponseObject interface {
+	VisitChangeEmailResponse(w http.ResponseWriter) error
+}
+
+type ChangeEmail202JSONResponse EmailChangeResponse
+
+func (response ChangeEmail202JSONResponse) VisitChangeEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ChangeEmail400Response struct {
+}
+
+func (response ChangeEmail400Response) VisitChangeEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ChangeEmail401Response struct {
+}
+
+func (response ChangeEmail401Response) VisitChangeEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ChangeEmail409Response struct {
+}
+
+func (response ChangeEmail409Response) VisitChangeEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type ChangeEmail500Response struct {
+}
+
+func (response ChangeEmail500Response) VisitChangeEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(w http.ResponseWriter) error
+}
+
+type Login200ResponseHeaders struct {
+	SetCookie string
+}
+
+type Login200JSONResponse struct {
+	Body    AuthSessionResponse
+	Headers Login200ResponseHeaders
+}
+
+func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type Login400Response struct {
+}
+
+func (response Login400Response) VisitLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type Login403Response struct {
+}
+
+func (response Login403Response) VisitLoginResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type LogoutRequestObject struct {
+}
+
+type LogoutResponseObject interface {
+	VisitLogoutResponse(w http.ResponseWriter) error
+}
+
+type Logout204Response struct {
+}
+
+func (response Logout204Response) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type Logout401Response struct {
+}
+
+func (response Logout401Response) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetCurrentUserRequestObject struct {
+}
+
+type GetCurrentUserResponseObject interface {
+	VisitGetCurrentUserResponse(w http.ResponseWriter) error
+}
+
+type GetCurrentUser200JSONResponse UserResponse
+
+func (response GetCurrentUser200JSONResponse) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCurrentUser401Response struct {
+}
+
+func (response GetCurrentUser401Response) VisitGetCurrentUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ChangePasswordRequestObject struct {
+	Body *ChangePasswordJSONRequestBody
+}
+
+type ChangePasswordResponseObject interface {
+	VisitChangePasswordResponse(w http.ResponseWriter) error
+}
+
+type ChangePassword204Response struct {
+}
+
+func (response ChangePassword204Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type ChangePassword400Response struct {
+}
+
+func (response ChangePassword400Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ChangePassword401Response struct {
+}
+
+func (response ChangePassword401Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ChangePassword500Response struct {
+}
+
+func (response ChangePassword500Response) VisitChangePasswordResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type VerifyEmailRequestObject struct {
+	Body *VerifyEmailJSONRequestBody
+}
+
+type VerifyEmailResponseObject interface {
+	VisitVerifyEmailResponse(w http.ResponseWriter) error
+}
+
+type VerifyEmail200ResponseHeaders struct {
+	SetCookie string
+}
+
+type VerifyEmail200JSONResponse struct {
+	Body    AuthSessionResponse
+	Headers VerifyEmail200ResponseHeaders
+}
+
+func (response VerifyEmail200JSONResponse) VisitVerifyEmailResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type VerifyEmail400Response struct {
+}
+
+func (response VerifyEmail400Response) VisitVerifyEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type VerifyEmail410Response struct {
+}
+
+func (response VerifyEmail410Response) VisitVerifyEmailResponse(w http.ResponseWriter) error {
+	w.WriteHeader(410)
+	return nil
+}
+
+type ListComponentsRequestObject struct {
+	Params ListComponentsParams
+}
+
+type ListComponentsResponseObject interface {
+	VisitListComponentsResponse(w http.ResponseWriter) error
+}
+
+type ListComponents200JSONResponse ComponentListResponse
+
+func (response ListComponents200JSONResponse) VisitListComponentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListComponents400Response struct {
+}
+
+func (response ListComponents400Response) VisitListComponentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ListComponents500Response struct {
+}
+
+func (response ListComponents500Response) VisitListComponentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type ListAvailableComponentsRequestObject struct {
+	Params ListAvailableComponentsParams
+}
+
+type ListAvailableComponentsResponseObject interface {
+	VisitListAvailableComponentsResponse(w http.ResponseWriter) error
+}
+
+type ListAvailableComponents200JSONResponse ComponentListResponse
+
+func (response ListAvailableComponents200JSONResponse) VisitListAvailableComponentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAvailableComponents400Response struct {
+}
+
+func (response ListAvailableComponents400Response) VisitListAvailableComponentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ListAvailableComponents401Response struct {
+}
+
+func (response ListAvailableComponents401Response) VisitListAvailableComponentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListAvailableComponents500Response struct {
+}
+
+func (response ListAvailableComponents500Response) VisitListAvailableComponentsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type PreviewComponentRequestObject struct {
+	ComponentId openapi_types.UUID `json:"componentId"`
+	Body        *PreviewComponentJSONRequestBody
+}
+
+type PreviewComponentResponseObject interface {
+	VisitPreviewComponentResponse(w http.ResponseWriter) error
+}
+
+type PreviewComponent200JSONResponse PreviewComponentResponse
+
+func (response PreviewComponent200JSONResponse) VisitPreviewComponentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewComponent400Response struct {
+}
+
+func (response PreviewComponent400Response) VisitPreviewComponentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type PreviewComponent401Response struct {
+}
+
+func (response PreviewComponent401Response) VisitPreviewComponentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type PreviewComponent403Response struct {
+}
+
+func (response PreviewComponent403Response) VisitPreviewComponentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type PreviewComponent502Response struct {
+}
+
+func (response PreviewComponent502Response) VisitPreviewComponentResponse(w http.ResponseWriter) error {
+	w.WriteHeader(502)
+	return nil
+}
+
+type ListFoldersRequestObject struct {
+}
+
+type ListFoldersResponseObject interface {
+	VisitListFoldersResponse(w http.ResponseWriter) error
+}
+
+type ListFolders200JSONResponse FolderListResponse
+
+func (response ListFolders200JSONResponse) VisitListFoldersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListFolders401Response struct {
+}
+
+func (response ListFolders401Response) VisitListFoldersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateFolderRequestObject struct {
+	Body *CreateFolderJSONRequestBody
+}
+
+type CreateFolderResponseObject interface {
+	VisitCreateFolderResponse(w http.ResponseWriter) error
+}
+
+type CreateFolder201JSONResponse Folder
+
+func (response CreateFolder201JSONResponse) VisitCreateFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateFolder400Response struct {
+}
+
+func (response CreateFolder400Response) VisitCreateFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type CreateFolder401Response struct {
+}
+
+func (response CreateFolder401Response) VisitCreateFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateFolder409Response struct {
+}
+
+func (response CreateFolder409Response) VisitCreateFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type DeleteFolderRequestObject struct {
+	FolderId FolderId `json:"folderId"`
+}
+
+type DeleteFolderResponseObject interface {
+	VisitDeleteFolderResponse(w http.ResponseWriter) error
+}
+
+type DeleteFolder204Response struct {
+}
+
+func (response DeleteFolder204Response) VisitDeleteFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteFolder401Response struct {
+}
+
+func (response DeleteFolder401Response) VisitDeleteFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteFolder404Response struct {
+}
+
+func (response DeleteFolder404Response) VisitDeleteFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UpdateFolderRequestObject struct {
+	FolderId FolderId `json:"folderId"`
+	Body     *UpdateFolderJSONRequestBody
+}
+
+type UpdateFolderResponseObject interface {
+	VisitUpdateFolderResponse(w http.ResponseWriter) error
+}
+
+type UpdateFolder200JSONResponse Folder
+
+func (response UpdateFolder200JSONResponse) VisitUpdateFolderResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateFolder400Response struct {
+}
+
+func (response UpdateFolder400Response) VisitUpdateFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UpdateFolder401Response struct {
+}
+
+func (response UpdateFolder401Response) VisitUpdateFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateFolder404Response struct {
+}
+
+func (response UpdateFolder404Response) VisitUpdateFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UpdateFolder409Response struct {
+}
+
+func (response UpdateFolder409Response) VisitUpdateFolderResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type ListIdentitiesRequestObject struct {
+}
+
+type ListIdentitiesResponseObject interface {
+	VisitListIdentitiesResponse(w http.ResponseWriter) error
+}
+
+type ListIdentities200JSONResponse IdentityListResponse
+
+func (response ListIdentities200JSONResponse) VisitListIdentitiesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListIdentities401Response struct {
+}
+
+func (response ListIdentities401Response) VisitListIdentitiesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type ListIdentities500Response struct {
+}
+
+func (response ListIdentities500Response) VisitListIdentitiesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
+type AuthorizeOAuthRequestObject struct {
+	Provider OAuthProvider `json:"provider"`
+	Body     *AuthorizeOAuthJSONRequestBody
+}
+
+type AuthorizeOAuthResponseObject interface {
+	VisitAuthorizeOAuthResponse(w http.ResponseWriter) error
+}
+
+type AuthorizeOAuth200JSONResponse OAuthAuthorizationResponse
+
+func (response AuthorizeOAuth200JSONResponse) VisitAuthorizeOAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AuthorizeOAuth400Response struct {
+}
+
+func (response AuthorizeOAuth400Response) VisitAuthorizeOAuthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AuthorizeOAuth404Response struct {
+}
+
+func (response AuthorizeOAuth404Response) VisitAuthorizeOAuthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AuthorizeOAuth501Response struct {
+}
+
+func (response AuthorizeOAuth501Response) VisitAuthorizeOAuthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(501)
+	return nil
+}
+
+type ExchangeOAuthRequestObject struct {
+	Provider OAuthProvider `json:"provider"`
+	Body     *ExchangeOAuthJSONRequestBody
+}
+
+type ExchangeOAuthResponseObject interface {
+	VisitExchangeOAuthResponse(w http.ResponseWriter) error
+}
+
+type ExchangeOAuth200ResponseHeaders struct {
+	SetCookie string
+}
+
+type ExchangeOAuth200JSONResponse struct {
+	Body    AuthSessionResponse
+	Headers ExchangeOAuth200ResponseHeaders
+}
+
+func (response ExchangeOAuth200JSONResponse) VisitExchangeOAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Set-Cookie", fmt.Sprint(response.Headers.SetCookie))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type ExchangeOAuth400Response struct {
+}
+
+func (response ExchangeOAuth400Response) VisitExchangeOAuthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type ExchangeOAuth404Response struct {
+}
+
+func (response ExchangeOAuth404Response) VisitExchangeOAuthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ExchangeOAuth501Response struct {
+}
+
+func (response ExchangeOAuth501Response) VisitExchangeOAuthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(501)
+	return nil
+}
+
+type ExchangeOAuth502Response struct {
+}
+
+func (response ExchangeOAuth502Response) VisitExchangeOAuthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(502)
+	return nil
+}
+
+type ListServiceProvidersRequestObject struct {
+}
+
+type ListServiceProvidersResponseObject interface {
+	VisitListServiceProvidersResponse(w http.ResponseWriter) error
+}
+
+type ListServiceProviders200JSONResponse ServiceProviderListResponse
+
+func (response ListServiceProviders200JSONResponse) VisitListServiceProvidersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceSubscriptionsRequestObject struct {
+}
+
+type ListServiceSubscriptionsResponseObject interface {
+	VisitListServiceSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type ListServiceSubscriptions200JSONResponse SubscriptionListResponse
+
+func (response ListServiceSubscriptions200JSONResponse) VisitListServiceSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListServiceSubscriptions401Response struct {
+}
+
+func (response ListServiceSubscriptions401Response) VisitListServiceSubscriptionsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SubscribeServiceRequestObject struct {
+	Provider OAuthProvider `json:"provider"`
+	Body     *SubscribeServiceJSONRequestBody
+}
+
+type SubscribeServiceResponseObject interface {
+	VisitSubscribeServiceResponse(w http.ResponseWriter) error
+}
+
+type SubscribeService200JSONResponse SubscribeServiceResponse
+
+func (response SubscribeService200JSONResponse) VisitSubscribeServiceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubscribeService400Response struct {
+}
+
+func (response SubscribeService400Response) VisitSubscribeServiceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SubscribeService401Response struct {
+}
+
+func (response SubscribeService401Response) VisitSubscribeServiceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SubscribeService404Response struct {
+}
+
+func (response SubscribeService404Response) VisitSubscribeServiceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SubscribeService409Response struct {
+}
+
+func (response SubscribeService409Response) VisitSubscribeServiceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type SubscribeService502Response struct {
+}
+
+func (response SubscribeService502Response) VisitSubscribeServiceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(502)
+	return nil
+}
+
+type SubscribeServiceExchangeRequestObject struct {
+	Provider OAuthProvider `json:"provider"`
+	Body     *SubscribeServiceExchangeJSONRequestBody
+}
+
+type SubscribeServiceExchangeResponseObject interface {
+	VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error
+}
+
+type SubscribeServiceExchange200JSONResponse SubscribeExchangeResponse
+
+func (response SubscribeServiceExchange200JSONResponse) VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SubscribeServiceExchange400Response struct {
+}
+
+func (response SubscribeServiceExchange400Response) VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type SubscribeServiceExchange401Response struct {
+}
+
+func (response SubscribeServiceExchange401Response) VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type SubscribeServiceExchange404Response struct {
+}
+
+func (response SubscribeServiceExchange404Response) VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type SubscribeServiceExchange409Response struct {
+}
+
+func (response SubscribeServiceExchange409Response) VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type SubscribeServiceExchange502Response struct {
+}
+
+func (response SubscribeServiceExchange502Response) VisitSubscribeServiceExchangeResponse(w http.ResponseWriter) error {
+	w.WriteHeader(502)
+	return nil
+}
+
+type UnsubscribeServiceRequestObject struct {
+	Provider OAuthProvider `json:"provider"`
+}
+
+type UnsubscribeServiceResponseObject interface {
+	VisitUnsubscribeServiceResponse(w http.ResponseWriter) error
+}
+
+type UnsubscribeService204Response struct {
+}
+
+func (response UnsubscribeService204Response) VisitUnsubscribeServiceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type UnsubscribeService401Response struct {
+}
+
+func (response UnsubscribeService401Response) VisitUnsubscribeServiceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UnsubscribeService404Response struct {
+}
+
+func (response UnsubscribeService404Response) VisitUnsubscribeServiceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListTagsRequestObject struct {
+}
+
+type ListTagsResponseObject interface {
+	VisitListTagsResponse(w http.ResponseWriter) error
+}
+
+type ListTags200JSONResponse TagListResponse
+
+func (response ListTags200JSONResponse) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListTags401Response struct {
+}
+
+func (response ListTags401Response) VisitListTagsResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateTagRequestObject struct {
+	Body *CreateTagJSONRequestBody
+}
+
+type CreateTagResponseObject interface {
+	VisitCreateTagResponse(w http.ResponseWriter) error
+}
+
+type CreateTag201JSONResponse Tag
+
+func (response CreateTag201JSONResponse) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateTag400Response struct {
+}
+
+func (response CreateTag400Response) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type CreateTag401Response struct {
+}
+
+func (response CreateTag401Response) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateTag409Response struct {
+}
+
+func (response CreateTag409Response) VisitCreateTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type DeleteTagRequestObject struct {
+	TagId TagId `json:"tagId"`
+}
+
+type DeleteTagResponseObject interface {
+	VisitDeleteTagResponse(w http.ResponseWriter) error
+}
+
+type DeleteTag204Response struct {
+}
+
+func (response DeleteTag204Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteTag401Response struct {
+}
+
+func (response DeleteTag401Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteTag404Response struct {
+}
+
+func (response DeleteTag404Response) VisitDeleteTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UpdateTagRequestObject struct {
+	TagId TagId `json:"tagId"`
+	Body  *UpdateTagJSONRequestBody
+}
+
+type UpdateTagResponseObject interface {
+	VisitUpdateTagResponse(w http.ResponseWriter) error
+}
+
+type UpdateTag200JSONResponse Tag
+
+func (response UpdateTag200JSONResponse) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateTag400Response struct {
+}
+
+func (response UpdateTag400Response) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UpdateTag401Response struct {
+}
+
+func (response UpdateTag401Response) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateTag404Response struct {
+}
+
+func (response UpdateTag404Response) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UpdateTag409Response struct {
+}
+
+func (response UpdateTag409Response) VisitUpdateTagResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type BulkTagActionRequestObject struct {
+	TagId TagId `json:"tagId"`
+	Body  *BulkTagActionJSONRequestBody
+}
+
+type BulkTagActionResponseObject interface {
+	VisitBulkTagActionResponse(w http.ResponseWriter) error
+}
+
+type BulkTagAction200JSONResponse BulkTagActionResponse
+
+func (response BulkTagAction200JSONResponse) VisitBulkTagActionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkTagAction400Response struct {
+}
+
+func (response BulkTagAction400Response) VisitBulkTagActionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type BulkTagAction401Response struct {
+}
+
+func (response BulkTagAction401Response) VisitBulkTagActionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type BulkTagAction404Response struct {
+}
+
+func (response BulkTagAction404Response) VisitBulkTagActionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type ListAreaTemplatesRequestObject struct {
+}
+
+type ListAreaTemplatesResponseObject interface {
+	VisitListAreaTemplatesResponse(w http.ResponseWriter) error
+}
+
+type ListAreaTemplates200JSONResponse AreaTemplateListResponse
+
+func (response ListAreaTemplates200JSONResponse) VisitListAreaTemplatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListAreaTemplates401Response struct {
+}
+
+func (response ListAreaTemplates401Response) VisitListAreaTemplatesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetAreaTemplateRequestObject struct {
+	TemplateId TemplateId `json:"templateId"`
+}
+
+type GetAreaTemplateResponseObject interface {
+	VisitGetAreaTemplateResponse(w http.ResponseWriter) error
+}
+
+type GetAreaTemplate200JSONResponse AreaTemplate
+
+func (response GetAreaTemplate200JSONResponse) VisitGetAreaTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAreaTemplate401Response struct {
+}
+
+func (response GetAreaTemplate401Response) VisitGetAreaTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetAreaTemplate404Response struct {
+}
+
+func (response GetAreaTemplate404Response) VisitGetAreaTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type InstantiateAreaTemplateRequestObject struct {
+	TemplateId TemplateId `json:"templateId"`
+	Body       *InstantiateAreaTemplateJSONRequestBody
+}
+
+type InstantiateAreaTemplateResponseObject interface {
+	VisitInstantiateAreaTemplateResponse(w http.ResponseWriter) error
+}
+
+type InstantiateAreaTemplate201JSONResponse Area
+
+func (response InstantiateAreaTemplate201JSONResponse) VisitInstantiateAreaTemplateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type InstantiateAreaTemplate400Response struct {
+}
+
+func (response InstantiateAreaTemplate400Response) VisitInstantiateAreaTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type InstantiateAreaTemplate401Response struct {
+}
+
+func (response InstantiateAreaTemplate401Response) VisitInstantiateAreaTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type InstantiateAreaTemplate403Response struct {
+}
+
+func (response InstantiateAreaTemplate403Response) VisitInstantiateAreaTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type InstantiateAreaTemplate404Response struct {
+}
+
+func (response InstantiateAreaTemplate404Response) VisitInstantiateAreaTemplateResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RegisterUserRequestObject struct {
+	Body *RegisterUserJSONRequestBody
+}
+
+type RegisterUserResponseObject interface {
+	VisitRegisterUserResponse(w http.ResponseWriter) error
+}
+
+type RegisterUser202JSONResponse RegisterUserResponse
+
+func (response RegisterUser202JSONResponse) VisitRegisterUserResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RegisterUser400Response struct {
+}
+
+func (response RegisterUser400Response) VisitRegisterUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type RegisterUser409Response struct {
+}
+
+func (response RegisterUser409Response) VisitRegisterUserResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type ListWorkspacesRequestObject struct {
+}
+
+type ListWorkspacesResponseObject interface {
+	VisitListWorkspacesResponse(w http.ResponseWriter) error
+}
+
+type ListWorkspaces200JSONResponse WorkspaceListResponse
+
+func (response ListWorkspaces200JSONResponse) VisitListWorkspacesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListWorkspaces401Response struct {
+}
+
+func (response ListWorkspaces401Response) VisitListWorkspacesResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type CreateWorkspaceRequestObject struct {
+	Body *CreateWorkspaceJSONRequestBody
+}
+
+type CreateWorkspaceResponseObject interface {
+	VisitCreateWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type CreateWorkspace201JSONResponse Workspace
+
+func (response CreateWorkspace201JSONResponse) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateWorkspace400Response struct {
+}
+
+func (response CreateWorkspace400Response) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type CreateWorkspace401Response struct {
+}
+
+func (response CreateWorkspace401Response) VisitCreateWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteWorkspaceRequestObject struct {
+	WorkspaceId WorkspaceId `json:"workspaceId"`
+}
+
+type DeleteWorkspaceResponseObject interface {
+	VisitDeleteWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type DeleteWorkspace204Response struct {
+}
+
+func (response DeleteWorkspace204Response) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteWorkspace401Response struct {
+}
+
+func (response DeleteWorkspace401Response) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type DeleteWorkspace403Response struct {
+}
+
+func (response DeleteWorkspace403Response) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type DeleteWorkspace404Response struct {
+}
+
+func (response DeleteWorkspace404Response) VisitDeleteWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type GetWorkspaceRequestObject struct {
+	WorkspaceId WorkspaceId `json:"workspaceId"`
+}
+
+type GetWorkspaceResponseObject interface {
+	VisitGetWorkspaceResponse(w http.ResponseWriter) error
+}
+
+type GetWorkspace200JSONResponse WorkspaceDetail
+
+func (response GetWorkspace200JSONResponse) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetWorkspace401Response struct {
+}
+
+func (response GetWorkspace401Response) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type GetWorkspace404Response struct {
+}
+
+func (response GetWorkspace404Response) VisitGetWorkspaceResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AddWorkspaceMemberRequestObject struct {
+	WorkspaceId WorkspaceId `json:"workspaceId"`
+	Body        *AddWorkspaceMemberJSONRequestBody
+}
+
+type AddWorkspaceMemberResponseObject interface {
+	VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error
+}
+
+type AddWorkspaceMember201JSONResponse WorkspaceMember
+
+func (response AddWorkspaceMember201JSONResponse) VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddWorkspaceMember400Response struct {
+}
+
+func (response AddWorkspaceMember400Response) VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type AddWorkspaceMember401Response struct {
+}
+
+func (response AddWorkspaceMember401Response) VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type AddWorkspaceMember403Response struct {
+}
+
+func (response AddWorkspaceMember403Response) VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type AddWorkspaceMember404Response struct {
+}
+
+func (response AddWorkspaceMember404Response) VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type AddWorkspaceMember409Response struct {
+}
+
+func (response AddWorkspaceMember409Response) VisitAddWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type RemoveWorkspaceMemberRequestObject struct {
+	WorkspaceId WorkspaceId `json:"workspaceId"`
+	UserId      UserId      `json:"userId"`
+}
+
+type RemoveWorkspaceMemberResponseObject interface {
+	VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error
+}
+
+type RemoveWorkspaceMember204Response struct {
+}
+
+func (response RemoveWorkspaceMember204Response) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type RemoveWorkspaceMember401Response struct {
+}
+
+func (response RemoveWorkspaceMember401Response) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type RemoveWorkspaceMember403Response struct {
+}
+
+func (response RemoveWorkspaceMember403Response) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type RemoveWorkspaceMember404Response struct {
+}
+
+func (response RemoveWorkspaceMember404Response) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type RemoveWorkspaceMember409Response struct {
+}
+
+func (response RemoveWorkspaceMember409Response) VisitRemoveWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+type UpdateWorkspaceMemberRequestObject struct {
+	WorkspaceId WorkspaceId `json:"workspaceId"`
+	UserId      UserId      `json:"userId"`
+	Body        *UpdateWorkspaceMemberJSONRequestBody
+}
+
+type UpdateWorkspaceMemberResponseObject interface {
+	VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error
+}
+
+type UpdateWorkspaceMember200JSONResponse WorkspaceMember
+
+func (response UpdateWorkspaceMember200JSONResponse) VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateWorkspaceMember400Response struct {
+}
+
+func (response UpdateWorkspaceMember400Response) VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type UpdateWorkspaceMember401Response struct {
+}
+
+func (response UpdateWorkspaceMember401Response) VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(401)
+	return nil
+}
+
+type UpdateWorkspaceMember403Response struct {
+}
+
+func (response UpdateWorkspaceMember403Response) VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type UpdateWorkspaceMember404Response struct {
+}
+
+func (response UpdateWorkspaceMember404Response) VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(404)
+	return nil
+}
+
+type UpdateWorkspaceMember409Response struct {
+}
+
+func (response UpdateWorkspaceMember409Response) VisitUpdateWorkspaceMemberResponse(w http.ResponseWriter) error {
+	w.WriteHeader(409)
+	return nil
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Describe server capabilities
+	// (GET /about.json)
+	GetAbout(ctx context.Context, request GetAboutRequestObject) (GetAboutResponseObject, error)
+	// Publish an automation template
+	// (POST /v1/admin/templates)
+	CreateAreaTemplate(ctx context.Context, request CreateAreaTemplateRequestObject) (CreateAreaTemplateResponseObject, error)
+	// Unpublish an automation template
+	// (DELETE /v1/admin/templates/{templateId})
+	DeleteAreaTemplate(ctx context.Context, request DeleteAreaTemplateRequestObject) (DeleteAreaTemplateResponseObject, error)
+	// Update user email
+	// (PATCH /v1/admin/users/{userId}/email)
+	AdminUpdateUserEmail(ctx context.Context, request AdminUpdateUserEmailRequestObject) (AdminUpdateUserEmailResponseObject, error)
+	// Reset user password
+	// (PATCH /v1/admin/users/{userId}/password)
+	AdminResetUserPassword(ctx context.Context, request AdminResetUserPasswordRequestObject) (AdminResetUserPasswordResponseObject, error)
+	// Update user status
+	// (PATCH /v1/admin/users/{userId}/status)
+	AdminUpdateUserStatus(ctx context.Context, request AdminUpdateUserStatusRequestObject) (AdminUpdateUserStatusResponseObject, error)
+	// List automations owned by or shared with the current user
+	// (GET /v1/areas)
+	ListAreas(ctx context.Context, request ListAreasRequestObject) (ListAreasResponseObject, error)
+	// Create a new automation for the current user
+	// (POST /v1/areas)
+	CreateArea(ctx context.Context, request CreateAreaRequestObject) (CreateAreaResponseObject, error)
+	// Create an automation from a portable document produced by the export endpoint
+	// (POST /v1/areas/import)
+	ImportArea(ctx context.Context, request ImportAreaRequestObject) (ImportAreaResponseObject, error)
+	// Delete an automation owned by the current user
+	// (DELETE /v1/areas/{areaId})
+	DeleteArea(ctx context.Context, request DeleteAreaRequestObject) (DeleteAreaResponseObject, error)
+	// Retrieve an automation owned by the current user
+	// (GET /v1/areas/{areaId})
+	GetArea(ctx context.Context, request GetAreaRequestObject) (GetAreaResponseObject, error)
+	// Update an automation owned by the current user
+	// (PATCH /v1/areas/{areaId})
+	UpdateArea(ctx context.Context, request UpdateAreaRequestObject) (UpdateAreaResponseObject, error)
+	// Restrict when an automation reacts to events
+	// (PUT /v1/areas/{areaId}/activity-schedule)
+	SetAreaActivitySchedule(ctx context.Context, request SetAreaActivityScheduleRequestObject) (SetAreaActivityScheduleResponseObject, error)
+	// Test-fire an automation without side effects
+	// (POST /v1/areas/{areaId}/dry-run)
+	DryRunArea(ctx context.Context, request DryRunAreaRequestObject) (DryRunAreaResponseObject, error)
+	// Duplicate an automation and persist the copy for the current user
+	// (POST /v1/areas/{areaId}/duplicate)
+	DuplicateArea(ctx context.Context, request DuplicateAreaRequestObject) (DuplicateAreaResponseObject, error)
+	// Execute area reactions immediately
+	// (POST /v1/areas/{areaId}/execute)
+	ExecuteArea(ctx context.Context, request ExecuteAreaRequestObject) (ExecuteAreaResponseObject, error)
+	// Export an automation as a portable JSON or YAML document
+	// (GET /v1/areas/{areaId}/export)
+	ExportArea(ctx context.Context, request ExportAreaRequestObject) (ExportAreaResponseObject, error)
+	// File an automation in one of the current user's folders
+	// (PUT /v1/areas/{areaId}/folder)
+	MoveAreaFolder(ctx context.Context, request MoveAreaFolderRequestObject) (MoveAreaFolderResponseObject, error)
+	// Configure the circuit breaker of an automation
+	// (PUT /v1/areas/{areaId}/health-policy)
+	SetAreaHealthPolicy(ctx context.Context, request SetAreaHealthPolicyRequestObject) (SetAreaHealthPolicyResponseObject, error)
+	// List recent executions for an automation
+	// (GET /v1/areas/{areaId}/history)
+	ListAreaHistory(ctx context.Context, request ListAreaHistoryRequestObject) (ListAreaHistoryResponseObject, error)
+	// List revisions of an automation
+	// (GET /v1/areas/{areaId}/revisions)
+	ListAreaRevisions(ctx context.Context, request ListAreaRevisionsRequestObject) (ListAreaRevisionsResponseObject, error)
+	// Compare two revisions of an automation
+	// (GET /v1/areas/{areaId}/revisions/diff)
+	DiffAreaRevisions(ctx context.Context, request DiffAreaRevisionsRequestObject) (DiffAreaRevisionsResponseObject, error)
+	// Roll an automation back to a revision
+	// (POST /v1/areas/{areaId}/revisions/{revision}/rollback)
+	RollbackArea(ctx context.Context, request RollbackAreaRequestObject) (RollbackAreaResponseObject, error)
+	// Choose the member whose linked accounts run a shared automation
+	// (PUT /v1/areas/{areaId}/runner)
+	SetAreaRunner(ctx context.Context, request SetAreaRunnerRequestObject) (SetAreaRunnerResponseObject, error)
+	// Update the lifecycle status of an automation
+	// (PATCH /v1/areas/{areaId}/status)
+	UpdateAreaStatus(ctx context.Context, request UpdateAreaStatusRequestObject) (UpdateAreaStatusResponseObject, error)
+	// Detach one of the current user's tags from an automation
+	// (DELETE /v1/areas/{areaId}/tags/{tagId})
+	UntagArea(ctx context.Context, request UntagAreaRequestObject) (UntagAreaResponseObject, error)
+	// Attach one of the current user's tags to an automation
+	// (PUT /v1/areas/{areaId}/tags/{tagId})
+	TagArea(ctx context.Context, request TagAreaRequestObject) (TagAreaResponseObject, error)
+	// Retrieve the URL and secret receiving events for a webhook-triggered automation
+	// (GET /v1/areas/{areaId}/webhook)
+	GetAreaWebhook(ctx context.Context, request GetAreaWebhookRequestObject) (GetAreaWebhookResponseObject, error)
+	// Move an automation into or out of a workspace
+	// (PUT /v1/areas/{areaId}/workspace)
+	MoveAreaWorkspace(ctx context.Context, request MoveAreaWorkspaceRequestObject) (MoveAreaWorkspaceResponseObject, error)
+	// Change account email
+	// (PATCH /v1/auth/email)
+	ChangeEmail(ctx context.Context, request ChangeEmailRequestObject) (ChangeEmailResponseObject, error)
+	// Authenticate using email and password
+	// (POST /v1/auth/login)
+	Login(ctx context.Context, request LoginRequestObject) (LoginResponseObject, error)
+	// Revoke the current session
+	// (POST /v1/auth/logout)
+	Logout(ctx context.Context, request LogoutRequestObject) (LogoutResponseObject, error)
+	// Retrieve the authenticated user profile
+	// (GET /v1/auth/me)
+	GetCurrentUser(ctx context.Context, request GetCurrentUserRequestObject) (GetCurrentUserResponseObject, error)
+	// Change account password
+	// (PATCH /v1/auth/password)
+	ChangePassword(ctx context.Context, request ChangePasswordRequestObject) (ChangePasswordResponseObject, error)
+	// Verify email address and create a session
+	// (POST /v1/auth/verify)
+	VerifyEmail(ctx context.Context, request VerifyEmailRequestObject) (VerifyEmailResponseObject, error)
+	// List service components
+	// (GET /v1/components)
+	ListComponents(ctx context.Context, request ListComponentsRequestObject) (ListComponentsResponseObject, error)
+	// List components available to the current user
+	// (GET /v1/components/available)
+	ListAvailableComponents(ctx context.Context, request ListAvailableComponentsRequestObject) (ListAvailableComponentsResponseObject, error)
+	// Preview the events emitted by a polling action
+	// (POST /v1/components/{componentId}/preview)
+	PreviewComponent(ctx context.Context, request PreviewComponentRequestObject) (PreviewComponentResponseObject, error)
+	// List the folders of the current user
+	// (GET /v1/folders)
+	ListFolders(ctx context.Context, request ListFoldersRequestObject) (ListFoldersResponseObject, error)
+	// Create a folder for the current user
+	// (POST /v1/folders)
+	CreateFolder(ctx context.Context, request CreateFolderRequestObject) (CreateFolderResponseObject, error)
+	// Delete a folder
+	// (DELETE /v1/folders/{folderId})
+	DeleteFolder(ctx context.Context, request DeleteFolderRequestObject) (DeleteFolderResponseObject, error)
+	// Rename a folder
+	// (PATCH /v1/folders/{folderId})
+	UpdateFolder(ctx context.Context, request UpdateFolderRequestObject) (UpdateFolderResponseObject, error)
+	// List connected identities
+	// (GET /v1/identities)
+	ListIdentities(ctx context.Context, request ListIdentitiesRequestObject) (ListIdentitiesResponseObject, error)
+	// Initiate OAuth authorization
+	// (POST /v1/oauth/{provider}/authorize)
+	AuthorizeOAuth(ctx context.Context, request AuthorizeOAuthRequestObject) (AuthorizeOAuthResponseObject, error)
+	// Exchange authorization code for session
+	// (POST /v1/oauth/{provider}/exchange)
+	ExchangeOAuth(ctx context.Context, request ExchangeOAuthRequestObject) (ExchangeOAuthResponseObject, error)
+	// List available service providers
+	// (GET /v1/services)
+	ListServiceProviders(ctx context.Context, request ListServiceProvidersRequestObject) (ListServiceProvidersResponseObject, error)
+	// List service subscriptions for the current user
+	// (GET /v1/services/subscriptions)
+	ListServiceSubscriptions(ctx context.Context, request ListServiceSubscriptionsRequestObject) (ListServiceSubscriptionsResponseObject, error)
+	// Subscribe current user to service provider
+	// (POST /v1/services/{provider}/subscribe)
+	SubscribeService(ctx context.Context, request SubscribeServiceRequestObject) (SubscribeServiceResponseObject, error)
+	// Complete service subscription exchange
+	// (POST /v1/services/{provider}/subscribe/exchange)
+	SubscribeServiceExchange(ctx context.Context, request SubscribeServiceExchangeRequestObject) (SubscribeServiceExchangeResponseObject, error)
+	// Revoke a service subscription
+	// (DELETE /v1/services/{provider}/subscription)
+	UnsubscribeService(ctx context.Context, request UnsubscribeServiceRequestObject) (UnsubscribeServiceResponseObject, error)
+	// List the tags of the current user
+	// (GET /v1/tags)
+	ListTags(ctx context.Context, request ListTagsRequestObject) (ListTagsResponseObject, error)
+	// Create a tag for the current user
+	// (POST /v1/tags)
+	CreateTag(ctx context.Context, request CreateTagRequestObject) (CreateTagResponseObject, error)
+	// Delete a tag
+	// (DELETE /v1/tags/{tagId})
+	DeleteTag(ctx context.Context, request DeleteTagRequestObject) (DeleteTagResponseObject, error)
+	// Rename or recolor a tag
+	// (PATCH /v1/tags/{tagId})
+	UpdateTag(ctx context.Context, request UpdateTagRequestObject) (UpdateTagResponseObject, error)
+	// Enable, disable, archive or delete every automation carrying a tag
+	// (POST /v1/tags/{tagId}/bulk)
+	BulkTagAction(ctx context.Context, request BulkTagActionRequestObject) (BulkTagActionResponseObject, error)
+	// List automation templates
+	// (GET /v1/templates)
+	ListAreaTemplates(ctx context.Context, request ListAreaTemplatesRequestObject) (ListAreaTemplatesResponseObject, error)
+	// Get an automation template
+	// (GET /v1/templates/{templateId})
+	GetAreaTemplate(ctx context.Context, request GetAreaTemplateRequestObject) (GetAreaTemplateResponseObject, error)
+	// Create an automation from a template
+	// (POST /v1/templates/{templateId}/instantiate)
+	InstantiateAreaTemplate(ctx context.Context, request InstantiateAreaTemplateRequestObject) (InstantiateAreaTemplateResponseObject, error)
+	// Register a new user
+	// (POST /v1/users)
+	RegisterUser(ctx context.Context, request RegisterUserRequestObject) (RegisterUserResponseObject, error)
+	// List the workspaces of the current user
+	// (GET /v1/workspaces)
+	ListWorkspaces(ctx context.Context, request ListWorkspacesRequestObject) (ListWorkspacesResponseObject, error)
+	// Create a workspace owned by the current user
+	// (POST /v1/workspaces)
+	CreateWorkspace(ctx context.Context, request CreateWorkspaceRequestObject) (CreateWorkspaceResponseObject, error)
+	// Delete a workspace
+	// (DELETE /v1/workspaces/{workspaceId})
+	DeleteWorkspace(ctx context.Context, request DeleteWorkspaceRequestObject) (DeleteWorkspaceResponseObject, error)
+	// Get a workspace and its members
+	// (GET /v1/workspaces/{workspaceId})
+	GetWorkspace(ctx context.Context, request GetWorkspaceRequestObject) (GetWorkspaceResponseObject, error)
+	// Add a registered user to a workspace
+	// (POST /v1/workspaces/{workspaceId}/members)
+	AddWorkspaceMember(ctx context.Context, request AddWorkspaceMemberRequestObject) (AddWorkspaceMemberResponseObject, error)
+	// Remove a member from a workspace
+	// (DELETE /v1/workspaces/{workspaceId}/members/{userId})
+	RemoveWorkspaceMember(ctx context.Context, request RemoveWorkspaceMemberRequestObject) (RemoveWorkspaceMemberResponseObject, error)
+	// Change the role of a workspace member
+	// (PATCH /v1/workspaces/{workspaceId}/members/{userId})
+	UpdateWorkspaceMember(ctx context.Context, request UpdateWorkspaceMemberRequestObject) (UpdateWorkspaceMemberResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// GetAbout operation middleware
+func (sh *strictHandler) GetAbout(ctx *gin.Context) {
+	var request GetAboutRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAbout(ctx, request.(GetAboutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAbout")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAboutResponseObject); ok {
+		if err := validResponse.VisitGetAboutResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAreaTemplate operation middleware
+func (sh *strictHandler) CreateAreaTemplate(ctx *gin.Context) {
+	var request CreateAreaTemplateRequestObject
+
+	var body CreateAreaTemplateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAreaTemplate(ctx, request.(CreateAreaTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAreaTemplate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateAreaTemplateResponseObject); ok {
+		if err := validResponse.VisitCreateAreaTemplateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAreaTemplate operation middleware
+func (sh *strictHandler) DeleteAreaTemplate(ctx *gin.Context, templateId TemplateId) {
+	var request DeleteAreaTemplateRequestObject
+
+	request.TemplateId = templateId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAreaTemplate(ctx, request.(DeleteAreaTemplateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAreaTemplate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAreaTemplateResponseObject); ok {
+		if err := validResponse.VisitDeleteAreaTemplateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminUpdateUserEmail operation middleware
+func (sh *strictHandler) AdminUpdateUserEmail(ctx *gin.Context, userId UserId) {
+	var request AdminUpdateUserEmailRequestObject
+
+	request.UserId = userId
+
+	var body AdminUpdateUserEmailJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminUpdateUserEmail(ctx, request.(AdminUpdateUserEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminUpdateUserEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AdminUpdateUserEmailResponseObject); ok {
+		if err := validResponse.VisitAdminUpdateUserEmailResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminResetUserPassword operation middleware
+func (sh *strictHandler) AdminResetUserPassword(ctx *gin.Context, userId UserId) {
+	var request AdminResetUserPasswordRequestObject
+
+	request.UserId = userId
+
+	var body AdminResetUserPasswordJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminResetUserPassword(ctx, request.(AdminResetUserPasswordRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminResetUserPassword")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AdminResetUserPasswordResponseObject); ok {
+		if err := validResponse.VisitAdminResetUserPasswordResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AdminUpdateUserStatus operation middleware
+func (sh *strictHandler) AdminUpdateUserStatus(ctx *gin.Context, userId UserId) {
+	var request AdminUpdateUserStatusRequestObject
+
+	request.UserId = userId
+
+	var body AdminUpdateUserStatusJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.AdminUpdateUserStatus(ctx, request.(AdminUpdateUserStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "AdminUpdateUserStatus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(AdminUpdateUserStatusResponseObject); ok {
+		if err := validResponse.VisitAdminUpdateUserStatusResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAreas operation middleware
+func (sh *strictHandler) ListAreas(ctx *gin.Context, params ListAreasParams) {
+	var request ListAreasRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAreas(ctx, request.(ListAreasRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAreas")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListAreasResponseObject); ok {
+		if err := validResponse.VisitListAreasResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateArea operation middleware
+func (sh *strictHandler) CreateArea(ctx *gin.Context) {
+	var request CreateAreaRequestObject
+
+	var body CreateAreaJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateArea(ctx, request.(CreateAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateArea")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(CreateAreaResponseObject); ok {
+		if err := validResponse.VisitCreateAreaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ImportArea operation middleware
+func (sh *strictHandler) ImportArea(ctx *gin.Context) {
+	var request ImportAreaRequestObject
+
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/json") {
+
+		var body ImportAreaJSONRequestBody
+		if err := ctx.ShouldBindJSON(&body); err != nil {
+			ctx.Status(http.StatusBadRequest)
+			ctx.Error(err)
+			return
+		}
+		request.JSONBody = &body
+	}
+	if strings.HasPrefix(ctx.GetHeader("Content-Type"), "application/yaml") {
+		request.Body = ctx.Request.Body
+	}
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ImportArea(ctx, request.(ImportAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ImportArea")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ImportAreaResponseObject); ok {
+		if err := validResponse.VisitImportAreaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteArea operation middleware
+func (sh *strictHandler) DeleteArea(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request DeleteAreaRequestObject
+
+	request.AreaId = areaId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteArea(ctx, request.(DeleteAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteArea")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteAreaResponseObject); ok {
+		if err := validResponse.VisitDeleteAreaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetArea operation middleware
+func (sh *strictHandler) GetArea(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request GetAreaRequestObject
+
+	request.AreaId = areaId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetArea(ctx, request.(GetAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetArea")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAreaResponseObject); ok {
+		if err := validResponse.VisitGetAreaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateArea operation middleware
+func (sh *strictHandler) UpdateArea(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request UpdateAreaRequestObject
+
+	request.AreaId = areaId
+
+	var body UpdateAreaJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateArea(ctx, request.(UpdateAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateArea")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateAreaResponseObject); ok {
+		if err := validResponse.VisitUpdateAreaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetAreaActivitySchedule operation middleware
+func (sh *strictHandler) SetAreaActivitySchedule(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request SetAreaActivityScheduleRequestObject
+
+	request.AreaId = areaId
+
+	var body SetAreaActivityScheduleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetAreaActivitySchedule(ctx, request.(SetAreaActivityScheduleRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetAreaActivitySchedule")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(SetAreaActivityScheduleResponseObject); ok {
+		if err := validResponse.VisitSetAreaActivityScheduleResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DryRunArea operation middleware
+func (sh *strictHandler) DryRunArea(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request DryRunAreaRequestObject
+
+	request.AreaId = areaId
+
+	var body DryRunAreaJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DryRunArea(ctx, request.(DryRunAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DryRunArea")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DryRunAreaResponseObject); ok {
+		if err := validResponse.VisitDryRunAreaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DuplicateArea operation middleware
+func (sh *strictHandler) DuplicateArea(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request DuplicateAreaRequestObject
+
+	request.AreaId = areaId
+
+	var body DuplicateAreaJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DuplicateArea(ctx, request.(DuplicateAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DuplicateArea")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DuplicateAreaResponseObject); ok {
+		if err := validResponse.VisitDuplicateAreaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExecuteArea operation middleware
+func (sh *strictHandler) ExecuteArea(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request ExecuteAreaRequestObject
+
+	request.AreaId = areaId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ExecuteArea(ctx, request.(ExecuteAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExecuteArea")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ExecuteAreaResponseObject); ok {
+		if err := validResponse.VisitExecuteAreaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ExportArea operation middleware
+func (sh *strictHandler) ExportArea(ctx *gin.Context, areaId openapi_types.UUID, params ExportAreaParams) {
+	var request ExportAreaRequestObject
+
+	request.AreaId = areaId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ExportArea(ctx, request.(ExportAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ExportArea")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ExportAreaResponseObject); ok {
+		if err := validResponse.VisitExportAreaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MoveAreaFolder operation middleware
+func (sh *strictHandler) MoveAreaFolder(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request MoveAreaFolderRequestObject
+
+	request.AreaId = areaId
+
+	var body MoveAreaFolderJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MoveAreaFolder(ctx, request.(MoveAreaFolderRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MoveAreaFolder")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(MoveAreaFolderResponseObject); ok {
+		if err := validResponse.VisitMoveAreaFolderResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetAreaHealthPolicy operation middleware
+func (sh *strictHandler) SetAreaHealthPolicy(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request SetAreaHealthPolicyRequestObject
+
+	request.AreaId = areaId
+
+	var body SetAreaHealthPolicyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetAreaHealthPolicy(ctx, request.(SetAreaHealthPolicyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetAreaHealthPolicy")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(SetAreaHealthPolicyResponseObject); ok {
+		if err := validResponse.VisitSetAreaHealthPolicyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAreaHistory operation middleware
+func (sh *strictHandler) ListAreaHistory(ctx *gin.Context, areaId openapi_types.UUID, params ListAreaHistoryParams) {
+	var request ListAreaHistoryRequestObject
+
+	request.AreaId = areaId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAreaHistory(ctx, request.(ListAreaHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAreaHistory")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListAreaHistoryResponseObject); ok {
+		if err := validResponse.VisitListAreaHistoryResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAreaRevisions operation middleware
+func (sh *strictHandler) ListAreaRevisions(ctx *gin.Context, areaId openapi_types.UUID, params ListAreaRevisionsParams) {
+	var request ListAreaRevisionsRequestObject
+
+	request.AreaId = areaId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAreaRevisions(ctx, request.(ListAreaRevisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAreaRevisions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListAreaRevisionsResponseObject); ok {
+		if err := validResponse.VisitListAreaRevisionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DiffAreaRevisions operation middleware
+func (sh *strictHandler) DiffAreaRevisions(ctx *gin.Context, areaId openapi_types.UUID, params DiffAreaRevisionsParams) {
+	var request DiffAreaRevisionsRequestObject
+
+	request.AreaId = areaId
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DiffAreaRevisions(ctx, request.(DiffAreaRevisionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DiffAreaRevisions")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DiffAreaRevisionsResponseObject); ok {
+		if err := validResponse.VisitDiffAreaRevisionsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RollbackArea operation middleware
+func (sh *strictHandler) RollbackArea(ctx *gin.Context, areaId openapi_types.UUID, revision int) {
+	var request RollbackAreaRequestObject
+
+	request.AreaId = areaId
+	request.Revision = revision
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.RollbackArea(ctx, request.(RollbackAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RollbackArea")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(RollbackAreaResponseObject); ok {
+		if err := validResponse.VisitRollbackAreaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetAreaRunner operation middleware
+func (sh *strictHandler) SetAreaRunner(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request SetAreaRunnerRequestObject
+
+	request.AreaId = areaId
+
+	var body SetAreaRunnerJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.SetAreaRunner(ctx, request.(SetAreaRunnerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetAreaRunner")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(SetAreaRunnerResponseObject); ok {
+		if err := validResponse.VisitSetAreaRunnerResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateAreaStatus operation middleware
+func (sh *strictHandler) UpdateAreaStatus(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request UpdateAreaStatusRequestObject
+
+	request.AreaId = areaId
+
+	var body UpdateAreaStatusJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAreaStatus(ctx, request.(UpdateAreaStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAreaStatus")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UpdateAreaStatusResponseObject); ok {
+		if err := validResponse.VisitUpdateAreaStatusResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UntagArea operation middleware
+func (sh *strictHandler) UntagArea(ctx *gin.Context, areaId openapi_types.UUID, tagId TagId) {
+	var request UntagAreaRequestObject
+
+	request.AreaId = areaId
+	request.TagId = tagId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.UntagArea(ctx, request.(UntagAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UntagArea")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(UntagAreaResponseObject); ok {
+		if err := validResponse.VisitUntagAreaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TagArea operation middleware
+func (sh *strictHandler) TagArea(ctx *gin.Context, areaId openapi_types.UUID, tagId TagId) {
+	var request TagAreaRequestObject
+
+	request.AreaId = areaId
+	request.TagId = tagId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.TagArea(ctx, request.(TagAreaRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TagArea")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(TagAreaResponseObject); ok {
+		if err := validResponse.VisitTagAreaResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAreaWebhook operation middleware
+func (sh *strictHandler) GetAreaWebhook(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request GetAreaWebhookRequestObject
+
+	request.AreaId = areaId
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAreaWebhook(ctx, request.(GetAreaWebhookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAreaWebhook")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetAreaWebhookResponseObject); ok {
+		if err := validResponse.VisitGetAreaWebhookResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// MoveAreaWorkspace operation middleware
+func (sh *strictHandler) MoveAreaWorkspace(ctx *gin.Context, areaId openapi_types.UUID) {
+	var request MoveAreaWorkspaceRequestObject
+
+	request.AreaId = areaId
+
+	var body MoveAreaWorkspaceJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.MoveAreaWorkspace(ctx, request.(MoveAreaWorkspaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MoveAreaWorkspace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(MoveAreaWorkspaceResponseObject); ok {
+		if err := validResponse.VisitMoveAreaWorkspaceResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ChangeEmail operation middleware
+func (sh *strictHandler) ChangeEmail(ctx *gin.Context) {
+	var request ChangeEmailRequestObject
+
+	var body ChangeEmailJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ChangeEmail(ctx, request.(ChangeEmailRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ChangeEmail")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ChangeEmailResponseObject); ok {
+		if err := validResponse.VisitChangeEmailResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Login operation middleware
+func (sh *strictHandler) Login(ctx *gin.Context) {
+	var request LoginRequestObject
+
+	var body LoginJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Login")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(LoginResponseObject); ok {
+		if err := validResponse.VisitLoginResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+// Logout operation middleware
+func (sh *strictHandler) Logout(ctx *gin.Context) {
+	var request LogoutRequestObject
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Logout")
+	}
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(LogoutResponseObject); ok {
+		if err := validResponse.VisitLogoutResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetAbout operation middleware
-func (sh *strictHandler) GetAbout(ctx *gin.Context) {
-	var request GetAboutRequestObject
+// GetCurrentUser operation middleware
+func (sh *strictHandler) GetCurrentUser(ctx *gin.Context) {
+	var request GetCurrentUserRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAbout(ctx, request.(GetAboutRequestObject))
+		return sh.ssi.GetCurrentUser(ctx, request.(GetCurrentUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAbout")
+		handler = middleware(handler, "GetCurrentUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -2878,8 +7471,8 @@ func (sh *strictHandler) GetAbout(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetAboutResponseObject); ok {
-		if err := validResponse.VisitGetAboutResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetCurrentUserResponseObject); ok {
+		if err := validResponse.VisitGetCurrentUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -2887,13 +7480,11 @@ func (sh *strictHandler) GetAbout(ctx *gin.Context) {
 	}
 }
 
-// AdminUpdateUserEmail operation middleware
-func (sh *strictHandler) AdminUpdateUserEmail(ctx *gin.Context, userId UserId) {
-	var request AdminUpdateUserEmailRequestObject
-
-	request.UserId = userId
+// ChangePassword operation middleware
+func (sh *strictHandler) ChangePassword(ctx *gin.Context) {
+	var request ChangePasswordRequestObject
 
-	var body AdminUpdateUserEmailJSONRequestBody
+	var body ChangePasswordJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -2902,10 +7493,10 @@ func (sh *strictHandler) AdminUpdateUserEmail(ctx *gin.Context, userId UserId) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminUpdateUserEmail(ctx, request.(AdminUpdateUserEmailRequestObject))
+		return sh.ssi.ChangePassword(ctx, request.(ChangePasswordRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminUpdateUserEmail")
+		handler = middleware(handler, "ChangePassword")
 	}
 
 	response, err := handler(ctx, request)
@@ -2913,8 +7504,8 @@ func (sh *strictHandler) AdminUpdateUserEmail(ctx *gin.Context, userId UserId) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AdminUpdateUserEmailResponseObject); ok {
-		if err := validResponse.VisitAdminUpdateUserEmailResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ChangePasswordResponseObject); ok {
+		if err := validResponse.VisitChangePasswordResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -2922,13 +7513,11 @@ func (sh *strictHandler) AdminUpdateUserEmail(ctx *gin.Context, userId UserId) {
 	}
 }
 
-// AdminResetUserPassword operation middleware
-func (sh *strictHandler) AdminResetUserPassword(ctx *gin.Context, userId UserId) {
-	var request AdminResetUserPasswordRequestObject
-
-	request.UserId = userId
+// VerifyEmail operation middleware
+func (sh *strictHandler) VerifyEmail(ctx *gin.Context) {
+	var request VerifyEmailRequestObject
 
-	var body AdminResetUserPasswordJSONRequestBody
+	var body VerifyEmailJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -2937,10 +7526,10 @@ func (sh *strictHandler) AdminResetUserPassword(ctx *gin.Context, userId UserId)
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminResetUserPassword(ctx, request.(AdminResetUserPasswordRequestObject))
+		return sh.ssi.VerifyEmail(ctx, request.(VerifyEmailRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminResetUserPassword")
+		handler = middleware(handler, "VerifyEmail")
 	}
 
 	response, err := handler(ctx, request)
@@ -2948,8 +7537,8 @@ func (sh *strictHandler) AdminResetUserPassword(ctx *gin.Context, userId UserId)
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AdminResetUserPasswordResponseObject); ok {
-		if err := validResponse.VisitAdminResetUserPasswordResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(VerifyEmailResponseObject); ok {
+		if err := validResponse.VisitVerifyEmailResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -2957,13 +7546,67 @@ func (sh *strictHandler) AdminResetUserPassword(ctx *gin.Context, userId UserId)
 	}
 }
 
-// AdminUpdateUserStatus operation middleware
-func (sh *strictHandler) AdminUpdateUserStatus(ctx *gin.Context, userId UserId) {
-	var request AdminUpdateUserStatusRequestObject
+// ListComponents operation middleware
+func (sh *strictHandler) ListComponents(ctx *gin.Context, params ListComponentsParams) {
+	var request ListComponentsRequestObject
 
-	request.UserId = userId
+	request.Params = params
 
-	var body AdminUpdateUserStatusJSONRequestBody
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListComponents(ctx, request.(ListComponentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListComponents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListComponentsResponseObject); ok {
+		if err := validResponse.VisitListComponentsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ListAvailableComponents operation middleware
+func (sh *strictHandler) ListAvailableComponents(ctx *gin.Context, params ListAvailableComponentsParams) {
+	var request ListAvailableComponentsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListAvailableComponents(ctx, request.(ListAvailableComponentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListAvailableComponents")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListAvailableComponentsResponseObject); ok {
+		if err := validResponse.VisitListAvailableComponentsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PreviewComponent operation middleware
+func (sh *strictHandler) PreviewComponent(ctx *gin.Context, componentId openapi_types.UUID) {
+	var request PreviewComponentRequestObject
+
+	request.ComponentId = componentId
+
+	var body PreviewComponentJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -2972,10 +7615,10 @@ func (sh *strictHandler) AdminUpdateUserStatus(ctx *gin.Context, userId UserId)
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AdminUpdateUserStatus(ctx, request.(AdminUpdateUserStatusRequestObject))
+		return sh.ssi.PreviewComponent(ctx, request.(PreviewComponentRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AdminUpdateUserStatus")
+		handler = middleware(handler, "PreviewComponent")
 	}
 
 	response, err := handler(ctx, request)
@@ -2983,8 +7626,8 @@ func (sh *strictHandler) AdminUpdateUserStatus(ctx *gin.Context, userId UserId)
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AdminUpdateUserStatusResponseObject); ok {
-		if err := validResponse.VisitAdminUpdateUserStatusResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PreviewComponentResponseObject); ok {
+		if err := validResponse.VisitPreviewComponentResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -2992,15 +7635,15 @@ func (sh *strictHandler) AdminUpdateUserStatus(ctx *gin.Context, userId UserId)
 	}
 }
 
-// ListAreas operation middleware
-func (sh *strictHandler) ListAreas(ctx *gin.Context) {
-	var request ListAreasRequestObject
+// ListFolders operation middleware
+func (sh *strictHandler) ListFolders(ctx *gin.Context) {
+	var request ListFoldersRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAreas(ctx, request.(ListAreasRequestObject))
+		return sh.ssi.ListFolders(ctx, request.(ListFoldersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAreas")
+		handler = middleware(handler, "ListFolders")
 	}
 
 	response, err := handler(ctx, request)
@@ -3008,8 +7651,8 @@ func (sh *strictHandler) ListAreas(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListAreasResponseObject); ok {
-		if err := validResponse.VisitListAreasResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListFoldersResponseObject); ok {
+		if err := validResponse.VisitListFoldersResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3017,11 +7660,11 @@ func (sh *strictHandler) ListAreas(ctx *gin.Context) {
 	}
 }
 
-// CreateArea operation middleware
-func (sh *strictHandler) CreateArea(ctx *gin.Context) {
-	var request CreateAreaRequestObject
+// CreateFolder operation middleware
+func (sh *strictHandler) CreateFolder(ctx *gin.Context) {
+	var request CreateFolderRequestObject
 
-	var body CreateAreaJSONRequestBody
+	var body CreateFolderJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3030,10 +7673,10 @@ func (sh *strictHandler) CreateArea(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateArea(ctx, request.(CreateAreaRequestObject))
+		return sh.ssi.CreateFolder(ctx, request.(CreateFolderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateArea")
+		handler = middleware(handler, "CreateFolder")
 	}
 
 	response, err := handler(ctx, request)
@@ -3041,8 +7684,8 @@ func (sh *strictHandler) CreateArea(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(CreateAreaResponseObject); ok {
-		if err := validResponse.VisitCreateAreaResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateFolderResponseObject); ok {
+		if err := validResponse.VisitCreateFolderResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3050,17 +7693,17 @@ func (sh *strictHandler) CreateArea(ctx *gin.Context) {
 	}
 }
 
-// DeleteArea operation middleware
-func (sh *strictHandler) DeleteArea(ctx *gin.Context, areaId openapi_types.UUID) {
-	var request DeleteAreaRequestObject
+// DeleteFolder operation middleware
+func (sh *strictHandler) DeleteFolder(ctx *gin.Context, folderId FolderId) {
+	var request DeleteFolderRequestObject
 
-	request.AreaId = areaId
+	request.FolderId = folderId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteArea(ctx, request.(DeleteAreaRequestObject))
+		return sh.ssi.DeleteFolder(ctx, request.(DeleteFolderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteArea")
+		handler = middleware(handler, "DeleteFolder")
 	}
 
 	response, err := handler(ctx, request)
@@ -3068,8 +7711,8 @@ func (sh *strictHandler) DeleteArea(ctx *gin.Context, areaId openapi_types.UUID)
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DeleteAreaResponseObject); ok {
-		if err := validResponse.VisitDeleteAreaResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteFolderResponseObject); ok {
+		if err := validResponse.VisitDeleteFolderResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3077,17 +7720,25 @@ func (sh *strictHandler) DeleteArea(ctx *gin.Context, areaId openapi_types.UUID)
 	}
 }
 
-// GetArea operation middleware
-func (sh *strictHandler) GetArea(ctx *gin.Context, areaId openapi_types.UUID) {
-	var request GetAreaRequestObject
+// UpdateFolder operation middleware
+func (sh *strictHandler) UpdateFolder(ctx *gin.Context, folderId FolderId) {
+	var request UpdateFolderRequestObject
 
-	request.AreaId = areaId
+	request.FolderId = folderId
+
+	var body UpdateFolderJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetArea(ctx, request.(GetAreaRequestObject))
+		return sh.ssi.UpdateFolder(ctx, request.(UpdateFolderRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetArea")
+		handler = middleware(handler, "UpdateFolder")
 	}
 
 	response, err := handler(ctx, request)
@@ -3095,8 +7746,8 @@ func (sh *strictHandler) GetArea(ctx *gin.Context, areaId openapi_types.UUID) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetAreaResponseObject); ok {
-		if err := validResponse.VisitGetAreaResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateFolderResponseObject); ok {
+		if err := validResponse.VisitUpdateFolderResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3104,13 +7755,38 @@ func (sh *strictHandler) GetArea(ctx *gin.Context, areaId openapi_types.UUID) {
 	}
 }
 
-// UpdateArea operation middleware
-func (sh *strictHandler) UpdateArea(ctx *gin.Context, areaId openapi_types.UUID) {
-	var request UpdateAreaRequestObject
+// ListIdentities operation middleware
+func (sh *strictHandler) ListIdentities(ctx *gin.Context) {
+	var request ListIdentitiesRequestObject
 
-	request.AreaId = areaId
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.ListIdentities(ctx, request.(ListIdentitiesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ListIdentities")
+	}
 
-	var body UpdateAreaJSONRequestBody
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(ListIdentitiesResponseObject); ok {
+		if err := validResponse.VisitListIdentitiesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// AuthorizeOAuth operation middleware
+func (sh *strictHandler) AuthorizeOAuth(ctx *gin.Context, provider OAuthProvider) {
+	var request AuthorizeOAuthRequestObject
+
+	request.Provider = provider
+
+	var body AuthorizeOAuthJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3119,10 +7795,10 @@ func (sh *strictHandler) UpdateArea(ctx *gin.Context, areaId openapi_types.UUID)
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateArea(ctx, request.(UpdateAreaRequestObject))
+		return sh.ssi.AuthorizeOAuth(ctx, request.(AuthorizeOAuthRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateArea")
+		handler = middleware(handler, "AuthorizeOAuth")
 	}
 
 	response, err := handler(ctx, request)
@@ -3130,8 +7806,8 @@ func (sh *strictHandler) UpdateArea(ctx *gin.Context, areaId openapi_types.UUID)
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(UpdateAreaResponseObject); ok {
-		if err := validResponse.VisitUpdateAreaResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(AuthorizeOAuthResponseObject); ok {
+		if err := validResponse.VisitAuthorizeOAuthResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3139,13 +7815,13 @@ func (sh *strictHandler) UpdateArea(ctx *gin.Context, areaId openapi_types.UUID)
 	}
 }
 
-// DuplicateArea operation middleware
-func (sh *strictHandler) DuplicateArea(ctx *gin.Context, areaId openapi_types.UUID) {
-	var request DuplicateAreaRequestObject
+// ExchangeOAuth operation middleware
+func (sh *strictHandler) ExchangeOAuth(ctx *gin.Context, provider OAuthProvider) {
+	var request ExchangeOAuthRequestObject
 
-	request.AreaId = areaId
+	request.Provider = provider
 
-	var body DuplicateAreaJSONRequestBody
+	var body ExchangeOAuthJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3154,10 +7830,10 @@ func (sh *strictHandler) DuplicateArea(ctx *gin.Context, areaId openapi_types.UU
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.DuplicateArea(ctx, request.(DuplicateAreaRequestObject))
+		return sh.ssi.ExchangeOAuth(ctx, request.(ExchangeOAuthRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DuplicateArea")
+		handler = middleware(handler, "ExchangeOAuth")
 	}
 
 	response, err := handler(ctx, request)
@@ -3165,8 +7841,8 @@ func (sh *strictHandler) DuplicateArea(ctx *gin.Context, areaId openapi_types.UU
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(DuplicateAreaResponseObject); ok {
-		if err := validResponse.VisitDuplicateAreaResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ExchangeOAuthResponseObject); ok {
+		if err := validResponse.VisitExchangeOAuthResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3174,17 +7850,15 @@ func (sh *strictHandler) DuplicateArea(ctx *gin.Context, areaId openapi_types.UU
 	}
 }
 
-// ExecuteArea operation middleware
-func (sh *strictHandler) ExecuteArea(ctx *gin.Context, areaId openapi_types.UUID) {
-	var request ExecuteAreaRequestObject
-
-	request.AreaId = areaId
+// ListServiceProviders operation middleware
+func (sh *strictHandler) ListServiceProviders(ctx *gin.Context) {
+	var request ListServiceProvidersRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ExecuteArea(ctx, request.(ExecuteAreaRequestObject))
+		return sh.ssi.ListServiceProviders(ctx, request.(ListServiceProvidersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ExecuteArea")
+		handler = middleware(handler, "ListServiceProviders")
 	}
 
 	response, err := handler(ctx, request)
@@ -3192,8 +7866,8 @@ func (sh *strictHandler) ExecuteArea(ctx *gin.Context, areaId openapi_types.UUID
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ExecuteAreaResponseObject); ok {
-		if err := validResponse.VisitExecuteAreaResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListServiceProvidersResponseObject); ok {
+		if err := validResponse.VisitListServiceProvidersResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3201,18 +7875,15 @@ func (sh *strictHandler) ExecuteArea(ctx *gin.Context, areaId openapi_types.UUID
 	}
 }
 
-// ListAreaHistory operation middleware
-func (sh *strictHandler) ListAreaHistory(ctx *gin.Context, areaId openapi_types.UUID, params ListAreaHistoryParams) {
-	var request ListAreaHistoryRequestObject
-
-	request.AreaId = areaId
-	request.Params = params
+// ListServiceSubscriptions operation middleware
+func (sh *strictHandler) ListServiceSubscriptions(ctx *gin.Context) {
+	var request ListServiceSubscriptionsRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAreaHistory(ctx, request.(ListAreaHistoryRequestObject))
+		return sh.ssi.ListServiceSubscriptions(ctx, request.(ListServiceSubscriptionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAreaHistory")
+		handler = middleware(handler, "ListServiceSubscriptions")
 	}
 
 	response, err := handler(ctx, request)
@@ -3220,8 +7891,8 @@ func (sh *strictHandler) ListAreaHistory(ctx *gin.Context, areaId openapi_types.
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListAreaHistoryResponseObject); ok {
-		if err := validResponse.VisitListAreaHistoryResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListServiceSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitListServiceSubscriptionsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3229,13 +7900,13 @@ func (sh *strictHandler) ListAreaHistory(ctx *gin.Context, areaId openapi_types.
 	}
 }
 
-// UpdateAreaStatus operation middleware
-func (sh *strictHandler) UpdateAreaStatus(ctx *gin.Context, areaId openapi_types.UUID) {
-	var request UpdateAreaStatusRequestObject
+// SubscribeService operation middleware
+func (sh *strictHandler) SubscribeService(ctx *gin.Context, provider OAuthProvider) {
+	var request SubscribeServiceRequestObject
 
-	request.AreaId = areaId
+	request.Provider = provider
 
-	var body UpdateAreaStatusJSONRequestBody
+	var body SubscribeServiceJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3244,10 +7915,10 @@ func (sh *strictHandler) UpdateAreaStatus(ctx *gin.Context, areaId openapi_types
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateAreaStatus(ctx, request.(UpdateAreaStatusRequestObject))
+		return sh.ssi.SubscribeService(ctx, request.(SubscribeServiceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateAreaStatus")
+		handler = middleware(handler, "SubscribeService")
 	}
 
 	response, err := handler(ctx, request)
@@ -3255,8 +7926,8 @@ func (sh *strictHandler) UpdateAreaStatus(ctx *gin.Context, areaId openapi_types
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(UpdateAreaStatusResponseObject); ok {
-		if err := validResponse.VisitUpdateAreaStatusResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(SubscribeServiceResponseObject); ok {
+		if err := validResponse.VisitSubscribeServiceResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3264,11 +7935,13 @@ func (sh *strictHandler) UpdateAreaStatus(ctx *gin.Context, areaId openapi_types
 	}
 }
 
-// ChangeEmail operation middleware
-func (sh *strictHandler) ChangeEmail(ctx *gin.Context) {
-	var request ChangeEmailRequestObject
+// SubscribeServiceExchange operation middleware
+func (sh *strictHandler) SubscribeServiceExchange(ctx *gin.Context, provider OAuthProvider) {
+	var request SubscribeServiceExchangeRequestObject
 
-	var body ChangeEmailJSONRequestBody
+	request.Provider = provider
+
+	var body SubscribeServiceExchangeJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3277,10 +7950,10 @@ func (sh *strictHandler) ChangeEmail(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ChangeEmail(ctx, request.(ChangeEmailRequestObject))
+		return sh.ssi.SubscribeServiceExchange(ctx, request.(SubscribeServiceExchangeRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ChangeEmail")
+		handler = middleware(handler, "SubscribeServiceExchange")
 	}
 
 	response, err := handler(ctx, request)
@@ -3288,8 +7961,8 @@ func (sh *strictHandler) ChangeEmail(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ChangeEmailResponseObject); ok {
-		if err := validResponse.VisitChangeEmailResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(SubscribeServiceExchangeResponseObject); ok {
+		if err := validResponse.VisitSubscribeServiceExchangeResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3297,23 +7970,17 @@ func (sh *strictHandler) ChangeEmail(ctx *gin.Context) {
 	}
 }
 
-// Login operation middleware
-func (sh *strictHandler) Login(ctx *gin.Context) {
-	var request LoginRequestObject
+// UnsubscribeService operation middleware
+func (sh *strictHandler) UnsubscribeService(ctx *gin.Context, provider OAuthProvider) {
+	var request UnsubscribeServiceRequestObject
 
-	var body LoginJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.Provider = provider
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+		return sh.ssi.UnsubscribeService(ctx, request.(UnsubscribeServiceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Login")
+		handler = middleware(handler, "UnsubscribeService")
 	}
 
 	response, err := handler(ctx, request)
@@ -3321,8 +7988,8 @@ func (sh *strictHandler) Login(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(LoginResponseObject); ok {
-		if err := validResponse.VisitLoginResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UnsubscribeServiceResponseObject); ok {
+		if err := validResponse.VisitUnsubscribeServiceResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3330,15 +7997,15 @@ func (sh *strictHandler) Login(ctx *gin.Context) {
 	}
 }
 
-// Logout operation middleware
-func (sh *strictHandler) Logout(ctx *gin.Context) {
-	var request LogoutRequestObject
+// ListTags operation middleware
+func (sh *strictHandler) ListTags(ctx *gin.Context) {
+	var request ListTagsRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+		return sh.ssi.ListTags(ctx, request.(ListTagsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Logout")
+		handler = middleware(handler, "ListTags")
 	}
 
 	response, err := handler(ctx, request)
@@ -3346,8 +8013,8 @@ func (sh *strictHandler) Logout(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(LogoutResponseObject); ok {
-		if err := validResponse.VisitLogoutResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListTagsResponseObject); ok {
+		if err := validResponse.VisitListTagsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3355,15 +8022,23 @@ func (sh *strictHandler) Logout(ctx *gin.Context) {
 	}
 }
 
-// GetCurrentUser operation middleware
-func (sh *strictHandler) GetCurrentUser(ctx *gin.Context) {
-	var request GetCurrentUserRequestObject
+// CreateTag operation middleware
+func (sh *strictHandler) CreateTag(ctx *gin.Context) {
+	var request CreateTagRequestObject
+
+	var body CreateTagJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCurrentUser(ctx, request.(GetCurrentUserRequestObject))
+		return sh.ssi.CreateTag(ctx, request.(CreateTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCurrentUser")
+		handler = middleware(handler, "CreateTag")
 	}
 
 	response, err := handler(ctx, request)
@@ -3371,8 +8046,8 @@ func (sh *strictHandler) GetCurrentUser(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetCurrentUserResponseObject); ok {
-		if err := validResponse.VisitGetCurrentUserResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateTagResponseObject); ok {
+		if err := validResponse.VisitCreateTagResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3380,23 +8055,17 @@ func (sh *strictHandler) GetCurrentUser(ctx *gin.Context) {
 	}
 }
 
-// ChangePassword operation middleware
-func (sh *strictHandler) ChangePassword(ctx *gin.Context) {
-	var request ChangePasswordRequestObject
+// DeleteTag operation middleware
+func (sh *strictHandler) DeleteTag(ctx *gin.Context, tagId TagId) {
+	var request DeleteTagRequestObject
 
-	var body ChangePasswordJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
-		ctx.Error(err)
-		return
-	}
-	request.Body = &body
+	request.TagId = tagId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ChangePassword(ctx, request.(ChangePasswordRequestObject))
+		return sh.ssi.DeleteTag(ctx, request.(DeleteTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ChangePassword")
+		handler = middleware(handler, "DeleteTag")
 	}
 
 	response, err := handler(ctx, request)
@@ -3404,8 +8073,8 @@ func (sh *strictHandler) ChangePassword(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ChangePasswordResponseObject); ok {
-		if err := validResponse.VisitChangePasswordResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(DeleteTagResponseObject); ok {
+		if err := validResponse.VisitDeleteTagResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3413,11 +8082,13 @@ func (sh *strictHandler) ChangePassword(ctx *gin.Context) {
 	}
 }
 
-// VerifyEmail operation middleware
-func (sh *strictHandler) VerifyEmail(ctx *gin.Context) {
-	var request VerifyEmailRequestObject
+// UpdateTag operation middleware
+func (sh *strictHandler) UpdateTag(ctx *gin.Context, tagId TagId) {
+	var request UpdateTagRequestObject
 
-	var body VerifyEmailJSONRequestBody
+	request.TagId = tagId
+
+	var body UpdateTagJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3426,10 +8097,10 @@ func (sh *strictHandler) VerifyEmail(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.VerifyEmail(ctx, request.(VerifyEmailRequestObject))
+		return sh.ssi.UpdateTag(ctx, request.(UpdateTagRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "VerifyEmail")
+		handler = middleware(handler, "UpdateTag")
 	}
 
 	response, err := handler(ctx, request)
@@ -3437,8 +8108,8 @@ func (sh *strictHandler) VerifyEmail(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(VerifyEmailResponseObject); ok {
-		if err := validResponse.VisitVerifyEmailResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateTagResponseObject); ok {
+		if err := validResponse.VisitUpdateTagResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3446,17 +8117,25 @@ func (sh *strictHandler) VerifyEmail(ctx *gin.Context) {
 	}
 }
 
-// ListComponents operation middleware
-func (sh *strictHandler) ListComponents(ctx *gin.Context, params ListComponentsParams) {
-	var request ListComponentsRequestObject
+// BulkTagAction operation middleware
+func (sh *strictHandler) BulkTagAction(ctx *gin.Context, tagId TagId) {
+	var request BulkTagActionRequestObject
 
-	request.Params = params
+	request.TagId = tagId
+
+	var body BulkTagActionJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListComponents(ctx, request.(ListComponentsRequestObject))
+		return sh.ssi.BulkTagAction(ctx, request.(BulkTagActionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListComponents")
+		handler = middleware(handler, "BulkTagAction")
 	}
 
 	response, err := handler(ctx, request)
@@ -3464,8 +8143,8 @@ func (sh *strictHandler) ListComponents(ctx *gin.Context, params ListComponentsP
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListComponentsResponseObject); ok {
-		if err := validResponse.VisitListComponentsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(BulkTagActionResponseObject); ok {
+		if err := validResponse.VisitBulkTagActionResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3473,17 +8152,15 @@ func (sh *strictHandler) ListComponents(ctx *gin.Context, params ListComponentsP
 	}
 }
 
-// ListAvailableComponents operation middleware
-func (sh *strictHandler) ListAvailableComponents(ctx *gin.Context, params ListAvailableComponentsParams) {
-	var request ListAvailableComponentsRequestObject
-
-	request.Params = params
+// ListAreaTemplates operation middleware
+func (sh *strictHandler) ListAreaTemplates(ctx *gin.Context) {
+	var request ListAreaTemplatesRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListAvailableComponents(ctx, request.(ListAvailableComponentsRequestObject))
+		return sh.ssi.ListAreaTemplates(ctx, request.(ListAreaTemplatesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListAvailableComponents")
+		handler = middleware(handler, "ListAreaTemplates")
 	}
 
 	response, err := handler(ctx, request)
@@ -3491,8 +8168,8 @@ func (sh *strictHandler) ListAvailableComponents(ctx *gin.Context, params ListAv
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListAvailableComponentsResponseObject); ok {
-		if err := validResponse.VisitListAvailableComponentsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListAreaTemplatesResponseObject); ok {
+		if err := validResponse.VisitListAreaTemplatesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3500,15 +8177,17 @@ func (sh *strictHandler) ListAvailableComponents(ctx *gin.Context, params ListAv
 	}
 }
 
-// ListIdentities operation middleware
-func (sh *strictHandler) ListIdentities(ctx *gin.Context) {
-	var request ListIdentitiesRequestObject
+// GetAreaTemplate operation middleware
+func (sh *strictHandler) GetAreaTemplate(ctx *gin.Context, templateId TemplateId) {
+	var request GetAreaTemplateRequestObject
+
+	request.TemplateId = templateId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListIdentities(ctx, request.(ListIdentitiesRequestObject))
+		return sh.ssi.GetAreaTemplate(ctx, request.(GetAreaTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListIdentities")
+		handler = middleware(handler, "GetAreaTemplate")
 	}
 
 	response, err := handler(ctx, request)
@@ -3516,8 +8195,8 @@ func (sh *strictHandler) ListIdentities(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListIdentitiesResponseObject); ok {
-		if err := validResponse.VisitListIdentitiesResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetAreaTemplateResponseObject); ok {
+		if err := validResponse.VisitGetAreaTemplateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3525,13 +8204,13 @@ func (sh *strictHandler) ListIdentities(ctx *gin.Context) {
 	}
 }
 
-// AuthorizeOAuth operation middleware
-func (sh *strictHandler) AuthorizeOAuth(ctx *gin.Context, provider OAuthProvider) {
-	var request AuthorizeOAuthRequestObject
+// InstantiateAreaTemplate operation middleware
+func (sh *strictHandler) InstantiateAreaTemplate(ctx *gin.Context, templateId TemplateId) {
+	var request InstantiateAreaTemplateRequestObject
 
-	request.Provider = provider
+	request.TemplateId = templateId
 
-	var body AuthorizeOAuthJSONRequestBody
+	var body InstantiateAreaTemplateJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3540,10 +8219,10 @@ func (sh *strictHandler) AuthorizeOAuth(ctx *gin.Context, provider OAuthProvider
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.AuthorizeOAuth(ctx, request.(AuthorizeOAuthRequestObject))
+		return sh.ssi.InstantiateAreaTemplate(ctx, request.(InstantiateAreaTemplateRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "AuthorizeOAuth")
+		handler = middleware(handler, "InstantiateAreaTemplate")
 	}
 
 	response, err := handler(ctx, request)
@@ -3551,8 +8230,8 @@ func (sh *strictHandler) AuthorizeOAuth(ctx *gin.Context, provider OAuthProvider
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(AuthorizeOAuthResponseObject); ok {
-		if err := validResponse.VisitAuthorizeOAuthResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(InstantiateAreaTemplateResponseObject); ok {
+		if err := validResponse.VisitInstantiateAreaTemplateResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3560,13 +8239,11 @@ func (sh *strictHandler) AuthorizeOAuth(ctx *gin.Context, provider OAuthProvider
 	}
 }
 
-// ExchangeOAuth operation middleware
-func (sh *strictHandler) ExchangeOAuth(ctx *gin.Context, provider OAuthProvider) {
-	var request ExchangeOAuthRequestObject
-
-	request.Provider = provider
+// RegisterUser operation middleware
+func (sh *strictHandler) RegisterUser(ctx *gin.Context) {
+	var request RegisterUserRequestObject
 
-	var body ExchangeOAuthJSONRequestBody
+	var body RegisterUserJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3575,10 +8252,10 @@ func (sh *strictHandler) ExchangeOAuth(ctx *gin.Context, provider OAuthProvider)
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ExchangeOAuth(ctx, request.(ExchangeOAuthRequestObject))
+		return sh.ssi.RegisterUser(ctx, request.(RegisterUserRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ExchangeOAuth")
+		handler = middleware(handler, "RegisterUser")
 	}
 
 	response, err := handler(ctx, request)
@@ -3586,8 +8263,8 @@ func (sh *strictHandler) ExchangeOAuth(ctx *gin.Context, provider OAuthProvider)
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ExchangeOAuthResponseObject); ok {
-		if err := validResponse.VisitExchangeOAuthResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(RegisterUserResponseObject); ok {
+		if err := validResponse.VisitRegisterUserResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3595,15 +8272,15 @@ func (sh *strictHandler) ExchangeOAuth(ctx *gin.Context, provider OAuthProvider)
 	}
 }
 
-// ListServiceProviders operation middleware
-func (sh *strictHandler) ListServiceProviders(ctx *gin.Context) {
-	var request ListServiceProvidersRequestObject
+// ListWorkspaces operation middleware
+func (sh *strictHandler) ListWorkspaces(ctx *gin.Context) {
+	var request ListWorkspacesRequestObject
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListServiceProviders(ctx, request.(ListServiceProvidersRequestObject))
+		return sh.ssi.ListWorkspaces(ctx, request.(ListWorkspacesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListServiceProviders")
+		handler = middleware(handler, "ListWorkspaces")
 	}
 
 	response, err := handler(ctx, request)
@@ -3611,8 +8288,8 @@ func (sh *strictHandler) ListServiceProviders(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListServiceProvidersResponseObject); ok {
-		if err := validResponse.VisitListServiceProvidersResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(ListWorkspacesResponseObject); ok {
+		if err := validResponse.VisitListWorkspacesResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3620,15 +8297,23 @@ func (sh *strictHandler) ListServiceProviders(ctx *gin.Context) {
 	}
 }
 
-// ListServiceSubscriptions operation middleware
-func (sh *strictHandler) ListServiceSubscriptions(ctx *gin.Context) {
-	var request ListServiceSubscriptionsRequestObject
+// CreateWorkspace operation middleware
+func (sh *strictHandler) CreateWorkspace(ctx *gin.Context) {
+	var request CreateWorkspaceRequestObject
+
+	var body CreateWorkspaceJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.ListServiceSubscriptions(ctx, request.(ListServiceSubscriptionsRequestObject))
+		return sh.ssi.CreateWorkspace(ctx, request.(CreateWorkspaceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListServiceSubscriptions")
+		handler = middleware(handler, "CreateWorkspace")
 	}
 
 	response, err := handler(ctx, request)
@@ -3636,8 +8321,8 @@ func (sh *strictHandler) ListServiceSubscriptions(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(ListServiceSubscriptionsResponseObject); ok {
-		if err := validResponse.VisitListServiceSubscriptionsResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(CreateWorkspaceResponseObject); ok {
+		if err := validResponse.VisitCreateWorkspaceResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3645,25 +8330,44 @@ func (sh *strictHandler) ListServiceSubscriptions(ctx *gin.Context) {
 	}
 }
 
-// SubscribeService operation middleware
-func (sh *strictHandler) SubscribeService(ctx *gin.Context, provider OAuthProvider) {
-	var request SubscribeServiceRequestObject
+// DeleteWorkspace operation middleware
+func (sh *strictHandler) DeleteWorkspace(ctx *gin.Context, workspaceId WorkspaceId) {
+	var request DeleteWorkspaceRequestObject
 
-	request.Provider = provider
+	request.WorkspaceId = workspaceId
 
-	var body SubscribeServiceJSONRequestBody
-	if err := ctx.ShouldBindJSON(&body); err != nil {
-		ctx.Status(http.StatusBadRequest)
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteWorkspace(ctx, request.(DeleteWorkspaceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteWorkspace")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
 		ctx.Error(err)
-		return
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(DeleteWorkspaceResponseObject); ok {
+		if err := validResponse.VisitDeleteWorkspaceResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
 	}
-	request.Body = &body
+}
+
+// GetWorkspace operation middleware
+func (sh *strictHandler) GetWorkspace(ctx *gin.Context, workspaceId WorkspaceId) {
+	var request GetWorkspaceRequestObject
+
+	request.WorkspaceId = workspaceId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SubscribeService(ctx, request.(SubscribeServiceRequestObject))
+		return sh.ssi.GetWorkspace(ctx, request.(GetWorkspaceRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SubscribeService")
+		handler = middleware(handler, "GetWorkspace")
 	}
 
 	response, err := handler(ctx, request)
@@ -3671,8 +8375,8 @@ func (sh *strictHandler) SubscribeService(ctx *gin.Context, provider OAuthProvid
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(SubscribeServiceResponseObject); ok {
-		if err := validResponse.VisitSubscribeServiceResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(GetWorkspaceResponseObject); ok {
+		if err := validResponse.VisitGetWorkspaceResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3680,13 +8384,13 @@ func (sh *strictHandler) SubscribeService(ctx *gin.Context, provider OAuthProvid
 	}
 }
 
-// SubscribeServiceExchange operation middleware
-func (sh *strictHandler) SubscribeServiceExchange(ctx *gin.Context, provider OAuthProvider) {
-	var request SubscribeServiceExchangeRequestObject
+// AddWorkspaceMember operation middleware
+func (sh *strictHandler) AddWorkspaceMember(ctx *gin.Context, workspaceId WorkspaceId) {
+	var request AddWorkspaceMemberRequestObject
 
-	request.Provider = provider
+	request.WorkspaceId = workspaceId
 
-	var body SubscribeServiceExchangeJSONRequestBody
+	var body AddWorkspaceMemberJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3695,10 +8399,10 @@ func (sh *strictHandler) SubscribeServiceExchange(ctx *gin.Context, provider OAu
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.SubscribeServiceExchange(ctx, request.(SubscribeServiceExchangeRequestObject))
+		return sh.ssi.AddWorkspaceMember(ctx, request.(AddWorkspaceMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SubscribeServiceExchange")
+		handler = middleware(handler, "AddWorkspaceMember")
 	}
 
 	response, err := handler(ctx, request)
@@ -3706,8 +8410,8 @@ func (sh *strictHandler) SubscribeServiceExchange(ctx *gin.Context, provider OAu
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(SubscribeServiceExchangeResponseObject); ok {
-		if err := validResponse.VisitSubscribeServiceExchangeResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(AddWorkspaceMemberResponseObject); ok {
+		if err := validResponse.VisitAddWorkspaceMemberResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3715,17 +8419,18 @@ func (sh *strictHandler) SubscribeServiceExchange(ctx *gin.Context, provider OAu
 	}
 }
 
-// UnsubscribeService operation middleware
-func (sh *strictHandler) UnsubscribeService(ctx *gin.Context, provider OAuthProvider) {
-	var request UnsubscribeServiceRequestObject
+// RemoveWorkspaceMember operation middleware
+func (sh *strictHandler) RemoveWorkspaceMember(ctx *gin.Context, workspaceId WorkspaceId, userId UserId) {
+	var request RemoveWorkspaceMemberRequestObject
 
-	request.Provider = provider
+	request.WorkspaceId = workspaceId
+	request.UserId = userId
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.UnsubscribeService(ctx, request.(UnsubscribeServiceRequestObject))
+		return sh.ssi.RemoveWorkspaceMember(ctx, request.(RemoveWorkspaceMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UnsubscribeService")
+		handler = middleware(handler, "RemoveWorkspaceMember")
 	}
 
 	response, err := handler(ctx, request)
@@ -3733,8 +8438,8 @@ func (sh *strictHandler) UnsubscribeService(ctx *gin.Context, provider OAuthProv
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(UnsubscribeServiceResponseObject); ok {
-		if err := validResponse.VisitUnsubscribeServiceResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(RemoveWorkspaceMemberResponseObject); ok {
+		if err := validResponse.VisitRemoveWorkspaceMemberResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3742,11 +8447,14 @@ func (sh *strictHandler) UnsubscribeService(ctx *gin.Context, provider OAuthProv
 	}
 }
 
-// RegisterUser operation middleware
-func (sh *strictHandler) RegisterUser(ctx *gin.Context) {
-	var request RegisterUserRequestObject
+// UpdateWorkspaceMember operation middleware
+func (sh *strictHandler) UpdateWorkspaceMember(ctx *gin.Context, workspaceId WorkspaceId, userId UserId) {
+	var request UpdateWorkspaceMemberRequestObject
 
-	var body RegisterUserJSONRequestBody
+	request.WorkspaceId = workspaceId
+	request.UserId = userId
+
+	var body UpdateWorkspaceMemberJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -3755,10 +8463,10 @@ func (sh *strictHandler) RegisterUser(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.RegisterUser(ctx, request.(RegisterUserRequestObject))
+		return sh.ssi.UpdateWorkspaceMember(ctx, request.(UpdateWorkspaceMemberRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RegisterUser")
+		handler = middleware(handler, "UpdateWorkspaceMember")
 	}
 
 	response, err := handler(ctx, request)
@@ -3766,8 +8474,8 @@ func (sh *strictHandler) RegisterUser(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(RegisterUserResponseObject); ok {
-		if err := validResponse.VisitRegisterUserResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(UpdateWorkspaceMemberResponseObject); ok {
+		if err := validResponse.VisitUpdateWorkspaceMemberResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -3778,163 +8486,304 @@ func (sh *strictHandler) RegisterUser(ctx *gin.Context) {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9C3MbN5rgX8ExV2V5jqIe9mwymkrdamQn8Y4z0egxO7cjlwV2fyRhNYEOgBbFdem/",
-	"X+HZ6G70gxIlO3eprdqJxe4G8L2/D9/j8yhhy5xRoFKMjj6PcszxEiRw/a9fjgu5OOXslqTA1R9SEAkn",
-	"uSSMjo5G51kxRwnjHETOaEroHEmGMEoYnZF5wSFF+gsot59AOzPGEdzhZZ4Bup4zNs/geoyu50Quiun1",
-	"y8loPLI/j45G5vfReETUajmWi9F4RPFS/eY+ORqPOPxaEA7p6EjyAsYjkSxgidV25TpXzwrJCZ2P7u/H",
-	"o0sB/F3aPMolJb8WgEgKVJIZAY7YDMkFoEIAV6daYornMIlvpjBf7drKjPEllurZgqgn61u7dw9rwB9P",
-	"WSFPMgJUaqxwlgOXBPSPCyZk8wTvThFOUw5CuK0n+n2UA1eLa+wsAP10cXGK1D5ByCq4D/Yn6v8OIrsL",
-	"T/Yvs4EP/ik2/QSJHN2P7bYdQTV3Xtlx/QA/FUtMdzngFE8zQMGP/kDuy9V9HyMKK7QEIfAcEBEoZ0JC",
-	"igjVb805K/LmmRzyGnBskEDLshRWH+2iHwn92LJMDXR6zXFlyVZAnmm2EpE9Hs/nHOZYHTLPsFToRUuQ",
-	"OMVScd9ySqhC94wzKoGmCNMUTXFyo/57WpBMwcYQJGFUnaqKpsQT3v/kMBsdjb7ZK6XEniXTvZBGFfUC",
-	"vzVCovelc/NoHTZ2Wf+pVsCc+6Vq+y44ByovSAyvJ+ZHZD6OJFkCgrtcMQykCAuEKXqbs2SBLim5078L",
-	"iZd5BekH3x4cfvtvr19/uz8uOZpQ+W+vS8wTKmGujmcOQpII7Y/eEyEVfbknkCjynHGF0elaU53ZpxY4",
-	"EpZiMGBJAmppuxnMOV43IR0AKthlJ8DVdxsQx4k6T+R8x/qHknWE0wGV85EENjtgKVsaR2zjZ7v3QLRX",
-	"2XiGE5gydhOTEBxaD3hmf3rOI8ZlidtiuN0oJtMloWcgQJ5iIVaMp2dGCzQPd4rXGcMpUtoaq9eIkBxL",
-	"xoVShQIkwlrmauWY26815QiFlVsqBsA8wwkstYqyT2nzQQgyp+q/nPqd9EvVYKHWo1/mKZbwdolJ9sCD",
-	"F/oLCOtdvRAI1Lec1m0eX//cXONvsEI5J0vM19Uv6FXDQ3sJY74UIVABNP0HcDIjCS616gwXmXQmSHXx",
-	"/1yAXBiLhghRqMPcBu/bDbmNKBwHx7PLTxnLANMGFswue+B/LrEsxJYQkJEZJOskAyT0Z5s4MH9vrnOB",
-	"+RykfQ3tXOeg7VdliyoeutVWqSiE+juk12PEOLpOIQMJqTFTuynSrhsFBgccEZiFZEYfo4zQG6W+MUVW",
-	"xkiGGAW1hyXjgDyfGzB5eonJ5l6ZwwEbYa32lnBQVsVxBDMXTiGincuLk5dotQBjX+Fy5ysskP1EhX4V",
-	"1nal0TYNGu40Cn/R/4EzJIql5hilJzNSylh3dlpkmbIbHdk3liGbWP3lmSrniNvubZrnDRF5htdI/YqS",
-	"BRNA67vu1jjDlAYH7JRRTCu2ccD7Gu+gnWugCoKK2q9TItx/K8rHPFmQ2xbSH48MXw4iGwvgDAtp2Xko",
-	"pdQ4TGPCqkB7xpB+w031asaSB9oMmSkx/q2QTLm1mu8oOj57e1yjlZpFGvpCXVj0Wv/c0LlmRve3mMMa",
-	"cVOwxBmblwaJUqUsIdpNWBG5sM6M8c2HE7d5Y9getEFQbqCymPZAnBdq0PEI5vKCgZLkRnOYwLfKkM8Y",
-	"nQuSQvy0vWJCB0CM/ExTYtY4DXAaU6qnwAXRLmf1wGUwpRRbWmxqMnWgUEQUcJUjy7rJ7rBQpYvgX62k",
-	"/RNRVLt+SyVfR9xu/StJcIbgDpLCOdzYqxlNPIRGBGNN4UgJyzwiA47NDyhhBZXeuPCrTaK+0yBV5JXQ",
-	"JzZ9mPYBzlkktPVW/bmMKcyqO0YzTDKzSi9BfWLTYbzjwf2JTQcxhnthiIawNBAqCg6iyKQ1uDYj+DP9",
-	"KsqtsZbgXOpoH55J4FHElhTJCxrD6XmygLTIIA2grHA2HJNtiu6t/55XdL8WUBg9xwtKrd0niiQBMNbe",
-	"tUHw9RiBTCaPUHqhulM0+iiVZ0gpUHeO4xxU+xVgn5A4C2iqxeH1gaaYeqngvkMXdlhKdXaohN+a8ro9",
-	"Om0dfx98ztkKuJO67vP9pny572C1XjC2he5OWJZB4oQsh0TpyZLkLUaFszC65K1/K0L0v/AUFEdmNs5U",
-	"Pqu4gGvl45G2ZELarQyPVdQVS1+0IthtG/AGEN/vdth27bCQDX63xJ7UEivk4hyEIIy2Swf7gL/8UDYI",
-	"UaaI22LC2A0BE7vxPrAN7Vfimko85ISDUPppdLh/+Hp3//XuwXcXBwdHrw6P9vf/S0d+9XJqa+qNJcgF",
-	"S/Xtlg2mKaZmN0AvLHLM80q3CCNyAyvNL7J/cHGwf7S/bxexYbARzuBustSy59/tNicJW46Maz4qBP+4",
-	"f/DTn/7r7z989/b1P/968sd/fPv3n38+Ofj2u8N//qnUekcjE6CpKLiWxe/vmzLTA6VpJ+TEkousOa04",
-	"I3NaEZnXmAP+aOFx7dCiv77ewGSpwr9LMJ0Hj1axUj/HjxxTKZCAJaaSJP460NKMfvPPyF4BZGt07U5R",
-	"jYuXuG5aPqL/oudSRG549Isx5jhZYDofGJst44Da4NABSyd67R1PPCC2aTx2WAg2b41wuxsnH90uBJgQ",
-	"dyEXjBNhJaM+e78d4naQd0W6DSAHB/irsPQbHQRO++vp5ufXEpQvEU6MR8hWFLhYkDxq4XXeIrwBoeCD",
-	"eOQ2YYBpVzvCuPcmwdsH74moXNC22BvD43gxy6P7Eq9co3On7nsN2P1MKFnizBss3rB3qyj9Yi5ldfSd",
-	"A1VmNA4MCw4z4EDNHVfnXX+vuk+ND/A3a2O0xG97bZQbQvWDQIulgpK1KKMeUPmWO/pm1saxf7JmbnhI",
-	"muenSnXAXQ6JvrH3tkiHGVTikbYBJPR8ujWH9oNcGo8nr1g8VUPPh1VDlPT4PifaDugKpJ5UIOTi7DUX",
-	"D8kFlkhyMp8rW60v4rShZd5YTUBmcDLjbBka71s1hyVDYsFWiFDPTdr2tOAtTbuoua62ssR374HOlZVw",
-	"cPjdlmzlHzjArk4d+Y/zX/4Wmsi5t6Jt1K/LnRhiMJdY6qaddgewQT04EiCwXq6z7WuXUzNl+D2ahiKr",
-	"/k5FXxsV9Vg93g7Rr9hchoBSlD3inStlrCkiSLT//pir1oaM3PjyUzKFbuWvFkQoR4SImnwMcPzHg8PB",
-	"5KZz75DPvdMEZwlLefIJZ0I4souR0pJQ/++t3GpGZMK9Xuadeftgk9yY3gvAN0WeaQR3ko9HB7sFzkkK",
-	"AuEwlpDar7i7+w7F1Yn2N0Hmo41zMcNBbgWFk06091pbcTJQmt4m4BgOGbpoPxHcR8Cu/T3jsbSHRNwv",
-	"iIMsOPW3DNpv0aAemI4z3Gsdj8KcmLeDQwZOR1BYZWvnb1fSa7TzPTbkomknUVhqDRj0oHGod220mFx3",
-	"+ywmC8L/awiXug8PdVmCJbr2GXgsdV1NqVa1BhkDb/hC/D0EzIN9j9Aib8Z6EpbXQNt4ppG7URjYRFPZ",
-	"G8Z7kBLvXhxXQBaDuaIJJfXEoCuLFcd5DtzE3b0wECVvWplhVEVEUaqVNkpt6aUp88no0dic0FZpfsJB",
-	"kxvOdqdYGOHmFL0JGWtjoR5U7Q1mlvGgkY4k7PP0fxy+Gt0PjEadwVwZTdzaGkaoVSNz7Ws/Jlp1mmFC",
-	"JdyF2ZjKGRNYEjEjIMJQM8pZRpJ1dV/hcYO95GV0ZQsBLl1QcmwCaP+tMdWvrwOjdMb4CvM0oNS2WpUm",
-	"8eacRdMbnGe9K3JIlKxH5slyXYSzjK2ciW7wepcDJ0ATbdlPAckV4BtIW7LCUsIhkZecRJVQRhIikXsK",
-	"XZ690ymD1kwxWtxkhaIEZ5lCIuIlofmodnj2Eq8LKXNxtLeH83yiuG3XpqbjPN9jims072YgoeLvcBIN",
-	"eXsx2BpLMY+YiJNGbelYhRvcQIxKLKM+Fv61AHSLswK8T7BUXICJSRYANAW5AutLut1gmnowTlri46c3",
-	"SWTFHzI8R4Smzkpc2Wzc07+evFXeXZGlihSAzhhPKrQQ5twO4ok2Ye6I1QehqxGriMgOv3vJIyLr8uy9",
-	"jduURUfLQkjEcqA23isJLaC25lCyM1FiMTHlYErU7TFDeId7t4d76j/+t1n2I0m/n0wmA8gwYSmcLHCW",
-	"AZ3HoKQQop5BiXsIpcDJbejlG8uuJZGzssDP9mKtEYHVfw/i4su8sLH4yA5a1/mH3UjXOdxm1TrWM0cF",
-	"lSSzaRvmFgI5ThaTthSb2K2lZhVIFqx0m33WhbHWK5jvD8w3iK5VFby1O+91+jkkoPFnNlTZo5ecFgkK",
-	"AhW4xMJGaUykUGNU1ihdY6B6Zxun+dd7+8fTkzcw+3Hx7tNfs5/pL/nf+bm8vP3Pu//z31tBv4/t+xCZ",
-	"h4IVO0LT3uZ66CxUP/6WPS383XUVJrOMrYZwagvNeaVrwWrEdalxHU/lHG6VQDo5P/thSK5PClFac2aZ",
-	"chAHx5eAcpbZ8JJJUbEXXjknTHOicVlDF/G57c3TaCWKFueWY4SOm98arCndJyApOJFrhDPgJh701Mbp",
-	"ueSMzlst06pVinaWhE7Qd0pycjFGGUht+/0vRIvlFLh4uandGkQ2vtuKFVslp3bHS9+TVvyR0HzQTCXW",
-	"NFlwRlkhqrGGFeM3islMwheksQSyzZIhbHijGdGowrMl2WSYt14MLtCux6m0HBOE0SBMW26qN72kP7ai",
-	"k3xKmMXwWrtrewMyynTm70oXScnJtJAgzJ2GqOcsYuFCgDaIHlwt1BQSljBnJmrSG9CoJFcPLNPpuZ+1",
-	"xSPBb95mHRxAab3r1NaeS3dxV7uUUbVTYwmOxiOckxtYR+93K7m6jyw2qd6KljsrQdCWhjuAYrpDdI4s",
-	"hodQ4gTZF1Mp1xmw5db8gvdkvpArUP8fccg5CKAyuMBrEDvc5UwowVYeJBI6306eQAupDcR7HCyVZK5G",
-	"eV8YXIolCCzYqpJ1YxOwdFmDMXKawFi2OhbKbiViWck5ctdYtjhZf15LSctQgcbTZB1lpfaE61qvD5EV",
-	"c2NlXpttXiP4tcCZQNf669eTjSPc9rhR6BdTDUmIOARxy73Xlu4zfx9oSkZ22hOSXz8gDi/MKvmQi8nz",
-	"4Nm29JDK9zqPZcVDK/zLMNqGAH5gDN35D11hmiFRluYB29BW8V77gN8RvKkUtfiEpvDRjx5FHuNTSKN8",
-	"u3WCaK9wDl/vVmjhpoYrNWU1h2v06rPqMn1bbr9+2txqGqiNHKO/iz7enypvZe67YatpNjJpwpvzUhF/",
-	"dCsWVnCOaFFvDHOmrUBX5tmp8kZx5jJdCRWSF0EBfS1PqCyIaFw+Dq6/gDuTplGmnIXpSz7r9vmrLp4k",
-	"Byhs5dFWd7FBXUU3lttzxPrwXEkZq8Hl4aiOf/N3HD8Ox60RNpksfCjE36rlFcwbX7o7AWdYxlZDtvRl",
-	"bJkXUp+wxThSwlvHlTPA3F7F+Ve2mbsDK5eyZfK3mqLtsZlbrR2QAnIQVcofps+bzB3T6B0EM7CrjHOG",
-	"gpqHeguZAbTTVmjsahFiXWmc9VaGCFyHjdF45LprROy24UbXpYj5ZD7SZFs1ubsQm64xXdfyG4GmOSNR",
-	"x7ujIP/YxrVNNV0lZkgourw42aAef1CYOlJQaWPrw4p2yIMijI2+TG3iPMNC6uyTQcXhQfkt0kXoQsyK",
-	"DGXqAw9NDRuPOMti1ybAl8TEFTK4hbLVk7ua2LlewnIK/Nr0fUmXhLY0fdmgsUysu9K2CuulvpK3u/eq",
-	"fxOii1mijnI0FHsbzLTxY8c1fDHNSBIJiw1NOX546eD2q/ca/lhr4PLB4conDWf01LbomNC6uzjxgt0A",
-	"dVe8NlQfaazmo3HmRg3qkiu4+dMXK6OjUcelReOOz77TuD0jdJ7BbiFidzdu01hoM8p0JZibtmv1+8jR",
-	"I25QzN4+xCxEd6F4rhAYJe94lXS1GLpMNCgDrb5fr3m07Ngblu+WW8c5+SusTTdeuJPAKc7esKRF188p",
-	"mhckhYxQEPpmVLevsWbxAtPU9pUseBbms9RSqFIlhxUWhdZMdMasCyKxSf10d8C2Rei/1z5QHkrfMZ+6",
-	"hrDn5vHe9e1nG7bt6MKWxCPTbxUdn74zPTTqhVllE1p9L5sReiOQa1Gn4FI2rDOlBFdUkRoF7vufTtCl",
-	"AESkaXU3ZdiUi3Ixtn0KNY34qLUY6+8ynixAUyqY7D2TynlFA0SIyRW9ot988w36icwXGZkvpLiiu8jZ",
-	"LKW15m0frRRDJhhX+GZsb8ENEZrG0MovmqjPmiD4ArIcTM9CQokkusyEpmFyCeHproLCGtkUXVbLkhH6",
-	"ez/7okymrwk1hPVFCVRuCyrtfcdoBlgqeM0yPLfAUmtjSaYkI3KNKJNQguZHkNK01dBXxVf0YIJOXHWM",
-	"Nh1vCUbXp7+cX6C924M9jZrr+pFq4uUTU0bmenJFDyfoOLx/0EYbEJ375u/0E5+QK5TtYQDpYaNhyaY6",
-	"NQ/TeP395Iq+mqATnGVtVq2yrxZK1F3/+NacROcvLuG6NCRFVbpgKXGysC2CFYBoqojT/roAnALXcDyD",
-	"mUmv0gEgeks4o8uyPoxxpxeU5z7F5lE2n2dllmHOWWoiFmO10pzQucFdxhKcocuz9yXKzhQgM7IkUqCr",
-	"Yn//8N8Qh4xgg19FOm9syif3Tx6hP/zh4HDfZTLqGi20JLSQ8Ic/6H9U4ebuiNTX/lJwodgzA45pAkdI",
-	"LBiXSOTkBgQqcnWcV/vRbyPMtZaDXNqeLa/2FUMzmhoiP86yAEfYtrhc6FokpkTPTxcXp+eI0Wz9ZweY",
-	"Glz0W9qYBRN5ywuumKQEmJWHDljn74/V0u9ooi1+xF2diaJ0hSZGdxNFSpxZ64wIhG8x0WY3Ony99y2S",
-	"C86KuSUbY+yqJXCmT/WD8v4Z3S34XC2gwWJkl06MxEiS5AaC7NYUi4URfcwmfqMWma+/f8phSYqlkr5U",
-	"IEKTrEgBpZBa7J1nOLnR7QUoZEYQyxVJYHcNmGdrpN1OCYkWFBxuCay025eRBKzRahXLKWc5JyCVJdWn",
-	"TjKY42xPAl9qfab/45eZ7yA96L3xSBKZeZVW6p+RLs0RRj/tTw4m+6Px6G43Y3OtNHEmL+BOuveWmPer",
-	"XywESLE35Zim5kf1td0U85uJuDV2jMIXzsnoaPRqsj95pbN75EKbBXt4ygo5+SSMXToHGYuUSE7gFmzv",
-	"dX/5q2W5UhW+ts5HcGKi3Z58rETukkg0I3QOPOeESiMldJsSSF0O7rSgaQaVVu/oYlHqOd0q3/ghJqWZ",
-	"oqlNhFaMtACcKQm8gORGS2RLiygleE6ZkCTR5KJMF/31d+noaPQjSN3GWtfcGY7ScDrc33dmjW3DZOuf",
-	"1Jt7DnzlvITePtnlLdl9w3LRD7hoh6LC1/uv4w3ClIxy6CioZ++KWTo6+tcH5YHYWyFbnzd1LdpRgnMj",
-	"dZUVPh5JPBf2str0FPigSDRhKZxrM1r9+HmU4Skosvx7QTSTQnJjVFCiyHU8yjCdK7vV/EuwgmvuUf9G",
-	"uwKVOfmkkZNfUuQVHd2Py7V+AJks0M6Us5UA/rJc5RO+xQYy4Voz9fjOi2FLvXh5RRGaKO2xs8NBvETf",
-	"K0H7SkkWoZ/YeRk+MmXpunwmYVSwDCYZm5tfXv65tvfTtVwwinacegl2n+ufwp2TpSZU96zSAF68f+//",
-	"PJmDHHy8sY5psUJ+f7D/svzchGMi4OOM8Y9GAey8vKKaJXf8I/7wo/sPiq602ZEuCTVm1N5nk1x2v+dj",
-	"XzmWSSyJJMvYSkT6YtvkbZMFFwbKdJ6Ntt+0uWzvE7K1a7HQkoBaZemge7fy9N/6BMdyVMy/4gxbPrJn",
-	"B6/cfzBeIQj5F5autycSWjq831fdUMkLuH9CyRSraI3IJ3dNUNhy09dmC7WrJnqLM5L6spOKODvoTTHy",
-	"h9bPv4pV3ARkpOjmlmQwB1F7MyI4FS6VA4FmrKD2sT9FckhNrDbjgNO1Mn4Lk9Pwx9hpfzBh6jI4b5hB",
-	"y2EveQ3cgsK4QNyahKVO/grzizdlsXLogXdYXOjUln0FoboI/+jBCwpwQbOhr4+DouMhnpmHKoHT/x+Y",
-	"Zxg/lD35KiyhMVYdxLEZU5Rh/BaWeA+ywRCBwoleoJkN7bgw5xjZUQpjZGYoBOH/Tl1z7uLuX6uyqV48",
-	"/pY5xWJPV0P+htnEknO73vBXOS084irUrSdXJU9fLf+U7k2zJD+C22ofYbPr6qH1iKmwSL/S1ibs7RcC",
-	"w1TT349HuZ0yVwVA2Zdl9DS81WzjM4ipDrbH3LrpQMSnLMPd9hKwl6UerHQqeDxp6VLkzI8eTIZ0vfdZ",
-	"/c+79N7sRZdsN1D8Rv/dorgmdyOzD80XHzX78EMDna87B+TYOTzbk1IccMkdmDIdmC6l5uuWVwLxdF8N",
-	"EOiAeCWNZEPui0qfH0F+WbTsPyeXpfryV3y1WPZRvcfg2dtcVUyXmU3Piuzti/NmTt8z20gDCM3mcTyd",
-	"OH8mgrRWzsPJMaoq9nz/L+0jRK2CSv+03zjFRnvB3Vuq/XI2R9mF7TdPpx7CNVLFNPWtKUxic75+lJWz",
-	"Z/uBthOumQ3zhY2dw66ZNe7a9GvCZq8vZuFe5n3UKMCCXbstQXIIWS4hJVhCth6M4oWZQdLrsdlZJc+F",
-	"5XGjIhPfkWWxtC0IaiNZdKsjWXCKdlyfpj/uv3S5S78WoDdud6dv8kfhZpbm26Ojw/19ndFt/nXQHKv1",
-	"5DZdff5NRKiVtO1w97UKKu1B10f0REbzDCbWSKytze5ri3z9Rq2/Lxona1OvLj4W6CAXPhoULPvaLcFB",
-	"9Q0dxFvIRe+dpLnsEvG7x3gys02fo6lAGM04iEU1b8xN3QsmRUSGEdfiUuUAkacKTDVHlAwi48Pnvlz8",
-	"RzPt2My7G3xZwniYjPcwKn+W+0ADDJ/933MlqMhZF1WE9mANEkIUoPP8bBKgkvW+mFPfnPikrxJE9aRj",
-	"nWXjEw7MACdxRSPJhYo7RY4pEsynACXqn3YaIhIkM8lpFFY4ixC+LjPZmORtRrer6/Go1iXZXv2pT5uk",
-	"lOBqyVwQPKo1lUbisFB42MJ1a1ojPL3LAa+c3CWY+xSe8Nj9Y7ViI7M2OHRsUljcOQzZz4+vHI1HJitV",
-	"n+8c5O6JSX7vY+AqbVYszLq9cN8nShry41V71ZhFabV9VWcGViWZuNAtY2xhmHIh265Bu9Kwksuz931Z",
-	"V/9EOv+5K2GoImbQ1dUVRWj3J/TixBDkriKKI1SnyRfuyRS9+HxlGOtqdHTVxlpXo/GVZy79YMBeV6N7",
-	"/73E4lJM5J2sJVT9B77F5xobaEdnevXmg+EVJhINyAqrQOHFGH1WmzG9W47QCwXEF2P1J0umR+hzFUIv",
-	"jtCLJozu9TsBYR2hFzbp1XxuytL1kR5DMTGESmbrHb02MuShPhuHqPkA8rSjtlmC1Px6/3J8Re+3npjm",
-	"ajK/R3arJQGgTgqwDwd0gGqEoPZ7RR1bB4lvVrrsvKzkxblsb6Uad8znN0KzQtP39jhhutwV7UuYCxPj",
-	"7BdZITvUtJEyWFrDM8FZpkcAmOrCluoAdGI1rO20q7O79QwN3aVIa/crCradcrZGusSHFdL0chQFB5Sy",
-	"FRWSA16WU4qEZLnzD+k8qqKjCbERq/49m88hVWu2Wl1eNxGhBZ82UPLIveEZ3LIbiLWU+sKSUUHUCqjp",
-	"FxdQrJAdEqpV2mxfDnSzqePNB6esIvSN9s/cCFHRzvqbgW54Wmydy00Xg7aM+YJTx9yUUT2f3mQ5cTYj",
-	"GURrwH3X2bbyoEsBs8IUPU8Zk0JynOe645xJmTddZE0lIQclyOIp7na04aUJRj/SBLUnqpqgxw23eTPb",
-	"e8hM1Q1s0r5Uo5MwPL8F0WWvVlsCCA5iW5ZjQ+h+CduWWgmjIig/+h5tLMaW4ERYt7wyKyUl7frFarnq",
-	"zyvbInKoV8xVjr7dzHz12QGZwpd5aX+0DE7N1tHol5Yu1oBxbXx9jCEcXhoLcQXpw08X5XpQBvDrWN8V",
-	"N/l14BX79iJQj0qqrcWV+hNrFc1o73XdbrTabs7Cdo0qq4ttHX5trkAYwJugy9y5+MJ84Pj0namMFlfU",
-	"xHObdZu29tqoUiJFWeqkFKBXeYxj0+G+Sm9B24NHxZcqXn1Fx5kVbGZbCJFQ1Q3pgzBcj0V6OfweVopE",
-	"ae28gLRS6m4aLXwV8SVDFeqpg/22bhzWtlDCxAWdC+FsjZbIkiXJajd8XR3psjC/Ah/KSJqHhpc06HTU",
-	"qJ2lvqIgkjnsl44iGYI7Qi/aYfbiOSJEHnuoB33PEPfxmNlK4Kc6Mr27jjq4sC1fM+XUYRUzLruQ6IYe",
-	"LhllHMkROSmXb9y514wIkslwkK1A0zWyg7Nj+Rr2p1LYbTKavJlIEl2+2nR7x9xaaUGErs3YpOu2dJJw",
-	"amCrPH7KtJH4SP1oyYMfHWyGIAw1JwfYg5rBkuYCzXwQ17U+CQnGqQL3XoSq98ri8l76dhP5gao/CLRa",
-	"MFH2yTe9Lsp2zy3ZnZE8KLeD34n9t0fsaKZhYdBdbWi9ARts2Y3qYpvSwqq18/rXh/sPDbYKUFw2WXEz",
-	"GuO5nw1mq06w7WYx016ofEN3rSpbPeJY+K3JUe/KFZ+QaKLzeyM0894cIQDDE2BcIStc4T6CSjtyNnyu",
-	"zXU2AyQ/O8a833P97aHDk9a2uEDYi4Jy9GZ1yNjl2Xvjwei4rplVhN5RezFCRNkLZQozxkGZK2YEQZDv",
-	"EcyE802phKLHWcZWkfiwa+YP+umNi1f1W64D4gNqWEM31I0A3XXHqjqkJ4WQbElsK7FwfmjomlamMmwy",
-	"DXQD57R9rGs09X5Td/xTscx3CzO8Mji+xaMeYMlMVyZCke2PEoKgOQTzMeMpHwmX7pQQ/2DQmHszDfG6",
-	"Y2ooZTIYmWvkxUHbKJj6wxVR8c71xTPPVmdmfBnn2pCvwWMphx7qZwdso73t4YyjvO8ve60Qh8TXkcoR",
-	"wPUodFT7oFrP4jAA+ozqvI3un+taJJL6USUatBnVmHyPWKejDVz7NtSbtyuuvvlT6e+PN3L4G5rfDVvt",
-	"CqHb6bRaX9lWm5imYoFvQLtBHKfuSiWvTQjVc1Bt7r5uD+fiHuhiAVfUd1mrZJYEhgMiS58ZEjb+i981",
-	"N6wCN+3pyxsFrodlVRm6/VVnwuopVoEiNMOzeubUbqrf6iO7fo/I/3YSPfua7G3HclAPH3Z83M+vnmlH",
-	"pVFuZn+tiPpSIHzZkH5F4PqDPNDoUNvV1kYnh4bh/Uoyw5eM9kfh8MXj/gqgR+hFJzifJ/JvUYv6cfu0",
-	"sf8WPG3dPnCtwTtLO2sDA540DNM1qjUits9r401F58Wj6dbjI1+i8XIpnDxgGqDaa8zT6wPceeWFpwRe",
-	"20zAmMIzWbyYprZEVGf/RWKeD+6pU4nkVz7cV3DeAfzAlPXB+a4cZuP/6mqjYAfh3Kb6FBDdUto1uk9u",
-	"IA3uBHQ4yyZ7XFHcEglAwoZUTfJHgmkZ9nEjbv6stxB+GUuUMqWUFfdqgFrj2yRdBpsnwnVHCqu5m9Zw",
-	"fZjmsxvEG1FtY6jpg4NSD122Q8yE4GeFTNgSHn0j0JWpubmhF60GdEFtn5tRBuDrBbBR2682bRg4Z7zG",
-	"5R6MFWY23Tyr4rU1PN3J3Bt7rBVWMXmAjul84FqBQCcEmvEjfov9POTM3K+dl57M3XvwPgZyF4eE8XR4",
-	"GuP/4+zlaDuqSL1L9hDWCqYrxnvUXVLxFAqkL5+1Rg237OahjVn6Pt7edEzfXeEozDvNFH2Z1S6o/gJz",
-	"YgsudPLb7ozwYLCan+y3YvxmlrFVJCXVRdBsaf8VjVb1hzmpGEmuXuLo3ZtGCXTBZ9iI6LnOwrPDPZty",
-	"8ExnjgL31RhbDowF4GhLUn2OWujwmFvvQBCCwbccqoDhLMxYxskNZasM0jlUasJNU+GtVp5Uj90uqWv7",
-	"M0cYx4awCQWHPhFOaO6K8HpbGXC7xZ7cUncSm/Bc72T1jCEnc7H9xcqTnzWspM+65TDS75XG0UrjR948",
-	"OUw9Mp10SO6RXjeW73bqJ3A1Bwc1dz1qZqydm+lUjbft1KoBX3ivZ1rZqYBLJavCbx3t7emhVwsm5NF3",
-	"+9/ta9PFCpGmMBO58uwjg2rGdhhRMB3IDBGyE4rdBDmtb232XHBLcrdrJxX9zU6IchMA/TOxFmhUGQDa",
-	"2ykzg8a1mX7hZLzaPLyg5ZW5Q6vvompyRbbwhohEDxQrI212iHU4pjBIRgvWdOlmzWVdpK9MS205ur52",
-	"DFtyl4Y8mq5NvtGk2thLxI/pvhBZyyFdncgMJ6zANBr1Chb1lmP7OcPpipr87nYlnv/IWZEbKqQ1ovjl",
-	"Vr0Jq+h0JHUC+4J3WuyMtmNtXDY9iPKNoEPmicdPM0Ew9oKINeQttxKeMWpXf7j/vwEAAP//eTM4fT3N",
-	"AAA=",
+	"H4sIAAAAAAAC/+y9C3Mct7Ew+lfwbU6VpXOXD8lK4jCV+g5DybYS2WJIKj4noa+JncHuwpwFNgCG1EZX",
+	"//1WNx6DmcE8llxSSo4rVbG4MwM0Gt2NRj8/TDK5WkvBhNGTow+TNVV0xQxT+NfXssiZep3Dv3OmM8XX",
+	"hksxOZq8zpkwfM6ZInJOzJKROb67P5lOOLywpmY5mU4EXbHJ0WTuB5pOFPtHyRXLJ0dGlWw60dmSrSjM",
+	"MJdqRc3kaFKWHN40mzV8q43iYjH5+HE6eXtcmuWpkjc8Z6oN1HlRLkgmlWJ6LUXOxYIYSSjJpJjzRalY",
+	"TnAEsnZDkCdzqQh7T1frgpGrhZSLgl1NydWCm2U5u3oKy3GPJ0cT+zy9Qj9k7wrbK7qgi3HoNXTRgVuD",
+	"Q9wPsRdstS6oYeNgoaWRKwqPiXEfdsFWjXs/AN/pNB2+E/wfJSO8BWOpmYLdX1FBF13glXoHRPmDVNd6",
+	"TbORyLv1r3fAdBsNdx/APvqXkZOPZ7I0JwVnwsCfayXXTBnO8OFSapMA/ZTQPFdMaw96ht+TNVMwObLX",
+	"kpFvLy5OCcDJtKnzy7PDffjfsyTaqpX93QLwY3hLzn5mmZl8nDqwvYRqQ16DuPHn5NtyRcWeYjSns4KR",
+	"6GFYkB+5DvcxEeyWrJjWdMEI12QttWE54QK/WihZrttr8js4TAId0wp2+5Ob9CcufuqYpoE6nHNam7IT",
+	"kWcoF3UCxuPFQrEFhUUCv8L2khUzNKcGxOdqxgVs91xJYZjICRU5mdHsGv49K3kBuLEEyaWAVdW3KQuE",
+	"9x+KzSdHk18dVMfOgSPTg5hGgXqZumFq1Efn9tUmbty0YahOxJyHqRpwl0oxYS54al9P7ENiByeGrxhh",
+	"79fAMCwnVBMqyKu1zJbkneDv8bk2dLWubfqz3z57/tvfvHjx28NpxdFcmN+8qHaeC8MWsDy7EJ4laH/y",
+	"hmsD9OXfILpcr6WCHZ1tkOosnDA7N2ylRyOWZ2zyMQBDlaKbNqYjREVQ9iIcxm1hnGawnsT6jvFBxTra",
+	"H+K19fGMbbfASra0ltjFzw726Myps/GcZmwm5XVKQijWucAzRh99iWlZ4kGMwU3uZJ6Hs+87tpoxdWZP",
+	"gfa2shXlRXvNr+BnoFpKFFtwbZhiOR7d+5OIH+zXKXTKgg3hIIB4Bi83l+yHxpHSi1xxccY0M6dU61up",
+	"8miR9dWc0k0haU5Ap6TwGddGUSOVBkVEM0MoHiyomqzdaG1hKditnypFJeuCZmyF57B7C0anWvOFgH95",
+	"5Wd/+OiIJupc+rt1Tg3DjbrjwkscgVCE6gtNEOVetWgvv4NUvme3ZK34iqpNfQScNV70MNloJvK/MsXn",
+	"PKOV6jCnZWG8nlWf/IclM0urT3KtS1jMTfS9A8gDAnscLc9NP5OyYFR0UOAA/s8NNaXe0QYUfM6yTVYw",
+	"onHY9h7Y39vzXFC1YMZ9Rp5crRnesuDGBILiBu9OutTwO8uvpkQqcpWzghmW28tUP0W6eZPIUIwmToXq",
+	"JlJwcQ06ChXECVIjiRQMYFhJxUgQZhZNgV5SB9CgYFWM2hMJYMO1c7MZ+xW+G313ni1ZXhZsm+/DNx+n",
+	"k0wxalh+nKCMC691kCfvLk6ektslE8073C3VxA1R4x+gmj1jj/QWD/Vq3m/xH7Qgulwhx4IyUvDqIPO4",
+	"F2VRgHLu2a41zbzTAmFtE821cE3mvEBdvbYWd0kanG/JaGGWp7Lg2ajt/DZ+/+N0wre5o1Zwp2Adeb14",
+	"yfW6oBsCT0m2lJqJJpb71ZBxmoRi1GsoKVVJsRuuk7TwfbmaVUsuqGHaEP86USyTCjQcLzzrOGlrwKoU",
+	"x7rLGmC1EHILWECBAIp4lskSlCm4s+hyFt7XRJXiLvvQJR3fNOQqeXLFBFAbSMKrnOvwb6qyJb8JEjJI",
+	"zJ9mm5/0Rhu2uqo4NeMqK7khM8XoNVC84us1y58md9YOpUeKsPPqbdhTukjK/IUm1BgKAscrGHWMjaKg",
+	"C7pIEY49nEbJrkBC2rgzbby4uu0z0gQVMSFN9JIqFCdTAsID6XTNlEbxVr2q7yBtGoef/cSq4I7EYtEe",
+	"o2pQM6+Op66L1IxbA6k2Ujn2o4Icn706bmxu40Yc22L6tjvcOs7tEYDnlP9tnKUso4YWclFdiEDLlRlH",
+	"M8UtN0v7ljPujudf+8U4GFBXrwCoTYYWEG8Fo9no+dNyPJyZgmfX8ArR9AbkVyHFQvOcpVc7eKKhRd+q",
+	"NnnO7Ryn0Z6m9N1TpjRHk1d9wZV3oDrRUU4hmXpUABHtT1pk2TQZ+F2o00X0Vy9pe32rcTd3+obbPcUy",
+	"2DX2nmWlFflw1xS9FA7i5U9ydjeB5H9aSW387J5Vyc9yNl5gOTDOO84a+/uY6ZIjXyi+WDC1vdwFcCN6",
+	"J3PYzvGrsjB+TXlRKqb7lIV4GZqYJTVkTlGzy0vlKQ1Bev6CLGWpdEpfaNBcY/oh+or18haDcJlrD8vt",
+	"kmfL5uGBK8ALGLsB0TgldKZhh5BjuIHThRa3dKOJvTy1aZGJXPfv0FzJVXp6bWBkLqqxx22RNlSZgVln",
+	"bC4V2+288PvfpEgZ0I+/P7aE908pLPXdcpHLW02oim2ucEi/uzixCJYrbtyNprLNvSoBvwenVHGdVBPs",
+	"uAktm/JiE6bt23but3MKkoat1mZDCq4NuWZsrWHf7WNCi4LkdDPemBdR5g8ICEC8ou9f26+ffzVg3vOL",
+	"GyJ7N3gvDqaEun8RawPwNMHRPKCMJnpNhSYrngu+WBpUv9k/SlqQmSxFrkkmb9z97XYpC+Zx0XDw0E1i",
+	"N35g7BqeRLRAJGiyRIopsJvawHAtQgh4ZqJcAUpWUkymE1OyyXRyy/C0XpZAsopPphNN0XlQxi6ViGAb",
+	"miwTCY3ijcxoUUlNB2tWSM1Aq274y746OjwEFFBjmILP/98nfz989uPfD/d+9+P/9/zvh3tf/vj06O+H",
+	"e7+2P/1HJ/+OBcQirQnH4e/uDUfbsKPMxOKoi/xeyqxcMZGA/VQqgy68mnSdM8VEBqQXWcxnm+Dcn9or",
+	"MdDdDVNwydknVsEzm1iTARniaARfRvL0Fx2+WkuFmk0wntVulfvEm1DrylG2BA+QkdeIX5GT49PX5Jpt",
+	"2tOtSm3IjIHZwtotCF1QLgI/eQDuY6ryqK25AhoWnE41dUfWgyQMTR4afbWmTvukc8OUQ1LnfVuq6Mqd",
+	"vjc7CkkIPQc2saeYJ6UpcS6vYkOejdA8/PjTupNl1E2ujbm2L9I/CnxhCb+uvnOB94iaAkxyN/rAXa9r",
+	"PljOlNTDaDB45idwZ6O1/Gr/Preg4DNvXHzueNFpYXjdGU7kA42613jVYV0zajPehOi39yz6rI8iK9y7",
+	"V34fW9bcb6CGlNpfz6IjcIBOozCm4XtYCvKEx8qoDZmxJb3hslTe21fdl+tEN6OavWQF3XynI+ETGQBX",
+	"9P3Qc5iSs47n6Blhi02sBmRSaEMxVKDgglGFx6FdPadF4vhvoC2atBNZanNWimBDTeAJnTsRcsitLIuc",
+	"aCYq86i29Ic3igGO7bV7DBopmMjXkneMxJSSCYb5Yblx1+7gyoYFCIkH3FqxNVUsr3MSJSuuNRyw4fxM",
+	"c3fEpp0n0si1qcqPtpWoCCEVCW8ELTSrbLbW4iAVyaiA5duXZ6yOHrBhybIWUMXNPjkLjirtdGtKAEMF",
+	"82FW5FrIW0HKNQbkoEqxBpM6u2X5Eblls6WU13pq/ZJTcl7Q7HpKvuHm23KG/31DZ1PyvXRGnQVzBhwp",
+	"rAZUyOwajli4f07JG2QJ5/n0L07J+VoaPt9AwNAGLjhTQotZucIR3BUnZxgpkcnViuJQL5Vcz+R7Fyxa",
+	"nzWTa870lHyDUZbkpYIRGj+eLxkzmii4hHnsgXSGr4tyJfSUvC1NIeU1sUvPaMFETpW7g+NEF4yutA/v",
+	"0jG+FaO5ty2EGNE5V9qADpEtqQ2DcrtgdTkhzTQofA6lSt6Scq2ZcjM6Lt04K5Ge4gwSfcoOu0ENaCO2",
+	"jia7XIvZCKPwqIa5cg0uYT21FmwLB8g0ZZo4Xskbpj15kILOWEEUW8kbWmgbUnTDFC3sEzBjaHLLigL+",
+	"+43FshvuxCF7Ss4Y8BXO+TcpV9XqRjjEI2aexlbCivuGRGyHl/w8kp32cDSSGKbN3pwrNmQXxM9SV1Fk",
+	"NjcqgGVt6d7VzAvD0FgAZ7JpaBHrUi+vOpQZ9OhvZ7Z9dWPDQvBTvH9Uhjp7lrAVNyBjktbZXpx2RQ6+",
+	"LU0mrZpGSa424FRLXVUytk4KzhBaEdsUC7xFFczgzw61SyXLxTJFQPdCl71BCDgzCq6dbKO6IlmHOsUy",
+	"BtySxF6A4FyWKmPJZSoWn+AZ6LZovXty5eQJ3FocabhLC1qPOm4sd7yF1bWQpCeXaim6D3e7GxAzYPeJ",
+	"5USWZji2w29RE1XTijjGXIa+bTjoG7pxw1e6xveIc7AGX00U+2BtsfF2g50Obc1cLKbRu9WJQ/249m7C",
+	"FYHTWDG8hMIELpiISMH2yTH5J1NgTVBML2WRE3cztey5oiZb4qFSFiyl0QmNzosbtr3V3C0CbQp4JqEZ",
+	"3Si+tnM7LMGsK/qer0AZfnZ4eDidrLiwfx6mfPBzC8kZNanwyCVaOObeXF8HCVDIRWyC6oapshvLclaw",
+	"GpRJEAXiAS8BXPxJzrZ1MKApZs4F18sEoM4gA784BBCFcVVoisCTrR9tdpxzlkmRp2wcTCzMMqQJeOw0",
+	"ZuMazV2lAb4Tta37zeGLr4Y2r+2Ea5FXfX+bYFe47eRQro1Um1fCqASH2qccjJHBLVe/E8ao7zuQqTEg",
+	"HxMebvuAoIUu3JzCbOm4klExVEG//1nO7hY21XFzegU/VxkH8zrEjpVGuXt/lrNxnu1Ot2T3rWm8wdHR",
+	"QHzOKKbLwpze5aA+w0+DYpPRtcFkLnt0pza2okiIF0rpgs61l0dYhj3byk+WNFW+CuOFKKB/lKy0RklV",
+	"CuECJnWZZYzZMMkru8FXU8JMtp8+70cFysSuWqDR7cJkGsLBklIUjOI5zmN1ODxlSEj0mUPskyoNJRX8",
+	"Udv70dbLWshckx1qyTm9Zoh0WoB/g6zlLQue6tjg1Y/1Cu5otkE0dqnnJ7IoWOaFbDMegrgd1T7+p/cC",
+	"5L9KEP1blaMmWLgslOpd6w1Eb6nftChaYSvnZ+1gGcpliKDtQt4I4vslSmq3UVIxG/wSJ/XQcVJnnUG5",
+	"r1er0rpUtaBrvZSmFRlVhebaY5aC/UssEncUWpqlTGfnahuNS1bU70sYY+vQ7LtEuYc441uqw4LGn/Hu",
+	"QpGQ9f8orWugmsG+O63kHTXkWdA+vQmvI6i54879ds0cReH9ZK1kXmYsry/tyZXDDCgS7hCGf9pj21kS",
+	"lCwKYMQOY4KngnFh4Hbic/9NK6/IYi0sq64mhKmGqPYESSWBfGsKn3NW5I6eyIyZWwZ7fisDYhJ5LUjJ",
+	"7RH/SouS+exeWDWG/Ppx6nFbZumntiQFxtN8H2C398OB0cG9NWpkmuduXEwPT/ilMYIAHoYKDPBxw8IY",
+	"zBp/P/xx34q4fSOvxphrzHJwi17y+bxb9/ga4HFX65zP50yN3Se7q9tmJ9TIJmHZAmtb2idoZOr3Bkbw",
+	"c3x3GgAcQtAbLq47Q7ClirXO+PwINxxruXGD9egZIx1fW3kAO+M/7upol5o3gk1iEShTcZavOBqHryyW",
+	"rqwoczgbQcQ4aDTztOs0Hd5HbcYr2Y6u68ktA9p1+GpYua4m2J1u7Rc6qFdXcA7h7Dw6Uxq7mnOnfJgo",
+	"ZjpSPag1o2K0mnvazQSNOKZBPQIiuO4mWpCbe/LEe2wEo4o4hIu2BbELwee1HJ/WzUXLdN5QyDIatKxV",
+	"6khHflHu1bA7WBXiEYKK0LVUX5emN/NzVpRsrTi4vspZwfXS5hnWE2HvEz7noTgvpGlpondKl2w95/eU",
+	"yXfzBjUX1pucdYe9jlOaEgFvXfajIWrYRhonahUlaCE8uhP6BoVmNfzQ0nAn2ktq3fFL7Yg8rKo6A+z5",
+	"TDhcGJhmRu/YQHFPxaCZuo7AWkh94mpcVaqFrlIopmVxw/LTMF99zD+zjW7V2okv5cFmAUG32jkBbQgY",
+	"r+7nMRSBJgbCwPuMeRbaxAK6iMLFFiQOUBecZY2NcBKklAyXB+s0TK6J8Vk/gGcubKCzjxZqE0n64nEK",
+	"N44Kq95dZUch787epC+YLFPMdHgLc2IfQ3wKy6JKS/+9B2jYc3jYO7dvLRnNO6LESpUoHXE807IoDQPg",
+	"iJHk9O35hY8HMnJYi4RBp75Kl1tIcstKszxnGrajWzq5F0I1KqnIiislg6U4k/KaMxttFfLlXa2lWpg+",
+	"WGTXXDHM1Zk8P3z+Yu/wxd6zry6ePTv68vnR4eHfEFycDkCDL1bMLGWONcdc4Q+8/VwzceFQYN8HKtX2",
+	"qhwddmGSw2cXzw6PDg/dJK5kx4QW7P3+ClXS/3Jg7md4aYLjbVJq9dPhs29/97e/fP3Vqxf//eeTX//1",
+	"t3/57ruTZ7/96vl//67Sf45sYQRWOxM6Jv/4sW2mDkhpu2bW3NtTGhYjWvCFqGnSV1Qx+pPDx5XfFhx9",
+	"s4WXqI7/PlF7Hr1a35XmOr5RFGhXsxUIrCzIOkcz+OXvowD1K7+KGv1Ee93mIz1ceQuMe21e0R3eij+W",
+	"xfUFXdirr/M1J8o/KUbHBqimPamRAuzdyVRjCKpLGRjmdweEn2JwNZ1VjyoN0wca2/yEyXTiYkAm04lL",
+	"TIDfWMEMGw427nGtNeDqLPcWBbU0MOWwhDWFBFwV4AjhIfkydV2wj0ZrTklKSOWCeAdpbeRBuug9j6sx",
+	"px7sFBqtDWlc0aOqwA5gkvmKVvCH48COSjPbFjoaV9to3Vk6yter82+EEEhrv+e6aaHvp0IPwbqvhJRF",
+	"5OjKWXVcBkBHodM9Pd1+/WgTUiuf0wXBXEzpJV+n81b6ynO9ZJpb23G7TNcwSptLmA6W6ArqefNK1KHu",
+	"j7/fpBT/caqu7ofUj9cu3sIFX9EiODSD49/PAsqQLemIWdWKidz6pdrJT/e3EuU2RuD7rrvOyAv7NRd5",
+	"fAC0bsHJ1FK/9O2uUsfhzYY5OWDSvj8DPSeo2tW1qMdNWu1j9+UviozoV3MwTsInVwXySpkOEHvBghBv",
+	"yUBsxAkqrX1lUE5qGPImyEYISAhOhGuTHrSbbem5b82mWWH3BOOCI+f+Tt3lRhK9lLdwx/LchBdgh97q",
+	"HpJ057uIQxusODl69vyrBCh3MQR8rRjbg0WSP52//T6+ra+Dl91dC/vCDcY41Pvt/hXtdAeItKiHJgKI",
+	"XBSM9/03bNxzrpi+Nw0p9gsVffZUNKD1BD0EP3FFQiNKAX0kWAJAWQMiyDC+5z41DFsycuuqfkbCdoO9",
+	"rISQabPkuiEfoz3+9bPno8kNK3eTULkbCc4RFubeK6m1J7sUKa24CH/vJHUiIRNslLmrvfGsfX3prTgW",
+	"UHk7rvSY5eKiYIoIxnJ7FLGcG6mIkgUbwdp9tX4HMy+q9XtD9TiqbrlhgGaclyayXT8gCY+mwEFx9AhU",
+	"s4M9uqCLTntEJgtrMokKe/wKKnjQvfnx3tc/fvjNx/+YjEPRb14McFlqKd1QV0Wau2Dv2qadAPGyXBc8",
+	"C3vVQdmBaeUNU4rnrLKW2KPPjeKr0PZoir1y9mX1lw88dRYtPwPLG6M3qXzwepOWu99jwRQsJV3Vehwz",
+	"6aitaKEdDSzWRNBtq/JPiGKmVCLEI6KhAFE9srD0eJvmdBJXd3412qDslTLBbouNt8bGQ1nT7NSSC9JO",
+	"BrvUaU7eroBkp+3VlspNh2fu5WzOrfsIXrLNLRCt9dqWDUmyvQf8vh7uXfqix7udLer6rSsWc+OPBbcd",
+	"QwYVP2w3WNsIy50JbF9CqR8ntthx+GsMWvzAYw1O0RR9cEb2plZqpWDZViQ1rXuX7sKzoxmht+CFzuS6",
+	"gdrB+mS6tLgZjEZCeML01YfTGsqSOA8+9HG6IobGRjHyoZR/8JD7YAY5H6kujr8qusl756xXdm2t9666",
+	"ZuLUbQXBTX0WsfYHsV8+3odG3aB72o2MWT2j2TJYGKZ4b/YgSJU3eslsFQvaYuzWGyBegIZ0t2ZwCrmS",
+	"tRAfXSkJDmf2kpggGBh6q2CfZOAfe29OSqVTjkj7e6USvDdYaSXEfEtRpeqt6RiPi4U5xXdv5IJ31704",
+	"USy32Qp7EH6exzYEm62CN7ZmcMGgU79yNU3QSXGo8v/z/MvJx5GOrrN6xxervtWA6Jn7Po6w04JyYeyG",
+	"2HesnVdTw/WcMx2HXLhU/zpc8XIjWNaV42YHvrPv5A1K0YGzfrhDgsSWCC0Dg69oDpxCr9HJK0sM6OGY",
+	"EOjbOd6vqnkAr2+JqetfsvNIZS4ZLuQ8tuS7tIaWPvysHH584ff7oyUGLoUZ7FN5bB2z/6SNKIOOa2lk",
+	"7JxLdUsx9NzLwa4WmG28rZVMptV7j82eXrMMrjTEvlnNCyVt5a03/Vqmfr9mimM9QiPJjBFzC9HIeUdJ",
+	"k5wrlpl3iifvWgXPuCH+LfLu7DUM6m/jtcobYCwDDo77SlUBktHaK6ZeGrPWRwcHdL3eB1G75xqm0fX6",
+	"QILIxJOhYIbVdl/xybRPQev00dlXrCcTt7Yy2McAbqHgmWSk9Ns1hfYjN5iG5G3NKxCBlAsXgh/ycpZV",
+	"jTGsyeXQuN8RJHR6nSp283VBoehI7o0ht67Gz+mfT16B1wDK6cwYYWIuVcbyVEmfjyN5olM3cBgMwQ11",
+	"T2hXEqMb910qjBCjB5fURHqFrRgi10y4OALDRckac44lO19R1naZhXPuQFrCe35w8/wA/vF/7bQ/8fwP",
+	"+/v7I8gwkzk7WYLhOJlKhxsC75DMv0RypvhN7D2yBoyOgMvaBN+56MJ2Wxb4PYq3wPolOHgCgs55/uoA",
+	"6VuHBxbmcR4fUgrDC1cuwOULek7W+12lHVi65j4jLFvKyh2zDnRmk2TjnR+h0TWJrvMoeOUgHzS7uzJV",
+	"3kpWgzFITiMDBmp4Sbkj85RIEfa626B03IF64Gqa5l8cHB7PTl6y+TfL1z//ufhOvF3/RZ2bdzc/vP+f",
+	"f+5k+z2WK9drwIITOxppb/tz6Cw+fsLNNWpKUMfJvJC3Yzi1g+bCoevQiq9FJ67nqbWydblOzs++HlNj",
+	"Ik8bd05tFckQrNNDbkHX8CCoEpIVXR1KUJuLVEj59i5dly1ZaTdTOMt4bi3SUCtbm4avORbz/W7cdXcE",
+	"fhsXXYfNK1dbEheNttNmzb2OcoK6cywu4DsbKo537Skkha5hxYY8Pxyd2+cWgaOmdAYjDS36ymRZOKvU",
+	"c18FS5Ui68lod8+HKv650TGzEesp4RrHdE3EDyd+AfGEPXv5Kl3DEX+OlrgZpOM5FwumMOks5b8JniAp",
+	"oOi7NwKEqr0t7pcZxv4NFDdg742i9dAOIALnTrihvLiPN+GOVRT9wQO1Rb0kSFT77OQ/O+W0htLUFv6l",
+	"lIa+4StukvXh4XdrJVwXFDLrbdU9q/WuGBWalKKA11ii5+qKvj/2FqGG7mKrq7nyDjgDvIjSzodkWM1t",
+	"SnyNefcKe58VZZ4uu43Gu1AqSp8y9ZJuxswe9zK6wfBH5YSO658xPNO3slT3m2opS9U5Vyine8pUumtn",
+	"e6pAMbbIrmdCV/UY0NkxHRenFqbXwjB1Q4vO8nrnS6mwQnrOoPZTXIkAeDy0hrKiW2NxSiieC18pwt3w",
+	"tuavotyReq0aaFflgEBdHXuRJoa+1aUR3ck2Z2wtU01BTgsqSGF5Jwr8j4jaltWxd5hbuPxwEyWWZFLo",
+	"cpUKYysCo/adTjFPg/wpqOgA0lqnQ98n/GTavqt4qH1MTOLOShdsFFjv8M2WvAIQp355frxOxL/z07V8",
+	"2khfuo3LvCFVekzXDTVpN2KpYvo3VJukUKoolTBha9tt1ZSrOUlaHo2ZpUsOJQ3nyWlTC07tpjdWg8t8",
+	"dEAfE0oWLp7P1gxz5LlWXOIV1YYsxCECj22FP0321EY7h7tKun5WVQVyzbJScbMhtGDKBuA9tMn+3CiJ",
+	"LQLS9vq6rZ48WXGxT76CswRuCwUzeFH5f9yBo59ua82PHOdf7cS2Xyen7kx2TEypeWliuxpyud6IbKmk",
+	"kKWux5qAiRlun7ZKSErr2TZV0qmx7YiWOj47UlHHOdjLjta+7SbKzTilOBfax8VWQA0mnw7H1ticwICz",
+	"1L42khteMpNkOvs7XuaM4rPSMKe76mYRSap9CBgXzVjuhqWGGraQNtBhu1pq4zZmKCHGtV6KnkX12e8b",
+	"/INmUJ8M63NphBRs4p49n0wndM2v2SaZULPL4KF6GkoFWYWCbQKMGhTTH1XjyWK85zpNkEOhNdU8I0Du",
+	"TOh6A33/bhn8P1FsrZhmwkQZEy1ixytkvaFbm853lJjVQWoj9z2NllqqdyvjNna5pzKylq7ct09zdOnZ",
+	"aBex1r/EvbXT4g4GXa5XtSRPB4DrQ2CH359MA0NFJx6SdZKVuivg1h2LRBflwpomriyYV7b/oyZXOPrV",
+	"/tZeU7fcNPYxXOSsFKJHQ3MN4kOKTDBbulj7Pn9y18lUT8qhkY96ZWerqhXfJ2DfTZ9cu+1qP2MJL0Ha",
+	"nD9oYB+yid/RvpyAdCCCcHOHsMG4y/+ggIze7cpFrI3XuywnGrvxH0JxeozgHwbKR0UBPag2BGntm1P6",
+	"PhXIkPvkIuSlR54gmmuv7U9RXcImd5fCy4Ap0Suz/lZqY/8FPTjtv869+v/EQDcg1C4NQKIInMhPp4Sv",
+	"qPsS/gVfwr3hUsBf/ut0E5UQerAl/d0xItL7XPpc22M80+3976LqmsdviDZ7HN540N2dQUJdupCXHM/y",
+	"UyD+wEuzmmE9QvuuWc1C1sNk+Hm/mhQDNV5VgrtYPMeIYhLxNEMgd8chP1gsvaeQdEWVYXeAkxYjC7Ig",
+	"B9rSNNuzYakfLug/WkeYLFbRUzt3QRcD+RK2Txo1hmbLoGL1ZEz43KtGpiN7T/CR9V/7PnJXv5rPv/rq",
+	"8DDdHOx/TfbFBV30c7qhi/EMfkET5NeAEwdMgfIOAe2rKHBKFVYEt0vCenKq9G3gXJh1XOM06yoiML7u",
+	"Pntv029JsopwqKby+NX2HyS3+ywqqtJVb3+LevrDuwzN8H2jmE6VTrsXcC1F8XY+Ofr7+Eb+fvjJxx+n",
+	"HS1qULSs18XGxaNeM7bWLWoqbqEfvi2c1r5YtY4uP20/EuJma50IWIdebOOXHw+cWLp90Fy4YmgK07VA",
+	"zyo8u3/FDsr+9XYXfBhibtpRyvt+/J0e8xfGvg9j93hvTLasumf6UOZ1bef1iMDzcbnrrQNlqPyC/SAP",
+	"1ReksmxhJMkKRpVji/DJLvOC2a2vv2CLMbTPs/uWYehowFMjB12n/HFafZu5R+UfVd+do8Y42ukXFTAr",
+	"+Jxlm8wVOi/H0E5XVzFfWKw5ZGy+q8zPrrpgXpUXzIfLCvZcvSwy6qUF0r0epKsfN4WFYvtSp97aThma",
+	"cNOjG++q8kDHAkLShzUDdp5ovhdBH11VKSvwcrLxQBKTOmU5Df4gzJlYh1Bel8s229TMtznxzdmHMrOb",
+	"gZTW+xzaSVeePS7Iu4uTLdoYjnImJ/pQRVENw/5gfic/oPf9DR6MEEKAmXOjeupFnRUIlpHUel4WpIAB",
+	"7h5yl257ccqUD/4s2A0rKnnrtvDJlbUt244YWGKlq7NPh0B505RNT65ci1zoHWT1yB32I8TIKw99UKK2",
+	"IbrUhdJTjuvzkbjbD90w+x3wp1CpJks4r8ZWYvpHKQ0dEiVxiNa9SgbvvmpvyybW6ZK8syPyQY31A2UC",
+	"0eOx6a/zegEBDj6rgYt2BIQVdsHPZmNlWFPaRTE9GDIxOZr0hCO0onfcN+leWHulTkVleKCpRiXWxlku",
+	"bCmmZqTR5B6xERa2FH7DIZlAK6Mrgs/Q99aoOBLCM7nRzo32eVQhuYNqsFvjmRN14yVcgKYrGCW8YE/p",
+	"Xoy7B6NNbg2NK2X9vY1pZNRY3cm0k2mAsBcV/Q1Ewmu6Q86TGcNbsuse0JF2fAcsDZono8F7F+jQ3eX/",
+	"bvipbaFvho5pslBUmKBNrXbCdUFdHNb57sZgeqSXojOqq65I9PsG6tO3K2twdsuURh9rO65AT10YgCa0",
+	"0P66hFsAkQiA8qmrQE1WVNCFDyPQ+M6KboitEx/f/G5wSvgBh55MJzhC+s7nwzfBvrhyt86+0BXfsaLe",
+	"mKLKd6zCWgAkDp/YV73QOprErRSqTaFr/mcGNI4RwYYpQYuXMuu4/S4EWZQ8ZwUXzOICw32doWhJRT6T",
+	"8nrieoBUabWNTO4c9GkgZ4w452IunVHOUFsbx9HqBFL7pDL/1RigWhRG9J4W1ADNkXP7+uD8bti2U//C",
+	"dYQl5/gyOT59bVtIt2y9az8nRsFirzDi0yeQjkIyha2UeSng+BcY94JKmN4n72xVfyOJFDNJbTV0pad2",
+	"e22Ib4gR0lMcV6psyVB7YDa0wJYTuRTRRuj9S3EpfvWrX5Fv+WJZQOyXvhR7xN89K/tFuMPi5SZWTKY1",
+	"XWbqYo4tEVqWWDFh9mFYBIMsWbFmtrYkF9xwABA+inJcucr3AAsb4moYyUayrsbxvgs1xyUGZSKGbWZT",
+	"LTbLBxzPSo4tNxk1gK95QRd6Guamhs94wc2GCGlYhZpvmDG2qzQG5l6KZ/vkxBd/xQPmhlNyhX1qDm6e",
+	"HeDWXDWX1FD5fpalEmyzfyme75PjONoLj3VmOymGCOosjiFRDpEBN4hLOcMKAVSke6HsX4ov98kJLYou",
+	"60TlzvzmlV0JvHiwYleVQUDXpUvwp1LIp9SGihyI0z11nX8uxaU4Y3Ob5Q2DMHHDlRSrqvyxVF5X1zxn",
+	"M2pflQtIJ/K6pU34szSmDV1wsbB7V8iMFtAtqNqyM0Cky5S5LA8Pn/+GKFZwavcXSOelc0io8OYR+c//",
+	"fPb80BdUwBLEZMVFadh//if+Ucebj8iD0f5YKg3sWTBFRcaObCIS0RDiqkm5huV8eZgcGzOUaJaxtXEl",
+	"tb48JNomDuHYx0UR7RG8DRJjiaV2MdHr24uL03MiRbH5vUdMAy/4FQxtmHVArksFTFIhzMlDj6zzN8cw",
+	"9WuRoeWGKF/VESgdtkmKPSg2QZQ0oQBuSGkkz18c/JaYpZLlwpGNNVrAFLTAVX1t44/2SrWACRAtVnZh",
+	"fQZKDM+uWVRkI6d6aUWfdMWHSIfMx/FPFVvxcoXphZpwgUk0JGe5273zAsqNwHEuWGEFsbnlGdvbMKqK",
+	"jc3CMSxDQWGTS1HBLnjGnBrqDhZwlyjODNxuh46Tgi1ocWCYWuF5hv94O3eX7ZHfTSeGmyIcadX5M8FC",
+	"mLbR5ORw/9n+4WQ6eb9XyIW07j5zwd4b/92KquHjl2rNjD6YKSpy+xBG28uput7XN1ZBgf2iaz45mny5",
+	"f7j/peu9hWrBAZ3J0uz/7NpTLlItxc6YUZzdMCucq1BblOVwVITS0cGnkRLtbuVTELkrbkiUn2qlBLaM",
+	"YrkbjMxKkReMcGFVUGBkG3nnOA0Iem3tSbayiiAzV48FGGmJHlGSLVl2jRLZ0SLJOV0IqQ3PkFykbwkO",
+	"+u7kG2aOASOT6cRzFOLp+eGhV2tcxrOrNgpfHnj0WX16sPgZTFAFnn1saS74grdaAxW+OHyRUiORrMJ2",
+	"lCKwd00tnRz9/UewCrloKVcNd8aIpSJIEbdSlzMkXYwA+XvVMuNHINFM5uwcTRsa/dEYrDM5mvyl5Mik",
+	"LLu2R1Bmm7sVVCwmRxP3l03Oc3+TPU0CNa95qzRQRZGX4Fqq5vqagT/xyUzJW83U02qWn+kNtZiJ55rD",
+	"60++GDfVF08vBSH7cHo8eaKYfkr+AIL2SwaCFd948jR+ZSbzTfVOJoWGGORCLuyTp79vwH66MUtoLe+P",
+	"lwj6NT6KIecrJFT/7qW4FEG8/yH8vL9gZvTypuibkKX5w7PDp9Vw+5h4+9Ncqp/sAfDk6aVAlnwSXgmL",
+	"n3z8EegK1Q4wkB/UmnyupTZdLniz8UUQ4ZjLlcTCC+Hg8OMQzUXGfNCtr9/jjAOxN6LRW5KbNiO3C6xP",
+	"7FWVafNHmW92xs3dldw/1m/HGETREivPdidW4rUmpIp/VjX1taLlMLFrAguCVBtTk0XPBrMxwqLx/S8T",
+	"78c17Mla8RtesAXTjS9/lzJzRjU7CS0Uo/kGpb4VekHMnfqi+CLVsjYSdK4KZAdpH3zw/3ydf7TgFMwM",
+	"tIBz5o4EhQP9F2yONZRkCYp5m3Rf4gwN0q0CNzrjgapXDi4CzBgM1KC5Fz1otW7lR9/pPpCENGQuS9Hc",
+	"4Xdifa89xlvgwQdrs/p4EGxqa4hbSawEAlh0ov2CszjZlMnYX4tWQZRXeNt3AULFxjdA6shWrlMDItC6",
+	"28F59Cpkw25DD+9wjY4Wdi8DIxhrvp9REnB3ilWq/H1CEPq4n9LVpu8VgQ5dn0oCvkgHTcc80SEoX9mQ",
+	"AScguYDlwsu/Tq32axstUUXbWGZo8Jt9VNWWjRkMs9t6+StORt+WxTQzLu3fjxI8+K54ZuQxTvDPGdPM",
+	"AOKiVoCfHwchlM3ejo/MQzX//f8G5hnHD1V75xpL4I75IKNAWVswRRVN0sESb5hpMUR04CQj4ixAT7zn",
+	"fEp0qddM5FPn6YiiUHrPmnMf/vG5Hjb1SMJ/ZU5xu4fVtf6F2cSRc/e5oT1JdfCIL8XTYYjSWL6fKmDI",
+	"BRdVKBwVRNqyvJkrEw83SUqu0HB8BbaiBb9hYp+AfCVXVaH5q7hKMLvhEqyg4CU0cmHr6lWmdYzqdtmX",
+	"toogrB0NDVVReinYPvnBZYz6+QWElWVuQnbD1IasgOHrYRoAZtWVx+Ai2lwaKvm3OXOw1Frcp1q6uabE",
+	"Brpqj1SA5InPB/j1oc05B+s1oR69Hp9PvYfyHyVD66ozueKyJ9OIlVYWmMnR88NDDDi1fz1LFRvqKP1f",
+	"oaaxg7NNe/86ALPw1yBreXSb0/+ZsXUNdejECVUIERGOspkti7QuMC/cxiimwAh8UIERIhvGhR9PJ06q",
+	"s/yn2eYnvdGGrZIppe30wA0ap8GuOhmx2hJLSIBnlFGN9B1VBnU4CInQT0Avc1FQ5GrBzbKcXXVRSdwT",
+	"5R7bYQGMirlU8HVRQdRsMp57MNphEJaMKhWadRq66ADA0MXOp56jMHZeOtsmoGP20EPgXgCcUM32uNBM",
+	"aG74DSOaAXX6RhyRWHOhVilQ/tEUEn3ZMG0YzkEaQ0XQIK+uQqzJ1dNpXeCBv0KA8CWKCivIseoZCvgr",
+	"+OdZKY7htBCY92HDd62g7wBf2xCFagWeedPxZNNJmGXy49jl2Tz9mlC+gtc62coD3IaK6mxi9ZTU9D8+",
+	"oLbUbj+TUJlCAxp4cVBnmvPCVi/GnZTKnU3bq081bQUArZFN6BYrlY8+ChpB3EE/YW6aBqt4l3H6wY3S",
+	"n9AYnXRtVULBccjgNu/68hg6GcSBzQTTA5DZkfoIBpqH0w0qZrL3GWM5y8mTZulaVE18iBCGIShGnzbo",
+	"6qSjGbG3YwyQUqwgH1gHUex1qdPXa3z+gPQFQ7+UWbmCkQC2eKgNXRV3Hupzo1KL6hFkmrs1TEkproW8",
+	"jTuIY/CMp2ZM53xYYu4QbZ4Ea9ZyvP1QG/gBkSF+HbXy3TY3Ht4J7vchGv0A/2n5TLo8He2rDB5sEKdQ",
+	"j3pEpaVOIdsoMaOcIdH2W8h3aLkC2RJOFCok3jArE8GLjk+6fCAWg40trfU3Hzyi3FW7HQfxSbfl8DGZ",
+	"PMdIfv3Z7nKIwLnPPgcDY32nq7zcR93s3Z9J7Yz0RzYIjiA0dxN4dJ1n1wTpTHp3J8fkUXFAXRGPvbgG",
+	"yLo0nV09bJsH1NtKE4os+K9dKGYmVe6MRvbKwPIr74JtXNgBHGxVpw2jOSZ4lUJET6s2DHUuctUSW1VI",
+	"/l1Yqqt6y2fCYh6s0QwWCGxXHPZa6HI+5xmGLlaZQEoW7G4sdsZgdzPjmpHUOA0JES24VduWUQyWq82e",
+	"KkV3vNY5EzkGilsDHg5fixO2HODv3mgIz11Aoa51u6v1unWdezQT+T45DeUVgT+tQcbli7C8Mp3TkLdv",
+	"tU8LkVdPo+bGobKFV7e57aGUiKxRG7C9/OufdXhpwsVEjPjQjOcn7LbfvFQbTLqSpcnkio095uB+tOI6",
+	"aqlJXX/gz/Lwu2Da7M25ap5/vkoonkNsPmfZNpzpOhyx7lv9S//KvwEB19bSS8OPetsPu/Cvr6IFDDeo",
+	"FCS2b+RoK1KtN3cyQgXStQ0/egjXdh75xPf85z0dUUJ2z+e0m9PJi+e/6wO620Q5dSfxz64otws35Lqm",
+	"DXtluEpTt+rvCHe/2/JKI24Qn9txa0oNLxG+WrGcU8OKzRbU5W2dSUPFq/eRqfNRSGuaSMTgtHClfon9",
+	"3G+LV1f2Oz1xOFk8v/PxQF6DxrwA77xxf6J59cfHt6E8mL23WarQGSIjmZWH9z9TYWupsClpdWxX/dP5",
+	"2+9B0fmf4+/eVAsaywXOndt1HT6uTay5bXhJjS2yJIX3B++TY1vjzv6Jfep1o1G9PwP8F28h8MMsfdp8",
+	"7UYcTQr5V9gWn7t0rsoLDYLHJ32424xFuBsR03Z1GDiVzlHv3f8vrvzUF/P5WqtgOz+BIlQB4Jqv1cml",
+	"n0Ol8kTXxatfA5HWOZUL5BEns2Ml6AvthhuvydvEw72qimuSYyGTcaYYvYZ1YSG/Bj8ZSa4SET5XU5Iz",
+	"H9mpkWHd/Rd4Tkis4aZT/MpNp9mqVj3238Vklaq1+5nwmAVqvDUYX/98TVUn/uJu2YerrOQmUHezRud4",
+	"RuLauP5bSdXPh5R86977VPpfb6PXEGUZR1Q+SNTkQ+t/Ds199p/qbuL37nNV2N7wqvhktFtYefpOxKqY",
+	"7ZXXHb38CqN+fey+rM8zdQUQXBUTiAW3b7oKNLIoII8e68n4BnagXwq4U5VWw/QgdEcMnwUgPx9eCYj7",
+	"N2IVj+daWbQEv/j3/lXYxe/UnUV6GOIg5/N5p2R/yefzz4Neww45gsXyEtTqVzOqK51RrtZUcXtDT170",
+	"lVz1grZdRHwTLjs/wLagXGgTAOwAx8i7A/NY3ANU0Mc9vkT2khoC5MRUqEQEq4dG5JVUHk5VqyH008eC",
+	"SBXB1Kl44a7Xl7oL7vzg//nxwB893T7Ds7iNBHY9i6vmT/155qttWR+dqwdR78UaTjAs9OJnbtotbQhl",
+	"9e6Z3QLdbAlIpEiWCwRAmjUmFJszxUQU7haQvyq1adSg8DdEhc0R2+ftmYP8kc2SiYH9Kj5fbh+wQbge",
+	"Jd2XpCAJMyqAT2YsdN4HtyI12ZLp2q7ZLnUPz9827POOd60x7A9k1rBkIL8YSWj4ejz3IzF3GixSlVwU",
+	"mwFYni2aTOXORuRWyynkO1d6M9XCE2txgkXSFk1r6MehhOcGa1O6YouQhmWH6DRu2A6m/+JmjWQ31s/E",
+	"mmGBGrRmREwNBO0ks1SONvBHKtxuPoaxI5obSs2htT4VTn1Ho8hS+iKXvm3tUuo2k3S0yx3NtoMZ5K8g",
+	"jdBWHKw5CbxtMUTsNew2imlmnKbrfqqKoTatj23ua/Z7+bexK37SpPMuHvTJ5vEGu1zsUZnnn3uk6aju",
+	"P6N5Bt46+IA5mL35Ce+EoYtHVuKGKjvRRait8Mg0dkEXGCmfLVn+GPJ5UEEydNGTGAGA9rh1gAZc8ssA",
+	"DU3TCtF21459coyVeFEQI+SthoO2Uk9GleIgW/GiI/fkui1bL36hyhpV+iLHnz1VHpsxVNk0Do+Wa7ds",
+	"tpTyuqeAxjYk68oxGiwqnSlmiOYLW6pXroCObfBxuoiqYvQHB82/SQ6RX06CBt2jqjTt4yUU3euSGcgU",
+	"R6lcyVzjI0dOey5+jHWmJQGJvDt74wrLI6koljF+U1GJa+rZGvJOZB53OEnK5u8kTq1iescLZCD3pvQV",
+	"eYspnFHKULVgMV5hJTPpM8BpUTjNGP7091wXZlN9ZEolWj5+LvCqvmZKY+vQ6JELxumyMPkQkh+iTi3/",
+	"DiExVSuQzzYqJqqPOS60Pb7fQoG7yt55G/ep+Tx9+7AxrWgZI2FdLmYsanzTw8HQp2CowuaJ83gmK2l2",
+	"dA6yIscmrcwV08t6E4cV09oWc7KdT9UqmKRoniumE8eXBcMX2nyQ8gzVDFvR+fPHLpX513Zftn+UrBxB",
+	"/w5tQCdRZ4y70fmjVLc8cX2DXF+VgQKXQM7YqbKn9LXWJQOy9B05bGUk12AeiFeHzKoKRc0OQNYT4raI",
+	"rLhSUulLkej0AddjvcbCNqEefwZ/+tw4zQvbKUKwW1okCB97d25N8i4zy7eyClt99CHCLg5trb5RoURb",
+	"7i7qEUQL9n5/RZXh4r/cuPsZOk7DV1AlRuvbQ5X/n+dfWpodWYEGQNj5sRKv3m1IfeW+21Oopx8v+/2a",
+	"K6aPzeQIuPvF3uGLvWdfXTx7dvTl86PDw79NprYX4IU7hN34Wywa+MpB0NtxoM5+2I6V5VhlzLaIwfWd",
+	"M7N3gsQ2yMB12uyt7/VxSJS05MeX3a14g8YXnwK97RBqnX1sJTEr6DBRpquoZ19PhOzd2ZuhFgj/TbAZ",
+	"UV/1/pqYIZeX0H1g71vyxYklyD0giiPSpMkv/Js5+eLDpWWsy8nRZRdrXU6ml4G58MWIvS4nH8N4mdtL",
+	"vW/em0Z3gz/RG3qOu0GeYDXEweYM9JZCC5DhFg01LHwxJR8AmBUzS5kfkS8AiV9M4SdHpkfkQx1DXxyR",
+	"L9o4+ojfRIR1RL5wHWjscNDF4Qhj9vctofL55gnOTSx5wLBpjNoBSKAdALNCqX368en0UnzceZcIr3T+",
+	"gThQKwIgvRTgXo7ogDQIAeC9FJ6toy4UTro8eVprUuFe3Iej8Ykdfqtthm36g1tO3LviUgx1r4i7VLgR",
+	"ZWl6jmkrZahhOrrQfWHvwjeso1UXOXEnrF5iZjN8RcySa5vggKf7pYBCkDzjptig6QQ1ZiMJE7pUjOTy",
+	"VmijGF1V1Qi0kWsfkCgWySM62Z0moda/kYsFy2HOTq0rnE1V8St7JLVv+jfyut6xLeo++AklI2DUCajZ",
+	"JxdQsjQ9EqpT2uxeDvSzqefNO/ePIeRXeD9jHuPdrL8d6sb3qGly+Yr1GD0r20tGhRQ8o4Wr2a0kpiyl",
+	"Guvr0kWRdPXqe6cZ9Ku31iBptFF0vQYucv2rAFjm2noqBoIsbSo9sQz1zqbc3lMFdSuqq6DHrWvzdro3",
+	"B0hLrX7qa7A9XicdKpx9Eich70B0RUbKhAHBY2zHcmwM3a/YrqVWJoWOegH+gWwtxlbMi7B+eWVnyira",
+	"DZM1Gkc9rmxLyKFBMVdb+m7bZMGwI/peWCe3M3y51xsOomKTIl4rXZwC45Myg43BD9Vl4oqaYTycletO",
+	"/SwS6owfZ3TW1O4sUPdqEdGwKw23iQCawdvrpltpPbG2TF9xp2r1S9BeUJW0v2kZ8PbJu7W/4ms7AHRG",
+	"xjbF+lJYU2+7iaqL/bFHqW1p7/oOwgEYjjyp6CJRsh7tiJu7mVTj4y1eTv2MszOE2OAKI/FRh8iZHE12",
+	"c45Fi/rFrJTOB4v6W7G81nca6e3zsC9ZqoC3niXeuoCnXrcAYdLuOddhWXIk6axJ1tuAOMh8KeDP4A5l",
+	"Jc1dzUuIOrQadbPUZ2REsov91FYkS3BH5ItunH3xGBaisHtkYPsewe4TdmYnhp9KYg03NY5LWITPbFJR",
+	"3FKY+uCIernvZKrlSTX9QIeWr7EETzzxbEOuucg70rbco0Rh/8z4LA/3zzHtBZLTVzHQRblo9vOQclGw",
+	"+/XzeMg4nYD5oczL8KJvGTJWnRyhDyKDZe0J2hmV2rYVJ1lMMP4o8N8lqPqg6vQ8SN9uGMKEwbhCG4W+",
+	"rtVgdNHus87yrYl0Yg/BL8T+r0fsVemv2aaW6qC3YYMdX6P62KbSsII6DBOBxvXxxxZbRVscGKWZuteo",
+	"cNfHbB+ibkEfD7DJFLvtSYcsBXr9uVgUjKwhR8uHrzQLlQZjo6OlnFQMZFviw6VtbTautQnWdcNu4JVJ",
+	"04XXceNqrLIVN/vke2kw1DhUC5iS2yXPlmTlKz7pkrs6WwDt5cQw7XSpywm8WzA08mBhDx+wsJezORcs",
+	"J99eXJzi0uCJL4pMvqUiL2xYAzYvKzYub5NQzEUuuPCxd1yROVfaXApEkF0QEbIzpvTUov0kauw0HO9W",
+	"b/P02QW9Ndf0iWLe2mD03OgstTV7Q8AmjgiJC7QtIzmMoWeOq/BIe5RGL1YgPU/Z4pxPztuP5tSWwdpK",
+	"DjmcxhwKjGksymiDd7rj5nzxqb4aOF+7dx6QROwUQ+eMA4R4KeGIo9eOv0VTpqqgnE5Fz2/dfynUknsI",
+	"3r5DbbdnO568e4tG915CObqrmD0391Dj/tAoye71lrVp7Uf64IPvcTe6aX+ifV7Vrt/Xdra/d/Xs76pO",
+	"OJA987UDdWSvfofHe7Wm6R52qNNM1VlwyxYju0HOZ8Gph4/HqYoBvzwkp44ihfux9Bmzb3TTjuNebosM",
+	"cDbCcPMWVkWqL3xmtVP0acrp3T48X1czPuA2u1k2QyfoG7uECA0PcM+CszSe4WPiAiWELR3NY/SkHVYS",
+	"/jz44K/DH9GgJxX/J+vxX6GMjzPu91wf/4y4z7VdWEgrwmgKtFjuk9fChSNxXeVczdhcKgaXI9cws4qy",
+	"lqhtovyyVJPBBghD5oW8TVw2jv0K8O2tRRZ+5bXPO8it2Pkjb5hSPGd7fll1N9BJqY1ccVdowL9D3p29",
+	"jh1C/vd3ik+OJpU9dt2yx9rN9NjaxiWEi/aIw/XcuzVFjIefy9V6D5wd9eW7fQQqMZLINcNirjMlbxtR",
+	"LjSG7J0qYjy4Ugz71uADQS8H0mLi+cHNc6Tn/2s9nD/x/A/7+/v3xUt/IHZ4MboxbWeXedFzGQKhXnXa",
+	"sPIiIV8snzRfromK14IbTgNP1TD8iVxalnztPlZy6K7erYht0Mc1nnHA5/Vpg3nSmPg8AqgjvB6RL8Zj",
+	"tRk7bRH0gTR5m3x8rGCkRMB1nWjIdlRjo6yjLQ/uti0cal1bb7+uOdjsT5WXbbqVm6118rP3tmhpX+CK",
+	"Xaq1XVrBsaQi10uo+wQGA0VzH8gUdAMbLEBAfvgSrcdnr46DtxGSpC4F1L5iIif1eO5IcYBmrj4eO+5p",
+	"lY7wbGkFr9zqPr1SoA0VObUhZXETAl9cNsIdIi0+CPHvo8mLg8Pj2clLNv9m+frnPxffibfrv6hz8+7m",
+	"h/f/88+tzzc/9S9xMP966VW1+v0Ppjl0WFvD4F501MytbdKuifpKIHzaQJqawA0LuaPSAeCittHLoXFQ",
+	"TS2E+FPG2CTx8MmjbQChR+SLXnQ+TryN21oyvLcPG3HTsU871w9ceEO/9+LcvuQFwYOaYRpzDVlj3OtV",
+	"sERvuB+MFvmbdevjSjgFxLRQdVD3xo9A3Hntg4dEXjTREOaObe4cFbkrbY45N4lIg/t5hDyOawMPOQx6",
+	"kB+psiEkpi9z0N5/0dsfQRAAaJsg98nXUlm1dw/V1byiD2vOcq79S0E7LAFEu0AGG3KdUVGZfby37fcI",
+	"QjwyNSSXcChfCodQp3zbVKcIeK69dyhu17ZPTqoSBeDLjwHXZbYkVF+KK4x1vYJoWQDnKhLWVz4AgSoW",
+	"RQRH1coVW0lT8Y01+knF8kvBRKY2awBJChfi7QyTm0TZV79zjkEeXVPfip0CmI/UyLU9bY/8i+libE9X",
+	"h6tpI+8iTtQKjQl99EDA9R2SvbbXWpOejFDe2LsxKm9Cs4hlUpG13BRuXUwpqRoiK6C+JplghuZZ0Wlr",
+	"75VUW1+/a3xvU4m8BAlWeObbLxumBC0CiMN853X2z53/Huzuemc4RnKkr4l/75i9fw/28rSd1ArC/fIu",
+	"rLX26UbddWL1Qxw6Q4EADWoAn9juwgFqg3c3qYdJMZOljfNencs+6VFvL+CFB+S7C7oY0mIBhIcMZgIc",
+	"7CSS6YIuHiqH04//iSKZYGXpndkqhskGHBZ3rSufEGgAwehIJkMXW4YxdVeobreRhNG5DqWZbfInw25j",
+	"cQOFeu1J1ABWmhU3TFdhTqUwsoRBuiKcLKVtJ9o6iwO/SKN114FNF71lol1Uk8GFbRnSdF9sPFSZ+m35",
+	"9fAx+HVs3vYO+HWYCO7B1S6YCe8xCGMn9SR4+WBWFj2tlF7RbFkrtalJtmRoJ4C/jIYqttMmG1uNHTqj",
+	"oDbmLOXYn2UtlXHd3ikvSsV0CGXURtp6JTACqmB6nyA/DJTOTRQ3b0mLP5bFNVQp9+HNnxOP1GD7RHzS",
+	"gKFbB3lrr91kzVSE8UEmoln12gOLUOwvwqYk59r+g6psCdY/6WNUW4cRFrnfhFL4PazDVuuCmv5wwJAK",
+	"E952SW5xHlBRRD28vDpaMQ/XcRKckd09NC8CSA9chthPNGhrrVKcAmg7UFCj3TLRkoc26uCD/6fTXJIK",
+	"vqsQ75e4vYAIczx804EAZOpcc8/uV/A9xXB+4C6u+4aZRllmUyFzq0064EIbKtCU3X0yvRWFM9bZ9l9O",
+	"XVRMy+Kmuh75cfE0mlVVtPZJs38YDmCT2+Co6mkk5q9IyKhejbYm5sgM3ebY19W6dktsuz+SOkDttQk/",
+	"e8yi594lEArLBGobOonQEcE0q1o+OpJ44Dyv+7OYv77VuAwRQEcwG1Cr7uanP7IF9xmc4DTZw3TIQPKI",
+	"b5gPaqtDlHKibI+Pd3Llzy9FsvJ5XLeHEqPgI0Vev2yViS7VnFob9AIrlVh9PaHbnWF1HaZCxbodhzFF",
+	"6Ogq5PMY9aLjZe68SnuMBpplbG1YAw1n0dIJza6FvC1YvmC1utlAZK93W52vvuy+3tU1+OwSpomCU0Cf",
+	"ZlBMcLEuTee9rF7uXTkQB+rv+JU4j2HT4PKIAUI2DeGTlXB+1CAgXOuOg35+qcacrMZ8zzhhv1M7KbkT",
+	"eoD0W/V/qF57QLU9zDJ0d6rAqTRMe03UxMhd2fkr3AxY+6sXB03+ceOfhzP8360dz7Pdb2Tv5o12BdRC",
+	"Xe+8r8GsH7aras01dnNbXHPwIfx7wOR/3m7UNmPeRtTsIxUobuUaL6tSCGf0W3UZ+XuaSg1clX6o1jDS",
+	"4F9t4r3M/olrwdvKaDl4F6igGHQUpJodNfi2y+TxYHh9AMn5Eg0a/Wy3a6PH8C6g1SPiO9sIXHvqviO3",
+	"HfjPo9taIyE0zwNwtoX5DrZw90K7DeanltsOWQkysk8IzUdFsMiCfWLBIF2/xWEnElbD9ncV6miz2Qg0",
+	"z7FHv7/IhFCwEQJmJDUffLC3w97D5G3VVV+xle31tnEgI3f5cwPeKBi9iQo4yVuRMg7AKDtlluH2s+/0",
+	"FuUzHOHZ5eafnqocsofp6g3Vxrniqs+04UURTvW2a67lvLSb7D935qwRh1qvI/xT7vdDOdHvI0kPP4Ek",
+	"HV0X/bOQpY9K9SdVXiSCWu9a6QbtFLdjCn/hxTpV9PIUK6XhH9NJWa870L6WT9plK88NXUAmX/NrbX8f",
+	"McIbCckXObthhVyvbO28aqyjg4MCXlhKbY6+OvzqENnKYaJNQxoj++18JKNrOuMF1geZklnJCzDk2Wp6",
+	"GHMEJwhD/3BO5oyaUlmDsqvPFyVtvt/LuV4XdPO9fXRaUAMDVYmd7YV9RwVYuK0DNxQqmfqUKD11bXm5",
+	"yvfWVJlNvbZIDAm1Kb1NKOrskADhJdeZBFRUiT+uyqO1uzdLPsZz+pqT7Wl94lFVm7Zj6ZgFHd/2qlBc",
+	"uI0CZmqrRBdFcpkV50yTF87aLLZuJaOrlUuztrnUwFt7Njmk4qBo+tvY+NOEIbIMfZx20R1qJHbp8bYm",
+	"84Ciid1z3YNqLgxbKI8DtAsbuvhGyXJtGUE06PLtDXzJbiOxEUj1x4/T8EHwe16Wh4fPf0OO0YHTDkOu",
+	"vqh2g5wEEmkXKk19kPLQV++dyKKgM6maFTrqJ30FeYySZCzvjx///wEArVT9c++OAQA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -9,6 +9,7 @@ import (
 	authapp "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/auth"
 	componentapp "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/components"
 	monitorapp "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/monitoring"
+	workspaceapp "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/workspace"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/services/catalog"
 	"github.com/gin-gonic/gin"
 	openapitypes "github.com/oapi-codegen/runtime/types"
@@ -23,6 +24,7 @@ type Dependencies struct {
 	ComponentHandler  *componentapp.Handler
 	WebhookHandler    *areaapp.WebhookHandler
 	MonitoringHandler *monitorapp.Handler
+	WorkspaceHandler  *workspaceapp.Handler
 }
 
 // Register mounts all HTTP endpoints on the provided router
@@ -39,6 +41,7 @@ func Register(r gin.IRouter, deps Dependencies) error {
 		auth:       deps.AuthHandler,
 		area:       deps.AreaHandler,
 		components: deps.ComponentHandler,
+		workspace:  deps.WorkspaceHandler,
 	}
 
 	openapi.RegisterHandlers(r, handler)
@@ -64,6 +67,7 @@ type compositeHandler struct {
 	auth       *authapp.Handler
 	area       *areaapp.Handler
 	components *componentapp.Handler
+	workspace  *workspaceapp.Handler
 }
 
 func (h compositeHandler) GetAbout(c *gin.Context) {
@@ -82,12 +86,12 @@ func (h compositeHandler) RegisterUser(c *gin.Context) {
 	h.auth.RegisterUser(c)
 }
 
-func (h compositeHandler) ListAreas(c *gin.Context) {
+func (h compositeHandler) ListAreas(c *gin.Context, params openapi.ListAreasParams) {
 	if h.area == nil {
 		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
 		return
 	}
-	h.area.ListAreas(c)
+	h.area.ListAreas(c, params)
 }
 
 func (h compositeHandler) ListComponents(c *gin.Context, params openapi.ListComponentsParams) {
@@ -146,6 +150,102 @@ func (h compositeHandler) DuplicateArea(c *gin.Context, areaID openapitypes.UUID
 	h.area.DuplicateArea(c, areaID)
 }
 
+func (h compositeHandler) ExportArea(c *gin.Context, areaID openapitypes.UUID, params openapi.ExportAreaParams) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.ExportArea(c, areaID, params)
+}
+
+func (h compositeHandler) ImportArea(c *gin.Context) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.ImportArea(c)
+}
+
+func (h compositeHandler) GetAreaWebhook(c *gin.Context, areaID openapitypes.UUID) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.GetAreaWebhook(c, areaID)
+}
+
+func (h compositeHandler) ListAreaRevisions(c *gin.Context, areaID openapitypes.UUID, params openapi.ListAreaRevisionsParams) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.ListAreaRevisions(c, areaID, params)
+}
+
+func (h compositeHandler) DiffAreaRevisions(c *gin.Context, areaID openapitypes.UUID, params openapi.DiffAreaRevisionsParams) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.DiffAreaRevisions(c, areaID, params)
+}
+
+func (h compositeHandler) RollbackArea(c *gin.Context, areaID openapitypes.UUID, revision int) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.RollbackArea(c, areaID, revision)
+}
+
+func (h compositeHandler) DryRunArea(c *gin.Context, areaID openapitypes.UUID) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.DryRunArea(c, areaID)
+}
+
+func (h compositeHandler) ListAreaTemplates(c *gin.Context) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.ListAreaTemplates(c)
+}
+
+func (h compositeHandler) GetAreaTemplate(c *gin.Context, templateID openapitypes.UUID) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.GetAreaTemplate(c, templateID)
+}
+
+func (h compositeHandler) InstantiateAreaTemplate(c *gin.Context, templateID openapitypes.UUID) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.InstantiateAreaTemplate(c, templateID)
+}
+
+func (h compositeHandler) CreateAreaTemplate(c *gin.Context) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.CreateAreaTemplate(c)
+}
+
+func (h compositeHandler) DeleteAreaTemplate(c *gin.Context, templateID openapitypes.UUID) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.DeleteAreaTemplate(c, templateID)
+}
+
 func (h compositeHandler) ListAreaHistory(c *gin.Context, areaID openapitypes.UUID, params openapi.ListAreaHistoryParams) {
 	if h.area == nil {
 		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
@@ -154,6 +254,14 @@ func (h compositeHandler) ListAreaHistory(c *gin.Context, areaID openapitypes.UU
 	h.area.ListAreaHistory(c, areaID, params)
 }
 
+func (h compositeHandler) PreviewComponent(c *gin.Context, componentID openapitypes.UUID) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.PreviewComponent(c, componentID)
+}
+
 func (h compositeHandler) VerifyEmail(c *gin.Context) {
 	if h.auth == nil {
 		c.JSON(http.StatusNotImplemented, gin.H{"error": "auth handler missing"})
@@ -305,3 +413,187 @@ func (h compositeHandler) UnsubscribeService(c *gin.Context, provider string) {
 	}
 	h.auth.UnsubscribeService(c, provider)
 }
+
+func (h compositeHandler) MoveAreaWorkspace(c *gin.Context, areaID openapitypes.UUID) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.MoveAreaWorkspace(c, areaID)
+}
+
+func (h compositeHandler) SetAreaActivitySchedule(c *gin.Context, areaID openapitypes.UUID) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.SetAreaActivitySchedule(c, areaID)
+}
+
+func (h compositeHandler) SetAreaHealthPolicy(c *gin.Context, areaID openapitypes.UUID) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.SetAreaHealthPolicy(c, areaID)
+}
+
+func (h compositeHandler) SetAreaRunner(c *gin.Context, areaID openapitypes.UUID) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.SetAreaRunner(c, areaID)
+}
+
+func (h compositeHandler) MoveAreaFolder(c *gin.Context, areaID openapitypes.UUID) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.MoveAreaFolder(c, areaID)
+}
+
+func (h compositeHandler) TagArea(c *gin.Context, areaID openapitypes.UUID, tagID openapi.TagId) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.TagArea(c, areaID, tagID)
+}
+
+func (h compositeHandler) UntagArea(c *gin.Context, areaID openapitypes.UUID, tagID openapi.TagId) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.UntagArea(c, areaID, tagID)
+}
+
+func (h compositeHandler) ListTags(c *gin.Context) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.ListTags(c)
+}
+
+func (h compositeHandler) CreateTag(c *gin.Context) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.CreateTag(c)
+}
+
+func (h compositeHandler) UpdateTag(c *gin.Context, tagID openapi.TagId) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.UpdateTag(c, tagID)
+}
+
+func (h compositeHandler) DeleteTag(c *gin.Context, tagID openapi.TagId) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.DeleteTag(c, tagID)
+}
+
+func (h compositeHandler) BulkTagAction(c *gin.Context, tagID openapi.TagId) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.BulkTagAction(c, tagID)
+}
+
+func (h compositeHandler) ListFolders(c *gin.Context) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.ListFolders(c)
+}
+
+func (h compositeHandler) CreateFolder(c *gin.Context) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.CreateFolder(c)
+}
+
+func (h compositeHandler) UpdateFolder(c *gin.Context, folderID openapi.FolderId) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.UpdateFolder(c, folderID)
+}
+
+func (h compositeHandler) DeleteFolder(c *gin.Context, folderID openapi.FolderId) {
+	if h.area == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "area handler missing"})
+		return
+	}
+	h.area.DeleteFolder(c, folderID)
+}
+
+func (h compositeHandler) ListWorkspaces(c *gin.Context) {
+	if h.workspace == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "workspace handler missing"})
+		return
+	}
+	h.workspace.ListWorkspaces(c)
+}
+
+func (h compositeHandler) CreateWorkspace(c *gin.Context) {
+	if h.workspace == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "workspace handler missing"})
+		return
+	}
+	h.workspace.CreateWorkspace(c)
+}
+
+func (h compositeHandler) GetWorkspace(c *gin.Context, workspaceID openapitypes.UUID) {
+	if h.workspace == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "workspace handler missing"})
+		return
+	}
+	h.workspace.GetWorkspace(c, workspaceID)
+}
+
+func (h compositeHandler) DeleteWorkspace(c *gin.Context, workspaceID openapitypes.UUID) {
+	if h.workspace == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "workspace handler missing"})
+		return
+	}
+	h.workspace.DeleteWorkspace(c, workspaceID)
+}
+
+func (h compositeHandler) AddWorkspaceMember(c *gin.Context, workspaceID openapitypes.UUID) {
+	if h.workspace == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "workspace handler missing"})
+		return
+	}
+	h.workspace.AddWorkspaceMember(c, workspaceID)
+}
+
+func (h compositeHandler) UpdateWorkspaceMember(c *gin.Context, workspaceID openapitypes.UUID, userID openapitypes.UUID) {
+	if h.workspace == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "workspace handler missing"})
+		return
+	}
+	h.workspace.UpdateWorkspaceMember(c, workspaceID, userID)
+}
+
+func (h compositeHandler) RemoveWorkspaceMember(c *gin.Context, workspaceID openapitypes.UUID, userID openapitypes.UUID) {
+	if h.workspace == nil {
+		c.JSON(http.StatusNotImplemented, gin.H{"error": "workspace handler missing"})
+		return
+	}
+	h.workspace.RemoveWorkspaceMember(c, workspaceID, userID)
+}
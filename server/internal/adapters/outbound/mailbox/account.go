@@ -0,0 +1,153 @@
+package mailbox
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"strconv"
+	"strings"
+)
+
+// ProviderName is the service provider and identity provider key of generic mailboxes
+const ProviderName = "email"
+
+// ErrIMAPNotConfigured is returned when reading a mailbox whose account has no IMAP server
+var ErrIMAPNotConfigured = errors.New("mailbox: imap server not configured")
+
+// Security selects how a connection to a mail server is encrypted
+type Security string
+
+const (
+	// SecurityTLS opens the connection over implicit TLS (SMTPS on 465, IMAPS on 993)
+	SecurityTLS Security = "tls"
+	// SecuritySTARTTLS upgrades a plain connection with STARTTLS before authenticating
+	SecuritySTARTTLS Security = "starttls"
+	// SecurityNone keeps the connection unencrypted, credentials are then only sent to loopback hosts
+	SecurityNone Security = "none"
+)
+
+// Server locates an SMTP or IMAP endpoint
+type Server struct {
+	Host     string   `json:"host"`
+	Port     int      `json:"port"`
+	Security Security `json:"security"`
+}
+
+// Address returns the host:port dial address of the server
+func (s Server) Address() string {
+	return net.JoinHostPort(s.Host, strconv.Itoa(s.Port))
+}
+
+// Configured reports whether a host has been set
+func (s Server) Configured() bool {
+	return strings.TrimSpace(s.Host) != ""
+}
+
+// Account holds the credentials of a mailbox reachable over SMTP and IMAP
+// It is stored encrypted as the access token of the linked identity
+type Account struct {
+	Address  string `json:"address"`
+	Username string `json:"username"`
+	Password string `json:"password"`
+	SMTP     Server `json:"smtp"`
+	IMAP     Server `json:"imap"`
+}
+
+// ParseAccount builds an account from the credential fields submitted when linking the provider
+// Recognised keys are address, username, password, smtpHost, smtpPort, smtpSecurity, imapHost, imapPort and imapSecurity
+func ParseAccount(fields map[string]string) (Account, error) {
+	value := func(key string) string {
+		return strings.TrimSpace(fields[key])
+	}
+
+	address := value("address")
+	if address == "" {
+		return Account{}, fmt.Errorf("address is required")
+	}
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return Account{}, fmt.Errorf("address invalid: %w", err)
+	}
+
+	account := Account{
+		Address:  parsed.Address,
+		Username: value("username"),
+		Password: fields["password"],
+	}
+	if account.Username == "" {
+		account.Username = account.Address
+	}
+	if account.Password == "" {
+		return Account{}, fmt.Errorf("password is required")
+	}
+
+	account.SMTP, err = parseServer(value("smtpHost"), value("smtpPort"), value("smtpSecurity"), SecuritySTARTTLS, smtpDefaultPorts)
+	if err != nil {
+		return Account{}, fmt.Errorf("smtp: %w", err)
+	}
+	if !account.SMTP.Configured() {
+		return Account{}, fmt.Errorf("smtpHost is required")
+	}
+	if value("imapHost") != "" {
+		account.IMAP, err = parseServer(value("imapHost"), value("imapPort"), value("imapSecurity"), SecurityTLS, imapDefaultPorts)
+		if err != nil {
+			return Account{}, fmt.Errorf("imap: %w", err)
+		}
+	}
+	return account, nil
+}
+
+var (
+	smtpDefaultPorts = map[Security]int{SecurityTLS: 465, SecuritySTARTTLS: 587, SecurityNone: 25}
+	imapDefaultPorts = map[Security]int{SecurityTLS: 993, SecuritySTARTTLS: 143, SecurityNone: 143}
+)
+
+func parseServer(host string, port string, security string, fallback Security, defaultPorts map[Security]int) (Server, error) {
+	if host == "" {
+		return Server{}, nil
+	}
+	if strings.ContainsAny(host, "/: ") && net.ParseIP(host) == nil {
+		return Server{}, fmt.Errorf("host %q invalid", host)
+	}
+
+	server := Server{Host: host, Security: fallback}
+	if security != "" {
+		server.Security = Security(strings.ToLower(security))
+		if _, ok := defaultPorts[server.Security]; !ok {
+			return Server{}, fmt.Errorf("security %q unsupported", security)
+		}
+	}
+
+	server.Port = defaultPorts[server.Security]
+	if port != "" {
+		value, err := strconv.Atoi(port)
+		if err != nil || value <= 0 || value > 65535 {
+			return Server{}, fmt.Errorf("port %q invalid", port)
+		}
+		server.Port = value
+	}
+	return server, nil
+}
+
+// Encode serializes the account for storage as an identity access token
+func (a Account) Encode() (string, error) {
+	raw, err := json.Marshal(a)
+	if err != nil {
+		return "", fmt.Errorf("mailbox.Account.Encode: %w", err)
+	}
+	return string(raw), nil
+}
+
+// DecodeAccount restores an account serialized with Encode
+func DecodeAccount(secret string) (Account, error) {
+	var account Account
+	if err := json.Unmarshal([]byte(secret), &account); err != nil {
+		return Account{}, fmt.Errorf("mailbox.DecodeAccount: %w", err)
+	}
+	if account.Address == "" || !account.SMTP.Configured() {
+		return Account{}, fmt.Errorf("mailbox.DecodeAccount: account incomplete")
+	}
+	return account, nil
+}
@@ -0,0 +1,86 @@
+package mailbox
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/security/netguard"
+)
+
+const defaultDialTimeout = 30 * time.Second
+
+// Dialer opens connections to SMTP and IMAP servers
+// The zero value dials with a 30 second timeout and the system certificate pool
+// and refuses loopback, private and link-local addresses since accounts are supplied by users
+type Dialer struct {
+	Timeout   time.Duration
+	TLSConfig *tls.Config
+	// AllowPrivate lets the dialer reach internal addresses, reserved to servers set by the operator and to tests
+	AllowPrivate bool
+}
+
+// dial connects to the server, negotiating implicit TLS when required
+// The connection deadline follows the context so a stalled server cannot block a poll forever
+func (d Dialer) dial(ctx context.Context, server Server) (net.Conn, error) {
+	timeout := d.Timeout
+	if timeout <= 0 {
+		timeout = defaultDialTimeout
+	}
+	deadline := time.Now().Add(timeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	netDialer := net.Dialer{Deadline: deadline}
+	if !d.AllowPrivate {
+		if err := netguard.CheckHost(server.Host); err != nil {
+			return nil, fmt.Errorf("dial %s: %w", server.Address(), err)
+		}
+		netDialer.Control = netguard.Control
+	}
+	conn, err := netDialer.DialContext(ctx, "tcp", server.Address())
+	if err != nil {
+		return nil, fmt.Errorf("dial %s: %w", server.Address(), err)
+	}
+	if err := conn.SetDeadline(deadline); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("set deadline: %w", err)
+	}
+	if server.Security != SecurityTLS {
+		return conn, nil
+	}
+
+	tlsConn := tls.Client(conn, d.tlsConfig(server.Host))
+	if err := tlsConn.HandshakeContext(ctx); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("tls handshake %s: %w", server.Address(), err)
+	}
+	return tlsConn, nil
+}
+
+func (d Dialer) tlsConfig(host string) *tls.Config {
+	cfg := &tls.Config{MinVersion: tls.VersionTLS12}
+	if d.TLSConfig != nil {
+		cfg = d.TLSConfig.Clone()
+	}
+	if cfg.ServerName == "" {
+		cfg.ServerName = host
+	}
+	return cfg
+}
+
+// plaintextAllowed reports whether credentials may cross an unencrypted connection to host
+// Only loopback servers of a dialer allowed to reach internal addresses qualify
+func (d Dialer) plaintextAllowed(host string) bool {
+	if !d.AllowPrivate {
+		return false
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -0,0 +1,438 @@
+package mailbox
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+)
+
+// maxIMAPLiteral bounds literals accepted from the server so a hostile server cannot exhaust memory
+const maxIMAPLiteral = 1 << 20
+
+var (
+	imapLiteralSuffix = regexp.MustCompile(`\{(\d+)\+?\}\r\n$`)
+	imapUIDValidity   = regexp.MustCompile(`(?i)\[UIDVALIDITY (\d+)\]`)
+	imapUIDNext       = regexp.MustCompile(`(?i)\[UIDNEXT (\d+)\]`)
+)
+
+// imapClient speaks the small IMAP4rev1 subset needed to read new messages
+type imapClient struct {
+	conn   net.Conn
+	reader *bufio.Reader
+	tag    int
+}
+
+// imapResponse is an untagged response line, with literals inlined in raw and parsed into fields
+type imapResponse struct {
+	raw    []byte
+	fields []any
+}
+
+// openIMAP connects, upgrades with STARTTLS when requested and logs in
+func (d Dialer) openIMAP(ctx context.Context, server Server, username string, password string) (*imapClient, error) {
+	conn, err := d.dial(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+	client := &imapClient{conn: conn, reader: bufio.NewReader(conn)}
+
+	greeting, err := client.readResponse()
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("imap greeting: %w", err)
+	}
+	if !bytes.HasPrefix(bytes.ToUpper(greeting), []byte("* OK")) {
+		_ = conn.Close()
+		return nil, fmt.Errorf("imap greeting rejected: %s", strings.TrimSpace(string(greeting)))
+	}
+
+	encrypted := server.Security == SecurityTLS
+	if server.Security == SecuritySTARTTLS {
+		if _, err := client.command("STARTTLS"); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("imap STARTTLS: %w", err)
+		}
+		tlsConn := tls.Client(conn, d.tlsConfig(server.Host))
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			_ = conn.Close()
+			return nil, fmt.Errorf("imap STARTTLS handshake: %w", err)
+		}
+		client.conn = tlsConn
+		client.reader = bufio.NewReader(tlsConn)
+		encrypted = true
+	}
+	if !encrypted && !d.plaintextAllowed(server.Host) {
+		_ = conn.Close()
+		return nil, fmt.Errorf("imap refusing to send credentials to %s without encryption", server.Host)
+	}
+
+	user, err := imapQuote(username)
+	if err != nil {
+		_ = client.conn.Close()
+		return nil, fmt.Errorf("imap username: %w", err)
+	}
+	pass, err := imapQuote(password)
+	if err != nil {
+		_ = client.conn.Close()
+		return nil, fmt.Errorf("imap password: %w", err)
+	}
+	if _, err := client.command("LOGIN " + user + " " + pass); err != nil {
+		_ = client.conn.Close()
+		return nil, fmt.Errorf("imap login: %w", err)
+	}
+	return client, nil
+}
+
+// Close logs out and releases the connection
+func (c *imapClient) Close() error {
+	_, _ = c.command("LOGOUT")
+	return c.conn.Close()
+}
+
+// command sends a tagged command and collects untagged responses until its completion
+func (c *imapClient) command(line string) ([]imapResponse, error) {
+	c.tag++
+	tag := fmt.Sprintf("A%03d", c.tag)
+	if _, err := io.WriteString(c.conn, tag+" "+line+"\r\n"); err != nil {
+		return nil, fmt.Errorf("write command: %w", err)
+	}
+
+	var responses []imapResponse
+	for {
+		raw, err := c.readResponse()
+		if err != nil {
+			return nil, err
+		}
+		text := strings.TrimRight(string(raw), "\r\n")
+		switch {
+		case strings.HasPrefix(text, tag+" "):
+			status := strings.TrimPrefix(text, tag+" ")
+			if !strings.HasPrefix(strings.ToUpper(status), "OK") {
+				return nil, fmt.Errorf("%s", status)
+			}
+			return responses, nil
+		case strings.HasPrefix(text, "* "):
+			fields, err := parseIMAPFields(raw[2:])
+			if err != nil {
+				return nil, fmt.Errorf("parse response: %w", err)
+			}
+			responses = append(responses, imapResponse{raw: raw, fields: fields})
+		case strings.HasPrefix(text, "+"):
+			return nil, fmt.Errorf("unexpected continuation request")
+		}
+	}
+}
+
+// readResponse reads one response line, inlining the literals it announces
+func (c *imapClient) readResponse() ([]byte, error) {
+	var raw []byte
+	for {
+		line, err := c.reader.ReadBytes('\n')
+		if err != nil {
+			return nil, fmt.Errorf("read response: %w", err)
+		}
+		raw = append(raw, line...)
+		match := imapLiteralSuffix.FindSubmatch(line)
+		if match == nil {
+			return raw, nil
+		}
+		size, err := strconv.Atoi(string(match[1]))
+		if err != nil || size > maxIMAPLiteral {
+			return nil, fmt.Errorf("literal of %s bytes rejected", match[1])
+		}
+		literal := make([]byte, size)
+		if _, err := io.ReadFull(c.reader, literal); err != nil {
+			return nil, fmt.Errorf("read literal: %w", err)
+		}
+		raw = append(raw, literal...)
+	}
+}
+
+// status reads UIDVALIDITY and UIDNEXT without selecting the folder
+func (c *imapClient) status(folder string) (uint32, uint32, error) {
+	responses, err := c.command("STATUS " + imapMailboxName(folder) + " (UIDVALIDITY UIDNEXT)")
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, response := range responses {
+		if len(response.fields) < 3 || !strings.EqualFold(imapString(response.fields[0]), "STATUS") {
+			continue
+		}
+		attributes, _ := response.fields[2].([]any)
+		values := imapPairs(attributes)
+		validity, _ := strconv.ParseUint(values["UIDVALIDITY"], 10, 32)
+		next, _ := strconv.ParseUint(values["UIDNEXT"], 10, 32)
+		return uint32(validity), uint32(next), nil
+	}
+	return 0, 0, fmt.Errorf("STATUS response missing")
+}
+
+// examine opens the folder read-only so polling never alters flags
+func (c *imapClient) examine(folder string) (uint32, uint32, error) {
+	responses, err := c.command("EXAMINE " + imapMailboxName(folder))
+	if err != nil {
+		return 0, 0, err
+	}
+	var validity, next uint64
+	for _, response := range responses {
+		if match := imapUIDValidity.FindSubmatch(response.raw); match != nil {
+			validity, _ = strconv.ParseUint(string(match[1]), 10, 32)
+		}
+		if match := imapUIDNext.FindSubmatch(response.raw); match != nil {
+			next, _ = strconv.ParseUint(string(match[1]), 10, 32)
+		}
+	}
+	return uint32(validity), uint32(next), nil
+}
+
+// searchAfter lists the UIDs strictly greater than uid in ascending order
+func (c *imapClient) searchAfter(uid uint32) ([]uint32, error) {
+	responses, err := c.command(fmt.Sprintf("UID SEARCH UID %d:*", uint64(uid)+1))
+	if err != nil {
+		return nil, err
+	}
+	var uids []uint32
+	for _, response := range responses {
+		if len(response.fields) == 0 || !strings.EqualFold(imapString(response.fields[0]), "SEARCH") {
+			continue
+		}
+		for _, field := range response.fields[1:] {
+			value, err := strconv.ParseUint(imapString(field), 10, 32)
+			// n:* always matches the highest UID, even when it is lower than n
+			if err == nil && uint32(value) > uid {
+				uids = append(uids, uint32(value))
+			}
+		}
+	}
+	return uids, nil
+}
+
+// imapFetched carries the attributes returned for one message by UID FETCH
+type imapFetched struct {
+	uid          uint32
+	size         int64
+	internalDate string
+	body         []byte
+}
+
+// fetch loads size, arrival date and up to limit bytes of the raw message for each UID
+func (c *imapClient) fetch(uids []uint32, limit int) ([]imapFetched, error) {
+	set := make([]string, 0, len(uids))
+	for _, uid := range uids {
+		set = append(set, strconv.FormatUint(uint64(uid), 10))
+	}
+	responses, err := c.command(fmt.Sprintf("UID FETCH %s (UID RFC822.SIZE INTERNALDATE BODY.PEEK[]<0.%d>)", strings.Join(set, ","), limit))
+	if err != nil {
+		return nil, err
+	}
+
+	var fetched []imapFetched
+	for _, response := range responses {
+		if len(response.fields) < 3 || !strings.EqualFold(imapString(response.fields[1]), "FETCH") {
+			continue
+		}
+		attributes, _ := response.fields[2].([]any)
+		var message imapFetched
+		for i := 0; i+1 < len(attributes); i += 2 {
+			name := strings.ToUpper(imapString(attributes[i]))
+			switch {
+			case name == "UID":
+				value, _ := strconv.ParseUint(imapString(attributes[i+1]), 10, 32)
+				message.uid = uint32(value)
+			case name == "RFC822.SIZE":
+				message.size, _ = strconv.ParseInt(imapString(attributes[i+1]), 10, 64)
+			case name == "INTERNALDATE":
+				message.internalDate = imapString(attributes[i+1])
+			case strings.HasPrefix(name, "BODY[]"):
+				message.body = []byte(imapString(attributes[i+1]))
+			}
+		}
+		if message.uid != 0 {
+			fetched = append(fetched, message)
+		}
+	}
+	return fetched, nil
+}
+
+// parseIMAPFields tokenizes a response into atoms, strings, literals and nested lists
+// NIL is returned as nil; bracketed sections such as BODY[HEADER] stay part of their atom
+func parseIMAPFields(raw []byte) ([]any, error) {
+	parser := imapParser{data: bytes.TrimRight(raw, "\r\n")}
+	fields, err := parser.list(0)
+	if err != nil {
+		return nil, err
+	}
+	return fields, nil
+}
+
+type imapParser struct {
+	data []byte
+	pos  int
+}
+
+func (p *imapParser) list(closing byte) ([]any, error) {
+	var items []any
+	for p.pos < len(p.data) {
+		switch ch := p.data[p.pos]; {
+		case ch == ' ':
+			p.pos++
+		case ch == closing && closing != 0:
+			p.pos++
+			return items, nil
+		case ch == '(':
+			p.pos++
+			nested, err := p.list(')')
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, nested)
+		case ch == '"':
+			value, err := p.quoted()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		case ch == '{':
+			value, err := p.literal()
+			if err != nil {
+				return nil, err
+			}
+			items = append(items, value)
+		default:
+			atom := p.atom()
+			if strings.EqualFold(atom, "NIL") {
+				items = append(items, nil)
+			} else {
+				items = append(items, atom)
+			}
+		}
+	}
+	if closing != 0 {
+		return nil, fmt.Errorf("unterminated list")
+	}
+	return items, nil
+}
+
+func (p *imapParser) quoted() (string, error) {
+	var value strings.Builder
+	for p.pos++; p.pos < len(p.data); p.pos++ {
+		switch ch := p.data[p.pos]; ch {
+		case '\\':
+			p.pos++
+			if p.pos < len(p.data) {
+				value.WriteByte(p.data[p.pos])
+			}
+		case '"':
+			p.pos++
+			return value.String(), nil
+		default:
+			value.WriteByte(ch)
+		}
+	}
+	return "", fmt.Errorf("unterminated quoted string")
+}
+
+func (p *imapParser) literal() (string, error) {
+	end := bytes.IndexByte(p.data[p.pos:], '}')
+	if end < 0 {
+		return "", fmt.Errorf("unterminated literal size")
+	}
+	size, err := strconv.Atoi(strings.TrimSuffix(string(p.data[p.pos+1:p.pos+end]), "+"))
+	if err != nil {
+		return "", fmt.Errorf("literal size invalid: %w", err)
+	}
+	start := p.pos + end + 1
+	if !bytes.HasPrefix(p.data[start:], []byte("\r\n")) {
+		return "", fmt.Errorf("literal not followed by CRLF")
+	}
+	start += 2
+	if start+size > len(p.data) {
+		return "", fmt.Errorf("literal truncated")
+	}
+	p.pos = start + size
+	return string(p.data[start:p.pos]), nil
+}
+
+func (p *imapParser) atom() string {
+	start := p.pos
+	depth := 0
+	for p.pos < len(p.data) {
+		ch := p.data[p.pos]
+		if ch == '[' {
+			depth++
+		} else if ch == ']' && depth > 0 {
+			depth--
+		} else if depth == 0 && (ch == ' ' || ch == '(' || ch == ')') {
+			break
+		}
+		p.pos++
+	}
+	return string(p.data[start:p.pos])
+}
+
+func imapString(value any) string {
+	text, _ := value.(string)
+	return text
+}
+
+// imapPairs maps the name/value pairs of a STATUS attribute list
+func imapPairs(items []any) map[string]string {
+	pairs := make(map[string]string, len(items)/2)
+	for i := 0; i+1 < len(items); i += 2 {
+		pairs[strings.ToUpper(imapString(items[i]))] = imapString(items[i+1])
+	}
+	return pairs
+}
+
+// imapQuote renders a quoted string, rejecting values that would need a literal
+func imapQuote(value string) (string, error) {
+	if strings.ContainsAny(value, "\r\n\x00") {
+		return "", fmt.Errorf("line breaks are not allowed")
+	}
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(value)
+	return `"` + escaped + `"`, nil
+}
+
+// imapMailboxName quotes a folder name, encoding non-ASCII characters as modified UTF-7 (RFC 3501 5.1.3)
+func imapMailboxName(folder string) string {
+	var encoded strings.Builder
+	var pending []rune
+	flush := func() {
+		if len(pending) == 0 {
+			return
+		}
+		units := utf16.Encode(pending)
+		raw := make([]byte, 0, len(units)*2)
+		for _, unit := range units {
+			raw = append(raw, byte(unit>>8), byte(unit))
+		}
+		encoded.WriteByte('&')
+		encoded.WriteString(strings.ReplaceAll(base64.RawStdEncoding.EncodeToString(raw), "/", ","))
+		encoded.WriteByte('-')
+		pending = pending[:0]
+	}
+	for _, r := range folder {
+		if r >= 0x20 && r <= 0x7e {
+			flush()
+			if r == '&' {
+				encoded.WriteString("&-")
+			} else {
+				encoded.WriteRune(r)
+			}
+			continue
+		}
+		pending = append(pending, r)
+	}
+	flush()
+	quoted, _ := imapQuote(encoded.String())
+	return quoted
+}
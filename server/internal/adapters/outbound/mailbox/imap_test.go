@@ -0,0 +1,32 @@
+package mailbox
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIMAPMailboxNameUsesModifiedUTF7(t *testing.T) {
+	cases := map[string]string{
+		"INBOX":              `"INBOX"`,
+		"Work & Play":        `"Work &- Play"`,
+		"~peter/mail/台北/日本語": `"~peter/mail/&U,BTFw-/&ZeVnLIqe-"`,
+		`Quote "me"`:         `"Quote \"me\""`,
+	}
+	for folder, want := range cases {
+		if got := imapMailboxName(folder); got != want {
+			t.Fatalf("imapMailboxName(%q) = %s want %s", folder, got, want)
+		}
+	}
+}
+
+func TestParseIMAPFieldsHandlesLiteralsAndSections(t *testing.T) {
+	raw := []byte("1 FETCH (UID 7 INTERNALDATE \"01-May-2025 09:00:00 +0000\" BODY[]<0> {5}\r\nhi\r\n! FLAGS (\\Seen) X NIL)\r\n")
+	fields, err := parseIMAPFields(raw)
+	if err != nil {
+		t.Fatalf("parseIMAPFields returned error: %v", err)
+	}
+	want := []any{"1", "FETCH", []any{"UID", "7", "INTERNALDATE", "01-May-2025 09:00:00 +0000", "BODY[]<0>", "hi\r\n!", "FLAGS", []any{`\Seen`}, "X", nil}}
+	if !reflect.DeepEqual(fields, want) {
+		t.Fatalf("unexpected fields %#v", fields)
+	}
+}
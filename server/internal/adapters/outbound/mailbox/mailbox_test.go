@@ -0,0 +1,175 @@
+package mailbox_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/mailbox"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/endpoints/fakemail"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/security/netguard"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+)
+
+func startFakeMail(t *testing.T) (*fakemail.Server, map[string]string) {
+	t.Helper()
+	server := fakemail.New("me@example.com", "s3cret")
+	smtpAddr, err := server.ListenSMTP("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenSMTP returned error: %v", err)
+	}
+	imapAddr, err := server.ListenIMAP("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenIMAP returned error: %v", err)
+	}
+	t.Cleanup(server.Close)
+
+	_, smtpPort, _ := strings.Cut(smtpAddr, ":")
+	_, imapPort, _ := strings.Cut(imapAddr, ":")
+	return server, map[string]string{
+		"address":      "me@example.com",
+		"password":     "s3cret",
+		"smtpHost":     "127.0.0.1",
+		"smtpPort":     smtpPort,
+		"smtpSecurity": "none",
+		"imapHost":     "127.0.0.1",
+		"imapPort":     imapPort,
+		"imapSecurity": "none",
+	}
+}
+
+func TestParseAccountAppliesDefaults(t *testing.T) {
+	account, err := mailbox.ParseAccount(map[string]string{
+		"address":  "Me <me@example.com>",
+		"password": "pw",
+		"smtpHost": "smtp.example.com",
+		"imapHost": "imap.example.com",
+	})
+	if err != nil {
+		t.Fatalf("ParseAccount returned error: %v", err)
+	}
+	if account.Address != "me@example.com" || account.Username != "me@example.com" {
+		t.Fatalf("unexpected account identity %+v", account)
+	}
+	if account.SMTP.Port != 587 || account.SMTP.Security != mailbox.SecuritySTARTTLS {
+		t.Fatalf("unexpected smtp defaults %+v", account.SMTP)
+	}
+	if account.IMAP.Port != 993 || account.IMAP.Security != mailbox.SecurityTLS {
+		t.Fatalf("unexpected imap defaults %+v", account.IMAP)
+	}
+
+	if _, err := mailbox.ParseAccount(map[string]string{"address": "me@example.com", "password": "pw"}); err == nil {
+		t.Fatal("expected error without smtp host")
+	}
+	if _, err := mailbox.ParseAccount(map[string]string{"address": "me@example.com", "password": "pw", "smtpHost": "h", "smtpSecurity": "ssl3"}); err == nil {
+		t.Fatal("expected error for unsupported security")
+	}
+}
+
+func TestVerifierStoresAccountAsToken(t *testing.T) {
+	_, credentials := startFakeMail(t)
+	verifier := mailbox.NewVerifier(mailbox.Dialer{Timeout: 5 * time.Second, AllowPrivate: true})
+
+	exchange, err := verifier.Verify(context.Background(), credentials)
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+	if exchange.Profile.Subject != credentials["smtpHost"]+"/me@example.com" || exchange.Profile.Email != "me@example.com" || exchange.Profile.Provider != mailbox.ProviderName {
+		t.Fatalf("unexpected profile %+v", exchange.Profile)
+	}
+	account, err := mailbox.DecodeAccount(exchange.Token.AccessToken)
+	if err != nil {
+		t.Fatalf("DecodeAccount returned error: %v", err)
+	}
+	if account.Password != "s3cret" || account.IMAP.Port == 0 {
+		t.Fatalf("unexpected decoded account %+v", account)
+	}
+
+	credentials["password"] = "wrong"
+	if _, err := verifier.Verify(context.Background(), credentials); !errors.Is(err, identityport.ErrInvalidCredentials) {
+		t.Fatalf("expected invalid credentials got %v", err)
+	}
+
+	// Accounts supplied by users cannot point the server at internal addresses
+	guarded := mailbox.NewVerifier(mailbox.Dialer{Timeout: 5 * time.Second})
+	credentials["password"] = "s3cret"
+	if _, err := guarded.Verify(context.Background(), credentials); err == nil || !strings.Contains(err.Error(), netguard.ErrAddressBlocked.Error()) {
+		t.Fatalf("expected the loopback server to be refused got %v", err)
+	}
+}
+
+func TestSendThenReadNewMessages(t *testing.T) {
+	server, credentials := startFakeMail(t)
+	dialer := mailbox.Dialer{Timeout: 5 * time.Second, AllowPrivate: true}
+	exchange, err := mailbox.NewVerifier(dialer).Verify(context.Background(), credentials)
+	if err != nil {
+		t.Fatalf("Verify returned error: %v", err)
+	}
+	secret := exchange.Token.AccessToken
+	account, _ := mailbox.DecodeAccount(secret)
+	reader := mailbox.NewReader(dialer)
+
+	status, err := reader.Status(context.Background(), secret, "INBOX")
+	if err != nil {
+		t.Fatalf("Status returned error: %v", err)
+	}
+	if status.UIDNext != 1 || status.UIDValidity == 0 {
+		t.Fatalf("unexpected empty folder status %+v", status)
+	}
+
+	err = dialer.Send(context.Background(), account.SMTP, account.Username, account.Password, mailbox.Message{
+		From:    account.Address,
+		To:      []string{"me@example.com"},
+		Bcc:     []string{"audit@example.com"},
+		Subject: "Rapport trimestriel é",
+		Text:    "Numbers are up.\n.leading dot kept",
+		HTML:    "<p>Numbers are <b>up</b>.</p>",
+	})
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+	sent := server.Sent()
+	if len(sent) != 1 || len(sent[0].To) != 2 {
+		t.Fatalf("expected one message with two envelope recipients got %+v", sent)
+	}
+	if strings.Contains(string(sent[0].Data), "audit@example.com") {
+		t.Fatal("bcc recipient must not appear in headers")
+	}
+	server.Deliver("INBOX", []byte("From: Billing <billing@example.com>\r\nTo: me@example.com\r\nSubject: Invoice\r\nContent-Type: text/html\r\n\r\n<p>Total: 12 &euro;</p>\r\n"))
+
+	status, messages, err := reader.Messages(context.Background(), secret, "INBOX", 0, 10)
+	if err != nil {
+		t.Fatalf("Messages returned error: %v", err)
+	}
+	if status.UIDNext != 3 || len(messages) != 2 {
+		t.Fatalf("unexpected read %+v %d messages", status, len(messages))
+	}
+	if messages[0].Subject != "Rapport trimestriel é" || messages[0].Text != "Numbers are up.\n.leading dot kept" {
+		t.Fatalf("unexpected first message %+v", messages[0])
+	}
+	if messages[1].From != "Billing <billing@example.com>" || messages[1].Text != "Total: 12 €" {
+		t.Fatalf("unexpected second message %+v", messages[1])
+	}
+
+	_, messages, err = reader.Messages(context.Background(), secret, "INBOX", messages[1].UID, 10)
+	if err != nil {
+		t.Fatalf("Messages returned error: %v", err)
+	}
+	if len(messages) != 0 {
+		t.Fatalf("expected no message past the last UID got %d", len(messages))
+	}
+}
+
+func TestReaderRequiresIMAPServer(t *testing.T) {
+	secret, _ := mailbox.Account{
+		Address:  "me@example.com",
+		Username: "me@example.com",
+		Password: "pw",
+		SMTP:     mailbox.Server{Host: "127.0.0.1", Port: 25, Security: mailbox.SecurityNone},
+	}.Encode()
+	if _, err := mailbox.NewReader(mailbox.Dialer{}).Status(context.Background(), secret, "INBOX"); !errors.Is(err, mailbox.ErrIMAPNotConfigured) {
+		t.Fatalf("expected imap not configured got %v", err)
+	}
+}
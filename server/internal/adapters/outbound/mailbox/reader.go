@@ -0,0 +1,247 @@
+package mailbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+)
+
+const (
+	// fetchPreviewBytes bounds how much of each message is downloaded to build its event
+	fetchPreviewBytes = 64 << 10
+	// maxTextLength bounds the body text exposed to reactions
+	maxTextLength = 8000
+)
+
+var (
+	htmlTags       = regexp.MustCompile(`(?s)<(script|style)[^>]*>.*?</(script|style)>|<[^>]+>`)
+	repeatedBlanks = regexp.MustCompile(`[ \t]*\n[ \t\n]*\n`)
+)
+
+// Reader reads mailbox folders over IMAP with credentials stored by the verifier
+type Reader struct {
+	dialer Dialer
+}
+
+// NewReader assembles an IMAP mailbox reader
+func NewReader(dialer Dialer) *Reader {
+	return &Reader{dialer: dialer}
+}
+
+// Status reports the UID state of the folder
+func (r *Reader) Status(ctx context.Context, credentials string, folder string) (outbound.MailboxStatus, error) {
+	client, err := r.open(ctx, credentials)
+	if err != nil {
+		return outbound.MailboxStatus{}, fmt.Errorf("mailbox.Reader.Status: %w", err)
+	}
+	defer client.Close()
+
+	validity, next, err := client.status(folder)
+	if err != nil {
+		return outbound.MailboxStatus{}, fmt.Errorf("mailbox.Reader.Status: %s: %w", folder, err)
+	}
+	return outbound.MailboxStatus{UIDValidity: validity, UIDNext: next}, nil
+}
+
+// Messages returns up to limit messages with a UID greater than afterUID, oldest first
+// Messages are read with BODY.PEEK so their \Seen flag is left untouched
+func (r *Reader) Messages(ctx context.Context, credentials string, folder string, afterUID uint32, limit int) (outbound.MailboxStatus, []outbound.MailboxMessage, error) {
+	client, err := r.open(ctx, credentials)
+	if err != nil {
+		return outbound.MailboxStatus{}, nil, fmt.Errorf("mailbox.Reader.Messages: %w", err)
+	}
+	defer client.Close()
+
+	validity, next, err := client.examine(folder)
+	if err != nil {
+		return outbound.MailboxStatus{}, nil, fmt.Errorf("mailbox.Reader.Messages: examine %s: %w", folder, err)
+	}
+	status := outbound.MailboxStatus{UIDValidity: validity, UIDNext: next}
+
+	uids, err := client.searchAfter(afterUID)
+	if err != nil {
+		return status, nil, fmt.Errorf("mailbox.Reader.Messages: search: %w", err)
+	}
+	if len(uids) == 0 {
+		return status, nil, nil
+	}
+	if limit > 0 && len(uids) > limit {
+		uids = uids[:limit]
+	}
+
+	fetched, err := client.fetch(uids, fetchPreviewBytes)
+	if err != nil {
+		return status, nil, fmt.Errorf("mailbox.Reader.Messages: fetch: %w", err)
+	}
+	messages := make([]outbound.MailboxMessage, 0, len(fetched))
+	for _, item := range fetched {
+		messages = append(messages, parseMessage(item))
+	}
+	sort.Slice(messages, func(i, j int) bool { return messages[i].UID < messages[j].UID })
+	return status, messages, nil
+}
+
+func (r *Reader) open(ctx context.Context, credentials string) (*imapClient, error) {
+	account, err := DecodeAccount(credentials)
+	if err != nil {
+		return nil, err
+	}
+	if !account.IMAP.Configured() {
+		return nil, ErrIMAPNotConfigured
+	}
+	return r.dialer.openIMAP(ctx, account.IMAP, account.Username, account.Password)
+}
+
+// parseMessage decodes headers and the first text part of a possibly truncated raw message
+func parseMessage(item imapFetched) outbound.MailboxMessage {
+	message := outbound.MailboxMessage{UID: item.uid, Size: item.size}
+	if date, err := time.Parse("02-Jan-2006 15:04:05 -0700", strings.TrimSpace(item.internalDate)); err == nil {
+		message.Date = date.UTC()
+	}
+
+	parsed, err := mail.ReadMessage(bytes.NewReader(item.body))
+	if err != nil {
+		return message
+	}
+	header := parsed.Header
+	message.MessageID = strings.TrimSpace(header.Get("Message-Id"))
+	message.Subject = decodeHeader(header.Get("Subject"))
+	message.From = decodeHeader(header.Get("From"))
+	message.ReplyTo = decodeHeader(header.Get("Reply-To"))
+	message.To = addressList(header, "To")
+	message.Cc = addressList(header, "Cc")
+	if message.Date.IsZero() {
+		if date, err := header.Date(); err == nil {
+			message.Date = date.UTC()
+		}
+	}
+
+	var plain, rich string
+	walkParts(header.Get("Content-Type"), header.Get("Content-Transfer-Encoding"), header.Get("Content-Disposition"), parsed.Body, func(contentType string, filename string, body []byte) {
+		switch {
+		case filename != "":
+			message.Attachments = append(message.Attachments, filename)
+		case contentType == "text/plain" && plain == "":
+			plain = string(body)
+		case contentType == "text/html" && rich == "":
+			rich = string(body)
+		}
+	})
+	if plain == "" && rich != "" {
+		plain = htmlToText(rich)
+	}
+	plain = strings.ReplaceAll(plain, "\r\n", "\n")
+	message.Text = truncate(strings.TrimSpace(plain), maxTextLength)
+	return message
+}
+
+// walkParts visits leaf MIME parts, decoding their transfer encoding
+// Parse errors end the walk quietly because previews are cut at fetchPreviewBytes
+func walkParts(contentType string, encoding string, disposition string, body io.Reader, visit func(contentType string, filename string, body []byte)) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		mediaType = "text/plain"
+	}
+
+	if strings.HasPrefix(mediaType, "multipart/") {
+		reader := multipart.NewReader(body, params["boundary"])
+		for {
+			part, err := reader.NextRawPart()
+			if err != nil {
+				return
+			}
+			walkParts(part.Header.Get("Content-Type"), part.Header.Get("Content-Transfer-Encoding"), part.Header.Get("Content-Disposition"), part, visit)
+		}
+	}
+
+	filename := params["name"]
+	if _, dispositionParams, err := mime.ParseMediaType(disposition); err == nil && dispositionParams["filename"] != "" {
+		filename = dispositionParams["filename"]
+	}
+	if filename != "" {
+		visit(mediaType, decodeHeader(filename), nil)
+		return
+	}
+
+	var decoded io.Reader = body
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "quoted-printable":
+		decoded = quotedprintable.NewReader(body)
+	case "base64":
+		decoded = base64.NewDecoder(base64.StdEncoding, &lineStripper{reader: body})
+	}
+	content, _ := io.ReadAll(io.LimitReader(decoded, fetchPreviewBytes))
+	visit(mediaType, "", content)
+}
+
+// lineStripper drops line breaks so base64 bodies wrapped at 76 columns decode
+type lineStripper struct {
+	reader io.Reader
+}
+
+func (l *lineStripper) Read(p []byte) (int, error) {
+	for {
+		n, err := l.reader.Read(p)
+		kept := 0
+		for _, ch := range p[:n] {
+			if ch != '\r' && ch != '\n' {
+				p[kept] = ch
+				kept++
+			}
+		}
+		if kept > 0 || err != nil {
+			return kept, err
+		}
+	}
+}
+
+func decodeHeader(value string) string {
+	decoder := mime.WordDecoder{}
+	decoded, err := decoder.DecodeHeader(value)
+	if err != nil {
+		return strings.TrimSpace(value)
+	}
+	return strings.TrimSpace(decoded)
+}
+
+func addressList(header mail.Header, key string) []string {
+	addresses, err := header.AddressList(key)
+	if err != nil {
+		if raw := decodeHeader(header.Get(key)); raw != "" {
+			return []string{raw}
+		}
+		return nil
+	}
+	values := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		values = append(values, address.Address)
+	}
+	return values
+}
+
+func htmlToText(value string) string {
+	text := htmlTags.ReplaceAllString(value, "\n")
+	text = html.UnescapeString(text)
+	return repeatedBlanks.ReplaceAllString(text, "\n\n")
+}
+
+func truncate(value string, limit int) string {
+	runes := []rune(value)
+	if len(runes) <= limit {
+		return value
+	}
+	return string(runes[:limit])
+}
@@ -0,0 +1,247 @@
+package mailbox
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strings"
+	"time"
+)
+
+// Message is an email submitted over SMTP
+type Message struct {
+	From     string
+	FromName string
+	To       []string
+	Cc       []string
+	Bcc      []string
+	ReplyTo  string
+	Subject  string
+	Text     string
+	HTML     string
+}
+
+// Recipients returns every envelope recipient, Bcc included
+func (m Message) Recipients() []string {
+	recipients := make([]string, 0, len(m.To)+len(m.Cc)+len(m.Bcc))
+	recipients = append(recipients, m.To...)
+	recipients = append(recipients, m.Cc...)
+	return append(recipients, m.Bcc...)
+}
+
+// Bytes renders the message as RFC 5322 text with CRLF line endings
+// Bcc recipients are left out of the headers
+func (m Message) Bytes(now time.Time) ([]byte, error) {
+	from, err := mail.ParseAddress(m.From)
+	if err != nil {
+		return nil, fmt.Errorf("from address invalid: %w", err)
+	}
+	from.Name = m.FromName
+	if len(m.Recipients()) == 0 {
+		return nil, fmt.Errorf("no recipients provided")
+	}
+	if strings.ContainsAny(m.Subject, "\r\n") {
+		return nil, fmt.Errorf("subject must be a single line")
+	}
+
+	var buf bytes.Buffer
+	header := func(name string, value string) {
+		buf.WriteString(name + ": " + value + "\r\n")
+	}
+	header("From", from.String())
+	for _, field := range []struct {
+		name string
+		list []string
+	}{{"To", m.To}, {"Cc", m.Cc}} {
+		if len(field.list) == 0 {
+			continue
+		}
+		formatted, err := formatAddressList(field.list)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", strings.ToLower(field.name), err)
+		}
+		header(field.name, formatted)
+	}
+	if m.ReplyTo != "" {
+		formatted, err := formatAddressList([]string{m.ReplyTo})
+		if err != nil {
+			return nil, fmt.Errorf("reply-to: %w", err)
+		}
+		header("Reply-To", formatted)
+	}
+	header("Subject", mime.QEncoding.Encode("utf-8", m.Subject))
+	header("Date", now.Format(time.RFC1123Z))
+	header("Message-ID", messageID(from.Address))
+	header("MIME-Version", "1.0")
+
+	switch {
+	case m.Text != "" && m.HTML != "":
+		writer := multipart.NewWriter(&buf)
+		header("Content-Type", `multipart/alternative; boundary="`+writer.Boundary()+`"`)
+		buf.WriteString("\r\n")
+		for _, part := range []struct{ contentType, body string }{
+			{"text/plain; charset=utf-8", m.Text},
+			{"text/html; charset=utf-8", m.HTML},
+		} {
+			partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+				"Content-Type":              {part.contentType},
+				"Content-Transfer-Encoding": {"quoted-printable"},
+			})
+			if err != nil {
+				return nil, fmt.Errorf("create part: %w", err)
+			}
+			if err := writeQuotedPrintable(partWriter, part.body); err != nil {
+				return nil, err
+			}
+		}
+		if err := writer.Close(); err != nil {
+			return nil, fmt.Errorf("close multipart: %w", err)
+		}
+	default:
+		contentType, body := "text/plain; charset=utf-8", m.Text
+		if m.HTML != "" {
+			contentType, body = "text/html; charset=utf-8", m.HTML
+		}
+		header("Content-Type", contentType)
+		header("Content-Transfer-Encoding", "quoted-printable")
+		buf.WriteString("\r\n")
+		if err := writeQuotedPrintable(&buf, body); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+func formatAddressList(values []string) (string, error) {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		address, err := mail.ParseAddress(value)
+		if err != nil {
+			return "", fmt.Errorf("address %q invalid: %w", value, err)
+		}
+		formatted = append(formatted, address.String())
+	}
+	return strings.Join(formatted, ", "), nil
+}
+
+func writeQuotedPrintable(w io.Writer, body string) error {
+	encoder := quotedprintable.NewWriter(w)
+	if _, err := encoder.Write([]byte(body)); err != nil {
+		return fmt.Errorf("encode body: %w", err)
+	}
+	if err := encoder.Close(); err != nil {
+		return fmt.Errorf("encode body: %w", err)
+	}
+	return nil
+}
+
+func messageID(from string) string {
+	domain := "area.local"
+	if at := strings.LastIndex(from, "@"); at >= 0 && at < len(from)-1 {
+		domain = from[at+1:]
+	}
+	random := make([]byte, 12)
+	_, _ = rand.Read(random)
+	return "<" + hex.EncodeToString(random) + "@" + domain + ">"
+}
+
+// Send submits the message to the SMTP server, authenticating when a username is provided
+func (d Dialer) Send(ctx context.Context, server Server, username string, password string, msg Message) error {
+	envelopeFrom, err := mail.ParseAddress(msg.From)
+	if err != nil {
+		return fmt.Errorf("mailbox.Send: from address invalid: %w", err)
+	}
+	recipients := make([]string, 0, len(msg.Recipients()))
+	for _, recipient := range msg.Recipients() {
+		address, err := mail.ParseAddress(recipient)
+		if err != nil {
+			return fmt.Errorf("mailbox.Send: recipient %q invalid: %w", recipient, err)
+		}
+		recipients = append(recipients, address.Address)
+	}
+	body, err := msg.Bytes(time.Now())
+	if err != nil {
+		return fmt.Errorf("mailbox.Send: %w", err)
+	}
+
+	client, err := d.openSMTP(ctx, server, username, password)
+	if err != nil {
+		return fmt.Errorf("mailbox.Send: %w", err)
+	}
+	defer client.Close()
+
+	if err := client.Mail(envelopeFrom.Address); err != nil {
+		return fmt.Errorf("mailbox.Send: MAIL FROM: %w", err)
+	}
+	for _, recipient := range recipients {
+		if err := client.Rcpt(recipient); err != nil {
+			return fmt.Errorf("mailbox.Send: RCPT TO %s: %w", recipient, err)
+		}
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("mailbox.Send: DATA: %w", err)
+	}
+	if _, err := writer.Write(body); err != nil {
+		_ = writer.Close()
+		return fmt.Errorf("mailbox.Send: write message: %w", err)
+	}
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("mailbox.Send: DATA: %w", err)
+	}
+	if err := client.Quit(); err != nil {
+		return fmt.Errorf("mailbox.Send: QUIT: %w", err)
+	}
+	return nil
+}
+
+// openSMTP connects, upgrades with STARTTLS when requested and authenticates the session
+func (d Dialer) openSMTP(ctx context.Context, server Server, username string, password string) (*smtp.Client, error) {
+	conn, err := d.dial(ctx, server)
+	if err != nil {
+		return nil, err
+	}
+	client, err := smtp.NewClient(conn, server.Host)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("smtp greeting: %w", err)
+	}
+
+	if server.Security == SecuritySTARTTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			_ = client.Close()
+			return nil, fmt.Errorf("smtp server %s does not offer STARTTLS", server.Address())
+		}
+		if err := client.StartTLS(d.tlsConfig(server.Host)); err != nil {
+			_ = client.Close()
+			return nil, fmt.Errorf("smtp STARTTLS: %w", err)
+		}
+	}
+
+	if username != "" {
+		if err := client.Auth(smtp.PlainAuth("", username, password, server.Host)); err != nil {
+			_ = client.Close()
+			return nil, fmt.Errorf("smtp auth: %w", err)
+		}
+	}
+	return client, nil
+}
+
+// checkSMTP authenticates against the server without sending a message
+func (d Dialer) checkSMTP(ctx context.Context, server Server, username string, password string) error {
+	client, err := d.openSMTP(ctx, server, username, password)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	return client.Quit()
+}
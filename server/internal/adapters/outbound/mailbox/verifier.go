@@ -0,0 +1,63 @@
+package mailbox
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/oauth2"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+)
+
+// Verifier checks mailbox credentials by logging into the SMTP and, when configured, IMAP servers
+type Verifier struct {
+	dialer Dialer
+}
+
+// NewVerifier assembles a mailbox credential verifier
+func NewVerifier(dialer Dialer) *Verifier {
+	return &Verifier{dialer: dialer}
+}
+
+// Verify implements identityport.CredentialVerifier
+// The serialized account is returned as access token so the identity repository stores it encrypted
+func (v *Verifier) Verify(ctx context.Context, credentials map[string]string) (identityport.TokenExchange, error) {
+	account, err := ParseAccount(credentials)
+	if err != nil {
+		return identityport.TokenExchange{}, fmt.Errorf("mailbox.Verifier.Verify: %w: %v", identityport.ErrInvalidCredentials, err)
+	}
+
+	if err := v.dialer.checkSMTP(ctx, account.SMTP, account.Username, account.Password); err != nil {
+		return identityport.TokenExchange{}, fmt.Errorf("mailbox.Verifier.Verify: %w: %v", identityport.ErrInvalidCredentials, err)
+	}
+	if account.IMAP.Configured() {
+		client, err := v.dialer.openIMAP(ctx, account.IMAP, account.Username, account.Password)
+		if err != nil {
+			return identityport.TokenExchange{}, fmt.Errorf("mailbox.Verifier.Verify: %w: %v", identityport.ErrInvalidCredentials, err)
+		}
+		_ = client.Close()
+	}
+
+	secret, err := account.Encode()
+	if err != nil {
+		return identityport.TokenExchange{}, fmt.Errorf("mailbox.Verifier.Verify: %w", err)
+	}
+	return identityport.TokenExchange{
+		Token: oauth2.Token{AccessToken: secret},
+		Profile: identitydomain.Profile{
+			Provider: ProviderName,
+			Subject:  accountSubject(account),
+			Email:    account.Address,
+		},
+	}, nil
+}
+
+// accountSubject keys the identity on the SMTP server and the address
+// The same address on another server is another mailbox and must not take over the identity
+func accountSubject(account Account) string {
+	return strings.ToLower(strings.TrimSuffix(account.SMTP.Host, ".")) + "/" + strings.ToLower(account.Address)
+}
+
+// Ensure Verifier implements identityport.CredentialVerifier
+var _ identityport.CredentialVerifier = (*Verifier)(nil)
@@ -0,0 +1,50 @@
+package smtp
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/mailbox"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+)
+
+// Mailer delivers transactional emails through any SMTP submission server
+// Authentication is skipped when Username is empty, which suits local relays
+type Mailer struct {
+	Server    mailbox.Server
+	Username  string
+	Password  string
+	FromEmail string
+	FromName  string
+	Dialer    mailbox.Dialer
+}
+
+// Send implements outbound.Mailer over SMTP
+func (m Mailer) Send(ctx context.Context, msg outbound.Mail) error {
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf("smtp.Mailer.Send: context cancelled: %w", err)
+	}
+	if !m.Server.Configured() {
+		return fmt.Errorf("smtp.Mailer.Send: host is empty")
+	}
+	if strings.TrimSpace(m.FromEmail) == "" {
+		return fmt.Errorf("smtp.Mailer.Send: from email is empty")
+	}
+	if strings.TrimSpace(msg.To) == "" {
+		return fmt.Errorf("smtp.Mailer.Send: recipient is empty")
+	}
+
+	err := m.Dialer.Send(ctx, m.Server, m.Username, m.Password, mailbox.Message{
+		From:     m.FromEmail,
+		FromName: m.FromName,
+		To:       []string{msg.To},
+		Subject:  msg.Subject,
+		Text:     msg.Text,
+		HTML:     msg.HTML,
+	})
+	if err != nil {
+		return fmt.Errorf("smtp.Mailer.Send: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package smtp
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/mailbox"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/endpoints/fakemail"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+)
+
+func TestMailerSendsVerificationEmail(t *testing.T) {
+	server := fakemail.New("noreply@area.local", "relay-pass")
+	addr, err := server.ListenSMTP("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenSMTP returned error: %v", err)
+	}
+	defer server.Close()
+	_, portText, _ := strings.Cut(addr, ":")
+	port, _ := strconv.Atoi(portText)
+
+	mailer := Mailer{
+		Server:    mailbox.Server{Host: "127.0.0.1", Port: port, Security: mailbox.SecurityNone},
+		Username:  "noreply@area.local",
+		Password:  "relay-pass",
+		FromEmail: "noreply@area.local",
+		FromName:  "AREA",
+		Dialer:    mailbox.Dialer{Timeout: 5 * time.Second, AllowPrivate: true},
+	}
+	err = mailer.Send(context.Background(), outbound.Mail{To: "user@example.com", Subject: "Verify your email", Text: "Click the link", HTML: "<a href=\"#\">Verify</a>"})
+	if err != nil {
+		t.Fatalf("Send returned error: %v", err)
+	}
+
+	sent := server.Sent()
+	if len(sent) != 1 || sent[0].From != "noreply@area.local" || sent[0].To[0] != "user@example.com" {
+		t.Fatalf("unexpected envelope %+v", sent)
+	}
+	if !strings.Contains(string(sent[0].Data), `From: "AREA" <noreply@area.local>`) {
+		t.Fatalf("unexpected headers %s", sent[0].Data)
+	}
+}
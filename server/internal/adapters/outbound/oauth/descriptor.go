@@ -3,6 +3,7 @@ package oauth
 import (
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -31,6 +32,39 @@ type ProviderDescriptor struct {
 	TokenAuthMethod     string
 	TokenFormat         string
 	TokenHeaders        map[string]string
+	APIBaseURL          string
+	Instance            *InstanceEndpoints
+}
+
+// InstanceEndpoints describes how a self-hosted deployment derives its URLs from the instance base URL
+// Paths are appended to the configured base URL, for example https://gitlab.example.com
+type InstanceEndpoints struct {
+	AuthorizationPath string
+	TokenPath         string
+	UserInfoPath      string
+	APIPath           string
+}
+
+// ForInstance returns a copy of the descriptor targeting the deployment reachable at baseURL
+// An empty base URL keeps the public endpoints; the second value is the REST API root to use
+func (d ProviderDescriptor) ForInstance(baseURL string) (ProviderDescriptor, string, error) {
+	baseURL = strings.TrimRight(strings.TrimSpace(baseURL), "/")
+	if baseURL == "" {
+		return d, d.APIBaseURL, nil
+	}
+	if d.Instance == nil {
+		return d, "", fmt.Errorf("%s does not support custom base urls", d.DisplayName)
+	}
+	parsed, err := url.Parse(baseURL)
+	if err != nil || (parsed.Scheme != "https" && parsed.Scheme != "http") || parsed.Host == "" {
+		return d, "", fmt.Errorf("%s base url %q invalid", d.DisplayName, baseURL)
+	}
+
+	clone := d
+	clone.AuthorizationURL = baseURL + d.Instance.AuthorizationPath
+	clone.TokenURL = baseURL + d.Instance.TokenPath
+	clone.UserInfoURL = baseURL + d.Instance.UserInfoPath
+	return clone, baseURL + d.Instance.APIPath, nil
 }
 
 // Registry enumerates the descriptors known to the application
@@ -50,11 +84,13 @@ func BuiltIn() Registry {
 				"email",
 				"profile",
 				"https://www.googleapis.com/auth/gmail.send",
+				"https://www.googleapis.com/auth/gmail.readonly",
 				"https://www.googleapis.com/auth/calendar",
 				"https://www.googleapis.com/auth/calendar.readonly",
 				"https://www.googleapis.com/auth/drive",
 				"https://www.googleapis.com/auth/drive.file",
 				"https://www.googleapis.com/auth/drive.readonly",
+				"https://www.googleapis.com/auth/spreadsheets",
 			},
 			AuthorizationParams: map[string]string{
 				"access_type":            "offline",
@@ -73,6 +109,13 @@ func BuiltIn() Registry {
 				"User-Agent": "AREA-Server",
 			},
 			ProfileExtractor: githubProfileExtractor,
+			APIBaseURL:       "https://api.github.com",
+			Instance: &InstanceEndpoints{
+				AuthorizationPath: "/login/oauth/authorize",
+				TokenPath:         "/login/oauth/access_token",
+				UserInfoPath:      "/api/v3/user",
+				APIPath:           "/api/v3",
+			},
 		},
 		"gitlab": {
 			DisplayName:      "GitLab",
@@ -84,6 +127,13 @@ func BuiltIn() Registry {
 				"User-Agent": "AREA-Server",
 			},
 			ProfileExtractor: gitlabProfileExtractor,
+			APIBaseURL:       "https://gitlab.com/api/v4",
+			Instance: &InstanceEndpoints{
+				AuthorizationPath: "/oauth/authorize",
+				TokenPath:         "/oauth/token",
+				UserInfoPath:      "/api/v4/user",
+				APIPath:           "/api/v4",
+			},
 		},
 		"dropbox": {
 			DisplayName:      "Dropbox",
@@ -92,7 +142,7 @@ func BuiltIn() Registry {
 			UserInfoURL:      "https://api.dropboxapi.com/2/users/get_current_account",
 			UserInfoMethod:   "POST",
 			UserInfoBody:     "null",
-			DefaultScopes:    []string{"account_info.read", "files.metadata.read", "files.metadata.write"},
+			DefaultScopes:    []string{"account_info.read", "files.metadata.read", "files.metadata.write", "files.content.read", "files.content.write", "sharing.read", "sharing.write"},
 			AuthorizationParams: map[string]string{
 				"token_access_type": "offline",
 			},
@@ -113,6 +163,11 @@ func BuiltIn() Registry {
 				"user-library-read",
 				"playlist-modify-public",
 				"playlist-modify-private",
+				"user-library-modify",
+				"user-follow-read",
+				"user-read-recently-played",
+				"user-read-playback-state",
+				"user-modify-playback-state",
 			},
 			UserInfoHeaders: map[string]string{
 				"Accept":     "application/json",
@@ -151,7 +206,7 @@ func BuiltIn() Registry {
 			AuthorizationURL: "https://slack.com/oauth/v2/authorize",
 			TokenURL:         "https://slack.com/api/oauth.v2.access",
 			UserInfoURL:      "https://slack.com/api/auth.test",
-			DefaultScopes:    []string{"chat:write", "channels:history", "groups:history", "im:history", "mpim:history", "users:read", "offline_access"},
+			DefaultScopes:    []string{"chat:write", "channels:history", "groups:history", "im:history", "mpim:history", "users:read", "reactions:write", "files:write", "users:read.email", "im:write", "channels:write", "groups:write", "users.profile:write", "offline_access"},
 			UserInfoHeaders: map[string]string{
 				"User-Agent": "AREA-Server",
 			},
@@ -183,6 +238,8 @@ func BuiltIn() Registry {
 				"email",
 				"Mail.Read",
 				"Mail.Send",
+				"Calendars.ReadWrite",
+				"ChannelMessage.Send",
 			},
 			UserInfoHeaders: map[string]string{
 				"Accept":     "application/json",
@@ -1,9 +1,12 @@
 package oauth
 
 import (
+	"context"
+	"strings"
 	"testing"
 
 	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 )
 
 func TestNewManagerBuildsProviders(t *testing.T) {
@@ -83,3 +86,89 @@ func TestNewManagerMissingCredentials(t *testing.T) {
 		t.Fatalf("expected error for missing credentials")
 	}
 }
+
+func TestNewManagerBuildsSelfHostedInstances(t *testing.T) {
+	cfg := ManagerConfig{
+		Providers: map[string]ProviderCredentials{
+			"gitlab": {
+				ClientID:     "saas-client",
+				ClientSecret: "secret",
+				RedirectURI:  "https://app.example/callback",
+			},
+			"gitlab-corp": {
+				ClientID:     "corp-client",
+				ClientSecret: "secret",
+				RedirectURI:  "https://app.example/callback",
+				Type:         "GitLab",
+				BaseURL:      "https://gitlab.corp.example/",
+			},
+		},
+	}
+
+	manager, err := NewManager(BuiltIn(), cfg)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	saas, ok := manager.Provider("gitlab")
+	if !ok {
+		t.Fatalf("expected gitlab provider registered")
+	}
+	if got := identityport.RebaseEndpoint(saas, "https://gitlab.com/api/v4/projects/1"); got != "https://gitlab.com/api/v4/projects/1" {
+		t.Fatalf("public instance endpoint rewritten to %s", got)
+	}
+
+	corp, ok := manager.Provider("gitlab-corp")
+	if !ok {
+		t.Fatalf("expected gitlab-corp provider registered")
+	}
+	if kind := identityport.ProviderType(corp); kind != "gitlab" {
+		t.Fatalf("unexpected provider type %s", kind)
+	}
+	if got := identityport.RebaseEndpoint(corp, "https://gitlab.com/api/v4/projects/1"); got != "https://gitlab.corp.example/api/v4/projects/1" {
+		t.Fatalf("unexpected rebased endpoint %s", got)
+	}
+
+	resp, err := corp.AuthorizationURL(context.Background(), identityport.AuthorizationRequest{State: "state"})
+	if err != nil {
+		t.Fatalf("AuthorizationURL returned error: %v", err)
+	}
+	if !strings.HasPrefix(resp.AuthorizationURL, "https://gitlab.corp.example/oauth/authorize?") {
+		t.Fatalf("unexpected authorization url %s", resp.AuthorizationURL)
+	}
+}
+
+func TestNewManagerRejectsInvalidInstances(t *testing.T) {
+	cases := map[string]ProviderCredentials{
+		"unknown type":         {Type: "mattermost", BaseURL: "https://chat.example"},
+		"unsupported base url": {Type: "google", BaseURL: "https://google.example"},
+		"malformed base url":   {Type: "github", BaseURL: "ftp://github.example"},
+	}
+	for name, creds := range cases {
+		t.Run(name, func(t *testing.T) {
+			creds.ClientID = "client"
+			creds.ClientSecret = "secret"
+			creds.RedirectURI = "https://app.example/callback"
+			cfg := ManagerConfig{Providers: map[string]ProviderCredentials{"instance": creds}}
+			if _, err := NewManager(BuiltIn(), cfg); err == nil {
+				t.Fatalf("expected error")
+			}
+		})
+	}
+}
+
+func TestProviderDescriptorForInstance(t *testing.T) {
+	descriptor, apiBaseURL, err := BuiltIn()["github"].ForInstance("https://github.corp.example")
+	if err != nil {
+		t.Fatalf("ForInstance returned error: %v", err)
+	}
+	if descriptor.TokenURL != "https://github.corp.example/login/oauth/access_token" {
+		t.Fatalf("unexpected token url %s", descriptor.TokenURL)
+	}
+	if descriptor.UserInfoURL != "https://github.corp.example/api/v3/user" {
+		t.Fatalf("unexpected userinfo url %s", descriptor.UserInfoURL)
+	}
+	if apiBaseURL != "https://github.corp.example/api/v3" {
+		t.Fatalf("unexpected api base url %s", apiBaseURL)
+	}
+}
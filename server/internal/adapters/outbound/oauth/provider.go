@@ -14,11 +14,15 @@ import (
 )
 
 // ProviderCredentials wires runtime secrets and redirect metadata for a provider
+// Type selects the descriptor when the provider key names an instance (for example gitlab-corp)
+// BaseURL points self-hosted providers at their own deployment
 type ProviderCredentials struct {
 	ClientID     string
 	ClientSecret string
 	RedirectURI  string
 	Scopes       []string
+	Type         string
+	BaseURL      string
 }
 
 // ManagerConfig configures the OAuth provider manager
@@ -30,7 +34,10 @@ type ManagerConfig struct {
 
 type provider struct {
 	name          string
+	kind          string
 	descriptor    ProviderDescriptor
+	apiBaseURL    string
+	defaultAPIURL string
 	client        *oauth2.Client
 	httpClient    oauth2.HTTPClient
 	redirectURI   string
@@ -84,6 +91,15 @@ func NewProvider(name string, descriptor ProviderDescriptor, creds ProviderCrede
 	if strings.TrimSpace(creds.ClientID) == "" {
 		return nil, fmt.Errorf("oauth.NewProvider[%s]: client id is required", name)
 	}
+	defaultAPIURL := descriptor.APIBaseURL
+	descriptor, apiBaseURL, err := descriptor.ForInstance(creds.BaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("oauth.NewProvider[%s]: %w", name, err)
+	}
+	kind := strings.ToLower(strings.TrimSpace(creds.Type))
+	if kind == "" {
+		kind = name
+	}
 	if strings.TrimSpace(descriptor.AuthorizationURL) == "" {
 		return nil, fmt.Errorf("oauth.NewProvider[%s]: authorization url missing", name)
 	}
@@ -150,7 +166,10 @@ func NewProvider(name string, descriptor ProviderDescriptor, creds ProviderCrede
 
 	return &provider{
 		name:          name,
+		kind:          kind,
 		descriptor:    descriptor,
+		apiBaseURL:    apiBaseURL,
+		defaultAPIURL: defaultAPIURL,
 		client:        client,
 		httpClient:    httpClient,
 		redirectURI:   creds.RedirectURI,
@@ -183,14 +202,18 @@ func NewManager(registry Registry, cfg ManagerConfig, opts ...ProviderOption) (*
 			continue
 		}
 
-		descriptor, ok := registry[key]
-		if !ok {
-			return nil, fmt.Errorf("oauth.NewManager: descriptor for %s not found", key)
-		}
 		creds, ok := cfg.Providers[key]
 		if !ok {
 			return nil, fmt.Errorf("oauth.NewManager: credentials for %s not configured", key)
 		}
+		descriptorKey := strings.ToLower(strings.TrimSpace(creds.Type))
+		if descriptorKey == "" {
+			descriptorKey = key
+		}
+		descriptor, ok := registry[descriptorKey]
+		if !ok {
+			return nil, fmt.Errorf("oauth.NewManager: descriptor for %s not found", descriptorKey)
+		}
 
 		provider, err := NewProvider(key, descriptor, creds, opts...)
 		if err != nil {
@@ -232,6 +255,21 @@ func (p *provider) Name() string {
 	return p.name
 }
 
+// Type returns the descriptor family the provider was built from
+func (p *provider) Type() string {
+	return p.kind
+}
+
+// APIBaseURL returns the REST API root of the configured instance
+func (p *provider) APIBaseURL() string {
+	return p.apiBaseURL
+}
+
+// DefaultAPIBaseURL returns the REST API root of the public deployment
+func (p *provider) DefaultAPIBaseURL() string {
+	return p.defaultAPIURL
+}
+
 func (p *provider) AuthorizationURL(ctx context.Context, req identityport.AuthorizationRequest) (identityport.AuthorizationResponse, error) {
 	redirect := strings.TrimSpace(req.RedirectURI)
 	if redirect == "" {
@@ -494,3 +532,6 @@ func firstNonEmpty(values ...string) string {
 	}
 	return ""
 }
+
+// Ensure provider exposes its instance configuration
+var _ identityport.InstanceProvider = (*provider)(nil)
@@ -0,0 +1,214 @@
+package area
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// HealthRepository persists the circuit breaker state of areas using Postgres via GORM
+type HealthRepository struct {
+	db *gorm.DB
+}
+
+// NewHealthRepository constructs a HealthRepository backed by the provided gorm handle
+func NewHealthRepository(db *gorm.DB) HealthRepository {
+	return HealthRepository{db: db}
+}
+
+// areaHealthStatsQuery counts the finished jobs of an area since its last reset
+// Consecutive failures are the failed jobs finished after the last successful one
+const areaHealthStatsQuery = `
+WITH finished AS (
+	SELECT j.status::text AS status, j.updated_at
+	FROM jobs j
+	JOIN area_links l ON l.id = j.area_link_id
+	JOIN areas a ON a.id = l.area_id
+	WHERE l.area_id = @area
+	  AND j.status IN ('succeeded', 'failed')
+	  AND j.updated_at > COALESCE(a.health_reset_at, TIMESTAMPTZ 'epoch')
+)
+SELECT
+	(SELECT COUNT(*) FROM finished f
+	 WHERE f.status = 'failed'
+	   AND f.updated_at > COALESCE((SELECT MAX(s.updated_at) FROM finished s WHERE s.status = 'succeeded'), TIMESTAMPTZ 'epoch')
+	) AS consecutive_failures,
+	(SELECT COUNT(*) FROM finished f WHERE f.updated_at >= @window) AS window_jobs,
+	(SELECT COUNT(*) FROM finished f WHERE f.updated_at >= @window AND f.status = 'failed') AS window_failures`
+
+type areaHealthStatsRow struct {
+	ConsecutiveFailures int `gorm:"column:consecutive_failures"`
+	WindowJobs          int `gorm:"column:window_jobs"`
+	WindowFailures      int `gorm:"column:window_failures"`
+}
+
+// Stats summarises the reaction jobs of the area finished since its last reset
+func (r HealthRepository) Stats(ctx context.Context, areaID uuid.UUID, windowStart time.Time) (areadomain.HealthStats, error) {
+	if r.db == nil {
+		return areadomain.HealthStats{}, fmt.Errorf("postgres.area.HealthRepository.Stats: nil db handle")
+	}
+	var row areaHealthStatsRow
+	if err := r.db.WithContext(ctx).
+		Raw(areaHealthStatsQuery, map[string]any{"area": areaID, "window": windowStart.UTC()}).
+		Scan(&row).Error; err != nil {
+		return areadomain.HealthStats{}, fmt.Errorf("postgres.area.HealthRepository.Stats: %w", err)
+	}
+	return areadomain.HealthStats{
+		ConsecutiveFailures: row.ConsecutiveFailures,
+		WindowJobs:          row.WindowJobs,
+		WindowFailures:      row.WindowFailures,
+	}, nil
+}
+
+// Suspend moves an enabled area to suspended_by_system and deactivates its action sources
+func (r HealthRepository) Suspend(ctx context.Context, areaID uuid.UUID, suspension areadomain.Suspension) (bool, error) {
+	if r.db == nil {
+		return false, fmt.Errorf("postgres.area.HealthRepository.Suspend: nil db handle")
+	}
+	suspended := false
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&areaModel{}).
+			Where("id = ? AND status = ?", areaID, string(areadomain.StatusEnabled)).
+			Updates(map[string]any{
+				"status":            string(areadomain.StatusSuspended),
+				"suspended_at":      suspension.At.UTC(),
+				"suspension_reason": suspension.Reason,
+				"updated_at":        suspension.At.UTC(),
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+		suspended = true
+		return setAreaSourcesActive(tx, areaID, false, suspension.At)
+	})
+	if err != nil {
+		return false, fmt.Errorf("postgres.area.HealthRepository.Suspend: %w", err)
+	}
+	return suspended, nil
+}
+
+// Resume reactivates the action sources of the area and ignores the jobs finished before at
+func (r HealthRepository) Resume(ctx context.Context, areaID uuid.UUID, at time.Time) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.HealthRepository.Resume: nil db handle")
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&areaModel{}).
+			Where("id = ?", areaID).
+			UpdateColumns(map[string]any{
+				"health_reset_at":   at.UTC(),
+				"suspended_at":      nil,
+				"suspension_reason": nil,
+			})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return outbound.ErrNotFound
+		}
+		return setAreaSourcesActive(tx, areaID, true, at)
+	})
+	if err != nil {
+		return fmt.Errorf("postgres.area.HealthRepository.Resume: %w", err)
+	}
+	return nil
+}
+
+// ClearSuspension forgets when and why the area was suspended, its action sources stay paused
+func (r HealthRepository) ClearSuspension(ctx context.Context, areaID uuid.UUID) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.HealthRepository.ClearSuspension: nil db handle")
+	}
+	result := r.db.WithContext(ctx).
+		Model(&areaModel{}).
+		Where("id = ?", areaID).
+		UpdateColumns(map[string]any{
+			"suspended_at":      nil,
+			"suspension_reason": nil,
+		})
+	if result.Error != nil {
+		return fmt.Errorf("postgres.area.HealthRepository.ClearSuspension: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
+
+// UpdatePolicy stores the policy of the area, nil falls back to the default policy
+func (r HealthRepository) UpdatePolicy(ctx context.Context, areaID uuid.UUID, policy *areadomain.HealthPolicy) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.HealthRepository.UpdatePolicy: nil db handle")
+	}
+	var payload any
+	if policy != nil {
+		encoded, err := encodeHealthPolicy(*policy)
+		if err != nil {
+			return fmt.Errorf("postgres.area.HealthRepository.UpdatePolicy: encode: %w", err)
+		}
+		payload = encoded
+	}
+	result := r.db.WithContext(ctx).
+		Model(&areaModel{}).
+		Where("id = ?", areaID).
+		UpdateColumn("health_policy", payload)
+	if result.Error != nil {
+		return fmt.Errorf("postgres.area.HealthRepository.UpdatePolicy: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
+
+func setAreaSourcesActive(tx *gorm.DB, areaID uuid.UUID, active bool, at time.Time) error {
+	return tx.Exec(`
+UPDATE action_sources s
+SET is_active = ?, updated_at = ?
+FROM area_links l
+WHERE l.area_id = ? AND l.role = 'action' AND s.component_config_id = l.component_config_id`,
+		active, at.UTC(), areaID).Error
+}
+
+type healthPolicyPayload struct {
+	ConsecutiveFailures int     `json:"consecutive_failures"`
+	FailureRate         float64 `json:"failure_rate"`
+	WindowSeconds       int     `json:"window_seconds"`
+	MinJobs             int     `json:"min_jobs"`
+}
+
+func encodeHealthPolicy(policy areadomain.HealthPolicy) ([]byte, error) {
+	return json.Marshal(healthPolicyPayload{
+		ConsecutiveFailures: policy.ConsecutiveFailures,
+		FailureRate:         policy.FailureRate,
+		WindowSeconds:       int(policy.Window / time.Second),
+		MinJobs:             policy.MinJobs,
+	})
+}
+
+func decodeHealthPolicy(raw []byte) (*areadomain.HealthPolicy, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var payload healthPolicyPayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, err
+	}
+	return &areadomain.HealthPolicy{
+		ConsecutiveFailures: payload.ConsecutiveFailures,
+		FailureRate:         payload.FailureRate,
+		Window:              time.Duration(payload.WindowSeconds) * time.Second,
+		MinJobs:             payload.MinJobs,
+	}, nil
+}
+
+var _ outbound.AreaHealthRepository = HealthRepository{}
@@ -0,0 +1,368 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// TagRepository persists area tags using Postgres via GORM
+type TagRepository struct {
+	db *gorm.DB
+}
+
+// NewTagRepository constructs a TagRepository backed by the provided gorm handle
+func NewTagRepository(db *gorm.DB) TagRepository {
+	return TagRepository{db: db}
+}
+
+// FolderRepository persists area folders using Postgres via GORM
+type FolderRepository struct {
+	db *gorm.DB
+}
+
+// NewFolderRepository constructs a FolderRepository backed by the provided gorm handle
+func NewFolderRepository(db *gorm.DB) FolderRepository {
+	return FolderRepository{db: db}
+}
+
+type tagModel struct {
+	ID        uuid.UUID `gorm:"column:id;type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"column:user_id"`
+	Name      string    `gorm:"column:name"`
+	Color     *string   `gorm:"column:color"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (tagModel) TableName() string { return "tags" }
+
+type areaTagModel struct {
+	AreaID    uuid.UUID `gorm:"column:area_id;primaryKey"`
+	TagID     uuid.UUID `gorm:"column:tag_id;primaryKey"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+}
+
+func (areaTagModel) TableName() string { return "area_tags" }
+
+type folderModel struct {
+	ID        uuid.UUID `gorm:"column:id;type:uuid;primaryKey"`
+	UserID    uuid.UUID `gorm:"column:user_id"`
+	Name      string    `gorm:"column:name"`
+	CreatedAt time.Time `gorm:"column:created_at"`
+	UpdatedAt time.Time `gorm:"column:updated_at"`
+}
+
+func (folderModel) TableName() string { return "area_folders" }
+
+// Create stores a new tag
+func (r TagRepository) Create(ctx context.Context, tag areadomain.Tag) (areadomain.Tag, error) {
+	if r.db == nil {
+		return areadomain.Tag{}, fmt.Errorf("postgres.area.TagRepository.Create: nil db handle")
+	}
+	model := tagFromDomain(tag)
+	if model.ID == uuid.Nil {
+		model.ID = uuid.New()
+	}
+	if model.CreatedAt.IsZero() {
+		model.CreatedAt = time.Now().UTC()
+	}
+	if model.UpdatedAt.IsZero() {
+		model.UpdatedAt = model.CreatedAt
+	}
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		if isUniqueViolation(err) {
+			return areadomain.Tag{}, outbound.ErrConflict
+		}
+		return areadomain.Tag{}, fmt.Errorf("postgres.area.TagRepository.Create: %w", err)
+	}
+	return model.toDomain(), nil
+}
+
+// FindByID retrieves a tag by identifier
+func (r TagRepository) FindByID(ctx context.Context, id uuid.UUID) (areadomain.Tag, error) {
+	if r.db == nil {
+		return areadomain.Tag{}, fmt.Errorf("postgres.area.TagRepository.FindByID: nil db handle")
+	}
+	var model tagModel
+	if err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return areadomain.Tag{}, outbound.ErrNotFound
+		}
+		return areadomain.Tag{}, fmt.Errorf("postgres.area.TagRepository.FindByID: %w", err)
+	}
+	return model.toDomain(), nil
+}
+
+// ListByUser returns the tags of a user ordered by name
+func (r TagRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]areadomain.Tag, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("postgres.area.TagRepository.ListByUser: nil db handle")
+	}
+	var models []tagModel
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("lower(name) ASC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("postgres.area.TagRepository.ListByUser: %w", err)
+	}
+	tags := make([]areadomain.Tag, 0, len(models))
+	for _, model := range models {
+		tags = append(tags, model.toDomain())
+	}
+	return tags, nil
+}
+
+// Update persists the name and color of a tag
+func (r TagRepository) Update(ctx context.Context, tag areadomain.Tag) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.TagRepository.Update: nil db handle")
+	}
+	result := r.db.WithContext(ctx).
+		Model(&tagModel{}).
+		Where("id = ?", tag.ID).
+		Updates(map[string]any{
+			"name":       tag.Name,
+			"color":      tag.Color,
+			"updated_at": tag.UpdatedAt.UTC(),
+		})
+	if result.Error != nil {
+		if isUniqueViolation(result.Error) {
+			return outbound.ErrConflict
+		}
+		return fmt.Errorf("postgres.area.TagRepository.Update: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes a tag and detaches it from every area
+func (r TagRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.TagRepository.Delete: nil db handle")
+	}
+	result := r.db.WithContext(ctx).Delete(&tagModel{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("postgres.area.TagRepository.Delete: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
+
+// Attach assigns the tag to the area
+func (r TagRepository) Attach(ctx context.Context, tagID uuid.UUID, areaID uuid.UUID) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.TagRepository.Attach: nil db handle")
+	}
+	model := areaTagModel{AreaID: areaID, TagID: tagID, CreatedAt: time.Now().UTC()}
+	if err := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model).Error; err != nil {
+		return fmt.Errorf("postgres.area.TagRepository.Attach: %w", err)
+	}
+	return nil
+}
+
+// Detach removes the tag from the area
+func (r TagRepository) Detach(ctx context.Context, tagID uuid.UUID, areaID uuid.UUID) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.TagRepository.Detach: nil db handle")
+	}
+	result := r.db.WithContext(ctx).Delete(&areaTagModel{}, "tag_id = ? AND area_id = ?", tagID, areaID)
+	if result.Error != nil {
+		return fmt.Errorf("postgres.area.TagRepository.Detach: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
+
+// ListAreaIDs returns the identifiers of the areas carrying the tag
+func (r TagRepository) ListAreaIDs(ctx context.Context, tagID uuid.UUID) ([]uuid.UUID, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("postgres.area.TagRepository.ListAreaIDs: nil db handle")
+	}
+	var ids []uuid.UUID
+	if err := r.db.WithContext(ctx).
+		Model(&areaTagModel{}).
+		Where("tag_id = ?", tagID).
+		Order("created_at ASC").
+		Pluck("area_id", &ids).Error; err != nil {
+		return nil, fmt.Errorf("postgres.area.TagRepository.ListAreaIDs: %w", err)
+	}
+	return ids, nil
+}
+
+// Create stores a new folder
+func (r FolderRepository) Create(ctx context.Context, folder areadomain.Folder) (areadomain.Folder, error) {
+	if r.db == nil {
+		return areadomain.Folder{}, fmt.Errorf("postgres.area.FolderRepository.Create: nil db handle")
+	}
+	model := folderFromDomain(folder)
+	if model.ID == uuid.Nil {
+		model.ID = uuid.New()
+	}
+	if model.CreatedAt.IsZero() {
+		model.CreatedAt = time.Now().UTC()
+	}
+	if model.UpdatedAt.IsZero() {
+		model.UpdatedAt = model.CreatedAt
+	}
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		if isUniqueViolation(err) {
+			return areadomain.Folder{}, outbound.ErrConflict
+		}
+		return areadomain.Folder{}, fmt.Errorf("postgres.area.FolderRepository.Create: %w", err)
+	}
+	return model.toDomain(), nil
+}
+
+// FindByID retrieves a folder by identifier
+func (r FolderRepository) FindByID(ctx context.Context, id uuid.UUID) (areadomain.Folder, error) {
+	if r.db == nil {
+		return areadomain.Folder{}, fmt.Errorf("postgres.area.FolderRepository.FindByID: nil db handle")
+	}
+	var model folderModel
+	if err := r.db.WithContext(ctx).First(&model, "id = ?", id).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return areadomain.Folder{}, outbound.ErrNotFound
+		}
+		return areadomain.Folder{}, fmt.Errorf("postgres.area.FolderRepository.FindByID: %w", err)
+	}
+	return model.toDomain(), nil
+}
+
+// ListByUser returns the folders of a user ordered by name
+func (r FolderRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([]areadomain.Folder, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("postgres.area.FolderRepository.ListByUser: nil db handle")
+	}
+	var models []folderModel
+	if err := r.db.WithContext(ctx).
+		Where("user_id = ?", userID).
+		Order("lower(name) ASC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("postgres.area.FolderRepository.ListByUser: %w", err)
+	}
+	folders := make([]areadomain.Folder, 0, len(models))
+	for _, model := range models {
+		folders = append(folders, model.toDomain())
+	}
+	return folders, nil
+}
+
+// Update persists the name of a folder
+func (r FolderRepository) Update(ctx context.Context, folder areadomain.Folder) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.FolderRepository.Update: nil db handle")
+	}
+	result := r.db.WithContext(ctx).
+		Model(&folderModel{}).
+		Where("id = ?", folder.ID).
+		Updates(map[string]any{
+			"name":       folder.Name,
+			"updated_at": folder.UpdatedAt.UTC(),
+		})
+	if result.Error != nil {
+		if isUniqueViolation(result.Error) {
+			return outbound.ErrConflict
+		}
+		return fmt.Errorf("postgres.area.FolderRepository.Update: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
+
+// Delete removes a folder, the foreign key leaves its areas without folder
+func (r FolderRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.FolderRepository.Delete: nil db handle")
+	}
+	result := r.db.WithContext(ctx).Delete(&folderModel{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("postgres.area.FolderRepository.Delete: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
+
+// AssignArea moves the area into the folder or out of any folder when folderID is nil
+func (r FolderRepository) AssignArea(ctx context.Context, areaID uuid.UUID, folderID *uuid.UUID) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.FolderRepository.AssignArea: nil db handle")
+	}
+	result := r.db.WithContext(ctx).
+		Model(&areaModel{}).
+		Where("id = ?", areaID).
+		UpdateColumn("folder_id", folderID)
+	if result.Error != nil {
+		return fmt.Errorf("postgres.area.FolderRepository.AssignArea: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
+
+func tagFromDomain(tag areadomain.Tag) tagModel {
+	return tagModel{
+		ID:        tag.ID,
+		UserID:    tag.UserID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: tag.CreatedAt.UTC(),
+		UpdatedAt: tag.UpdatedAt.UTC(),
+	}
+}
+
+func (m tagModel) toDomain() areadomain.Tag {
+	return areadomain.Tag{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Name:      m.Name,
+		Color:     m.Color,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+func folderFromDomain(folder areadomain.Folder) folderModel {
+	return folderModel{
+		ID:        folder.ID,
+		UserID:    folder.UserID,
+		Name:      folder.Name,
+		CreatedAt: folder.CreatedAt.UTC(),
+		UpdatedAt: folder.UpdatedAt.UTC(),
+	}
+}
+
+func (m folderModel) toDomain() areadomain.Folder {
+	return areadomain.Folder{
+		ID:        m.ID,
+		UserID:    m.UserID,
+		Name:      m.Name,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+var (
+	_ outbound.AreaTagRepository    = TagRepository{}
+	_ outbound.AreaFolderRepository = FolderRepository{}
+)
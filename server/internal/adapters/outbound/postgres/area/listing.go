@@ -0,0 +1,194 @@
+package area
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// areaActivityJoins computes the activity summary of each area with one lateral lookup per figure
+// Each lookup is served by the (area_id, created_at) and (area_link_id, created_at) indexes
+const areaActivityJoins = `
+LEFT JOIN LATERAL (
+	SELECT t.created_at AS last_triggered_at
+	FROM triggers t
+	WHERE t.area_id = a.id
+	ORDER BY t.created_at DESC
+	LIMIT 1
+) lt ON TRUE
+LEFT JOIN LATERAL (
+	SELECT j.status::text AS last_job_status, j.updated_at AS last_job_at
+	FROM jobs j
+	JOIN area_links l ON l.id = j.area_link_id
+	WHERE l.area_id = a.id
+	ORDER BY j.created_at DESC
+	LIMIT 1
+) lj ON TRUE
+LEFT JOIN LATERAL (
+	SELECT COUNT(*) AS recent_failures
+	FROM jobs j
+	JOIN area_links l ON l.id = j.area_link_id
+	WHERE l.area_id = a.id AND j.status = 'failed' AND j.updated_at >= ?
+) lf ON TRUE`
+
+type areaActivityRow struct {
+	ID              uuid.UUID  `gorm:"column:id"`
+	SortValue       time.Time  `gorm:"column:sort_value"`
+	LastTriggeredAt *time.Time `gorm:"column:last_triggered_at"`
+	LastJobStatus   *string    `gorm:"column:last_job_status"`
+	LastJobAt       *time.Time `gorm:"column:last_job_at"`
+	RecentFailures  int        `gorm:"column:recent_failures"`
+}
+
+// ListPage returns one page of areas matching the options ordered by the requested key then by id
+func (r Repository) ListPage(ctx context.Context, opts outbound.AreaListOptions) (outbound.AreaPage, error) {
+	if r.db == nil {
+		return outbound.AreaPage{}, fmt.Errorf("postgres.area.Repository.ListPage: nil db handle")
+	}
+	limit := opts.Limit
+	if limit <= 0 {
+		return outbound.AreaPage{}, fmt.Errorf("postgres.area.Repository.ListPage: limit must be positive")
+	}
+	now := opts.Now
+	if now.IsZero() {
+		now = time.Now().UTC()
+	}
+
+	sortExpr, err := areaSortExpression(opts.Sort)
+	if err != nil {
+		return outbound.AreaPage{}, fmt.Errorf("postgres.area.Repository.ListPage: %w", err)
+	}
+	direction, comparator := "DESC", "<"
+	if opts.Ascending {
+		direction, comparator = "ASC", ">"
+	}
+
+	query := r.db.WithContext(ctx).
+		Table("areas AS a").
+		Select(fmt.Sprintf(`a.id, %s AS sort_value, lt.last_triggered_at, lj.last_job_status, lj.last_job_at, lf.recent_failures`, sortExpr)).
+		Joins(areaActivityJoins, now.Add(-24*time.Hour))
+
+	if len(opts.WorkspaceIDs) > 0 {
+		query = query.Where("(a.user_id = ? OR a.workspace_id IN ?)", opts.UserID, opts.WorkspaceIDs)
+	} else {
+		query = query.Where("a.user_id = ?", opts.UserID)
+	}
+	if len(opts.Statuses) > 0 {
+		statuses := make([]string, 0, len(opts.Statuses))
+		for _, status := range opts.Statuses {
+			statuses = append(statuses, string(status))
+		}
+		query = query.Where("a.status::text IN ?", statuses)
+	}
+	if search := strings.TrimSpace(opts.Search); search != "" {
+		query = query.Where(`a.name ILIKE ? ESCAPE '\'`, "%"+escapeLike(search)+"%")
+	}
+	if provider := strings.TrimSpace(opts.Provider); provider != "" {
+		query = query.Where(`EXISTS (
+			SELECT 1 FROM area_links l
+			JOIN user_component_configs cfg ON cfg.id = l.component_config_id
+			JOIN service_components sc ON sc.id = cfg.component_id
+			JOIN service_providers sp ON sp.id = sc.provider_id
+			WHERE l.area_id = a.id AND sp.name = ?)`, strings.ToLower(provider))
+	}
+	if opts.ComponentID != nil {
+		query = query.Where(`EXISTS (
+			SELECT 1 FROM area_links l
+			JOIN user_component_configs cfg ON cfg.id = l.component_config_id
+			WHERE l.area_id = a.id AND cfg.component_id = ?)`, *opts.ComponentID)
+	}
+	if opts.TagID != nil {
+		query = query.Where("EXISTS (SELECT 1 FROM area_tags at WHERE at.area_id = a.id AND at.tag_id = ?)", *opts.TagID)
+	}
+	if opts.FolderID != nil {
+		query = query.Where("a.folder_id = ?", *opts.FolderID)
+	}
+	if opts.After != nil {
+		query = query.Where(fmt.Sprintf("(%s, a.id) %s (?, ?)", sortExpr, comparator), opts.After.SortValue, opts.After.ID)
+	}
+
+	var rows []areaActivityRow
+	if err := query.
+		Order(fmt.Sprintf("sort_value %s, a.id %s", direction, direction)).
+		Limit(limit + 1).
+		Scan(&rows).Error; err != nil {
+		return outbound.AreaPage{}, fmt.Errorf("postgres.area.Repository.ListPage: %w", err)
+	}
+
+	page := outbound.AreaPage{}
+	if len(rows) > limit {
+		rows = rows[:limit]
+		last := rows[len(rows)-1]
+		page.Next = &outbound.AreaCursor{SortValue: last.SortValue, ID: last.ID}
+	}
+	if len(rows) == 0 {
+		page.Areas = []areadomain.Area{}
+		return page, nil
+	}
+
+	ids := make([]uuid.UUID, 0, len(rows))
+	for _, row := range rows {
+		ids = append(ids, row.ID)
+	}
+	var models []areaModel
+	if err := r.db.WithContext(ctx).
+		Preload("Links", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Links.ComponentConfig").
+		Preload("Tags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("tags.name ASC")
+		}).
+		Where("id IN ?", ids).
+		Find(&models).Error; err != nil {
+		return outbound.AreaPage{}, fmt.Errorf("postgres.area.Repository.ListPage: load areas: %w", err)
+	}
+	byID := make(map[uuid.UUID]areaModel, len(models))
+	for _, model := range models {
+		byID[model.ID] = model
+	}
+
+	page.Areas = make([]areadomain.Area, 0, len(rows))
+	for _, row := range rows {
+		model, ok := byID[row.ID]
+		if !ok {
+			continue
+		}
+		area := model.toDomain()
+		area.Activity = &areadomain.Activity{
+			LastTriggeredAt: row.LastTriggeredAt,
+			LastJobAt:       row.LastJobAt,
+			RecentFailures:  row.RecentFailures,
+		}
+		if row.LastJobStatus != nil {
+			area.Activity.LastJobStatus = *row.LastJobStatus
+		}
+		page.Areas = append(page.Areas, area)
+	}
+	return page, nil
+}
+
+// areaSortExpression maps a sort key to its SQL expression, areas that never ran sort as the epoch
+func areaSortExpression(sort outbound.AreaSort) (string, error) {
+	switch sort {
+	case "", outbound.AreaSortCreated:
+		return "a.created_at", nil
+	case outbound.AreaSortUpdated:
+		return "a.updated_at", nil
+	case outbound.AreaSortLastRun:
+		return "COALESCE(lt.last_triggered_at, TIMESTAMPTZ 'epoch')", nil
+	default:
+		return "", fmt.Errorf("unsupported sort %q", sort)
+	}
+}
+
+func escapeLike(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return replacer.Replace(value)
+}
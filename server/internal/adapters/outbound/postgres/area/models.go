@@ -12,14 +12,22 @@ import (
 )
 
 type areaModel struct {
-	ID          uuid.UUID       `gorm:"column:id;type:uuid;primaryKey"`
-	UserID      uuid.UUID       `gorm:"column:user_id"`
-	Name        string          `gorm:"column:name"`
-	Description *string         `gorm:"column:description"`
-	Status      string          `gorm:"column:status"`
-	CreatedAt   time.Time       `gorm:"column:created_at"`
-	UpdatedAt   time.Time       `gorm:"column:updated_at"`
-	Links       []areaLinkModel `gorm:"foreignKey:AreaID;constraint:OnDelete:CASCADE"`
+	ID               uuid.UUID       `gorm:"column:id;type:uuid;primaryKey"`
+	UserID           uuid.UUID       `gorm:"column:user_id"`
+	WorkspaceID      *uuid.UUID      `gorm:"column:workspace_id"`
+	FolderID         *uuid.UUID      `gorm:"column:folder_id"`
+	Name             string          `gorm:"column:name"`
+	Description      *string         `gorm:"column:description"`
+	Status           string          `gorm:"column:status"`
+	Revision         int             `gorm:"column:revision"`
+	HealthPolicy     []byte          `gorm:"column:health_policy"`
+	SuspendedAt      *time.Time      `gorm:"column:suspended_at"`
+	SuspensionReason *string         `gorm:"column:suspension_reason"`
+	ActivitySchedule []byte          `gorm:"column:activity_schedule"`
+	CreatedAt        time.Time       `gorm:"column:created_at"`
+	UpdatedAt        time.Time       `gorm:"column:updated_at"`
+	Links            []areaLinkModel `gorm:"foreignKey:AreaID;constraint:OnDelete:CASCADE"`
+	Tags             []tagModel      `gorm:"many2many:area_tags;joinForeignKey:AreaID;joinReferences:TagID"`
 }
 
 func (areaModel) TableName() string { return "areas" }
@@ -56,12 +64,30 @@ func (m areaModel) toDomain() areadomain.Area {
 	area := areadomain.Area{
 		ID:          m.ID,
 		UserID:      m.UserID,
+		WorkspaceID: m.WorkspaceID,
+		FolderID:    m.FolderID,
 		Name:        m.Name,
 		Description: m.Description,
 		Status:      areadomain.Status(m.Status),
+		Revision:    m.Revision,
 		CreatedAt:   m.CreatedAt,
 		UpdatedAt:   m.UpdatedAt,
 	}
+	if policy, err := decodeHealthPolicy(m.HealthPolicy); err == nil {
+		area.HealthPolicy = policy
+	}
+	if schedule, err := decodeActivitySchedule(m.ActivitySchedule); err == nil {
+		area.ActivitySchedule = schedule
+	}
+	if area.Status == areadomain.StatusSuspended && m.SuspendedAt != nil {
+		area.Suspension = &areadomain.Suspension{At: *m.SuspendedAt}
+		if m.SuspensionReason != nil {
+			area.Suspension.Reason = *m.SuspensionReason
+		}
+	}
+	for _, tag := range m.Tags {
+		area.Tags = append(area.Tags, tag.toDomain())
+	}
 	for _, linkModel := range m.Links {
 		link, err := linkModel.toDomain()
 		if err != nil {
@@ -82,9 +108,12 @@ func areaFromDomain(area areadomain.Area) areaModel {
 	return areaModel{
 		ID:          area.ID,
 		UserID:      area.UserID,
+		WorkspaceID: area.WorkspaceID,
+		FolderID:    area.FolderID,
 		Name:        area.Name,
 		Description: area.Description,
 		Status:      string(area.Status),
+		Revision:    area.Revision,
 		CreatedAt:   area.CreatedAt,
 		UpdatedAt:   area.UpdatedAt,
 	}
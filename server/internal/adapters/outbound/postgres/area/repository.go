@@ -216,6 +216,9 @@ func (r Repository) FindByID(ctx context.Context, id uuid.UUID) (areadomain.Area
 			return db.Order("position ASC")
 		}).
 		Preload("Links.ComponentConfig").
+		Preload("Tags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("tags.name ASC")
+		}).
 		First(&model, "id = ?", id).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return areadomain.Area{}, outbound.ErrNotFound
@@ -236,6 +239,9 @@ func (r Repository) ListByUser(ctx context.Context, userID uuid.UUID) ([]areadom
 			return db.Order("position ASC")
 		}).
 		Preload("Links.ComponentConfig").
+		Preload("Tags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("tags.name ASC")
+		}).
 		Where("user_id = ?", userID).
 		Order("created_at DESC").
 		Find(&models).Error; err != nil {
@@ -248,6 +254,61 @@ func (r Repository) ListByUser(ctx context.Context, userID uuid.UUID) ([]areadom
 	return areas, nil
 }
 
+// ListByWorkspace returns all areas shared in the workspace ordered by creation date descending
+func (r Repository) ListByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]areadomain.Area, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("postgres.area.Repository.ListByWorkspace: nil db handle")
+	}
+	var models []areaModel
+	if err := r.db.WithContext(ctx).
+		Preload("Links", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Links.ComponentConfig").
+		Preload("Tags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("tags.name ASC")
+		}).
+		Where("workspace_id = ?", workspaceID).
+		Order("created_at DESC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("postgres.area.Repository.ListByWorkspace: %w", err)
+	}
+	areas := make([]areadomain.Area, 0, len(models))
+	for _, model := range models {
+		areas = append(areas, model.toDomain())
+	}
+	return areas, nil
+}
+
+// ListByWorkspaces returns all areas shared in any of the workspaces ordered by creation date descending
+func (r Repository) ListByWorkspaces(ctx context.Context, workspaceIDs []uuid.UUID) ([]areadomain.Area, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("postgres.area.Repository.ListByWorkspaces: nil db handle")
+	}
+	if len(workspaceIDs) == 0 {
+		return nil, nil
+	}
+	var models []areaModel
+	if err := r.db.WithContext(ctx).
+		Preload("Links", func(db *gorm.DB) *gorm.DB {
+			return db.Order("position ASC")
+		}).
+		Preload("Links.ComponentConfig").
+		Preload("Tags", func(db *gorm.DB) *gorm.DB {
+			return db.Order("tags.name ASC")
+		}).
+		Where("workspace_id IN ?", workspaceIDs).
+		Order("created_at DESC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("postgres.area.Repository.ListByWorkspaces: %w", err)
+	}
+	areas := make([]areadomain.Area, 0, len(models))
+	for _, model := range models {
+		areas = append(areas, model.toDomain())
+	}
+	return areas, nil
+}
+
 // Delete removes an area by its identifier
 func (r Repository) Delete(ctx context.Context, id uuid.UUID) error {
 	if r.db == nil {
@@ -304,8 +365,43 @@ func (r Repository) UpdateMetadata(ctx context.Context, area areadomain.Area) er
 	if r.db == nil {
 		return fmt.Errorf("postgres.area.Repository.UpdateMetadata: nil db handle")
 	}
+	if err := updateMetadata(r.db.WithContext(ctx), area); err != nil {
+		return fmt.Errorf("postgres.area.Repository.UpdateMetadata: %w", err)
+	}
+	return nil
+}
+
+// UpdateConfig persists changes to an existing component configuration
+func (r Repository) UpdateConfig(ctx context.Context, config componentdomain.Config) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.Repository.UpdateConfig: nil db handle")
+	}
+	if err := updateConfig(r.db.WithContext(ctx), config); err != nil {
+		return fmt.Errorf("postgres.area.Repository.UpdateConfig: %w", err)
+	}
+	return nil
+}
+
+// UpdateOwnership moves the area and the component configs it links to a new user and workspace
+func (r Repository) UpdateOwnership(ctx context.Context, area areadomain.Area) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.Repository.UpdateOwnership: nil db handle")
+	}
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return updateOwnership(tx, area)
+	})
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return err
+		}
+		return fmt.Errorf("postgres.area.Repository.UpdateOwnership: %w", err)
+	}
+	return nil
+}
+
+func updateMetadata(db *gorm.DB, area areadomain.Area) error {
 	if area.ID == uuid.Nil {
-		return fmt.Errorf("postgres.area.Repository.UpdateMetadata: missing id")
+		return fmt.Errorf("missing id")
 	}
 
 	desc := interface{}(nil)
@@ -323,27 +419,22 @@ func (r Repository) UpdateMetadata(ctx context.Context, area areadomain.Area) er
 		"updated_at":  area.UpdatedAt.UTC(),
 	}
 
-	if err := r.db.WithContext(ctx).
-		Model(&areaModel{}).
+	if err := db.Model(&areaModel{}).
 		Where("id = ?", area.ID).
 		Updates(updates).Error; err != nil {
-		return fmt.Errorf("postgres.area.Repository.UpdateMetadata: %w", err)
+		return fmt.Errorf("update area: %w", err)
 	}
 	return nil
 }
 
-// UpdateConfig persists changes to an existing component configuration
-func (r Repository) UpdateConfig(ctx context.Context, config componentdomain.Config) error {
-	if r.db == nil {
-		return fmt.Errorf("postgres.area.Repository.UpdateConfig: nil db handle")
-	}
+func updateConfig(db *gorm.DB, config componentdomain.Config) error {
 	if config.ID == uuid.Nil {
-		return fmt.Errorf("postgres.area.Repository.UpdateConfig: missing id")
+		return fmt.Errorf("missing config id")
 	}
 
 	model, err := configFromDomain(config)
 	if err != nil {
-		return fmt.Errorf("postgres.area.Repository.UpdateConfig: encode params: %w", err)
+		return fmt.Errorf("encode params: %w", err)
 	}
 
 	name := interface{}(nil)
@@ -360,11 +451,40 @@ func (r Repository) UpdateConfig(ctx context.Context, config componentdomain.Con
 		"updated_at": model.UpdatedAt.UTC(),
 	}
 
-	if err := r.db.WithContext(ctx).
-		Model(&componentConfigModel{}).
+	if err := db.Model(&componentConfigModel{}).
 		Where("id = ?", model.ID).
 		Updates(updates).Error; err != nil {
-		return fmt.Errorf("postgres.area.Repository.UpdateConfig: %w", err)
+		return fmt.Errorf("update config: %w", err)
+	}
+	return nil
+}
+
+// updateOwnership must run inside a transaction since it writes the area and its configs
+func updateOwnership(tx *gorm.DB, area areadomain.Area) error {
+	if area.ID == uuid.Nil || area.UserID == uuid.Nil {
+		return fmt.Errorf("missing id")
+	}
+
+	result := tx.Model(&areaModel{}).
+		Where("id = ?", area.ID).
+		Updates(map[string]any{
+			"user_id":      area.UserID,
+			"workspace_id": area.WorkspaceID,
+			"updated_at":   area.UpdatedAt.UTC(),
+			// folders belong to the runner, a new runner starts without folder
+			"folder_id": gorm.Expr("CASE WHEN user_id = ? THEN folder_id ELSE NULL END", area.UserID),
+		})
+	if result.Error != nil {
+		return fmt.Errorf("update area: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	configIDs := tx.Model(&areaLinkModel{}).Select("component_config_id").Where("area_id = ?", area.ID)
+	if err := tx.Model(&componentConfigModel{}).
+		Where("id IN (?)", configIDs).
+		Update("user_id", area.UserID).Error; err != nil {
+		return fmt.Errorf("update configs: %w", err)
 	}
 	return nil
 }
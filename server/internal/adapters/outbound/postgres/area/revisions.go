@@ -0,0 +1,285 @@
+package area
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// RevisionRepository persists area revisions using Postgres via GORM
+type RevisionRepository struct {
+	db *gorm.DB
+}
+
+// NewRevisionRepository constructs a RevisionRepository backed by the provided gorm handle
+func NewRevisionRepository(db *gorm.DB) RevisionRepository {
+	return RevisionRepository{db: db}
+}
+
+type revisionModel struct {
+	ID        uuid.UUID      `gorm:"column:id;type:uuid;primaryKey"`
+	AreaID    uuid.UUID      `gorm:"column:area_id"`
+	Number    int            `gorm:"column:number"`
+	AuthorID  *uuid.UUID     `gorm:"column:author_id"`
+	Reason    string         `gorm:"column:reason"`
+	Snapshot  datatypes.JSON `gorm:"column:snapshot"`
+	CreatedAt time.Time      `gorm:"column:created_at"`
+}
+
+func (revisionModel) TableName() string { return "area_revisions" }
+
+type snapshotPayload struct {
+	Name        string                `json:"name"`
+	Description *string               `json:"description,omitempty"`
+	Status      string                `json:"status"`
+	Links       []snapshotLinkPayload `json:"links"`
+}
+
+type snapshotLinkPayload struct {
+	Role        string         `json:"role"`
+	Position    int            `json:"position"`
+	ConfigID    uuid.UUID      `json:"configId"`
+	ComponentID uuid.UUID      `json:"componentId"`
+	Name        string         `json:"name,omitempty"`
+	Params      map[string]any `json:"params,omitempty"`
+}
+
+// Create stores the revision under the next number of its area
+func (r RevisionRepository) Create(ctx context.Context, revision areadomain.Revision) (areadomain.Revision, error) {
+	if r.db == nil {
+		return areadomain.Revision{}, fmt.Errorf("postgres.area.RevisionRepository.Create: nil db handle")
+	}
+	if revision.AreaID == uuid.Nil {
+		return areadomain.Revision{}, fmt.Errorf("postgres.area.RevisionRepository.Create: missing area id")
+	}
+
+	model, err := newRevisionModel(revision)
+	if err != nil {
+		return areadomain.Revision{}, fmt.Errorf("postgres.area.RevisionRepository.Create: encode snapshot: %w", err)
+	}
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		return insertRevision(tx, &model)
+	})
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) || errors.Is(err, outbound.ErrConflict) {
+			return areadomain.Revision{}, err
+		}
+		return areadomain.Revision{}, fmt.Errorf("postgres.area.RevisionRepository.Create: %w", err)
+	}
+	return model.toDomain()
+}
+
+// Commit applies the change and stores the revision describing it in a single transaction
+func (r RevisionRepository) Commit(ctx context.Context, change outbound.AreaChange, revision areadomain.Revision) (areadomain.Revision, error) {
+	if r.db == nil {
+		return areadomain.Revision{}, fmt.Errorf("postgres.area.RevisionRepository.Commit: nil db handle")
+	}
+	if revision.AreaID == uuid.Nil {
+		return areadomain.Revision{}, fmt.Errorf("postgres.area.RevisionRepository.Commit: missing area id")
+	}
+
+	model, err := newRevisionModel(revision)
+	if err != nil {
+		return areadomain.Revision{}, fmt.Errorf("postgres.area.RevisionRepository.Commit: encode snapshot: %w", err)
+	}
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := insertRevision(tx, &model); err != nil {
+			return err
+		}
+		if change.Ownership {
+			if err := updateOwnership(tx, change.Area); err != nil {
+				return fmt.Errorf("ownership: %w", err)
+			}
+		}
+		if change.Metadata {
+			if err := updateMetadata(tx, change.Area); err != nil {
+				return fmt.Errorf("metadata: %w", err)
+			}
+		}
+		for _, config := range change.Configs {
+			if err := updateConfig(tx, config); err != nil {
+				return fmt.Errorf("config %s: %w", config.ID, err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) || errors.Is(err, outbound.ErrConflict) {
+			return areadomain.Revision{}, err
+		}
+		return areadomain.Revision{}, fmt.Errorf("postgres.area.RevisionRepository.Commit: %w", err)
+	}
+	return model.toDomain()
+}
+
+// insertRevision stores the revision under the next number of its area
+// The area row is updated first so concurrent writers serialize on its lock
+func insertRevision(tx *gorm.DB, model *revisionModel) error {
+	result := tx.Model(&areaModel{}).
+		Where("id = ?", model.AreaID).
+		UpdateColumn("revision", gorm.Expr("revision + 1"))
+	if result.Error != nil {
+		return fmt.Errorf("bump area revision: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	if err := tx.Model(&areaModel{}).
+		Where("id = ?", model.AreaID).
+		Pluck("revision", &model.Number).Error; err != nil {
+		return fmt.Errorf("read area revision: %w", err)
+	}
+	if err := tx.Create(model).Error; err != nil {
+		if isUniqueViolation(err) {
+			return outbound.ErrConflict
+		}
+		return fmt.Errorf("insert revision: %w", err)
+	}
+	return nil
+}
+
+// ListByArea returns the most recent revisions of an area, newest first
+func (r RevisionRepository) ListByArea(ctx context.Context, areaID uuid.UUID, limit int) ([]areadomain.Revision, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("postgres.area.RevisionRepository.ListByArea: nil db handle")
+	}
+	query := r.db.WithContext(ctx).
+		Where("area_id = ?", areaID).
+		Order("number DESC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	var models []revisionModel
+	if err := query.Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("postgres.area.RevisionRepository.ListByArea: %w", err)
+	}
+	revisions := make([]areadomain.Revision, 0, len(models))
+	for _, model := range models {
+		revision, err := model.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("postgres.area.RevisionRepository.ListByArea: %w", err)
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+// FindByNumber retrieves a single revision of an area
+func (r RevisionRepository) FindByNumber(ctx context.Context, areaID uuid.UUID, number int) (areadomain.Revision, error) {
+	if r.db == nil {
+		return areadomain.Revision{}, fmt.Errorf("postgres.area.RevisionRepository.FindByNumber: nil db handle")
+	}
+	var model revisionModel
+	if err := r.db.WithContext(ctx).
+		Where("area_id = ? AND number = ?", areaID, number).
+		Take(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return areadomain.Revision{}, outbound.ErrNotFound
+		}
+		return areadomain.Revision{}, fmt.Errorf("postgres.area.RevisionRepository.FindByNumber: %w", err)
+	}
+	revision, err := model.toDomain()
+	if err != nil {
+		return areadomain.Revision{}, fmt.Errorf("postgres.area.RevisionRepository.FindByNumber: %w", err)
+	}
+	return revision, nil
+}
+
+// newRevisionModel encodes a revision about to be inserted, filling in its id and creation time
+func newRevisionModel(revision areadomain.Revision) (revisionModel, error) {
+	model, err := revisionFromDomain(revision)
+	if err != nil {
+		return revisionModel{}, err
+	}
+	if model.ID == uuid.Nil {
+		model.ID = uuid.New()
+	}
+	if model.CreatedAt.IsZero() {
+		model.CreatedAt = time.Now().UTC()
+	}
+	return model, nil
+}
+
+func revisionFromDomain(revision areadomain.Revision) (revisionModel, error) {
+	payload := snapshotPayload{
+		Name:        revision.Snapshot.Name,
+		Description: revision.Snapshot.Description,
+		Status:      string(revision.Snapshot.Status),
+		Links:       make([]snapshotLinkPayload, 0, len(revision.Snapshot.Links)),
+	}
+	for _, link := range revision.Snapshot.Links {
+		payload.Links = append(payload.Links, snapshotLinkPayload{
+			Role:        string(link.Role),
+			Position:    link.Position,
+			ConfigID:    link.ConfigID,
+			ComponentID: link.ComponentID,
+			Name:        link.Name,
+			Params:      link.Params,
+		})
+	}
+	encoded, err := json.Marshal(payload)
+	if err != nil {
+		return revisionModel{}, err
+	}
+
+	model := revisionModel{
+		ID:        revision.ID,
+		AreaID:    revision.AreaID,
+		Reason:    string(revision.Reason),
+		Snapshot:  datatypes.JSON(encoded),
+		CreatedAt: revision.CreatedAt.UTC(),
+	}
+	if revision.AuthorID != uuid.Nil {
+		author := revision.AuthorID
+		model.AuthorID = &author
+	}
+	return model, nil
+}
+
+func (m revisionModel) toDomain() (areadomain.Revision, error) {
+	var payload snapshotPayload
+	if len(m.Snapshot) > 0 {
+		if err := json.Unmarshal(m.Snapshot, &payload); err != nil {
+			return areadomain.Revision{}, fmt.Errorf("decode snapshot: %w", err)
+		}
+	}
+	revision := areadomain.Revision{
+		ID:        m.ID,
+		AreaID:    m.AreaID,
+		Number:    m.Number,
+		Reason:    areadomain.RevisionReason(m.Reason),
+		CreatedAt: m.CreatedAt,
+		Snapshot: areadomain.Snapshot{
+			Name:        payload.Name,
+			Description: payload.Description,
+			Status:      areadomain.Status(payload.Status),
+			Links:       make([]areadomain.SnapshotLink, 0, len(payload.Links)),
+		},
+	}
+	if m.AuthorID != nil {
+		revision.AuthorID = *m.AuthorID
+	}
+	for _, link := range payload.Links {
+		revision.Snapshot.Links = append(revision.Snapshot.Links, areadomain.SnapshotLink{
+			Role:        areadomain.LinkRole(link.Role),
+			Position:    link.Position,
+			ConfigID:    link.ConfigID,
+			ComponentID: link.ComponentID,
+			Name:        link.Name,
+			Params:      link.Params,
+		})
+	}
+	return revision, nil
+}
+
+var _ outbound.AreaRevisionRepository = RevisionRepository{}
@@ -0,0 +1,98 @@
+package area
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+// UpdateActivitySchedule stores the activity schedule of the area, nil keeps it always active
+func (r Repository) UpdateActivitySchedule(ctx context.Context, areaID uuid.UUID, schedule *areadomain.ActivitySchedule) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.Repository.UpdateActivitySchedule: nil db handle")
+	}
+	var payload any
+	if schedule != nil {
+		encoded, err := encodeActivitySchedule(*schedule)
+		if err != nil {
+			return fmt.Errorf("postgres.area.Repository.UpdateActivitySchedule: encode: %w", err)
+		}
+		payload = encoded
+	}
+	result := r.db.WithContext(ctx).
+		Model(&areaModel{}).
+		Where("id = ?", areaID).
+		UpdateColumn("activity_schedule", payload)
+	if result.Error != nil {
+		return fmt.Errorf("postgres.area.Repository.UpdateActivitySchedule: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
+
+type activitySchedulePayload struct {
+	TimeZone string                  `json:"time_zone,omitempty"`
+	Windows  []activityWindowPayload `json:"windows,omitempty"`
+	StartsAt *time.Time              `json:"starts_at,omitempty"`
+	EndsAt   *time.Time              `json:"ends_at,omitempty"`
+}
+
+type activityWindowPayload struct {
+	Days        []int `json:"days,omitempty"`
+	StartMinute int   `json:"start_minute"`
+	EndMinute   int   `json:"end_minute"`
+}
+
+func encodeActivitySchedule(schedule areadomain.ActivitySchedule) ([]byte, error) {
+	payload := activitySchedulePayload{
+		TimeZone: schedule.TimeZone,
+		StartsAt: schedule.StartsAt,
+		EndsAt:   schedule.EndsAt,
+	}
+	for _, window := range schedule.Windows {
+		days := make([]int, 0, len(window.Days))
+		for _, day := range window.Days {
+			days = append(days, int(day))
+		}
+		payload.Windows = append(payload.Windows, activityWindowPayload{
+			Days:        days,
+			StartMinute: window.Start,
+			EndMinute:   window.End,
+		})
+	}
+	return json.Marshal(payload)
+}
+
+func decodeActivitySchedule(raw []byte) (*areadomain.ActivitySchedule, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	var payload activitySchedulePayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, err
+	}
+	schedule := &areadomain.ActivitySchedule{
+		TimeZone: payload.TimeZone,
+		StartsAt: payload.StartsAt,
+		EndsAt:   payload.EndsAt,
+	}
+	for _, window := range payload.Windows {
+		days := make([]time.Weekday, 0, len(window.Days))
+		for _, day := range window.Days {
+			days = append(days, time.Weekday(day))
+		}
+		schedule.Windows = append(schedule.Windows, areadomain.ActivityWindow{
+			Days:  days,
+			Start: window.StartMinute,
+			End:   window.EndMinute,
+		})
+	}
+	return schedule, nil
+}
@@ -0,0 +1,201 @@
+package area
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// TemplateRepository persists area templates using Postgres via GORM
+type TemplateRepository struct {
+	db *gorm.DB
+}
+
+// NewTemplateRepository constructs a TemplateRepository backed by the provided gorm handle
+func NewTemplateRepository(db *gorm.DB) TemplateRepository {
+	return TemplateRepository{db: db}
+}
+
+type templateModel struct {
+	ID          uuid.UUID      `gorm:"column:id;type:uuid;primaryKey"`
+	Name        string         `gorm:"column:name"`
+	Description string         `gorm:"column:description"`
+	Definition  datatypes.JSON `gorm:"column:definition"`
+	CreatedBy   *uuid.UUID     `gorm:"column:created_by"`
+	CreatedAt   time.Time      `gorm:"column:created_at"`
+	UpdatedAt   time.Time      `gorm:"column:updated_at"`
+}
+
+func (templateModel) TableName() string { return "area_templates" }
+
+type templateDefinition struct {
+	Action    templateSlotPayload   `json:"action"`
+	Reactions []templateSlotPayload `json:"reactions"`
+}
+
+type templateSlotPayload struct {
+	ComponentID uuid.UUID      `json:"componentId"`
+	Name        string         `json:"name,omitempty"`
+	Params      map[string]any `json:"params,omitempty"`
+}
+
+// Create stores a new template
+func (r TemplateRepository) Create(ctx context.Context, template areadomain.Template) (areadomain.Template, error) {
+	if r.db == nil {
+		return areadomain.Template{}, fmt.Errorf("postgres.area.TemplateRepository.Create: nil db handle")
+	}
+	model, err := templateFromDomain(template)
+	if err != nil {
+		return areadomain.Template{}, fmt.Errorf("postgres.area.TemplateRepository.Create: encode definition: %w", err)
+	}
+	if model.ID == uuid.Nil {
+		model.ID = uuid.New()
+	}
+	now := time.Now().UTC()
+	if model.CreatedAt.IsZero() {
+		model.CreatedAt = now
+	}
+	if model.UpdatedAt.IsZero() {
+		model.UpdatedAt = model.CreatedAt
+	}
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		if isUniqueViolation(err) {
+			return areadomain.Template{}, outbound.ErrConflict
+		}
+		return areadomain.Template{}, fmt.Errorf("postgres.area.TemplateRepository.Create: %w", err)
+	}
+	return model.toDomain()
+}
+
+// FindByID retrieves a template by identifier
+func (r TemplateRepository) FindByID(ctx context.Context, id uuid.UUID) (areadomain.Template, error) {
+	if r.db == nil {
+		return areadomain.Template{}, fmt.Errorf("postgres.area.TemplateRepository.FindByID: nil db handle")
+	}
+	var model templateModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).Take(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return areadomain.Template{}, outbound.ErrNotFound
+		}
+		return areadomain.Template{}, fmt.Errorf("postgres.area.TemplateRepository.FindByID: %w", err)
+	}
+	template, err := model.toDomain()
+	if err != nil {
+		return areadomain.Template{}, fmt.Errorf("postgres.area.TemplateRepository.FindByID: %w", err)
+	}
+	return template, nil
+}
+
+// List returns every template ordered by name
+func (r TemplateRepository) List(ctx context.Context) ([]areadomain.Template, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("postgres.area.TemplateRepository.List: nil db handle")
+	}
+	var models []templateModel
+	if err := r.db.WithContext(ctx).Order("name ASC").Order("id ASC").Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("postgres.area.TemplateRepository.List: %w", err)
+	}
+	templates := make([]areadomain.Template, 0, len(models))
+	for _, model := range models {
+		template, err := model.toDomain()
+		if err != nil {
+			return nil, fmt.Errorf("postgres.area.TemplateRepository.List: %w", err)
+		}
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+// Delete removes a template
+func (r TemplateRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.area.TemplateRepository.Delete: nil db handle")
+	}
+	result := r.db.WithContext(ctx).Delete(&templateModel{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("postgres.area.TemplateRepository.Delete: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
+
+func templateFromDomain(template areadomain.Template) (templateModel, error) {
+	definition := templateDefinition{
+		Action:    templateSlotFromDomain(template.Action),
+		Reactions: make([]templateSlotPayload, 0, len(template.Reactions)),
+	}
+	for _, reaction := range template.Reactions {
+		definition.Reactions = append(definition.Reactions, templateSlotFromDomain(reaction))
+	}
+	encoded, err := json.Marshal(definition)
+	if err != nil {
+		return templateModel{}, err
+	}
+
+	model := templateModel{
+		ID:          template.ID,
+		Name:        template.Name,
+		Description: template.Description,
+		Definition:  datatypes.JSON(encoded),
+		CreatedAt:   template.CreatedAt.UTC(),
+		UpdatedAt:   template.UpdatedAt.UTC(),
+	}
+	if template.CreatedBy != uuid.Nil {
+		author := template.CreatedBy
+		model.CreatedBy = &author
+	}
+	return model, nil
+}
+
+func templateSlotFromDomain(slot areadomain.TemplateSlot) templateSlotPayload {
+	return templateSlotPayload{
+		ComponentID: slot.ComponentID,
+		Name:        slot.Name,
+		Params:      slot.Params,
+	}
+}
+
+func (m templateModel) toDomain() (areadomain.Template, error) {
+	var definition templateDefinition
+	if len(m.Definition) > 0 {
+		if err := json.Unmarshal(m.Definition, &definition); err != nil {
+			return areadomain.Template{}, fmt.Errorf("decode definition: %w", err)
+		}
+	}
+	template := areadomain.Template{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Action:      definition.Action.toDomain(),
+		Reactions:   make([]areadomain.TemplateSlot, 0, len(definition.Reactions)),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+	if m.CreatedBy != nil {
+		template.CreatedBy = *m.CreatedBy
+	}
+	for _, reaction := range definition.Reactions {
+		template.Reactions = append(template.Reactions, reaction.toDomain())
+	}
+	return template, nil
+}
+
+func (p templateSlotPayload) toDomain() areadomain.TemplateSlot {
+	return areadomain.TemplateSlot{
+		ComponentID: p.ComponentID,
+		Name:        p.Name,
+		Params:      p.Params,
+	}
+}
+
+var _ outbound.AreaTemplateRepository = TemplateRepository{}
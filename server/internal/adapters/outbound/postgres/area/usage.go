@@ -0,0 +1,67 @@
+package area
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	userdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/user"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// UsageRepository counts the areas and executions of members using Postgres via GORM
+type UsageRepository struct {
+	db *gorm.DB
+}
+
+// NewUsageRepository constructs a UsageRepository backed by the provided gorm handle
+func NewUsageRepository(db *gorm.DB) UsageRepository {
+	return UsageRepository{db: db}
+}
+
+// usageQuery counts the areas run by a member, archived ones excluded, and the triggers that enqueued jobs for them
+const usageQuery = `
+SELECT
+	(SELECT COUNT(*) FROM areas a WHERE a.user_id = @user AND a.status <> @archived) AS areas,
+	COUNT(*) FILTER (WHERE t.created_at >= @hour) AS executions_last_hour,
+	COUNT(*) AS executions_last_day
+FROM triggers t
+JOIN areas a ON a.id = t.area_id
+WHERE a.user_id = @user
+  AND t.status = 'matched'
+  AND t.created_at >= @day`
+
+type usageRow struct {
+	Areas              int `gorm:"column:areas"`
+	ExecutionsLastHour int `gorm:"column:executions_last_hour"`
+	ExecutionsLastDay  int `gorm:"column:executions_last_day"`
+}
+
+// Usage counts the areas run by the user and the executions matched within the hour and the day before at
+func (r UsageRepository) Usage(ctx context.Context, userID uuid.UUID, at time.Time) (userdomain.Usage, error) {
+	if r.db == nil {
+		return userdomain.Usage{}, fmt.Errorf("postgres.area.UsageRepository.Usage: nil db handle")
+	}
+	at = at.UTC()
+	var row usageRow
+	if err := r.db.WithContext(ctx).
+		Raw(usageQuery, map[string]any{
+			"user":     userID,
+			"archived": string(areadomain.StatusArchived),
+			"hour":     at.Add(-time.Hour),
+			"day":      at.Add(-24 * time.Hour),
+		}).
+		Scan(&row).Error; err != nil {
+		return userdomain.Usage{}, fmt.Errorf("postgres.area.UsageRepository.Usage: %w", err)
+	}
+	return userdomain.Usage{
+		Areas:              row.Areas,
+		ExecutionsLastHour: row.ExecutionsLastHour,
+		ExecutionsLastDay:  row.ExecutionsLastDay,
+	}, nil
+}
+
+var _ outbound.UsageRepository = UsageRepository{}
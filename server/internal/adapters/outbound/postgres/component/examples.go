@@ -0,0 +1,73 @@
+package component
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+	"gorm.io/datatypes"
+	"gorm.io/gorm"
+)
+
+// ExampleRepository exposes Postgres-backed component examples
+type ExampleRepository struct {
+	db *gorm.DB
+}
+
+// NewExampleRepository constructs an ExampleRepository bound to the provided gorm handle
+func NewExampleRepository(db *gorm.DB) ExampleRepository {
+	return ExampleRepository{db: db}
+}
+
+type exampleModel struct {
+	ID            uuid.UUID      `gorm:"column:id;primaryKey"`
+	ComponentID   uuid.UUID      `gorm:"column:component_id"`
+	ExampleInput  datatypes.JSON `gorm:"column:example_input"`
+	ExampleOutput datatypes.JSON `gorm:"column:example_output"`
+}
+
+func (exampleModel) TableName() string { return "service_component_examples" }
+
+// ListByComponent returns the examples registered for a component
+func (r ExampleRepository) ListByComponent(ctx context.Context, componentID uuid.UUID) ([]componentdomain.Example, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("postgres.component.ExampleRepository.ListByComponent: nil db handle")
+	}
+	var models []exampleModel
+	if err := r.db.WithContext(ctx).
+		Where("component_id = ?", componentID).
+		Order("id").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("postgres.component.ExampleRepository.ListByComponent: %w", err)
+	}
+	examples := make([]componentdomain.Example, 0, len(models))
+	for _, model := range models {
+		examples = append(examples, model.toDomain())
+	}
+	return examples, nil
+}
+
+func (m exampleModel) toDomain() componentdomain.Example {
+	example := componentdomain.Example{
+		ID:          m.ID,
+		ComponentID: m.ComponentID,
+	}
+	if len(m.ExampleInput) > 0 {
+		var input map[string]any
+		if err := json.Unmarshal(m.ExampleInput, &input); err == nil {
+			example.Input = input
+		}
+	}
+	if len(m.ExampleOutput) > 0 {
+		var output map[string]any
+		if err := json.Unmarshal(m.ExampleOutput, &output); err == nil {
+			example.Output = output
+		}
+	}
+	return example
+}
+
+var _ outbound.ComponentExampleRepository = ExampleRepository{}
@@ -0,0 +1,285 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// Repository persists workspaces and their members using Postgres via GORM
+type Repository struct {
+	db *gorm.DB
+}
+
+// NewRepository constructs a Repository backed by the provided gorm handle
+func NewRepository(db *gorm.DB) Repository {
+	return Repository{db: db}
+}
+
+type workspaceModel struct {
+	ID        uuid.UUID  `gorm:"column:id;type:uuid;primaryKey"`
+	Name      string     `gorm:"column:name"`
+	CreatedBy *uuid.UUID `gorm:"column:created_by"`
+	CreatedAt time.Time  `gorm:"column:created_at"`
+	UpdatedAt time.Time  `gorm:"column:updated_at"`
+}
+
+func (workspaceModel) TableName() string { return "workspaces" }
+
+type memberModel struct {
+	WorkspaceID uuid.UUID `gorm:"column:workspace_id;primaryKey"`
+	UserID      uuid.UUID `gorm:"column:user_id;primaryKey"`
+	Role        string    `gorm:"column:role"`
+	CreatedAt   time.Time `gorm:"column:created_at"`
+	UpdatedAt   time.Time `gorm:"column:updated_at"`
+	Email       string    `gorm:"column:email;->"`
+}
+
+func (memberModel) TableName() string { return "workspace_members" }
+
+type membershipRow struct {
+	workspaceModel
+	Role string `gorm:"column:role"`
+}
+
+// Create stores the workspace and its first owner in a single transaction
+func (r Repository) Create(ctx context.Context, workspace workspacedomain.Workspace, owner workspacedomain.Member) (workspacedomain.Workspace, error) {
+	if r.db == nil {
+		return workspacedomain.Workspace{}, fmt.Errorf("postgres.workspace.Repository.Create: nil db handle")
+	}
+	model := workspaceFromDomain(workspace)
+	if model.ID == uuid.Nil {
+		model.ID = uuid.New()
+	}
+	if model.CreatedAt.IsZero() {
+		model.CreatedAt = time.Now().UTC()
+	}
+	if model.UpdatedAt.IsZero() {
+		model.UpdatedAt = model.CreatedAt
+	}
+	member := memberFromDomain(owner)
+	member.WorkspaceID = model.ID
+	if member.CreatedAt.IsZero() {
+		member.CreatedAt = model.CreatedAt
+	}
+	if member.UpdatedAt.IsZero() {
+		member.UpdatedAt = model.CreatedAt
+	}
+
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&model).Error; err != nil {
+			return fmt.Errorf("insert workspace: %w", err)
+		}
+		if err := tx.Create(&member).Error; err != nil {
+			return fmt.Errorf("insert owner: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return workspacedomain.Workspace{}, fmt.Errorf("postgres.workspace.Repository.Create: %w", err)
+	}
+	return model.toDomain(), nil
+}
+
+// FindByID retrieves a workspace by identifier
+func (r Repository) FindByID(ctx context.Context, id uuid.UUID) (workspacedomain.Workspace, error) {
+	if r.db == nil {
+		return workspacedomain.Workspace{}, fmt.Errorf("postgres.workspace.Repository.FindByID: nil db handle")
+	}
+	var model workspaceModel
+	if err := r.db.WithContext(ctx).Where("id = ?", id).Take(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return workspacedomain.Workspace{}, outbound.ErrNotFound
+		}
+		return workspacedomain.Workspace{}, fmt.Errorf("postgres.workspace.Repository.FindByID: %w", err)
+	}
+	return model.toDomain(), nil
+}
+
+// ListByUser returns the workspaces the user belongs to with the user's role, ordered by name
+func (r Repository) ListByUser(ctx context.Context, userID uuid.UUID) ([]workspacedomain.Membership, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("postgres.workspace.Repository.ListByUser: nil db handle")
+	}
+	var rows []membershipRow
+	if err := r.db.WithContext(ctx).
+		Table("workspaces AS w").
+		Select("w.*, m.role").
+		Joins("JOIN workspace_members m ON m.workspace_id = w.id").
+		Where("m.user_id = ?", userID).
+		Order("w.name ASC").
+		Find(&rows).Error; err != nil {
+		return nil, fmt.Errorf("postgres.workspace.Repository.ListByUser: %w", err)
+	}
+	memberships := make([]workspacedomain.Membership, 0, len(rows))
+	for _, row := range rows {
+		memberships = append(memberships, workspacedomain.Membership{
+			Workspace: row.workspaceModel.toDomain(),
+			Role:      workspacedomain.Role(row.Role),
+		})
+	}
+	return memberships, nil
+}
+
+// Delete removes a workspace, its members and detaches its areas
+func (r Repository) Delete(ctx context.Context, id uuid.UUID) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.workspace.Repository.Delete: nil db handle")
+	}
+	result := r.db.WithContext(ctx).Delete(&workspaceModel{}, "id = ?", id)
+	if result.Error != nil {
+		return fmt.Errorf("postgres.workspace.Repository.Delete: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
+
+// FindMember retrieves the membership of a user in a workspace
+func (r Repository) FindMember(ctx context.Context, workspaceID uuid.UUID, userID uuid.UUID) (workspacedomain.Member, error) {
+	if r.db == nil {
+		return workspacedomain.Member{}, fmt.Errorf("postgres.workspace.Repository.FindMember: nil db handle")
+	}
+	var model memberModel
+	if err := r.membersQuery(ctx).
+		Where("m.workspace_id = ? AND m.user_id = ?", workspaceID, userID).
+		Take(&model).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return workspacedomain.Member{}, outbound.ErrNotFound
+		}
+		return workspacedomain.Member{}, fmt.Errorf("postgres.workspace.Repository.FindMember: %w", err)
+	}
+	return model.toDomain(), nil
+}
+
+// ListMembers returns the members of a workspace ordered by join date
+func (r Repository) ListMembers(ctx context.Context, workspaceID uuid.UUID) ([]workspacedomain.Member, error) {
+	if r.db == nil {
+		return nil, fmt.Errorf("postgres.workspace.Repository.ListMembers: nil db handle")
+	}
+	var models []memberModel
+	if err := r.membersQuery(ctx).
+		Where("m.workspace_id = ?", workspaceID).
+		Order("m.created_at ASC").
+		Find(&models).Error; err != nil {
+		return nil, fmt.Errorf("postgres.workspace.Repository.ListMembers: %w", err)
+	}
+	members := make([]workspacedomain.Member, 0, len(models))
+	for _, model := range models {
+		members = append(members, model.toDomain())
+	}
+	return members, nil
+}
+
+// SaveMember inserts a member or updates the role of an existing one
+func (r Repository) SaveMember(ctx context.Context, member workspacedomain.Member) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.workspace.Repository.SaveMember: nil db handle")
+	}
+	model := memberFromDomain(member)
+	now := time.Now().UTC()
+	if model.CreatedAt.IsZero() {
+		model.CreatedAt = now
+	}
+	if model.UpdatedAt.IsZero() {
+		model.UpdatedAt = now
+	}
+	result := r.db.WithContext(ctx).
+		Model(&memberModel{}).
+		Where("workspace_id = ? AND user_id = ?", model.WorkspaceID, model.UserID).
+		Updates(map[string]any{"role": model.Role, "updated_at": model.UpdatedAt})
+	if result.Error != nil {
+		return fmt.Errorf("postgres.workspace.Repository.SaveMember: update: %w", result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+	if err := r.db.WithContext(ctx).Create(&model).Error; err != nil {
+		if strings.Contains(strings.ToLower(err.Error()), "duplicate") {
+			return outbound.ErrConflict
+		}
+		return fmt.Errorf("postgres.workspace.Repository.SaveMember: insert: %w", err)
+	}
+	return nil
+}
+
+// DeleteMember removes a user from a workspace
+func (r Repository) DeleteMember(ctx context.Context, workspaceID uuid.UUID, userID uuid.UUID) error {
+	if r.db == nil {
+		return fmt.Errorf("postgres.workspace.Repository.DeleteMember: nil db handle")
+	}
+	result := r.db.WithContext(ctx).
+		Delete(&memberModel{}, "workspace_id = ? AND user_id = ?", workspaceID, userID)
+	if result.Error != nil {
+		return fmt.Errorf("postgres.workspace.Repository.DeleteMember: %w", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return outbound.ErrNotFound
+	}
+	return nil
+}
+
+func (r Repository) membersQuery(ctx context.Context) *gorm.DB {
+	return r.db.WithContext(ctx).
+		Table("workspace_members AS m").
+		Select("m.*, u.email").
+		Joins("JOIN users u ON u.id = m.user_id")
+}
+
+func workspaceFromDomain(workspace workspacedomain.Workspace) workspaceModel {
+	model := workspaceModel{
+		ID:        workspace.ID,
+		Name:      workspace.Name,
+		CreatedAt: workspace.CreatedAt.UTC(),
+		UpdatedAt: workspace.UpdatedAt.UTC(),
+	}
+	if workspace.CreatedBy != uuid.Nil {
+		author := workspace.CreatedBy
+		model.CreatedBy = &author
+	}
+	return model
+}
+
+func (m workspaceModel) toDomain() workspacedomain.Workspace {
+	workspace := workspacedomain.Workspace{
+		ID:        m.ID,
+		Name:      m.Name,
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+	if m.CreatedBy != nil {
+		workspace.CreatedBy = *m.CreatedBy
+	}
+	return workspace
+}
+
+func memberFromDomain(member workspacedomain.Member) memberModel {
+	return memberModel{
+		WorkspaceID: member.WorkspaceID,
+		UserID:      member.UserID,
+		Role:        string(member.Role),
+		CreatedAt:   member.CreatedAt.UTC(),
+		UpdatedAt:   member.UpdatedAt.UTC(),
+	}
+}
+
+func (m memberModel) toDomain() workspacedomain.Member {
+	return workspacedomain.Member{
+		WorkspaceID: m.WorkspaceID,
+		UserID:      m.UserID,
+		Email:       m.Email,
+		Role:        workspacedomain.Role(m.Role),
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+var _ outbound.WorkspaceRepository = Repository{}
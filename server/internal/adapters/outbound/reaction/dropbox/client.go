@@ -0,0 +1,286 @@
+package dropbox
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf16"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+)
+
+const (
+	dropboxAPIBaseURL     = "https://api.dropboxapi.com/2"
+	dropboxContentBaseURL = "https://content.dropboxapi.com/2"
+)
+
+// apiClient bundles the identity lookup and token refresh flow shared by Dropbox executors
+type apiClient struct {
+	name       string
+	identities identityport.Repository
+	providers  ProviderResolver
+	http       HTTPClient
+	clock      Clock
+}
+
+// apiCall describes a single Dropbox API request issued by a reaction
+// RPC routes carry a JSON payload while content routes pass their argument in the Dropbox-API-Arg header
+// and stream their body, which is opened again when the call is replayed after a token refresh
+type apiCall struct {
+	endpoint string
+	payload  []byte
+	arg      []byte
+	body     func() (io.ReadCloser, int64, error)
+}
+
+func newAPIClient(name string, identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock) apiClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return apiClient{name: name, identities: identities, providers: providers, http: client, clock: clock}
+}
+
+func (c apiClient) configured() bool {
+	return c.identities != nil && c.providers != nil
+}
+
+// resolveIdentity loads the identity bound to the reaction and ensures it carries a usable access token
+func (c apiClient) resolveIdentity(ctx context.Context, area areadomain.Area, identityID uuid.UUID) (identitydomain.Identity, string, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	return c.ensureAccessToken(ctx, identity, false)
+}
+
+func (c apiClient) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
+	now := c.now()
+	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
+		return identity, identity.AccessToken, nil
+	}
+
+	provider, ok := c.providers.Provider(dropboxProviderName)
+	if !ok {
+		return identity, "", fmt.Errorf("%s: provider %s not configured", c.name, dropboxProviderName)
+	}
+
+	exchange, err := provider.Refresh(ctx, identity)
+	if err != nil {
+		return identity, "", fmt.Errorf("%s: refresh token: %w", c.name, err)
+	}
+
+	refreshToken := exchange.Token.RefreshToken
+	if refreshToken == "" {
+		refreshToken = identity.RefreshToken
+	}
+	expiresAt := identity.ExpiresAt
+	if !exchange.Token.ExpiresAt.IsZero() {
+		expires := exchange.Token.ExpiresAt.UTC()
+		expiresAt = &expires
+	}
+	scopes := exchange.Token.Scope
+	if len(scopes) == 0 {
+		scopes = identity.Scopes
+	}
+
+	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
+	updated.UpdatedAt = now
+
+	if err := c.identities.Update(ctx, updated); err != nil {
+		return identity, "", fmt.Errorf("%s: update identity: %w", c.name, err)
+	}
+
+	return updated, updated.AccessToken, nil
+}
+
+// session threads the identity and access token across the Dropbox calls issued by a single reaction
+type session struct {
+	api         apiClient
+	identity    identitydomain.Identity
+	accessToken string
+	result      outbound.ReactionResult
+}
+
+// call sends the request, refreshing the access token once when Dropbox rejects the credentials
+func (s *session) call(ctx context.Context, call apiCall) (map[string]any, error) {
+	result, body, unauthorized, err := s.api.send(ctx, s.accessToken, call)
+	if err != nil && unauthorized {
+		s.identity, s.accessToken, err = s.api.ensureAccessToken(ctx, s.identity, true)
+		if err != nil {
+			return nil, err
+		}
+		result, body, unauthorized, err = s.api.send(ctx, s.accessToken, call)
+		if err != nil && unauthorized {
+			s.result = result
+			return body, fmt.Errorf("%s: unauthorized after refresh", s.api.name)
+		}
+	}
+	s.result = result
+	return body, err
+}
+
+// preview describes the request the call would issue with the identity, with the access token redacted
+// The identity is only checked, its token is never refreshed
+func (c apiClient) preview(ctx context.Context, area areadomain.Area, identityID uuid.UUID, call apiCall) (outbound.ReactionResult, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	var body io.Reader
+	if call.payload != nil {
+		body = bytes.NewReader(call.payload)
+	}
+	req, err := c.newRequest(ctx, "<redacted>", call, body)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	return outbound.ReactionResult{
+		Endpoint: call.endpoint,
+		Request:  describeRequest(req, call),
+	}, nil
+}
+
+func (c apiClient) newRequest(ctx context.Context, accessToken string, call apiCall, body io.Reader) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, call.endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: build request: %w", c.name, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "AREA-Server")
+	if call.arg != nil {
+		req.Header.Set("Dropbox-API-Arg", string(call.arg))
+		req.Header.Set("Content-Type", "application/octet-stream")
+	} else {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	return req, nil
+}
+
+func describeRequest(req *http.Request, call apiCall) map[string]any {
+	request := map[string]any{
+		"method":  http.MethodPost,
+		"url":     call.endpoint,
+		"headers": copyHeaders(req.Header),
+		"body":    string(call.payload),
+	}
+	if call.arg != nil {
+		request["arg"] = string(call.arg)
+	}
+	return request
+}
+
+func (c apiClient) send(ctx context.Context, accessToken string, call apiCall) (outbound.ReactionResult, map[string]any, bool, error) {
+	var (
+		body          io.Reader
+		contentLength int64 = -1
+	)
+	switch {
+	case call.body != nil:
+		stream, size, err := call.body()
+		if err != nil {
+			return outbound.ReactionResult{}, nil, false, fmt.Errorf("%s: open upload body: %w", c.name, err)
+		}
+		defer stream.Close()
+		body, contentLength = stream, size
+	case call.payload != nil:
+		body = bytes.NewReader(call.payload)
+	}
+
+	req, err := c.newRequest(ctx, accessToken, call, body)
+	if err != nil {
+		return outbound.ReactionResult{}, nil, false, err
+	}
+	if call.arg != nil && call.body != nil && contentLength >= 0 {
+		req.ContentLength = contentLength
+	}
+
+	start := c.now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return outbound.ReactionResult{}, nil, false, fmt.Errorf("%s: request failed: %w", c.name, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	duration := c.now().Sub(start)
+
+	result := outbound.ReactionResult{
+		Endpoint: call.endpoint,
+		Request:  describeRequest(req, call),
+		Response: map[string]any{
+			"body":    string(respBody),
+			"headers": copyHeaders(resp.Header),
+		},
+		StatusCode: &resp.StatusCode,
+		Duration:   duration,
+	}
+
+	var decoded map[string]any
+	if len(respBody) > 0 {
+		_ = json.Unmarshal(respBody, &decoded)
+	}
+
+	if resp.StatusCode >= 400 {
+		unauthorized := resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden
+		// Dropbox describes route errors as {"error_summary":"path/not_found/..","error":{...}}
+		if summary, ok := decoded["error_summary"].(string); ok && strings.TrimSpace(summary) != "" {
+			return result, decoded, unauthorized, fmt.Errorf("%s: received status %d: %s", c.name, resp.StatusCode, strings.TrimSpace(summary))
+		}
+		return result, decoded, unauthorized, fmt.Errorf("%s: received status %d", c.name, resp.StatusCode)
+	}
+	return result, decoded, false, nil
+}
+
+func (c apiClient) now() time.Time {
+	if c.clock == nil {
+		return time.Now().UTC()
+	}
+	return c.clock.Now().UTC()
+}
+
+// headerArg encodes the Dropbox-API-Arg header value
+// HTTP headers must stay ASCII so every other character is escaped as a JSON \u sequence
+func headerArg(value any) ([]byte, error) {
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+	var builder strings.Builder
+	for _, r := range string(encoded) {
+		if r < 0x7f {
+			builder.WriteRune(r)
+			continue
+		}
+		for _, unit := range utf16.Encode([]rune{r}) {
+			fmt.Fprintf(&builder, "\\u%04x", unit)
+		}
+	}
+	return []byte(builder.String()), nil
+}
+
+func copyHeaders(headers http.Header) map[string][]string {
+	copied := make(map[string][]string, len(headers))
+	for key, values := range headers {
+		copied[key] = append([]string(nil), values...)
+	}
+	return copied
+}
@@ -1,18 +1,15 @@
 package dropbox
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 	"time"
 
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
-	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
 	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
@@ -22,7 +19,7 @@ import (
 const (
 	dropboxProviderName         = "dropbox"
 	createFolderComponentName   = "dropbox_create_folder"
-	dropboxCreateFolderEndpoint = "https://api.dropboxapi.com/2/files/create_folder_v2"
+	dropboxCreateFolderEndpoint = dropboxAPIBaseURL + "/files/create_folder_v2"
 )
 
 // ProviderResolver exposes OAuth providers by name
@@ -48,30 +45,18 @@ func (systemClock) Now() time.Time {
 
 // FolderExecutor delivers Dropbox reactions that create folders
 type FolderExecutor struct {
-	identities identityport.Repository
-	providers  ProviderResolver
-	http       HTTPClient
-	clock      Clock
-	logger     *zap.Logger
+	api    apiClient
+	logger *zap.Logger
 }
 
 // NewFolderExecutor constructs a FolderExecutor from its dependencies
 func NewFolderExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *FolderExecutor {
-	if client == nil {
-		client = http.DefaultClient
-	}
-	if clock == nil {
-		clock = systemClock{}
-	}
 	if logger == nil {
 		logger = zap.NewNop()
 	}
 	return &FolderExecutor{
-		identities: identities,
-		providers:  providers,
-		http:       client,
-		clock:      clock,
-		logger:     logger,
+		api:    newAPIClient("dropbox.FolderExecutor", identities, providers, client, clock),
+		logger: logger,
 	}
 }
 
@@ -89,7 +74,7 @@ func (e *FolderExecutor) Execute(ctx context.Context, area areadomain.Area, link
 	if !e.Supports(link.Config.Component) {
 		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FolderExecutor: unsupported component")
 	}
-	if e.identities == nil || e.providers == nil {
+	if !e.api.configured() {
 		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FolderExecutor: resolver not configured")
 	}
 
@@ -98,146 +83,57 @@ func (e *FolderExecutor) Execute(ctx context.Context, area areadomain.Area, link
 		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FolderExecutor: %w", err)
 	}
 
-	identity, err := e.identities.FindByID(ctx, cfg.identityID)
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, cfg.identityID)
 	if err != nil {
-		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FolderExecutor: identity lookup: %w", err)
-	}
-	if identity.UserID != area.UserID {
-		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FolderExecutor: identity not owned by user")
+		return outbound.ReactionResult{}, err
 	}
 
-	identity, accessToken, err := e.ensureAccessToken(ctx, identity, false)
+	call, err := createFolderCall(cfg)
 	if err != nil {
 		return outbound.ReactionResult{}, err
 	}
 
-	result, unauthorized, err := e.createFolder(ctx, accessToken, cfg)
-	if err != nil && unauthorized {
-		identity, accessToken, err = e.ensureAccessToken(ctx, identity, true)
-		if err != nil {
-			return outbound.ReactionResult{}, err
-		}
-		result, unauthorized, err = e.createFolder(ctx, accessToken, cfg)
-	}
-	if err != nil {
-		return result, err
-	}
-	if unauthorized {
-		return result, fmt.Errorf("dropbox.FolderExecutor: unauthorized after refresh")
+	sess := &session{api: e.api, identity: identity, accessToken: accessToken}
+	if _, err := sess.call(ctx, call); err != nil {
+		return sess.result, err
 	}
 
 	e.logger.Info("dropbox folder created",
 		zap.String("area_id", area.ID.String()),
-		zap.String("identity_id", identity.ID.String()),
+		zap.String("identity_id", sess.identity.ID.String()),
 		zap.String("path", cfg.path),
 	)
-	return result, nil
+	return sess.result, nil
 }
 
-func (e *FolderExecutor) createFolder(ctx context.Context, accessToken string, cfg folderConfig) (outbound.ReactionResult, bool, error) {
-	payload := map[string]any{
-		"path":       cfg.path,
-		"autorename": cfg.autorename,
+// Preview describes the folder creation request without sending it
+func (e *FolderExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FolderExecutor: unsupported component")
 	}
-
-	bodyBytes, err := json.Marshal(payload)
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("dropbox.FolderExecutor: marshal payload: %w", err)
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FolderExecutor: resolver not configured")
 	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, dropboxCreateFolderEndpoint, bytes.NewReader(bodyBytes))
+	cfg, err := parseFolderConfig(link.Config.Params)
 	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("dropbox.FolderExecutor: build request: %w", err)
+		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FolderExecutor: %w", err)
 	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", "AREA-Server")
-
-	start := time.Now()
-	resp, err := e.http.Do(req)
+	call, err := createFolderCall(cfg)
 	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("dropbox.FolderExecutor: request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := io.ReadAll(resp.Body)
-	duration := time.Since(start)
-
-	requestHeaders := copyHeaders(req.Header)
-	responseHeaders := copyHeaders(resp.Header)
-
-	result := outbound.ReactionResult{
-		Endpoint: dropboxCreateFolderEndpoint,
-		Request: map[string]any{
-			"method":  http.MethodPost,
-			"url":     dropboxCreateFolderEndpoint,
-			"headers": requestHeaders,
-			"body":    string(bodyBytes),
-		},
-		Response: map[string]any{
-			"body":    string(respBody),
-			"headers": responseHeaders,
-		},
-		StatusCode: &resp.StatusCode,
-		Duration:   duration,
-	}
-
-	if resp.StatusCode >= 400 {
-		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
-			return result, true, fmt.Errorf("dropbox.FolderExecutor: received status %d", resp.StatusCode)
-		}
-		return result, false, fmt.Errorf("dropbox.FolderExecutor: received status %d", resp.StatusCode)
+		return outbound.ReactionResult{}, err
 	}
-
-	return result, false, nil
+	return e.api.preview(ctx, area, cfg.identityID, call)
 }
 
-func (e *FolderExecutor) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
-	now := e.now()
-	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
-		return identity, identity.AccessToken, nil
-	}
-
-	provider, ok := e.providers.Provider(dropboxProviderName)
-	if !ok {
-		return identity, "", fmt.Errorf("dropbox.FolderExecutor: provider %s not configured", dropboxProviderName)
-	}
-
-	exchange, err := provider.Refresh(ctx, identity)
+func createFolderCall(cfg folderConfig) (apiCall, error) {
+	payload, err := json.Marshal(map[string]any{
+		"path":       cfg.path,
+		"autorename": cfg.autorename,
+	})
 	if err != nil {
-		return identity, "", fmt.Errorf("dropbox.FolderExecutor: refresh token: %w", err)
-	}
-
-	refreshToken := exchange.Token.RefreshToken
-	if refreshToken == "" {
-		refreshToken = identity.RefreshToken
+		return apiCall{}, fmt.Errorf("dropbox.FolderExecutor: marshal payload: %w", err)
 	}
-	expiresAt := identity.ExpiresAt
-	if !exchange.Token.ExpiresAt.IsZero() {
-		expires := exchange.Token.ExpiresAt.UTC()
-		expiresAt = &expires
-	}
-	scopes := exchange.Token.Scope
-	if len(scopes) == 0 {
-		scopes = identity.Scopes
-	}
-
-	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
-	updated.UpdatedAt = now
-
-	if err := e.identities.Update(ctx, updated); err != nil {
-		return identity, "", fmt.Errorf("dropbox.FolderExecutor: update identity: %w", err)
-	}
-
-	return updated, updated.AccessToken, nil
-}
-
-func (e *FolderExecutor) now() time.Time {
-	if e.clock == nil {
-		return time.Now().UTC()
-	}
-	return e.clock.Now().UTC()
+	return apiCall{endpoint: dropboxCreateFolderEndpoint, payload: payload}, nil
 }
 
 type folderConfig struct {
@@ -335,14 +231,6 @@ func toString(value any) (string, error) {
 	}
 }
 
-func copyHeaders(headers http.Header) map[string][]string {
-	copied := make(map[string][]string, len(headers))
-	for key, values := range headers {
-		copied[key] = append([]string(nil), values...)
-	}
-	return copied
-}
-
 // Ensure FolderExecutor satisfies the ComponentReactionHandler contract
 var _ interface {
 	Supports(*componentdomain.Component) bool
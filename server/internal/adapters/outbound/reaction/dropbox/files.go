@@ -0,0 +1,438 @@
+package dropbox
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/filesource"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	uploadFileComponentName = "dropbox_upload_file"
+	copyFileComponentName   = "dropbox_copy_file"
+	shareFileComponentName  = "dropbox_share_file"
+	// Dropbox rejects single uploads above 150 MiB, larger or unsized sources go through an upload session
+	dropboxSingleUploadLimit = 150 << 20
+	dropboxUploadChunkSize   = 64 << 20
+)
+
+// fileInput gathers what an operation needs to plan its Dropbox calls
+// The event carries the payload of the action that triggered the reaction when available
+type fileInput struct {
+	params  map[string]any
+	event   map[string]any
+	sources filesource.Doer
+}
+
+// filePlan describes the target of a Dropbox reaction and the calls that perform it
+// call is set when the plan issues a single request known upfront, which makes it previewable
+type filePlan struct {
+	identityID uuid.UUID
+	target     string
+	call       *apiCall
+	run        func(ctx context.Context, sess *session) error
+}
+
+type fileOperation func(input fileInput) (filePlan, error)
+
+var fileOperations = map[string]fileOperation{
+	uploadFileComponentName: planUploadFile,
+	copyFileComponentName:   planCopyFile,
+	shareFileComponentName:  planShareFile,
+}
+
+// FileExecutor delivers Dropbox reactions that upload, copy and share files
+type FileExecutor struct {
+	api     apiClient
+	sources filesource.Doer
+	logger  *zap.Logger
+}
+
+// NewFileExecutor constructs a FileExecutor from its dependencies
+func NewFileExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *FileExecutor {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &FileExecutor{
+		api:     newAPIClient("dropbox.FileExecutor", identities, providers, client, clock),
+		sources: filesource.NewClient(),
+		logger:  logger,
+	}
+}
+
+// Supports reports whether the executor can handle the provided component
+func (e *FileExecutor) Supports(component *componentdomain.Component) bool {
+	if component == nil || !strings.EqualFold(component.Provider.Name, dropboxProviderName) {
+		return false
+	}
+	_, ok := fileOperations[strings.ToLower(component.Name)]
+	return ok
+}
+
+// Execute performs the Dropbox file operation selected by the component using the linked identity
+func (e *FileExecutor) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FileExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FileExecutor: resolver not configured")
+	}
+
+	component := link.Config.Component
+	event, _ := outbound.TriggerEvent(ctx)
+	plan, err := fileOperations[strings.ToLower(component.Name)](fileInput{
+		params:  link.Config.Params,
+		event:   event,
+		sources: e.sources,
+	})
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FileExecutor: %w", err)
+	}
+
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, plan.identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+
+	sess := &session{api: e.api, identity: identity, accessToken: accessToken}
+	if err := plan.run(ctx, sess); err != nil {
+		return sess.result, err
+	}
+
+	e.logger.Info("dropbox reaction delivered",
+		zap.String("area_id", area.ID.String()),
+		zap.String("identity_id", sess.identity.ID.String()),
+		zap.String("component", component.Name),
+		zap.String("target", plan.target),
+	)
+	return sess.result, nil
+}
+
+// Preview describes the request of the Dropbox file operation without sending it
+// Uploads stream their source and sharing may fall back to listing links, neither can be previewed
+func (e *FileExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FileExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FileExecutor: resolver not configured")
+	}
+	event, _ := outbound.TriggerEvent(ctx)
+	plan, err := fileOperations[strings.ToLower(link.Config.Component.Name)](fileInput{
+		params:  link.Config.Params,
+		event:   event,
+		sources: e.sources,
+	})
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("dropbox.FileExecutor: %w", err)
+	}
+	if plan.call == nil {
+		return outbound.ReactionResult{}, outbound.ErrPreviewUnsupported
+	}
+	return e.api.preview(ctx, area, plan.identityID, *plan.call)
+}
+
+// planUploadFile writes the content of a URL, a text or an event field to a Dropbox path
+// A path ending with a slash is treated as a folder and completed with the name of the source
+func planUploadFile(input fileInput) (filePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return filePlan{}, err
+	}
+	path, err := requiredPath(input.params, "path")
+	if err != nil {
+		return filePlan{}, err
+	}
+	spec, err := filesource.ParseSpec(input.params)
+	if err != nil {
+		return filePlan{}, fmt.Errorf("parse file config: %w", err)
+	}
+	overwrite, err := optionalBool(input.params, "overwrite", false)
+	if err != nil {
+		return filePlan{}, fmt.Errorf("parse file config: overwrite invalid: %w", err)
+	}
+	autorename, err := optionalBool(input.params, "autorename", false)
+	if err != nil {
+		return filePlan{}, fmt.Errorf("parse file config: autorename invalid: %w", err)
+	}
+	mode := "add"
+	if overwrite {
+		mode = "overwrite"
+	}
+
+	return filePlan{
+		identityID: identityID,
+		target:     path,
+		run: func(ctx context.Context, sess *session) error {
+			content, err := spec.Open(ctx, input.sources, input.event)
+			if err != nil {
+				return fmt.Errorf("%s: open %s: %w", sess.api.name, spec.Describe(), err)
+			}
+			defer content.Body.Close()
+
+			destination := path
+			if strings.HasSuffix(destination, "/") {
+				if content.Name == "" {
+					return fmt.Errorf("%s: path %q is a folder and the source has no file name", sess.api.name, path)
+				}
+				destination += content.Name
+			}
+			commit := map[string]any{
+				"path":       destination,
+				"mode":       mode,
+				"autorename": autorename,
+				"mute":       false,
+			}
+
+			if content.Size >= 0 && content.Size <= dropboxSingleUploadLimit {
+				return uploadSingle(ctx, sess, spec, input, content, commit)
+			}
+			return uploadSession(ctx, sess, content.Body, commit)
+		},
+	}, nil
+}
+
+// uploadSingle streams the source in one request, the source is opened again if the request is replayed
+func uploadSingle(ctx context.Context, sess *session, spec filesource.Spec, input fileInput, content *filesource.Content, commit map[string]any) error {
+	arg, err := headerArg(commit)
+	if err != nil {
+		return fmt.Errorf("%s: encode upload argument: %w", sess.api.name, err)
+	}
+	opened := content
+	_, err = sess.call(ctx, apiCall{
+		endpoint: dropboxContentBaseURL + "/files/upload",
+		arg:      arg,
+		body: func() (io.ReadCloser, int64, error) {
+			if opened != nil {
+				body, size := opened.Body, opened.Size
+				opened = nil
+				return body, size, nil
+			}
+			reopened, err := spec.Open(ctx, input.sources, input.event)
+			if err != nil {
+				return nil, 0, err
+			}
+			return reopened.Body, reopened.Size, nil
+		},
+	})
+	return err
+}
+
+// uploadSession streams the source in fixed size chunks so no more than one request is in flight
+// Chunks are read straight from the source and therefore cannot be replayed
+func uploadSession(ctx context.Context, sess *session, source io.Reader, commit map[string]any) error {
+	startArg, err := headerArg(map[string]any{"close": false})
+	if err != nil {
+		return fmt.Errorf("%s: encode upload argument: %w", sess.api.name, err)
+	}
+	started, err := sess.call(ctx, apiCall{endpoint: dropboxContentBaseURL + "/files/upload_session/start", arg: startArg})
+	if err != nil {
+		return err
+	}
+	sessionID, _ := started["session_id"].(string)
+	if sessionID == "" {
+		return fmt.Errorf("%s: upload session id missing from response", sess.api.name)
+	}
+
+	reader := bufio.NewReader(source)
+	var offset int64
+	for {
+		if _, err := reader.Peek(1); err != nil {
+			if err == io.EOF {
+				break
+			}
+			return fmt.Errorf("%s: read source: %w", sess.api.name, err)
+		}
+		arg, err := headerArg(map[string]any{
+			"cursor": map[string]any{"session_id": sessionID, "offset": offset},
+			"close":  false,
+		})
+		if err != nil {
+			return fmt.Errorf("%s: encode upload argument: %w", sess.api.name, err)
+		}
+		chunk := &countingReader{reader: io.LimitReader(reader, dropboxUploadChunkSize)}
+		consumed := false
+		if _, err := sess.call(ctx, apiCall{
+			endpoint: dropboxContentBaseURL + "/files/upload_session/append_v2",
+			arg:      arg,
+			body: func() (io.ReadCloser, int64, error) {
+				if consumed {
+					return nil, 0, fmt.Errorf("chunk at offset %d cannot be replayed", offset)
+				}
+				consumed = true
+				return io.NopCloser(chunk), -1, nil
+			},
+		}); err != nil {
+			return err
+		}
+		offset += chunk.read
+	}
+
+	finishArg, err := headerArg(map[string]any{
+		"cursor": map[string]any{"session_id": sessionID, "offset": offset},
+		"commit": commit,
+	})
+	if err != nil {
+		return fmt.Errorf("%s: encode upload argument: %w", sess.api.name, err)
+	}
+	_, err = sess.call(ctx, apiCall{endpoint: dropboxContentBaseURL + "/files/upload_session/finish", arg: finishArg})
+	return err
+}
+
+// planCopyFile copies a file or folder to another path of the same Dropbox
+func planCopyFile(input fileInput) (filePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return filePlan{}, err
+	}
+	fromPath, err := requiredPath(input.params, "fromPath")
+	if err != nil {
+		return filePlan{}, err
+	}
+	toPath, err := requiredPath(input.params, "toPath")
+	if err != nil {
+		return filePlan{}, err
+	}
+	autorename, err := optionalBool(input.params, "autorename", false)
+	if err != nil {
+		return filePlan{}, fmt.Errorf("parse file config: autorename invalid: %w", err)
+	}
+	payload, err := json.Marshal(map[string]any{
+		"from_path":  fromPath,
+		"to_path":    toPath,
+		"autorename": autorename,
+	})
+	if err != nil {
+		return filePlan{}, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	call := apiCall{endpoint: dropboxAPIBaseURL + "/files/copy_v2", payload: payload}
+	return filePlan{
+		identityID: identityID,
+		target:     toPath,
+		call:       &call,
+		run: func(ctx context.Context, sess *session) error {
+			_, err := sess.call(ctx, call)
+			return err
+		},
+	}, nil
+}
+
+// planShareFile creates a public shared link, reusing the existing link when the file is already shared
+// The link is exposed as sharedLink in the reaction response
+func planShareFile(input fileInput) (filePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return filePlan{}, err
+	}
+	path, err := requiredPath(input.params, "path")
+	if err != nil {
+		return filePlan{}, err
+	}
+	createPayload, err := json.Marshal(map[string]any{
+		"path":     path,
+		"settings": map[string]any{"requested_visibility": "public"},
+	})
+	if err != nil {
+		return filePlan{}, fmt.Errorf("marshal payload: %w", err)
+	}
+	listPayload, err := json.Marshal(map[string]any{"path": path, "direct_only": true})
+	if err != nil {
+		return filePlan{}, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	return filePlan{
+		identityID: identityID,
+		target:     path,
+		run: func(ctx context.Context, sess *session) error {
+			created, err := sess.call(ctx, apiCall{endpoint: dropboxAPIBaseURL + "/sharing/create_shared_link_with_settings", payload: createPayload})
+			link, _ := created["url"].(string)
+			if err != nil {
+				summary, _ := created["error_summary"].(string)
+				if !strings.HasPrefix(summary, "shared_link_already_exists") {
+					return err
+				}
+				listed, err := sess.call(ctx, apiCall{endpoint: dropboxAPIBaseURL + "/sharing/list_shared_links", payload: listPayload})
+				if err != nil {
+					return err
+				}
+				if links, ok := listed["links"].([]any); ok && len(links) > 0 {
+					first, _ := links[0].(map[string]any)
+					link, _ = first["url"].(string)
+				}
+			}
+			if link == "" {
+				return fmt.Errorf("%s: shared link missing from response", sess.api.name)
+			}
+			sess.result.Response["sharedLink"] = link
+			return nil
+		},
+	}, nil
+}
+
+func parseIdentityID(params map[string]any) (uuid.UUID, error) {
+	if params == nil {
+		return uuid.Nil, fmt.Errorf("parse file config: params missing")
+	}
+	raw, ok := params["identityId"]
+	if !ok {
+		return uuid.Nil, fmt.Errorf("parse file config: identityId missing")
+	}
+	value, err := toString(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("parse file config: identityId invalid: %w", err)
+	}
+	identityID, err := uuid.Parse(strings.TrimSpace(value))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("parse file config: parse identityId: %w", err)
+	}
+	return identityID, nil
+}
+
+// requiredPath reads a Dropbox path and makes it absolute
+func requiredPath(params map[string]any, key string) (string, error) {
+	raw, ok := params[key]
+	if !ok {
+		return "", fmt.Errorf("parse file config: %s missing", key)
+	}
+	value, err := toString(raw)
+	if err != nil {
+		return "", fmt.Errorf("parse file config: %s invalid: %w", key, err)
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("parse file config: %s empty", key)
+	}
+	if !strings.HasPrefix(value, "/") {
+		value = "/" + value
+	}
+	return value, nil
+}
+
+// countingReader records how many bytes of an upload chunk were sent
+type countingReader struct {
+	reader io.Reader
+	read   int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.read += int64(n)
+	return n, err
+}
+
+// Ensure FileExecutor satisfies the ComponentReactionHandler contract
+var _ interface {
+	Supports(*componentdomain.Component) bool
+	Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error)
+} = (*FileExecutor)(nil)
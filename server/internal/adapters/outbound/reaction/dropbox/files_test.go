@@ -0,0 +1,199 @@
+package dropbox
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+// sequenceClientStub answers requests in order and drains their bodies like a real transport would
+type sequenceClientStub struct {
+	responses []http.Response
+	requests  []*http.Request
+	bodies    []string
+}
+
+func (c *sequenceClientStub) Do(req *http.Request) (*http.Response, error) {
+	c.requests = append(c.requests, req)
+	body := ""
+	if req.Body != nil {
+		raw, _ := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		body = string(raw)
+	}
+	c.bodies = append(c.bodies, body)
+	if len(c.responses) == 0 {
+		return &http.Response{StatusCode: http.StatusOK, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}
+	resp := c.responses[0]
+	c.responses = c.responses[1:]
+	return &resp, nil
+}
+
+func dropboxJSON(status int, body string) http.Response {
+	return http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func fileFixture(t *testing.T, componentName string, params map[string]any, responses ...http.Response) (*FileExecutor, *sequenceClientStub, areadomain.Area, areadomain.Link) {
+	t.Helper()
+	userID := uuid.New()
+	identityID := uuid.New()
+	now := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Hour)
+	repo := &identityRepoStub{identity: identitydomain.Identity{
+		ID:          identityID,
+		UserID:      userID,
+		Provider:    dropboxProviderName,
+		AccessToken: "access-token",
+		ExpiresAt:   &expires,
+	}}
+	client := &sequenceClientStub{responses: responses}
+	exec := NewFileExecutor(repo, providerResolverStub{}, client, clockStub{now: now}, zap.NewNop())
+	exec.sources = client
+
+	params["identityId"] = identityID.String()
+	link := areadomain.Link{
+		ID:   uuid.New(),
+		Role: areadomain.LinkRoleReaction,
+		Config: componentdomain.Config{
+			Params: params,
+			Component: &componentdomain.Component{
+				Name:     componentName,
+				Provider: componentdomain.Provider{Name: dropboxProviderName},
+			},
+		},
+	}
+	return exec, client, areadomain.Area{ID: uuid.New(), UserID: userID}, link
+}
+
+func TestFileExecutorSupports(t *testing.T) {
+	exec := NewFileExecutor(nil, nil, nil, nil, nil)
+	for name := range fileOperations {
+		if !exec.Supports(&componentdomain.Component{Name: name, Provider: componentdomain.Provider{Name: "Dropbox"}}) {
+			t.Fatalf("expected %s to be supported", name)
+		}
+	}
+	if exec.Supports(&componentdomain.Component{Name: createFolderComponentName, Provider: componentdomain.Provider{Name: dropboxProviderName}}) {
+		t.Fatal("folder creation belongs to FolderExecutor")
+	}
+}
+
+func TestFileExecutorUploadsEventField(t *testing.T) {
+	exec, client, area, link := fileFixture(t, uploadFileComponentName, map[string]any{
+		"path":         "/Inbox/notes.txt",
+		"contentField": "attachment",
+		"overwrite":    true,
+	}, dropboxJSON(http.StatusOK, `{"name":"notes.txt"}`))
+
+	ctx := outbound.WithTriggerEvent(context.Background(), map[string]any{"attachment": "hello ünïcode"})
+	if _, err := exec.Execute(ctx, area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+
+	if len(client.requests) != 1 {
+		t.Fatalf("expected a single upload request got %d", len(client.requests))
+	}
+	req := client.requests[0]
+	if req.URL.String() != dropboxContentBaseURL+"/files/upload" {
+		t.Fatalf("unexpected endpoint %s", req.URL)
+	}
+	if client.bodies[0] != "hello ünïcode" {
+		t.Fatalf("unexpected upload body %q", client.bodies[0])
+	}
+	arg := req.Header.Get("Dropbox-API-Arg")
+	for _, r := range arg {
+		if r > 0x7f {
+			t.Fatalf("Dropbox-API-Arg must be ASCII got %q", arg)
+		}
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal([]byte(arg), &decoded); err != nil {
+		t.Fatalf("decode Dropbox-API-Arg: %v", err)
+	}
+	if decoded["path"] != "/Inbox/notes.txt" || decoded["mode"] != "overwrite" {
+		t.Fatalf("unexpected upload argument %v", decoded)
+	}
+}
+
+func TestFileExecutorUploadsUnsizedSourceThroughSession(t *testing.T) {
+	source := strings.Repeat("a", 1024)
+	exec, client, area, link := fileFixture(t, uploadFileComponentName, map[string]any{
+		"path":      "/Backups/",
+		"sourceUrl": "https://files.example.com/archive.tar",
+	},
+		http.Response{StatusCode: http.StatusOK, ContentLength: -1, Header: http.Header{}, Body: io.NopCloser(strings.NewReader(source))},
+		dropboxJSON(http.StatusOK, `{"session_id":"sess-1"}`),
+		dropboxJSON(http.StatusOK, `null`),
+		dropboxJSON(http.StatusOK, `{"name":"archive.tar"}`),
+	)
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+
+	if len(client.requests) != 4 {
+		t.Fatalf("expected download, start, append and finish got %d requests", len(client.requests))
+	}
+	if client.requests[0].URL.Host != "files.example.com" {
+		t.Fatalf("expected source download first got %s", client.requests[0].URL)
+	}
+	if !strings.HasSuffix(client.requests[2].URL.Path, "/upload_session/append_v2") || client.bodies[2] != source {
+		t.Fatalf("unexpected append request %s with %d bytes", client.requests[2].URL, len(client.bodies[2]))
+	}
+	var finish map[string]any
+	if err := json.Unmarshal([]byte(client.requests[3].Header.Get("Dropbox-API-Arg")), &finish); err != nil {
+		t.Fatalf("decode finish argument: %v", err)
+	}
+	cursor, _ := finish["cursor"].(map[string]any)
+	commit, _ := finish["commit"].(map[string]any)
+	if cursor["session_id"] != "sess-1" || cursor["offset"] != float64(len(source)) {
+		t.Fatalf("unexpected finish cursor %v", cursor)
+	}
+	if commit["path"] != "/Backups/archive.tar" {
+		t.Fatalf("unexpected commit path %v", commit["path"])
+	}
+}
+
+func TestFileExecutorShareReusesExistingLink(t *testing.T) {
+	exec, client, area, link := fileFixture(t, shareFileComponentName, map[string]any{"path": "Reports/q1.pdf"},
+		dropboxJSON(http.StatusConflict, `{"error_summary":"shared_link_already_exists/metadata/..","error":{".tag":"shared_link_already_exists"}}`),
+		dropboxJSON(http.StatusOK, `{"links":[{"url":"https://www.dropbox.com/s/abc/q1.pdf?dl=0"}]}`),
+	)
+
+	result, err := exec.Execute(context.Background(), area, link)
+	if err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if got := result.Response["sharedLink"]; got != "https://www.dropbox.com/s/abc/q1.pdf?dl=0" {
+		t.Fatalf("unexpected shared link %v", got)
+	}
+	if !strings.Contains(client.bodies[1], `"path":"/Reports/q1.pdf"`) {
+		t.Fatalf("unexpected list payload %s", client.bodies[1])
+	}
+}
+
+func TestFileExecutorRejectsAmbiguousSource(t *testing.T) {
+	exec, _, area, link := fileFixture(t, uploadFileComponentName, map[string]any{
+		"path":      "/notes.txt",
+		"content":   "hello",
+		"sourceUrl": "https://files.example.com/notes.txt",
+	})
+	if _, err := exec.Execute(context.Background(), area, link); err == nil || !strings.Contains(err.Error(), "mutually exclusive") {
+		t.Fatalf("expected mutually exclusive error got %v", err)
+	}
+}
@@ -0,0 +1,230 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/mailbox"
+	mailutils "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/mail"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const sendMessageComponentName = "email_send_message"
+
+// Sender submits messages to an SMTP server
+type Sender interface {
+	Send(ctx context.Context, server mailbox.Server, username string, password string, msg mailbox.Message) error
+}
+
+// Clock abstracts time retrieval for deterministic tests
+type Clock interface {
+	Now() time.Time
+}
+
+// SendExecutor sends emails through the SMTP server of a mailbox linked with credentials
+type SendExecutor struct {
+	identities identityport.Repository
+	sender     Sender
+	clock      Clock
+	logger     *zap.Logger
+}
+
+// NewSendExecutor constructs an SMTP send executor from its dependencies
+func NewSendExecutor(identities identityport.Repository, sender Sender, clock Clock, logger *zap.Logger) *SendExecutor {
+	if sender == nil {
+		sender = mailbox.Dialer{}
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &SendExecutor{identities: identities, sender: sender, clock: clock, logger: logger}
+}
+
+// Supports reports whether the executor can handle the provided component
+func (e *SendExecutor) Supports(component *componentdomain.Component) bool {
+	if component == nil {
+		return false
+	}
+	return strings.EqualFold(component.Name, sendMessageComponentName) && strings.EqualFold(component.Provider.Name, mailbox.ProviderName)
+}
+
+// Execute submits the configured message with the mailbox account of the selected identity
+func (e *SendExecutor) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	prepared, err := e.prepare(ctx, area, link)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	account, cfg, result := prepared.account, prepared.cfg, prepared.result
+
+	message := mailbox.Message{
+		From:    account.Address,
+		To:      cfg.to,
+		Cc:      cfg.cc,
+		Bcc:     cfg.bcc,
+		ReplyTo: cfg.replyTo,
+		Subject: cfg.subject,
+		Text:    cfg.body,
+		HTML:    cfg.html,
+	}
+
+	start := e.clock.Now()
+	err = e.sender.Send(ctx, account.SMTP, account.Username, account.Password, message)
+	result.Duration = e.clock.Now().Sub(start)
+	if err != nil {
+		return result, fmt.Errorf("email.SendExecutor: %w", err)
+	}
+	result.Response = map[string]any{
+		"accepted":   true,
+		"recipients": len(message.Recipients()),
+	}
+
+	e.logger.Info("email reaction delivered",
+		zap.String("area_id", area.ID.String()),
+		zap.String("identity_id", prepared.identityID.String()),
+		zap.String("smtp_server", account.SMTP.Address()),
+		zap.Int("recipient_count", len(message.Recipients())),
+	)
+	return result, nil
+}
+
+// Preview describes the message and SMTP server without submitting anything
+func (e *SendExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	prepared, err := e.prepare(ctx, area, link)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	result := prepared.result
+	result.Request["replyTo"] = prepared.cfg.replyTo
+	result.Request["text"] = prepared.cfg.body
+	result.Request["html"] = prepared.cfg.html
+	return result, nil
+}
+
+// preparedMessage bundles the mailbox account of a reaction with the message it sends
+type preparedMessage struct {
+	identityID uuid.UUID
+	account    mailbox.Account
+	cfg        messageConfig
+	result     outbound.ReactionResult
+}
+
+// prepare resolves the mailbox account of the reaction and describes the message it sends
+func (e *SendExecutor) prepare(ctx context.Context, area areadomain.Area, link areadomain.Link) (preparedMessage, error) {
+	if !e.Supports(link.Config.Component) {
+		return preparedMessage{}, fmt.Errorf("email.SendExecutor: unsupported component")
+	}
+	if e.identities == nil {
+		return preparedMessage{}, fmt.Errorf("email.SendExecutor: identity repository not configured")
+	}
+
+	cfg, err := parseMessageConfig(link.Config.Params)
+	if err != nil {
+		return preparedMessage{}, fmt.Errorf("email.SendExecutor: %w", err)
+	}
+
+	identity, err := e.identities.FindByID(ctx, cfg.identityID)
+	if err != nil {
+		return preparedMessage{}, fmt.Errorf("email.SendExecutor: identity lookup: %w", err)
+	}
+	if identity.UserID != area.UserID {
+		return preparedMessage{}, fmt.Errorf("email.SendExecutor: identity not owned by user")
+	}
+	if !strings.EqualFold(identity.Provider, mailbox.ProviderName) {
+		return preparedMessage{}, fmt.Errorf("email.SendExecutor: identity is not a mailbox account")
+	}
+	account, err := mailbox.DecodeAccount(identity.AccessToken)
+	if err != nil {
+		return preparedMessage{}, fmt.Errorf("email.SendExecutor: %w", err)
+	}
+
+	return preparedMessage{
+		identityID: identity.ID,
+		account:    account,
+		cfg:        cfg,
+		result: outbound.ReactionResult{
+			Endpoint: "smtp://" + account.SMTP.Address(),
+			Request: map[string]any{
+				"from":    account.Address,
+				"to":      append([]string(nil), cfg.to...),
+				"cc":      append([]string(nil), cfg.cc...),
+				"bcc":     append([]string(nil), cfg.bcc...),
+				"subject": cfg.subject,
+			},
+		},
+	}, nil
+}
+
+type messageConfig struct {
+	identityID uuid.UUID
+	to         []string
+	cc         []string
+	bcc        []string
+	replyTo    string
+	subject    string
+	body       string
+	html       string
+}
+
+func parseMessageConfig(params map[string]any) (messageConfig, error) {
+	cfg := messageConfig{}
+
+	identityStr, err := mailutils.ToString(params["identityId"])
+	if err != nil {
+		return cfg, fmt.Errorf("identityId missing")
+	}
+	cfg.identityID, err = uuid.Parse(strings.TrimSpace(identityStr))
+	if err != nil {
+		return cfg, fmt.Errorf("identityId parse: %w", err)
+	}
+
+	cfg.to, err = mailutils.ParseList(params["to"], false)
+	if err != nil {
+		return cfg, fmt.Errorf("to invalid: %w", err)
+	}
+	if cfg.cc, err = mailutils.ParseList(params["cc"], true); err != nil {
+		return cfg, fmt.Errorf("cc invalid: %w", err)
+	}
+	if cfg.bcc, err = mailutils.ParseList(params["bcc"], true); err != nil {
+		return cfg, fmt.Errorf("bcc invalid: %w", err)
+	}
+	if value, err := mailutils.ToString(params["replyTo"]); err == nil {
+		cfg.replyTo = strings.TrimSpace(value)
+	}
+
+	subject, err := mailutils.ToString(params["subject"])
+	if err != nil {
+		return cfg, fmt.Errorf("subject missing")
+	}
+	cfg.subject = strings.TrimSpace(strings.NewReplacer("\r\n", " ", "\r", " ", "\n", " ").Replace(subject))
+
+	if value, err := mailutils.ToString(params["body"]); err == nil {
+		cfg.body = value
+	}
+	if value, err := mailutils.ToString(params["html"]); err == nil {
+		cfg.html = value
+	}
+	if strings.TrimSpace(cfg.body) == "" && strings.TrimSpace(cfg.html) == "" {
+		return cfg, fmt.Errorf("body or html is required")
+	}
+	return cfg, nil
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now().UTC() }
+
+// Ensure SendExecutor satisfies the ComponentReactionHandler contract
+var _ interface {
+	Supports(*componentdomain.Component) bool
+	Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error)
+} = (*SendExecutor)(nil)
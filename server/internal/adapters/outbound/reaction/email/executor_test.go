@@ -0,0 +1,126 @@
+package email
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/mailbox"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/endpoints/fakemail"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func TestSendExecutorSubmitsThroughLinkedMailbox(t *testing.T) {
+	server := fakemail.New("me@example.com", "s3cret")
+	addr, err := server.ListenSMTP("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenSMTP returned error: %v", err)
+	}
+	defer server.Close()
+	_, portText, _ := strings.Cut(addr, ":")
+	port, _ := strconv.Atoi(portText)
+
+	secret, _ := mailbox.Account{
+		Address:  "me@example.com",
+		Username: "me@example.com",
+		Password: "s3cret",
+		SMTP:     mailbox.Server{Host: "127.0.0.1", Port: port, Security: mailbox.SecurityNone},
+	}.Encode()
+	userID := uuid.New()
+	identity := identitydomain.Identity{ID: uuid.New(), UserID: userID, Provider: mailbox.ProviderName, Subject: "me@example.com", AccessToken: secret}
+	exec := NewSendExecutor(&identityRepoStub{identity: identity}, mailbox.Dialer{Timeout: 5 * time.Second, AllowPrivate: true}, nil, zap.NewNop())
+
+	link := sendLink(map[string]any{
+		"identityId": identity.ID.String(),
+		"to":         "ops@example.com; lead@example.com",
+		"bcc":        "audit@example.com",
+		"subject":    "Deploy\r\nfinished",
+		"body":       "All green",
+	})
+	result, err := exec.Execute(context.Background(), areadomain.Area{ID: uuid.New(), UserID: userID}, link)
+	if err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if result.Endpoint != "smtp://"+addr || result.Response["recipients"] != 3 {
+		t.Fatalf("unexpected result %+v", result)
+	}
+
+	sent := server.Sent()
+	if len(sent) != 1 || len(sent[0].To) != 3 {
+		t.Fatalf("unexpected envelope %+v", sent)
+	}
+	if !strings.Contains(string(sent[0].Data), "Subject: Deploy finished\r\n") {
+		t.Fatalf("expected folded subject got %s", sent[0].Data)
+	}
+}
+
+func TestSendExecutorRejectsForeignIdentity(t *testing.T) {
+	identity := identitydomain.Identity{ID: uuid.New(), UserID: uuid.New(), Provider: mailbox.ProviderName}
+	exec := NewSendExecutor(&identityRepoStub{identity: identity}, nil, nil, nil)
+	link := sendLink(map[string]any{"identityId": identity.ID.String(), "to": "a@example.com", "subject": "s", "body": "b"})
+	if _, err := exec.Execute(context.Background(), areadomain.Area{UserID: uuid.New()}, link); err == nil || !strings.Contains(err.Error(), "not owned") {
+		t.Fatalf("expected ownership error got %v", err)
+	}
+}
+
+func TestParseMessageConfigRequiresContent(t *testing.T) {
+	if _, err := parseMessageConfig(map[string]any{"identityId": uuid.NewString(), "to": "a@example.com", "subject": "s"}); err == nil {
+		t.Fatal("expected error without body or html")
+	}
+}
+
+func sendLink(params map[string]any) areadomain.Link {
+	return areadomain.Link{
+		ID:   uuid.New(),
+		Role: areadomain.LinkRoleReaction,
+		Config: componentdomain.Config{
+			Params: params,
+			Component: &componentdomain.Component{
+				Name:     sendMessageComponentName,
+				Provider: componentdomain.Provider{Name: mailbox.ProviderName},
+			},
+		},
+	}
+}
+
+type identityRepoStub struct {
+	identity identitydomain.Identity
+}
+
+func (s *identityRepoStub) Create(context.Context, identitydomain.Identity) (identitydomain.Identity, error) {
+	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) Update(context.Context, identitydomain.Identity) error {
+	return fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) FindByID(_ context.Context, id uuid.UUID) (identitydomain.Identity, error) {
+	if id != s.identity.ID {
+		return identitydomain.Identity{}, fmt.Errorf("identity not found")
+	}
+	return s.identity, nil
+}
+
+func (s *identityRepoStub) FindByUserAndProvider(context.Context, uuid.UUID, string) (identitydomain.Identity, error) {
+	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) FindByProviderSubject(context.Context, string, string) (identitydomain.Identity, error) {
+	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) ListByUser(context.Context, uuid.UUID) ([]identitydomain.Identity, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) Delete(context.Context, uuid.UUID) error {
+	return fmt.Errorf("not implemented")
+}
@@ -0,0 +1,195 @@
+// Package filesource opens the content of files uploaded by storage reactions
+// Content is either downloaded from a URL or taken from text in the params or the triggering event,
+// and is always exposed as a stream so large downloads are piped to the upload without buffering
+package filesource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/security/netguard"
+)
+
+// downloadTimeout bounds the download of a source, large files are streamed for up to this long
+const downloadTimeout = 10 * time.Minute
+
+// Doer models the subset of http.Client used to download sources
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// NewClient returns the client downloading sources, it refuses to reach loopback, private and link-local addresses
+// Sources are user supplied URLs so they must never be fetched with a provider client
+func NewClient() Doer {
+	return netguard.Client(downloadTimeout)
+}
+
+// Spec describes where the content of a file comes from
+type Spec struct {
+	URL        string
+	Text       string
+	EventField string
+}
+
+// Content is an opened source, Size is -1 when the length is unknown
+type Content struct {
+	Body        io.ReadCloser
+	Size        int64
+	ContentType string
+	Name        string
+}
+
+// ParseSpec reads the sourceUrl, content and contentField params, exactly one of them must be set
+func ParseSpec(params map[string]any) (Spec, error) {
+	spec := Spec{
+		URL:        stringParam(params, "sourceUrl"),
+		Text:       rawStringParam(params, "content"),
+		EventField: stringParam(params, "contentField"),
+	}
+	set := 0
+	for _, value := range []string{spec.URL, spec.Text, spec.EventField} {
+		if strings.TrimSpace(value) != "" {
+			set++
+		}
+	}
+	switch {
+	case set == 0:
+		return Spec{}, fmt.Errorf("one of sourceUrl, content or contentField is required")
+	case set > 1:
+		return Spec{}, fmt.Errorf("sourceUrl, content and contentField are mutually exclusive")
+	}
+	if spec.URL != "" {
+		parsed, err := url.Parse(spec.URL)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return Spec{}, fmt.Errorf("sourceUrl must be an absolute http or https URL")
+		}
+		if err := netguard.CheckHost(parsed.Hostname()); err != nil {
+			return Spec{}, fmt.Errorf("sourceUrl host not allowed: %w", err)
+		}
+	}
+	return spec, nil
+}
+
+// Describe returns a short label of the source suitable for logs and reaction results
+func (s Spec) Describe() string {
+	switch {
+	case s.URL != "":
+		return s.URL
+	case s.EventField != "":
+		return "event." + s.EventField
+	default:
+		return "content"
+	}
+}
+
+// Open starts reading the source, the caller must close the returned body
+func (s Spec) Open(ctx context.Context, client Doer, event map[string]any) (*Content, error) {
+	switch {
+	case s.URL != "":
+		return download(ctx, client, s.URL)
+	case s.EventField != "":
+		value, ok := lookup(event, s.EventField)
+		if !ok {
+			return nil, fmt.Errorf("field %q not found in triggering event", s.EventField)
+		}
+		text, contentType, err := encodeValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", s.EventField, err)
+		}
+		return textContent(text, contentType), nil
+	default:
+		return textContent(s.Text, "text/plain; charset=utf-8"), nil
+	}
+}
+
+func download(ctx context.Context, client Doer, source string) (*Content, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, source, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build download request: %w", err)
+	}
+	req.Header.Set("User-Agent", "AREA-Server")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("download source: %w", err)
+	}
+	if resp.StatusCode >= 400 {
+		snippet, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		_ = resp.Body.Close()
+		return nil, fmt.Errorf("download source: received status %d: %s", resp.StatusCode, strings.TrimSpace(string(snippet)))
+	}
+
+	content := &Content{
+		Body:        resp.Body,
+		Size:        resp.ContentLength,
+		ContentType: resp.Header.Get("Content-Type"),
+		Name:        path.Base(req.URL.Path),
+	}
+	if content.Name == "/" || content.Name == "." {
+		content.Name = ""
+	}
+	if _, params, err := mime.ParseMediaType(resp.Header.Get("Content-Disposition")); err == nil && params["filename"] != "" {
+		content.Name = params["filename"]
+	}
+	if content.ContentType == "" {
+		content.ContentType = "application/octet-stream"
+	}
+	return content, nil
+}
+
+func textContent(text string, contentType string) *Content {
+	return &Content{
+		Body:        io.NopCloser(strings.NewReader(text)),
+		Size:        int64(len(text)),
+		ContentType: contentType,
+	}
+}
+
+// lookup resolves a dotted path such as attachment.text inside the event payload
+func lookup(event map[string]any, field string) (any, bool) {
+	var current any = event
+	for _, segment := range strings.Split(field, ".") {
+		node, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if current, ok = node[segment]; !ok {
+			return nil, false
+		}
+	}
+	return current, current != nil
+}
+
+func encodeValue(value any) (string, string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, "text/plain; charset=utf-8", nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), "text/plain; charset=utf-8", nil
+	case bool:
+		return strconv.FormatBool(v), "text/plain; charset=utf-8", nil
+	default:
+		encoded, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return "", "", fmt.Errorf("encode value: %w", err)
+		}
+		return string(encoded), "application/json", nil
+	}
+}
+
+func stringParam(params map[string]any, key string) string {
+	return strings.TrimSpace(rawStringParam(params, key))
+}
+
+func rawStringParam(params map[string]any, key string) string {
+	value, _ := params[key].(string)
+	return value
+}
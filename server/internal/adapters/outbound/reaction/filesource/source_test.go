@@ -0,0 +1,116 @@
+package filesource
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/security/netguard"
+)
+
+type doerStub struct {
+	response *http.Response
+	request  *http.Request
+}
+
+func (d *doerStub) Do(req *http.Request) (*http.Response, error) {
+	d.request = req
+	return d.response, nil
+}
+
+func TestParseSpecRequiresExactlyOneSource(t *testing.T) {
+	if _, err := ParseSpec(map[string]any{}); err == nil {
+		t.Fatal("expected error without a source")
+	}
+	if _, err := ParseSpec(map[string]any{"content": "a", "contentField": "body"}); err == nil {
+		t.Fatal("expected error with two sources")
+	}
+	if _, err := ParseSpec(map[string]any{"sourceUrl": "file:///etc/passwd"}); err == nil {
+		t.Fatal("expected error for non http URL")
+	}
+	spec, err := ParseSpec(map[string]any{"content": "  keep spacing  "})
+	if err != nil {
+		t.Fatalf("ParseSpec returned error: %v", err)
+	}
+	if spec.Text != "  keep spacing  " {
+		t.Fatalf("expected text to be kept verbatim got %q", spec.Text)
+	}
+}
+
+func TestSourcesCannotReachInternalAddresses(t *testing.T) {
+	for _, source := range []string{"http://127.0.0.1:8080/admin", "http://localhost/", "http://10.0.0.4/", "http://169.254.169.254/latest/meta-data/"} {
+		if _, err := ParseSpec(map[string]any{"sourceUrl": source}); !errors.Is(err, netguard.ErrAddressBlocked) {
+			t.Fatalf("expected %s to be rejected, got %v", source, err)
+		}
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("expected the loopback server not to be reached")
+	}))
+	defer server.Close()
+	// A public name resolving to a loopback address is refused when the connection is dialed
+	spec := Spec{URL: server.URL}
+	if _, err := spec.Open(context.Background(), NewClient(), nil); !errors.Is(err, netguard.ErrAddressBlocked) {
+		t.Fatalf("expected the download to be refused, got %v", err)
+	}
+}
+
+func TestOpenStreamsDownloadWithDispositionName(t *testing.T) {
+	doer := &doerStub{response: &http.Response{
+		StatusCode:    http.StatusOK,
+		ContentLength: 5,
+		Header: http.Header{
+			"Content-Type":        {"application/pdf"},
+			"Content-Disposition": {`attachment; filename="report.pdf"`},
+		},
+		Body: io.NopCloser(strings.NewReader("%PDF-")),
+	}}
+	spec, err := ParseSpec(map[string]any{"sourceUrl": "https://files.example.com/download?id=1"})
+	if err != nil {
+		t.Fatalf("ParseSpec returned error: %v", err)
+	}
+
+	content, err := spec.Open(context.Background(), doer, nil)
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	defer content.Body.Close()
+
+	if content.Name != "report.pdf" || content.Size != 5 || content.ContentType != "application/pdf" {
+		t.Fatalf("unexpected content %+v", content)
+	}
+	if doer.request.URL.String() != "https://files.example.com/download?id=1" {
+		t.Fatalf("unexpected download request %s", doer.request.URL)
+	}
+}
+
+func TestOpenEncodesEventFields(t *testing.T) {
+	event := map[string]any{"issue": map[string]any{"labels": []any{"bug"}, "title": "Crash"}}
+
+	spec, _ := ParseSpec(map[string]any{"contentField": "issue.title"})
+	content, err := spec.Open(context.Background(), nil, event)
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	if body, _ := io.ReadAll(content.Body); string(body) != "Crash" || !strings.HasPrefix(content.ContentType, "text/plain") {
+		t.Fatalf("unexpected text content %q %s", body, content.ContentType)
+	}
+
+	spec, _ = ParseSpec(map[string]any{"contentField": "issue.labels"})
+	content, err = spec.Open(context.Background(), nil, event)
+	if err != nil {
+		t.Fatalf("Open returned error: %v", err)
+	}
+	if content.ContentType != "application/json" {
+		t.Fatalf("expected structured values to be encoded as JSON got %s", content.ContentType)
+	}
+
+	spec, _ = ParseSpec(map[string]any{"contentField": "issue.body"})
+	if _, err := spec.Open(context.Background(), nil, event); err == nil {
+		t.Fatal("expected error for missing event field")
+	}
+}
@@ -0,0 +1,289 @@
+package gdrive
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+)
+
+const (
+	driveAPIBaseURL    = "https://www.googleapis.com/drive/v3"
+	driveUploadBaseURL = "https://www.googleapis.com/upload/drive/v3"
+)
+
+// apiClient bundles the identity lookup and token refresh flow shared by Google Drive executors
+type apiClient struct {
+	name       string
+	identities identityport.Repository
+	providers  ProviderResolver
+	http       HTTPClient
+	clock      Clock
+}
+
+// apiCall describes a single Drive API request issued by a reaction
+// Streamed bodies are opened again when the call is replayed after a token refresh
+type apiCall struct {
+	method   string
+	endpoint string
+	payload  []byte
+	body     func() (io.ReadCloser, string, error)
+	summary  string
+}
+
+func newAPIClient(name string, identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock) apiClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return apiClient{name: name, identities: identities, providers: providers, http: client, clock: clock}
+}
+
+func (c apiClient) configured() bool {
+	return c.identities != nil && c.providers != nil
+}
+
+// resolveIdentity loads the identity bound to the reaction and ensures it carries a usable access token
+func (c apiClient) resolveIdentity(ctx context.Context, area areadomain.Area, identityID uuid.UUID) (identitydomain.Identity, string, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	return c.ensureAccessToken(ctx, identity, false)
+}
+
+func (c apiClient) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
+	now := c.now()
+	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
+		return identity, identity.AccessToken, nil
+	}
+
+	provider, ok := c.providers.Provider(gdriveProviderName)
+	if !ok {
+		return identity, "", fmt.Errorf("%s: provider %s not configured", c.name, gdriveProviderName)
+	}
+
+	exchange, err := provider.Refresh(ctx, identity)
+	if err != nil {
+		return identity, "", fmt.Errorf("%s: refresh token: %w", c.name, err)
+	}
+
+	refToken := exchange.Token.RefreshToken
+	if refToken == "" {
+		refToken = identity.RefreshToken
+	}
+	expiresAt := identity.ExpiresAt
+	if !exchange.Token.ExpiresAt.IsZero() {
+		expires := exchange.Token.ExpiresAt.UTC()
+		expiresAt = &expires
+	}
+	scopes := exchange.Token.Scope
+	if len(scopes) == 0 {
+		scopes = identity.Scopes
+	}
+
+	updated := identity.WithTokens(exchange.Token.AccessToken, refToken, expiresAt, scopes)
+	updated.UpdatedAt = now
+	if err := c.identities.Update(ctx, updated); err != nil {
+		return identity, "", fmt.Errorf("%s: update identity: %w", c.name, err)
+	}
+	return updated, updated.AccessToken, nil
+}
+
+// session threads the identity and access token across the Drive calls issued by a single reaction
+type session struct {
+	api         apiClient
+	identity    identitydomain.Identity
+	accessToken string
+	result      outbound.ReactionResult
+}
+
+// call sends the request, refreshing the access token once when Google rejects the credentials
+func (s *session) call(ctx context.Context, call apiCall) (map[string]any, error) {
+	result, body, unauthorized, err := s.api.send(ctx, s.accessToken, call)
+	if err != nil && unauthorized {
+		s.identity, s.accessToken, err = s.api.ensureAccessToken(ctx, s.identity, true)
+		if err != nil {
+			return nil, err
+		}
+		result, body, unauthorized, err = s.api.send(ctx, s.accessToken, call)
+		if err != nil && unauthorized {
+			s.result = result
+			return body, fmt.Errorf("%s: unauthorized after refresh", s.api.name)
+		}
+	}
+	s.result = result
+	return body, err
+}
+
+// download issues an authenticated GET and hands the response body back unread so it can be streamed elsewhere
+// The caller must close the returned body
+func (s *session) download(ctx context.Context, endpoint string) (io.ReadCloser, string, error) {
+	resp, err := s.api.get(ctx, s.accessToken, endpoint)
+	if err == nil && resp.StatusCode == http.StatusUnauthorized {
+		_ = resp.Body.Close()
+		s.identity, s.accessToken, err = s.api.ensureAccessToken(ctx, s.identity, true)
+		if err != nil {
+			return nil, "", err
+		}
+		resp, err = s.api.get(ctx, s.accessToken, endpoint)
+	}
+	if err != nil {
+		return nil, "", err
+	}
+	if resp.StatusCode >= 400 {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, "", fmt.Errorf("%s: api error %d: %s", s.api.name, resp.StatusCode, apiErrorMessage(body))
+	}
+	return resp.Body, resp.Header.Get("Content-Type"), nil
+}
+
+func (c apiClient) get(ctx context.Context, accessToken string, endpoint string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%s: build request: %w", c.name, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("User-Agent", "AREA-Server")
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: request failed: %w", c.name, err)
+	}
+	return resp, nil
+}
+
+// preview describes the request the call would issue with the identity without sending it
+// The identity is only checked, its token is never refreshed
+func (c apiClient) preview(ctx context.Context, area areadomain.Area, identityID uuid.UUID, call apiCall) (outbound.ReactionResult, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	return outbound.ReactionResult{
+		Endpoint: call.endpoint,
+		Request: map[string]any{
+			"method": call.method,
+			"url":    call.endpoint,
+			"body":   string(call.payload),
+		},
+	}, nil
+}
+
+func (c apiClient) send(ctx context.Context, accessToken string, call apiCall) (outbound.ReactionResult, map[string]any, bool, error) {
+	var (
+		body        io.Reader
+		contentType string
+	)
+	switch {
+	case call.body != nil:
+		stream, streamType, err := call.body()
+		if err != nil {
+			return outbound.ReactionResult{}, nil, false, fmt.Errorf("%s: open upload body: %w", c.name, err)
+		}
+		defer stream.Close()
+		body, contentType = stream, streamType
+	case call.payload != nil:
+		body, contentType = bytes.NewReader(call.payload), "application/json"
+	}
+
+	req, err := http.NewRequestWithContext(ctx, call.method, call.endpoint, body)
+	if err != nil {
+		return outbound.ReactionResult{}, nil, false, fmt.Errorf("%s: build request: %w", c.name, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "AREA-Server")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	start := c.now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return outbound.ReactionResult{}, nil, false, fmt.Errorf("%s: request failed: %w", c.name, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	duration := c.now().Sub(start)
+
+	requestBody := string(call.payload)
+	if call.body != nil {
+		requestBody = call.summary
+	}
+	result := outbound.ReactionResult{
+		Endpoint: call.endpoint,
+		Request: map[string]any{
+			"method": call.method,
+			"url":    call.endpoint,
+			"body":   requestBody,
+		},
+		Response: map[string]any{
+			"body":    strings.TrimSpace(string(respBody)),
+			"headers": copyHeaders(resp.Header),
+		},
+		StatusCode: &resp.StatusCode,
+		Duration:   duration,
+	}
+
+	var decoded map[string]any
+	if len(respBody) > 0 {
+		_ = json.Unmarshal(respBody, &decoded)
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return result, decoded, true, fmt.Errorf("%s: unauthorized: %s", c.name, apiErrorMessage(respBody))
+	case resp.StatusCode >= 400:
+		return result, decoded, false, fmt.Errorf("%s: api error %d: %s", c.name, resp.StatusCode, apiErrorMessage(respBody))
+	default:
+		return result, decoded, false, nil
+	}
+}
+
+func (c apiClient) now() time.Time {
+	if c.clock == nil {
+		return time.Now().UTC()
+	}
+	return c.clock.Now().UTC()
+}
+
+// apiErrorMessage extracts the message Google wraps as {"error":{"code":404,"message":"..."}}
+func apiErrorMessage(body []byte) string {
+	var envelope struct {
+		Error struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(body, &envelope); err == nil && strings.TrimSpace(envelope.Error.Message) != "" {
+		return strings.TrimSpace(envelope.Error.Message)
+	}
+	return strings.TrimSpace(string(body))
+}
+
+func copyHeaders(headers http.Header) map[string][]string {
+	copied := make(map[string][]string, len(headers))
+	for key, values := range headers {
+		copied[key] = append([]string(nil), values...)
+	}
+	return copied
+}
@@ -13,7 +13,6 @@ import (
 
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
-	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
 	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
@@ -42,25 +41,16 @@ type Clock interface {
 
 // Executor delivers Google Drive reactions on behalf of the user through OAuth tokens
 type Executor struct {
-	identities identityport.Repository
-	providers  ProviderResolver
-	http       HTTPClient
-	clock      Clock
-	logger     *zap.Logger
+	api    apiClient
+	logger *zap.Logger
 }
 
 // NewExecutor constructs a Google Drive executor from its dependencies
 func NewExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *Executor {
-	if client == nil {
-		client = http.DefaultClient
-	}
-	if clock == nil {
-		clock = systemClock{}
-	}
 	if logger == nil {
 		logger = zap.NewNop()
 	}
-	return &Executor{identities: identities, providers: providers, http: client, clock: clock, logger: logger}
+	return &Executor{api: newAPIClient("gdrive.Executor", identities, providers, client, clock), logger: logger}
 }
 
 // Supports reports whether the executor can handle the provided component
@@ -79,7 +69,7 @@ func (e *Executor) Execute(ctx context.Context, area areadomain.Area, link aread
 	if !e.Supports(link.Config.Component) {
 		return outbound.ReactionResult{}, fmt.Errorf("gdrive.Executor: unsupported component")
 	}
-	if e.identities == nil || e.providers == nil {
+	if !e.api.configured() {
 		return outbound.ReactionResult{}, fmt.Errorf("gdrive.Executor: resolver not configured")
 	}
 
@@ -88,20 +78,12 @@ func (e *Executor) Execute(ctx context.Context, area areadomain.Area, link aread
 		return outbound.ReactionResult{}, fmt.Errorf("gdrive.Executor: %w", err)
 	}
 
-	identity, err := e.identities.FindByID(ctx, cfg.identityID)
-	if err != nil {
-		return outbound.ReactionResult{}, fmt.Errorf("gdrive.Executor: identity lookup: %w", err)
-	}
-	if identity.UserID != area.UserID {
-		return outbound.ReactionResult{}, fmt.Errorf("gdrive.Executor: identity not owned by user")
-	}
-
-	identity, accessToken, err := e.ensureAccessToken(ctx, identity, false)
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, cfg.identityID)
 	if err != nil {
 		return outbound.ReactionResult{}, err
 	}
 
-	endpoint := fmt.Sprintf("https://www.googleapis.com/drive/v3/files/%s", cfg.fileID)
+	endpoint := fmt.Sprintf("%s/files/%s", driveAPIBaseURL, cfg.fileID)
 
 	currentFile, result, unauthorized, err := e.getFile(ctx, endpoint, accessToken, cfg.fileID)
 	if err != nil {
@@ -128,7 +110,7 @@ func (e *Executor) Execute(ctx context.Context, area areadomain.Area, link aread
 
 	result, unauthorized, err = e.moveFileWithParents(ctx, endpoint, accessToken, cfg.destinationFolderId, currentParents, requestInfo)
 	if err != nil && unauthorized {
-		identity, accessToken, err = e.ensureAccessToken(ctx, identity, true)
+		identity, accessToken, err = e.api.ensureAccessToken(ctx, identity, true)
 		if err != nil {
 			return outbound.ReactionResult{}, err
 		}
@@ -149,44 +131,6 @@ func (e *Executor) Execute(ctx context.Context, area areadomain.Area, link aread
 	return result, nil
 }
 
-func (e *Executor) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
-	now := e.now()
-	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
-		return identity, identity.AccessToken, nil
-	}
-
-	provider, ok := e.providers.Provider(gdriveProviderName)
-	if !ok {
-		return identity, "", fmt.Errorf("gdrive.Executor: provider %s not configured", gdriveProviderName)
-	}
-
-	exchange, err := provider.Refresh(ctx, identity)
-	if err != nil {
-		return identity, "", fmt.Errorf("gdrive.Executor: refresh token: %w", err)
-	}
-
-	refToken := exchange.Token.RefreshToken
-	if refToken == "" {
-		refToken = identity.RefreshToken
-	}
-	expiresAt := identity.ExpiresAt
-	if !exchange.Token.ExpiresAt.IsZero() {
-		expires := exchange.Token.ExpiresAt.UTC()
-		expiresAt = &expires
-	}
-	scopes := exchange.Token.Scope
-	if len(scopes) == 0 {
-		scopes = identity.Scopes
-	}
-
-	updated := identity.WithTokens(exchange.Token.AccessToken, refToken, expiresAt, scopes)
-	updated.UpdatedAt = now
-	if err := e.identities.Update(ctx, updated); err != nil {
-		return identity, "", fmt.Errorf("gdrive.Executor: update identity: %w", err)
-	}
-	return updated, updated.AccessToken, nil
-}
-
 func (e *Executor) getFile(ctx context.Context, endpoint string, accessToken string, fileID string) (map[string]any, outbound.ReactionResult, bool, error) {
 	getEndpoint := fmt.Sprintf("%s?fields=parents", endpoint)
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, getEndpoint, nil)
@@ -196,7 +140,7 @@ func (e *Executor) getFile(ctx context.Context, endpoint string, accessToken str
 	req.Header.Set("Authorization", "Bearer "+accessToken)
 
 	start := time.Now()
-	resp, err := e.http.Do(req)
+	resp, err := e.api.http.Do(req)
 	if err != nil {
 		return nil, outbound.ReactionResult{}, false, fmt.Errorf("gdrive.Executor: get request failed: %w", err)
 	}
@@ -255,7 +199,7 @@ func (e *Executor) moveFileWithParents(ctx context.Context, endpoint string, acc
 	req.Header.Set("Content-Type", "application/json")
 
 	start := time.Now()
-	resp, err := e.http.Do(req)
+	resp, err := e.api.http.Do(req)
 	if err != nil {
 		return outbound.ReactionResult{}, false, fmt.Errorf("gdrive.Executor: move request failed: %w", err)
 	}
@@ -290,13 +234,6 @@ func (e *Executor) moveFileWithParents(ctx context.Context, endpoint string, acc
 	}
 }
 
-func (e *Executor) now() time.Time {
-	if e.clock == nil {
-		return time.Now().UTC()
-	}
-	return e.clock.Now().UTC()
-}
-
 func cloneMap(source map[string]any) map[string]any {
 	if len(source) == 0 {
 		return map[string]any{}
@@ -0,0 +1,497 @@
+package gdrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"strings"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/filesource"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	uploadFileComponentName = "gdrive_upload_file"
+	copyFileComponentName   = "gdrive_copy_file"
+	shareFileComponentName  = "gdrive_share_file"
+	exportPDFComponentName  = "gdrive_export_pdf"
+	driveFileFields         = "id,name,mimeType,webViewLink"
+	googleAppsMimePrefix    = "application/vnd.google-apps."
+)
+
+// fileInput gathers what an operation needs to plan its Drive calls
+// The event carries the payload of the action that triggered the reaction when available
+type fileInput struct {
+	params  map[string]any
+	event   map[string]any
+	sources filesource.Doer
+}
+
+// filePlan describes the target of a Drive reaction and the calls that perform it
+// call is set when the plan issues a single request known upfront, which makes it previewable
+type filePlan struct {
+	identityID uuid.UUID
+	target     string
+	call       *apiCall
+	run        func(ctx context.Context, sess *session) error
+}
+
+type fileOperation func(input fileInput) (filePlan, error)
+
+var fileOperations = map[string]fileOperation{
+	uploadFileComponentName: planUploadFile,
+	copyFileComponentName:   planCopyFile,
+	shareFileComponentName:  planShareFile,
+	exportPDFComponentName:  planExportPDF,
+}
+
+// FileExecutor delivers Google Drive reactions that upload, copy, share and export files
+type FileExecutor struct {
+	api     apiClient
+	sources filesource.Doer
+	logger  *zap.Logger
+}
+
+// NewFileExecutor constructs a FileExecutor from its dependencies
+func NewFileExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *FileExecutor {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &FileExecutor{
+		api:     newAPIClient("gdrive.FileExecutor", identities, providers, client, clock),
+		sources: filesource.NewClient(),
+		logger:  logger,
+	}
+}
+
+// Supports reports whether the executor can handle the provided component
+func (e *FileExecutor) Supports(component *componentdomain.Component) bool {
+	if component == nil || !strings.EqualFold(component.Provider.Name, gdriveProviderName) {
+		return false
+	}
+	_, ok := fileOperations[strings.ToLower(component.Name)]
+	return ok
+}
+
+// Execute performs the Drive file operation selected by the component using the linked identity
+func (e *FileExecutor) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("gdrive.FileExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("gdrive.FileExecutor: resolver not configured")
+	}
+
+	component := link.Config.Component
+	event, _ := outbound.TriggerEvent(ctx)
+	plan, err := fileOperations[strings.ToLower(component.Name)](fileInput{
+		params:  link.Config.Params,
+		event:   event,
+		sources: e.sources,
+	})
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("gdrive.FileExecutor: %w", err)
+	}
+
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, plan.identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+
+	sess := &session{api: e.api, identity: identity, accessToken: accessToken}
+	if err := plan.run(ctx, sess); err != nil {
+		return sess.result, err
+	}
+
+	e.logger.Info("gdrive reaction delivered",
+		zap.String("area_id", area.ID.String()),
+		zap.String("identity_id", sess.identity.ID.String()),
+		zap.String("component", component.Name),
+		zap.String("target", plan.target),
+	)
+	return sess.result, nil
+}
+
+// Preview describes the request of the Drive file operation without sending it
+// Uploads, shares and exports chain several requests and cannot be previewed
+func (e *FileExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("gdrive.FileExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("gdrive.FileExecutor: resolver not configured")
+	}
+	event, _ := outbound.TriggerEvent(ctx)
+	plan, err := fileOperations[strings.ToLower(link.Config.Component.Name)](fileInput{
+		params:  link.Config.Params,
+		event:   event,
+		sources: e.sources,
+	})
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("gdrive.FileExecutor: %w", err)
+	}
+	if plan.call == nil {
+		return outbound.ReactionResult{}, outbound.ErrPreviewUnsupported
+	}
+	return e.api.preview(ctx, area, plan.identityID, *plan.call)
+}
+
+// planUploadFile creates a Drive file from the content of a URL, a text or an event field
+func planUploadFile(input fileInput) (filePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return filePlan{}, err
+	}
+	spec, err := filesource.ParseSpec(input.params)
+	if err != nil {
+		return filePlan{}, fmt.Errorf("parse file config: %w", err)
+	}
+	name := optionalString(input.params, "name")
+	folderID := optionalString(input.params, "folderId")
+	mimeType := optionalString(input.params, "mimeType")
+
+	return filePlan{
+		identityID: identityID,
+		target:     spec.Describe(),
+		run: func(ctx context.Context, sess *session) error {
+			content, err := spec.Open(ctx, input.sources, input.event)
+			if err != nil {
+				return fmt.Errorf("%s: open %s: %w", sess.api.name, spec.Describe(), err)
+			}
+			defer content.Body.Close()
+
+			fileName := name
+			if fileName == "" {
+				fileName = content.Name
+			}
+			if fileName == "" {
+				return fmt.Errorf("%s: name is required when the source has no file name", sess.api.name)
+			}
+			metadata := map[string]any{"name": fileName}
+			if folderID != "" {
+				metadata["parents"] = []string{folderID}
+			}
+
+			opened := content
+			created, err := uploadMultipart(ctx, sess, metadata, func() (io.ReadCloser, string, error) {
+				if opened != nil {
+					body, contentType := opened.Body, opened.ContentType
+					opened = nil
+					return body, withDefault(mimeType, contentType), nil
+				}
+				reopened, err := spec.Open(ctx, input.sources, input.event)
+				if err != nil {
+					return nil, "", err
+				}
+				return reopened.Body, withDefault(mimeType, reopened.ContentType), nil
+			})
+			if err != nil {
+				return err
+			}
+			exposeLink(sess, "fileLink", created)
+			return nil
+		},
+	}, nil
+}
+
+// planCopyFile duplicates a Drive file, optionally renaming it or placing the copy in another folder
+func planCopyFile(input fileInput) (filePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return filePlan{}, err
+	}
+	fileID, err := requiredString(input.params, "fileId")
+	if err != nil {
+		return filePlan{}, err
+	}
+	metadata := map[string]any{}
+	if name := optionalString(input.params, "name"); name != "" {
+		metadata["name"] = name
+	}
+	if folderID := optionalString(input.params, "destinationFolderId"); folderID != "" {
+		metadata["parents"] = []string{folderID}
+	}
+	payload, err := json.Marshal(metadata)
+	if err != nil {
+		return filePlan{}, fmt.Errorf("marshal payload: %w", err)
+	}
+	endpoint := fmt.Sprintf("%s/files/%s/copy?%s", driveAPIBaseURL, url.PathEscape(fileID), fileQuery().Encode())
+
+	call := apiCall{method: http.MethodPost, endpoint: endpoint, payload: payload}
+	return filePlan{
+		identityID: identityID,
+		target:     fileID,
+		call:       &call,
+		run: func(ctx context.Context, sess *session) error {
+			copied, err := sess.call(ctx, call)
+			if err != nil {
+				return err
+			}
+			exposeLink(sess, "fileLink", copied)
+			return nil
+		},
+	}, nil
+}
+
+// planShareFile grants a permission on a Drive file and returns its link
+// Without an email address the file is shared with anyone holding the link
+func planShareFile(input fileInput) (filePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return filePlan{}, err
+	}
+	fileID, err := requiredString(input.params, "fileId")
+	if err != nil {
+		return filePlan{}, err
+	}
+	role := strings.ToLower(withDefault(optionalString(input.params, "role"), "reader"))
+	switch role {
+	case "reader", "commenter", "writer":
+	default:
+		return filePlan{}, fmt.Errorf("role must be reader, commenter or writer")
+	}
+	permission := map[string]any{"role": role, "type": "anyone"}
+	query := url.Values{"supportsAllDrives": {"true"}}
+	if email := optionalString(input.params, "emailAddress"); email != "" {
+		permission["type"] = "user"
+		permission["emailAddress"] = email
+		notify, err := optionalBool(input.params, "notify", true)
+		if err != nil {
+			return filePlan{}, fmt.Errorf("notify invalid: %w", err)
+		}
+		query.Set("sendNotificationEmail", fmt.Sprintf("%t", notify))
+	}
+	payload, err := json.Marshal(permission)
+	if err != nil {
+		return filePlan{}, fmt.Errorf("marshal payload: %w", err)
+	}
+	fileEndpoint := fmt.Sprintf("%s/files/%s", driveAPIBaseURL, url.PathEscape(fileID))
+
+	return filePlan{
+		identityID: identityID,
+		target:     fileID,
+		run: func(ctx context.Context, sess *session) error {
+			if _, err := sess.call(ctx, apiCall{method: http.MethodPost, endpoint: fileEndpoint + "/permissions?" + query.Encode(), payload: payload}); err != nil {
+				return err
+			}
+			file, err := sess.call(ctx, apiCall{method: http.MethodGet, endpoint: fileEndpoint + "?" + fileQuery().Encode()})
+			if err != nil {
+				return err
+			}
+			if link, _ := file["webViewLink"].(string); link == "" {
+				return fmt.Errorf("%s: webViewLink missing from response", sess.api.name)
+			}
+			exposeLink(sess, "sharedLink", file)
+			return nil
+		},
+	}, nil
+}
+
+// planExportPDF converts a Google Docs editor file to PDF and stores the result in Drive
+// The export is streamed into the upload, by default next to the original file
+func planExportPDF(input fileInput) (filePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return filePlan{}, err
+	}
+	fileID, err := requiredString(input.params, "fileId")
+	if err != nil {
+		return filePlan{}, err
+	}
+	name := optionalString(input.params, "name")
+	folderID := optionalString(input.params, "folderId")
+	fileEndpoint := fmt.Sprintf("%s/files/%s", driveAPIBaseURL, url.PathEscape(fileID))
+	exportEndpoint := fileEndpoint + "/export?" + url.Values{"mimeType": {"application/pdf"}}.Encode()
+
+	return filePlan{
+		identityID: identityID,
+		target:     fileID,
+		run: func(ctx context.Context, sess *session) error {
+			source, err := sess.call(ctx, apiCall{
+				method:   http.MethodGet,
+				endpoint: fileEndpoint + "?" + url.Values{"fields": {"id,name,mimeType,parents"}, "supportsAllDrives": {"true"}}.Encode(),
+			})
+			if err != nil {
+				return err
+			}
+			if mimeType, _ := source["mimeType"].(string); !strings.HasPrefix(mimeType, googleAppsMimePrefix) {
+				return fmt.Errorf("%s: file %s is %q, only Google Docs editor files can be exported", sess.api.name, fileID, mimeType)
+			}
+
+			fileName := name
+			if fileName == "" {
+				original, _ := source["name"].(string)
+				fileName = original + ".pdf"
+			}
+			metadata := map[string]any{"name": fileName}
+			if folderID != "" {
+				metadata["parents"] = []string{folderID}
+			} else if parents, ok := source["parents"].([]any); ok && len(parents) > 0 {
+				metadata["parents"] = parents
+			}
+
+			exported, _, err := sess.download(ctx, exportEndpoint)
+			if err != nil {
+				return err
+			}
+			defer exported.Close()
+
+			created, err := uploadMultipart(ctx, sess, metadata, func() (io.ReadCloser, string, error) {
+				if exported != nil {
+					body := exported
+					exported = nil
+					return body, "application/pdf", nil
+				}
+				body, _, err := sess.download(ctx, exportEndpoint)
+				return body, "application/pdf", err
+			})
+			if err != nil {
+				return err
+			}
+			exposeLink(sess, "fileLink", created)
+			return nil
+		},
+	}, nil
+}
+
+// uploadMultipart creates a file with a multipart upload whose media part is piped from the source
+// Only the metadata is held in memory, the media is copied to the request as it is sent
+func uploadMultipart(ctx context.Context, sess *session, metadata map[string]any, media func() (io.ReadCloser, string, error)) (map[string]any, error) {
+	encoded, err := json.Marshal(metadata)
+	if err != nil {
+		return nil, fmt.Errorf("%s: marshal metadata: %w", sess.api.name, err)
+	}
+	query := fileQuery()
+	query.Set("uploadType", "multipart")
+
+	return sess.call(ctx, apiCall{
+		method:   http.MethodPost,
+		endpoint: driveUploadBaseURL + "/files?" + query.Encode(),
+		summary:  string(encoded),
+		body: func() (io.ReadCloser, string, error) {
+			source, mediaType, err := media()
+			if err != nil {
+				return nil, "", err
+			}
+			reader, writer := io.Pipe()
+			form := multipart.NewWriter(writer)
+			go func() {
+				defer source.Close()
+				writer.CloseWithError(writeMultipart(form, encoded, source, mediaType))
+			}()
+			return reader, "multipart/related; boundary=" + form.Boundary(), nil
+		},
+	})
+}
+
+func writeMultipart(form *multipart.Writer, metadata []byte, media io.Reader, mediaType string) error {
+	part, err := form.CreatePart(textproto.MIMEHeader{"Content-Type": {"application/json; charset=UTF-8"}})
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(metadata); err != nil {
+		return err
+	}
+	part, err = form.CreatePart(textproto.MIMEHeader{"Content-Type": {withDefault(mediaType, "application/octet-stream")}})
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(part, media); err != nil {
+		return err
+	}
+	return form.Close()
+}
+
+// exposeLink copies the webViewLink of a Drive file into the reaction response under key
+func exposeLink(sess *session, key string, file map[string]any) {
+	if link, _ := file["webViewLink"].(string); link != "" && sess.result.Response != nil {
+		sess.result.Response[key] = link
+	}
+}
+
+func fileQuery() url.Values {
+	return url.Values{"fields": {driveFileFields}, "supportsAllDrives": {"true"}}
+}
+
+func parseIdentityID(params map[string]any) (uuid.UUID, error) {
+	raw, ok := params["identityId"]
+	if !ok {
+		return uuid.Nil, fmt.Errorf("identityId missing")
+	}
+	value, err := toString(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("identityId invalid")
+	}
+	identityID, err := uuid.Parse(strings.TrimSpace(value))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("identityId parse: %w", err)
+	}
+	return identityID, nil
+}
+
+func requiredString(params map[string]any, key string) (string, error) {
+	raw, ok := params[key]
+	if !ok {
+		return "", fmt.Errorf("%s missing", key)
+	}
+	value, err := toString(raw)
+	if err != nil {
+		return "", fmt.Errorf("%s invalid", key)
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("%s cannot be empty", key)
+	}
+	return value, nil
+}
+
+func optionalString(params map[string]any, key string) string {
+	value, err := toString(params[key])
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(value)
+}
+
+func optionalBool(params map[string]any, key string, defaultValue bool) (bool, error) {
+	switch v := params[key].(type) {
+	case nil:
+		return defaultValue, nil
+	case bool:
+		return v, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "":
+			return defaultValue, nil
+		case "true", "1", "yes":
+			return true, nil
+		case "false", "0", "no":
+			return false, nil
+		}
+		return false, fmt.Errorf("unexpected string %q", v)
+	default:
+		return false, fmt.Errorf("unexpected type %T", v)
+	}
+}
+
+func withDefault(value string, fallback string) string {
+	if value != "" {
+		return value
+	}
+	return fallback
+}
+
+var _ interface {
+	Supports(*componentdomain.Component) bool
+	Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error)
+} = (*FileExecutor)(nil)
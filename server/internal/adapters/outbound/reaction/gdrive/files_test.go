@@ -0,0 +1,258 @@
+package gdrive
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func TestFileExecutorSupports(t *testing.T) {
+	exec := NewFileExecutor(nil, nil, nil, nil, nil)
+	for name := range fileOperations {
+		if !exec.Supports(&componentdomain.Component{Name: name, Provider: componentdomain.Provider{Name: "Google"}}) {
+			t.Fatalf("expected %s to be supported", name)
+		}
+	}
+	if exec.Supports(&componentdomain.Component{Name: gdriveComponentName, Provider: componentdomain.Provider{Name: gdriveProviderName}}) {
+		t.Fatal("moving files belongs to Executor")
+	}
+}
+
+func TestFileExecutorUploadStreamsMultipartBody(t *testing.T) {
+	exec, client, area, link := fileFixture(t, uploadFileComponentName, map[string]any{
+		"contentField": "message.body",
+		"name":         "message.txt",
+		"folderId":     "folder-1",
+	}, driveJSON(http.StatusOK, `{"id":"file-1","webViewLink":"https://drive.google.com/file/d/file-1/view"}`))
+
+	ctx := outbound.WithTriggerEvent(context.Background(), map[string]any{"message": map[string]any{"body": "hello drive"}})
+	result, err := exec.Execute(ctx, area, link)
+	if err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if got := result.Response["fileLink"]; got != "https://drive.google.com/file/d/file-1/view" {
+		t.Fatalf("unexpected file link %v", got)
+	}
+
+	req := client.requests[0]
+	if !strings.HasPrefix(req.URL.String(), driveUploadBaseURL+"/files?") || req.URL.Query().Get("uploadType") != "multipart" {
+		t.Fatalf("unexpected upload endpoint %s", req.URL)
+	}
+	metadata, media := readMultipart(t, req.Header.Get("Content-Type"), client.bodies[0])
+	if metadata["name"] != "message.txt" {
+		t.Fatalf("unexpected metadata %v", metadata)
+	}
+	if parents, _ := metadata["parents"].([]any); len(parents) != 1 || parents[0] != "folder-1" {
+		t.Fatalf("unexpected parents %v", metadata["parents"])
+	}
+	if media != "hello drive" {
+		t.Fatalf("unexpected media %q", media)
+	}
+}
+
+func TestFileExecutorExportPDFNextToOriginal(t *testing.T) {
+	exec, client, area, link := fileFixture(t, exportPDFComponentName, map[string]any{"fileId": "doc-1"},
+		driveJSON(http.StatusOK, `{"id":"doc-1","name":"Minutes","mimeType":"application/vnd.google-apps.document","parents":["folder-9"]}`),
+		http.Response{StatusCode: http.StatusOK, Header: http.Header{"Content-Type": {"application/pdf"}}, Body: io.NopCloser(strings.NewReader("%PDF-1.7"))},
+		driveJSON(http.StatusOK, `{"id":"pdf-1","webViewLink":"https://drive.google.com/file/d/pdf-1/view"}`),
+	)
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if len(client.requests) != 3 {
+		t.Fatalf("expected metadata, export and upload requests got %d", len(client.requests))
+	}
+	if client.requests[1].URL.Query().Get("mimeType") != "application/pdf" {
+		t.Fatalf("unexpected export request %s", client.requests[1].URL)
+	}
+	metadata, media := readMultipart(t, client.requests[2].Header.Get("Content-Type"), client.bodies[2])
+	if metadata["name"] != "Minutes.pdf" {
+		t.Fatalf("unexpected exported name %v", metadata["name"])
+	}
+	if parents, _ := metadata["parents"].([]any); len(parents) != 1 || parents[0] != "folder-9" {
+		t.Fatalf("expected export next to original got %v", metadata["parents"])
+	}
+	if media != "%PDF-1.7" {
+		t.Fatalf("unexpected media %q", media)
+	}
+}
+
+func TestFileExecutorExportRejectsBinaryFiles(t *testing.T) {
+	exec, _, area, link := fileFixture(t, exportPDFComponentName, map[string]any{"fileId": "img-1"},
+		driveJSON(http.StatusOK, `{"id":"img-1","name":"photo.png","mimeType":"image/png"}`),
+	)
+	if _, err := exec.Execute(context.Background(), area, link); err == nil || !strings.Contains(err.Error(), "only Google Docs editor files") {
+		t.Fatalf("expected export rejection got %v", err)
+	}
+}
+
+func TestFileExecutorShareWithAnyone(t *testing.T) {
+	exec, client, area, link := fileFixture(t, shareFileComponentName, map[string]any{"fileId": "file-1"},
+		driveJSON(http.StatusOK, `{"id":"perm-1"}`),
+		driveJSON(http.StatusOK, `{"id":"file-1","webViewLink":"https://drive.google.com/file/d/file-1/view"}`),
+	)
+
+	result, err := exec.Execute(context.Background(), area, link)
+	if err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if got := result.Response["sharedLink"]; got != "https://drive.google.com/file/d/file-1/view" {
+		t.Fatalf("unexpected shared link %v", got)
+	}
+	if client.bodies[0] != `{"role":"reader","type":"anyone"}` {
+		t.Fatalf("unexpected permission payload %s", client.bodies[0])
+	}
+}
+
+func fileFixture(t *testing.T, componentName string, params map[string]any, responses ...http.Response) (*FileExecutor, *httpClientStub, areadomain.Area, areadomain.Link) {
+	t.Helper()
+	userID := uuid.New()
+	identityID := uuid.New()
+	now := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Hour)
+	repo := &identityRepoStub{identity: identitydomain.Identity{
+		ID:          identityID,
+		UserID:      userID,
+		Provider:    gdriveProviderName,
+		AccessToken: "access-token",
+		ExpiresAt:   &expires,
+	}}
+	client := &httpClientStub{responses: responses}
+	exec := NewFileExecutor(repo, providerResolverStub{}, client, clockStub{now: now}, zap.NewNop())
+	exec.sources = client
+
+	params["identityId"] = identityID.String()
+	link := areadomain.Link{
+		ID:   uuid.New(),
+		Role: areadomain.LinkRoleReaction,
+		Config: componentdomain.Config{
+			Params: params,
+			Component: &componentdomain.Component{
+				Name:     componentName,
+				Provider: componentdomain.Provider{Name: gdriveProviderName},
+			},
+		},
+	}
+	return exec, client, areadomain.Area{ID: uuid.New(), UserID: userID}, link
+}
+
+func readMultipart(t *testing.T, contentType string, body string) (map[string]any, string) {
+	t.Helper()
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil || mediaType != "multipart/related" {
+		t.Fatalf("unexpected content type %q", contentType)
+	}
+	reader := multipart.NewReader(strings.NewReader(body), params["boundary"])
+	part, err := reader.NextPart()
+	if err != nil {
+		t.Fatalf("read metadata part: %v", err)
+	}
+	var metadata map[string]any
+	if err := json.NewDecoder(part).Decode(&metadata); err != nil {
+		t.Fatalf("decode metadata: %v", err)
+	}
+	part, err = reader.NextPart()
+	if err != nil {
+		t.Fatalf("read media part: %v", err)
+	}
+	media, _ := io.ReadAll(part)
+	return metadata, string(media)
+}
+
+func driveJSON(status int, body string) http.Response {
+	return http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+// httpClientStub answers requests in order and drains their bodies like a real transport would
+type httpClientStub struct {
+	responses []http.Response
+	requests  []*http.Request
+	bodies    []string
+}
+
+func (c *httpClientStub) Do(req *http.Request) (*http.Response, error) {
+	c.requests = append(c.requests, req)
+	body := ""
+	if req.Body != nil {
+		raw, _ := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		body = string(raw)
+	}
+	c.bodies = append(c.bodies, body)
+	if len(c.responses) == 0 {
+		return nil, fmt.Errorf("unexpected request %s", req.URL)
+	}
+	resp := c.responses[0]
+	c.responses = c.responses[1:]
+	return &resp, nil
+}
+
+type identityRepoStub struct {
+	identity identitydomain.Identity
+}
+
+func (s *identityRepoStub) Create(context.Context, identitydomain.Identity) (identitydomain.Identity, error) {
+	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) Update(_ context.Context, identity identitydomain.Identity) error {
+	s.identity = identity
+	return nil
+}
+
+func (s *identityRepoStub) FindByID(_ context.Context, id uuid.UUID) (identitydomain.Identity, error) {
+	if id != s.identity.ID {
+		return identitydomain.Identity{}, fmt.Errorf("identity not found")
+	}
+	return s.identity, nil
+}
+
+func (s *identityRepoStub) FindByUserAndProvider(context.Context, uuid.UUID, string) (identitydomain.Identity, error) {
+	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) FindByProviderSubject(context.Context, string, string) (identitydomain.Identity, error) {
+	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) ListByUser(context.Context, uuid.UUID) ([]identitydomain.Identity, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) Delete(context.Context, uuid.UUID) error {
+	return fmt.Errorf("not implemented")
+}
+
+type providerResolverStub struct{}
+
+func (providerResolverStub) Provider(string) (identityport.Provider, bool) {
+	return nil, false
+}
+
+type clockStub struct {
+	now time.Time
+}
+
+func (c clockStub) Now() time.Time {
+	return c.now
+}
@@ -1,18 +1,15 @@
 package github
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 	"time"
 
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
-	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
 	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
@@ -22,7 +19,7 @@ import (
 const (
 	githubProviderName        = "github"
 	createIssueComponentName  = "github_create_issue"
-	githubCreateIssueEndpoint = "https://api.github.com/repos/%s/%s/issues"
+	githubCreateIssueEndpoint = githubAPIBaseURL + "/repos/%s/%s/issues"
 )
 
 // ProviderResolver exposes OAuth providers by name
@@ -48,30 +45,18 @@ func (systemClock) Now() time.Time {
 
 // IssueExecutor delivers GitHub reactions that create issues
 type IssueExecutor struct {
-	identities identityport.Repository
-	providers  ProviderResolver
-	http       HTTPClient
-	clock      Clock
-	logger     *zap.Logger
+	api    apiClient
+	logger *zap.Logger
 }
 
 // NewIssueExecutor constructs an IssueExecutor from its dependencies
 func NewIssueExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *IssueExecutor {
-	if client == nil {
-		client = http.DefaultClient
-	}
-	if clock == nil {
-		clock = systemClock{}
-	}
 	if logger == nil {
 		logger = zap.NewNop()
 	}
 	return &IssueExecutor{
-		identities: identities,
-		providers:  providers,
-		http:       client,
-		clock:      clock,
-		logger:     logger,
+		api:    newAPIClient("github.IssueExecutor", identities, providers, client, clock),
+		logger: logger,
 	}
 }
 
@@ -89,7 +74,7 @@ func (e *IssueExecutor) Execute(ctx context.Context, area areadomain.Area, link
 	if !e.Supports(link.Config.Component) {
 		return outbound.ReactionResult{}, fmt.Errorf("github.IssueExecutor: unsupported component")
 	}
-	if e.identities == nil || e.providers == nil {
+	if !e.api.configured() {
 		return outbound.ReactionResult{}, fmt.Errorf("github.IssueExecutor: resolver not configured")
 	}
 
@@ -98,33 +83,19 @@ func (e *IssueExecutor) Execute(ctx context.Context, area areadomain.Area, link
 		return outbound.ReactionResult{}, fmt.Errorf("github.IssueExecutor: %w", err)
 	}
 
-	identity, err := e.identities.FindByID(ctx, cfg.identityID)
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, cfg.identityID)
 	if err != nil {
-		return outbound.ReactionResult{}, fmt.Errorf("github.IssueExecutor: identity lookup: %w", err)
-	}
-	if identity.UserID != area.UserID {
-		return outbound.ReactionResult{}, fmt.Errorf("github.IssueExecutor: identity not owned by user")
+		return outbound.ReactionResult{}, err
 	}
 
-	identity, accessToken, err := e.ensureAccessToken(ctx, identity, false)
+	call, err := issueCall(cfg)
 	if err != nil {
 		return outbound.ReactionResult{}, err
 	}
-
-	result, unauthorized, err := e.createIssue(ctx, accessToken, cfg)
-	if err != nil && unauthorized {
-		identity, accessToken, err = e.ensureAccessToken(ctx, identity, true)
-		if err != nil {
-			return outbound.ReactionResult{}, err
-		}
-		result, unauthorized, err = e.createIssue(ctx, accessToken, cfg)
-	}
+	result, identity, _, err := e.api.deliver(ctx, identity, accessToken, call)
 	if err != nil {
 		return result, err
 	}
-	if unauthorized {
-		return result, fmt.Errorf("github.IssueExecutor: unauthorized after refresh")
-	}
 
 	e.logger.Info("github issue created",
 		zap.String("area_id", area.ID.String()),
@@ -134,9 +105,26 @@ func (e *IssueExecutor) Execute(ctx context.Context, area areadomain.Area, link
 	return result, nil
 }
 
-func (e *IssueExecutor) createIssue(ctx context.Context, accessToken string, cfg issueConfig) (outbound.ReactionResult, bool, error) {
-	endpoint := fmt.Sprintf(githubCreateIssueEndpoint, cfg.owner, cfg.repository)
+// Preview describes the issue creation request without sending it
+func (e *IssueExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("github.IssueExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("github.IssueExecutor: resolver not configured")
+	}
+	cfg, err := parseIssueConfig(link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("github.IssueExecutor: %w", err)
+	}
+	call, err := issueCall(cfg)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	return e.api.preview(ctx, area, cfg.identityID, call)
+}
 
+func issueCall(cfg issueConfig) (apiCall, error) {
 	payload := map[string]any{
 		"title": cfg.title,
 	}
@@ -149,102 +137,13 @@ func (e *IssueExecutor) createIssue(ctx context.Context, accessToken string, cfg
 
 	bodyBytes, err := json.Marshal(payload)
 	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("github.IssueExecutor: marshal payload: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("github.IssueExecutor: build request: %w", err)
-	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
-	req.Header.Set("Accept", "application/vnd.github+json")
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", "AREA-Server")
-
-	start := time.Now()
-	resp, err := e.http.Do(req)
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("github.IssueExecutor: request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := io.ReadAll(resp.Body)
-	duration := time.Since(start)
-
-	requestHeaders := copyHeaders(req.Header)
-	responseHeaders := copyHeaders(resp.Header)
-
-	result := outbound.ReactionResult{
-		Endpoint: endpoint,
-		Request: map[string]any{
-			"method":  http.MethodPost,
-			"url":     endpoint,
-			"headers": requestHeaders,
-			"body":    string(bodyBytes),
-		},
-		Response: map[string]any{
-			"body":    string(respBody),
-			"headers": responseHeaders,
-		},
-		StatusCode: &resp.StatusCode,
-		Duration:   duration,
-	}
-
-	if resp.StatusCode >= 400 {
-		if resp.StatusCode == http.StatusUnauthorized {
-			return result, true, fmt.Errorf("github.IssueExecutor: received status %d", resp.StatusCode)
-		}
-		return result, false, fmt.Errorf("github.IssueExecutor: received status %d", resp.StatusCode)
-	}
-
-	return result, false, nil
-}
-
-func (e *IssueExecutor) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
-	now := e.now()
-	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
-		return identity, identity.AccessToken, nil
-	}
-
-	provider, ok := e.providers.Provider(githubProviderName)
-	if !ok {
-		return identity, "", fmt.Errorf("github.IssueExecutor: provider %s not configured", githubProviderName)
-	}
-
-	exchange, err := provider.Refresh(ctx, identity)
-	if err != nil {
-		return identity, "", fmt.Errorf("github.IssueExecutor: refresh token: %w", err)
-	}
-
-	refreshToken := exchange.Token.RefreshToken
-	if refreshToken == "" {
-		refreshToken = identity.RefreshToken
-	}
-	expiresAt := identity.ExpiresAt
-	if !exchange.Token.ExpiresAt.IsZero() {
-		expires := exchange.Token.ExpiresAt.UTC()
-		expiresAt = &expires
-	}
-	scopes := exchange.Token.Scope
-	if len(scopes) == 0 {
-		scopes = identity.Scopes
-	}
-
-	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
-	updated.UpdatedAt = now
-
-	if err := e.identities.Update(ctx, updated); err != nil {
-		return identity, "", fmt.Errorf("github.IssueExecutor: update identity: %w", err)
-	}
-
-	return updated, updated.AccessToken, nil
-}
-
-func (e *IssueExecutor) now() time.Time {
-	if e.clock == nil {
-		return time.Now().UTC()
+		return apiCall{}, fmt.Errorf("github.IssueExecutor: marshal payload: %w", err)
 	}
-	return e.clock.Now().UTC()
+	return apiCall{
+		method:   http.MethodPost,
+		endpoint: fmt.Sprintf(githubCreateIssueEndpoint, cfg.owner, cfg.repository),
+		payload:  bodyBytes,
+	}, nil
 }
 
 type issueConfig struct {
@@ -313,16 +212,16 @@ func parseIssueConfig(params map[string]any) (issueConfig, error) {
 func requiredString(params map[string]any, key string) (string, error) {
 	value, ok := params[key]
 	if !ok {
-		return "", fmt.Errorf("parse issue config: %s missing", key)
+		return "", fmt.Errorf("parse config: %s missing", key)
 	}
 	str, err := toString(value)
 	if err != nil {
-		return "", fmt.Errorf("parse issue config: %s invalid: %w", key, err)
+		return "", fmt.Errorf("parse config: %s invalid: %w", key, err)
 	}
 	if trimmed := strings.TrimSpace(str); trimmed != "" {
 		return trimmed, nil
 	}
-	return "", fmt.Errorf("parse issue config: %s empty", key)
+	return "", fmt.Errorf("parse config: %s empty", key)
 }
 
 func parseLabels(value any) ([]string, error) {
@@ -2,6 +2,7 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
 	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
 	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -129,6 +131,60 @@ func TestIssueExecutorExecuteCreatesIssue(t *testing.T) {
 	}
 }
 
+func TestExecutorsPreviewWithoutSending(t *testing.T) {
+	userID := uuid.New()
+	identityID := uuid.New()
+	past := time.Now().Add(-time.Hour).UTC()
+	repo := &identityRepoStub{identity: identitydomain.Identity{
+		ID:          identityID,
+		UserID:      userID,
+		Provider:    githubProviderName,
+		AccessToken: "expired-token",
+		ExpiresAt:   &past,
+	}}
+	client := &httpClientStub{}
+	area := areadomain.Area{ID: uuid.New(), UserID: userID}
+	link := func(name string, params map[string]any) areadomain.Link {
+		params["identityId"] = identityID.String()
+		return areadomain.Link{Config: componentdomain.Config{
+			Params:    params,
+			Component: &componentdomain.Component{Name: name, Provider: componentdomain.Provider{Name: githubProviderName}},
+		}}
+	}
+
+	issues := NewIssueExecutor(repo, providerResolverStub{}, client, nil, zap.NewNop())
+	result, err := issues.Preview(context.Background(), area, link(createIssueComponentName, map[string]any{
+		"owner": "octocat", "repository": "hello-world", "title": "Bug report",
+	}))
+	if err != nil {
+		t.Fatalf("Preview returned error: %v", err)
+	}
+	if result.Endpoint != "https://api.github.com/repos/octocat/hello-world/issues" || result.Request["body"] != `{"title":"Bug report"}` {
+		t.Fatalf("unexpected preview %+v", result)
+	}
+	headers, _ := result.Request["headers"].(map[string][]string)
+	if auth := headers["Authorization"]; len(auth) != 1 || auth[0] != "Bearer <redacted>" {
+		t.Fatalf("expected the token redacted, got %v", auth)
+	}
+
+	repository := NewRepositoryExecutor(repo, providerResolverStub{}, client, nil, zap.NewNop())
+	if _, err := repository.Preview(context.Background(), area, link(commentComponentName, map[string]any{
+		"owner": "octocat", "repository": "hello-world", "number": 7, "body": "On it",
+	})); err != nil {
+		t.Fatalf("Preview returned error: %v", err)
+	}
+	_, err = repository.Preview(context.Background(), area, link(removeLabelsComponentName, map[string]any{
+		"owner": "octocat", "repository": "hello-world", "number": 7, "labels": "bug, urgent",
+	}))
+	if !errors.Is(err, outbound.ErrPreviewUnsupported) {
+		t.Fatalf("expected several requests to be unsupported, got %v", err)
+	}
+
+	if client.lastRequest != nil || repo.updateCalled {
+		t.Fatalf("expected previews to neither call GitHub nor refresh the token")
+	}
+}
+
 type identityRepoStub struct {
 	identity     identitydomain.Identity
 	updateCalled bool
@@ -0,0 +1,456 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	commentComponentName          = "github_comment"
+	addLabelsComponentName        = "github_add_labels"
+	removeLabelsComponentName     = "github_remove_labels"
+	closeIssueComponentName       = "github_close_issue"
+	reopenIssueComponentName      = "github_reopen_issue"
+	createReleaseComponentName    = "github_create_release"
+	dispatchWorkflowComponentName = "github_dispatch_workflow"
+	createGistComponentName       = "github_create_gist"
+)
+
+// repositoryPlan lists the GitHub calls required by a reaction along with a description of its target
+type repositoryPlan struct {
+	identityID uuid.UUID
+	target     string
+	calls      []apiCall
+}
+
+type repositoryOperation func(params map[string]any) (repositoryPlan, error)
+
+var repositoryOperations = map[string]repositoryOperation{
+	commentComponentName:          planComment,
+	addLabelsComponentName:        planAddLabels,
+	removeLabelsComponentName:     planRemoveLabels,
+	closeIssueComponentName:       planIssueState("closed"),
+	reopenIssueComponentName:      planIssueState("open"),
+	createReleaseComponentName:    planCreateRelease,
+	dispatchWorkflowComponentName: planDispatchWorkflow,
+	createGistComponentName:       planCreateGist,
+}
+
+// RepositoryExecutor delivers GitHub reactions acting on issues, pull requests, releases, workflows and gists
+type RepositoryExecutor struct {
+	api    apiClient
+	logger *zap.Logger
+}
+
+// NewRepositoryExecutor constructs a RepositoryExecutor from its dependencies
+func NewRepositoryExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *RepositoryExecutor {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &RepositoryExecutor{
+		api:    newAPIClient("github.RepositoryExecutor", identities, providers, client, clock),
+		logger: logger,
+	}
+}
+
+// Supports reports whether the executor can handle the provided component
+func (e *RepositoryExecutor) Supports(component *componentdomain.Component) bool {
+	if component == nil || !strings.EqualFold(component.Provider.Name, githubProviderName) {
+		return false
+	}
+	_, ok := repositoryOperations[strings.ToLower(component.Name)]
+	return ok
+}
+
+// Execute performs the GitHub operation selected by the component using the linked identity
+func (e *RepositoryExecutor) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("github.RepositoryExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("github.RepositoryExecutor: resolver not configured")
+	}
+
+	component := link.Config.Component
+	plan, err := repositoryOperations[strings.ToLower(component.Name)](link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("github.RepositoryExecutor: %w", err)
+	}
+
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, plan.identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+
+	var result outbound.ReactionResult
+	for _, call := range plan.calls {
+		result, identity, accessToken, err = e.api.deliver(ctx, identity, accessToken, call)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	e.logger.Info("github reaction delivered",
+		zap.String("area_id", area.ID.String()),
+		zap.String("identity_id", identity.ID.String()),
+		zap.String("component", component.Name),
+		zap.String("target", plan.target),
+	)
+	return result, nil
+}
+
+// Preview describes the GitHub request without sending it
+// Operations issuing several requests, such as removing more than one label, cannot be previewed
+func (e *RepositoryExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("github.RepositoryExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("github.RepositoryExecutor: resolver not configured")
+	}
+	plan, err := repositoryOperations[strings.ToLower(link.Config.Component.Name)](link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("github.RepositoryExecutor: %w", err)
+	}
+	if len(plan.calls) != 1 {
+		return outbound.ReactionResult{}, outbound.ErrPreviewUnsupported
+	}
+	return e.api.preview(ctx, area, plan.identityID, plan.calls[0])
+}
+
+func planComment(params map[string]any) (repositoryPlan, error) {
+	plan, number, err := parseIssueTarget(params)
+	if err != nil {
+		return plan, err
+	}
+	body, err := requiredString(params, "body")
+	if err != nil {
+		return plan, err
+	}
+	payload, err := json.Marshal(map[string]any{"body": body})
+	if err != nil {
+		return plan, fmt.Errorf("marshal payload: %w", err)
+	}
+	plan.calls = []apiCall{{
+		method:   http.MethodPost,
+		endpoint: fmt.Sprintf("%s/issues/%d/comments", repositoryEndpoint(plan.target), number),
+		payload:  payload,
+	}}
+	return plan, nil
+}
+
+func planAddLabels(params map[string]any) (repositoryPlan, error) {
+	plan, number, err := parseIssueTarget(params)
+	if err != nil {
+		return plan, err
+	}
+	labels, err := requiredLabels(params)
+	if err != nil {
+		return plan, err
+	}
+	payload, err := json.Marshal(map[string]any{"labels": labels})
+	if err != nil {
+		return plan, fmt.Errorf("marshal payload: %w", err)
+	}
+	plan.calls = []apiCall{{
+		method:   http.MethodPost,
+		endpoint: fmt.Sprintf("%s/issues/%d/labels", repositoryEndpoint(plan.target), number),
+		payload:  payload,
+	}}
+	return plan, nil
+}
+
+func planRemoveLabels(params map[string]any) (repositoryPlan, error) {
+	plan, number, err := parseIssueTarget(params)
+	if err != nil {
+		return plan, err
+	}
+	labels, err := requiredLabels(params)
+	if err != nil {
+		return plan, err
+	}
+	for _, label := range labels {
+		plan.calls = append(plan.calls, apiCall{
+			method:        http.MethodDelete,
+			endpoint:      fmt.Sprintf("%s/issues/%d/labels/%s", repositoryEndpoint(plan.target), number, url.PathEscape(label)),
+			allowNotFound: true,
+		})
+	}
+	return plan, nil
+}
+
+func planIssueState(state string) repositoryOperation {
+	return func(params map[string]any) (repositoryPlan, error) {
+		plan, number, err := parseIssueTarget(params)
+		if err != nil {
+			return plan, err
+		}
+		body := map[string]any{"state": state}
+		if state == "closed" {
+			reason, err := optionalString(params, "stateReason")
+			if err != nil {
+				return plan, err
+			}
+			if reason != "" {
+				body["state_reason"] = reason
+			}
+		}
+		payload, err := json.Marshal(body)
+		if err != nil {
+			return plan, fmt.Errorf("marshal payload: %w", err)
+		}
+		plan.calls = []apiCall{{
+			method:   http.MethodPatch,
+			endpoint: fmt.Sprintf("%s/issues/%d", repositoryEndpoint(plan.target), number),
+			payload:  payload,
+		}}
+		return plan, nil
+	}
+}
+
+func planCreateRelease(params map[string]any) (repositoryPlan, error) {
+	plan, err := parseRepositoryTarget(params)
+	if err != nil {
+		return plan, err
+	}
+	tag, err := requiredString(params, "tagName")
+	if err != nil {
+		return plan, err
+	}
+	body := map[string]any{"tag_name": tag}
+	for key, field := range map[string]string{"target": "target_commitish", "name": "name", "body": "body"} {
+		value, err := optionalString(params, key)
+		if err != nil {
+			return plan, err
+		}
+		if value != "" {
+			body[field] = value
+		}
+	}
+	for key, field := range map[string]string{"draft": "draft", "prerelease": "prerelease", "generateNotes": "generate_release_notes"} {
+		value, err := optionalBool(params, key)
+		if err != nil {
+			return plan, err
+		}
+		if value {
+			body[field] = true
+		}
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return plan, fmt.Errorf("marshal payload: %w", err)
+	}
+	plan.calls = []apiCall{{
+		method:   http.MethodPost,
+		endpoint: repositoryEndpoint(plan.target) + "/releases",
+		payload:  payload,
+	}}
+	return plan, nil
+}
+
+func planDispatchWorkflow(params map[string]any) (repositoryPlan, error) {
+	plan, err := parseRepositoryTarget(params)
+	if err != nil {
+		return plan, err
+	}
+	workflow, err := requiredString(params, "workflow")
+	if err != nil {
+		return plan, err
+	}
+	ref, err := requiredString(params, "ref")
+	if err != nil {
+		return plan, err
+	}
+	body := map[string]any{"ref": ref}
+	inputs, err := optionalString(params, "inputs")
+	if err != nil {
+		return plan, err
+	}
+	if inputs != "" {
+		decoded := map[string]any{}
+		if err := json.Unmarshal([]byte(inputs), &decoded); err != nil {
+			return plan, fmt.Errorf("parse workflow inputs: %w", err)
+		}
+		body["inputs"] = decoded
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return plan, fmt.Errorf("marshal payload: %w", err)
+	}
+	plan.calls = []apiCall{{
+		method:   http.MethodPost,
+		endpoint: fmt.Sprintf("%s/actions/workflows/%s/dispatches", repositoryEndpoint(plan.target), url.PathEscape(workflow)),
+		payload:  payload,
+	}}
+	return plan, nil
+}
+
+func planCreateGist(params map[string]any) (repositoryPlan, error) {
+	var plan repositoryPlan
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return plan, err
+	}
+	plan.identityID = identityID
+
+	filename, err := requiredString(params, "filename")
+	if err != nil {
+		return plan, err
+	}
+	content, err := requiredString(params, "content")
+	if err != nil {
+		return plan, err
+	}
+	description, err := optionalString(params, "description")
+	if err != nil {
+		return plan, err
+	}
+	public, err := optionalBool(params, "public")
+	if err != nil {
+		return plan, err
+	}
+	payload, err := json.Marshal(map[string]any{
+		"description": description,
+		"public":      public,
+		"files": map[string]any{
+			filename: map[string]any{"content": content},
+		},
+	})
+	if err != nil {
+		return plan, fmt.Errorf("marshal payload: %w", err)
+	}
+	plan.target = "gist:" + filename
+	plan.calls = []apiCall{{
+		method:   http.MethodPost,
+		endpoint: githubAPIBaseURL + "/gists",
+		payload:  payload,
+	}}
+	return plan, nil
+}
+
+func parseRepositoryTarget(params map[string]any) (repositoryPlan, error) {
+	var plan repositoryPlan
+	if params == nil {
+		return plan, fmt.Errorf("params missing")
+	}
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return plan, err
+	}
+	owner, err := requiredString(params, "owner")
+	if err != nil {
+		return plan, err
+	}
+	repository, err := requiredString(params, "repository")
+	if err != nil {
+		return plan, err
+	}
+	plan.identityID = identityID
+	plan.target = owner + "/" + repository
+	return plan, nil
+}
+
+func parseIssueTarget(params map[string]any) (repositoryPlan, int, error) {
+	plan, err := parseRepositoryTarget(params)
+	if err != nil {
+		return plan, 0, err
+	}
+	raw, err := optionalString(params, "number")
+	if err != nil {
+		return plan, 0, err
+	}
+	if raw == "" {
+		return plan, 0, fmt.Errorf("number missing")
+	}
+	number, err := strconv.Atoi(strings.TrimPrefix(raw, "#"))
+	if err != nil || number <= 0 {
+		return plan, 0, fmt.Errorf("number invalid: %q", raw)
+	}
+	return plan, number, nil
+}
+
+func repositoryEndpoint(target string) string {
+	owner, repository, _ := strings.Cut(target, "/")
+	return fmt.Sprintf("%s/repos/%s/%s", githubAPIBaseURL, url.PathEscape(owner), url.PathEscape(repository))
+}
+
+func parseIdentityID(params map[string]any) (uuid.UUID, error) {
+	raw, err := requiredString(params, "identityId")
+	if err != nil {
+		return uuid.Nil, err
+	}
+	identityID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("parse identityId: %w", err)
+	}
+	return identityID, nil
+}
+
+func requiredLabels(params map[string]any) ([]string, error) {
+	labels, err := parseLabels(params["labels"])
+	if err != nil {
+		return nil, fmt.Errorf("labels invalid: %w", err)
+	}
+	if len(labels) == 0 {
+		return nil, fmt.Errorf("labels empty")
+	}
+	return labels, nil
+}
+
+func optionalString(params map[string]any, key string) (string, error) {
+	value, ok := params[key]
+	if !ok || value == nil {
+		return "", nil
+	}
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case int:
+		return strconv.Itoa(v), nil
+	}
+	str, err := toString(value)
+	if err != nil {
+		return "", fmt.Errorf("%s invalid: %w", key, err)
+	}
+	return strings.TrimSpace(str), nil
+}
+
+func optionalBool(params map[string]any, key string) (bool, error) {
+	value, ok := params[key]
+	if !ok || value == nil {
+		return false, nil
+	}
+	switch v := value.(type) {
+	case bool:
+		return v, nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return false, nil
+		}
+		parsed, err := strconv.ParseBool(strings.TrimSpace(v))
+		if err != nil {
+			return false, fmt.Errorf("%s invalid: %w", key, err)
+		}
+		return parsed, nil
+	default:
+		return false, fmt.Errorf("%s invalid: unexpected type %T", key, value)
+	}
+}
+
+// Ensure RepositoryExecutor satisfies the ComponentReactionHandler contract
+var _ interface {
+	Supports(*componentdomain.Component) bool
+	Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error)
+} = (*RepositoryExecutor)(nil)
@@ -0,0 +1,206 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type recordedRequest struct {
+	method string
+	url    string
+	body   string
+}
+
+type recordingClient struct {
+	statuses []int
+	requests []recordedRequest
+}
+
+func (c *recordingClient) Do(req *http.Request) (*http.Response, error) {
+	var body string
+	if req.Body != nil {
+		data, _ := io.ReadAll(req.Body)
+		body = string(data)
+	}
+	c.requests = append(c.requests, recordedRequest{method: req.Method, url: req.URL.String(), body: body})
+
+	status := http.StatusOK
+	if index := len(c.requests) - 1; index < len(c.statuses) {
+		status = c.statuses[index]
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}, nil
+}
+
+func executeRepositoryReaction(t *testing.T, client *recordingClient, name string, params map[string]any) error {
+	t.Helper()
+	userID := uuid.New()
+	identityID := uuid.New()
+	future := time.Now().Add(time.Hour).UTC()
+	repo := &identityRepoStub{identity: identitydomain.Identity{
+		ID:          identityID,
+		UserID:      userID,
+		Provider:    githubProviderName,
+		AccessToken: "access-token",
+		ExpiresAt:   &future,
+	}}
+	exec := NewRepositoryExecutor(repo, providerResolverStub{}, client, clockStub{now: time.Now().UTC()}, zap.NewNop())
+
+	params["identityId"] = identityID.String()
+	link := areadomain.Link{
+		ID:   uuid.New(),
+		Role: areadomain.LinkRoleReaction,
+		Config: componentdomain.Config{
+			Params: params,
+			Component: &componentdomain.Component{
+				Name:     name,
+				Provider: componentdomain.Provider{Name: githubProviderName},
+			},
+		},
+	}
+	_, err := exec.Execute(context.Background(), areadomain.Area{ID: uuid.New(), UserID: userID}, link)
+	return err
+}
+
+func TestRepositoryExecutorSupports(t *testing.T) {
+	exec := NewRepositoryExecutor(nil, nil, nil, nil, nil)
+	for name := range repositoryOperations {
+		component := &componentdomain.Component{Name: strings.ToUpper(name), Provider: componentdomain.Provider{Name: "GitHub"}}
+		if !exec.Supports(component) {
+			t.Fatalf("expected %s to be supported", name)
+		}
+	}
+	if exec.Supports(&componentdomain.Component{Name: createIssueComponentName, Provider: componentdomain.Provider{Name: githubProviderName}}) {
+		t.Fatal("issue creation is handled by IssueExecutor")
+	}
+	if exec.Supports(&componentdomain.Component{Name: commentComponentName, Provider: componentdomain.Provider{Name: "gitlab"}}) {
+		t.Fatal("unexpected support for another provider")
+	}
+}
+
+func TestRepositoryExecutorOperations(t *testing.T) {
+	cases := []struct {
+		name     string
+		params   map[string]any
+		method   string
+		url      string
+		expected map[string]any
+	}{
+		{
+			name:     commentComponentName,
+			params:   map[string]any{"owner": "octo", "repository": "repo", "number": "#12", "body": "Thanks!"},
+			method:   http.MethodPost,
+			url:      "https://api.github.com/repos/octo/repo/issues/12/comments",
+			expected: map[string]any{"body": "Thanks!"},
+		},
+		{
+			name:     addLabelsComponentName,
+			params:   map[string]any{"owner": "octo", "repository": "repo", "number": float64(7), "labels": "bug, triage"},
+			method:   http.MethodPost,
+			url:      "https://api.github.com/repos/octo/repo/issues/7/labels",
+			expected: map[string]any{"labels": []any{"bug", "triage"}},
+		},
+		{
+			name:     closeIssueComponentName,
+			params:   map[string]any{"owner": "octo", "repository": "repo", "number": "3", "stateReason": "not_planned"},
+			method:   http.MethodPatch,
+			url:      "https://api.github.com/repos/octo/repo/issues/3",
+			expected: map[string]any{"state": "closed", "state_reason": "not_planned"},
+		},
+		{
+			name:     reopenIssueComponentName,
+			params:   map[string]any{"owner": "octo", "repository": "repo", "number": "3"},
+			method:   http.MethodPatch,
+			url:      "https://api.github.com/repos/octo/repo/issues/3",
+			expected: map[string]any{"state": "open"},
+		},
+		{
+			name:     createReleaseComponentName,
+			params:   map[string]any{"owner": "octo", "repository": "repo", "tagName": "v1.2.0", "target": "main", "prerelease": "true"},
+			method:   http.MethodPost,
+			url:      "https://api.github.com/repos/octo/repo/releases",
+			expected: map[string]any{"tag_name": "v1.2.0", "target_commitish": "main", "prerelease": true},
+		},
+		{
+			name:     dispatchWorkflowComponentName,
+			params:   map[string]any{"owner": "octo", "repository": "repo", "workflow": "deploy.yml", "ref": "main", "inputs": `{"environment":"staging"}`},
+			method:   http.MethodPost,
+			url:      "https://api.github.com/repos/octo/repo/actions/workflows/deploy.yml/dispatches",
+			expected: map[string]any{"ref": "main", "inputs": map[string]any{"environment": "staging"}},
+		},
+		{
+			name:     createGistComponentName,
+			params:   map[string]any{"filename": "notes.md", "content": "# Notes", "public": true},
+			method:   http.MethodPost,
+			url:      "https://api.github.com/gists",
+			expected: map[string]any{"description": "", "public": true, "files": map[string]any{"notes.md": map[string]any{"content": "# Notes"}}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &recordingClient{}
+			if err := executeRepositoryReaction(t, client, tc.name, tc.params); err != nil {
+				t.Fatalf("Execute returned error: %v", err)
+			}
+			if len(client.requests) != 1 {
+				t.Fatalf("expected 1 request got %d", len(client.requests))
+			}
+			request := client.requests[0]
+			if request.method != tc.method || request.url != tc.url {
+				t.Fatalf("unexpected request %s %s", request.method, request.url)
+			}
+			var body map[string]any
+			if err := json.Unmarshal([]byte(request.body), &body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			expected, _ := json.Marshal(tc.expected)
+			actual, _ := json.Marshal(body)
+			if string(expected) != string(actual) {
+				t.Fatalf("unexpected body %s want %s", actual, expected)
+			}
+		})
+	}
+}
+
+func TestRepositoryExecutorRemoveLabelsToleratesMissingLabels(t *testing.T) {
+	client := &recordingClient{statuses: []int{http.StatusNotFound, http.StatusOK}}
+	params := map[string]any{"owner": "octo", "repository": "repo", "number": "5", "labels": "needs info, stale"}
+	if err := executeRepositoryReaction(t, client, removeLabelsComponentName, params); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if len(client.requests) != 2 {
+		t.Fatalf("expected 2 requests got %d", len(client.requests))
+	}
+	if got := client.requests[0].url; got != "https://api.github.com/repos/octo/repo/issues/5/labels/needs%20info" {
+		t.Fatalf("unexpected url %s", got)
+	}
+	if client.requests[1].method != http.MethodDelete {
+		t.Fatalf("unexpected method %s", client.requests[1].method)
+	}
+}
+
+func TestRepositoryExecutorRejectsInvalidNumber(t *testing.T) {
+	client := &recordingClient{}
+	params := map[string]any{"owner": "octo", "repository": "repo", "number": "abc", "body": "hi"}
+	if err := executeRepositoryReaction(t, client, commentComponentName, params); err == nil {
+		t.Fatal("expected invalid number error")
+	}
+	if len(client.requests) != 0 {
+		t.Fatal("no request should be issued for invalid params")
+	}
+}
@@ -0,0 +1,234 @@
+package gitlab
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+)
+
+const gitlabAPIBaseURL = "https://gitlab.com/api/v4"
+
+// apiClient bundles the identity lookup and token refresh flow shared by GitLab executors
+type apiClient struct {
+	name       string
+	identities identityport.Repository
+	providers  ProviderResolver
+	http       HTTPClient
+	clock      Clock
+}
+
+// apiCall describes a single GitLab REST request issued by a reaction
+type apiCall struct {
+	method        string
+	endpoint      string
+	payload       []byte
+	allowNotFound bool
+}
+
+func newAPIClient(name string, identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock) apiClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return apiClient{name: name, identities: identities, providers: providers, http: client, clock: clock}
+}
+
+func (c apiClient) configured() bool {
+	return c.identities != nil && c.providers != nil
+}
+
+// resolveIdentity loads the identity bound to the reaction and ensures it carries a usable access token
+func (c apiClient) resolveIdentity(ctx context.Context, area areadomain.Area, identityID uuid.UUID) (identitydomain.Identity, string, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	if provider, ok := c.provider(identity); ok && identityport.ProviderType(provider) != gitlabProviderName {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity provider %s is not a GitLab instance", c.name, provider.Name())
+	}
+	return c.ensureAccessToken(ctx, identity, false)
+}
+
+// provider resolves the OAuth provider that issued the identity so self-hosted instances keep their own endpoints
+func (c apiClient) provider(identity identitydomain.Identity) (identityport.Provider, bool) {
+	if name := strings.TrimSpace(identity.Provider); name != "" {
+		if provider, ok := c.providers.Provider(name); ok {
+			return provider, true
+		}
+	}
+	return c.providers.Provider(gitlabProviderName)
+}
+
+func (c apiClient) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
+	now := c.now()
+	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
+		return identity, identity.AccessToken, nil
+	}
+
+	provider, ok := c.provider(identity)
+	if !ok {
+		return identity, "", fmt.Errorf("%s: provider %s not configured", c.name, gitlabProviderName)
+	}
+
+	exchange, err := provider.Refresh(ctx, identity)
+	if err != nil {
+		return identity, "", fmt.Errorf("%s: refresh token: %w", c.name, err)
+	}
+
+	refreshToken := exchange.Token.RefreshToken
+	if refreshToken == "" {
+		refreshToken = identity.RefreshToken
+	}
+	expiresAt := identity.ExpiresAt
+	if !exchange.Token.ExpiresAt.IsZero() {
+		expires := exchange.Token.ExpiresAt.UTC()
+		expiresAt = &expires
+	}
+	scopes := exchange.Token.Scope
+	if len(scopes) == 0 {
+		scopes = identity.Scopes
+	}
+
+	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
+	updated.UpdatedAt = now
+
+	if err := c.identities.Update(ctx, updated); err != nil {
+		return identity, "", fmt.Errorf("%s: update identity: %w", c.name, err)
+	}
+
+	return updated, updated.AccessToken, nil
+}
+
+// deliver sends the call and transparently refreshes the access token once when GitLab rejects the token
+func (c apiClient) deliver(ctx context.Context, identity identitydomain.Identity, accessToken string, call apiCall) (outbound.ReactionResult, identitydomain.Identity, string, error) {
+	if provider, ok := c.provider(identity); ok {
+		call.endpoint = identityport.RebaseEndpoint(provider, call.endpoint)
+	}
+	result, unauthorized, err := c.send(ctx, accessToken, call)
+	if err != nil && unauthorized {
+		identity, accessToken, err = c.ensureAccessToken(ctx, identity, true)
+		if err != nil {
+			return outbound.ReactionResult{}, identity, accessToken, err
+		}
+		result, unauthorized, err = c.send(ctx, accessToken, call)
+	}
+	if err != nil {
+		return result, identity, accessToken, err
+	}
+	if unauthorized {
+		return result, identity, accessToken, fmt.Errorf("%s: unauthorized after refresh", c.name)
+	}
+	return result, identity, accessToken, nil
+}
+
+// preview describes the request the call would issue with the identity, with the access token redacted
+// The identity is only read to target its own instance, its token is never refreshed
+func (c apiClient) preview(ctx context.Context, area areadomain.Area, identityID uuid.UUID, call apiCall) (outbound.ReactionResult, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	if provider, ok := c.provider(identity); ok {
+		call.endpoint = identityport.RebaseEndpoint(provider, call.endpoint)
+	}
+	req, err := c.newRequest(ctx, "<redacted>", call)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	return outbound.ReactionResult{
+		Endpoint: call.endpoint,
+		Request: map[string]any{
+			"method":  call.method,
+			"url":     call.endpoint,
+			"headers": copyHeaders(req.Header),
+			"body":    string(call.payload),
+		},
+	}, nil
+}
+
+func (c apiClient) newRequest(ctx context.Context, accessToken string, call apiCall) (*http.Request, error) {
+	var body io.Reader
+	if call.payload != nil {
+		body = bytes.NewReader(call.payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, call.method, call.endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: build request: %w", c.name, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+	if call.payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("User-Agent", "AREA-Server")
+	return req, nil
+}
+
+func (c apiClient) send(ctx context.Context, accessToken string, call apiCall) (outbound.ReactionResult, bool, error) {
+	req, err := c.newRequest(ctx, accessToken, call)
+	if err != nil {
+		return outbound.ReactionResult{}, false, err
+	}
+
+	start := time.Now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return outbound.ReactionResult{}, false, fmt.Errorf("%s: request failed: %w", c.name, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	duration := time.Since(start)
+
+	result := outbound.ReactionResult{
+		Endpoint: call.endpoint,
+		Request: map[string]any{
+			"method":  call.method,
+			"url":     call.endpoint,
+			"headers": copyHeaders(req.Header),
+			"body":    string(call.payload),
+		},
+		Response: map[string]any{
+			"body":    string(respBody),
+			"headers": copyHeaders(resp.Header),
+		},
+		StatusCode: &resp.StatusCode,
+		Duration:   duration,
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden:
+		return result, true, fmt.Errorf("%s: received status %d", c.name, resp.StatusCode)
+	case resp.StatusCode == http.StatusNotFound && call.allowNotFound:
+		return result, false, nil
+	case resp.StatusCode >= 400:
+		return result, false, fmt.Errorf("%s: received status %d", c.name, resp.StatusCode)
+	default:
+		return result, false, nil
+	}
+}
+
+func (c apiClient) now() time.Time {
+	if c.clock == nil {
+		return time.Now().UTC()
+	}
+	return c.clock.Now().UTC()
+}
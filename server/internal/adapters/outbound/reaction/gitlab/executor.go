@@ -1,11 +1,9 @@
 package gitlab
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strings"
@@ -13,7 +11,6 @@ import (
 
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
-	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
 	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
@@ -23,7 +20,7 @@ import (
 const (
 	gitlabProviderName        = "gitlab"
 	createIssueComponentName  = "gitlab_create_issue"
-	gitlabCreateIssueEndpoint = "https://gitlab.com/api/v4/projects/%s/issues"
+	gitlabCreateIssueEndpoint = gitlabAPIBaseURL + "/projects/%s/issues"
 )
 
 // ProviderResolver exposes OAuth providers by name
@@ -49,30 +46,18 @@ func (systemClock) Now() time.Time {
 
 // IssueExecutor delivers GitLab reactions that create issues
 type IssueExecutor struct {
-	identities identityport.Repository
-	providers  ProviderResolver
-	http       HTTPClient
-	clock      Clock
-	logger     *zap.Logger
+	api    apiClient
+	logger *zap.Logger
 }
 
 // NewIssueExecutor constructs an IssueExecutor from its dependencies
 func NewIssueExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *IssueExecutor {
-	if client == nil {
-		client = http.DefaultClient
-	}
-	if clock == nil {
-		clock = systemClock{}
-	}
 	if logger == nil {
 		logger = zap.NewNop()
 	}
 	return &IssueExecutor{
-		identities: identities,
-		providers:  providers,
-		http:       client,
-		clock:      clock,
-		logger:     logger,
+		api:    newAPIClient("gitlab.IssueExecutor", identities, providers, client, clock),
+		logger: logger,
 	}
 }
 
@@ -90,7 +75,7 @@ func (e *IssueExecutor) Execute(ctx context.Context, area areadomain.Area, link
 	if !e.Supports(link.Config.Component) {
 		return outbound.ReactionResult{}, fmt.Errorf("gitlab.IssueExecutor: unsupported component")
 	}
-	if e.identities == nil || e.providers == nil {
+	if !e.api.configured() {
 		return outbound.ReactionResult{}, fmt.Errorf("gitlab.IssueExecutor: resolver not configured")
 	}
 
@@ -99,33 +84,19 @@ func (e *IssueExecutor) Execute(ctx context.Context, area areadomain.Area, link
 		return outbound.ReactionResult{}, fmt.Errorf("gitlab.IssueExecutor: %w", err)
 	}
 
-	identity, err := e.identities.FindByID(ctx, cfg.identityID)
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, cfg.identityID)
 	if err != nil {
-		return outbound.ReactionResult{}, fmt.Errorf("gitlab.IssueExecutor: identity lookup: %w", err)
-	}
-	if identity.UserID != area.UserID {
-		return outbound.ReactionResult{}, fmt.Errorf("gitlab.IssueExecutor: identity not owned by user")
+		return outbound.ReactionResult{}, err
 	}
 
-	identity, accessToken, err := e.ensureAccessToken(ctx, identity, false)
+	call, err := issueCall(cfg)
 	if err != nil {
 		return outbound.ReactionResult{}, err
 	}
-
-	result, unauthorized, err := e.createIssue(ctx, accessToken, cfg)
-	if err != nil && unauthorized {
-		identity, accessToken, err = e.ensureAccessToken(ctx, identity, true)
-		if err != nil {
-			return outbound.ReactionResult{}, err
-		}
-		result, unauthorized, err = e.createIssue(ctx, accessToken, cfg)
-	}
+	result, identity, _, err := e.api.deliver(ctx, identity, accessToken, call)
 	if err != nil {
 		return result, err
 	}
-	if unauthorized {
-		return result, fmt.Errorf("gitlab.IssueExecutor: unauthorized after refresh")
-	}
 
 	e.logger.Info("gitlab issue created",
 		zap.String("area_id", area.ID.String()),
@@ -135,10 +106,26 @@ func (e *IssueExecutor) Execute(ctx context.Context, area areadomain.Area, link
 	return result, nil
 }
 
-func (e *IssueExecutor) createIssue(ctx context.Context, accessToken string, cfg issueConfig) (outbound.ReactionResult, bool, error) {
-	project := url.PathEscape(cfg.owner + "/" + cfg.repository)
-	endpoint := fmt.Sprintf(gitlabCreateIssueEndpoint, project)
+// Preview describes the issue creation request without sending it
+func (e *IssueExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("gitlab.IssueExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("gitlab.IssueExecutor: resolver not configured")
+	}
+	cfg, err := parseIssueConfig(link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("gitlab.IssueExecutor: %w", err)
+	}
+	call, err := issueCall(cfg)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	return e.api.preview(ctx, area, cfg.identityID, call)
+}
 
+func issueCall(cfg issueConfig) (apiCall, error) {
 	payload := map[string]any{
 		"title": cfg.title,
 	}
@@ -151,102 +138,13 @@ func (e *IssueExecutor) createIssue(ctx context.Context, accessToken string, cfg
 
 	bodyBytes, err := json.Marshal(payload)
 	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("gitlab.IssueExecutor: marshal payload: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("gitlab.IssueExecutor: build request: %w", err)
-	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("User-Agent", "AREA-Server")
-
-	start := time.Now()
-	resp, err := e.http.Do(req)
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("gitlab.IssueExecutor: request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := io.ReadAll(resp.Body)
-	duration := time.Since(start)
-
-	requestHeaders := copyHeaders(req.Header)
-	responseHeaders := copyHeaders(resp.Header)
-
-	result := outbound.ReactionResult{
-		Endpoint: endpoint,
-		Request: map[string]any{
-			"method":  http.MethodPost,
-			"url":     endpoint,
-			"headers": requestHeaders,
-			"body":    string(bodyBytes),
-		},
-		Response: map[string]any{
-			"body":    string(respBody),
-			"headers": responseHeaders,
-		},
-		StatusCode: &resp.StatusCode,
-		Duration:   duration,
-	}
-
-	if resp.StatusCode >= 400 {
-		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
-			return result, true, fmt.Errorf("gitlab.IssueExecutor: received status %d", resp.StatusCode)
-		}
-		return result, false, fmt.Errorf("gitlab.IssueExecutor: received status %d", resp.StatusCode)
-	}
-
-	return result, false, nil
-}
-
-func (e *IssueExecutor) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
-	now := e.now()
-	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
-		return identity, identity.AccessToken, nil
-	}
-
-	provider, ok := e.providers.Provider(gitlabProviderName)
-	if !ok {
-		return identity, "", fmt.Errorf("gitlab.IssueExecutor: provider %s not configured", gitlabProviderName)
-	}
-
-	exchange, err := provider.Refresh(ctx, identity)
-	if err != nil {
-		return identity, "", fmt.Errorf("gitlab.IssueExecutor: refresh token: %w", err)
-	}
-
-	refreshToken := exchange.Token.RefreshToken
-	if refreshToken == "" {
-		refreshToken = identity.RefreshToken
-	}
-	expiresAt := identity.ExpiresAt
-	if !exchange.Token.ExpiresAt.IsZero() {
-		expires := exchange.Token.ExpiresAt.UTC()
-		expiresAt = &expires
-	}
-	scopes := exchange.Token.Scope
-	if len(scopes) == 0 {
-		scopes = identity.Scopes
-	}
-
-	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
-	updated.UpdatedAt = now
-
-	if err := e.identities.Update(ctx, updated); err != nil {
-		return identity, "", fmt.Errorf("gitlab.IssueExecutor: update identity: %w", err)
-	}
-
-	return updated, updated.AccessToken, nil
-}
-
-func (e *IssueExecutor) now() time.Time {
-	if e.clock == nil {
-		return time.Now().UTC()
+		return apiCall{}, fmt.Errorf("gitlab.IssueExecutor: marshal payload: %w", err)
 	}
-	return e.clock.Now().UTC()
+	return apiCall{
+		method:   http.MethodPost,
+		endpoint: fmt.Sprintf(gitlabCreateIssueEndpoint, url.PathEscape(cfg.owner+"/"+cfg.repository)),
+		payload:  bodyBytes,
+	}, nil
 }
 
 type issueConfig struct {
@@ -315,16 +213,16 @@ func parseIssueConfig(params map[string]any) (issueConfig, error) {
 func requiredString(params map[string]any, key string) (string, error) {
 	value, ok := params[key]
 	if !ok {
-		return "", fmt.Errorf("parse issue config: %s missing", key)
+		return "", fmt.Errorf("parse config: %s missing", key)
 	}
 	str, err := toString(value)
 	if err != nil {
-		return "", fmt.Errorf("parse issue config: %s invalid: %w", key, err)
+		return "", fmt.Errorf("parse config: %s invalid: %w", key, err)
 	}
 	if trimmed := strings.TrimSpace(str); trimmed != "" {
 		return trimmed, nil
 	}
-	return "", fmt.Errorf("parse issue config: %s empty", key)
+	return "", fmt.Errorf("parse config: %s empty", key)
 }
 
 func parseLabels(value any) ([]string, error) {
@@ -0,0 +1,425 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	commentComponentName         = "gitlab_comment"
+	addLabelsComponentName       = "gitlab_add_labels"
+	removeLabelsComponentName    = "gitlab_remove_labels"
+	closeIssueComponentName      = "gitlab_close_issue"
+	reopenIssueComponentName     = "gitlab_reopen_issue"
+	createReleaseComponentName   = "gitlab_create_release"
+	triggerPipelineComponentName = "gitlab_trigger_pipeline"
+	createSnippetComponentName   = "gitlab_create_snippet"
+
+	targetTypeIssue        = "issue"
+	targetTypeMergeRequest = "merge_request"
+)
+
+// projectPlan lists the GitLab calls required by a reaction along with a description of its target
+type projectPlan struct {
+	identityID uuid.UUID
+	target     string
+	calls      []apiCall
+}
+
+type projectOperation func(params map[string]any) (projectPlan, error)
+
+var projectOperations = map[string]projectOperation{
+	commentComponentName:         planComment,
+	addLabelsComponentName:       planLabels("add_labels"),
+	removeLabelsComponentName:    planLabels("remove_labels"),
+	closeIssueComponentName:      planIssueState("close"),
+	reopenIssueComponentName:     planIssueState("reopen"),
+	createReleaseComponentName:   planCreateRelease,
+	triggerPipelineComponentName: planTriggerPipeline,
+	createSnippetComponentName:   planCreateSnippet,
+}
+
+// ProjectExecutor delivers GitLab reactions acting on issues, merge requests, releases, pipelines and snippets
+type ProjectExecutor struct {
+	api    apiClient
+	logger *zap.Logger
+}
+
+// NewProjectExecutor constructs a ProjectExecutor from its dependencies
+func NewProjectExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *ProjectExecutor {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &ProjectExecutor{
+		api:    newAPIClient("gitlab.ProjectExecutor", identities, providers, client, clock),
+		logger: logger,
+	}
+}
+
+// Supports reports whether the executor can handle the provided component
+func (e *ProjectExecutor) Supports(component *componentdomain.Component) bool {
+	if component == nil || !strings.EqualFold(component.Provider.Name, gitlabProviderName) {
+		return false
+	}
+	_, ok := projectOperations[strings.ToLower(component.Name)]
+	return ok
+}
+
+// Execute performs the GitLab operation selected by the component using the linked identity
+func (e *ProjectExecutor) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("gitlab.ProjectExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("gitlab.ProjectExecutor: resolver not configured")
+	}
+
+	component := link.Config.Component
+	plan, err := projectOperations[strings.ToLower(component.Name)](link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("gitlab.ProjectExecutor: %w", err)
+	}
+
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, plan.identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+
+	var result outbound.ReactionResult
+	for _, call := range plan.calls {
+		result, identity, accessToken, err = e.api.deliver(ctx, identity, accessToken, call)
+		if err != nil {
+			return result, err
+		}
+	}
+
+	e.logger.Info("gitlab reaction delivered",
+		zap.String("area_id", area.ID.String()),
+		zap.String("identity_id", identity.ID.String()),
+		zap.String("component", component.Name),
+		zap.String("target", plan.target),
+	)
+	return result, nil
+}
+
+// Preview describes the GitLab request without sending it
+func (e *ProjectExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("gitlab.ProjectExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("gitlab.ProjectExecutor: resolver not configured")
+	}
+	plan, err := projectOperations[strings.ToLower(link.Config.Component.Name)](link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("gitlab.ProjectExecutor: %w", err)
+	}
+	if len(plan.calls) != 1 {
+		return outbound.ReactionResult{}, outbound.ErrPreviewUnsupported
+	}
+	return e.api.preview(ctx, area, plan.identityID, plan.calls[0])
+}
+
+func planComment(params map[string]any) (projectPlan, error) {
+	plan, resource, err := parseItemTarget(params)
+	if err != nil {
+		return plan, err
+	}
+	body, err := requiredString(params, "body")
+	if err != nil {
+		return plan, err
+	}
+	payload, err := json.Marshal(map[string]any{"body": body})
+	if err != nil {
+		return plan, fmt.Errorf("marshal payload: %w", err)
+	}
+	plan.calls = []apiCall{{
+		method:   http.MethodPost,
+		endpoint: resource + "/notes",
+		payload:  payload,
+	}}
+	return plan, nil
+}
+
+func planLabels(field string) projectOperation {
+	return func(params map[string]any) (projectPlan, error) {
+		plan, resource, err := parseItemTarget(params)
+		if err != nil {
+			return plan, err
+		}
+		labels, err := parseLabels(params["labels"])
+		if err != nil {
+			return plan, fmt.Errorf("labels invalid: %w", err)
+		}
+		if len(labels) == 0 {
+			return plan, fmt.Errorf("labels empty")
+		}
+		payload, err := json.Marshal(map[string]any{field: strings.Join(labels, ",")})
+		if err != nil {
+			return plan, fmt.Errorf("marshal payload: %w", err)
+		}
+		plan.calls = []apiCall{{
+			method:   http.MethodPut,
+			endpoint: resource,
+			payload:  payload,
+		}}
+		return plan, nil
+	}
+}
+
+func planIssueState(event string) projectOperation {
+	return func(params map[string]any) (projectPlan, error) {
+		plan, iid, err := parseIssueTarget(params)
+		if err != nil {
+			return plan, err
+		}
+		payload, err := json.Marshal(map[string]any{"state_event": event})
+		if err != nil {
+			return plan, fmt.Errorf("marshal payload: %w", err)
+		}
+		plan.calls = []apiCall{{
+			method:   http.MethodPut,
+			endpoint: fmt.Sprintf("%s/issues/%d", projectEndpoint(plan.target), iid),
+			payload:  payload,
+		}}
+		return plan, nil
+	}
+}
+
+func planCreateRelease(params map[string]any) (projectPlan, error) {
+	plan, err := parseProjectTarget(params)
+	if err != nil {
+		return plan, err
+	}
+	tag, err := requiredString(params, "tagName")
+	if err != nil {
+		return plan, err
+	}
+	body := map[string]any{"tag_name": tag}
+	for key, field := range map[string]string{"ref": "ref", "name": "name", "description": "description"} {
+		value, err := optionalString(params, key)
+		if err != nil {
+			return plan, err
+		}
+		if value != "" {
+			body[field] = value
+		}
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return plan, fmt.Errorf("marshal payload: %w", err)
+	}
+	plan.calls = []apiCall{{
+		method:   http.MethodPost,
+		endpoint: projectEndpoint(plan.target) + "/releases",
+		payload:  payload,
+	}}
+	return plan, nil
+}
+
+func planTriggerPipeline(params map[string]any) (projectPlan, error) {
+	plan, err := parseProjectTarget(params)
+	if err != nil {
+		return plan, err
+	}
+	ref, err := requiredString(params, "ref")
+	if err != nil {
+		return plan, err
+	}
+	body := map[string]any{"ref": ref}
+	raw, err := optionalString(params, "variables")
+	if err != nil {
+		return plan, err
+	}
+	if raw != "" {
+		decoded := map[string]any{}
+		if err := json.Unmarshal([]byte(raw), &decoded); err != nil {
+			return plan, fmt.Errorf("parse pipeline variables: %w", err)
+		}
+		keys := make([]string, 0, len(decoded))
+		for key := range decoded {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		variables := make([]map[string]any, 0, len(keys))
+		for _, key := range keys {
+			variables = append(variables, map[string]any{"key": key, "value": fmt.Sprint(decoded[key])})
+		}
+		body["variables"] = variables
+	}
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return plan, fmt.Errorf("marshal payload: %w", err)
+	}
+	plan.calls = []apiCall{{
+		method:   http.MethodPost,
+		endpoint: projectEndpoint(plan.target) + "/pipeline",
+		payload:  payload,
+	}}
+	return plan, nil
+}
+
+func planCreateSnippet(params map[string]any) (projectPlan, error) {
+	var plan projectPlan
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return plan, err
+	}
+	plan.identityID = identityID
+
+	title, err := requiredString(params, "title")
+	if err != nil {
+		return plan, err
+	}
+	filename, err := requiredString(params, "filename")
+	if err != nil {
+		return plan, err
+	}
+	content, err := requiredString(params, "content")
+	if err != nil {
+		return plan, err
+	}
+	description, err := optionalString(params, "description")
+	if err != nil {
+		return plan, err
+	}
+	visibility, err := optionalString(params, "visibility")
+	if err != nil {
+		return plan, err
+	}
+	if visibility == "" {
+		visibility = "private"
+	}
+	payload, err := json.Marshal(map[string]any{
+		"title":       title,
+		"description": description,
+		"visibility":  visibility,
+		"files": []map[string]any{
+			{"file_path": filename, "content": content},
+		},
+	})
+	if err != nil {
+		return plan, fmt.Errorf("marshal payload: %w", err)
+	}
+	plan.target = "snippet:" + title
+	plan.calls = []apiCall{{
+		method:   http.MethodPost,
+		endpoint: gitlabAPIBaseURL + "/snippets",
+		payload:  payload,
+	}}
+	return plan, nil
+}
+
+func parseProjectTarget(params map[string]any) (projectPlan, error) {
+	var plan projectPlan
+	if params == nil {
+		return plan, fmt.Errorf("params missing")
+	}
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return plan, err
+	}
+	owner, err := requiredString(params, "owner")
+	if err != nil {
+		return plan, err
+	}
+	repository, err := requiredString(params, "repository")
+	if err != nil {
+		return plan, err
+	}
+	plan.identityID = identityID
+	plan.target = owner + "/" + repository
+	return plan, nil
+}
+
+func parseIssueTarget(params map[string]any) (projectPlan, int, error) {
+	plan, err := parseProjectTarget(params)
+	if err != nil {
+		return plan, 0, err
+	}
+	raw, err := optionalString(params, "iid")
+	if err != nil {
+		return plan, 0, err
+	}
+	if raw == "" {
+		return plan, 0, fmt.Errorf("iid missing")
+	}
+	iid, err := strconv.Atoi(strings.TrimLeft(raw, "#!"))
+	if err != nil || iid <= 0 {
+		return plan, 0, fmt.Errorf("iid invalid: %q", raw)
+	}
+	return plan, iid, nil
+}
+
+// parseItemTarget resolves the issue or merge request endpoint selected by the targetType parameter
+func parseItemTarget(params map[string]any) (projectPlan, string, error) {
+	plan, iid, err := parseIssueTarget(params)
+	if err != nil {
+		return plan, "", err
+	}
+	targetType, err := optionalString(params, "targetType")
+	if err != nil {
+		return plan, "", err
+	}
+	switch strings.ToLower(targetType) {
+	case "", targetTypeIssue:
+		return plan, fmt.Sprintf("%s/issues/%d", projectEndpoint(plan.target), iid), nil
+	case targetTypeMergeRequest:
+		return plan, fmt.Sprintf("%s/merge_requests/%d", projectEndpoint(plan.target), iid), nil
+	default:
+		return plan, "", fmt.Errorf("targetType %q unsupported", targetType)
+	}
+}
+
+func projectEndpoint(target string) string {
+	return gitlabAPIBaseURL + "/projects/" + url.PathEscape(target)
+}
+
+func parseIdentityID(params map[string]any) (uuid.UUID, error) {
+	raw, err := requiredString(params, "identityId")
+	if err != nil {
+		return uuid.Nil, err
+	}
+	identityID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("parse identityId: %w", err)
+	}
+	return identityID, nil
+}
+
+func optionalString(params map[string]any, key string) (string, error) {
+	value, ok := params[key]
+	if !ok || value == nil {
+		return "", nil
+	}
+	switch v := value.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case int:
+		return strconv.Itoa(v), nil
+	}
+	str, err := toString(value)
+	if err != nil {
+		return "", fmt.Errorf("%s invalid: %w", key, err)
+	}
+	return strings.TrimSpace(str), nil
+}
+
+// Ensure ProjectExecutor satisfies the ComponentReactionHandler contract
+var _ interface {
+	Supports(*componentdomain.Component) bool
+	Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error)
+} = (*ProjectExecutor)(nil)
@@ -0,0 +1,240 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type recordedRequest struct {
+	method string
+	url    string
+	body   string
+}
+
+type recordingClient struct {
+	requests []recordedRequest
+}
+
+func (c *recordingClient) Do(req *http.Request) (*http.Response, error) {
+	var body string
+	if req.Body != nil {
+		data, _ := io.ReadAll(req.Body)
+		body = string(data)
+	}
+	c.requests = append(c.requests, recordedRequest{method: req.Method, url: req.URL.String(), body: body})
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader("{}")),
+	}, nil
+}
+
+func executeProjectReaction(t *testing.T, client *recordingClient, name string, params map[string]any) error {
+	t.Helper()
+	userID := uuid.New()
+	identityID := uuid.New()
+	future := time.Now().Add(time.Hour).UTC()
+	repo := &identityRepoStub{identity: identitydomain.Identity{
+		ID:          identityID,
+		UserID:      userID,
+		Provider:    gitlabProviderName,
+		AccessToken: "access-token",
+		ExpiresAt:   &future,
+	}}
+	exec := NewProjectExecutor(repo, providerResolverStub{}, client, clockStub{now: time.Now().UTC()}, zap.NewNop())
+
+	params["identityId"] = identityID.String()
+	link := areadomain.Link{
+		ID:   uuid.New(),
+		Role: areadomain.LinkRoleReaction,
+		Config: componentdomain.Config{
+			Params: params,
+			Component: &componentdomain.Component{
+				Name:     name,
+				Provider: componentdomain.Provider{Name: gitlabProviderName},
+			},
+		},
+	}
+	_, err := exec.Execute(context.Background(), areadomain.Area{ID: uuid.New(), UserID: userID}, link)
+	return err
+}
+
+func TestProjectExecutorSupports(t *testing.T) {
+	exec := NewProjectExecutor(nil, nil, nil, nil, nil)
+	for name := range projectOperations {
+		if !exec.Supports(&componentdomain.Component{Name: name, Provider: componentdomain.Provider{Name: "GitLab"}}) {
+			t.Fatalf("expected %s to be supported", name)
+		}
+	}
+	if exec.Supports(&componentdomain.Component{Name: createIssueComponentName, Provider: componentdomain.Provider{Name: gitlabProviderName}}) {
+		t.Fatal("issue creation is handled by IssueExecutor")
+	}
+}
+
+func TestProjectExecutorOperations(t *testing.T) {
+	cases := []struct {
+		name     string
+		params   map[string]any
+		method   string
+		url      string
+		expected map[string]any
+	}{
+		{
+			name:     commentComponentName,
+			params:   map[string]any{"owner": "group", "repository": "app", "iid": "!4", "targetType": "merge_request", "body": "LGTM"},
+			method:   http.MethodPost,
+			url:      "https://gitlab.com/api/v4/projects/group%2Fapp/merge_requests/4/notes",
+			expected: map[string]any{"body": "LGTM"},
+		},
+		{
+			name:     removeLabelsComponentName,
+			params:   map[string]any{"owner": "group", "repository": "app", "iid": float64(9), "labels": []any{"bug", "stale"}},
+			method:   http.MethodPut,
+			url:      "https://gitlab.com/api/v4/projects/group%2Fapp/issues/9",
+			expected: map[string]any{"remove_labels": "bug,stale"},
+		},
+		{
+			name:     closeIssueComponentName,
+			params:   map[string]any{"owner": "group", "repository": "app", "iid": "9"},
+			method:   http.MethodPut,
+			url:      "https://gitlab.com/api/v4/projects/group%2Fapp/issues/9",
+			expected: map[string]any{"state_event": "close"},
+		},
+		{
+			name:     createReleaseComponentName,
+			params:   map[string]any{"owner": "group", "repository": "app", "tagName": "v2.0.0", "ref": "main"},
+			method:   http.MethodPost,
+			url:      "https://gitlab.com/api/v4/projects/group%2Fapp/releases",
+			expected: map[string]any{"tag_name": "v2.0.0", "ref": "main"},
+		},
+		{
+			name:   triggerPipelineComponentName,
+			params: map[string]any{"owner": "group", "repository": "app", "ref": "main", "variables": `{"DEPLOY":"true","ENV":"staging"}`},
+			method: http.MethodPost,
+			url:    "https://gitlab.com/api/v4/projects/group%2Fapp/pipeline",
+			expected: map[string]any{"ref": "main", "variables": []any{
+				map[string]any{"key": "DEPLOY", "value": "true"},
+				map[string]any{"key": "ENV", "value": "staging"},
+			}},
+		},
+		{
+			name:   createSnippetComponentName,
+			params: map[string]any{"title": "Logs", "filename": "out.log", "content": "ok"},
+			method: http.MethodPost,
+			url:    "https://gitlab.com/api/v4/snippets",
+			expected: map[string]any{"title": "Logs", "description": "", "visibility": "private", "files": []any{
+				map[string]any{"file_path": "out.log", "content": "ok"},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			client := &recordingClient{}
+			if err := executeProjectReaction(t, client, tc.name, tc.params); err != nil {
+				t.Fatalf("Execute returned error: %v", err)
+			}
+			if len(client.requests) != 1 {
+				t.Fatalf("expected 1 request got %d", len(client.requests))
+			}
+			request := client.requests[0]
+			if request.method != tc.method || request.url != tc.url {
+				t.Fatalf("unexpected request %s %s", request.method, request.url)
+			}
+			var body map[string]any
+			if err := json.Unmarshal([]byte(request.body), &body); err != nil {
+				t.Fatalf("decode body: %v", err)
+			}
+			expected, _ := json.Marshal(tc.expected)
+			actual, _ := json.Marshal(body)
+			if string(expected) != string(actual) {
+				t.Fatalf("unexpected body %s want %s", actual, expected)
+			}
+		})
+	}
+}
+
+func TestProjectExecutorRejectsUnknownTargetType(t *testing.T) {
+	client := &recordingClient{}
+	params := map[string]any{"owner": "group", "repository": "app", "iid": "1", "targetType": "epic", "body": "hi"}
+	if err := executeProjectReaction(t, client, commentComponentName, params); err == nil {
+		t.Fatal("expected unsupported target type error")
+	}
+	if len(client.requests) != 0 {
+		t.Fatal("no request should be issued for invalid params")
+	}
+}
+
+type instanceProviderStub struct {
+	identityport.Provider
+	name string
+	kind string
+	base string
+}
+
+func (p instanceProviderStub) Name() string              { return p.name }
+func (p instanceProviderStub) Type() string              { return p.kind }
+func (p instanceProviderStub) APIBaseURL() string        { return p.base }
+func (p instanceProviderStub) DefaultAPIBaseURL() string { return gitlabAPIBaseURL }
+
+type instanceResolverStub map[string]identityport.Provider
+
+func (r instanceResolverStub) Provider(name string) (identityport.Provider, bool) {
+	provider, ok := r[name]
+	return provider, ok
+}
+
+func TestProjectExecutorTargetsSelfHostedInstance(t *testing.T) {
+	userID := uuid.New()
+	identityID := uuid.New()
+	future := time.Now().Add(time.Hour).UTC()
+	repo := &identityRepoStub{identity: identitydomain.Identity{
+		ID:          identityID,
+		UserID:      userID,
+		Provider:    "gitlab-corp",
+		AccessToken: "access-token",
+		ExpiresAt:   &future,
+	}}
+	resolver := instanceResolverStub{
+		"gitlab-corp": instanceProviderStub{name: "gitlab-corp", kind: gitlabProviderName, base: "https://gitlab.corp.example/api/v4"},
+		"github-corp": instanceProviderStub{name: "github-corp", kind: "github", base: "https://github.corp.example/api/v3"},
+	}
+	client := &recordingClient{}
+	exec := NewProjectExecutor(repo, resolver, client, clockStub{now: time.Now().UTC()}, zap.NewNop())
+
+	link := areadomain.Link{
+		ID:   uuid.New(),
+		Role: areadomain.LinkRoleReaction,
+		Config: componentdomain.Config{
+			Params: map[string]any{"identityId": identityID.String(), "owner": "group", "repository": "app", "iid": "3", "body": "hi"},
+			Component: &componentdomain.Component{
+				Name:     commentComponentName,
+				Provider: componentdomain.Provider{Name: gitlabProviderName},
+			},
+		},
+	}
+	area := areadomain.Area{ID: uuid.New(), UserID: userID}
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if got := client.requests[0].url; got != "https://gitlab.corp.example/api/v4/projects/group%2Fapp/issues/3/notes" {
+		t.Fatalf("unexpected url %s", got)
+	}
+
+	repo.identity.Provider = "github-corp"
+	if _, err := exec.Execute(context.Background(), area, link); err == nil {
+		t.Fatal("expected identities of another provider type to be rejected")
+	}
+}
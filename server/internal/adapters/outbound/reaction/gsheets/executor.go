@@ -0,0 +1,531 @@
+package gsheets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	appendRowComponentName  = "gsheets_append_row"
+	gsheetsProviderName     = "google"
+	gsheetsAPIBaseURL       = "https://sheets.googleapis.com/v4/spreadsheets"
+	defaultSheetName        = "Sheet1"
+	defaultValueInputOption = "USER_ENTERED"
+)
+
+var columnLettersPattern = regexp.MustCompile(`^[A-Za-z]{1,3}$`)
+
+// ProviderResolver exposes OAuth providers by name
+type ProviderResolver interface {
+	Provider(name string) (identityport.Provider, bool)
+}
+
+// HTTPClient models the subset of http.Client used by the executor
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Clock abstracts time retrieval for deterministic tests
+type Clock interface {
+	Now() time.Time
+}
+
+// Executor appends rows to Google Sheets on behalf of the user through OAuth tokens
+type Executor struct {
+	identities identityport.Repository
+	providers  ProviderResolver
+	http       HTTPClient
+	clock      Clock
+	logger     *zap.Logger
+}
+
+// NewExecutor constructs a Google Sheets executor from its dependencies
+func NewExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *Executor {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &Executor{identities: identities, providers: providers, http: client, clock: clock, logger: logger}
+}
+
+// Supports reports whether the executor can handle the provided component
+func (e *Executor) Supports(component *componentdomain.Component) bool {
+	if component == nil {
+		return false
+	}
+	return strings.EqualFold(component.Name, appendRowComponentName) &&
+		strings.EqualFold(component.Provider.Name, gsheetsProviderName)
+}
+
+// Execute appends the configured values as a new row of the selected sheet
+func (e *Executor) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("gsheets.Executor: unsupported component")
+	}
+	if e.identities == nil || e.providers == nil {
+		return outbound.ReactionResult{}, fmt.Errorf("gsheets.Executor: resolver not configured")
+	}
+
+	cfg, err := parseAppendRowConfig(link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("gsheets.Executor: %w", err)
+	}
+
+	identity, err := e.identities.FindByID(ctx, cfg.identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("gsheets.Executor: identity lookup: %w", err)
+	}
+	if identity.UserID != area.UserID {
+		return outbound.ReactionResult{}, fmt.Errorf("gsheets.Executor: identity not owned by user")
+	}
+
+	identity, accessToken, err := e.ensureAccessToken(ctx, identity, false)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+
+	var header []string
+	if len(cfg.columns) > 0 {
+		headerEndpoint := valuesEndpoint(cfg.spreadsheetID, cfg.rangeFor("1:1"))
+		var result outbound.ReactionResult
+		var body []byte
+		result, body, identity, accessToken, err = e.call(ctx, identity, accessToken, http.MethodGet, headerEndpoint, nil, nil)
+		if err != nil {
+			return result, err
+		}
+		header, err = decodeHeaderRow(body)
+		if err != nil {
+			return result, fmt.Errorf("gsheets.Executor: %w", err)
+		}
+	}
+
+	endpoint, payload, requestInfo, err := appendRequest(cfg, header)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+
+	result, _, identity, _, err := e.call(ctx, identity, accessToken, http.MethodPost, endpoint, payload, requestInfo)
+	if err != nil {
+		return result, err
+	}
+
+	e.logger.Info("gsheets row appended",
+		zap.String("area_id", area.ID.String()),
+		zap.String("identity_id", identity.ID.String()),
+		zap.String("spreadsheet_id", cfg.spreadsheetID),
+	)
+	return result, nil
+}
+
+// Preview describes the append request without sending it
+// Rows mapped to named columns read the header row first and cannot be previewed
+func (e *Executor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("gsheets.Executor: unsupported component")
+	}
+	if e.identities == nil || e.providers == nil {
+		return outbound.ReactionResult{}, fmt.Errorf("gsheets.Executor: resolver not configured")
+	}
+
+	cfg, err := parseAppendRowConfig(link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("gsheets.Executor: %w", err)
+	}
+	if len(cfg.columns) > 0 {
+		return outbound.ReactionResult{}, outbound.ErrPreviewUnsupported
+	}
+
+	identity, err := e.identities.FindByID(ctx, cfg.identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("gsheets.Executor: identity lookup: %w", err)
+	}
+	if identity.UserID != area.UserID {
+		return outbound.ReactionResult{}, fmt.Errorf("gsheets.Executor: identity not owned by user")
+	}
+
+	endpoint, payload, requestInfo, err := appendRequest(cfg, nil)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	requestInfo["method"] = http.MethodPost
+	requestInfo["url"] = endpoint
+	requestInfo["body"] = string(payload)
+	return outbound.ReactionResult{Endpoint: endpoint, Request: requestInfo}, nil
+}
+
+// appendRequest builds the endpoint, payload and request summary appending the row of cfg
+func appendRequest(cfg appendRowConfig, header []string) (string, []byte, map[string]any, error) {
+	row, err := buildRow(cfg.values, cfg.columns, header)
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("gsheets.Executor: %w", err)
+	}
+
+	targetRange := cfg.rangeFor("A1")
+	payload, err := json.Marshal(map[string]any{
+		"range":          targetRange,
+		"majorDimension": "ROWS",
+		"values":         [][]any{row},
+	})
+	if err != nil {
+		return "", nil, nil, fmt.Errorf("gsheets.Executor: marshal payload: %w", err)
+	}
+
+	query := url.Values{}
+	query.Set("valueInputOption", cfg.valueInputOption)
+	query.Set("insertDataOption", "INSERT_ROWS")
+	endpoint := valuesEndpoint(cfg.spreadsheetID, targetRange) + ":append?" + query.Encode()
+	requestInfo := map[string]any{
+		"spreadsheetId": cfg.spreadsheetID,
+		"sheetName":     cfg.sheetName,
+		"values":        row,
+	}
+	return endpoint, payload, requestInfo, nil
+}
+
+// call issues the request and transparently refreshes the access token once when Google answers 401
+func (e *Executor) call(ctx context.Context, identity identitydomain.Identity, accessToken string, method string, endpoint string, payload []byte, request map[string]any) (outbound.ReactionResult, []byte, identitydomain.Identity, string, error) {
+	result, body, unauthorized, err := e.send(ctx, method, endpoint, accessToken, payload, request)
+	if err != nil && unauthorized {
+		identity, accessToken, err = e.ensureAccessToken(ctx, identity, true)
+		if err != nil {
+			return outbound.ReactionResult{}, nil, identity, accessToken, err
+		}
+		result, body, unauthorized, err = e.send(ctx, method, endpoint, accessToken, payload, request)
+	}
+	if err != nil {
+		return result, body, identity, accessToken, err
+	}
+	if unauthorized {
+		return result, body, identity, accessToken, fmt.Errorf("gsheets.Executor: unauthorized after refresh")
+	}
+	return result, body, identity, accessToken, nil
+}
+
+func (e *Executor) send(ctx context.Context, method string, endpoint string, accessToken string, payload []byte, request map[string]any) (outbound.ReactionResult, []byte, bool, error) {
+	var reader io.Reader
+	if payload != nil {
+		reader = bytes.NewReader(payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return outbound.ReactionResult{}, nil, false, fmt.Errorf("gsheets.Executor: build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+	if payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	start := time.Now()
+	resp, err := e.http.Do(req)
+	if err != nil {
+		return outbound.ReactionResult{}, nil, false, fmt.Errorf("gsheets.Executor: request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	duration := time.Since(start)
+
+	responseHeaders := map[string][]string{}
+	for key, values := range resp.Header {
+		responseHeaders[key] = append([]string(nil), values...)
+	}
+
+	result := outbound.ReactionResult{
+		Endpoint: endpoint,
+		Request:  cloneMap(request),
+		Response: map[string]any{
+			"body":    strings.TrimSpace(string(body)),
+			"headers": responseHeaders,
+		},
+		StatusCode: &resp.StatusCode,
+		Duration:   duration,
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return result, body, true, fmt.Errorf("gsheets.Executor: unauthorized: %s", strings.TrimSpace(string(body)))
+	case resp.StatusCode >= 400:
+		return result, body, false, fmt.Errorf("gsheets.Executor: api error %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	default:
+		return result, body, false, nil
+	}
+}
+
+func (e *Executor) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
+	now := e.now()
+	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
+		return identity, identity.AccessToken, nil
+	}
+
+	provider, ok := e.providers.Provider(gsheetsProviderName)
+	if !ok {
+		return identity, "", fmt.Errorf("gsheets.Executor: provider %s not configured", gsheetsProviderName)
+	}
+
+	exchange, err := provider.Refresh(ctx, identity)
+	if err != nil {
+		return identity, "", fmt.Errorf("gsheets.Executor: refresh token: %w", err)
+	}
+
+	refToken := exchange.Token.RefreshToken
+	if refToken == "" {
+		refToken = identity.RefreshToken
+	}
+	expiresAt := identity.ExpiresAt
+	if !exchange.Token.ExpiresAt.IsZero() {
+		expires := exchange.Token.ExpiresAt.UTC()
+		expiresAt = &expires
+	}
+	scopes := exchange.Token.Scope
+	if len(scopes) == 0 {
+		scopes = identity.Scopes
+	}
+
+	updated := identity.WithTokens(exchange.Token.AccessToken, refToken, expiresAt, scopes)
+	updated.UpdatedAt = now
+	if err := e.identities.Update(ctx, updated); err != nil {
+		return identity, "", fmt.Errorf("gsheets.Executor: update identity: %w", err)
+	}
+	return updated, updated.AccessToken, nil
+}
+
+func (e *Executor) now() time.Time {
+	if e.clock == nil {
+		return time.Now().UTC()
+	}
+	return e.clock.Now().UTC()
+}
+
+func valuesEndpoint(spreadsheetID string, valueRange string) string {
+	return fmt.Sprintf("%s/%s/values/%s", gsheetsAPIBaseURL, url.PathEscape(spreadsheetID), url.PathEscape(valueRange))
+}
+
+func decodeHeaderRow(body []byte) ([]string, error) {
+	var payload struct {
+		Values [][]any `json:"values"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decode header row: %w", err)
+	}
+	if len(payload.Values) == 0 {
+		return nil, nil
+	}
+	header := make([]string, len(payload.Values[0]))
+	for index, value := range payload.Values[0] {
+		header[index] = strings.TrimSpace(fmt.Sprint(value))
+	}
+	return header, nil
+}
+
+// buildRow places each value in its target column, resolving header names before column letters
+func buildRow(values []string, columns []string, header []string) ([]any, error) {
+	if len(columns) == 0 {
+		row := make([]any, len(values))
+		for index, value := range values {
+			row[index] = value
+		}
+		return row, nil
+	}
+	if len(columns) != len(values) {
+		return nil, fmt.Errorf("columns count %d does not match values count %d", len(columns), len(values))
+	}
+
+	cells := make(map[int]string, len(values))
+	width := 0
+	for position, column := range columns {
+		index, err := resolveColumn(column, header)
+		if err != nil {
+			return nil, err
+		}
+		cells[index] = values[position]
+		if index+1 > width {
+			width = index + 1
+		}
+	}
+	row := make([]any, width)
+	for index := range row {
+		row[index] = cells[index]
+	}
+	return row, nil
+}
+
+func resolveColumn(column string, header []string) (int, error) {
+	for index, name := range header {
+		if name != "" && strings.EqualFold(name, column) {
+			return index, nil
+		}
+	}
+	if columnLettersPattern.MatchString(column) {
+		index := 0
+		for _, letter := range strings.ToUpper(column) {
+			index = index*26 + int(letter-'A') + 1
+		}
+		return index - 1, nil
+	}
+	return 0, fmt.Errorf("column %q not found in header row", column)
+}
+
+func cloneMap(source map[string]any) map[string]any {
+	if len(source) == 0 {
+		return map[string]any{}
+	}
+	result := make(map[string]any, len(source))
+	for key, value := range source {
+		result[key] = value
+	}
+	return result
+}
+
+type appendRowConfig struct {
+	identityID       uuid.UUID
+	spreadsheetID    string
+	sheetName        string
+	values           []string
+	columns          []string
+	valueInputOption string
+}
+
+// rangeFor builds an A1 range on the configured sheet, quoting the sheet name as required by the API
+func (c appendRowConfig) rangeFor(cells string) string {
+	return "'" + strings.ReplaceAll(c.sheetName, "'", "''") + "'!" + cells
+}
+
+func parseAppendRowConfig(params map[string]any) (appendRowConfig, error) {
+	cfg := appendRowConfig{sheetName: defaultSheetName, valueInputOption: defaultValueInputOption}
+
+	identityRaw, ok := params["identityId"]
+	if !ok {
+		return cfg, fmt.Errorf("identityId missing")
+	}
+	identityStr, err := toString(identityRaw)
+	if err != nil {
+		return cfg, fmt.Errorf("identityId invalid")
+	}
+	identityID, err := uuid.Parse(strings.TrimSpace(identityStr))
+	if err != nil {
+		return cfg, fmt.Errorf("identityId parse: %w", err)
+	}
+	cfg.identityID = identityID
+
+	spreadsheetID, err := toString(params["spreadsheetId"])
+	if err != nil || strings.TrimSpace(spreadsheetID) == "" {
+		return cfg, fmt.Errorf("spreadsheetId missing")
+	}
+	cfg.spreadsheetID = strings.TrimSpace(spreadsheetID)
+
+	if raw, ok := params["sheetName"]; ok && raw != nil {
+		name, err := toString(raw)
+		if err != nil {
+			return cfg, fmt.Errorf("sheetName invalid")
+		}
+		if trimmed := strings.TrimSpace(name); trimmed != "" {
+			cfg.sheetName = trimmed
+		}
+	}
+
+	values, err := parseValues(params["values"])
+	if err != nil {
+		return cfg, err
+	}
+	if len(values) == 0 {
+		return cfg, fmt.Errorf("values cannot be empty")
+	}
+	cfg.values = values
+
+	if raw, ok := params["columns"]; ok && raw != nil {
+		columns, err := toString(raw)
+		if err != nil {
+			return cfg, fmt.Errorf("columns invalid")
+		}
+		for _, column := range strings.Split(columns, ",") {
+			if trimmed := strings.TrimSpace(column); trimmed != "" {
+				cfg.columns = append(cfg.columns, trimmed)
+			}
+		}
+	}
+
+	if raw, ok := params["valueInputOption"]; ok && raw != nil {
+		option, err := toString(raw)
+		if err != nil {
+			return cfg, fmt.Errorf("valueInputOption invalid")
+		}
+		switch strings.ToUpper(strings.TrimSpace(option)) {
+		case "":
+		case "RAW", "USER_ENTERED":
+			cfg.valueInputOption = strings.ToUpper(strings.TrimSpace(option))
+		default:
+			return cfg, fmt.Errorf("valueInputOption %q unsupported", option)
+		}
+	}
+	return cfg, nil
+}
+
+// parseValues accepts one value per line or a list of values, keeping inner blank lines as empty cells
+func parseValues(raw any) ([]string, error) {
+	switch v := raw.(type) {
+	case nil:
+		return nil, fmt.Errorf("values missing")
+	case []any:
+		values := make([]string, len(v))
+		for index, item := range v {
+			values[index] = fmt.Sprint(item)
+		}
+		return values, nil
+	case []string:
+		return append([]string(nil), v...), nil
+	default:
+		text, err := toString(raw)
+		if err != nil {
+			return nil, fmt.Errorf("values invalid")
+		}
+		text = strings.Trim(strings.ReplaceAll(text, "\r\n", "\n"), "\n")
+		if strings.TrimSpace(text) == "" {
+			return nil, nil
+		}
+		return strings.Split(text, "\n"), nil
+	}
+}
+
+func toString(v any) (string, error) {
+	switch val := v.(type) {
+	case string:
+		return val, nil
+	case float64:
+		return fmt.Sprintf("%v", val), nil
+	case bool:
+		return fmt.Sprintf("%v", val), nil
+	default:
+		return "", fmt.Errorf("cannot convert %T to string", v)
+	}
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now().UTC() }
+
+var _ interface {
+	Supports(*componentdomain.Component) bool
+	Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error)
+} = (*Executor)(nil)
@@ -0,0 +1,218 @@
+package gsheets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func TestExecutorSupports(t *testing.T) {
+	exec := NewExecutor(nil, nil, nil, nil, nil)
+	if !exec.Supports(&componentdomain.Component{Name: appendRowComponentName, Provider: componentdomain.Provider{Name: "Google"}}) {
+		t.Fatal("expected component to be supported")
+	}
+	if exec.Supports(nil) {
+		t.Fatal("nil component should not be supported")
+	}
+	if exec.Supports(&componentdomain.Component{Name: "gdrive_upload_file", Provider: componentdomain.Provider{Name: gsheetsProviderName}}) {
+		t.Fatal("unexpected support for different component")
+	}
+}
+
+func TestExecutorAppendsValuesInOrder(t *testing.T) {
+	client := &routingClient{}
+	link, area, repo := appendRowFixture(map[string]any{
+		"spreadsheetId": "sheet-1",
+		"values":        "Ada\nada@example.com\n",
+	})
+	exec := NewExecutor(repo, providerResolverStub{}, client, clockStub{now: time.Now().UTC()}, zap.NewNop())
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if len(client.requests) != 1 {
+		t.Fatalf("expected 1 request got %d", len(client.requests))
+	}
+	request := client.requests[0]
+	if request.method != http.MethodPost {
+		t.Fatalf("unexpected method %s", request.method)
+	}
+	if request.url != "https://sheets.googleapis.com/v4/spreadsheets/sheet-1/values/%27Sheet1%27%21A1:append?insertDataOption=INSERT_ROWS&valueInputOption=USER_ENTERED" {
+		t.Fatalf("unexpected url %s", request.url)
+	}
+	assertValues(t, request.body, []any{"Ada", "ada@example.com"})
+}
+
+func TestExecutorMapsValuesToColumns(t *testing.T) {
+	client := &routingClient{header: `{"values":[["Name","Email","Status"]]}`}
+	link, area, repo := appendRowFixture(map[string]any{
+		"spreadsheetId": "sheet-1",
+		"sheetName":     "Leads",
+		"values":        "new\nada@example.com\nnote",
+		"columns":       "status, email, E",
+	})
+	exec := NewExecutor(repo, providerResolverStub{}, client, clockStub{now: time.Now().UTC()}, zap.NewNop())
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if len(client.requests) != 2 {
+		t.Fatalf("expected header lookup and append, got %d requests", len(client.requests))
+	}
+	if client.requests[0].method != http.MethodGet || !strings.Contains(client.requests[0].url, "%27Leads%27%211:1") {
+		t.Fatalf("unexpected header request %s %s", client.requests[0].method, client.requests[0].url)
+	}
+	assertValues(t, client.requests[1].body, []any{"", "ada@example.com", "new", "", "note"})
+}
+
+func TestExecutorRejectsUnknownColumn(t *testing.T) {
+	client := &routingClient{header: `{"values":[["Name"]]}`}
+	link, area, repo := appendRowFixture(map[string]any{
+		"spreadsheetId": "sheet-1",
+		"values":        "Ada",
+		"columns":       "Full name",
+	})
+	exec := NewExecutor(repo, providerResolverStub{}, client, clockStub{now: time.Now().UTC()}, zap.NewNop())
+
+	if _, err := exec.Execute(context.Background(), area, link); err == nil {
+		t.Fatal("expected unknown column error")
+	}
+	if len(client.requests) != 1 {
+		t.Fatalf("only the header lookup should be issued, got %d requests", len(client.requests))
+	}
+}
+
+func appendRowFixture(params map[string]any) (areadomain.Link, areadomain.Area, *identityRepoStub) {
+	userID := uuid.New()
+	identityID := uuid.New()
+	future := time.Now().Add(time.Hour).UTC()
+	repo := &identityRepoStub{identity: identitydomain.Identity{
+		ID:          identityID,
+		UserID:      userID,
+		Provider:    gsheetsProviderName,
+		AccessToken: "access-token",
+		ExpiresAt:   &future,
+	}}
+	params["identityId"] = identityID.String()
+	link := areadomain.Link{
+		ID:   uuid.New(),
+		Role: areadomain.LinkRoleReaction,
+		Config: componentdomain.Config{
+			Params: params,
+			Component: &componentdomain.Component{
+				Name:     appendRowComponentName,
+				Provider: componentdomain.Provider{Name: gsheetsProviderName},
+			},
+		},
+	}
+	return link, areadomain.Area{ID: uuid.New(), UserID: userID}, repo
+}
+
+func assertValues(t *testing.T, body string, expected []any) {
+	t.Helper()
+	var payload struct {
+		Values [][]any `json:"values"`
+	}
+	if err := json.Unmarshal([]byte(body), &payload); err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	if len(payload.Values) != 1 {
+		t.Fatalf("expected a single row got %v", payload.Values)
+	}
+	want, _ := json.Marshal(expected)
+	got, _ := json.Marshal(payload.Values[0])
+	if string(want) != string(got) {
+		t.Fatalf("unexpected row %s want %s", got, want)
+	}
+}
+
+type recordedRequest struct {
+	method string
+	url    string
+	body   string
+}
+
+type routingClient struct {
+	header   string
+	requests []recordedRequest
+}
+
+func (c *routingClient) Do(req *http.Request) (*http.Response, error) {
+	var body string
+	if req.Body != nil {
+		data, _ := io.ReadAll(req.Body)
+		body = string(data)
+	}
+	c.requests = append(c.requests, recordedRequest{method: req.Method, url: req.URL.String(), body: body})
+	response := "{}"
+	if req.Method == http.MethodGet {
+		response = c.header
+	}
+	return &http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(response)),
+	}, nil
+}
+
+type identityRepoStub struct {
+	identity identitydomain.Identity
+}
+
+func (s *identityRepoStub) Create(context.Context, identitydomain.Identity) (identitydomain.Identity, error) {
+	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) Update(ctx context.Context, identity identitydomain.Identity) error {
+	s.identity = identity
+	return nil
+}
+
+func (s *identityRepoStub) FindByID(ctx context.Context, id uuid.UUID) (identitydomain.Identity, error) {
+	if id != s.identity.ID {
+		return identitydomain.Identity{}, fmt.Errorf("identity not found")
+	}
+	return s.identity, nil
+}
+
+func (s *identityRepoStub) FindByUserAndProvider(context.Context, uuid.UUID, string) (identitydomain.Identity, error) {
+	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) FindByProviderSubject(context.Context, string, string) (identitydomain.Identity, error) {
+	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) ListByUser(context.Context, uuid.UUID) ([]identitydomain.Identity, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) Delete(context.Context, uuid.UUID) error {
+	return fmt.Errorf("not implemented")
+}
+
+type providerResolverStub struct{}
+
+func (providerResolverStub) Provider(string) (identityport.Provider, bool) {
+	return nil, false
+}
+
+type clockStub struct {
+	now time.Time
+}
+
+func (c clockStub) Now() time.Time {
+	return c.now
+}
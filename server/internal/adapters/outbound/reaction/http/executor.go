@@ -53,11 +53,32 @@ func (e Executor) ExecuteReaction(ctx context.Context, area areadomain.Area, lin
 	}
 }
 
-func (e Executor) execHTTPRequest(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+// Preview builds the HTTP request without sending it
+func (e Executor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("reaction.http: component unsupported")
+	}
+	req, bodyBytes, err := e.buildRequest(ctx, area, link)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	url := req.URL.String()
+	return outbound.ReactionResult{
+		Endpoint: url,
+		Request: map[string]any{
+			"method":  req.Method,
+			"url":     url,
+			"headers": copyHeaders(req.Header),
+			"body":    string(bodyBytes),
+		},
+	}, nil
+}
+
+func (e Executor) buildRequest(ctx context.Context, area areadomain.Area, link areadomain.Link) (*http.Request, []byte, error) {
 	params := link.Config.Params
 	url := stringParam(params, "url", "endpoint")
 	if url == "" {
-		return outbound.ReactionResult{}, fmt.Errorf("reaction.http: params.url required")
+		return nil, nil, fmt.Errorf("reaction.http: params.url required")
 	}
 	method := strings.ToUpper(stringParam(params, "method"))
 	if method == "" {
@@ -66,12 +87,12 @@ func (e Executor) execHTTPRequest(ctx context.Context, area areadomain.Area, lin
 
 	bodyBytes, contentType, err := e.buildBody(area, link, params)
 	if err != nil {
-		return outbound.ReactionResult{}, err
+		return nil, nil, err
 	}
 
 	req, err := http.NewRequestWithContext(ctx, method, url, bytes.NewReader(bodyBytes))
 	if err != nil {
-		return outbound.ReactionResult{}, fmt.Errorf("reaction.http: new request: %w", err)
+		return nil, nil, fmt.Errorf("reaction.http: new request: %w", err)
 	}
 	if contentType != "" {
 		req.Header.Set("Content-Type", contentType)
@@ -79,6 +100,16 @@ func (e Executor) execHTTPRequest(ctx context.Context, area areadomain.Area, lin
 	for key, value := range headerMap(params) {
 		req.Header.Set(key, value)
 	}
+	return req, bodyBytes, nil
+}
+
+func (e Executor) execHTTPRequest(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	req, bodyBytes, err := e.buildRequest(ctx, area, link)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	method := req.Method
+	url := req.URL.String()
 
 	start := time.Now()
 	resp, err := e.client().Do(req)
@@ -93,14 +124,8 @@ func (e Executor) execHTTPRequest(ctx context.Context, area areadomain.Area, lin
 	}
 	duration := time.Since(start)
 
-	requestHeaders := map[string][]string{}
-	for key, values := range req.Header {
-		requestHeaders[key] = append([]string(nil), values...)
-	}
-	responseHeaders := map[string][]string{}
-	for key, values := range resp.Header {
-		responseHeaders[key] = append([]string(nil), values...)
-	}
+	requestHeaders := copyHeaders(req.Header)
+	responseHeaders := copyHeaders(resp.Header)
 
 	if resp.StatusCode >= 400 {
 		return outbound.ReactionResult{
@@ -144,6 +169,14 @@ func (e Executor) execHTTPRequest(ctx context.Context, area areadomain.Area, lin
 	}, nil
 }
 
+func copyHeaders(headers http.Header) map[string][]string {
+	copied := make(map[string][]string, len(headers))
+	for key, values := range headers {
+		copied[key] = append([]string(nil), values...)
+	}
+	return copied
+}
+
 func (e Executor) client() *http.Client {
 	if e.Client != nil {
 		return e.Client
@@ -0,0 +1,257 @@
+package linear
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+)
+
+// apiClient bundles the identity lookup and token refresh flow shared by Linear executors
+type apiClient struct {
+	name       string
+	identities identityport.Repository
+	providers  ProviderResolver
+	http       HTTPClient
+	clock      Clock
+}
+
+// graphQLCall describes a single query or mutation sent to the Linear GraphQL API
+type graphQLCall struct {
+	query     string
+	variables map[string]any
+}
+
+func newAPIClient(name string, identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock) apiClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return apiClient{name: name, identities: identities, providers: providers, http: client, clock: clock}
+}
+
+func (c apiClient) configured() bool {
+	return c.identities != nil && c.providers != nil
+}
+
+// resolveIdentity loads the identity bound to the reaction and ensures it carries a usable access token
+func (c apiClient) resolveIdentity(ctx context.Context, area areadomain.Area, identityID uuid.UUID) (identitydomain.Identity, string, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	return c.ensureAccessToken(ctx, identity, false)
+}
+
+func (c apiClient) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
+	now := c.now()
+	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
+		return identity, identity.AccessToken, nil
+	}
+
+	provider, ok := c.providers.Provider(linearProviderName)
+	if !ok {
+		return identity, "", fmt.Errorf("%s: provider %s not configured", c.name, linearProviderName)
+	}
+
+	exchange, err := provider.Refresh(ctx, identity)
+	if err != nil {
+		return identity, "", fmt.Errorf("%s: refresh token: %w", c.name, err)
+	}
+
+	refreshToken := exchange.Token.RefreshToken
+	if refreshToken == "" {
+		refreshToken = identity.RefreshToken
+	}
+	expiresAt := identity.ExpiresAt
+	if !exchange.Token.ExpiresAt.IsZero() {
+		expires := exchange.Token.ExpiresAt.UTC()
+		expiresAt = &expires
+	}
+	scopes := exchange.Token.Scope
+	if len(scopes) == 0 {
+		scopes = identity.Scopes
+	}
+
+	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
+	updated.UpdatedAt = now
+
+	if err := c.identities.Update(ctx, updated); err != nil {
+		return identity, "", fmt.Errorf("%s: update identity: %w", c.name, err)
+	}
+
+	return updated, updated.AccessToken, nil
+}
+
+// session threads the identity and access token across the GraphQL calls issued by a single reaction
+type session struct {
+	api         apiClient
+	identity    identitydomain.Identity
+	accessToken string
+	result      outbound.ReactionResult
+}
+
+// call sends the GraphQL request, refreshing the access token once when Linear rejects the credentials
+// The data object is returned so operations can chain lookups and mutations
+func (s *session) call(ctx context.Context, call graphQLCall) (map[string]any, error) {
+	result, data, unauthorized, err := s.api.send(ctx, s.accessToken, call)
+	if err != nil && unauthorized {
+		s.identity, s.accessToken, err = s.api.ensureAccessToken(ctx, s.identity, true)
+		if err != nil {
+			return nil, err
+		}
+		result, data, unauthorized, err = s.api.send(ctx, s.accessToken, call)
+		if err != nil && unauthorized {
+			s.result = result
+			return data, fmt.Errorf("%s: unauthorized after refresh", s.api.name)
+		}
+	}
+	s.result = result
+	return data, err
+}
+
+// preview describes the request the call would issue with the identity, with the access token redacted
+// The identity is only checked, its token is never refreshed
+func (c apiClient) preview(ctx context.Context, area areadomain.Area, identityID uuid.UUID, call graphQLCall) (outbound.ReactionResult, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	req, bodyBytes, err := c.newRequest(ctx, "<redacted>", call)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	return outbound.ReactionResult{
+		Endpoint: linearGraphQLEndpoint,
+		Request: map[string]any{
+			"method":  http.MethodPost,
+			"url":     linearGraphQLEndpoint,
+			"headers": copyHeaders(req.Header),
+			"body":    string(bodyBytes),
+		},
+	}, nil
+}
+
+func (c apiClient) newRequest(ctx context.Context, accessToken string, call graphQLCall) (*http.Request, []byte, error) {
+	payload := map[string]any{"query": call.query}
+	if len(call.variables) > 0 {
+		payload["variables"] = call.variables
+	}
+	bodyBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: marshal payload: %w", c.name, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, linearGraphQLEndpoint, bytes.NewReader(bodyBytes))
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: build request: %w", c.name, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "AREA-Server")
+	return req, bodyBytes, nil
+}
+
+func (c apiClient) send(ctx context.Context, accessToken string, call graphQLCall) (outbound.ReactionResult, map[string]any, bool, error) {
+	req, bodyBytes, err := c.newRequest(ctx, accessToken, call)
+	if err != nil {
+		return outbound.ReactionResult{}, nil, false, err
+	}
+
+	start := c.now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return outbound.ReactionResult{}, nil, false, fmt.Errorf("%s: request failed: %w", c.name, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	duration := c.now().Sub(start)
+
+	result := outbound.ReactionResult{
+		Endpoint: linearGraphQLEndpoint,
+		Request: map[string]any{
+			"method":  http.MethodPost,
+			"url":     linearGraphQLEndpoint,
+			"headers": copyHeaders(req.Header),
+			"body":    string(bodyBytes),
+		},
+		Response: map[string]any{
+			"body":    string(respBody),
+			"headers": copyHeaders(resp.Header),
+		},
+		StatusCode: &resp.StatusCode,
+		Duration:   duration,
+	}
+
+	var decoded struct {
+		Data   map[string]any `json:"data"`
+		Errors []struct {
+			Message    string         `json:"message"`
+			Extensions map[string]any `json:"extensions"`
+		} `json:"errors"`
+	}
+	decodeErr := json.Unmarshal(respBody, &decoded)
+
+	unauthorized := resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden
+	messages := make([]string, 0, len(decoded.Errors))
+	for _, entry := range decoded.Errors {
+		// Linear reports expired tokens as a GraphQL error with an authentication code
+		if code, _ := entry.Extensions["code"].(string); strings.EqualFold(code, "AUTHENTICATION_ERROR") {
+			unauthorized = true
+		}
+		if trimmed := strings.TrimSpace(entry.Message); trimmed != "" {
+			messages = append(messages, trimmed)
+		}
+	}
+
+	if resp.StatusCode >= 400 {
+		if len(messages) > 0 {
+			return result, decoded.Data, unauthorized, fmt.Errorf("%s: received status %d: %s", c.name, resp.StatusCode, strings.Join(messages, "; "))
+		}
+		return result, decoded.Data, unauthorized, fmt.Errorf("%s: received status %d", c.name, resp.StatusCode)
+	}
+	if decodeErr != nil {
+		return result, nil, false, fmt.Errorf("%s: decode response: %w", c.name, decodeErr)
+	}
+	if len(decoded.Errors) > 0 {
+		if len(messages) == 0 {
+			messages = append(messages, "graphQL errors present")
+		}
+		return result, decoded.Data, unauthorized, fmt.Errorf("%s: %s", c.name, strings.Join(messages, "; "))
+	}
+	return result, decoded.Data, false, nil
+}
+
+func (c apiClient) now() time.Time {
+	if c.clock == nil {
+		return time.Now().UTC()
+	}
+	return c.clock.Now().UTC()
+}
+
+// mutationSucceeded reports whether the payload returned under field carries success true
+func mutationSucceeded(data map[string]any, field string) bool {
+	payload, _ := data[field].(map[string]any)
+	success, _ := payload["success"].(bool)
+	return success
+}
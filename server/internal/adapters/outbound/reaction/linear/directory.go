@@ -0,0 +1,126 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	lookupState   = "workflow state"
+	lookupLabel   = "label"
+	lookupMember  = "member"
+	lookupProject = "project"
+
+	teamDirectoryTTL = 10 * time.Minute
+)
+
+const teamDirectoryQuery = `query TeamDirectory($teamId: String!) {
+  team(id: $teamId) {
+    id
+    states(first: 250) { nodes { id name } }
+    labels(first: 250) { nodes { id name } }
+    members(first: 250) { nodes { id email } }
+    projects(first: 250) { nodes { id name } }
+  }
+  issueLabels(first: 250, filter: { team: { null: true } }) { nodes { id name } }
+}`
+
+// teamDirectory maps lowercase names of a team's states, labels, members and projects to Linear IDs
+type teamDirectory struct {
+	entries   map[string]map[string]string
+	fetchedAt time.Time
+}
+
+func (d teamDirectory) lookup(kind string, name string) (string, bool) {
+	id, ok := d.entries[kind][strings.ToLower(strings.TrimSpace(name))]
+	return id, ok
+}
+
+// directoryCache keeps one directory per team so repeated reactions do not refetch names on every run
+type directoryCache struct {
+	mu    sync.Mutex
+	ttl   time.Duration
+	teams map[string]teamDirectory
+}
+
+func newDirectoryCache(ttl time.Duration) *directoryCache {
+	return &directoryCache{ttl: ttl, teams: map[string]teamDirectory{}}
+}
+
+// resolve returns the ID behind a name, refetching the team directory once when a cached copy misses
+// so states, labels or projects created since the last fetch are found
+func (c *directoryCache) resolve(ctx context.Context, sess *session, teamID string, kind string, name string) (string, error) {
+	now := sess.api.now()
+
+	c.mu.Lock()
+	directory, cached := c.teams[teamID]
+	c.mu.Unlock()
+	fresh := cached && now.Sub(directory.fetchedAt) < c.ttl
+
+	if fresh {
+		if id, ok := directory.lookup(kind, name); ok {
+			return id, nil
+		}
+	}
+
+	directory, err := fetchTeamDirectory(ctx, sess, teamID)
+	if err != nil {
+		return "", err
+	}
+	directory.fetchedAt = now
+
+	c.mu.Lock()
+	c.teams[teamID] = directory
+	c.mu.Unlock()
+
+	if id, ok := directory.lookup(kind, name); ok {
+		return id, nil
+	}
+	return "", fmt.Errorf("%s: %s %q not found in team", sess.api.name, kind, name)
+}
+
+func fetchTeamDirectory(ctx context.Context, sess *session, teamID string) (teamDirectory, error) {
+	data, err := sess.call(ctx, graphQLCall{query: teamDirectoryQuery, variables: map[string]any{"teamId": teamID}})
+	if err != nil {
+		return teamDirectory{}, err
+	}
+	team, ok := data["team"].(map[string]any)
+	if !ok {
+		return teamDirectory{}, fmt.Errorf("%s: team %s not found", sess.api.name, teamID)
+	}
+
+	directory := teamDirectory{entries: map[string]map[string]string{
+		lookupState:   indexNodes(team["states"], "name"),
+		lookupLabel:   indexNodes(data["issueLabels"], "name"),
+		lookupMember:  indexNodes(team["members"], "email"),
+		lookupProject: indexNodes(team["projects"], "name"),
+	}}
+	// Team labels take precedence over workspace labels sharing the same name
+	for name, id := range indexNodes(team["labels"], "name") {
+		directory.entries[lookupLabel][name] = id
+	}
+	return directory, nil
+}
+
+// indexNodes turns a GraphQL connection into a lowercase key to ID map
+func indexNodes(connection any, key string) map[string]string {
+	index := map[string]string{}
+	body, _ := connection.(map[string]any)
+	nodes, _ := body["nodes"].([]any)
+	for _, raw := range nodes {
+		node, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		id, _ := node["id"].(string)
+		name, _ := node[key].(string)
+		if id == "" || strings.TrimSpace(name) == "" {
+			continue
+		}
+		index[strings.ToLower(strings.TrimSpace(name))] = id
+	}
+	return index
+}
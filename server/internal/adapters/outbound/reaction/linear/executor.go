@@ -1,11 +1,9 @@
 package linear
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"strconv"
 	"strings"
@@ -13,7 +11,6 @@ import (
 
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
-	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
 	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
@@ -51,30 +48,18 @@ func (systemClock) Now() time.Time {
 
 // IssueExecutor delivers Linear reactions that create issues
 type IssueExecutor struct {
-	identities identityport.Repository
-	providers  ProviderResolver
-	http       HTTPClient
-	clock      Clock
-	logger     *zap.Logger
+	api    apiClient
+	logger *zap.Logger
 }
 
 // NewIssueExecutor constructs an IssueExecutor from its dependencies
 func NewIssueExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *IssueExecutor {
-	if client == nil {
-		client = http.DefaultClient
-	}
-	if clock == nil {
-		clock = systemClock{}
-	}
 	if logger == nil {
 		logger = zap.NewNop()
 	}
 	return &IssueExecutor{
-		identities: identities,
-		providers:  providers,
-		http:       client,
-		clock:      clock,
-		logger:     logger,
+		api:    newAPIClient("linear.IssueExecutor", identities, providers, client, clock),
+		logger: logger,
 	}
 }
 
@@ -92,7 +77,7 @@ func (e *IssueExecutor) Execute(ctx context.Context, area areadomain.Area, link
 	if !e.Supports(link.Config.Component) {
 		return outbound.ReactionResult{}, fmt.Errorf("linear.IssueExecutor: unsupported component")
 	}
-	if e.identities == nil || e.providers == nil {
+	if !e.api.configured() {
 		return outbound.ReactionResult{}, fmt.Errorf("linear.IssueExecutor: resolver not configured")
 	}
 
@@ -101,189 +86,58 @@ func (e *IssueExecutor) Execute(ctx context.Context, area areadomain.Area, link
 		return outbound.ReactionResult{}, fmt.Errorf("linear.IssueExecutor: %w", err)
 	}
 
-	identity, err := e.identities.FindByID(ctx, cfg.identityID)
-	if err != nil {
-		return outbound.ReactionResult{}, fmt.Errorf("linear.IssueExecutor: identity lookup: %w", err)
-	}
-	if identity.UserID != area.UserID {
-		return outbound.ReactionResult{}, fmt.Errorf("linear.IssueExecutor: identity not owned by user")
-	}
-
-	identity, accessToken, err := e.ensureAccessToken(ctx, identity, false)
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, cfg.identityID)
 	if err != nil {
 		return outbound.ReactionResult{}, err
 	}
 
-	result, unauthorized, err := e.createIssue(ctx, accessToken, cfg)
-	if err != nil && unauthorized {
-		identity, accessToken, err = e.ensureAccessToken(ctx, identity, true)
-		if err != nil {
-			return outbound.ReactionResult{}, err
-		}
-		result, unauthorized, err = e.createIssue(ctx, accessToken, cfg)
-	}
+	sess := &session{api: e.api, identity: identity, accessToken: accessToken}
+	data, err := sess.call(ctx, buildIssueCreateCall(cfg))
 	if err != nil {
-		return result, err
+		return sess.result, err
 	}
-	if unauthorized {
-		return result, fmt.Errorf("linear.IssueExecutor: unauthorized after refresh")
+	if !mutationSucceeded(data, "issueCreate") {
+		return sess.result, fmt.Errorf("linear.IssueExecutor: create issue not successful")
 	}
 
 	e.logger.Info("linear issue created",
 		zap.String("area_id", area.ID.String()),
-		zap.String("identity_id", identity.ID.String()),
+		zap.String("identity_id", sess.identity.ID.String()),
 		zap.String("team_id", cfg.teamID),
 	)
-	return result, nil
+	return sess.result, nil
 }
 
-func (e *IssueExecutor) createIssue(ctx context.Context, accessToken string, cfg issueConfig) (outbound.ReactionResult, bool, error) {
-	payload := map[string]any{
-		"query": "mutation IssueCreate($input: IssueCreateInput!) { issueCreate(input: $input) { success issue { id identifier url } } }",
-		"variables": map[string]any{
-			"input": map[string]any{
-				"teamId": cfg.teamID,
-				"title":  cfg.title,
-			},
-		},
-	}
-
-	input := payload["variables"].(map[string]any)["input"].(map[string]any)
-	description := decorateLinearDescription(cfg.description)
-	if description != "" {
-		input["description"] = description
-	}
-	if cfg.priority != nil {
-		input["priority"] = *cfg.priority
-	}
-
-	bodyBytes, err := json.Marshal(payload)
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("linear.IssueExecutor: marshal payload: %w", err)
+// Preview describes the issue creation request without sending it
+func (e *IssueExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("linear.IssueExecutor: unsupported component")
 	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, linearGraphQLEndpoint, bytes.NewReader(bodyBytes))
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("linear.IssueExecutor: build request: %w", err)
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("linear.IssueExecutor: resolver not configured")
 	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", "AREA-Server")
-
-	start := time.Now()
-	resp, err := e.http.Do(req)
+	cfg, err := parseIssueConfig(link.Config.Params)
 	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("linear.IssueExecutor: request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := io.ReadAll(resp.Body)
-	duration := time.Since(start)
-
-	requestHeaders := copyHeaders(req.Header)
-	responseHeaders := copyHeaders(resp.Header)
-
-	result := outbound.ReactionResult{
-		Endpoint: linearGraphQLEndpoint,
-		Request: map[string]any{
-			"method":  http.MethodPost,
-			"url":     linearGraphQLEndpoint,
-			"headers": requestHeaders,
-			"body":    string(bodyBytes),
-		},
-		Response: map[string]any{
-			"body":    string(respBody),
-			"headers": responseHeaders,
-		},
-		StatusCode: &resp.StatusCode,
-		Duration:   duration,
-	}
-
-	if resp.StatusCode >= 400 {
-		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
-			return result, true, fmt.Errorf("linear.IssueExecutor: received status %d", resp.StatusCode)
-		}
-		return result, false, fmt.Errorf("linear.IssueExecutor: received status %d", resp.StatusCode)
-	}
-
-	var gqlResp struct {
-		Data struct {
-			IssueCreate struct {
-				Success bool `json:"success"`
-			} `json:"issueCreate"`
-		} `json:"data"`
-		Errors []struct {
-			Message string `json:"message"`
-		} `json:"errors"`
-	}
-	if err := json.Unmarshal(respBody, &gqlResp); err != nil {
-		return result, false, fmt.Errorf("linear.IssueExecutor: decode response: %w", err)
-	}
-	if len(gqlResp.Errors) > 0 {
-		messages := make([]string, 0, len(gqlResp.Errors))
-		for _, entry := range gqlResp.Errors {
-			if trimmed := strings.TrimSpace(entry.Message); trimmed != "" {
-				messages = append(messages, trimmed)
-			}
-		}
-		if len(messages) == 0 {
-			messages = append(messages, "graphQL errors present")
-		}
-		return result, false, fmt.Errorf("linear.IssueExecutor: %s", strings.Join(messages, "; "))
-	}
-	if !gqlResp.Data.IssueCreate.Success {
-		return result, false, fmt.Errorf("linear.IssueExecutor: create issue not successful")
+		return outbound.ReactionResult{}, fmt.Errorf("linear.IssueExecutor: %w", err)
 	}
-
-	return result, false, nil
+	return e.api.preview(ctx, area, cfg.identityID, buildIssueCreateCall(cfg))
 }
 
-func (e *IssueExecutor) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
-	now := e.now()
-	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
-		return identity, identity.AccessToken, nil
-	}
-
-	provider, ok := e.providers.Provider(linearProviderName)
-	if !ok {
-		return identity, "", fmt.Errorf("linear.IssueExecutor: provider %s not configured", linearProviderName)
-	}
-
-	exchange, err := provider.Refresh(ctx, identity)
-	if err != nil {
-		return identity, "", fmt.Errorf("linear.IssueExecutor: refresh token: %w", err)
-	}
-
-	refreshToken := exchange.Token.RefreshToken
-	if refreshToken == "" {
-		refreshToken = identity.RefreshToken
+func buildIssueCreateCall(cfg issueConfig) graphQLCall {
+	input := map[string]any{
+		"teamId": cfg.teamID,
+		"title":  cfg.title,
 	}
-	expiresAt := identity.ExpiresAt
-	if !exchange.Token.ExpiresAt.IsZero() {
-		expires := exchange.Token.ExpiresAt.UTC()
-		expiresAt = &expires
-	}
-	scopes := exchange.Token.Scope
-	if len(scopes) == 0 {
-		scopes = identity.Scopes
+	if description := decorateLinearDescription(cfg.description); description != "" {
+		input["description"] = description
 	}
-
-	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
-	updated.UpdatedAt = now
-
-	if err := e.identities.Update(ctx, updated); err != nil {
-		return identity, "", fmt.Errorf("linear.IssueExecutor: update identity: %w", err)
+	if cfg.priority != nil {
+		input["priority"] = *cfg.priority
 	}
-
-	return updated, updated.AccessToken, nil
-}
-
-func (e *IssueExecutor) now() time.Time {
-	if e.clock == nil {
-		return time.Now().UTC()
+	return graphQLCall{
+		query:     "mutation IssueCreate($input: IssueCreateInput!) { issueCreate(input: $input) { success issue { id identifier url } } }",
+		variables: map[string]any{"input": input},
 	}
-	return e.clock.Now().UTC()
 }
 
 type issueConfig struct {
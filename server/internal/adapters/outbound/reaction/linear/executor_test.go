@@ -194,8 +194,10 @@ func (providerResolverStub) Provider(string) (identityport.Provider, bool) {
 
 type httpClientStub struct {
 	response    http.Response
+	responses   []http.Response
 	lastRequest *http.Request
 	lastBody    string
+	bodies      []string
 	err         error
 }
 
@@ -204,12 +206,17 @@ func (c *httpClientStub) Do(req *http.Request) (*http.Response, error) {
 	if req.Body != nil {
 		data, _ := io.ReadAll(req.Body)
 		c.lastBody = string(data)
+		c.bodies = append(c.bodies, c.lastBody)
 		req.Body = io.NopCloser(bytes.NewReader(data))
 	}
 	if c.err != nil {
 		return nil, c.err
 	}
 	resp := c.response
+	if len(c.responses) > 0 {
+		resp = c.responses[0]
+		c.responses = c.responses[1:]
+	}
 	if resp.Body == nil {
 		resp.Body = io.NopCloser(strings.NewReader(`{"data":{"issueCreate":{"success":true}}}`))
 	}
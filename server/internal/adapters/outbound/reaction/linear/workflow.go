@@ -0,0 +1,252 @@
+package linear
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	commentIssueComponentName = "linear_comment_issue"
+	moveIssueComponentName    = "linear_move_issue_state"
+	assignIssueComponentName  = "linear_assign_issue"
+	addLabelsComponentName    = "linear_add_labels"
+	linkProjectComponentName  = "linear_link_project"
+)
+
+const issueLookupQuery = "query Issue($id: String!) { issue(id: $id) { id identifier team { id } } }"
+
+const issueUpdateMutation = "mutation IssueUpdate($id: String!, $input: IssueUpdateInput!) { issueUpdate(id: $id, input: $input) { success issue { id identifier url } } }"
+
+// nameLookup is a name that must be resolved to a Linear ID within the issue's team
+type nameLookup struct {
+	kind string
+	name string
+}
+
+// workflowPlan describes a mutation applied to an existing issue once its team names are resolved
+type workflowPlan struct {
+	identityID uuid.UUID
+	issue      string
+	lookups    []nameLookup
+	mutation   string
+	build      func(issueID string, ids []string) graphQLCall
+}
+
+type workflowOperation func(params map[string]any) (workflowPlan, error)
+
+var workflowOperations = map[string]workflowOperation{
+	commentIssueComponentName: planCommentIssue,
+	moveIssueComponentName:    planMoveIssueState,
+	assignIssueComponentName:  planAssignIssue,
+	addLabelsComponentName:    planAddLabels,
+	linkProjectComponentName:  planLinkProject,
+}
+
+// WorkflowExecutor delivers Linear reactions that comment on, move, assign, label and link existing issues
+type WorkflowExecutor struct {
+	api       apiClient
+	directory *directoryCache
+	logger    *zap.Logger
+}
+
+// NewWorkflowExecutor constructs a WorkflowExecutor from its dependencies
+func NewWorkflowExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *WorkflowExecutor {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &WorkflowExecutor{
+		api:       newAPIClient("linear.WorkflowExecutor", identities, providers, client, clock),
+		directory: newDirectoryCache(teamDirectoryTTL),
+		logger:    logger,
+	}
+}
+
+// Supports reports whether the executor can handle the provided component
+func (e *WorkflowExecutor) Supports(component *componentdomain.Component) bool {
+	if component == nil || !strings.EqualFold(component.Provider.Name, linearProviderName) {
+		return false
+	}
+	_, ok := workflowOperations[strings.ToLower(component.Name)]
+	return ok
+}
+
+// Execute resolves the issue and the names it references, then applies the mutation selected by the component
+func (e *WorkflowExecutor) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("linear.WorkflowExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("linear.WorkflowExecutor: resolver not configured")
+	}
+
+	component := link.Config.Component
+	plan, err := workflowOperations[strings.ToLower(component.Name)](link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("linear.WorkflowExecutor: %w", err)
+	}
+
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, plan.identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	sess := &session{api: e.api, identity: identity, accessToken: accessToken}
+
+	// Identifiers such as ENG-123 are accepted, the lookup returns the UUID and the owning team
+	data, err := sess.call(ctx, graphQLCall{query: issueLookupQuery, variables: map[string]any{"id": plan.issue}})
+	if err != nil {
+		return sess.result, err
+	}
+	issue, _ := data["issue"].(map[string]any)
+	issueID, _ := issue["id"].(string)
+	team, _ := issue["team"].(map[string]any)
+	teamID, _ := team["id"].(string)
+	if issueID == "" {
+		return sess.result, fmt.Errorf("linear.WorkflowExecutor: issue %s not found", plan.issue)
+	}
+
+	ids := make([]string, 0, len(plan.lookups))
+	for _, lookup := range plan.lookups {
+		id, err := e.directory.resolve(ctx, sess, teamID, lookup.kind, lookup.name)
+		if err != nil {
+			return sess.result, err
+		}
+		ids = append(ids, id)
+	}
+
+	data, err = sess.call(ctx, plan.build(issueID, ids))
+	if err != nil {
+		return sess.result, err
+	}
+	if !mutationSucceeded(data, plan.mutation) {
+		return sess.result, fmt.Errorf("linear.WorkflowExecutor: %s not successful", plan.mutation)
+	}
+
+	e.logger.Info("linear reaction delivered",
+		zap.String("area_id", area.ID.String()),
+		zap.String("identity_id", sess.identity.ID.String()),
+		zap.String("component", component.Name),
+		zap.String("issue", plan.issue),
+	)
+	return sess.result, nil
+}
+
+func planCommentIssue(params map[string]any) (workflowPlan, error) {
+	plan, err := newWorkflowPlan(params)
+	if err != nil {
+		return workflowPlan{}, err
+	}
+	body, err := requiredTrimmedString(params, "body")
+	if err != nil {
+		return workflowPlan{}, err
+	}
+	plan.mutation = "commentCreate"
+	plan.build = func(issueID string, _ []string) graphQLCall {
+		return graphQLCall{
+			query:     "mutation CommentCreate($input: CommentCreateInput!) { commentCreate(input: $input) { success comment { id url } } }",
+			variables: map[string]any{"input": map[string]any{"issueId": issueID, "body": body}},
+		}
+	}
+	return plan, nil
+}
+
+func planMoveIssueState(params map[string]any) (workflowPlan, error) {
+	return planSingleLookupUpdate(params, "stateName", lookupState, "stateId")
+}
+
+func planAssignIssue(params map[string]any) (workflowPlan, error) {
+	return planSingleLookupUpdate(params, "email", lookupMember, "assigneeId")
+}
+
+func planLinkProject(params map[string]any) (workflowPlan, error) {
+	return planSingleLookupUpdate(params, "projectName", lookupProject, "projectId")
+}
+
+func planAddLabels(params map[string]any) (workflowPlan, error) {
+	plan, err := newWorkflowPlan(params)
+	if err != nil {
+		return workflowPlan{}, err
+	}
+	raw, err := requiredTrimmedString(params, "labels")
+	if err != nil {
+		return workflowPlan{}, err
+	}
+	seen := map[string]struct{}{}
+	for _, part := range strings.Split(raw, ",") {
+		name := strings.TrimSpace(part)
+		if name == "" {
+			continue
+		}
+		if _, ok := seen[strings.ToLower(name)]; ok {
+			continue
+		}
+		seen[strings.ToLower(name)] = struct{}{}
+		plan.lookups = append(plan.lookups, nameLookup{kind: lookupLabel, name: name})
+	}
+	if len(plan.lookups) == 0 {
+		return workflowPlan{}, fmt.Errorf("parse issue config: labels empty")
+	}
+	plan.mutation = "issueUpdate"
+	plan.build = func(issueID string, ids []string) graphQLCall {
+		// addedLabelIds keeps the labels already on the issue
+		return issueUpdateCall(issueID, map[string]any{"addedLabelIds": ids})
+	}
+	return plan, nil
+}
+
+// planSingleLookupUpdate resolves the name held by param and sets the resulting ID on field
+func planSingleLookupUpdate(params map[string]any, param string, kind string, field string) (workflowPlan, error) {
+	plan, err := newWorkflowPlan(params)
+	if err != nil {
+		return workflowPlan{}, err
+	}
+	name, err := requiredTrimmedString(params, param)
+	if err != nil {
+		return workflowPlan{}, err
+	}
+	plan.lookups = []nameLookup{{kind: kind, name: name}}
+	plan.mutation = "issueUpdate"
+	plan.build = func(issueID string, ids []string) graphQLCall {
+		return issueUpdateCall(issueID, map[string]any{field: ids[0]})
+	}
+	return plan, nil
+}
+
+func newWorkflowPlan(params map[string]any) (workflowPlan, error) {
+	if params == nil {
+		return workflowPlan{}, fmt.Errorf("parse issue config: params missing")
+	}
+	rawIdentity, err := requiredTrimmedString(params, "identityId")
+	if err != nil {
+		return workflowPlan{}, err
+	}
+	identityID, err := uuid.Parse(rawIdentity)
+	if err != nil {
+		return workflowPlan{}, fmt.Errorf("parse issue config: identityId parse: %w", err)
+	}
+	issue, err := requiredTrimmedString(params, "issueId")
+	if err != nil {
+		return workflowPlan{}, err
+	}
+	return workflowPlan{identityID: identityID, issue: issue}, nil
+}
+
+func issueUpdateCall(issueID string, input map[string]any) graphQLCall {
+	return graphQLCall{
+		query:     issueUpdateMutation,
+		variables: map[string]any{"id": issueID, "input": input},
+	}
+}
+
+// Ensure WorkflowExecutor satisfies the ComponentReactionHandler contract
+var _ interface {
+	Supports(*componentdomain.Component) bool
+	Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error)
+} = (*WorkflowExecutor)(nil)
@@ -0,0 +1,202 @@
+package linear
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/google/uuid"
+)
+
+const (
+	issueLookupResponse   = `{"data":{"issue":{"id":"issue-uuid","identifier":"ENG-42","team":{"id":"team-1"}}}}`
+	teamDirectoryResponse = `{"data":{
+		"team":{"id":"team-1",
+			"states":{"nodes":[{"id":"state-todo","name":"Todo"},{"id":"state-review","name":"In Review"}]},
+			"labels":{"nodes":[{"id":"label-bug","name":"Bug"}]},
+			"members":{"nodes":[{"id":"user-ada","email":"Ada@example.com"}]},
+			"projects":{"nodes":[{"id":"project-q3","name":"Q3 Launch"}]}},
+		"issueLabels":{"nodes":[{"id":"label-ws-bug","name":"Bug"},{"id":"label-urgent","name":"Urgent"}]}}}`
+	issueUpdateResponse = `{"data":{"issueUpdate":{"success":true}}}`
+)
+
+func linearJSON(body string) http.Response {
+	return http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func workflowFixture(t *testing.T, componentName string, params map[string]any) (*WorkflowExecutor, *httpClientStub, areadomain.Area, areadomain.Link) {
+	t.Helper()
+	userID := uuid.New()
+	identityID := uuid.New()
+	now := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Hour)
+	repo := &identityRepoStub{identity: identitydomain.Identity{ID: identityID, UserID: userID, Provider: linearProviderName, AccessToken: "access-token", ExpiresAt: &expires}}
+	client := &httpClientStub{}
+	exec := NewWorkflowExecutor(repo, providerResolverStub{}, client, clockStub{now: now}, nil)
+
+	params["identityId"] = identityID.String()
+	params["issueId"] = "ENG-42"
+	link := areadomain.Link{
+		ID:   uuid.New(),
+		Role: areadomain.LinkRoleReaction,
+		Config: componentdomain.Config{
+			Params: params,
+			Component: &componentdomain.Component{
+				Name:     componentName,
+				Provider: componentdomain.Provider{Name: linearProviderName},
+			},
+		},
+	}
+	return exec, client, areadomain.Area{ID: uuid.New(), UserID: userID}, link
+}
+
+func decodeVariables(t *testing.T, body string) map[string]any {
+	t.Helper()
+	var payload struct {
+		Variables map[string]any `json:"variables"`
+	}
+	if err := json.Unmarshal([]byte(body), &payload); err != nil {
+		t.Fatalf("decode body %s: %v", body, err)
+	}
+	return payload.Variables
+}
+
+func TestWorkflowExecutorSupports(t *testing.T) {
+	exec := NewWorkflowExecutor(nil, nil, nil, nil, nil)
+	for name := range workflowOperations {
+		if !exec.Supports(&componentdomain.Component{Name: name, Provider: componentdomain.Provider{Name: "Linear"}}) {
+			t.Fatalf("expected %s to be supported", name)
+		}
+	}
+	if exec.Supports(&componentdomain.Component{Name: createIssueComponentName, Provider: componentdomain.Provider{Name: linearProviderName}}) {
+		t.Fatal("issue creation belongs to the issue executor")
+	}
+}
+
+func TestWorkflowExecutorCommentsOnIssue(t *testing.T) {
+	exec, client, area, link := workflowFixture(t, commentIssueComponentName, map[string]any{"body": "Deployed to staging"})
+	client.responses = []http.Response{
+		linearJSON(issueLookupResponse),
+		linearJSON(`{"data":{"commentCreate":{"success":true}}}`),
+	}
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if len(client.bodies) != 2 {
+		t.Fatalf("expected lookup and mutation, got %d requests", len(client.bodies))
+	}
+	if id := decodeVariables(t, client.bodies[0])["id"]; id != "ENG-42" {
+		t.Fatalf("expected identifier lookup, got %v", id)
+	}
+	input := decodeVariables(t, client.bodies[1])["input"].(map[string]any)
+	if input["issueId"] != "issue-uuid" || input["body"] != "Deployed to staging" {
+		t.Fatalf("unexpected comment input %v", input)
+	}
+}
+
+func TestWorkflowExecutorResolvesNamesAndCachesTeamDirectory(t *testing.T) {
+	exec, client, area, link := workflowFixture(t, moveIssueComponentName, map[string]any{"stateName": "in review"})
+	client.responses = []http.Response{
+		linearJSON(issueLookupResponse),
+		linearJSON(teamDirectoryResponse),
+		linearJSON(issueUpdateResponse),
+		linearJSON(issueLookupResponse),
+		linearJSON(issueUpdateResponse),
+	}
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if teamID := decodeVariables(t, client.bodies[1])["teamId"]; teamID != "team-1" {
+		t.Fatalf("expected directory lookup for the issue team, got %v", teamID)
+	}
+	update := decodeVariables(t, client.bodies[2])
+	if update["id"] != "issue-uuid" || update["input"].(map[string]any)["stateId"] != "state-review" {
+		t.Fatalf("unexpected update %v", update)
+	}
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("second Execute returned error: %v", err)
+	}
+	if len(client.bodies) != 5 {
+		t.Fatalf("expected the cached directory to be reused, got %d requests", len(client.bodies))
+	}
+}
+
+func TestWorkflowExecutorAssignsAddsLabelsAndLinksProject(t *testing.T) {
+	cases := []struct {
+		component string
+		params    map[string]any
+		field     string
+		expected  any
+	}{
+		{assignIssueComponentName, map[string]any{"email": "ada@example.com"}, "assigneeId", "user-ada"},
+		{linkProjectComponentName, map[string]any{"projectName": "Q3 launch"}, "projectId", "project-q3"},
+		{addLabelsComponentName, map[string]any{"labels": "bug, Urgent, BUG"}, "addedLabelIds", []any{"label-bug", "label-urgent"}},
+	}
+	for _, tc := range cases {
+		exec, client, area, link := workflowFixture(t, tc.component, tc.params)
+		client.responses = []http.Response{
+			linearJSON(issueLookupResponse),
+			linearJSON(teamDirectoryResponse),
+			linearJSON(issueUpdateResponse),
+		}
+		if _, err := exec.Execute(context.Background(), area, link); err != nil {
+			t.Fatalf("%s: Execute returned error: %v", tc.component, err)
+		}
+		input := decodeVariables(t, client.bodies[2])["input"].(map[string]any)
+		encoded, _ := json.Marshal(input[tc.field])
+		expected, _ := json.Marshal(tc.expected)
+		if string(encoded) != string(expected) {
+			t.Fatalf("%s: expected %s=%s got %s", tc.component, tc.field, expected, encoded)
+		}
+	}
+}
+
+func TestWorkflowExecutorRefetchesDirectoryOnMiss(t *testing.T) {
+	exec, client, area, link := workflowFixture(t, moveIssueComponentName, map[string]any{"stateName": "Shipped"})
+	client.responses = []http.Response{
+		linearJSON(issueLookupResponse),
+		linearJSON(teamDirectoryResponse),
+	}
+
+	_, err := exec.Execute(context.Background(), area, link)
+	if err == nil || !strings.Contains(err.Error(), `workflow state "Shipped" not found`) {
+		t.Fatalf("expected unknown state error, got %v", err)
+	}
+
+	link.Config.Params["stateName"] = "Todo"
+	client.responses = []http.Response{
+		linearJSON(issueLookupResponse),
+		linearJSON(issueUpdateResponse),
+	}
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if len(client.bodies) != 4 {
+		t.Fatalf("expected cached hit without refetch, got %d requests", len(client.bodies))
+	}
+}
+
+func TestWorkflowExecutorSurfacesGraphQLErrors(t *testing.T) {
+	exec, client, area, link := workflowFixture(t, commentIssueComponentName, map[string]any{"body": "hello"})
+	client.responses = []http.Response{
+		linearJSON(`{"data":{"issue":null},"errors":[{"message":"Entity not found: Issue"}]}`),
+	}
+
+	if _, err := exec.Execute(context.Background(), area, link); err == nil || !strings.Contains(err.Error(), "Entity not found") {
+		t.Fatalf("expected GraphQL error, got %v", err)
+	}
+}
@@ -0,0 +1,227 @@
+package notion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+)
+
+const notionAPIBaseURL = "https://api.notion.com/v1"
+
+// apiClient bundles the identity lookup and token refresh flow shared by Notion executors
+type apiClient struct {
+	name       string
+	identities identityport.Repository
+	providers  ProviderResolver
+	http       HTTPClient
+	clock      Clock
+}
+
+// apiCall describes a single Notion REST request issued by a reaction
+type apiCall struct {
+	method   string
+	endpoint string
+	payload  []byte
+}
+
+func newAPIClient(name string, identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock) apiClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return apiClient{name: name, identities: identities, providers: providers, http: client, clock: clock}
+}
+
+func (c apiClient) configured() bool {
+	return c.identities != nil && c.providers != nil
+}
+
+// resolveIdentity loads the identity bound to the reaction and ensures it carries a usable access token
+func (c apiClient) resolveIdentity(ctx context.Context, area areadomain.Area, identityID uuid.UUID) (identitydomain.Identity, string, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	return c.ensureAccessToken(ctx, identity, false)
+}
+
+func (c apiClient) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
+	now := c.now()
+	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
+		return identity, identity.AccessToken, nil
+	}
+
+	provider, ok := c.providers.Provider(notionProviderName)
+	if !ok {
+		return identity, "", fmt.Errorf("%s: provider %s not configured", c.name, notionProviderName)
+	}
+
+	exchange, err := provider.Refresh(ctx, identity)
+	if err != nil {
+		return identity, "", fmt.Errorf("%s: refresh token: %w", c.name, err)
+	}
+
+	refreshToken := exchange.Token.RefreshToken
+	if refreshToken == "" {
+		refreshToken = identity.RefreshToken
+	}
+	expiresAt := identity.ExpiresAt
+	if !exchange.Token.ExpiresAt.IsZero() {
+		expires := exchange.Token.ExpiresAt.UTC()
+		expiresAt = &expires
+	}
+	scopes := exchange.Token.Scope
+	if len(scopes) == 0 {
+		scopes = identity.Scopes
+	}
+
+	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
+	updated.UpdatedAt = now
+
+	if err := c.identities.Update(ctx, updated); err != nil {
+		return identity, "", fmt.Errorf("%s: update identity: %w", c.name, err)
+	}
+
+	return updated, updated.AccessToken, nil
+}
+
+// session threads the identity and access token across the Notion calls issued by a single reaction
+type session struct {
+	api         apiClient
+	identity    identitydomain.Identity
+	accessToken string
+	result      outbound.ReactionResult
+}
+
+// call sends the request, refreshing the access token once when Notion rejects the credentials
+// The decoded response is returned so operations can chain calls on identifiers from earlier responses
+func (s *session) call(ctx context.Context, call apiCall) (map[string]any, error) {
+	result, body, unauthorized, err := s.api.send(ctx, s.accessToken, call)
+	if err != nil && unauthorized {
+		s.identity, s.accessToken, err = s.api.ensureAccessToken(ctx, s.identity, true)
+		if err != nil {
+			return nil, err
+		}
+		result, body, unauthorized, err = s.api.send(ctx, s.accessToken, call)
+		if err != nil && unauthorized {
+			s.result = result
+			return body, fmt.Errorf("%s: unauthorized after refresh", s.api.name)
+		}
+	}
+	s.result = result
+	return body, err
+}
+
+// preview describes the request the call would issue with the identity, with the access token redacted
+// The identity is only checked, its token is never refreshed
+func (c apiClient) preview(ctx context.Context, area areadomain.Area, identityID uuid.UUID, call apiCall) (outbound.ReactionResult, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	req, err := c.newRequest(ctx, "<redacted>", call)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	return outbound.ReactionResult{
+		Endpoint: call.endpoint,
+		Request: map[string]any{
+			"method":  call.method,
+			"url":     call.endpoint,
+			"headers": copyHeaders(req.Header),
+			"body":    string(call.payload),
+		},
+	}, nil
+}
+
+func (c apiClient) newRequest(ctx context.Context, accessToken string, call apiCall) (*http.Request, error) {
+	var body io.Reader
+	if call.payload != nil {
+		body = bytes.NewReader(call.payload)
+	}
+	req, err := http.NewRequestWithContext(ctx, call.method, call.endpoint, body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: build request: %w", c.name, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	if call.payload != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", "AREA-Server")
+	req.Header.Set("Notion-Version", notionVersionHeader)
+	return req, nil
+}
+
+func (c apiClient) send(ctx context.Context, accessToken string, call apiCall) (outbound.ReactionResult, map[string]any, bool, error) {
+	req, err := c.newRequest(ctx, accessToken, call)
+	if err != nil {
+		return outbound.ReactionResult{}, nil, false, err
+	}
+
+	start := c.now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return outbound.ReactionResult{}, nil, false, fmt.Errorf("%s: request failed: %w", c.name, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	duration := c.now().Sub(start)
+
+	result := outbound.ReactionResult{
+		Endpoint: call.endpoint,
+		Request: map[string]any{
+			"method":  call.method,
+			"url":     call.endpoint,
+			"headers": copyHeaders(req.Header),
+			"body":    string(call.payload),
+		},
+		Response: map[string]any{
+			"body":    string(respBody),
+			"headers": copyHeaders(resp.Header),
+		},
+		StatusCode: &resp.StatusCode,
+		Duration:   duration,
+	}
+
+	var decoded map[string]any
+	if len(respBody) > 0 {
+		_ = json.Unmarshal(respBody, &decoded)
+	}
+
+	if resp.StatusCode >= 400 {
+		unauthorized := resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden
+		if message, ok := decoded["message"].(string); ok && strings.TrimSpace(message) != "" {
+			return result, decoded, unauthorized, fmt.Errorf("%s: received status %d: %s", c.name, resp.StatusCode, strings.TrimSpace(message))
+		}
+		return result, decoded, unauthorized, fmt.Errorf("%s: received status %d", c.name, resp.StatusCode)
+	}
+	return result, decoded, false, nil
+}
+
+func (c apiClient) now() time.Time {
+	if c.clock == nil {
+		return time.Now().UTC()
+	}
+	return c.clock.Now().UTC()
+}
@@ -0,0 +1,360 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	upsertRowComponentName      = "notion_upsert_database_row"
+	updatePropertyComponentName = "notion_update_page_property"
+	appendBlockComponentName    = "notion_append_block"
+	notionMaxAppendedBlocks     = 100
+)
+
+var notionBlockTypes = map[string]struct{}{
+	"paragraph":          {},
+	"heading_1":          {},
+	"heading_2":          {},
+	"heading_3":          {},
+	"bulleted_list_item": {},
+	"numbered_list_item": {},
+	"to_do":              {},
+	"quote":              {},
+	"code":               {},
+}
+
+// databasePlan describes the target of a Notion reaction and the calls that perform it
+// call is set when the plan issues a single request known upfront, which makes it previewable
+type databasePlan struct {
+	identityID uuid.UUID
+	target     string
+	call       *apiCall
+	run        func(ctx context.Context, sess *session) error
+}
+
+type databaseOperation func(params map[string]any) (databasePlan, error)
+
+var databaseOperations = map[string]databaseOperation{
+	upsertRowComponentName:      planUpsertRow,
+	updatePropertyComponentName: planUpdatePageProperty,
+	appendBlockComponentName:    planAppendBlock,
+}
+
+// DatabaseExecutor delivers Notion reactions that upsert database rows, update page properties and append blocks
+type DatabaseExecutor struct {
+	api    apiClient
+	logger *zap.Logger
+}
+
+// NewDatabaseExecutor constructs a DatabaseExecutor from its dependencies
+func NewDatabaseExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *DatabaseExecutor {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &DatabaseExecutor{
+		api:    newAPIClient("notion.DatabaseExecutor", identities, providers, client, clock),
+		logger: logger,
+	}
+}
+
+// Supports reports whether the executor can handle the provided component
+func (e *DatabaseExecutor) Supports(component *componentdomain.Component) bool {
+	if component == nil || !strings.EqualFold(component.Provider.Name, notionProviderName) {
+		return false
+	}
+	_, ok := databaseOperations[strings.ToLower(component.Name)]
+	return ok
+}
+
+// Execute performs the Notion operation selected by the component using the linked identity
+func (e *DatabaseExecutor) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("notion.DatabaseExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("notion.DatabaseExecutor: resolver not configured")
+	}
+
+	component := link.Config.Component
+	plan, err := databaseOperations[strings.ToLower(component.Name)](link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("notion.DatabaseExecutor: %w", err)
+	}
+
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, plan.identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+
+	sess := &session{api: e.api, identity: identity, accessToken: accessToken}
+	if err := plan.run(ctx, sess); err != nil {
+		return sess.result, err
+	}
+
+	e.logger.Info("notion reaction delivered",
+		zap.String("area_id", area.ID.String()),
+		zap.String("identity_id", sess.identity.ID.String()),
+		zap.String("component", component.Name),
+		zap.String("target", plan.target),
+	)
+	return sess.result, nil
+}
+
+// Preview describes the request of the Notion operation without sending it
+// Upserting rows and updating properties read Notion before writing and cannot be previewed
+func (e *DatabaseExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("notion.DatabaseExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("notion.DatabaseExecutor: resolver not configured")
+	}
+	plan, err := databaseOperations[strings.ToLower(link.Config.Component.Name)](link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("notion.DatabaseExecutor: %w", err)
+	}
+	if plan.call == nil {
+		return outbound.ReactionResult{}, outbound.ErrPreviewUnsupported
+	}
+	return e.api.preview(ctx, area, plan.identityID, *plan.call)
+}
+
+// planUpsertRow reads the database schema, looks up a row by the match property and updates it or creates a new row
+func planUpsertRow(params map[string]any) (databasePlan, error) {
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return databasePlan{}, err
+	}
+	databaseID, err := requiredString(params, "databaseId")
+	if err != nil {
+		return databasePlan{}, err
+	}
+	matchProperty, err := requiredString(params, "matchProperty")
+	if err != nil {
+		return databasePlan{}, err
+	}
+	matchValue, err := requiredString(params, "matchValue")
+	if err != nil {
+		return databasePlan{}, err
+	}
+	values, err := parsePropertyValues(params["properties"])
+	if err != nil {
+		return databasePlan{}, fmt.Errorf("parse page config: properties invalid: %w", err)
+	}
+
+	return databasePlan{
+		identityID: identityID,
+		target:     databaseID,
+		run: func(ctx context.Context, sess *session) error {
+			database, err := sess.call(ctx, apiCall{method: http.MethodGet, endpoint: databaseEndpoint(databaseID)})
+			if err != nil {
+				return err
+			}
+			schema := parseSchema(database)
+			property, kind, ok := schema.lookup(matchProperty)
+			if !ok {
+				return fmt.Errorf("%s: match property %q not found in database", sess.api.name, matchProperty)
+			}
+
+			filter, err := encodeFilter(property, kind, matchValue)
+			if err != nil {
+				return fmt.Errorf("%s: match property %q: %w", sess.api.name, property, err)
+			}
+			query, err := json.Marshal(map[string]any{"filter": filter, "page_size": 1})
+			if err != nil {
+				return fmt.Errorf("%s: marshal query: %w", sess.api.name, err)
+			}
+			matches, err := sess.call(ctx, apiCall{method: http.MethodPost, endpoint: databaseEndpoint(databaseID) + "/query", payload: query})
+			if err != nil {
+				return err
+			}
+
+			row := map[string]any{}
+			for name, value := range values {
+				row[name] = value
+			}
+			existing := firstResultID(matches)
+			if existing == "" && !hasKeyFold(row, property) {
+				// New rows carry the match value so the next run finds them
+				row[property] = matchValue
+			}
+			properties, err := schema.encodeProperties(row)
+			if err != nil {
+				return fmt.Errorf("%s: %w", sess.api.name, err)
+			}
+
+			if existing != "" {
+				payload, err := json.Marshal(map[string]any{"properties": properties})
+				if err != nil {
+					return fmt.Errorf("%s: marshal payload: %w", sess.api.name, err)
+				}
+				_, err = sess.call(ctx, apiCall{method: http.MethodPatch, endpoint: pageEndpoint(existing), payload: payload})
+				return err
+			}
+			payload, err := json.Marshal(map[string]any{
+				"parent":     map[string]any{"database_id": databaseID},
+				"properties": properties,
+			})
+			if err != nil {
+				return fmt.Errorf("%s: marshal payload: %w", sess.api.name, err)
+			}
+			_, err = sess.call(ctx, apiCall{method: http.MethodPost, endpoint: notionCreatePageEndpoint, payload: payload})
+			return err
+		},
+	}, nil
+}
+
+// planUpdatePageProperty reads the page to learn its property types and patches the provided values
+func planUpdatePageProperty(params map[string]any) (databasePlan, error) {
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return databasePlan{}, err
+	}
+	pageID, err := requiredString(params, "pageId")
+	if err != nil {
+		return databasePlan{}, err
+	}
+	values, err := parsePropertyValues(params["properties"])
+	if err != nil {
+		return databasePlan{}, fmt.Errorf("parse page config: properties invalid: %w", err)
+	}
+	if property, err := optionalString(params, "property"); err != nil {
+		return databasePlan{}, fmt.Errorf("parse page config: property invalid: %w", err)
+	} else if property != "" {
+		values[property] = params["value"]
+	}
+	if len(values) == 0 {
+		return databasePlan{}, fmt.Errorf("parse page config: properties missing")
+	}
+
+	return databasePlan{
+		identityID: identityID,
+		target:     pageID,
+		run: func(ctx context.Context, sess *session) error {
+			page, err := sess.call(ctx, apiCall{method: http.MethodGet, endpoint: pageEndpoint(pageID)})
+			if err != nil {
+				return err
+			}
+			properties, err := parseSchema(page).encodeProperties(values)
+			if err != nil {
+				return fmt.Errorf("%s: %w", sess.api.name, err)
+			}
+			payload, err := json.Marshal(map[string]any{"properties": properties})
+			if err != nil {
+				return fmt.Errorf("%s: marshal payload: %w", sess.api.name, err)
+			}
+			_, err = sess.call(ctx, apiCall{method: http.MethodPatch, endpoint: pageEndpoint(pageID), payload: payload})
+			return err
+		},
+	}, nil
+}
+
+// planAppendBlock appends one block of the selected type per non-empty line of content
+func planAppendBlock(params map[string]any) (databasePlan, error) {
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return databasePlan{}, err
+	}
+	pageID, err := requiredString(params, "pageId")
+	if err != nil {
+		return databasePlan{}, err
+	}
+	content, err := requiredString(params, "content")
+	if err != nil {
+		return databasePlan{}, err
+	}
+	blockType, err := optionalString(params, "blockType")
+	if err != nil {
+		return databasePlan{}, fmt.Errorf("parse page config: blockType invalid: %w", err)
+	}
+	blockType = strings.ToLower(firstNonEmpty(blockType, "paragraph"))
+	if _, ok := notionBlockTypes[blockType]; !ok {
+		return databasePlan{}, fmt.Errorf("parse page config: blockType %q not supported", blockType)
+	}
+
+	var children []map[string]any
+	if blockType == "code" {
+		children = append(children, textBlock(blockType, content))
+	} else {
+		for _, line := range strings.Split(content, "\n") {
+			if trimmed := strings.TrimSpace(line); trimmed != "" {
+				children = append(children, textBlock(blockType, trimmed))
+			}
+		}
+	}
+	if len(children) > notionMaxAppendedBlocks {
+		return databasePlan{}, fmt.Errorf("parse page config: content exceeds %d blocks", notionMaxAppendedBlocks)
+	}
+	payload, err := json.Marshal(map[string]any{"children": children})
+	if err != nil {
+		return databasePlan{}, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	call := apiCall{method: http.MethodPatch, endpoint: notionAPIBaseURL + "/blocks/" + url.PathEscape(pageID) + "/children", payload: payload}
+	return databasePlan{
+		identityID: identityID,
+		target:     pageID,
+		call:       &call,
+		run: func(ctx context.Context, sess *session) error {
+			_, err := sess.call(ctx, call)
+			return err
+		},
+	}, nil
+}
+
+func parseIdentityID(params map[string]any) (uuid.UUID, error) {
+	raw, err := requiredString(params, "identityId")
+	if err != nil {
+		return uuid.Nil, err
+	}
+	identityID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("parse page config: parse identityId: %w", err)
+	}
+	return identityID, nil
+}
+
+func databaseEndpoint(databaseID string) string {
+	return notionAPIBaseURL + "/databases/" + url.PathEscape(databaseID)
+}
+
+func pageEndpoint(pageID string) string {
+	return notionAPIBaseURL + "/pages/" + url.PathEscape(pageID)
+}
+
+func firstResultID(body map[string]any) string {
+	results, _ := body["results"].([]any)
+	if len(results) == 0 {
+		return ""
+	}
+	first, _ := results[0].(map[string]any)
+	id, _ := first["id"].(string)
+	return id
+}
+
+func hasKeyFold(values map[string]any, name string) bool {
+	for key := range values {
+		if strings.EqualFold(key, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Ensure DatabaseExecutor satisfies the ComponentReactionHandler contract
+var _ interface {
+	Supports(*componentdomain.Component) bool
+	Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error)
+} = (*DatabaseExecutor)(nil)
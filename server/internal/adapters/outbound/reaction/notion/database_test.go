@@ -0,0 +1,244 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/google/uuid"
+)
+
+const crmSchema = `{"object":"database","properties":{
+	"Name":{"id":"title","type":"title"},
+	"Email":{"id":"a","type":"email"},
+	"Stage":{"id":"b","type":"select"},
+	"Tags":{"id":"c","type":"multi_select"},
+	"Close date":{"id":"d","type":"date"},
+	"Amount":{"id":"e","type":"number"},
+	"Active":{"id":"f","type":"checkbox"},
+	"Company":{"id":"g","type":"relation"}
+}}`
+
+func notionJSON(status int, body string) http.Response {
+	return http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func databaseFixture(t *testing.T, componentName string, params map[string]any, responses ...http.Response) (*DatabaseExecutor, *httpClientStub, areadomain.Area, areadomain.Link) {
+	t.Helper()
+	userID := uuid.New()
+	identityID := uuid.New()
+	now := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Hour)
+	repo := &identityRepoStub{identity: identitydomain.Identity{ID: identityID, UserID: userID, Provider: notionProviderName, AccessToken: "secret", ExpiresAt: &expires}}
+	client := &httpClientStub{responses: responses}
+	exec := NewDatabaseExecutor(repo, providerResolverStub{}, client, clockStub{now: now}, nil)
+
+	params["identityId"] = identityID.String()
+	link := areadomain.Link{
+		ID:   uuid.New(),
+		Role: areadomain.LinkRoleReaction,
+		Config: componentdomain.Config{
+			Params: params,
+			Component: &componentdomain.Component{
+				Name:     componentName,
+				Provider: componentdomain.Provider{Name: notionProviderName},
+			},
+		},
+	}
+	return exec, client, areadomain.Area{ID: uuid.New(), UserID: userID}, link
+}
+
+func decodeRequest(t *testing.T, req *http.Request) map[string]any {
+	t.Helper()
+	raw, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		t.Fatalf("decode body %s: %v", raw, err)
+	}
+	return payload
+}
+
+func TestDatabaseExecutorSupports(t *testing.T) {
+	exec := NewDatabaseExecutor(nil, nil, nil, nil, nil)
+	for name := range databaseOperations {
+		if !exec.Supports(&componentdomain.Component{Name: name, Provider: componentdomain.Provider{Name: "Notion"}}) {
+			t.Fatalf("expected %s to be supported", name)
+		}
+	}
+	if exec.Supports(&componentdomain.Component{Name: createPageComponentName, Provider: componentdomain.Provider{Name: notionProviderName}}) {
+		t.Fatal("page creation belongs to the create page executor")
+	}
+}
+
+func TestDatabaseExecutorUpsertUpdatesMatchingRow(t *testing.T) {
+	exec, client, area, link := databaseFixture(t, upsertRowComponentName, map[string]any{
+		"databaseId":    "db-1",
+		"matchProperty": "email",
+		"matchValue":    "ada@example.com",
+		"properties":    "Stage: Won\nTags: b2b, eu\nClose date: 2025-06-01\nAmount: 1200.5\nActive: yes\nCompany: page-9",
+	},
+		notionJSON(http.StatusOK, crmSchema),
+		notionJSON(http.StatusOK, `{"results":[{"id":"row-7"}]}`),
+		notionJSON(http.StatusOK, `{"object":"page","id":"row-7"}`),
+	)
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if len(client.requests) != 3 {
+		t.Fatalf("expected 3 requests got %d", len(client.requests))
+	}
+	if client.requests[0].Method != http.MethodGet || client.requests[0].URL.Path != "/v1/databases/db-1" {
+		t.Fatalf("unexpected schema request %s %s", client.requests[0].Method, client.requests[0].URL.Path)
+	}
+
+	query := decodeRequest(t, client.requests[1])
+	filter := query["filter"].(map[string]any)
+	if filter["property"] != "Email" || filter["email"].(map[string]any)["equals"] != "ada@example.com" {
+		t.Fatalf("unexpected filter %v", filter)
+	}
+
+	update := client.requests[2]
+	if update.Method != http.MethodPatch || update.URL.Path != "/v1/pages/row-7" {
+		t.Fatalf("unexpected update request %s %s", update.Method, update.URL.Path)
+	}
+	properties := decodeRequest(t, update)["properties"].(map[string]any)
+	if _, ok := properties["Email"]; ok {
+		t.Fatalf("match property should not be rewritten on update: %v", properties)
+	}
+	if properties["Stage"].(map[string]any)["select"].(map[string]any)["name"] != "Won" {
+		t.Fatalf("unexpected select %v", properties["Stage"])
+	}
+	if tags := properties["Tags"].(map[string]any)["multi_select"].([]any); len(tags) != 2 {
+		t.Fatalf("unexpected multi select %v", tags)
+	}
+	if properties["Close date"].(map[string]any)["date"].(map[string]any)["start"] != "2025-06-01" {
+		t.Fatalf("unexpected date %v", properties["Close date"])
+	}
+	if properties["Amount"].(map[string]any)["number"] != 1200.5 || properties["Active"].(map[string]any)["checkbox"] != true {
+		t.Fatalf("unexpected number or checkbox %v %v", properties["Amount"], properties["Active"])
+	}
+	if relation := properties["Company"].(map[string]any)["relation"].([]any); relation[0].(map[string]any)["id"] != "page-9" {
+		t.Fatalf("unexpected relation %v", relation)
+	}
+}
+
+func TestDatabaseExecutorUpsertCreatesMissingRow(t *testing.T) {
+	exec, client, area, link := databaseFixture(t, upsertRowComponentName, map[string]any{
+		"databaseId":    "db-1",
+		"matchProperty": "Email",
+		"matchValue":    "grace@example.com",
+		"properties":    map[string]any{"Name": "Grace", "Amount": float64(10)},
+	},
+		notionJSON(http.StatusOK, crmSchema),
+		notionJSON(http.StatusOK, `{"results":[]}`),
+		notionJSON(http.StatusOK, `{"object":"page","id":"row-8"}`),
+	)
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	create := client.requests[2]
+	if create.Method != http.MethodPost || create.URL.String() != notionCreatePageEndpoint {
+		t.Fatalf("unexpected create request %s %s", create.Method, create.URL)
+	}
+	payload := decodeRequest(t, create)
+	if payload["parent"].(map[string]any)["database_id"] != "db-1" {
+		t.Fatalf("unexpected parent %v", payload["parent"])
+	}
+	properties := payload["properties"].(map[string]any)
+	if properties["Email"].(map[string]any)["email"] != "grace@example.com" {
+		t.Fatalf("expected match value on new row, got %v", properties["Email"])
+	}
+	title := properties["Name"].(map[string]any)["title"].([]any)[0].(map[string]any)["text"].(map[string]any)
+	if title["content"] != "Grace" {
+		t.Fatalf("unexpected title %v", title)
+	}
+}
+
+func TestDatabaseExecutorRejectsUnknownProperty(t *testing.T) {
+	exec, _, area, link := databaseFixture(t, updatePropertyComponentName, map[string]any{
+		"pageId":   "page-1",
+		"property": "Missing",
+		"value":    "x",
+	}, notionJSON(http.StatusOK, crmSchema))
+
+	if _, err := exec.Execute(context.Background(), area, link); err == nil || !strings.Contains(err.Error(), `property "Missing" not found`) {
+		t.Fatalf("expected unknown property error, got %v", err)
+	}
+}
+
+func TestDatabaseExecutorUpdatesPageProperty(t *testing.T) {
+	exec, client, area, link := databaseFixture(t, updatePropertyComponentName, map[string]any{
+		"pageId":   "page-1",
+		"property": "Active",
+		"value":    "false",
+	},
+		notionJSON(http.StatusOK, crmSchema),
+		notionJSON(http.StatusOK, `{"object":"page","id":"page-1"}`),
+	)
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	properties := decodeRequest(t, client.requests[1])["properties"].(map[string]any)
+	if len(properties) != 1 || properties["Active"].(map[string]any)["checkbox"] != false {
+		t.Fatalf("unexpected properties %v", properties)
+	}
+}
+
+func TestDatabaseExecutorAppendsBlocks(t *testing.T) {
+	exec, client, area, link := databaseFixture(t, appendBlockComponentName, map[string]any{
+		"pageId":    "page-1",
+		"content":   "Call back\n\nSend quote",
+		"blockType": "to_do",
+	}, notionJSON(http.StatusOK, `{"results":[]}`))
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	req := client.requests[0]
+	if req.Method != http.MethodPatch || req.URL.Path != "/v1/blocks/page-1/children" {
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+	}
+	children := decodeRequest(t, req)["children"].([]any)
+	if len(children) != 2 || children[1].(map[string]any)["type"] != "to_do" {
+		t.Fatalf("unexpected children %v", children)
+	}
+}
+
+func TestDatabaseExecutorSurfacesNotionMessage(t *testing.T) {
+	exec, _, area, link := databaseFixture(t, appendBlockComponentName, map[string]any{
+		"pageId":  "page-1",
+		"content": "Hello",
+	}, notionJSON(http.StatusNotFound, `{"object":"error","status":404,"code":"object_not_found","message":"Could not find block"}`))
+
+	if _, err := exec.Execute(context.Background(), area, link); err == nil || !strings.Contains(err.Error(), "Could not find block") {
+		t.Fatalf("expected Notion message in error, got %v", err)
+	}
+}
+
+func TestEncodeDateRange(t *testing.T) {
+	value, err := encodePropertyValue("date", "2025-01-01/2025-01-05")
+	if err != nil {
+		t.Fatalf("encodePropertyValue returned error: %v", err)
+	}
+	date := value["date"].(map[string]any)
+	if date["start"] != "2025-01-01" || date["end"] != "2025-01-05" {
+		t.Fatalf("unexpected date range %v", date)
+	}
+}
@@ -1,18 +1,15 @@
 package notion
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 	"time"
 
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
-	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
 	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
@@ -50,30 +47,18 @@ func (systemClock) Now() time.Time {
 
 // CreatePageExecutor delivers Notion reactions that create new pages
 type CreatePageExecutor struct {
-	identities identityport.Repository
-	providers  ProviderResolver
-	http       HTTPClient
-	clock      Clock
-	logger     *zap.Logger
+	api    apiClient
+	logger *zap.Logger
 }
 
 // NewCreatePageExecutor constructs a CreatePageExecutor from its dependencies
 func NewCreatePageExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *CreatePageExecutor {
-	if client == nil {
-		client = http.DefaultClient
-	}
-	if clock == nil {
-		clock = systemClock{}
-	}
 	if logger == nil {
 		logger = zap.NewNop()
 	}
 	return &CreatePageExecutor{
-		identities: identities,
-		providers:  providers,
-		http:       client,
-		clock:      clock,
-		logger:     logger,
+		api:    newAPIClient("notion.CreatePageExecutor", identities, providers, client, clock),
+		logger: logger,
 	}
 }
 
@@ -91,7 +76,7 @@ func (e *CreatePageExecutor) Execute(ctx context.Context, area areadomain.Area,
 	if !e.Supports(link.Config.Component) {
 		return outbound.ReactionResult{}, fmt.Errorf("notion.CreatePageExecutor: unsupported component")
 	}
-	if e.identities == nil || e.providers == nil {
+	if !e.api.configured() {
 		return outbound.ReactionResult{}, fmt.Errorf("notion.CreatePageExecutor: resolver not configured")
 	}
 
@@ -100,43 +85,57 @@ func (e *CreatePageExecutor) Execute(ctx context.Context, area areadomain.Area,
 		return outbound.ReactionResult{}, fmt.Errorf("notion.CreatePageExecutor: %w", err)
 	}
 
-	identity, err := e.identities.FindByID(ctx, cfg.identityID)
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, cfg.identityID)
 	if err != nil {
-		return outbound.ReactionResult{}, fmt.Errorf("notion.CreatePageExecutor: identity lookup: %w", err)
-	}
-	if identity.UserID != area.UserID {
-		return outbound.ReactionResult{}, fmt.Errorf("notion.CreatePageExecutor: identity not owned by user")
+		return outbound.ReactionResult{}, err
 	}
 
-	identity, accessToken, err := e.ensureAccessToken(ctx, identity, false)
+	call, err := createPageCall(cfg)
 	if err != nil {
 		return outbound.ReactionResult{}, err
 	}
 
-	result, unauthorized, err := e.createPage(ctx, accessToken, cfg)
-	if err != nil && unauthorized {
-		identity, accessToken, err = e.ensureAccessToken(ctx, identity, true)
-		if err != nil {
-			return outbound.ReactionResult{}, err
-		}
-		result, unauthorized, err = e.createPage(ctx, accessToken, cfg)
-	}
-	if err != nil {
-		return result, err
-	}
-	if unauthorized {
-		return result, fmt.Errorf("notion.CreatePageExecutor: unauthorized after refresh")
+	sess := &session{api: e.api, identity: identity, accessToken: accessToken}
+	if _, err := sess.call(ctx, call); err != nil {
+		return sess.result, err
 	}
 
 	e.logger.Info("notion page created",
 		zap.String("area_id", area.ID.String()),
-		zap.String("identity_id", identity.ID.String()),
+		zap.String("identity_id", sess.identity.ID.String()),
 		zap.String("database_id", cfg.databaseID),
 	)
-	return result, nil
+	return sess.result, nil
 }
 
-func (e *CreatePageExecutor) createPage(ctx context.Context, accessToken string, cfg pageConfig) (outbound.ReactionResult, bool, error) {
+// Preview describes the page creation request without sending it
+func (e *CreatePageExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("notion.CreatePageExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("notion.CreatePageExecutor: resolver not configured")
+	}
+	cfg, err := parsePageConfig(link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("notion.CreatePageExecutor: %w", err)
+	}
+	call, err := createPageCall(cfg)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+	return e.api.preview(ctx, area, cfg.identityID, call)
+}
+
+func createPageCall(cfg pageConfig) (apiCall, error) {
+	bodyBytes, err := json.Marshal(buildCreatePagePayload(cfg))
+	if err != nil {
+		return apiCall{}, fmt.Errorf("notion.CreatePageExecutor: marshal payload: %w", err)
+	}
+	return apiCall{method: http.MethodPost, endpoint: notionCreatePageEndpoint, payload: bodyBytes}, nil
+}
+
+func buildCreatePagePayload(cfg pageConfig) map[string]any {
 	properties := map[string]any{}
 	titlePropName := firstNonEmpty(cfg.titleProperty, notionDefaultTitlePropKey, "Name")
 	properties[titlePropName] = map[string]any{
@@ -157,123 +156,9 @@ func (e *CreatePageExecutor) createPage(ctx context.Context, accessToken string,
 	}
 
 	if cfg.content != "" {
-		payload["children"] = []map[string]any{
-			{
-				"object": "block",
-				"type":   "paragraph",
-				"paragraph": map[string]any{
-					"rich_text": []map[string]any{
-						{
-							"type": "text",
-							"text": map[string]any{
-								"content": cfg.content,
-							},
-						},
-					},
-				},
-			},
-		}
-	}
-
-	bodyBytes, err := json.Marshal(payload)
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("notion.CreatePageExecutor: marshal payload: %w", err)
-	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, notionCreatePageEndpoint, bytes.NewReader(bodyBytes))
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("notion.CreatePageExecutor: build request: %w", err)
-	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", "AREA-Server")
-	req.Header.Set("Notion-Version", notionVersionHeader)
-
-	start := e.now()
-	resp, err := e.http.Do(req)
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("notion.CreatePageExecutor: request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := io.ReadAll(resp.Body)
-	duration := e.now().Sub(start)
-
-	requestHeaders := copyHeaders(req.Header)
-	responseHeaders := copyHeaders(resp.Header)
-
-	result := outbound.ReactionResult{
-		Endpoint: notionCreatePageEndpoint,
-		Request: map[string]any{
-			"method":  http.MethodPost,
-			"url":     notionCreatePageEndpoint,
-			"headers": requestHeaders,
-			"body":    string(bodyBytes),
-		},
-		Response: map[string]any{
-			"body":    string(respBody),
-			"headers": responseHeaders,
-		},
-		StatusCode: &resp.StatusCode,
-		Duration:   duration,
-	}
-
-	if resp.StatusCode >= 400 {
-		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
-			return result, true, fmt.Errorf("notion.CreatePageExecutor: received status %d", resp.StatusCode)
-		}
-		return result, false, fmt.Errorf("notion.CreatePageExecutor: received status %d", resp.StatusCode)
-	}
-
-	return result, false, nil
-}
-
-func (e *CreatePageExecutor) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
-	now := e.now()
-	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
-		return identity, identity.AccessToken, nil
-	}
-
-	provider, ok := e.providers.Provider(notionProviderName)
-	if !ok {
-		return identity, "", fmt.Errorf("notion.CreatePageExecutor: provider %s not configured", notionProviderName)
-	}
-
-	exchange, err := provider.Refresh(ctx, identity)
-	if err != nil {
-		return identity, "", fmt.Errorf("notion.CreatePageExecutor: refresh token: %w", err)
-	}
-
-	refreshToken := exchange.Token.RefreshToken
-	if refreshToken == "" {
-		refreshToken = identity.RefreshToken
-	}
-	expiresAt := identity.ExpiresAt
-	if !exchange.Token.ExpiresAt.IsZero() {
-		expires := exchange.Token.ExpiresAt.UTC()
-		expiresAt = &expires
-	}
-	scopes := exchange.Token.Scope
-	if len(scopes) == 0 {
-		scopes = identity.Scopes
-	}
-
-	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
-	updated.UpdatedAt = now
-
-	if err := e.identities.Update(ctx, updated); err != nil {
-		return identity, "", fmt.Errorf("notion.CreatePageExecutor: update identity: %w", err)
-	}
-
-	return updated, updated.AccessToken, nil
-}
-
-func (e *CreatePageExecutor) now() time.Time {
-	if e.clock == nil {
-		return time.Now().UTC()
+		payload["children"] = []map[string]any{textBlock("paragraph", cfg.content)}
 	}
-	return e.clock.Now().UTC()
+	return payload
 }
 
 type pageConfig struct {
@@ -0,0 +1,370 @@
+package notion
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// propertySchema maps a property name to its Notion type as reported by a database or page
+type propertySchema map[string]string
+
+// parseSchema extracts property types from the properties object of a database or page response
+func parseSchema(body map[string]any) propertySchema {
+	schema := propertySchema{}
+	properties, _ := body["properties"].(map[string]any)
+	for name, raw := range properties {
+		definition, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		if kind, ok := definition["type"].(string); ok {
+			schema[name] = kind
+		}
+	}
+	return schema
+}
+
+// lookup resolves a property by exact name first, then case-insensitively
+func (s propertySchema) lookup(name string) (string, string, bool) {
+	if kind, ok := s[name]; ok {
+		return name, kind, true
+	}
+	for candidate, kind := range s {
+		if strings.EqualFold(candidate, name) {
+			return candidate, kind, true
+		}
+	}
+	return "", "", false
+}
+
+// encodeProperties converts raw values into Notion property values according to the schema
+func (s propertySchema) encodeProperties(values map[string]any) (map[string]any, error) {
+	encoded := make(map[string]any, len(values))
+	for _, name := range sortedKeys(values) {
+		property, kind, ok := s.lookup(name)
+		if !ok {
+			return nil, fmt.Errorf("property %q not found", name)
+		}
+		value, err := encodePropertyValue(kind, values[name])
+		if err != nil {
+			return nil, fmt.Errorf("property %q: %w", property, err)
+		}
+		encoded[property] = value
+	}
+	return encoded, nil
+}
+
+// encodePropertyValue builds the Notion payload for a single property of the given type
+func encodePropertyValue(kind string, value any) (map[string]any, error) {
+	switch kind {
+	case "title", "rich_text":
+		text, err := scalarString(value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{kind: []map[string]any{{"text": map[string]any{"content": text}}}}, nil
+	case "select", "status":
+		name, err := scalarString(value)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			return map[string]any{kind: nil}, nil
+		}
+		return map[string]any{kind: map[string]any{"name": name}}, nil
+	case "multi_select":
+		names, err := listValues(value)
+		if err != nil {
+			return nil, err
+		}
+		options := make([]map[string]any, 0, len(names))
+		for _, name := range names {
+			options = append(options, map[string]any{"name": name})
+		}
+		return map[string]any{kind: options}, nil
+	case "relation":
+		ids, err := listValues(value)
+		if err != nil {
+			return nil, err
+		}
+		relations := make([]map[string]any, 0, len(ids))
+		for _, id := range ids {
+			relations = append(relations, map[string]any{"id": id})
+		}
+		return map[string]any{kind: relations}, nil
+	case "date":
+		date, err := encodeDate(value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{kind: date}, nil
+	case "number":
+		number, err := parseNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{kind: number}, nil
+	case "checkbox":
+		checked, err := parseCheckbox(value)
+		if err != nil {
+			return nil, err
+		}
+		return map[string]any{kind: checked}, nil
+	case "url", "email", "phone_number":
+		text, err := scalarString(value)
+		if err != nil {
+			return nil, err
+		}
+		if text == "" {
+			return map[string]any{kind: nil}, nil
+		}
+		return map[string]any{kind: text}, nil
+	default:
+		return nil, fmt.Errorf("type %s is not writable", kind)
+	}
+}
+
+// encodeFilter builds the database query filter matching a property against a value
+func encodeFilter(property string, kind string, value any) (map[string]any, error) {
+	var condition map[string]any
+	switch kind {
+	case "title", "rich_text", "url", "email", "phone_number", "select", "status":
+		text, err := scalarString(value)
+		if err != nil {
+			return nil, err
+		}
+		condition = map[string]any{"equals": text}
+	case "multi_select", "relation":
+		text, err := scalarString(value)
+		if err != nil {
+			return nil, err
+		}
+		condition = map[string]any{"contains": text}
+	case "number":
+		number, err := parseNumber(value)
+		if err != nil {
+			return nil, err
+		}
+		condition = map[string]any{"equals": number}
+	case "checkbox":
+		checked, err := parseCheckbox(value)
+		if err != nil {
+			return nil, err
+		}
+		condition = map[string]any{"equals": checked}
+	case "date":
+		text, err := scalarString(value)
+		if err != nil {
+			return nil, err
+		}
+		condition = map[string]any{"equals": text}
+	default:
+		return nil, fmt.Errorf("type %s cannot be used to match rows", kind)
+	}
+	return map[string]any{"property": property, kind: condition}, nil
+}
+
+// parsePropertyValues accepts a JSON object, a decoded map or "Name: value" lines
+func parsePropertyValues(value any) (map[string]any, error) {
+	switch v := value.(type) {
+	case nil:
+		return map[string]any{}, nil
+	case map[string]any:
+		values := make(map[string]any, len(v))
+		for key, item := range v {
+			values[key] = item
+		}
+		return values, nil
+	case string:
+		trimmed := strings.TrimSpace(v)
+		if trimmed == "" {
+			return map[string]any{}, nil
+		}
+		if strings.HasPrefix(trimmed, "{") {
+			var decoded map[string]any
+			if err := json.Unmarshal([]byte(trimmed), &decoded); err != nil {
+				return nil, fmt.Errorf("invalid JSON: %w", err)
+			}
+			return decoded, nil
+		}
+		values := map[string]any{}
+		for _, line := range strings.Split(trimmed, "\n") {
+			line = strings.TrimSpace(line)
+			if line == "" {
+				continue
+			}
+			name, raw, ok := strings.Cut(line, ":")
+			if !ok || strings.TrimSpace(name) == "" {
+				return nil, fmt.Errorf("line %q must use the form Name: value", line)
+			}
+			values[strings.TrimSpace(name)] = strings.TrimSpace(raw)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("expected object got %T", value)
+	}
+}
+
+func encodeDate(value any) (any, error) {
+	switch v := value.(type) {
+	case map[string]any:
+		start := strings.TrimSpace(stringOf(v["start"]))
+		if start == "" {
+			return nil, fmt.Errorf("date start missing")
+		}
+		date := map[string]any{"start": start}
+		if end := strings.TrimSpace(stringOf(v["end"])); end != "" {
+			date["end"] = end
+		}
+		return date, nil
+	default:
+		text, err := scalarString(value)
+		if err != nil {
+			return nil, err
+		}
+		if text == "" {
+			return nil, nil
+		}
+		// A slash separated value describes a range, for example 2025-01-01/2025-01-05
+		if start, end, ok := strings.Cut(text, "/"); ok && !strings.Contains(end, "/") {
+			return map[string]any{"start": strings.TrimSpace(start), "end": strings.TrimSpace(end)}, nil
+		}
+		return map[string]any{"start": text}, nil
+	}
+}
+
+func parseNumber(value any) (any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case float64:
+		return v, nil
+	case int:
+		return float64(v), nil
+	case int64:
+		return float64(v), nil
+	case string:
+		trimmed := strings.TrimSpace(v)
+		if trimmed == "" {
+			return nil, nil
+		}
+		number, err := strconv.ParseFloat(trimmed, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %q", trimmed)
+		}
+		return number, nil
+	default:
+		return nil, fmt.Errorf("expected number got %T", value)
+	}
+}
+
+func parseCheckbox(value any) (bool, error) {
+	switch v := value.(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(v)) {
+		case "", "false", "no", "0", "off":
+			return false, nil
+		case "true", "yes", "1", "on", "x":
+			return true, nil
+		default:
+			return false, fmt.Errorf("invalid checkbox value %q", v)
+		}
+	default:
+		return false, fmt.Errorf("expected boolean got %T", value)
+	}
+}
+
+// listValues accepts a list or a comma separated string
+func listValues(value any) ([]string, error) {
+	switch v := value.(type) {
+	case nil:
+		return []string{}, nil
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			text, err := scalarString(item)
+			if err != nil {
+				return nil, err
+			}
+			if text != "" {
+				items = append(items, text)
+			}
+		}
+		return items, nil
+	case []string:
+		return v, nil
+	case string:
+		items := []string{}
+		for _, part := range strings.Split(v, ",") {
+			if trimmed := strings.TrimSpace(part); trimmed != "" {
+				items = append(items, trimmed)
+			}
+		}
+		return items, nil
+	default:
+		return nil, fmt.Errorf("expected list got %T", value)
+	}
+}
+
+func scalarString(value any) (string, error) {
+	switch v := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return strings.TrimSpace(v), nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	case int:
+		return strconv.Itoa(v), nil
+	case bool:
+		return strconv.FormatBool(v), nil
+	default:
+		return "", fmt.Errorf("expected text got %T", value)
+	}
+}
+
+func stringOf(value any) string {
+	text, _ := scalarString(value)
+	return text
+}
+
+func sortedKeys(values map[string]any) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// textBlock builds a block of the given type holding plain text
+func textBlock(kind string, content string) map[string]any {
+	body := map[string]any{
+		"rich_text": []map[string]any{
+			{
+				"type": "text",
+				"text": map[string]any{
+					"content": content,
+				},
+			},
+		},
+	}
+	switch kind {
+	case "to_do":
+		body["checked"] = false
+	case "code":
+		body["language"] = "plain text"
+	}
+	return map[string]any{
+		"object": "block",
+		"type":   kind,
+		kind:     body,
+	}
+}
@@ -0,0 +1,248 @@
+package outlook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	mailutils "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/mail"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	calendarEventComponentName = "outlook_create_event"
+	graphDateTimeLayout        = "2006-01-02T15:04:05"
+)
+
+// CalendarEventExecutor creates Outlook calendar events through Microsoft Graph
+type CalendarEventExecutor struct {
+	graph  graphClient
+	logger *zap.Logger
+}
+
+// NewCalendarEventExecutor constructs a CalendarEventExecutor from its dependencies
+func NewCalendarEventExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *CalendarEventExecutor {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &CalendarEventExecutor{graph: newGraphClient("outlook.CalendarEventExecutor", identities, providers, client, clock), logger: logger}
+}
+
+// Supports reports whether the executor can handle the provided component
+func (e *CalendarEventExecutor) Supports(component *componentdomain.Component) bool {
+	if component == nil {
+		return false
+	}
+	return strings.EqualFold(component.Name, calendarEventComponentName) &&
+		strings.EqualFold(component.Provider.Name, outlookProviderName)
+}
+
+// Execute creates the configured event in the user's Outlook calendar
+func (e *CalendarEventExecutor) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.CalendarEventExecutor: unsupported component")
+	}
+	if !e.graph.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.CalendarEventExecutor: resolver not configured")
+	}
+
+	cfg, err := parseCalendarEventConfig(link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.CalendarEventExecutor: %w", err)
+	}
+
+	identity, accessToken, err := e.graph.resolveIdentity(ctx, area, cfg.identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+
+	payload, err := buildCalendarEventPayload(cfg)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.CalendarEventExecutor: build payload: %w", err)
+	}
+
+	endpoint := calendarEventsEndpoint(cfg.calendarID)
+	result, identity, err := e.graph.deliver(ctx, identity, accessToken, http.MethodPost, endpoint, payload, calendarEventRequestInfo(cfg))
+	if err != nil {
+		return result, err
+	}
+
+	e.logger.Info("outlook calendar reaction delivered",
+		zap.String("area_id", area.ID.String()),
+		zap.String("identity_id", identity.ID.String()),
+		zap.String("subject", cfg.subject),
+	)
+	return result, nil
+}
+
+// Preview describes the event without creating it
+func (e *CalendarEventExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.CalendarEventExecutor: unsupported component")
+	}
+	if !e.graph.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.CalendarEventExecutor: resolver not configured")
+	}
+	cfg, err := parseCalendarEventConfig(link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.CalendarEventExecutor: %w", err)
+	}
+	return e.graph.preview(ctx, area, cfg.identityID, calendarEventsEndpoint(cfg.calendarID), calendarEventRequestInfo(cfg))
+}
+
+func calendarEventRequestInfo(cfg calendarEventConfig) map[string]any {
+	requestInfo := map[string]any{
+		"subject":         cfg.subject,
+		"body":            cfg.body,
+		"location":        cfg.location,
+		"startTime":       cfg.startTime.Format(time.RFC3339),
+		"endTime":         cfg.endTime.Format(time.RFC3339),
+		"attendees":       append([]string(nil), cfg.attendees...),
+		"isOnlineMeeting": cfg.onlineMeeting,
+	}
+	if cfg.calendarID != "" {
+		requestInfo["calendarId"] = cfg.calendarID
+	}
+	return requestInfo
+}
+
+func calendarEventsEndpoint(calendarID string) string {
+	if calendarID == "" {
+		return graphAPIBaseURL + "/me/events"
+	}
+	return fmt.Sprintf("%s/me/calendars/%s/events", graphAPIBaseURL, url.PathEscape(calendarID))
+}
+
+type calendarEventConfig struct {
+	identityID    uuid.UUID
+	calendarID    string
+	subject       string
+	body          string
+	location      string
+	startTime     time.Time
+	endTime       time.Time
+	attendees     []string
+	onlineMeeting bool
+}
+
+func parseCalendarEventConfig(params map[string]any) (calendarEventConfig, error) {
+	cfg := calendarEventConfig{}
+
+	identityID, err := parseIdentityParam(params)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.identityID = identityID
+
+	cfg.subject, err = requiredString(params, "subject")
+	if err != nil {
+		return cfg, err
+	}
+
+	cfg.calendarID = optionalString(params, "calendarId")
+	cfg.body = optionalString(params, "body")
+	cfg.location = optionalString(params, "location")
+
+	startRaw, err := requiredString(params, "startTime")
+	if err != nil {
+		return cfg, err
+	}
+	cfg.startTime, err = time.Parse(time.RFC3339, startRaw)
+	if err != nil {
+		return cfg, fmt.Errorf("startTime parse: %w", err)
+	}
+
+	endRaw, err := requiredString(params, "endTime")
+	if err != nil {
+		return cfg, err
+	}
+	cfg.endTime, err = time.Parse(time.RFC3339, endRaw)
+	if err != nil {
+		return cfg, fmt.Errorf("endTime parse: %w", err)
+	}
+	if !cfg.endTime.After(cfg.startTime) {
+		return cfg, fmt.Errorf("endTime must be after startTime")
+	}
+
+	if attendeesRaw, ok := params["attendees"]; ok {
+		cfg.attendees, err = mailutils.ParseList(attendeesRaw, true)
+		if err != nil {
+			return cfg, fmt.Errorf("attendees invalid: %w", err)
+		}
+	}
+
+	if raw, ok := params["isOnlineMeeting"]; ok {
+		value, ok := raw.(bool)
+		if !ok {
+			return cfg, fmt.Errorf("isOnlineMeeting invalid")
+		}
+		cfg.onlineMeeting = value
+	}
+
+	return cfg, nil
+}
+
+func buildCalendarEventPayload(cfg calendarEventConfig) ([]byte, error) {
+	event := map[string]any{
+		"subject": cfg.subject,
+		"start": map[string]string{
+			"dateTime": cfg.startTime.UTC().Format(graphDateTimeLayout),
+			"timeZone": "UTC",
+		},
+		"end": map[string]string{
+			"dateTime": cfg.endTime.UTC().Format(graphDateTimeLayout),
+			"timeZone": "UTC",
+		},
+	}
+	if cfg.body != "" {
+		event["body"] = map[string]any{
+			"contentType": "Text",
+			"content":     cfg.body,
+		}
+	}
+	if cfg.location != "" {
+		event["location"] = map[string]string{"displayName": cfg.location}
+	}
+	if len(cfg.attendees) > 0 {
+		attendees := make([]map[string]any, 0, len(cfg.attendees))
+		for _, address := range cfg.attendees {
+			attendees = append(attendees, map[string]any{
+				"emailAddress": map[string]string{"address": address},
+				"type":         "required",
+			})
+		}
+		event["attendees"] = attendees
+	}
+	if cfg.onlineMeeting {
+		event["isOnlineMeeting"] = true
+		event["onlineMeetingProvider"] = "teamsForBusiness"
+	}
+	return json.Marshal(event)
+}
+
+func optionalString(params map[string]any, key string) string {
+	raw, ok := params[key]
+	if !ok {
+		return ""
+	}
+	value, err := mailutils.ToString(raw)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(value)
+}
+
+// Ensure CalendarEventExecutor satisfies the ComponentReactionHandler contract
+var _ interface {
+	Supports(*componentdomain.Component) bool
+	Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error)
+} = (*CalendarEventExecutor)(nil)
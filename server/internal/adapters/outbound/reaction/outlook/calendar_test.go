@@ -0,0 +1,111 @@
+package outlook
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/google/uuid"
+)
+
+func TestCalendarEventExecutorExecuteSuccess(t *testing.T) {
+	identityID := uuid.New()
+	userID := uuid.New()
+	repo := &stubIdentityRepo{identity: identitydomain.Identity{
+		ID:          identityID,
+		UserID:      userID,
+		Provider:    outlookProviderName,
+		AccessToken: "token-123",
+	}}
+	client := &stubHTTPClient{}
+	exec := NewCalendarEventExecutor(repo, stubProviderResolver{}, client, stubClock{now: time.Now()}, nil)
+
+	area := areadomain.Area{ID: uuid.New(), UserID: userID}
+	link := areadomain.Link{Config: componentdomain.Config{
+		Component: &componentdomain.Component{Name: calendarEventComponentName, Provider: componentdomain.Provider{Name: outlookProviderName}},
+		Params: map[string]any{
+			"identityId":      identityID.String(),
+			"subject":         "Incident review",
+			"body":            "Discuss the outage",
+			"startTime":       "2025-03-01T10:00:00+01:00",
+			"endTime":         "2025-03-01T11:00:00+01:00",
+			"location":        "Room 4",
+			"attendees":       "a@example.com, b@example.com",
+			"isOnlineMeeting": true,
+		},
+	}}
+
+	result, err := exec.Execute(context.Background(), area, link)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Endpoint != graphAPIBaseURL+"/me/events" {
+		t.Fatalf("unexpected endpoint %s", result.Endpoint)
+	}
+
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(client.bodies[0]), &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	start, ok := payload["start"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected start object")
+	}
+	if start["dateTime"] != "2025-03-01T09:00:00" || start["timeZone"] != "UTC" {
+		t.Fatalf("unexpected start %v", start)
+	}
+	attendees, ok := payload["attendees"].([]any)
+	if !ok || len(attendees) != 2 {
+		t.Fatalf("expected two attendees, got %v", payload["attendees"])
+	}
+	if payload["isOnlineMeeting"] != true {
+		t.Fatalf("expected online meeting flag")
+	}
+	location, ok := payload["location"].(map[string]any)
+	if !ok || location["displayName"] != "Room 4" {
+		t.Fatalf("unexpected location %v", payload["location"])
+	}
+}
+
+func TestCalendarEventExecutorUsesCalendarID(t *testing.T) {
+	identityID := uuid.New()
+	userID := uuid.New()
+	repo := &stubIdentityRepo{identity: identitydomain.Identity{ID: identityID, UserID: userID, AccessToken: "token"}}
+	client := &stubHTTPClient{}
+	exec := NewCalendarEventExecutor(repo, stubProviderResolver{}, client, stubClock{now: time.Now()}, nil)
+
+	link := areadomain.Link{Config: componentdomain.Config{
+		Component: &componentdomain.Component{Name: calendarEventComponentName, Provider: componentdomain.Provider{Name: outlookProviderName}},
+		Params: map[string]any{
+			"identityId": identityID.String(),
+			"calendarId": "AAMkAD=",
+			"subject":    "Sync",
+			"startTime":  "2025-03-01T10:00:00Z",
+			"endTime":    "2025-03-01T10:30:00Z",
+		},
+	}}
+
+	result, err := exec.Execute(context.Background(), areadomain.Area{ID: uuid.New(), UserID: userID}, link)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if result.Endpoint != graphAPIBaseURL+"/me/calendars/AAMkAD=/events" {
+		t.Fatalf("unexpected endpoint %s", result.Endpoint)
+	}
+}
+
+func TestParseCalendarEventConfigRejectsInvertedRange(t *testing.T) {
+	_, err := parseCalendarEventConfig(map[string]any{
+		"identityId": uuid.NewString(),
+		"subject":    "Sync",
+		"startTime":  "2025-03-01T10:00:00Z",
+		"endTime":    "2025-03-01T09:00:00Z",
+	})
+	if err == nil {
+		t.Fatalf("expected error when endTime precedes startTime")
+	}
+}
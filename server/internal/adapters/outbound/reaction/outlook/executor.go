@@ -1,11 +1,9 @@
 package outlook
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 	"time"
@@ -13,7 +11,6 @@ import (
 	mailutils "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/mail"
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
-	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
 	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
@@ -23,7 +20,7 @@ import (
 const (
 	outlookComponentName    = "outlook_send_email"
 	outlookProviderName     = "microsoft"
-	outlookSendMailEndpoint = "https://graph.microsoft.com/v1.0/me/sendMail"
+	outlookSendMailEndpoint = graphAPIBaseURL + "/me/sendMail"
 )
 
 // ProviderResolver exposes OAuth providers by name
@@ -43,25 +40,16 @@ type Clock interface {
 
 // Executor delivers Outlook reactions on behalf of the user through OAuth tokens
 type Executor struct {
-	identities identityport.Repository
-	providers  ProviderResolver
-	http       HTTPClient
-	clock      Clock
-	logger     *zap.Logger
+	graph  graphClient
+	logger *zap.Logger
 }
 
 // NewExecutor constructs an Outlook executor from its dependencies
 func NewExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *Executor {
-	if client == nil {
-		client = http.DefaultClient
-	}
-	if clock == nil {
-		clock = systemClock{}
-	}
 	if logger == nil {
 		logger = zap.NewNop()
 	}
-	return &Executor{identities: identities, providers: providers, http: client, clock: clock, logger: logger}
+	return &Executor{graph: newGraphClient("outlook.Executor", identities, providers, client, clock), logger: logger}
 }
 
 // Supports reports whether the executor can handle the provided component
@@ -78,7 +66,7 @@ func (e *Executor) Execute(ctx context.Context, area areadomain.Area, link aread
 	if !e.Supports(link.Config.Component) {
 		return outbound.ReactionResult{}, fmt.Errorf("outlook.Executor: unsupported component")
 	}
-	if e.identities == nil || e.providers == nil {
+	if !e.graph.configured() {
 		return outbound.ReactionResult{}, fmt.Errorf("outlook.Executor: resolver not configured")
 	}
 
@@ -87,15 +75,7 @@ func (e *Executor) Execute(ctx context.Context, area areadomain.Area, link aread
 		return outbound.ReactionResult{}, fmt.Errorf("outlook.Executor: %w", err)
 	}
 
-	identity, err := e.identities.FindByID(ctx, cfg.identityID)
-	if err != nil {
-		return outbound.ReactionResult{}, fmt.Errorf("outlook.Executor: identity lookup: %w", err)
-	}
-	if identity.UserID != area.UserID {
-		return outbound.ReactionResult{}, fmt.Errorf("outlook.Executor: identity not owned by user")
-	}
-
-	identity, accessToken, err := e.ensureAccessToken(ctx, identity, false)
+	identity, accessToken, err := e.graph.resolveIdentity(ctx, area, cfg.identityID)
 	if err != nil {
 		return outbound.ReactionResult{}, err
 	}
@@ -105,28 +85,10 @@ func (e *Executor) Execute(ctx context.Context, area areadomain.Area, link aread
 		return outbound.ReactionResult{}, fmt.Errorf("outlook.Executor: build payload: %w", err)
 	}
 
-	requestInfo := map[string]any{
-		"to":      append([]string(nil), cfg.to...),
-		"cc":      append([]string(nil), cfg.cc...),
-		"bcc":     append([]string(nil), cfg.bcc...),
-		"subject": cfg.subject,
-		"body":    cfg.body,
-	}
-
-	result, unauthorized, err := e.sendMail(ctx, accessToken, payload, requestInfo)
-	if err != nil && unauthorized {
-		identity, accessToken, err = e.ensureAccessToken(ctx, identity, true)
-		if err != nil {
-			return outbound.ReactionResult{}, err
-		}
-		result, unauthorized, err = e.sendMail(ctx, accessToken, payload, requestInfo)
-	}
+	result, identity, err := e.graph.deliver(ctx, identity, accessToken, http.MethodPost, outlookSendMailEndpoint, payload, mailRequestInfo(cfg))
 	if err != nil {
 		return result, err
 	}
-	if unauthorized {
-		return result, fmt.Errorf("outlook.Executor: unauthorized after refresh")
-	}
 
 	e.logger.Info("outlook reaction delivered",
 		zap.String("area_id", area.ID.String()),
@@ -137,93 +99,29 @@ func (e *Executor) Execute(ctx context.Context, area areadomain.Area, link aread
 	return result, nil
 }
 
-func (e *Executor) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
-	now := e.now()
-	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
-		return identity, identity.AccessToken, nil
-	}
-
-	provider, ok := e.providers.Provider(outlookProviderName)
-	if !ok {
-		return identity, "", fmt.Errorf("outlook.Executor: provider %s not configured", outlookProviderName)
-	}
-
-	exchange, err := provider.Refresh(ctx, identity)
-	if err != nil {
-		return identity, "", fmt.Errorf("outlook.Executor: refresh token: %w", err)
-	}
-
-	refreshToken := exchange.Token.RefreshToken
-	if refreshToken == "" {
-		refreshToken = identity.RefreshToken
-	}
-	expiresAt := identity.ExpiresAt
-	if !exchange.Token.ExpiresAt.IsZero() {
-		exp := exchange.Token.ExpiresAt.UTC()
-		expiresAt = &exp
-	}
-	scopes := exchange.Token.Scope
-	if len(scopes) == 0 {
-		scopes = identity.Scopes
-	}
-
-	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
-	updated.UpdatedAt = now
-	if err := e.identities.Update(ctx, updated); err != nil {
-		return identity, "", fmt.Errorf("outlook.Executor: update identity: %w", err)
+// Preview describes the message without sending it
+func (e *Executor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.Executor: unsupported component")
 	}
-	return updated, updated.AccessToken, nil
-}
-
-func (e *Executor) sendMail(ctx context.Context, accessToken string, payload []byte, request map[string]any) (outbound.ReactionResult, bool, error) {
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, outlookSendMailEndpoint, bytes.NewReader(payload))
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("outlook.Executor: build request: %w", err)
+	if !e.graph.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.Executor: resolver not configured")
 	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
-	req.Header.Set("Content-Type", "application/json")
-
-	start := time.Now()
-	resp, err := e.http.Do(req)
+	cfg, err := parseMessageConfig(link.Config.Params)
 	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("outlook.Executor: request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, _ := io.ReadAll(resp.Body)
-	duration := time.Since(start)
-
-	responseHeaders := map[string][]string{}
-	for key, values := range resp.Header {
-		responseHeaders[key] = append([]string(nil), values...)
-	}
-
-	result := outbound.ReactionResult{
-		Endpoint: outlookSendMailEndpoint,
-		Request:  cloneMap(request),
-		Response: map[string]any{
-			"body":    strings.TrimSpace(string(body)),
-			"headers": responseHeaders,
-		},
-		StatusCode: &resp.StatusCode,
-		Duration:   duration,
-	}
-
-	switch {
-	case resp.StatusCode == http.StatusUnauthorized:
-		return result, true, fmt.Errorf("outlook.Executor: unauthorized: %s", strings.TrimSpace(string(body)))
-	case resp.StatusCode >= 400:
-		return result, false, fmt.Errorf("outlook.Executor: api error %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
-	default:
-		return result, false, nil
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.Executor: %w", err)
 	}
+	return e.graph.preview(ctx, area, cfg.identityID, outlookSendMailEndpoint, mailRequestInfo(cfg))
 }
 
-func (e *Executor) now() time.Time {
-	if e.clock == nil {
-		return time.Now().UTC()
+func mailRequestInfo(cfg messageConfig) map[string]any {
+	return map[string]any{
+		"to":      append([]string(nil), cfg.to...),
+		"cc":      append([]string(nil), cfg.cc...),
+		"bcc":     append([]string(nil), cfg.bcc...),
+		"subject": cfg.subject,
+		"body":    cfg.body,
 	}
-	return e.clock.Now().UTC()
 }
 
 type messageConfig struct {
@@ -238,17 +136,9 @@ type messageConfig struct {
 func parseMessageConfig(params map[string]any) (messageConfig, error) {
 	cfg := messageConfig{}
 
-	identityRaw, ok := params["identityId"]
-	if !ok {
-		return cfg, fmt.Errorf("identityId missing")
-	}
-	identityStr, err := mailutils.ToString(identityRaw)
-	if err != nil {
-		return cfg, fmt.Errorf("identityId invalid")
-	}
-	identityID, err := uuid.Parse(strings.TrimSpace(identityStr))
+	identityID, err := parseIdentityParam(params)
 	if err != nil {
-		return cfg, fmt.Errorf("identityId parse: %w", err)
+		return cfg, err
 	}
 	cfg.identityID = identityID
 
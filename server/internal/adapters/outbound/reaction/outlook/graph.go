@@ -0,0 +1,191 @@
+package outlook
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	mailutils "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/mail"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+)
+
+const graphAPIBaseURL = "https://graph.microsoft.com/v1.0"
+
+// graphClient bundles the identity lookup and token refresh flow shared by Microsoft Graph executors
+type graphClient struct {
+	name       string
+	identities identityport.Repository
+	providers  ProviderResolver
+	http       HTTPClient
+	clock      Clock
+}
+
+func newGraphClient(name string, identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock) graphClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return graphClient{name: name, identities: identities, providers: providers, http: client, clock: clock}
+}
+
+func (c graphClient) configured() bool {
+	return c.identities != nil && c.providers != nil
+}
+
+// resolveIdentity loads the identity bound to the reaction and ensures it carries a usable access token
+func (c graphClient) resolveIdentity(ctx context.Context, area areadomain.Area, identityID uuid.UUID) (identitydomain.Identity, string, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	return c.ensureAccessToken(ctx, identity, false)
+}
+
+func (c graphClient) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
+	now := c.now()
+	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
+		return identity, identity.AccessToken, nil
+	}
+
+	provider, ok := c.providers.Provider(outlookProviderName)
+	if !ok {
+		return identity, "", fmt.Errorf("%s: provider %s not configured", c.name, outlookProviderName)
+	}
+
+	exchange, err := provider.Refresh(ctx, identity)
+	if err != nil {
+		return identity, "", fmt.Errorf("%s: refresh token: %w", c.name, err)
+	}
+
+	refreshToken := exchange.Token.RefreshToken
+	if refreshToken == "" {
+		refreshToken = identity.RefreshToken
+	}
+	expiresAt := identity.ExpiresAt
+	if !exchange.Token.ExpiresAt.IsZero() {
+		exp := exchange.Token.ExpiresAt.UTC()
+		expiresAt = &exp
+	}
+	scopes := exchange.Token.Scope
+	if len(scopes) == 0 {
+		scopes = identity.Scopes
+	}
+
+	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
+	updated.UpdatedAt = now
+	if err := c.identities.Update(ctx, updated); err != nil {
+		return identity, "", fmt.Errorf("%s: update identity: %w", c.name, err)
+	}
+	return updated, updated.AccessToken, nil
+}
+
+// deliver sends the payload and transparently refreshes the access token once when Graph answers 401
+func (c graphClient) deliver(ctx context.Context, identity identitydomain.Identity, accessToken string, method string, endpoint string, payload []byte, request map[string]any) (outbound.ReactionResult, identitydomain.Identity, error) {
+	result, unauthorized, err := c.send(ctx, method, endpoint, accessToken, payload, request)
+	if err != nil && unauthorized {
+		identity, accessToken, err = c.ensureAccessToken(ctx, identity, true)
+		if err != nil {
+			return outbound.ReactionResult{}, identity, err
+		}
+		result, unauthorized, err = c.send(ctx, method, endpoint, accessToken, payload, request)
+	}
+	if err != nil {
+		return result, identity, err
+	}
+	if unauthorized {
+		return result, identity, fmt.Errorf("%s: unauthorized after refresh", c.name)
+	}
+	return result, identity, nil
+}
+
+// preview describes the request the reaction would send with the identity, its token is never refreshed
+func (c graphClient) preview(ctx context.Context, area areadomain.Area, identityID uuid.UUID, endpoint string, request map[string]any) (outbound.ReactionResult, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	return outbound.ReactionResult{Endpoint: endpoint, Request: cloneMap(request)}, nil
+}
+
+func (c graphClient) send(ctx context.Context, method string, endpoint string, accessToken string, payload []byte, request map[string]any) (outbound.ReactionResult, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return outbound.ReactionResult{}, false, fmt.Errorf("%s: build request: %w", c.name, err)
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Content-Type", "application/json")
+
+	start := time.Now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return outbound.ReactionResult{}, false, fmt.Errorf("%s: request failed: %w", c.name, err)
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(resp.Body)
+	duration := time.Since(start)
+
+	responseHeaders := map[string][]string{}
+	for key, values := range resp.Header {
+		responseHeaders[key] = append([]string(nil), values...)
+	}
+
+	result := outbound.ReactionResult{
+		Endpoint: endpoint,
+		Request:  cloneMap(request),
+		Response: map[string]any{
+			"body":    strings.TrimSpace(string(body)),
+			"headers": responseHeaders,
+		},
+		StatusCode: &resp.StatusCode,
+		Duration:   duration,
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusUnauthorized:
+		return result, true, fmt.Errorf("%s: unauthorized: %s", c.name, strings.TrimSpace(string(body)))
+	case resp.StatusCode >= 400:
+		return result, false, fmt.Errorf("%s: api error %d: %s", c.name, resp.StatusCode, strings.TrimSpace(string(body)))
+	default:
+		return result, false, nil
+	}
+}
+
+func (c graphClient) now() time.Time {
+	if c.clock == nil {
+		return time.Now().UTC()
+	}
+	return c.clock.Now().UTC()
+}
+
+func parseIdentityParam(params map[string]any) (uuid.UUID, error) {
+	identityRaw, ok := params["identityId"]
+	if !ok {
+		return uuid.Nil, fmt.Errorf("identityId missing")
+	}
+	identityStr, err := mailutils.ToString(identityRaw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("identityId invalid")
+	}
+	identityID, err := uuid.Parse(strings.TrimSpace(identityStr))
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("identityId parse: %w", err)
+	}
+	return identityID, nil
+}
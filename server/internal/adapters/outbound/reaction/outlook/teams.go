@@ -0,0 +1,199 @@
+package outlook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	mailutils "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/mail"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	teamsMessageComponentName = "teams_post_channel_message"
+	teamsMessageMaxLength     = 28000
+)
+
+// TeamsMessageExecutor posts messages to Microsoft Teams channels through Microsoft Graph
+type TeamsMessageExecutor struct {
+	graph  graphClient
+	logger *zap.Logger
+}
+
+// NewTeamsMessageExecutor constructs a TeamsMessageExecutor from its dependencies
+func NewTeamsMessageExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *TeamsMessageExecutor {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &TeamsMessageExecutor{graph: newGraphClient("outlook.TeamsMessageExecutor", identities, providers, client, clock), logger: logger}
+}
+
+// Supports reports whether the executor can handle the provided component
+func (e *TeamsMessageExecutor) Supports(component *componentdomain.Component) bool {
+	if component == nil {
+		return false
+	}
+	return strings.EqualFold(component.Name, teamsMessageComponentName) &&
+		strings.EqualFold(component.Provider.Name, outlookProviderName)
+}
+
+// Execute posts the configured message to the selected Teams channel
+func (e *TeamsMessageExecutor) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.TeamsMessageExecutor: unsupported component")
+	}
+	if !e.graph.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.TeamsMessageExecutor: resolver not configured")
+	}
+
+	cfg, err := parseTeamsMessageConfig(link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.TeamsMessageExecutor: %w", err)
+	}
+
+	identity, accessToken, err := e.graph.resolveIdentity(ctx, area, cfg.identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+
+	payload, err := json.Marshal(map[string]any{
+		"body": map[string]any{
+			"contentType": cfg.contentType,
+			"content":     cfg.message,
+		},
+	})
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.TeamsMessageExecutor: build payload: %w", err)
+	}
+
+	endpoint := teamsChannelMessagesEndpoint(cfg.teamID, cfg.channelID)
+	result, identity, err := e.graph.deliver(ctx, identity, accessToken, http.MethodPost, endpoint, payload, teamsMessageRequestInfo(cfg))
+	if err != nil {
+		return result, err
+	}
+
+	e.logger.Info("teams reaction delivered",
+		zap.String("area_id", area.ID.String()),
+		zap.String("identity_id", identity.ID.String()),
+		zap.String("team_id", cfg.teamID),
+		zap.String("channel_id", cfg.channelID),
+	)
+	return result, nil
+}
+
+// Preview describes the channel message without posting it
+func (e *TeamsMessageExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.TeamsMessageExecutor: unsupported component")
+	}
+	if !e.graph.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.TeamsMessageExecutor: resolver not configured")
+	}
+	cfg, err := parseTeamsMessageConfig(link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("outlook.TeamsMessageExecutor: %w", err)
+	}
+	return e.graph.preview(ctx, area, cfg.identityID, teamsChannelMessagesEndpoint(cfg.teamID, cfg.channelID), teamsMessageRequestInfo(cfg))
+}
+
+func teamsMessageRequestInfo(cfg teamsMessageConfig) map[string]any {
+	return map[string]any{
+		"teamId":      cfg.teamID,
+		"channelId":   cfg.channelID,
+		"contentType": cfg.contentType,
+		"message":     cfg.message,
+	}
+}
+
+func teamsChannelMessagesEndpoint(teamID string, channelID string) string {
+	return fmt.Sprintf("%s/teams/%s/channels/%s/messages", graphAPIBaseURL, url.PathEscape(teamID), url.PathEscape(channelID))
+}
+
+type teamsMessageConfig struct {
+	identityID  uuid.UUID
+	teamID      string
+	channelID   string
+	message     string
+	contentType string
+}
+
+func parseTeamsMessageConfig(params map[string]any) (teamsMessageConfig, error) {
+	cfg := teamsMessageConfig{contentType: "text"}
+
+	identityID, err := parseIdentityParam(params)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.identityID = identityID
+
+	cfg.teamID, err = requiredString(params, "teamId")
+	if err != nil {
+		return cfg, err
+	}
+	cfg.channelID, err = requiredString(params, "channelId")
+	if err != nil {
+		return cfg, err
+	}
+
+	messageRaw, ok := params["message"]
+	if !ok {
+		return cfg, fmt.Errorf("message missing")
+	}
+	cfg.message, err = mailutils.ToString(messageRaw)
+	if err != nil {
+		return cfg, fmt.Errorf("message invalid")
+	}
+	if strings.TrimSpace(cfg.message) == "" {
+		return cfg, fmt.Errorf("message cannot be empty")
+	}
+	if len(cfg.message) > teamsMessageMaxLength {
+		return cfg, fmt.Errorf("message exceeds %d characters", teamsMessageMaxLength)
+	}
+
+	if raw, ok := params["contentType"]; ok {
+		value, err := mailutils.ToString(raw)
+		if err != nil {
+			return cfg, fmt.Errorf("contentType invalid")
+		}
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "", "text":
+			cfg.contentType = "text"
+		case "html":
+			cfg.contentType = "html"
+		default:
+			return cfg, fmt.Errorf("contentType must be text or html")
+		}
+	}
+
+	return cfg, nil
+}
+
+func requiredString(params map[string]any, key string) (string, error) {
+	raw, ok := params[key]
+	if !ok {
+		return "", fmt.Errorf("%s missing", key)
+	}
+	value, err := mailutils.ToString(raw)
+	if err != nil {
+		return "", fmt.Errorf("%s invalid", key)
+	}
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", fmt.Errorf("%s cannot be empty", key)
+	}
+	return value, nil
+}
+
+// Ensure TeamsMessageExecutor satisfies the ComponentReactionHandler contract
+var _ interface {
+	Supports(*componentdomain.Component) bool
+	Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error)
+} = (*TeamsMessageExecutor)(nil)
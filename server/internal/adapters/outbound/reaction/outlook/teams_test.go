@@ -0,0 +1,126 @@
+package outlook
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/google/uuid"
+)
+
+func TestTeamsMessageExecutorSupports(t *testing.T) {
+	exec := NewTeamsMessageExecutor(nil, nil, nil, nil, nil)
+
+	component := &componentdomain.Component{Name: teamsMessageComponentName, Provider: componentdomain.Provider{Name: outlookProviderName}}
+	if !exec.Supports(component) {
+		t.Fatalf("expected support for teams component")
+	}
+	if exec.Supports(&componentdomain.Component{Name: outlookComponentName, Provider: componentdomain.Provider{Name: outlookProviderName}}) {
+		t.Fatalf("unexpected support for outlook send component")
+	}
+}
+
+func TestTeamsMessageExecutorExecuteSuccess(t *testing.T) {
+	identityID := uuid.New()
+	userID := uuid.New()
+	repo := &stubIdentityRepo{identity: identitydomain.Identity{
+		ID:          identityID,
+		UserID:      userID,
+		Provider:    outlookProviderName,
+		AccessToken: "token-123",
+	}}
+	client := &stubHTTPClient{responses: []*http.Response{{StatusCode: http.StatusCreated, Body: ioNopCloser(`{"id":"msg-1"}`)}}}
+	exec := NewTeamsMessageExecutor(repo, stubProviderResolver{}, client, stubClock{now: time.Now()}, nil)
+
+	area := areadomain.Area{ID: uuid.New(), UserID: userID}
+	link := areadomain.Link{Config: componentdomain.Config{
+		Component: &componentdomain.Component{Name: teamsMessageComponentName, Provider: componentdomain.Provider{Name: outlookProviderName}},
+		Params: map[string]any{
+			"identityId":  identityID.String(),
+			"teamId":      "team-1",
+			"channelId":   "19:abc@thread.tacv2",
+			"message":     "<b>Deploy finished</b>",
+			"contentType": "html",
+		},
+	}}
+
+	result, err := exec.Execute(context.Background(), area, link)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := graphAPIBaseURL + "/teams/team-1/channels/19:abc@thread.tacv2/messages"
+	if result.Endpoint != expected {
+		t.Fatalf("unexpected endpoint %s", result.Endpoint)
+	}
+	if client.authHeaders[0] != "Bearer token-123" {
+		t.Fatalf("unexpected authorization header %s", client.authHeaders[0])
+	}
+	var payload map[string]any
+	if err := json.Unmarshal([]byte(client.bodies[0]), &payload); err != nil {
+		t.Fatalf("failed to decode payload: %v", err)
+	}
+	body, ok := payload["body"].(map[string]any)
+	if !ok {
+		t.Fatalf("expected body object")
+	}
+	if body["contentType"] != "html" || body["content"] != "<b>Deploy finished</b>" {
+		t.Fatalf("unexpected body %v", body)
+	}
+}
+
+func TestTeamsMessageExecutorRefreshOnUnauthorized(t *testing.T) {
+	identityID := uuid.New()
+	userID := uuid.New()
+	repo := &stubIdentityRepo{identity: identitydomain.Identity{
+		ID:           identityID,
+		UserID:       userID,
+		Provider:     outlookProviderName,
+		AccessToken:  "stale",
+		RefreshToken: "refresh",
+	}}
+	provider := &stubProvider{token: "fresh"}
+	client := &stubHTTPClient{responses: []*http.Response{
+		{StatusCode: http.StatusUnauthorized, Body: ioNopCloser("expired")},
+		{StatusCode: http.StatusCreated, Body: ioNopCloser("{}")},
+	}}
+	exec := NewTeamsMessageExecutor(repo, stubProviderResolver{provider: provider}, client, stubClock{now: time.Now()}, nil)
+
+	area := areadomain.Area{ID: uuid.New(), UserID: userID}
+	link := areadomain.Link{Config: componentdomain.Config{
+		Component: &componentdomain.Component{Name: teamsMessageComponentName, Provider: componentdomain.Provider{Name: outlookProviderName}},
+		Params: map[string]any{
+			"identityId": identityID.String(),
+			"teamId":     "team-1",
+			"channelId":  "channel-1",
+			"message":    "hello",
+		},
+	}}
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if provider.refreshCalls != 1 {
+		t.Fatalf("expected one refresh, got %d", provider.refreshCalls)
+	}
+	if len(client.authHeaders) != 2 || client.authHeaders[1] != "Bearer fresh" {
+		t.Fatalf("expected retry with refreshed token, got %v", client.authHeaders)
+	}
+}
+
+func TestParseTeamsMessageConfigRejectsUnknownContentType(t *testing.T) {
+	_, err := parseTeamsMessageConfig(map[string]any{
+		"identityId":  uuid.NewString(),
+		"teamId":      "team",
+		"channelId":   "channel",
+		"message":     "hi",
+		"contentType": "markdown",
+	})
+	if err == nil {
+		t.Fatalf("expected error for unsupported content type")
+	}
+}
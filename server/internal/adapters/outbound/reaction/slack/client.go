@@ -0,0 +1,321 @@
+package slack
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+)
+
+const (
+	slackAPIBaseURL = "https://slack.com/api"
+	// slackMaxRetryWait bounds how long a reaction waits in-process for a rate limit window to reopen
+	slackMaxRetryWait = 5 * time.Second
+	// slackDefaultRetryWait is used when a rate limited response carries no usable Retry-After header
+	slackDefaultRetryWait = time.Second
+)
+
+// apiClient bundles the identity lookup, token refresh and Slack Web API envelope handling shared by Slack executors
+type apiClient struct {
+	name       string
+	identities identityport.Repository
+	providers  ProviderResolver
+	http       HTTPClient
+	clock      Clock
+	wait       func(ctx context.Context, delay time.Duration) error
+}
+
+// apiCall describes a single Slack Web API request issued by a reaction
+// Calls with raw set skip the Slack envelope, which is used for pre-signed upload URLs
+type apiCall struct {
+	method   string
+	endpoint string
+	payload  []byte
+	form     url.Values
+	query    url.Values
+	raw      bool
+}
+
+// apiResponse carries the decoded Slack envelope along with the transport level outcome
+type apiResponse struct {
+	result       outbound.ReactionResult
+	body         map[string]any
+	unauthorized bool
+	rateLimited  bool
+	retryAfter   time.Duration
+}
+
+func newAPIClient(name string, identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock) apiClient {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return apiClient{name: name, identities: identities, providers: providers, http: client, clock: clock, wait: sleepContext}
+}
+
+func (c apiClient) configured() bool {
+	return c.identities != nil && c.providers != nil
+}
+
+// resolveIdentity loads the identity bound to the reaction and ensures it carries a usable access token
+func (c apiClient) resolveIdentity(ctx context.Context, area areadomain.Area, identityID uuid.UUID) (identitydomain.Identity, string, error) {
+	identity, err := c.identities.FindByID(ctx, identityID)
+	if err != nil {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity lookup: %w", c.name, err)
+	}
+	if identity.UserID != area.UserID {
+		return identitydomain.Identity{}, "", fmt.Errorf("%s: identity not owned by user", c.name)
+	}
+	return c.ensureAccessToken(ctx, identity, false)
+}
+
+func (c apiClient) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
+	now := c.now()
+	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
+		return identity, identity.AccessToken, nil
+	}
+
+	provider, ok := c.providers.Provider(slackProviderName)
+	if !ok {
+		return identity, "", fmt.Errorf("%s: provider %s not configured", c.name, slackProviderName)
+	}
+
+	exchange, err := provider.Refresh(ctx, identity)
+	if err != nil {
+		return identity, "", fmt.Errorf("%s: refresh token: %w", c.name, err)
+	}
+
+	refreshToken := exchange.Token.RefreshToken
+	if refreshToken == "" {
+		refreshToken = identity.RefreshToken
+	}
+	expiresAt := identity.ExpiresAt
+	if !exchange.Token.ExpiresAt.IsZero() {
+		expires := exchange.Token.ExpiresAt.UTC()
+		expiresAt = &expires
+	}
+	scopes := exchange.Token.Scope
+	if len(scopes) == 0 {
+		scopes = identity.Scopes
+	}
+	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
+
+	if err := c.identities.Update(ctx, updated); err != nil {
+		return identity, "", fmt.Errorf("%s: update identity: %w", c.name, err)
+	}
+
+	return updated, updated.AccessToken, nil
+}
+
+// session threads the identity and access token across the Slack calls issued by a single reaction
+type session struct {
+	api         apiClient
+	identity    identitydomain.Identity
+	accessToken string
+	result      outbound.ReactionResult
+}
+
+// call delivers the request, refreshing the access token once when Slack rejects the credentials
+// The decoded envelope is returned so operations can chain calls on identifiers from earlier responses
+func (s *session) call(ctx context.Context, call apiCall) (map[string]any, error) {
+	resp, err := s.api.attempt(ctx, s.accessToken, call)
+	if err != nil && resp.unauthorized {
+		s.identity, s.accessToken, err = s.api.ensureAccessToken(ctx, s.identity, true)
+		if err != nil {
+			return nil, err
+		}
+		resp, err = s.api.attempt(ctx, s.accessToken, call)
+		if err != nil && resp.unauthorized {
+			s.result = resp.result
+			return resp.body, fmt.Errorf("%s: unauthorized after refresh", s.api.name)
+		}
+	}
+	s.result = resp.result
+	return resp.body, err
+}
+
+// attempt sends the call and retries once when Slack asks to back off for a short period
+// Longer rate limit windows surface as errors so the job retry policy reschedules the reaction
+func (c apiClient) attempt(ctx context.Context, accessToken string, call apiCall) (apiResponse, error) {
+	resp, err := c.send(ctx, accessToken, call)
+	if err == nil || !resp.rateLimited || resp.retryAfter > slackMaxRetryWait {
+		return resp, err
+	}
+	if waitErr := c.wait(ctx, resp.retryAfter); waitErr != nil {
+		return resp, fmt.Errorf("%s: wait for rate limit: %w", c.name, waitErr)
+	}
+	return c.send(ctx, accessToken, call)
+}
+
+// encode renders the target URL and body of the call along with a printable copy of the body
+func (call apiCall) encode() (string, io.Reader, string, string) {
+	endpoint := call.endpoint
+	if len(call.query) > 0 {
+		endpoint += "?" + call.query.Encode()
+	}
+
+	var (
+		body        io.Reader
+		requestBody string
+		contentType string
+	)
+	switch {
+	case call.form != nil:
+		requestBody = call.form.Encode()
+		body = strings.NewReader(requestBody)
+		contentType = "application/x-www-form-urlencoded"
+	case call.payload != nil:
+		requestBody = string(call.payload)
+		body = bytes.NewReader(call.payload)
+		contentType = "application/json; charset=utf-8"
+		if call.raw {
+			requestBody = fmt.Sprintf("<%d bytes>", len(call.payload))
+			contentType = "application/octet-stream"
+		}
+	}
+	return endpoint, body, requestBody, contentType
+}
+
+func (call apiCall) setHeaders(req *http.Request, accessToken string, contentType string) {
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	req.Header.Set("User-Agent", "AREA-Server")
+}
+
+// preview describes the request the call would issue, with the access token redacted
+func (c apiClient) preview(ctx context.Context, call apiCall) (outbound.ReactionResult, error) {
+	endpoint, body, requestBody, contentType := call.encode()
+	req, err := http.NewRequestWithContext(ctx, call.method, endpoint, body)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("%s: build request: %w", c.name, err)
+	}
+	call.setHeaders(req, "<redacted>", contentType)
+	return outbound.ReactionResult{
+		Endpoint: call.endpoint,
+		Request: map[string]any{
+			"method":  call.method,
+			"url":     endpoint,
+			"headers": copyHeaders(req.Header),
+			"body":    requestBody,
+		},
+	}, nil
+}
+
+func (c apiClient) send(ctx context.Context, accessToken string, call apiCall) (apiResponse, error) {
+	endpoint, body, requestBody, contentType := call.encode()
+	req, err := http.NewRequestWithContext(ctx, call.method, endpoint, body)
+	if err != nil {
+		return apiResponse{}, fmt.Errorf("%s: build request: %w", c.name, err)
+	}
+	call.setHeaders(req, accessToken, contentType)
+
+	start := c.now()
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return apiResponse{}, fmt.Errorf("%s: request failed: %w", c.name, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, _ := io.ReadAll(resp.Body)
+	duration := c.now().Sub(start)
+
+	out := apiResponse{result: outbound.ReactionResult{
+		Endpoint: call.endpoint,
+		Request: map[string]any{
+			"method":  call.method,
+			"url":     endpoint,
+			"headers": copyHeaders(req.Header),
+			"body":    requestBody,
+		},
+		Response: map[string]any{
+			"body":    string(respBody),
+			"headers": copyHeaders(resp.Header),
+		},
+		StatusCode: &resp.StatusCode,
+		Duration:   duration,
+	}}
+
+	if resp.StatusCode == http.StatusTooManyRequests {
+		out.rateLimited = true
+		out.retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+		return out, fmt.Errorf("%s: rate limited, retry after %s", c.name, out.retryAfter)
+	}
+	if call.raw {
+		if resp.StatusCode >= 400 {
+			out.unauthorized = resp.StatusCode == http.StatusUnauthorized
+			return out, fmt.Errorf("%s: received status %d", c.name, resp.StatusCode)
+		}
+		return out, nil
+	}
+
+	var ack slackResponse
+	if len(respBody) > 0 {
+		_ = json.Unmarshal(respBody, &ack)
+		_ = json.Unmarshal(respBody, &out.body)
+	}
+	if resp.StatusCode < 400 && ack.OK {
+		return out, nil
+	}
+
+	out.unauthorized = isSlackUnauthorized(resp.StatusCode, ack.Error)
+	if strings.TrimSpace(ack.Error) == "ratelimited" {
+		out.rateLimited = true
+		out.retryAfter = parseRetryAfter(resp.Header.Get("Retry-After"))
+	}
+	errMsg := strings.TrimSpace(ack.Error)
+	if errMsg == "" {
+		errMsg = strings.TrimSpace(string(respBody))
+	}
+	if errMsg == "" {
+		errMsg = fmt.Sprintf("received status %d", resp.StatusCode)
+	}
+	return out, fmt.Errorf("%s: %s", c.name, errMsg)
+}
+
+func (c apiClient) now() time.Time {
+	if c.clock == nil {
+		return time.Now().UTC()
+	}
+	return c.clock.Now().UTC()
+}
+
+// parseRetryAfter reads the delay, expressed in seconds, that Slack sends alongside rate limited responses
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(strings.TrimSpace(value))
+	if err != nil || seconds < 0 {
+		return slackDefaultRetryWait
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+func methodEndpoint(method string) string {
+	return slackAPIBaseURL + "/" + method
+}
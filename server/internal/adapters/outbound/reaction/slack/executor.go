@@ -1,18 +1,15 @@
 package slack
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"strings"
 	"time"
 
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
-	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
 	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
@@ -48,30 +45,18 @@ func (systemClock) Now() time.Time {
 
 // MessageExecutor delivers Slack reactions that send channel messages
 type MessageExecutor struct {
-	identities identityport.Repository
-	providers  ProviderResolver
-	http       HTTPClient
-	clock      Clock
-	logger     *zap.Logger
+	api    apiClient
+	logger *zap.Logger
 }
 
 // NewMessageExecutor constructs a MessageExecutor from its dependencies
 func NewMessageExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *MessageExecutor {
-	if client == nil {
-		client = http.DefaultClient
-	}
-	if clock == nil {
-		clock = systemClock{}
-	}
 	if logger == nil {
 		logger = zap.NewNop()
 	}
 	return &MessageExecutor{
-		identities: identities,
-		providers:  providers,
-		http:       client,
-		clock:      clock,
-		logger:     logger,
+		api:    newAPIClient("slack.MessageExecutor", identities, providers, client, clock),
+		logger: logger,
 	}
 }
 
@@ -89,7 +74,7 @@ func (e *MessageExecutor) Execute(ctx context.Context, area areadomain.Area, lin
 	if !e.Supports(link.Config.Component) {
 		return outbound.ReactionResult{}, fmt.Errorf("slack.MessageExecutor: unsupported component")
 	}
-	if e.identities == nil || e.providers == nil {
+	if !e.api.configured() {
 		return outbound.ReactionResult{}, fmt.Errorf("slack.MessageExecutor: resolver not configured")
 	}
 
@@ -98,153 +83,58 @@ func (e *MessageExecutor) Execute(ctx context.Context, area areadomain.Area, lin
 		return outbound.ReactionResult{}, fmt.Errorf("slack.MessageExecutor: %w", err)
 	}
 
-	identity, err := e.identities.FindByID(ctx, cfg.identityID)
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, cfg.identityID)
 	if err != nil {
-		return outbound.ReactionResult{}, fmt.Errorf("slack.MessageExecutor: identity lookup: %w", err)
-	}
-	if identity.UserID != area.UserID {
-		return outbound.ReactionResult{}, fmt.Errorf("slack.MessageExecutor: identity not owned by user")
+		return outbound.ReactionResult{}, err
 	}
 
-	identity, accessToken, err := e.ensureAccessToken(ctx, identity, false)
+	call, err := messageCall(cfg)
 	if err != nil {
 		return outbound.ReactionResult{}, err
 	}
 
-	result, unauthorized, err := e.postMessage(ctx, accessToken, cfg)
-	if err != nil && unauthorized {
-		identity, accessToken, err = e.ensureAccessToken(ctx, identity, true)
-		if err != nil {
-			return outbound.ReactionResult{}, err
-		}
-		result, unauthorized, err = e.postMessage(ctx, accessToken, cfg)
-	}
-	if err != nil {
-		return result, err
-	}
-	if unauthorized {
-		return result, fmt.Errorf("slack.MessageExecutor: unauthorized after refresh")
+	sess := &session{api: e.api, identity: identity, accessToken: accessToken}
+	if _, err := sess.call(ctx, call); err != nil {
+		return sess.result, err
 	}
 
 	e.logger.Info("slack message sent",
 		zap.String("area_id", area.ID.String()),
-		zap.String("identity_id", identity.ID.String()),
+		zap.String("identity_id", sess.identity.ID.String()),
 		zap.String("channel_id", cfg.channelID),
 	)
-	return result, nil
+	return sess.result, nil
 }
 
-func (e *MessageExecutor) postMessage(ctx context.Context, accessToken string, cfg messageConfig) (outbound.ReactionResult, bool, error) {
-	payload := map[string]any{
-		"channel": cfg.channelID,
-		"text":    cfg.text,
-	}
-	if cfg.threadTs != "" {
-		payload["thread_ts"] = cfg.threadTs
-	}
-
-	bodyBytes, err := json.Marshal(payload)
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("slack.MessageExecutor: marshal payload: %w", err)
+// Preview describes the chat.postMessage call without sending it
+func (e *MessageExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("slack.MessageExecutor: unsupported component")
 	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, slackPostMessageEndpoint, bytes.NewReader(bodyBytes))
+	cfg, err := parseMessageConfig(link.Config.Params)
 	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("slack.MessageExecutor: build request: %w", err)
+		return outbound.ReactionResult{}, fmt.Errorf("slack.MessageExecutor: %w", err)
 	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
-	req.Header.Set("Content-Type", "application/json; charset=utf-8")
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", "AREA-Server")
-
-	start := e.now()
-	resp, err := e.http.Do(req)
+	call, err := messageCall(cfg)
 	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("slack.MessageExecutor: request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := io.ReadAll(resp.Body)
-	duration := e.now().Sub(start)
-
-	requestHeaders := copyHeaders(req.Header)
-	responseHeaders := copyHeaders(resp.Header)
-
-	result := outbound.ReactionResult{
-		Endpoint: slackPostMessageEndpoint,
-		Request: map[string]any{
-			"method":  http.MethodPost,
-			"url":     slackPostMessageEndpoint,
-			"headers": requestHeaders,
-			"body":    string(bodyBytes),
-		},
-		Response: map[string]any{
-			"body":    string(respBody),
-			"headers": responseHeaders,
-		},
-		StatusCode: &resp.StatusCode,
-		Duration:   duration,
-	}
-
-	var ack slackResponse
-	if len(respBody) > 0 {
-		_ = json.Unmarshal(respBody, &ack)
-	}
-
-	if resp.StatusCode >= 400 || !ack.OK {
-		unauthorized := isSlackUnauthorized(resp.StatusCode, ack.Error)
-		errMsg := strings.TrimSpace(ack.Error)
-		if errMsg == "" {
-			errMsg = strings.TrimSpace(string(respBody))
-		}
-		if errMsg == "" {
-			errMsg = fmt.Sprintf("received status %d", resp.StatusCode)
-		}
-		return result, unauthorized, fmt.Errorf("slack.MessageExecutor: %s", errMsg)
+		return outbound.ReactionResult{}, err
 	}
-
-	return result, false, nil
+	return e.api.preview(ctx, call)
 }
 
-func (e *MessageExecutor) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
-	now := e.now()
-	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
-		return identity, identity.AccessToken, nil
+func messageCall(cfg messageConfig) (apiCall, error) {
+	payload := map[string]any{
+		"channel": cfg.channelID,
+		"text":    cfg.text,
 	}
-
-	provider, ok := e.providers.Provider(slackProviderName)
-	if !ok {
-		return identity, "", fmt.Errorf("slack.MessageExecutor: provider %s not configured", slackProviderName)
+	if cfg.threadTs != "" {
+		payload["thread_ts"] = cfg.threadTs
 	}
-
-	exchange, err := provider.Refresh(ctx, identity)
+	bodyBytes, err := json.Marshal(payload)
 	if err != nil {
-		return identity, "", fmt.Errorf("slack.MessageExecutor: refresh token: %w", err)
-	}
-
-	refreshToken := exchange.Token.RefreshToken
-	if refreshToken == "" {
-		refreshToken = identity.RefreshToken
-	}
-	expiresAt := identity.ExpiresAt
-	if !exchange.Token.ExpiresAt.IsZero() {
-		expires := exchange.Token.ExpiresAt.UTC()
-		expiresAt = &expires
-	}
-	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, exchange.Token.Scope)
-
-	if err := e.identities.Update(ctx, updated); err != nil {
-		return identity, "", fmt.Errorf("slack.MessageExecutor: update identity: %w", err)
-	}
-
-	return updated, updated.AccessToken, nil
-}
-
-func (e *MessageExecutor) now() time.Time {
-	if e.clock == nil {
-		return time.Now().UTC()
+		return apiCall{}, fmt.Errorf("slack.MessageExecutor: marshal payload: %w", err)
 	}
-	return e.clock.Now().UTC()
+	return apiCall{method: http.MethodPost, endpoint: slackPostMessageEndpoint, payload: bodyBytes}, nil
 }
 
 type slackResponse struct {
@@ -0,0 +1,588 @@
+package slack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	postBlocksComponentName      = "slack_post_blocks"
+	replyInThreadComponentName   = "slack_reply_in_thread"
+	addReactionComponentName     = "slack_add_reaction"
+	uploadFileComponentName      = "slack_upload_file"
+	directMessageComponentName   = "slack_dm_user_by_email"
+	setChannelTopicComponentName = "slack_set_channel_topic"
+	setUserStatusComponentName   = "slack_set_user_status"
+)
+
+// workspaceInput gathers what an operation needs to plan its Slack calls
+// The event carries the payload of the action that triggered the reaction when available
+type workspaceInput struct {
+	params map[string]any
+	event  map[string]any
+	now    time.Time
+}
+
+// workspacePlan describes the target of a Slack reaction and the calls that perform it
+// Plans issuing a single call expose it so dry runs can describe the request without sending it
+type workspacePlan struct {
+	identityID uuid.UUID
+	target     string
+	run        func(ctx context.Context, sess *session) error
+	single     *apiCall
+}
+
+type workspaceOperation func(input workspaceInput) (workspacePlan, error)
+
+var workspaceOperations = map[string]workspaceOperation{
+	postBlocksComponentName:      planPostBlocks,
+	replyInThreadComponentName:   planReplyInThread,
+	addReactionComponentName:     planAddReaction,
+	uploadFileComponentName:      planUploadFile,
+	directMessageComponentName:   planDirectMessage,
+	setChannelTopicComponentName: planSetChannelTopic,
+	setUserStatusComponentName:   planSetUserStatus,
+}
+
+// WorkspaceExecutor delivers Slack reactions covering Block Kit messages, threads, reactions, files, DMs, topics and statuses
+type WorkspaceExecutor struct {
+	api    apiClient
+	logger *zap.Logger
+}
+
+// NewWorkspaceExecutor constructs a WorkspaceExecutor from its dependencies
+func NewWorkspaceExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *WorkspaceExecutor {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &WorkspaceExecutor{
+		api:    newAPIClient("slack.WorkspaceExecutor", identities, providers, client, clock),
+		logger: logger,
+	}
+}
+
+// Supports reports whether the executor can handle the provided component
+func (e *WorkspaceExecutor) Supports(component *componentdomain.Component) bool {
+	if component == nil || !strings.EqualFold(component.Provider.Name, slackProviderName) {
+		return false
+	}
+	_, ok := workspaceOperations[strings.ToLower(component.Name)]
+	return ok
+}
+
+// Execute performs the Slack operation selected by the component using the linked identity
+func (e *WorkspaceExecutor) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("slack.WorkspaceExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("slack.WorkspaceExecutor: resolver not configured")
+	}
+
+	component := link.Config.Component
+	event, _ := outbound.TriggerEvent(ctx)
+	plan, err := workspaceOperations[strings.ToLower(component.Name)](workspaceInput{
+		params: link.Config.Params,
+		event:  event,
+		now:    e.api.now(),
+	})
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("slack.WorkspaceExecutor: %w", err)
+	}
+
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, plan.identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+
+	sess := &session{api: e.api, identity: identity, accessToken: accessToken}
+	if err := plan.run(ctx, sess); err != nil {
+		return sess.result, err
+	}
+
+	e.logger.Info("slack reaction delivered",
+		zap.String("area_id", area.ID.String()),
+		zap.String("identity_id", sess.identity.ID.String()),
+		zap.String("component", component.Name),
+		zap.String("target", plan.target),
+	)
+	return sess.result, nil
+}
+
+// Preview describes the Slack call without sending it
+// Operations chaining several calls, such as file uploads, cannot be previewed
+func (e *WorkspaceExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("slack.WorkspaceExecutor: unsupported component")
+	}
+	event, _ := outbound.TriggerEvent(ctx)
+	plan, err := workspaceOperations[strings.ToLower(link.Config.Component.Name)](workspaceInput{
+		params: link.Config.Params,
+		event:  event,
+		now:    e.api.now(),
+	})
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("slack.WorkspaceExecutor: %w", err)
+	}
+	if plan.single == nil {
+		return outbound.ReactionResult{}, outbound.ErrPreviewUnsupported
+	}
+	return e.api.preview(ctx, *plan.single)
+}
+
+func planPostBlocks(input workspaceInput) (workspacePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	channelID, err := requiredString(input.params, "channelId")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	blocks, err := parseBlocks(input.params["blocks"])
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	if blocks == nil {
+		return workspacePlan{}, fmt.Errorf("parse message config: blocks missing")
+	}
+	text, err := optionalString(input.params, "text")
+	if err != nil {
+		return workspacePlan{}, fmt.Errorf("parse message config: text invalid: %w", err)
+	}
+	threadTs, err := optionalString(input.params, "threadTs")
+	if err != nil {
+		return workspacePlan{}, fmt.Errorf("parse message config: threadTs invalid: %w", err)
+	}
+
+	payload := map[string]any{"channel": channelID, "blocks": blocks}
+	if text != "" {
+		payload["text"] = text
+	}
+	if threadTs != "" {
+		payload["thread_ts"] = threadTs
+	}
+	return jsonPlan(identityID, channelID, "chat.postMessage", payload)
+}
+
+func planReplyInThread(input workspaceInput) (workspacePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	channelID, err := paramOrEvent(input, "channelId", "channel", "channel_id", "channelId", "item.channel")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	threadTs, err := paramOrEvent(input, "threadTs", "thread_ts", "threadTs", "ts", "item.ts")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	text, err := requiredString(input.params, "text")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	broadcast, err := optionalBool(input.params, "broadcast")
+	if err != nil {
+		return workspacePlan{}, fmt.Errorf("parse message config: broadcast invalid: %w", err)
+	}
+
+	payload := map[string]any{"channel": channelID, "thread_ts": threadTs, "text": text}
+	if broadcast {
+		payload["reply_broadcast"] = true
+	}
+	return jsonPlan(identityID, channelID+"/"+threadTs, "chat.postMessage", payload)
+}
+
+func planAddReaction(input workspaceInput) (workspacePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	channelID, err := paramOrEvent(input, "channelId", "channel", "channel_id", "channelId", "item.channel")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	timestamp, err := paramOrEvent(input, "timestamp", "ts", "item.ts")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	name, err := requiredString(input.params, "emoji")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	name = strings.Trim(name, ":")
+	if name == "" {
+		return workspacePlan{}, fmt.Errorf("parse message config: emoji empty")
+	}
+	return jsonPlan(identityID, channelID+"/"+timestamp, "reactions.add", map[string]any{
+		"channel":   channelID,
+		"timestamp": timestamp,
+		"name":      name,
+	})
+}
+
+// planUploadFile follows Slack's external upload flow: reserve an upload URL, send the bytes, then share the file
+func planUploadFile(input workspaceInput) (workspacePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	channelID, err := requiredString(input.params, "channelId")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	filename, err := requiredString(input.params, "filename")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	rawContent, ok := input.params["content"]
+	if !ok {
+		return workspacePlan{}, fmt.Errorf("parse message config: content missing")
+	}
+	content, err := toString(rawContent)
+	if err != nil {
+		return workspacePlan{}, fmt.Errorf("parse message config: content invalid: %w", err)
+	}
+	if content == "" {
+		return workspacePlan{}, fmt.Errorf("parse message config: content empty")
+	}
+	title, err := optionalString(input.params, "title")
+	if err != nil {
+		return workspacePlan{}, fmt.Errorf("parse message config: title invalid: %w", err)
+	}
+	if title == "" {
+		title = filename
+	}
+	comment, err := optionalString(input.params, "initialComment")
+	if err != nil {
+		return workspacePlan{}, fmt.Errorf("parse message config: initialComment invalid: %w", err)
+	}
+	threadTs, err := optionalString(input.params, "threadTs")
+	if err != nil {
+		return workspacePlan{}, fmt.Errorf("parse message config: threadTs invalid: %w", err)
+	}
+
+	return workspacePlan{
+		identityID: identityID,
+		target:     channelID + "/" + filename,
+		run: func(ctx context.Context, sess *session) error {
+			reserved, err := sess.call(ctx, apiCall{
+				method:   http.MethodPost,
+				endpoint: methodEndpoint("files.getUploadURLExternal"),
+				form:     url.Values{"filename": {filename}, "length": {strconv.Itoa(len(content))}},
+			})
+			if err != nil {
+				return err
+			}
+			uploadURL := stringValue(reserved, "upload_url")
+			fileID := stringValue(reserved, "file_id")
+			if uploadURL == "" || fileID == "" {
+				return fmt.Errorf("%s: upload url missing from response", sess.api.name)
+			}
+
+			if _, err := sess.call(ctx, apiCall{method: http.MethodPost, endpoint: uploadURL, payload: []byte(content), raw: true}); err != nil {
+				return err
+			}
+
+			files, err := json.Marshal([]map[string]string{{"id": fileID, "title": title}})
+			if err != nil {
+				return fmt.Errorf("%s: marshal files: %w", sess.api.name, err)
+			}
+			form := url.Values{"files": {string(files)}, "channel_id": {channelID}}
+			if comment != "" {
+				form.Set("initial_comment", comment)
+			}
+			if threadTs != "" {
+				form.Set("thread_ts", threadTs)
+			}
+			_, err = sess.call(ctx, apiCall{method: http.MethodPost, endpoint: methodEndpoint("files.completeUploadExternal"), form: form})
+			return err
+		},
+	}, nil
+}
+
+// planDirectMessage resolves the user by email, opens a DM conversation and posts the message there
+func planDirectMessage(input workspaceInput) (workspacePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	email, err := requiredString(input.params, "email")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	text, err := requiredString(input.params, "text")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	blocks, err := parseBlocks(input.params["blocks"])
+	if err != nil {
+		return workspacePlan{}, err
+	}
+
+	return workspacePlan{
+		identityID: identityID,
+		target:     email,
+		run: func(ctx context.Context, sess *session) error {
+			lookup, err := sess.call(ctx, apiCall{
+				method:   http.MethodGet,
+				endpoint: methodEndpoint("users.lookupByEmail"),
+				query:    url.Values{"email": {email}},
+			})
+			if err != nil {
+				return err
+			}
+			user, _ := lookup["user"].(map[string]any)
+			userID := stringValue(user, "id")
+			if userID == "" {
+				return fmt.Errorf("%s: user id missing from lookup response", sess.api.name)
+			}
+
+			openPayload, err := json.Marshal(map[string]any{"users": userID})
+			if err != nil {
+				return fmt.Errorf("%s: marshal payload: %w", sess.api.name, err)
+			}
+			opened, err := sess.call(ctx, apiCall{method: http.MethodPost, endpoint: methodEndpoint("conversations.open"), payload: openPayload})
+			if err != nil {
+				return err
+			}
+			channel, _ := opened["channel"].(map[string]any)
+			channelID := stringValue(channel, "id")
+			if channelID == "" {
+				return fmt.Errorf("%s: channel id missing from conversations.open response", sess.api.name)
+			}
+
+			message := map[string]any{"channel": channelID, "text": text}
+			if blocks != nil {
+				message["blocks"] = blocks
+			}
+			payload, err := json.Marshal(message)
+			if err != nil {
+				return fmt.Errorf("%s: marshal payload: %w", sess.api.name, err)
+			}
+			_, err = sess.call(ctx, apiCall{method: http.MethodPost, endpoint: slackPostMessageEndpoint, payload: payload})
+			return err
+		},
+	}, nil
+}
+
+func planSetChannelTopic(input workspaceInput) (workspacePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	channelID, err := requiredString(input.params, "channelId")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	topic, err := requiredString(input.params, "topic")
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	return jsonPlan(identityID, channelID, "conversations.setTopic", map[string]any{
+		"channel": channelID,
+		"topic":   topic,
+	})
+}
+
+// planSetUserStatus updates the custom status of the identity owner, clearing it when both text and emoji are empty
+func planSetUserStatus(input workspaceInput) (workspacePlan, error) {
+	identityID, err := parseIdentityID(input.params)
+	if err != nil {
+		return workspacePlan{}, err
+	}
+	text, err := optionalString(input.params, "statusText")
+	if err != nil {
+		return workspacePlan{}, fmt.Errorf("parse message config: statusText invalid: %w", err)
+	}
+	emoji, err := optionalString(input.params, "statusEmoji")
+	if err != nil {
+		return workspacePlan{}, fmt.Errorf("parse message config: statusEmoji invalid: %w", err)
+	}
+	if emoji != "" {
+		emoji = ":" + strings.Trim(emoji, ":") + ":"
+	}
+	minutes, err := optionalInt(input.params, "expirationMinutes")
+	if err != nil {
+		return workspacePlan{}, fmt.Errorf("parse message config: expirationMinutes invalid: %w", err)
+	}
+	if minutes < 0 {
+		return workspacePlan{}, fmt.Errorf("parse message config: expirationMinutes must be positive")
+	}
+
+	expiration := int64(0)
+	if minutes > 0 {
+		expiration = input.now.Add(time.Duration(minutes) * time.Minute).Unix()
+	}
+	return jsonPlan(identityID, "status", "users.profile.set", map[string]any{
+		"profile": map[string]any{
+			"status_text":       text,
+			"status_emoji":      emoji,
+			"status_expiration": expiration,
+		},
+	})
+}
+
+// jsonPlan builds a plan issuing a single JSON encoded Web API call
+func jsonPlan(identityID uuid.UUID, target string, method string, body map[string]any) (workspacePlan, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return workspacePlan{}, fmt.Errorf("marshal payload: %w", err)
+	}
+	call := apiCall{method: http.MethodPost, endpoint: methodEndpoint(method), payload: payload}
+	return workspacePlan{
+		identityID: identityID,
+		target:     target,
+		run: func(ctx context.Context, sess *session) error {
+			_, err := sess.call(ctx, call)
+			return err
+		},
+		single: &call,
+	}, nil
+}
+
+func parseIdentityID(params map[string]any) (uuid.UUID, error) {
+	raw, err := requiredString(params, "identityId")
+	if err != nil {
+		return uuid.Nil, err
+	}
+	identityID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("parse message config: parse identityId: %w", err)
+	}
+	return identityID, nil
+}
+
+// paramOrEvent reads a parameter and falls back to the first matching field of the triggering event
+func paramOrEvent(input workspaceInput, key string, eventPaths ...string) (string, error) {
+	value, err := optionalString(input.params, key)
+	if err != nil {
+		return "", fmt.Errorf("parse message config: %s invalid: %w", key, err)
+	}
+	if value != "" {
+		return value, nil
+	}
+	for _, path := range eventPaths {
+		if value := eventString(input.event, path); value != "" {
+			return value, nil
+		}
+	}
+	return "", fmt.Errorf("parse message config: %s missing and not found in triggering event", key)
+}
+
+func eventString(event map[string]any, path string) string {
+	var current any = event
+	for _, segment := range strings.Split(path, ".") {
+		node, ok := current.(map[string]any)
+		if !ok {
+			return ""
+		}
+		current = node[segment]
+	}
+	switch v := current.(type) {
+	case string:
+		return strings.TrimSpace(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return ""
+	}
+}
+
+// parseBlocks accepts Block Kit blocks either as a JSON array string, a full message object or a decoded list
+func parseBlocks(value any) ([]any, error) {
+	switch v := value.(type) {
+	case nil:
+		return nil, nil
+	case []any:
+		if len(v) == 0 {
+			return nil, nil
+		}
+		return v, nil
+	case map[string]any:
+		blocks, ok := v["blocks"].([]any)
+		if !ok {
+			return nil, fmt.Errorf("parse message config: blocks must be an array")
+		}
+		return parseBlocks(blocks)
+	case string:
+		trimmed := strings.TrimSpace(v)
+		if trimmed == "" {
+			return nil, nil
+		}
+		var decoded any
+		if err := json.Unmarshal([]byte(trimmed), &decoded); err != nil {
+			return nil, fmt.Errorf("parse message config: blocks invalid JSON: %w", err)
+		}
+		if _, ok := decoded.(string); ok {
+			return nil, fmt.Errorf("parse message config: blocks must be an array")
+		}
+		return parseBlocks(decoded)
+	default:
+		return nil, fmt.Errorf("parse message config: blocks must be an array")
+	}
+}
+
+func optionalBool(params map[string]any, key string) (bool, error) {
+	switch v := params[key].(type) {
+	case nil:
+		return false, nil
+	case bool:
+		return v, nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return false, nil
+		}
+		return strconv.ParseBool(strings.TrimSpace(v))
+	default:
+		return false, fmt.Errorf("expected boolean got %T", v)
+	}
+}
+
+func optionalInt(params map[string]any, key string) (int, error) {
+	switch v := params[key].(type) {
+	case nil:
+		return 0, nil
+	case int:
+		return v, nil
+	case int64:
+		return int(v), nil
+	case float64:
+		return int(v), nil
+	case string:
+		if strings.TrimSpace(v) == "" {
+			return 0, nil
+		}
+		return strconv.Atoi(strings.TrimSpace(v))
+	default:
+		return 0, fmt.Errorf("expected integer got %T", v)
+	}
+}
+
+func stringValue(values map[string]any, key string) string {
+	if values == nil {
+		return ""
+	}
+	str, _ := values[key].(string)
+	return strings.TrimSpace(str)
+}
+
+// Ensure WorkspaceExecutor satisfies the ComponentReactionHandler contract
+var _ interface {
+	Supports(*componentdomain.Component) bool
+	Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error)
+} = (*WorkspaceExecutor)(nil)
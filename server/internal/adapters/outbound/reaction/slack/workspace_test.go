@@ -0,0 +1,319 @@
+package slack
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func slackJSON(body string) http.Response {
+	return http.Response{
+		StatusCode: http.StatusOK,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func workspaceFixture(t *testing.T, componentName string, params map[string]any, responses ...http.Response) (*WorkspaceExecutor, *httpClientStub, areadomain.Area, areadomain.Link) {
+	t.Helper()
+	userID := uuid.New()
+	identityID := uuid.New()
+	now := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Hour)
+	repo := &identityRepoStub{identity: identitydomain.Identity{
+		ID:          identityID,
+		UserID:      userID,
+		Provider:    slackProviderName,
+		AccessToken: "xoxp-token",
+		ExpiresAt:   &expires,
+	}}
+	client := &httpClientStub{responses: responses}
+	exec := NewWorkspaceExecutor(repo, providerResolverStub{}, client, clockStub{now: now}, zap.NewNop())
+
+	params["identityId"] = identityID.String()
+	link := areadomain.Link{
+		ID:   uuid.New(),
+		Role: areadomain.LinkRoleReaction,
+		Config: componentdomain.Config{
+			Params: params,
+			Component: &componentdomain.Component{
+				Name:     componentName,
+				Provider: componentdomain.Provider{Name: slackProviderName},
+			},
+		},
+	}
+	return exec, client, areadomain.Area{ID: uuid.New(), UserID: userID}, link
+}
+
+func readBody(t *testing.T, req *http.Request) string {
+	t.Helper()
+	if req.Body == nil {
+		return ""
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	return string(body)
+}
+
+func TestWorkspaceExecutorSupports(t *testing.T) {
+	exec := NewWorkspaceExecutor(nil, providerResolverStub{}, nil, nil, nil)
+	for name := range workspaceOperations {
+		component := &componentdomain.Component{Name: name, Provider: componentdomain.Provider{Name: "Slack"}}
+		if !exec.Supports(component) {
+			t.Fatalf("expected %s to be supported", name)
+		}
+	}
+	if exec.Supports(&componentdomain.Component{Name: postMessageComponentName, Provider: componentdomain.Provider{Name: slackProviderName}}) {
+		t.Fatal("plain messages belong to the message executor")
+	}
+	if exec.Supports(&componentdomain.Component{Name: postBlocksComponentName, Provider: componentdomain.Provider{Name: "github"}}) {
+		t.Fatal("unexpected support for another provider")
+	}
+}
+
+func TestWorkspaceExecutorPostsBlocks(t *testing.T) {
+	exec, client, area, link := workspaceFixture(t, postBlocksComponentName, map[string]any{
+		"channelId": "C1",
+		"text":      "Deploy finished",
+		"blocks":    `[{"type":"section","text":{"type":"mrkdwn","text":"*Deploy* finished"}}]`,
+	}, slackJSON(`{"ok":true,"ts":"1.0"}`))
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	req := client.requests[0]
+	if req.URL.String() != "https://slack.com/api/chat.postMessage" {
+		t.Fatalf("unexpected url %s", req.URL)
+	}
+	body := readBody(t, req)
+	if !strings.Contains(body, `"blocks":[{"text":{"text":"*Deploy* finished","type":"mrkdwn"},"type":"section"}]`) || !strings.Contains(body, `"text":"Deploy finished"`) {
+		t.Fatalf("unexpected body %s", body)
+	}
+}
+
+func TestWorkspaceExecutorRepliesInTriggeringThread(t *testing.T) {
+	exec, client, area, link := workspaceFixture(t, replyInThreadComponentName, map[string]any{
+		"text": "On it",
+	}, slackJSON(`{"ok":true}`))
+
+	ctx := outbound.WithTriggerEvent(context.Background(), map[string]any{"channel": "C9", "ts": "1700000000.000200"})
+	if _, err := exec.Execute(ctx, area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	body := readBody(t, client.requests[0])
+	if !strings.Contains(body, `"thread_ts":"1700000000.000200"`) || !strings.Contains(body, `"channel":"C9"`) {
+		t.Fatalf("expected thread taken from event, got %s", body)
+	}
+
+	exec, _, area, link = workspaceFixture(t, replyInThreadComponentName, map[string]any{"text": "On it"})
+	if _, err := exec.Execute(context.Background(), area, link); err == nil || !strings.Contains(err.Error(), "not found in triggering event") {
+		t.Fatalf("expected missing thread error, got %v", err)
+	}
+}
+
+func TestWorkspaceExecutorAddsReaction(t *testing.T) {
+	exec, client, area, link := workspaceFixture(t, addReactionComponentName, map[string]any{
+		"channelId": "C1",
+		"timestamp": "1.5",
+		"emoji":     ":eyes:",
+	}, slackJSON(`{"ok":true}`))
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	req := client.requests[0]
+	if req.URL.Path != "/api/reactions.add" {
+		t.Fatalf("unexpected path %s", req.URL.Path)
+	}
+	if body := readBody(t, req); !strings.Contains(body, `"name":"eyes"`) {
+		t.Fatalf("expected emoji without colons, got %s", body)
+	}
+}
+
+func TestWorkspaceExecutorUploadsFile(t *testing.T) {
+	exec, client, area, link := workspaceFixture(t, uploadFileComponentName, map[string]any{
+		"channelId":      "C1",
+		"filename":       "report.csv",
+		"content":        "a,b\n1,2\n",
+		"initialComment": "Daily report",
+	},
+		slackJSON(`{"ok":true,"upload_url":"https://files.slack.com/upload/v1/abc","file_id":"F1"}`),
+		http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("OK - 8"))},
+		slackJSON(`{"ok":true,"files":[{"id":"F1"}]}`),
+	)
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if len(client.requests) != 3 {
+		t.Fatalf("expected 3 requests got %d", len(client.requests))
+	}
+	reserve, _ := url.ParseQuery(readBody(t, client.requests[0]))
+	if reserve.Get("filename") != "report.csv" || reserve.Get("length") != "8" {
+		t.Fatalf("unexpected reservation form %v", reserve)
+	}
+	if client.requests[1].URL.String() != "https://files.slack.com/upload/v1/abc" || readBody(t, client.requests[1]) != "a,b\n1,2\n" {
+		t.Fatalf("unexpected upload request %s", client.requests[1].URL)
+	}
+	complete, _ := url.ParseQuery(readBody(t, client.requests[2]))
+	if complete.Get("channel_id") != "C1" || complete.Get("initial_comment") != "Daily report" || !strings.Contains(complete.Get("files"), `"id":"F1"`) {
+		t.Fatalf("unexpected completion form %v", complete)
+	}
+}
+
+func TestWorkspaceExecutorPreviewDoesNotSend(t *testing.T) {
+	exec, client, area, link := workspaceFixture(t, replyInThreadComponentName, map[string]any{"text": "On it"})
+
+	ctx := outbound.WithTriggerEvent(context.Background(), map[string]any{"channel": "C9", "ts": "1.2"})
+	result, err := exec.Preview(ctx, area, link)
+	if err != nil {
+		t.Fatalf("Preview returned error: %v", err)
+	}
+	if len(client.requests) != 0 {
+		t.Fatalf("preview must not send requests")
+	}
+	if body, _ := result.Request["body"].(string); !strings.Contains(body, `"thread_ts":"1.2"`) {
+		t.Fatalf("unexpected preview body %v", result.Request["body"])
+	}
+	headers, _ := result.Request["headers"].(map[string][]string)
+	if got := headers["Authorization"]; len(got) != 1 || strings.Contains(got[0], "xoxp") {
+		t.Fatalf("expected redacted token got %v", got)
+	}
+
+	exec, _, area, link = workspaceFixture(t, uploadFileComponentName, map[string]any{"channelId": "C1", "filename": "a.txt", "content": "a"})
+	if _, err := exec.Preview(context.Background(), area, link); !errors.Is(err, outbound.ErrPreviewUnsupported) {
+		t.Fatalf("expected ErrPreviewUnsupported for chained calls got %v", err)
+	}
+}
+
+func TestWorkspaceExecutorMessagesUserByEmail(t *testing.T) {
+	exec, client, area, link := workspaceFixture(t, directMessageComponentName, map[string]any{
+		"email": "ada@example.com",
+		"text":  "Hello Ada",
+	},
+		slackJSON(`{"ok":true,"user":{"id":"U42"}}`),
+		slackJSON(`{"ok":true,"channel":{"id":"D42"}}`),
+		slackJSON(`{"ok":true,"ts":"2.0"}`),
+	)
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if got := client.requests[0].URL.Query().Get("email"); got != "ada@example.com" {
+		t.Fatalf("unexpected lookup email %q", got)
+	}
+	if body := readBody(t, client.requests[1]); !strings.Contains(body, `"users":"U42"`) {
+		t.Fatalf("unexpected conversations.open body %s", body)
+	}
+	if body := readBody(t, client.requests[2]); !strings.Contains(body, `"channel":"D42"`) {
+		t.Fatalf("expected message sent to DM channel, got %s", body)
+	}
+}
+
+func TestWorkspaceExecutorSetsUserStatusWithExpiration(t *testing.T) {
+	exec, client, area, link := workspaceFixture(t, setUserStatusComponentName, map[string]any{
+		"statusText":        "In a meeting",
+		"statusEmoji":       "calendar",
+		"expirationMinutes": float64(30),
+	}, slackJSON(`{"ok":true}`))
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	body := readBody(t, client.requests[0])
+	expected := time.Date(2025, 5, 1, 9, 30, 0, 0, time.UTC).Unix()
+	if !strings.Contains(body, `"status_emoji":":calendar:"`) || !strings.Contains(body, `"status_expiration":`+strconv.FormatInt(expected, 10)) {
+		t.Fatalf("unexpected status body %s", body)
+	}
+}
+
+func TestWorkspaceExecutorSurfacesSlackErrors(t *testing.T) {
+	exec, _, area, link := workspaceFixture(t, setChannelTopicComponentName, map[string]any{
+		"channelId": "C404",
+		"topic":     "Release week",
+	}, slackJSON(`{"ok":false,"error":"channel_not_found"}`))
+
+	result, err := exec.Execute(context.Background(), area, link)
+	if err == nil || !strings.Contains(err.Error(), "channel_not_found") {
+		t.Fatalf("expected Slack error code, got %v", err)
+	}
+	if result.StatusCode == nil || *result.StatusCode != http.StatusOK {
+		t.Fatalf("expected result recorded for failed call, got %+v", result.StatusCode)
+	}
+}
+
+func TestWorkspaceExecutorRetriesAfterShortRateLimit(t *testing.T) {
+	limited := http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"2"}},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	exec, client, area, link := workspaceFixture(t, setChannelTopicComponentName, map[string]any{
+		"channelId": "C1",
+		"topic":     "Release week",
+	}, limited, slackJSON(`{"ok":true}`))
+	var waited []time.Duration
+	exec.api.wait = func(_ context.Context, delay time.Duration) error {
+		waited = append(waited, delay)
+		return nil
+	}
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if len(client.requests) != 2 || len(waited) != 1 || waited[0] != 2*time.Second {
+		t.Fatalf("expected a single retry after 2s, got %d requests and waits %v", len(client.requests), waited)
+	}
+}
+
+func TestWorkspaceExecutorFailsOnLongRateLimit(t *testing.T) {
+	limited := http.Response{
+		StatusCode: http.StatusTooManyRequests,
+		Header:     http.Header{"Retry-After": []string{"60"}},
+		Body:       io.NopCloser(strings.NewReader("")),
+	}
+	exec, client, area, link := workspaceFixture(t, setChannelTopicComponentName, map[string]any{
+		"channelId": "C1",
+		"topic":     "Release week",
+	}, limited)
+	exec.api.wait = func(context.Context, time.Duration) error {
+		t.Fatal("should not wait for long rate limit windows")
+		return nil
+	}
+
+	_, err := exec.Execute(context.Background(), area, link)
+	if err == nil || !strings.Contains(err.Error(), "rate limited, retry after 1m0s") {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+	if len(client.requests) != 1 {
+		t.Fatalf("expected a single request got %d", len(client.requests))
+	}
+}
+
+func TestParseBlocks(t *testing.T) {
+	blocks, err := parseBlocks(`{"blocks":[{"type":"divider"}]}`)
+	if err != nil || len(blocks) != 1 {
+		t.Fatalf("expected message object to be unwrapped, got %v %v", blocks, err)
+	}
+	if _, err := parseBlocks(`"divider"`); err == nil {
+		t.Fatal("expected error for non array blocks")
+	}
+	if blocks, err := parseBlocks(" "); err != nil || blocks != nil {
+		t.Fatalf("expected empty blocks to be ignored, got %v %v", blocks, err)
+	}
+}
@@ -1,11 +1,9 @@
 package spotify
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -14,7 +12,6 @@ import (
 
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
-	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
 	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
@@ -50,30 +47,18 @@ func (systemClock) Now() time.Time {
 
 // AddTrackExecutor delivers Spotify reactions that append tracks to playlists
 type AddTrackExecutor struct {
-	identities identityport.Repository
-	providers  ProviderResolver
-	http       HTTPClient
-	clock      Clock
-	logger     *zap.Logger
+	api    apiClient
+	logger *zap.Logger
 }
 
 // NewAddTrackExecutor constructs an AddTrackExecutor from its dependencies
 func NewAddTrackExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *AddTrackExecutor {
-	if client == nil {
-		client = http.DefaultClient
-	}
-	if clock == nil {
-		clock = systemClock{}
-	}
 	if logger == nil {
 		logger = zap.NewNop()
 	}
 	return &AddTrackExecutor{
-		identities: identities,
-		providers:  providers,
-		http:       client,
-		clock:      clock,
-		logger:     logger,
+		api:    newAPIClient("spotify.AddTrackExecutor", identities, providers, client, clock),
+		logger: logger,
 	}
 }
 
@@ -91,7 +76,7 @@ func (e *AddTrackExecutor) Execute(ctx context.Context, area areadomain.Area, li
 	if !e.Supports(link.Config.Component) {
 		return outbound.ReactionResult{}, fmt.Errorf("spotify.AddTrackExecutor: unsupported component")
 	}
-	if e.identities == nil || e.providers == nil {
+	if !e.api.configured() {
 		return outbound.ReactionResult{}, fmt.Errorf("spotify.AddTrackExecutor: resolver not configured")
 	}
 
@@ -100,150 +85,61 @@ func (e *AddTrackExecutor) Execute(ctx context.Context, area areadomain.Area, li
 		return outbound.ReactionResult{}, fmt.Errorf("spotify.AddTrackExecutor: %w", err)
 	}
 
-	identity, err := e.identities.FindByID(ctx, cfg.identityID)
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, cfg.identityID)
 	if err != nil {
-		return outbound.ReactionResult{}, fmt.Errorf("spotify.AddTrackExecutor: identity lookup: %w", err)
-	}
-	if identity.UserID != area.UserID {
-		return outbound.ReactionResult{}, fmt.Errorf("spotify.AddTrackExecutor: identity not owned by user")
+		return outbound.ReactionResult{}, err
 	}
 
-	identity, accessToken, err := e.ensureAccessToken(ctx, identity, false)
+	call, err := addTrackCall(cfg)
 	if err != nil {
 		return outbound.ReactionResult{}, err
 	}
 
-	result, unauthorized, err := e.addTrack(ctx, accessToken, cfg)
-	if err != nil && unauthorized {
-		identity, accessToken, err = e.ensureAccessToken(ctx, identity, true)
-		if err != nil {
-			return outbound.ReactionResult{}, err
-		}
-		result, unauthorized, err = e.addTrack(ctx, accessToken, cfg)
-	}
-	if err != nil {
-		return result, err
-	}
-	if unauthorized {
-		return result, fmt.Errorf("spotify.AddTrackExecutor: unauthorized after refresh")
+	sess := &session{api: e.api, identity: identity, accessToken: accessToken}
+	if _, err := sess.call(ctx, call); err != nil {
+		return sess.result, err
 	}
 
 	e.logger.Info("spotify track added to playlist",
 		zap.String("area_id", area.ID.String()),
-		zap.String("identity_id", identity.ID.String()),
+		zap.String("identity_id", sess.identity.ID.String()),
 		zap.String("playlist_id", cfg.playlistID),
 	)
-	return result, nil
+	return sess.result, nil
 }
 
-func (e *AddTrackExecutor) addTrack(ctx context.Context, accessToken string, cfg addTrackConfig) (outbound.ReactionResult, bool, error) {
-	endpoint := fmt.Sprintf(spotifyAddTrackEndpointTmpl, url.PathEscape(cfg.playlistID))
-
-	payload := map[string]any{
-		"uris": []string{cfg.trackURI},
+// Preview describes the playlist request without sending it
+func (e *AddTrackExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("spotify.AddTrackExecutor: unsupported component")
 	}
-	if cfg.position != nil {
-		payload["position"] = *cfg.position
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("spotify.AddTrackExecutor: resolver not configured")
 	}
-
-	bodyBytes, err := json.Marshal(payload)
+	cfg, err := parseAddTrackConfig(link.Config.Params)
 	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("spotify.AddTrackExecutor: marshal payload: %w", err)
+		return outbound.ReactionResult{}, fmt.Errorf("spotify.AddTrackExecutor: %w", err)
 	}
-
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(bodyBytes))
+	call, err := addTrackCall(cfg)
 	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("spotify.AddTrackExecutor: build request: %w", err)
-	}
-	req.Header.Set("Authorization", "Bearer "+accessToken)
-	req.Header.Set("Content-Type", "application/json")
-	req.Header.Set("Accept", "application/json")
-	req.Header.Set("User-Agent", "AREA-Server")
-
-	start := e.now()
-	resp, err := e.http.Do(req)
-	if err != nil {
-		return outbound.ReactionResult{}, false, fmt.Errorf("spotify.AddTrackExecutor: request failed: %w", err)
-	}
-	defer resp.Body.Close()
-
-	respBody, _ := io.ReadAll(resp.Body)
-	duration := e.now().Sub(start)
-
-	requestHeaders := copyHeaders(req.Header)
-	responseHeaders := copyHeaders(resp.Header)
-
-	result := outbound.ReactionResult{
-		Endpoint: endpoint,
-		Request: map[string]any{
-			"method":  http.MethodPost,
-			"url":     endpoint,
-			"headers": requestHeaders,
-			"body":    string(bodyBytes),
-		},
-		Response: map[string]any{
-			"body":    string(respBody),
-			"headers": responseHeaders,
-		},
-		StatusCode: &resp.StatusCode,
-		Duration:   duration,
-	}
-
-	if resp.StatusCode >= 400 {
-		if resp.StatusCode == http.StatusUnauthorized || resp.StatusCode == http.StatusForbidden {
-			return result, true, fmt.Errorf("spotify.AddTrackExecutor: received status %d", resp.StatusCode)
-		}
-		return result, false, fmt.Errorf("spotify.AddTrackExecutor: received status %d", resp.StatusCode)
+		return outbound.ReactionResult{}, err
 	}
-
-	return result, false, nil
+	return e.api.preview(ctx, area, cfg.identityID, call)
 }
 
-func (e *AddTrackExecutor) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, force bool) (identitydomain.Identity, string, error) {
-	now := e.now()
-	if identity.AccessToken != "" && !force && !identity.TokenExpired(now) {
-		return identity, identity.AccessToken, nil
+func addTrackCall(cfg addTrackConfig) (apiCall, error) {
+	payload := map[string]any{
+		"uris": []string{cfg.trackURI},
 	}
-
-	provider, ok := e.providers.Provider(spotifyProviderName)
-	if !ok {
-		return identity, "", fmt.Errorf("spotify.AddTrackExecutor: provider %s not configured", spotifyProviderName)
+	if cfg.position != nil {
+		payload["position"] = *cfg.position
 	}
-
-	exchange, err := provider.Refresh(ctx, identity)
+	bodyBytes, err := json.Marshal(payload)
 	if err != nil {
-		return identity, "", fmt.Errorf("spotify.AddTrackExecutor: refresh token: %w", err)
+		return apiCall{}, fmt.Errorf("spotify.AddTrackExecutor: marshal payload: %w", err)
 	}
-
-	refreshToken := exchange.Token.RefreshToken
-	if refreshToken == "" {
-		refreshToken = identity.RefreshToken
-	}
-	expiresAt := identity.ExpiresAt
-	if !exchange.Token.ExpiresAt.IsZero() {
-		expires := exchange.Token.ExpiresAt.UTC()
-		expiresAt = &expires
-	}
-	scopes := exchange.Token.Scope
-	if len(scopes) == 0 {
-		scopes = identity.Scopes
-	}
-
-	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
-	updated.UpdatedAt = now
-
-	if err := e.identities.Update(ctx, updated); err != nil {
-		return identity, "", fmt.Errorf("spotify.AddTrackExecutor: update identity: %w", err)
-	}
-
-	return updated, updated.AccessToken, nil
-}
-
-func (e *AddTrackExecutor) now() time.Time {
-	if e.clock == nil {
-		return time.Now().UTC()
-	}
-	return e.clock.Now().UTC()
+	endpoint := fmt.Sprintf(spotifyAddTrackEndpointTmpl, url.PathEscape(cfg.playlistID))
+	return apiCall{method: http.MethodPost, endpoint: endpoint, payload: bodyBytes}, nil
 }
 
 type addTrackConfig struct {
@@ -426,15 +322,15 @@ func toInt(value any) (int, error) {
 func requiredString(params map[string]any, key string) (string, error) {
 	value, ok := params[key]
 	if !ok {
-		return "", fmt.Errorf("parse add track config: %s missing", key)
+		return "", fmt.Errorf("parse config: %s missing", key)
 	}
 	str, err := toString(value)
 	if err != nil {
-		return "", fmt.Errorf("parse add track config: %s invalid: %w", key, err)
+		return "", fmt.Errorf("parse config: %s invalid: %w", key, err)
 	}
 	trimmed := strings.TrimSpace(str)
 	if trimmed == "" {
-		return "", fmt.Errorf("parse add track config: %s empty", key)
+		return "", fmt.Errorf("parse config: %s empty", key)
 	}
 	return trimmed, nil
 }
@@ -233,16 +233,23 @@ func (providerResolverStub) Provider(string) (identityport.Provider, bool) {
 
 type httpClientStub struct {
 	response    http.Response
+	responses   []http.Response
+	requests    []*http.Request
 	lastRequest *http.Request
 	err         error
 }
 
 func (c *httpClientStub) Do(req *http.Request) (*http.Response, error) {
 	c.lastRequest = req
+	c.requests = append(c.requests, req)
 	if c.err != nil {
 		return nil, c.err
 	}
 	resp := c.response
+	if len(c.responses) > 0 {
+		resp = c.responses[0]
+		c.responses = c.responses[1:]
+	}
 	if resp.Body == nil {
 		resp.Body = io.NopCloser(strings.NewReader("{}"))
 	}
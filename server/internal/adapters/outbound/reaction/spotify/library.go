@@ -0,0 +1,501 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	createPlaylistComponentName = "spotify_create_playlist"
+	removeTracksComponentName   = "spotify_remove_tracks_from_playlist"
+	startPlaybackComponentName  = "spotify_start_playback"
+	pausePlaybackComponentName  = "spotify_pause_playback"
+	queueTrackComponentName     = "spotify_queue_track"
+	saveAlbumComponentName      = "spotify_save_album"
+	spotifyMaxTracksPerRequest  = 100
+)
+
+// libraryPlan describes the target of a Spotify reaction and the calls that perform it
+// call is set when the plan issues a single request known upfront, which makes it previewable
+type libraryPlan struct {
+	identityID uuid.UUID
+	target     string
+	call       *apiCall
+	run        func(ctx context.Context, sess *session) error
+}
+
+type libraryOperation func(params map[string]any) (libraryPlan, error)
+
+var libraryOperations = map[string]libraryOperation{
+	createPlaylistComponentName: planCreatePlaylist,
+	removeTracksComponentName:   planRemoveTracks,
+	startPlaybackComponentName:  planStartPlayback,
+	pausePlaybackComponentName:  planPausePlayback,
+	queueTrackComponentName:     planQueueTrack,
+	saveAlbumComponentName:      planSaveAlbum,
+}
+
+// LibraryExecutor delivers Spotify reactions that manage playlists, saved albums and playback
+type LibraryExecutor struct {
+	api    apiClient
+	logger *zap.Logger
+}
+
+// NewLibraryExecutor constructs a LibraryExecutor from its dependencies
+func NewLibraryExecutor(identities identityport.Repository, providers ProviderResolver, client HTTPClient, clock Clock, logger *zap.Logger) *LibraryExecutor {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &LibraryExecutor{
+		api:    newAPIClient("spotify.LibraryExecutor", identities, providers, client, clock),
+		logger: logger,
+	}
+}
+
+// Supports reports whether the executor can handle the provided component
+func (e *LibraryExecutor) Supports(component *componentdomain.Component) bool {
+	if component == nil || !strings.EqualFold(component.Provider.Name, spotifyProviderName) {
+		return false
+	}
+	_, ok := libraryOperations[strings.ToLower(component.Name)]
+	return ok
+}
+
+// Execute performs the Spotify operation selected by the component using the linked identity
+func (e *LibraryExecutor) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("spotify.LibraryExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("spotify.LibraryExecutor: resolver not configured")
+	}
+
+	component := link.Config.Component
+	plan, err := libraryOperations[strings.ToLower(component.Name)](link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("spotify.LibraryExecutor: %w", err)
+	}
+
+	identity, accessToken, err := e.api.resolveIdentity(ctx, area, plan.identityID)
+	if err != nil {
+		return outbound.ReactionResult{}, err
+	}
+
+	sess := &session{api: e.api, identity: identity, accessToken: accessToken}
+	if err := plan.run(ctx, sess); err != nil {
+		return sess.result, err
+	}
+
+	e.logger.Info("spotify reaction delivered",
+		zap.String("area_id", area.ID.String()),
+		zap.String("identity_id", sess.identity.ID.String()),
+		zap.String("component", component.Name),
+		zap.String("target", plan.target),
+	)
+	return sess.result, nil
+}
+
+// Preview describes the request of the Spotify operation without sending it
+// Creating playlists and targeting a device by name look the account up first and cannot be previewed
+func (e *LibraryExecutor) Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	if !e.Supports(link.Config.Component) {
+		return outbound.ReactionResult{}, fmt.Errorf("spotify.LibraryExecutor: unsupported component")
+	}
+	if !e.api.configured() {
+		return outbound.ReactionResult{}, fmt.Errorf("spotify.LibraryExecutor: resolver not configured")
+	}
+	plan, err := libraryOperations[strings.ToLower(link.Config.Component.Name)](link.Config.Params)
+	if err != nil {
+		return outbound.ReactionResult{}, fmt.Errorf("spotify.LibraryExecutor: %w", err)
+	}
+	if plan.call == nil {
+		return outbound.ReactionResult{}, outbound.ErrPreviewUnsupported
+	}
+	return e.api.preview(ctx, area, plan.identityID, *plan.call)
+}
+
+// planCreatePlaylist creates a playlist owned by the linked account
+func planCreatePlaylist(params map[string]any) (libraryPlan, error) {
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return libraryPlan{}, err
+	}
+	name, err := requiredString(params, "name")
+	if err != nil {
+		return libraryPlan{}, err
+	}
+	payload := map[string]any{"name": name, "public": false}
+	if description, _ := params["description"].(string); strings.TrimSpace(description) != "" {
+		payload["description"] = strings.TrimSpace(description)
+	}
+	public, err := optionalBool(params, "public")
+	if err != nil {
+		return libraryPlan{}, fmt.Errorf("parse config: public invalid: %w", err)
+	}
+	if public != nil {
+		payload["public"] = *public
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return libraryPlan{}, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	return libraryPlan{
+		identityID: identityID,
+		target:     name,
+		run: func(ctx context.Context, sess *session) error {
+			userID := strings.TrimSpace(sess.identity.Subject)
+			if userID == "" {
+				profile, err := sess.call(ctx, apiCall{method: http.MethodGet, endpoint: spotifyAPIBaseURL + "/me"})
+				if err != nil {
+					return err
+				}
+				userID, _ = profile["id"].(string)
+				if userID == "" {
+					return fmt.Errorf("%s: spotify user id unavailable", sess.api.name)
+				}
+			}
+			endpoint := spotifyAPIBaseURL + "/users/" + url.PathEscape(userID) + "/playlists"
+			_, err := sess.call(ctx, apiCall{method: http.MethodPost, endpoint: endpoint, payload: body})
+			return err
+		},
+	}, nil
+}
+
+// planRemoveTracks removes every occurrence of the listed tracks from a playlist
+func planRemoveTracks(params map[string]any) (libraryPlan, error) {
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return libraryPlan{}, err
+	}
+	playlistValue, err := requiredString(params, "playlistId")
+	if err != nil {
+		return libraryPlan{}, err
+	}
+	playlistID, err := normalizePlaylistID(playlistValue)
+	if err != nil {
+		return libraryPlan{}, fmt.Errorf("parse config: playlistId invalid: %w", err)
+	}
+	uris, err := parseTrackList(params, "trackUris")
+	if err != nil {
+		return libraryPlan{}, err
+	}
+	if len(uris) == 0 {
+		return libraryPlan{}, fmt.Errorf("parse config: trackUris empty")
+	}
+	tracks := make([]map[string]any, 0, len(uris))
+	for _, uri := range uris {
+		tracks = append(tracks, map[string]any{"uri": uri})
+	}
+	body, err := json.Marshal(map[string]any{"tracks": tracks})
+	if err != nil {
+		return libraryPlan{}, fmt.Errorf("marshal payload: %w", err)
+	}
+
+	call := apiCall{method: http.MethodDelete, endpoint: fmt.Sprintf(spotifyAddTrackEndpointTmpl, url.PathEscape(playlistID)), payload: body}
+	return libraryPlan{
+		identityID: identityID,
+		target:     playlistID,
+		call:       &call,
+		run: func(ctx context.Context, sess *session) error {
+			_, err := sess.call(ctx, call)
+			return err
+		},
+	}, nil
+}
+
+// planStartPlayback resumes playback, or starts the given context or tracks, on the selected device
+func planStartPlayback(params map[string]any) (libraryPlan, error) {
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return libraryPlan{}, err
+	}
+	payload := map[string]any{}
+	if value, _ := params["contextUri"].(string); strings.TrimSpace(value) != "" {
+		contextURI, err := normalizeContextURI(value)
+		if err != nil {
+			return libraryPlan{}, fmt.Errorf("parse config: contextUri invalid: %w", err)
+		}
+		payload["context_uri"] = contextURI
+	}
+	uris, err := parseTrackList(params, "trackUris")
+	if err != nil {
+		return libraryPlan{}, err
+	}
+	if len(uris) > 0 {
+		if _, ok := payload["context_uri"]; ok {
+			return libraryPlan{}, fmt.Errorf("parse config: contextUri and trackUris are mutually exclusive")
+		}
+		payload["uris"] = uris
+	}
+	var body []byte
+	if len(payload) > 0 {
+		if body, err = json.Marshal(payload); err != nil {
+			return libraryPlan{}, fmt.Errorf("marshal payload: %w", err)
+		}
+	}
+	return playerPlan(identityID, params, http.MethodPut, "/me/player/play", nil, body), nil
+}
+
+// planPausePlayback pauses playback on the selected device
+func planPausePlayback(params map[string]any) (libraryPlan, error) {
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return libraryPlan{}, err
+	}
+	return playerPlan(identityID, params, http.MethodPut, "/me/player/pause", nil, nil), nil
+}
+
+// planQueueTrack adds a track to the end of the playback queue
+func planQueueTrack(params map[string]any) (libraryPlan, error) {
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return libraryPlan{}, err
+	}
+	trackValue, err := requiredString(params, "trackUri")
+	if err != nil {
+		return libraryPlan{}, err
+	}
+	trackURI, err := normalizeTrackURI(trackValue)
+	if err != nil {
+		return libraryPlan{}, fmt.Errorf("parse config: trackUri invalid: %w", err)
+	}
+	return playerPlan(identityID, params, http.MethodPost, "/me/player/queue", url.Values{"uri": {trackURI}}, nil), nil
+}
+
+// planSaveAlbum saves an album to the library of the linked account
+func planSaveAlbum(params map[string]any) (libraryPlan, error) {
+	identityID, err := parseIdentityID(params)
+	if err != nil {
+		return libraryPlan{}, err
+	}
+	albumValue, err := requiredString(params, "albumId")
+	if err != nil {
+		return libraryPlan{}, err
+	}
+	albumID, err := normalizeReference("album", albumValue)
+	if err != nil {
+		return libraryPlan{}, fmt.Errorf("parse config: albumId invalid: %w", err)
+	}
+	body, err := json.Marshal(map[string]any{"ids": []string{albumID}})
+	if err != nil {
+		return libraryPlan{}, fmt.Errorf("marshal payload: %w", err)
+	}
+	call := apiCall{method: http.MethodPut, endpoint: spotifyAPIBaseURL + "/me/albums", payload: body}
+	return libraryPlan{
+		identityID: identityID,
+		target:     albumID,
+		call:       &call,
+		run: func(ctx context.Context, sess *session) error {
+			_, err := sess.call(ctx, call)
+			return err
+		},
+	}, nil
+}
+
+// playerPlan issues a player command, resolving the optional device param by ID or by name first
+func playerPlan(identityID uuid.UUID, params map[string]any, method string, path string, query url.Values, body []byte) libraryPlan {
+	device, _ := params["device"].(string)
+	device = strings.TrimSpace(device)
+	target := firstNonEmpty(device, "active device")
+	plan := libraryPlan{
+		identityID: identityID,
+		target:     target,
+		run: func(ctx context.Context, sess *session) error {
+			values := url.Values{}
+			for key, items := range query {
+				values[key] = items
+			}
+			if device != "" {
+				deviceID, err := resolveDevice(ctx, sess, device)
+				if err != nil {
+					return err
+				}
+				values.Set("device_id", deviceID)
+			}
+			endpoint := spotifyAPIBaseURL + path
+			if encoded := values.Encode(); encoded != "" {
+				endpoint += "?" + encoded
+			}
+			_, err := sess.call(ctx, apiCall{method: method, endpoint: endpoint, payload: body})
+			return err
+		},
+	}
+	// Without a device the command targets the active device and needs no lookup
+	if device == "" {
+		endpoint := spotifyAPIBaseURL + path
+		if encoded := query.Encode(); encoded != "" {
+			endpoint += "?" + encoded
+		}
+		plan.call = &apiCall{method: method, endpoint: endpoint, payload: body}
+	}
+	return plan
+}
+
+// resolveDevice matches the reference against the IDs and names of the devices currently available to the account
+func resolveDevice(ctx context.Context, sess *session, reference string) (string, error) {
+	body, err := sess.call(ctx, apiCall{method: http.MethodGet, endpoint: spotifyAPIBaseURL + "/me/player/devices"})
+	if err != nil {
+		return "", err
+	}
+	devices, _ := body["devices"].([]any)
+	names := make([]string, 0, len(devices))
+	for _, raw := range devices {
+		device, ok := raw.(map[string]any)
+		if !ok {
+			continue
+		}
+		id, _ := device["id"].(string)
+		name, _ := device["name"].(string)
+		if id == reference || strings.EqualFold(name, reference) {
+			return id, nil
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 {
+		return "", fmt.Errorf("%s: device %q not found, no device is available", sess.api.name, reference)
+	}
+	return "", fmt.Errorf("%s: device %q not found, available devices: %s", sess.api.name, reference, strings.Join(names, ", "))
+}
+
+// parseTrackList accepts a list or a comma or newline separated string of track references
+func parseTrackList(params map[string]any, key string) ([]string, error) {
+	var raw []string
+	switch value := params[key].(type) {
+	case nil:
+	case string:
+		raw = strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' })
+	case []any:
+		for _, item := range value {
+			text, err := toString(item)
+			if err != nil {
+				return nil, fmt.Errorf("parse config: %s invalid: %w", key, err)
+			}
+			raw = append(raw, text)
+		}
+	default:
+		return nil, fmt.Errorf("parse config: %s invalid: expected list got %T", key, value)
+	}
+
+	uris := make([]string, 0, len(raw))
+	for _, item := range raw {
+		if strings.TrimSpace(item) == "" {
+			continue
+		}
+		uri, err := normalizeTrackURI(item)
+		if err != nil {
+			return nil, fmt.Errorf("parse config: %s invalid: %w", key, err)
+		}
+		uris = append(uris, uri)
+	}
+	if len(uris) > spotifyMaxTracksPerRequest {
+		return nil, fmt.Errorf("parse config: %s exceeds %d tracks", key, spotifyMaxTracksPerRequest)
+	}
+	return uris, nil
+}
+
+// normalizeContextURI converts album, playlist, artist and show references into Spotify URIs
+func normalizeContextURI(value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	for _, kind := range []string{"album", "playlist", "artist", "show"} {
+		if strings.HasPrefix(strings.ToLower(trimmed), "spotify:"+kind+":") || strings.Contains(strings.ToLower(trimmed), "/"+kind+"/") {
+			id, err := normalizeReference(kind, trimmed)
+			if err != nil {
+				return "", err
+			}
+			return "spotify:" + kind + ":" + id, nil
+		}
+	}
+	return "", fmt.Errorf("unrecognised context reference %q", value)
+}
+
+// normalizeReference extracts the ID of the given kind from a URI, an open.spotify.com link or a bare ID
+func normalizeReference(kind string, value string) (string, error) {
+	trimmed := strings.TrimSpace(value)
+	if trimmed == "" {
+		return "", fmt.Errorf("%s reference empty", kind)
+	}
+	lower := strings.ToLower(trimmed)
+	if prefix := "spotify:" + kind + ":"; strings.HasPrefix(lower, prefix) {
+		if id := strings.TrimSpace(trimmed[len(prefix):]); id != "" {
+			return id, nil
+		}
+		return "", fmt.Errorf("%s id missing", kind)
+	}
+	if strings.HasPrefix(lower, "http://") || strings.HasPrefix(lower, "https://") {
+		if parsed, err := url.Parse(trimmed); err == nil {
+			segments := strings.Split(strings.Trim(parsed.Path, "/"), "/")
+			for idx := 0; idx+1 < len(segments); idx++ {
+				if strings.EqualFold(segments[idx], kind) && strings.TrimSpace(segments[idx+1]) != "" {
+					return strings.TrimSpace(segments[idx+1]), nil
+				}
+			}
+		}
+		return "", fmt.Errorf("unrecognised %s link %q", kind, value)
+	}
+	if strings.ContainsAny(trimmed, ":/") {
+		return "", fmt.Errorf("unrecognised %s reference %q", kind, value)
+	}
+	return trimmed, nil
+}
+
+func parseIdentityID(params map[string]any) (uuid.UUID, error) {
+	raw, err := requiredString(params, "identityId")
+	if err != nil {
+		return uuid.Nil, err
+	}
+	identityID, err := uuid.Parse(raw)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("parse config: parse identityId: %w", err)
+	}
+	return identityID, nil
+}
+
+func optionalBool(params map[string]any, key string) (*bool, error) {
+	switch value := params[key].(type) {
+	case nil:
+		return nil, nil
+	case bool:
+		return &value, nil
+	case string:
+		switch strings.ToLower(strings.TrimSpace(value)) {
+		case "":
+			return nil, nil
+		case "true", "yes", "1":
+			enabled := true
+			return &enabled, nil
+		case "false", "no", "0":
+			enabled := false
+			return &enabled, nil
+		}
+		return nil, fmt.Errorf("invalid boolean %q", value)
+	default:
+		return nil, fmt.Errorf("expected boolean got %T", value)
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if strings.TrimSpace(value) != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// Ensure LibraryExecutor satisfies the ComponentReactionHandler contract
+var _ interface {
+	Supports(*componentdomain.Component) bool
+	Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error)
+} = (*LibraryExecutor)(nil)
@@ -0,0 +1,199 @@
+package spotify
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+func spotifyJSON(status int, body string) http.Response {
+	return http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func libraryFixture(t *testing.T, componentName string, params map[string]any, responses ...http.Response) (*LibraryExecutor, *httpClientStub, areadomain.Area, areadomain.Link) {
+	t.Helper()
+	userID := uuid.New()
+	identityID := uuid.New()
+	now := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	expires := now.Add(time.Hour)
+	repo := &identityRepoStub{identity: identitydomain.Identity{ID: identityID, UserID: userID, Provider: spotifyProviderName, Subject: "listener", AccessToken: "token", ExpiresAt: &expires}}
+	client := &httpClientStub{responses: responses}
+	exec := NewLibraryExecutor(repo, providerResolverStub{}, client, clockStub{now: now}, nil)
+
+	params["identityId"] = identityID.String()
+	link := areadomain.Link{
+		ID:   uuid.New(),
+		Role: areadomain.LinkRoleReaction,
+		Config: componentdomain.Config{
+			Params: params,
+			Component: &componentdomain.Component{
+				Name:     componentName,
+				Provider: componentdomain.Provider{Name: spotifyProviderName},
+			},
+		},
+	}
+	return exec, client, areadomain.Area{ID: uuid.New(), UserID: userID}, link
+}
+
+func decodeBody(t *testing.T, req *http.Request) map[string]any {
+	t.Helper()
+	raw, err := io.ReadAll(req.Body)
+	if err != nil {
+		t.Fatalf("read body: %v", err)
+	}
+	var payload map[string]any
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		t.Fatalf("decode body %s: %v", raw, err)
+	}
+	return payload
+}
+
+func TestLibraryExecutorSupports(t *testing.T) {
+	exec := NewLibraryExecutor(nil, nil, nil, nil, nil)
+	for name := range libraryOperations {
+		if !exec.Supports(&componentdomain.Component{Name: name, Provider: componentdomain.Provider{Name: "Spotify"}}) {
+			t.Fatalf("expected %s to be supported", name)
+		}
+	}
+	if exec.Supports(&componentdomain.Component{Name: addTrackComponentName, Provider: componentdomain.Provider{Name: spotifyProviderName}}) {
+		t.Fatal("adding tracks belongs to the add track executor")
+	}
+}
+
+func TestLibraryExecutorCreatesPlaylistForIdentity(t *testing.T) {
+	exec, client, area, link := libraryFixture(t, createPlaylistComponentName, map[string]any{
+		"name":        "Release radar",
+		"description": "Fresh releases",
+		"public":      "true",
+	}, spotifyJSON(http.StatusCreated, `{"id":"pl-1"}`))
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	req := client.requests[0]
+	if req.Method != http.MethodPost || req.URL.Path != "/v1/users/listener/playlists" {
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+	}
+	payload := decodeBody(t, req)
+	if payload["name"] != "Release radar" || payload["public"] != true || payload["description"] != "Fresh releases" {
+		t.Fatalf("unexpected payload %v", payload)
+	}
+}
+
+func TestLibraryExecutorRemovesTracks(t *testing.T) {
+	exec, client, area, link := libraryFixture(t, removeTracksComponentName, map[string]any{
+		"playlistId": "https://open.spotify.com/playlist/pl-9?si=abc",
+		"trackUris":  "spotify:track:a1, https://open.spotify.com/track/b2\nc3",
+	}, spotifyJSON(http.StatusOK, `{"snapshot_id":"s"}`))
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	req := client.requests[0]
+	if req.Method != http.MethodDelete || req.URL.Path != "/v1/playlists/pl-9/tracks" {
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+	}
+	tracks := decodeBody(t, req)["tracks"].([]any)
+	if len(tracks) != 3 || tracks[1].(map[string]any)["uri"] != "spotify:track:b2" || tracks[2].(map[string]any)["uri"] != "spotify:track:c3" {
+		t.Fatalf("unexpected tracks %v", tracks)
+	}
+}
+
+func TestLibraryExecutorStartsPlaybackOnNamedDevice(t *testing.T) {
+	exec, client, area, link := libraryFixture(t, startPlaybackComponentName, map[string]any{
+		"device":     "kitchen speaker",
+		"contextUri": "https://open.spotify.com/album/alb-1",
+	},
+		spotifyJSON(http.StatusOK, `{"devices":[{"id":"dev-1","name":"Laptop"},{"id":"dev-2","name":"Kitchen Speaker"}]}`),
+		spotifyJSON(http.StatusNoContent, ``),
+	)
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	play := client.requests[1]
+	if play.Method != http.MethodPut || play.URL.Path != "/v1/me/player/play" || play.URL.Query().Get("device_id") != "dev-2" {
+		t.Fatalf("unexpected play request %s %s", play.Method, play.URL)
+	}
+	if contextURI := decodeBody(t, play)["context_uri"]; contextURI != "spotify:album:alb-1" {
+		t.Fatalf("unexpected context %v", contextURI)
+	}
+}
+
+func TestLibraryExecutorReportsUnknownDevice(t *testing.T) {
+	exec, _, area, link := libraryFixture(t, pausePlaybackComponentName, map[string]any{"device": "Car"},
+		spotifyJSON(http.StatusOK, `{"devices":[{"id":"dev-1","name":"Laptop"}]}`),
+	)
+
+	if _, err := exec.Execute(context.Background(), area, link); err == nil || !strings.Contains(err.Error(), "available devices: Laptop") {
+		t.Fatalf("expected device error, got %v", err)
+	}
+}
+
+func TestLibraryExecutorQueuesTrackAndSurfacesSpotifyMessage(t *testing.T) {
+	exec, client, area, link := libraryFixture(t, queueTrackComponentName, map[string]any{"trackUri": "t-1"},
+		spotifyJSON(http.StatusNotFound, `{"error":{"status":404,"message":"Player command failed: No active device found"}}`),
+	)
+
+	_, err := exec.Execute(context.Background(), area, link)
+	if err == nil || !strings.Contains(err.Error(), "No active device found") {
+		t.Fatalf("expected Spotify message in error, got %v", err)
+	}
+	if uri := client.requests[0].URL.Query().Get("uri"); uri != "spotify:track:t-1" {
+		t.Fatalf("unexpected queued uri %q", uri)
+	}
+}
+
+func TestLibraryExecutorSavesAlbum(t *testing.T) {
+	exec, client, area, link := libraryFixture(t, saveAlbumComponentName, map[string]any{"albumId": "spotify:album:alb-7"},
+		spotifyJSON(http.StatusOK, ``),
+	)
+
+	if _, err := exec.Execute(context.Background(), area, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	req := client.requests[0]
+	ids := decodeBody(t, req)["ids"].([]any)
+	if req.Method != http.MethodPut || req.URL.Path != "/v1/me/albums" || ids[0] != "alb-7" {
+		t.Fatalf("unexpected request %s %s %v", req.Method, req.URL.Path, ids)
+	}
+}
+
+func TestLibraryExecutorPreviewsSingleRequestCommands(t *testing.T) {
+	exec, client, area, link := libraryFixture(t, queueTrackComponentName, map[string]any{"trackUri": "t-1"})
+
+	result, err := exec.Preview(context.Background(), area, link)
+	if err != nil {
+		t.Fatalf("Preview returned error: %v", err)
+	}
+	if result.Endpoint != spotifyAPIBaseURL+"/me/player/queue?uri=spotify%3Atrack%3At-1" || result.Request["method"] != http.MethodPost {
+		t.Fatalf("unexpected preview %+v", result)
+	}
+	headers, _ := result.Request["headers"].(map[string][]string)
+	if auth := headers["Authorization"]; len(auth) != 1 || auth[0] != "Bearer <redacted>" {
+		t.Fatalf("expected the token redacted, got %v", auth)
+	}
+
+	link.Config.Params["device"] = "Kitchen speaker"
+	if _, err := exec.Preview(context.Background(), area, link); !errors.Is(err, outbound.ErrPreviewUnsupported) {
+		t.Fatalf("expected a named device to be unsupported, got %v", err)
+	}
+	if len(client.requests) != 0 {
+		t.Fatalf("expected previews not to call Spotify, got %d requests", len(client.requests))
+	}
+}
@@ -0,0 +1,263 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+// AreaDocumentVersion is the format version written by Export and accepted by Import
+const AreaDocumentVersion = 1
+
+const (
+	// parameterTypeIdentity marks parameters holding the identifier of a linked account
+	parameterTypeIdentity = "identity"
+	// parameterTypePassword marks parameters holding credentials such as tokens or API keys
+	parameterTypePassword = "password"
+)
+
+// AreaDocument is a portable representation of an automation
+// Components are referenced by provider, name and version so documents survive across environments
+// Identity parameters are left out because linked accounts belong to a single user, password parameters
+// are left out so credentials never leave the server and must be filled in again by the importer
+type AreaDocument struct {
+	Version     int                     `json:"version" yaml:"version"`
+	Name        string                  `json:"name" yaml:"name"`
+	Description string                  `json:"description,omitempty" yaml:"description,omitempty"`
+	Status      string                  `json:"status,omitempty" yaml:"status,omitempty"`
+	Action      AreaDocumentComponent   `json:"action" yaml:"action"`
+	Reactions   []AreaDocumentComponent `json:"reactions" yaml:"reactions"`
+}
+
+// AreaDocumentComponent references a catalog component and the params configured for it
+type AreaDocumentComponent struct {
+	Provider    string                   `json:"provider" yaml:"provider"`
+	Component   string                   `json:"component" yaml:"component"`
+	Version     int                      `json:"version,omitempty" yaml:"version,omitempty"`
+	Name        string                   `json:"name,omitempty" yaml:"name,omitempty"`
+	Params      map[string]any           `json:"params,omitempty" yaml:"params,omitempty"`
+	RetryPolicy *AreaDocumentRetryPolicy `json:"retryPolicy,omitempty" yaml:"retryPolicy,omitempty"`
+}
+
+// AreaDocumentRetryPolicy mirrors areadomain.RetryPolicy with delays in milliseconds
+type AreaDocumentRetryPolicy struct {
+	MaxRetries  int    `json:"maxRetries" yaml:"maxRetries"`
+	Strategy    string `json:"strategy,omitempty" yaml:"strategy,omitempty"`
+	BaseDelayMS int    `json:"baseDelayMs,omitempty" yaml:"baseDelayMs,omitempty"`
+	MaxDelayMS  int    `json:"maxDelayMs,omitempty" yaml:"maxDelayMs,omitempty"`
+}
+
+// Export serializes an automation owned by the user into a portable document
+func (s *Service) Export(ctx context.Context, userID uuid.UUID, areaID uuid.UUID) (AreaDocument, error) {
+	area, err := s.Get(ctx, userID, areaID)
+	if err != nil {
+		return AreaDocument{}, fmt.Errorf("area.Service.Export: %w", err)
+	}
+	if area.Action == nil || area.Action.Config.Component == nil {
+		return AreaDocument{}, fmt.Errorf("area.Service.Export: %w", ErrAreaMisconfigured)
+	}
+
+	doc := AreaDocument{
+		Version:   AreaDocumentVersion,
+		Name:      area.Name,
+		Status:    string(area.Status),
+		Action:    exportLink(*area.Action),
+		Reactions: make([]AreaDocumentComponent, 0, len(area.Reactions)),
+	}
+	if area.Status == areadomain.StatusSuspended {
+		doc.Status = string(areadomain.StatusDisabled)
+	}
+	if area.Description != nil {
+		doc.Description = *area.Description
+	}
+	for _, reaction := range area.Reactions {
+		if reaction.Config.Component == nil {
+			return AreaDocument{}, fmt.Errorf("area.Service.Export: %w", ErrAreaMisconfigured)
+		}
+		doc.Reactions = append(doc.Reactions, exportLink(reaction))
+	}
+	return doc, nil
+}
+
+// Import creates an automation for the user from a portable document
+// Identity parameters are bound to the user's linked account for the provider the parameter expects
+func (s *Service) Import(ctx context.Context, userID uuid.UUID, doc AreaDocument) (areadomain.Area, error) {
+	if s.components == nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Import: component repository unavailable")
+	}
+	if doc.Version != AreaDocumentVersion {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Import: %w: unsupported version %d", ErrAreaDocumentInvalid, doc.Version)
+	}
+	status := areadomain.StatusEnabled
+	if trimmed := strings.ToLower(strings.TrimSpace(doc.Status)); trimmed != "" {
+		status = areadomain.Status(trimmed)
+		switch status {
+		case areadomain.StatusEnabled, areadomain.StatusDisabled, areadomain.StatusArchived:
+		default:
+			return areadomain.Area{}, fmt.Errorf("area.Service.Import: %w", ErrAreaStatusInvalid)
+		}
+	}
+
+	actionComponent, err := s.resolveDocumentComponent(ctx, componentdomain.KindAction, doc.Action)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Import: action: %w", err)
+	}
+	actionParams, err := s.bindIdentityParams(ctx, userID, actionComponent, doc.Action.Params)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Import: action: %w", err)
+	}
+	action := ActionInput{
+		ComponentID: actionComponent.ID,
+		Name:        strings.TrimSpace(doc.Action.Name),
+		Params:      actionParams,
+	}
+
+	reactions := make([]ReactionInput, 0, len(doc.Reactions))
+	for idx, item := range doc.Reactions {
+		component, err := s.resolveDocumentComponent(ctx, componentdomain.KindReaction, item)
+		if err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.Import: reaction %d: %w", idx+1, err)
+		}
+		params, err := s.bindIdentityParams(ctx, userID, component, item.Params)
+		if err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.Import: reaction %d: %w", idx+1, err)
+		}
+		policy, err := importRetryPolicy(item.RetryPolicy)
+		if err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.Import: reaction %d: %w", idx+1, err)
+		}
+		reactions = append(reactions, ReactionInput{
+			ComponentID: component.ID,
+			Name:        strings.TrimSpace(item.Name),
+			Params:      params,
+			RetryPolicy: policy,
+		})
+	}
+
+	created, err := s.create(ctx, userID, userID, nil, doc.Name, doc.Description, status, action, reactions)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Import: create: %w", err)
+	}
+	return created, nil
+}
+
+func exportLink(link areadomain.Link) AreaDocumentComponent {
+	component := link.Config.Component
+	item := AreaDocumentComponent{
+		Provider:  component.Provider.Name,
+		Component: component.Name,
+		Version:   component.Version,
+		Name:      strings.TrimSpace(link.Config.Name),
+		Params:    cloneParamsMap(link.Config.Params),
+	}
+	specs, _ := extractParameterSpecs(component.Metadata)
+	for _, spec := range specs {
+		if spec.Type == parameterTypeIdentity || spec.Type == parameterTypePassword {
+			delete(item.Params, spec.Key)
+		}
+	}
+	if len(item.Params) == 0 {
+		item.Params = nil
+	}
+	if link.RetryPolicy != nil {
+		item.RetryPolicy = &AreaDocumentRetryPolicy{
+			MaxRetries:  link.RetryPolicy.MaxRetries,
+			Strategy:    string(link.RetryPolicy.Strategy),
+			BaseDelayMS: int(link.RetryPolicy.BaseDelay / time.Millisecond),
+			MaxDelayMS:  int(link.RetryPolicy.MaxDelay / time.Millisecond),
+		}
+	}
+	return item
+}
+
+func importRetryPolicy(policy *AreaDocumentRetryPolicy) (*areadomain.RetryPolicy, error) {
+	if policy == nil {
+		return nil, nil
+	}
+	if policy.MaxRetries < 0 || policy.BaseDelayMS < 0 || policy.MaxDelayMS < 0 {
+		return nil, fmt.Errorf("%w: retry policy values must not be negative", ErrAreaDocumentInvalid)
+	}
+	strategy := areadomain.RetryStrategy(strings.ToLower(strings.TrimSpace(policy.Strategy)))
+	switch strategy {
+	case "":
+		strategy = areadomain.RetryStrategyConstant
+	case areadomain.RetryStrategyConstant, areadomain.RetryStrategyLinear, areadomain.RetryStrategyExponential:
+	default:
+		return nil, fmt.Errorf("%w: retry strategy %q not supported", ErrAreaDocumentInvalid, policy.Strategy)
+	}
+	return &areadomain.RetryPolicy{
+		MaxRetries: policy.MaxRetries,
+		Strategy:   strategy,
+		BaseDelay:  time.Duration(policy.BaseDelayMS) * time.Millisecond,
+		MaxDelay:   time.Duration(policy.MaxDelayMS) * time.Millisecond,
+	}, nil
+}
+
+// resolveDocumentComponent finds the catalog component referenced by a document entry
+// A missing version selects the most recent one
+func (s *Service) resolveDocumentComponent(ctx context.Context, kind componentdomain.Kind, item AreaDocumentComponent) (componentdomain.Component, error) {
+	provider := strings.TrimSpace(item.Provider)
+	name := strings.TrimSpace(item.Component)
+	if provider == "" || name == "" {
+		return componentdomain.Component{}, fmt.Errorf("%w: provider and component are required", ErrAreaDocumentInvalid)
+	}
+
+	candidates, err := s.components.List(ctx, outbound.ComponentListOptions{Kind: &kind, Provider: provider})
+	if err != nil {
+		return componentdomain.Component{}, fmt.Errorf("components.List: %w", err)
+	}
+	matches := make([]componentdomain.Component, 0, 1)
+	for _, candidate := range candidates {
+		if candidate.Name != name {
+			continue
+		}
+		if item.Version != 0 && candidate.Version != item.Version {
+			continue
+		}
+		matches = append(matches, candidate)
+	}
+	if len(matches) == 0 {
+		if item.Version != 0 {
+			return componentdomain.Component{}, fmt.Errorf("%w: component %s/%s version %d not found", ErrAreaDocumentInvalid, provider, name, item.Version)
+		}
+		return componentdomain.Component{}, fmt.Errorf("%w: component %s/%s not found", ErrAreaDocumentInvalid, provider, name)
+	}
+	sort.Slice(matches, func(i, j int) bool { return matches[i].Version > matches[j].Version })
+	return matches[0], nil
+}
+
+// bindIdentityParams replaces identity parameters with the user's own linked account
+// Values carried by the document are dropped since they reference another user's identities
+func (s *Service) bindIdentityParams(ctx context.Context, userID uuid.UUID, component componentdomain.Component, params map[string]any) (map[string]any, error) {
+	bound := cloneParamsMap(params)
+	specs, err := extractParameterSpecs(component.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrComponentParamsInvalid, err)
+	}
+	for _, spec := range specs {
+		if spec.Type != parameterTypeIdentity {
+			continue
+		}
+		delete(bound, spec.Key)
+		if s.identities == nil || spec.Provider == "" {
+			continue
+		}
+		identity, err := s.identities.FindByUserAndProvider(ctx, userID, spec.Provider)
+		if err != nil {
+			if errors.Is(err, outbound.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("identities.FindByUserAndProvider: %w", err)
+		}
+		bound[spec.Key] = identity.ID.String()
+	}
+	return bound, nil
+}
@@ -0,0 +1,192 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/google/uuid"
+)
+
+func documentTestComponents() (componentdomain.Component, componentdomain.Component, componentdomain.Component) {
+	action := componentdomain.Component{
+		ID:         uuid.New(),
+		ProviderID: uuid.New(),
+		Kind:       componentdomain.KindAction,
+		Name:       "timer_interval",
+		Version:    1,
+		Enabled:    true,
+		Provider:   componentdomain.Provider{Name: "timer"},
+	}
+	reactionV1 := componentdomain.Component{
+		ID:         uuid.New(),
+		ProviderID: uuid.New(),
+		Kind:       componentdomain.KindReaction,
+		Name:       "gmail_send_email",
+		Version:    1,
+		Enabled:    true,
+		Provider:   componentdomain.Provider{Name: "google"},
+		Metadata: map[string]any{
+			"parameters": []any{
+				map[string]any{"key": "identityId", "type": "identity", "provider": "google", "required": true},
+				map[string]any{"key": "to", "type": "text", "required": true},
+				map[string]any{"key": "apiKey", "type": "password"},
+			},
+		},
+	}
+	reactionV2 := reactionV1
+	reactionV2.ID = uuid.New()
+	reactionV2.Version = 2
+	return action, reactionV1, reactionV2
+}
+
+func TestServiceExportImportRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	action, reactionV1, reactionV2 := documentTestComponents()
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{
+		action.ID:     action,
+		reactionV1.ID: reactionV1,
+		reactionV2.ID: reactionV2,
+	}}
+	owner := uuid.New()
+	importer := uuid.New()
+	importerIdentity := identitydomain.Identity{ID: uuid.New(), UserID: importer, Provider: "google"}
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	svc := NewService(repo, components, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil,
+		WithIdentityRepository(&identityRepoStub{identity: importerIdentity}))
+
+	source, err := svc.Create(ctx, owner, "Daily digest", "sends a mail", ActionInput{
+		ComponentID: action.ID,
+		Params:      map[string]any{"frequencyValue": 1.0},
+	}, []ReactionInput{{
+		ComponentID: reactionV1.ID,
+		Name:        "mail me",
+		Params:      map[string]any{"identityId": uuid.NewString(), "to": "me@example.com", "apiKey": "owner-secret"},
+		RetryPolicy: &areadomain.RetryPolicy{MaxRetries: 3, Strategy: areadomain.RetryStrategyExponential, BaseDelay: 2 * time.Second},
+	}})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	source.Status = areadomain.StatusDisabled
+	if err := repo.UpdateMetadata(ctx, source); err != nil {
+		t.Fatalf("UpdateMetadata returned error: %v", err)
+	}
+
+	doc, err := svc.Export(ctx, owner, source.ID)
+	if err != nil {
+		t.Fatalf("Export returned error: %v", err)
+	}
+	if doc.Version != AreaDocumentVersion || doc.Status != "disabled" || doc.Description != "sends a mail" {
+		t.Fatalf("unexpected document header %+v", doc)
+	}
+	if doc.Action.Provider != "timer" || doc.Action.Component != "timer_interval" || doc.Action.Version != 1 {
+		t.Fatalf("unexpected action reference %+v", doc.Action)
+	}
+	reaction := doc.Reactions[0]
+	if _, ok := reaction.Params["identityId"]; ok {
+		t.Fatalf("identity param should be stripped from export")
+	}
+	if _, ok := reaction.Params["apiKey"]; ok {
+		t.Fatalf("password param should be stripped from export")
+	}
+	if reaction.RetryPolicy == nil || reaction.RetryPolicy.MaxRetries != 3 || reaction.RetryPolicy.BaseDelayMS != 2000 {
+		t.Fatalf("unexpected retry policy %+v", reaction.RetryPolicy)
+	}
+
+	imported, err := svc.Import(ctx, importer, doc)
+	if err != nil {
+		t.Fatalf("Import returned error: %v", err)
+	}
+	stored := repo.items[imported.ID]
+	if stored.UserID != importer || stored.Status != areadomain.StatusDisabled {
+		t.Fatalf("unexpected imported area %+v", stored)
+	}
+	link := stored.Reactions[0]
+	if link.Config.ComponentID != reactionV1.ID {
+		t.Fatalf("expected pinned version 1 component")
+	}
+	if link.Config.Params["identityId"] != importerIdentity.ID.String() {
+		t.Fatalf("identity not rebound: %+v", link.Config.Params)
+	}
+	if link.RetryPolicy == nil || link.RetryPolicy.Strategy != areadomain.RetryStrategyExponential {
+		t.Fatalf("retry policy not imported: %+v", link.RetryPolicy)
+	}
+
+	doc.Reactions[0].Version = 0
+	latest, err := svc.Import(ctx, importer, doc)
+	if err != nil {
+		t.Fatalf("Import returned error: %v", err)
+	}
+	if repo.items[latest.ID].Reactions[0].Config.ComponentID != reactionV2.ID {
+		t.Fatalf("expected latest component version when none is pinned")
+	}
+
+	_, err = svc.Import(ctx, uuid.New(), doc)
+	if !errors.Is(err, ErrComponentParamsInvalid) {
+		t.Fatalf("expected ErrComponentParamsInvalid without linked identity got %v", err)
+	}
+}
+
+func TestServiceImportRejectsInvalidDocuments(t *testing.T) {
+	action, reaction, _ := documentTestComponents()
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{action.ID: action, reaction.ID: reaction}}
+	svc := NewService(&memoryAreaRepo{}, components, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil)
+	valid := AreaDocument{
+		Version:   AreaDocumentVersion,
+		Name:      "Imported",
+		Action:    AreaDocumentComponent{Provider: "timer", Component: "timer_interval"},
+		Reactions: []AreaDocumentComponent{{Provider: "google", Component: "gmail_send_email", Params: map[string]any{"to": "a@b.c"}}},
+	}
+
+	cases := map[string]func(doc *AreaDocument){
+		"version":           func(doc *AreaDocument) { doc.Version = 2 },
+		"unknown component": func(doc *AreaDocument) { doc.Action.Component = "timer_cron" },
+		"unknown version":   func(doc *AreaDocument) { doc.Action.Version = 7 },
+		"kind mismatch":     func(doc *AreaDocument) { doc.Action = doc.Reactions[0] },
+		"missing provider":  func(doc *AreaDocument) { doc.Reactions[0].Provider = "" },
+		"retry strategy": func(doc *AreaDocument) {
+			doc.Reactions[0].RetryPolicy = &AreaDocumentRetryPolicy{MaxRetries: 1, Strategy: "random"}
+		},
+	}
+	for name, mutate := range cases {
+		t.Run(name, func(t *testing.T) {
+			doc := valid
+			doc.Reactions = []AreaDocumentComponent{valid.Reactions[0]}
+			mutate(&doc)
+			if _, err := svc.Import(context.Background(), uuid.New(), doc); !errors.Is(err, ErrAreaDocumentInvalid) {
+				t.Fatalf("expected ErrAreaDocumentInvalid got %v", err)
+			}
+		})
+	}
+}
+
+func TestDecodeAreaDocumentYAML(t *testing.T) {
+	doc, err := decodeAreaDocument("application/yaml", []byte(`
+version: 1
+name: From YAML
+action:
+  provider: timer
+  component: timer_interval
+  params:
+    frequencyValue: 5
+reactions:
+  - provider: google
+    component: gmail_send_email
+    retryPolicy:
+      maxRetries: 2
+      strategy: linear
+`))
+	if err != nil {
+		t.Fatalf("decodeAreaDocument returned error: %v", err)
+	}
+	if doc.Name != "From YAML" || len(doc.Reactions) != 1 || doc.Reactions[0].RetryPolicy.MaxRetries != 2 {
+		t.Fatalf("unexpected document %+v", doc)
+	}
+	if value, ok := doc.Action.Params["frequencyValue"].(float64); !ok || value != 5 {
+		t.Fatalf("expected JSON number types got %T", doc.Action.Params["frequencyValue"])
+	}
+}
@@ -0,0 +1,206 @@
+package area
+
+import (
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/security/netguard"
+)
+
+const (
+	// customHTTPPollingConfigSource marks ingestion blocks whose HTTP settings come from the action params
+	customHTTPPollingConfigSource = "params"
+
+	customHTTPAuthNone   = "none"
+	customHTTPAuthBasic  = "basic"
+	customHTTPAuthBearer = "bearer"
+	customHTTPAuthAPIKey = "apikey"
+
+	customHTTPDefaultAPIKeyName = "X-API-Key"
+)
+
+// isCustomHTTPPollingComponent reports whether the component polls an endpoint configured by the user
+func isCustomHTTPPollingComponent(component *componentdomain.Component) bool {
+	if component == nil {
+		return false
+	}
+	ingestion, ok, err := ingestionMetadata(component.Metadata)
+	if err != nil || !ok || !ingestionSupportsMode(ingestion, ingestionModePolling) {
+		return false
+	}
+	if handlerName := strings.ToLower(strings.TrimSpace(stringOrDefault(ingestion, "handler", ""))); handlerName != "" && handlerName != "http" {
+		return false
+	}
+	return strings.EqualFold(strings.TrimSpace(stringOrDefault(ingestion, "configSource", "")), customHTTPPollingConfigSource)
+}
+
+// expandCustomHTTPPollingComponent returns a copy of the component whose ingestion block carries the
+// HTTP settings entered by the user, so parseHTTPPollingConfig treats it like a seeded component
+// User values are used as templates, allowing {{cursor.*}} and {{now_rfc3339}} placeholders in the URL and body
+func expandCustomHTTPPollingComponent(component componentdomain.Component, params map[string]any) (componentdomain.Component, error) {
+	ingestion, ok, err := ingestionMetadata(component.Metadata)
+	if err != nil {
+		return componentdomain.Component{}, fmt.Errorf("ingestion metadata invalid: %w", err)
+	}
+	if !ok {
+		return componentdomain.Component{}, fmt.Errorf("ingestion metadata missing")
+	}
+
+	endpoint := strings.TrimSpace(stringOrDefault(params, "url", ""))
+	if endpoint == "" {
+		return componentdomain.Component{}, fmt.Errorf("url missing")
+	}
+	parsed, err := url.Parse(placeholderPattern.ReplaceAllString(endpoint, "x"))
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		return componentdomain.Component{}, fmt.Errorf("url must be an absolute http or https URL")
+	}
+	if err := netguard.CheckHost(parsed.Hostname()); err != nil {
+		return componentdomain.Component{}, fmt.Errorf("url host not allowed: %s", parsed.Hostname())
+	}
+
+	method := strings.ToUpper(strings.TrimSpace(stringOrDefault(params, "method", http.MethodGet)))
+	switch method {
+	case "":
+		method = http.MethodGet
+	case http.MethodGet, http.MethodPost:
+	default:
+		return componentdomain.Component{}, fmt.Errorf("method %q not supported", method)
+	}
+
+	headers, err := parseCustomHTTPHeaders(stringOrDefault(params, "headers", ""))
+	if err != nil {
+		return componentdomain.Component{}, err
+	}
+	var query []any
+	authHeaders, authQuery, err := customHTTPAuthSpecs(params)
+	if err != nil {
+		return componentdomain.Component{}, err
+	}
+	headers = append(headers, authHeaders...)
+	query = append(query, authQuery...)
+
+	httpConfig := map[string]any{
+		"endpoint":  endpoint,
+		"method":    method,
+		"itemsPath": normalizeItemsPath(stringOrDefault(params, "itemsPath", "")),
+		"headers":   headers,
+	}
+	if len(query) > 0 {
+		httpConfig["query"] = query
+	}
+	if body := stringOrDefault(params, "body", ""); method == http.MethodPost && strings.TrimSpace(body) != "" {
+		httpConfig["bodyTemplate"] = body
+		headers = append(headers, map[string]any{"name": "Content-Type", "value": "application/json"})
+		httpConfig["headers"] = headers
+	}
+
+	fingerprintField := normalizeItemsPath(stringOrDefault(params, "fingerprintField", ""))
+	timestampField := normalizeItemsPath(stringOrDefault(params, "timestampField", ""))
+	if fingerprintField != "" {
+		httpConfig["fingerprintField"] = fingerprintField
+	}
+	if timestampField != "" {
+		httpConfig["occurredAtField"] = timestampField
+		httpConfig["cursor"] = map[string]any{"source": httpPollingCursorSourceItem, "path": timestampField}
+	} else {
+		httpConfig["cursor"] = map[string]any{"source": httpPollingCursorSourceFingerprint}
+	}
+
+	if skipField := normalizeItemsPath(stringOrDefault(params, "skipField", "")); skipField != "" {
+		rule := map[string]any{"path": skipField}
+		equals := strings.TrimSpace(stringOrDefault(params, "skipEquals", ""))
+		contains := strings.TrimSpace(stringOrDefault(params, "skipContains", ""))
+		if equals == "" && contains == "" {
+			return componentdomain.Component{}, fmt.Errorf("skipField requires skipEquals or skipContains")
+		}
+		if equals != "" {
+			rule["equals"] = equals
+		}
+		if contains != "" {
+			rule["contains"] = contains
+		}
+		httpConfig["skipItems"] = []any{rule}
+	}
+
+	expandedIngestion := cloneMapAny(ingestion)
+	expandedIngestion["http"] = httpConfig
+	delete(expandedIngestion, "configSource")
+
+	expanded := component
+	expanded.Metadata = cloneMapAny(component.Metadata)
+	expanded.Metadata["ingestion"] = expandedIngestion
+	return expanded, nil
+}
+
+// parseCustomHTTPHeaders reads one "Name: value" header per line
+func parseCustomHTTPHeaders(raw string) ([]any, error) {
+	headers := make([]any, 0)
+	for index, line := range strings.Split(raw, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(line, ":")
+		name = strings.TrimSpace(name)
+		if !ok || name == "" || strings.ContainsAny(name, " \t") {
+			return nil, fmt.Errorf("headers line %d must look like \"Name: value\"", index+1)
+		}
+		headers = append(headers, map[string]any{"name": name, "value": strings.TrimSpace(value)})
+	}
+	return headers, nil
+}
+
+// customHTTPAuthSpecs translates the selected authentication scheme into header or query specs
+// Secrets stay sealed in the stored params and are referenced through placeholders once opened,
+// except the basic credentials which must be encoded up front
+func customHTTPAuthSpecs(params map[string]any) ([]any, []any, error) {
+	kind := strings.ToLower(strings.TrimSpace(stringOrDefault(params, "authType", customHTTPAuthNone)))
+	switch kind {
+	case "", customHTTPAuthNone:
+		return nil, nil, nil
+	case customHTTPAuthBasic:
+		username := stringOrDefault(params, "username", "")
+		if strings.TrimSpace(username) == "" {
+			return nil, nil, fmt.Errorf("basic auth requires username")
+		}
+		credentials := base64.StdEncoding.EncodeToString([]byte(username + ":" + stringOrDefault(params, "password", "")))
+		return []any{map[string]any{"name": "Authorization", "value": "Basic " + credentials}}, nil, nil
+	case customHTTPAuthBearer:
+		if strings.TrimSpace(stringOrDefault(params, "token", "")) == "" {
+			return nil, nil, fmt.Errorf("bearer auth requires token")
+		}
+		return []any{map[string]any{"name": "Authorization", "template": "Bearer {{params.token}}"}}, nil, nil
+	case customHTTPAuthAPIKey:
+		if strings.TrimSpace(stringOrDefault(params, "apiKey", "")) == "" {
+			return nil, nil, fmt.Errorf("api key auth requires apiKey")
+		}
+		name := strings.TrimSpace(stringOrDefault(params, "apiKeyName", customHTTPDefaultAPIKeyName))
+		if name == "" {
+			name = customHTTPDefaultAPIKeyName
+		}
+		spec := map[string]any{"name": name, "param": "apiKey"}
+		if strings.EqualFold(strings.TrimSpace(stringOrDefault(params, "apiKeyIn", "header")), "query") {
+			return nil, []any{spec}, nil
+		}
+		return []any{spec}, nil, nil
+	default:
+		return nil, nil, fmt.Errorf("authType %q not supported", kind)
+	}
+}
+
+// normalizeItemsPath accepts simple JSONPath expressions such as $.data.items[*] and returns a dotted path
+func normalizeItemsPath(value string) string {
+	path := strings.TrimSpace(value)
+	path = strings.TrimSuffix(path, "[*]")
+	switch {
+	case path == "$":
+		return ""
+	case strings.HasPrefix(path, "$."):
+		return path[2:]
+	}
+	return path
+}
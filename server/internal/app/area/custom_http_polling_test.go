@@ -0,0 +1,334 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func customHTTPPollComponent() componentdomain.Component {
+	return componentdomain.Component{
+		ID:         uuid.New(),
+		ProviderID: uuid.New(),
+		Kind:       componentdomain.KindAction,
+		Enabled:    true,
+		Name:       "http_poll",
+		Provider:   componentdomain.Provider{Name: "http"},
+		Metadata: map[string]any{
+			"parameters": []any{
+				map[string]any{"key": "url", "type": "text", "required": true},
+				map[string]any{"key": "authType", "type": "enum", "options": []any{"none", "basic", "bearer", "apiKey"}},
+				map[string]any{"key": "token", "type": "password"},
+			},
+			"ingestion": map[string]any{
+				"mode":            "polling",
+				"intervalSeconds": 60,
+				"handler":         "http",
+				"configSource":    "params",
+			},
+		},
+	}
+}
+
+func TestHTTPPollingHandlerCustomComponent(t *testing.T) {
+	transport := &recordingTransport{body: []byte(`{"data":{"items":[
+		{"id":"3","updated":"2024-05-03T10:00:00Z","state":"open"},
+		{"id":"2","updated":"2024-05-02T10:00:00Z","state":"draft"},
+		{"id":"1","updated":"2024-05-01T10:00:00Z","state":"open"}
+	]}}`)}
+	client := &http.Client{Transport: transport}
+	handler := NewHTTPPollingHandler(client, zap.NewNop(), nil, nil, WithCustomEndpointClient(client))
+	component := customHTTPPollComponent()
+	if !handler.Supports(&component) {
+		t.Fatalf("expected custom component to be supported")
+	}
+
+	params := map[string]any{
+		"url":              "https://api.example.com/items?since={{cursor.last_seen_ts}}",
+		"headers":          "X-Trace: abc\nAccept: application/vnd.example+json",
+		"authType":         "bearer",
+		"token":            "secret-token",
+		"itemsPath":        "$.data.items[*]",
+		"fingerprintField": "id",
+		"timestampField":   "updated",
+		"skipField":        "state",
+		"skipEquals":       "draft",
+	}
+	req := PollingRequest{
+		Binding:   actiondomain.PollingBinding{Config: componentdomain.Config{Params: params}},
+		Component: component,
+		Cursor:    map[string]any{},
+		Now:       time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 2 {
+		t.Fatalf("expected 2 events got %d", len(result.Events))
+	}
+	if result.Events[0].Fingerprint != "3" || result.Events[1].Fingerprint != "1" {
+		t.Fatalf("unexpected fingerprints %q %q", result.Events[0].Fingerprint, result.Events[1].Fingerprint)
+	}
+
+	request := transport.requests[0]
+	if got := request.Header.Get("Authorization"); got != "Bearer secret-token" {
+		t.Fatalf("unexpected authorization header %q", got)
+	}
+	if got := request.Header.Get("X-Trace"); got != "abc" {
+		t.Fatalf("unexpected custom header %q", got)
+	}
+	if got := request.Header.Get("Accept"); got != "application/vnd.example+json" {
+		t.Fatalf("custom accept header overridden: %q", got)
+	}
+
+	req.Cursor = result.Cursor
+	second, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("second Poll returned error: %v", err)
+	}
+	if len(second.Events) != 0 {
+		t.Fatalf("expected no new events got %d", len(second.Events))
+	}
+	if got := transport.requests[1].URL.Query().Get("since"); got != "2024-05-03T10:00:00Z" {
+		t.Fatalf("expected cursor in url got %q", got)
+	}
+}
+
+func TestHTTPPollingHandlerCustomComponentAuth(t *testing.T) {
+	cases := []struct {
+		name   string
+		params map[string]any
+		check  func(t *testing.T, request *http.Request)
+	}{
+		{
+			name:   "basic",
+			params: map[string]any{"authType": "basic", "username": "alice", "password": "wonderland"},
+			check: func(t *testing.T, request *http.Request) {
+				user, password, ok := request.BasicAuth()
+				if !ok || user != "alice" || password != "wonderland" {
+					t.Fatalf("unexpected basic auth %q %q %v", user, password, ok)
+				}
+			},
+		},
+		{
+			name:   "api key header",
+			params: map[string]any{"authType": "apiKey", "apiKey": "k-1"},
+			check: func(t *testing.T, request *http.Request) {
+				if got := request.Header.Get("X-API-Key"); got != "k-1" {
+					t.Fatalf("unexpected api key header %q", got)
+				}
+			},
+		},
+		{
+			name:   "api key query",
+			params: map[string]any{"authType": "apiKey", "apiKey": "k-2", "apiKeyName": "key", "apiKeyIn": "query"},
+			check: func(t *testing.T, request *http.Request) {
+				if got := request.URL.Query().Get("key"); got != "k-2" {
+					t.Fatalf("unexpected api key query %q", got)
+				}
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			transport := &recordingTransport{body: []byte(`[{"id":1}]`)}
+			client := &http.Client{Transport: transport}
+			handler := NewHTTPPollingHandler(client, zap.NewNop(), nil, nil, WithCustomEndpointClient(client))
+			params := map[string]any{"url": "https://api.example.com/items"}
+			for key, value := range tc.params {
+				params[key] = value
+			}
+			result, err := handler.Poll(context.Background(), PollingRequest{
+				Binding:   actiondomain.PollingBinding{Config: componentdomain.Config{Params: params}},
+				Component: customHTTPPollComponent(),
+				Now:       time.Now().UTC(),
+			})
+			if err != nil {
+				t.Fatalf("Poll returned error: %v", err)
+			}
+			if len(result.Events) != 1 {
+				t.Fatalf("expected 1 event got %d", len(result.Events))
+			}
+			tc.check(t, transport.requests[0])
+		})
+	}
+}
+
+func TestExpandCustomHTTPPollingComponentRejectsInvalidParams(t *testing.T) {
+	cases := map[string]map[string]any{
+		"missing url":     {},
+		"relative url":    {"url": "/items"},
+		"ftp url":         {"url": "ftp://example.com/items"},
+		"bad method":      {"url": "https://example.com", "method": "DELETE"},
+		"bad header":      {"url": "https://example.com", "headers": "no colon here"},
+		"bearer no token": {"url": "https://example.com", "authType": "bearer"},
+		"unknown auth":    {"url": "https://example.com", "authType": "digest"},
+		"skip no value":   {"url": "https://example.com", "skipField": "state"},
+		"loopback url":    {"url": "http://127.0.0.1:8080/admin"},
+		"metadata url":    {"url": "http://169.254.169.254/latest/meta-data"},
+		"localhost url":   {"url": "http://localhost/items"},
+	}
+	for name, params := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := expandCustomHTTPPollingComponent(customHTTPPollComponent(), params); err == nil {
+				t.Fatalf("expected error")
+			}
+		})
+	}
+}
+
+func TestServicePreviewPolling(t *testing.T) {
+	component := customHTTPPollComponent()
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{component.ID: component}}
+	transport := &recordingTransport{body: []byte(`{"results":[{"id":"a"},{"id":"b"}]}`)}
+	client := &http.Client{Transport: transport}
+	handler := NewHTTPPollingHandler(client, zap.NewNop(), nil, nil, WithCustomEndpointClient(client))
+	svc := NewService(nil, components, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil, WithPollingHandlers(handler))
+
+	preview, err := svc.PreviewPolling(context.Background(), uuid.New(), component.ID, map[string]any{
+		"url":              "https://api.example.com/search",
+		"itemsPath":        "results",
+		"fingerprintField": "id",
+	})
+	if err != nil {
+		t.Fatalf("PreviewPolling returned error: %v", err)
+	}
+	if preview.Total != 2 || len(preview.Events) != 2 || preview.Truncated {
+		t.Fatalf("unexpected preview %+v", preview)
+	}
+	if preview.Events[0].Payload["id"] != "a" {
+		t.Fatalf("unexpected payload %+v", preview.Events[0].Payload)
+	}
+
+	_, err = svc.PreviewPolling(context.Background(), uuid.New(), component.ID, map[string]any{"url": "not a url"})
+	if !errors.Is(err, ErrComponentParamsInvalid) {
+		t.Fatalf("expected ErrComponentParamsInvalid got %v", err)
+	}
+
+	transport.status = http.StatusUnauthorized
+	_, err = svc.PreviewPolling(context.Background(), uuid.New(), component.ID, map[string]any{"url": "https://api.example.com/search"})
+	if !errors.Is(err, ErrPollingPreviewFailed) {
+		t.Fatalf("expected ErrPollingPreviewFailed got %v", err)
+	}
+}
+
+func TestServicePreviewPollingRejectsNonPollingComponent(t *testing.T) {
+	component := componentdomain.Component{
+		ID:         uuid.New(),
+		ProviderID: uuid.New(),
+		Kind:       componentdomain.KindAction,
+		Enabled:    true,
+		Metadata:   map[string]any{"ingestion": map[string]any{"mode": "webhook"}},
+	}
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{component.ID: component}}
+	svc := NewService(nil, components, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil)
+
+	_, err := svc.PreviewPolling(context.Background(), uuid.New(), component.ID, nil)
+	if !errors.Is(err, ErrPollingPreviewUnsupported) {
+		t.Fatalf("expected ErrPollingPreviewUnsupported got %v", err)
+	}
+}
+
+type prefixSealer struct{}
+
+func (prefixSealer) Encrypt(value string) (string, error) {
+	return "enc(" + value + ")", nil
+}
+
+func (prefixSealer) Decrypt(value string) (string, error) {
+	return strings.TrimSuffix(strings.TrimPrefix(value, "enc("), ")"), nil
+}
+
+func TestServiceSealsSecretParams(t *testing.T) {
+	ctx := context.Background()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	action := customHTTPPollComponent()
+	reactionID := uuid.New()
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{
+		action.ID:  action,
+		reactionID: {ID: reactionID, Kind: componentdomain.KindReaction, Enabled: true, ProviderID: action.ProviderID},
+	}}
+	svc := NewService(repo, components, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil, WithParamSecrets(prefixSealer{}))
+
+	params := map[string]any{"url": "https://api.example.com/items", "authType": "bearer", "token": "secret-token"}
+	created, err := svc.Create(ctx, uuid.New(), "Sealed", "", ActionInput{ComponentID: action.ID, Params: params}, []ReactionInput{{ComponentID: reactionID}})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	stored := repo.items[created.ID].Action.Config.Params
+	if stored["token"] != sealedParamPrefix+"enc(secret-token)" {
+		t.Fatalf("expected sealed token, got %v", stored["token"])
+	}
+	if params["token"] != "secret-token" {
+		t.Fatalf("expected caller params left untouched")
+	}
+
+	replayed := map[string]any{"url": "https://attacker.example.com/", "authType": "bearer", "token": stored["token"]}
+	_, err = svc.Create(ctx, uuid.New(), "Replay", "", ActionInput{ComponentID: action.ID, Params: replayed}, []ReactionInput{{ComponentID: reactionID}})
+	if !errors.Is(err, ErrComponentParamsInvalid) {
+		t.Fatalf("expected sealed value replay rejected, got %v", err)
+	}
+	redirected := map[string]any{"url": "https://attacker.example.com/", "authType": "bearer", "token": stored["token"]}
+	_, err = svc.Update(ctx, created.UserID, created.ID, UpdateAreaCommand{
+		Action: &UpdateActionCommand{ConfigID: created.Action.Config.ID, Params: redirected, ParamsSet: true},
+	})
+	if !errors.Is(err, ErrComponentParamsInvalid) {
+		t.Fatalf("expected sealed value kept with a new url rejected, got %v", err)
+	}
+	reauthed := map[string]any{"url": "https://api.example.com/items", "authType": "apiKey", "apiKeyIn": "query", "token": stored["token"]}
+	_, err = svc.Update(ctx, created.UserID, created.ID, UpdateAreaCommand{
+		Action: &UpdateActionCommand{ConfigID: created.Action.Config.ID, Params: reauthed, ParamsSet: true},
+	})
+	if !errors.Is(err, ErrComponentParamsInvalid) {
+		t.Fatalf("expected sealed value kept with new auth params rejected, got %v", err)
+	}
+	kept := map[string]any{"url": "https://api.example.com/items", "authType": "bearer", "headers": "Accept: application/json", "token": stored["token"]}
+	_, err = svc.Update(ctx, created.UserID, created.ID, UpdateAreaCommand{
+		Action: &UpdateActionCommand{ConfigID: created.Action.Config.ID, Params: kept, ParamsSet: true},
+	})
+	if err != nil {
+		t.Fatalf("expected unchanged sealed value kept on update, got %v", err)
+	}
+	reentered := map[string]any{"url": "https://api.example.com/other", "authType": "bearer", "token": "secret-token"}
+	_, err = svc.Update(ctx, created.UserID, created.ID, UpdateAreaCommand{
+		Action: &UpdateActionCommand{ConfigID: created.Action.Config.ID, Params: reentered, ParamsSet: true},
+	})
+	if err != nil {
+		t.Fatalf("expected re-entered secret accepted with a new url, got %v", err)
+	}
+
+	transport := &recordingTransport{body: []byte(`[{"id":1}]`)}
+	client := &http.Client{Transport: transport}
+	handler := NewHTTPPollingHandler(client, zap.NewNop(), nil, nil, WithCustomEndpointClient(client), WithPollingParamSecrets(prefixSealer{}))
+	if _, err := handler.Poll(ctx, PollingRequest{
+		Binding:   actiondomain.PollingBinding{Config: componentdomain.Config{Params: stored}},
+		Component: action,
+		Now:       time.Now().UTC(),
+	}); err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if got := transport.requests[0].Header.Get("Authorization"); got != "Bearer secret-token" {
+		t.Fatalf("expected opened token in header, got %q", got)
+	}
+
+	unsealed := NewHTTPPollingHandler(client, zap.NewNop(), nil, nil, WithCustomEndpointClient(client))
+	if _, err := unsealed.Poll(ctx, PollingRequest{
+		Binding:   actiondomain.PollingBinding{Config: componentdomain.Config{Params: stored}},
+		Component: action,
+		Now:       time.Now().UTC(),
+	}); err == nil {
+		t.Fatalf("expected sealed params to fail without secrets")
+	}
+}
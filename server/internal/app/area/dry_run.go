@@ -0,0 +1,150 @@
+package area
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+// Sources of the sample event used by a dry run
+const (
+	DryRunPayloadRequest = "request"
+	DryRunPayloadExample = "example"
+	DryRunPayloadEmpty   = "empty"
+)
+
+// DryRunOptions carries the sample event sent through an automation
+// Event names the webhook event type when the action filters on it
+type DryRunOptions struct {
+	Payload map[string]any
+	Event   string
+}
+
+// DryRunResult reports how an automation would handle a sample event
+type DryRunResult struct {
+	Payload       map[string]any
+	PayloadSource string
+	Accepted      bool
+	Reason        string
+	Reactions     []DryRunReaction
+}
+
+// DryRunReaction describes the request a reaction would send for the sample event
+// Supported is false when the executor has no preview mode, in which case nothing is described
+type DryRunReaction struct {
+	ReactionID uuid.UUID
+	ConfigID   uuid.UUID
+	Component  string
+	Provider   string
+	Supported  bool
+	Result     outbound.ReactionResult
+	Error      string
+}
+
+// DryRun sends a sample event through the action filters and previews every reaction without contacting providers
+// Without a sample payload the first example documented for the action component is used
+func (s *Service) DryRun(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, opts DryRunOptions) (DryRunResult, error) {
+	if s.previewer == nil {
+		return DryRunResult{}, fmt.Errorf("area.Service.DryRun: reaction previewer unavailable")
+	}
+	area, err := s.Get(ctx, userID, areaID)
+	if err != nil {
+		return DryRunResult{}, fmt.Errorf("area.Service.DryRun: %w", err)
+	}
+	if area.Action == nil || len(area.Reactions) == 0 {
+		return DryRunResult{}, fmt.Errorf("area.Service.DryRun: %w", ErrAreaMisconfigured)
+	}
+
+	payload, source, err := s.dryRunPayload(ctx, *area.Action, opts.Payload)
+	if err != nil {
+		return DryRunResult{}, fmt.Errorf("area.Service.DryRun: %w", err)
+	}
+	result := DryRunResult{PayloadSource: source, Accepted: true}
+
+	var metadata map[string]any
+	if area.Action.Config.Component != nil {
+		metadata = area.Action.Config.Component.Metadata
+	}
+	eventCfg, hasEventCfg, err := parseWebhookEventConfig(metadata)
+	if err != nil {
+		return DryRunResult{}, fmt.Errorf("area.Service.DryRun: %w", err)
+	}
+	if hasEventCfg {
+		event := strings.ToLower(strings.TrimSpace(opts.Event))
+		payload = normalizeWebhookPayload(eventCfg.Normalize, event, payload)
+		if ok, reason := eventCfg.accepts(event, payload, area.Action.Config.Params); !ok {
+			result.Accepted = false
+			result.Reason = reason
+		}
+	}
+	schemaCfg, hasSchema, err := parseWebhookSchemaConfig(metadata, area.Action.Config.Params)
+	if err != nil {
+		return DryRunResult{}, fmt.Errorf("area.Service.DryRun: %w", err)
+	}
+	if result.Accepted && hasSchema {
+		body, err := json.Marshal(payload)
+		if err != nil {
+			return DryRunResult{}, fmt.Errorf("area.Service.DryRun: encode payload: %w", err)
+		}
+		if err := schemaCfg.check(body, payload); err != nil {
+			var payloadErr *WebhookPayloadError
+			if !errors.As(err, &payloadErr) {
+				return DryRunResult{}, fmt.Errorf("area.Service.DryRun: %w", err)
+			}
+			result.Accepted = false
+			result.Reason = payloadErr.Error()
+		}
+	}
+	result.Payload = payload
+	if !result.Accepted {
+		return result, nil
+	}
+
+	eventCtx := outbound.WithTriggerEvent(ctx, payload)
+	result.Reactions = make([]DryRunReaction, 0, len(area.Reactions))
+	for _, reaction := range area.Reactions {
+		item := DryRunReaction{
+			ReactionID: reaction.ID,
+			ConfigID:   reaction.Config.ID,
+			Supported:  true,
+		}
+		if component := reaction.Config.Component; component != nil {
+			item.Component = component.Name
+			item.Provider = component.Provider.Name
+		}
+		preview, err := s.previewer.PreviewReaction(eventCtx, area, reaction)
+		switch {
+		case errors.Is(err, outbound.ErrPreviewUnsupported):
+			item.Supported = false
+		case err != nil:
+			item.Error = err.Error()
+		}
+		item.Result = preview
+		result.Reactions = append(result.Reactions, item)
+	}
+	return result, nil
+}
+
+func (s *Service) dryRunPayload(ctx context.Context, action areadomain.Link, sample map[string]any) (map[string]any, string, error) {
+	if sample != nil {
+		return cloneParamsMap(sample), DryRunPayloadRequest, nil
+	}
+	if s.examples != nil {
+		examples, err := s.examples.ListByComponent(ctx, action.Config.ComponentID)
+		if err != nil {
+			return nil, "", fmt.Errorf("examples.ListByComponent: %w", err)
+		}
+		for _, example := range examples {
+			if len(example.Output) > 0 {
+				return cloneParamsMap(example.Output), DryRunPayloadExample, nil
+			}
+		}
+	}
+	return map[string]any{}, DryRunPayloadEmpty, nil
+}
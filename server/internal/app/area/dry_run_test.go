@@ -0,0 +1,158 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+type recordingPreviewer struct {
+	events []map[string]any
+}
+
+func (p *recordingPreviewer) PreviewReaction(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	event, _ := outbound.TriggerEvent(ctx)
+	p.events = append(p.events, event)
+	if link.Config.Component != nil && link.Config.Component.Name == "unsupported" {
+		return outbound.ReactionResult{}, outbound.ErrPreviewUnsupported
+	}
+	return outbound.ReactionResult{
+		Endpoint: "https://example.com/hook",
+		Request:  map[string]any{"body": event["id"]},
+	}, nil
+}
+
+type stubExampleRepo struct {
+	examples []componentdomain.Example
+}
+
+func (s stubExampleRepo) ListByComponent(ctx context.Context, componentID uuid.UUID) ([]componentdomain.Example, error) {
+	return s.examples, nil
+}
+
+func newDryRunFixture(t *testing.T, actionParams map[string]any) (*Service, *recordingPreviewer, uuid.UUID, uuid.UUID) {
+	t.Helper()
+	action := componentdomain.Component{
+		ID:       uuid.New(),
+		Kind:     componentdomain.KindAction,
+		Name:     "webhook_incoming",
+		Enabled:  true,
+		Provider: componentdomain.Provider{Name: "webhook"},
+		Metadata: map[string]any{
+			"ingestion": map[string]any{
+				"mode":   "webhook",
+				"schema": map[string]any{"param": "schema", "onInvalidParam": "onInvalid"},
+			},
+		},
+	}
+	supported := componentdomain.Component{ID: uuid.New(), Kind: componentdomain.KindReaction, Name: "http_webhook", Enabled: true}
+	unsupported := componentdomain.Component{ID: uuid.New(), Kind: componentdomain.KindReaction, Name: "unsupported", Enabled: true}
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{
+		action.ID:      action,
+		supported.ID:   supported,
+		unsupported.ID: unsupported,
+	}}
+
+	userID := uuid.New()
+	areaID := uuid.New()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{
+		areaID: {
+			ID:     areaID,
+			UserID: userID,
+			Name:   "Orders",
+			Status: areadomain.StatusDisabled,
+			Action: &areadomain.Link{
+				ID:     uuid.New(),
+				Role:   areadomain.LinkRoleAction,
+				Config: componentdomain.Config{ID: uuid.New(), ComponentID: action.ID, Params: actionParams},
+			},
+			Reactions: []areadomain.Link{
+				{ID: uuid.New(), Role: areadomain.LinkRoleReaction, Position: 1, Config: componentdomain.Config{ID: uuid.New(), ComponentID: supported.ID}},
+				{ID: uuid.New(), Role: areadomain.LinkRoleReaction, Position: 2, Config: componentdomain.Config{ID: uuid.New(), ComponentID: unsupported.ID}},
+			},
+		},
+	}}
+	previewer := &recordingPreviewer{}
+	examples := stubExampleRepo{examples: []componentdomain.Example{
+		{ComponentID: action.ID},
+		{ComponentID: action.ID, Output: map[string]any{"id": "ord_example", "total": 10.0}},
+	}}
+	svc := NewService(repo, components, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil,
+		WithReactionPreviewer(previewer), WithComponentExamples(examples))
+	return svc, previewer, userID, areaID
+}
+
+func TestServiceDryRunPreviewsReactions(t *testing.T) {
+	svc, previewer, userID, areaID := newDryRunFixture(t, map[string]any{})
+
+	result, err := svc.DryRun(context.Background(), userID, areaID, DryRunOptions{})
+	if err != nil {
+		t.Fatalf("DryRun returned error: %v", err)
+	}
+	if result.PayloadSource != DryRunPayloadExample || result.Payload["id"] != "ord_example" || !result.Accepted {
+		t.Fatalf("unexpected dry run header %+v", result)
+	}
+	if len(result.Reactions) != 2 {
+		t.Fatalf("expected 2 reactions got %d", len(result.Reactions))
+	}
+	first := result.Reactions[0]
+	if !first.Supported || first.Result.Endpoint != "https://example.com/hook" || first.Result.Request["body"] != "ord_example" {
+		t.Fatalf("unexpected preview %+v", first)
+	}
+	if second := result.Reactions[1]; second.Supported || second.Error != "" {
+		t.Fatalf("expected unsupported reaction got %+v", second)
+	}
+	if len(previewer.events) != 2 || previewer.events[0]["id"] != "ord_example" {
+		t.Fatalf("trigger event not passed to previewer: %+v", previewer.events)
+	}
+
+	result, err = svc.DryRun(context.Background(), userID, areaID, DryRunOptions{Payload: map[string]any{"id": "ord_custom"}})
+	if err != nil {
+		t.Fatalf("DryRun returned error: %v", err)
+	}
+	if result.PayloadSource != DryRunPayloadRequest || result.Reactions[0].Result.Request["body"] != "ord_custom" {
+		t.Fatalf("expected request payload to win got %+v", result)
+	}
+
+	if _, err := svc.DryRun(context.Background(), uuid.New(), areaID, DryRunOptions{}); !errors.Is(err, ErrAreaNotOwned) {
+		t.Fatalf("expected ErrAreaNotOwned got %v", err)
+	}
+}
+
+func TestServiceDryRunAppliesPayloadSchema(t *testing.T) {
+	svc, previewer, userID, areaID := newDryRunFixture(t, map[string]any{"schema": orderSchema})
+
+	result, err := svc.DryRun(context.Background(), userID, areaID, DryRunOptions{Payload: map[string]any{"id": "ord_1"}})
+	if err != nil {
+		t.Fatalf("DryRun returned error: %v", err)
+	}
+	if result.Accepted || result.Reason == "" || len(result.Reactions) != 0 {
+		t.Fatalf("expected schema rejection got %+v", result)
+	}
+	if len(previewer.events) != 0 {
+		t.Fatalf("rejected events must not reach reactions")
+	}
+}
+
+func TestCompositeReactionExecutorPreviewRequiresPreviewer(t *testing.T) {
+	component := &componentdomain.Component{Name: "http_webhook"}
+	executor := NewCompositeReactionExecutor(nil, nil, supportAllHandler{})
+	_, err := executor.PreviewReaction(context.Background(), areadomain.Area{}, areadomain.Link{Config: componentdomain.Config{Component: component}})
+	if !errors.Is(err, outbound.ErrPreviewUnsupported) {
+		t.Fatalf("expected ErrPreviewUnsupported got %v", err)
+	}
+}
+
+type supportAllHandler struct{}
+
+func (supportAllHandler) Supports(*componentdomain.Component) bool { return true }
+
+func (supportAllHandler) Execute(context.Context, areadomain.Area, areadomain.Link) (outbound.ReactionResult, error) {
+	panic("dry runs must never execute reactions")
+}
@@ -0,0 +1,187 @@
+package area
+
+import (
+	"strings"
+)
+
+const (
+	githubNormalizer         = "github"
+	githubNormalizerPrefix   = "github."
+	githubBranchRefPrefix    = "refs/heads/"
+	githubKindPullRequest    = "pull_request"
+	githubKindIssue          = "issue"
+	githubKindRelease        = "release"
+	githubKindPush           = "push"
+	githubKindWorkflowRun    = "workflow_run"
+	githubEventIssues        = "issues"
+	githubActionOpened       = "opened"
+	githubActionClosed       = "closed"
+	githubActionPublished    = "published"
+	githubActionCompleted    = "completed"
+	githubRepositoryOwnerKey = "owner"
+	githubRepositoryNameKey  = "repository"
+)
+
+// normalizeGitHubWebhookPayload enriches a GitHub webhook delivery with the fields shared by every GitHub trigger
+func normalizeGitHubWebhookPayload(event string, payload map[string]any) map[string]any {
+	normalized := cloneMapAny(payload)
+	if normalized == nil {
+		normalized = map[string]any{}
+	}
+	event = strings.ToLower(strings.TrimSpace(event))
+	if event != "" {
+		normalized["event"] = event
+	}
+	if ref := strings.TrimSpace(stringify(normalized["ref"])); strings.HasPrefix(ref, githubBranchRefPrefix) {
+		normalized["branch"] = strings.TrimPrefix(ref, githubBranchRefPrefix)
+	}
+	for _, key := range []string{githubKindIssue, githubKindPullRequest} {
+		if entity, err := toMapStringAny(normalized[key]); err == nil && entity != nil {
+			normalized["labelNames"] = githubLabelNames(entity["labels"])
+			break
+		}
+	}
+	if label, err := toMapStringAny(normalized["label"]); err == nil && label != nil {
+		normalized["labelName"] = stringify(label["name"])
+	}
+	return normalized
+}
+
+// normalizeGitHubPollingItem maps a GitHub REST API item onto the equivalent webhook payload shape
+func normalizeGitHubPollingItem(kind string, item map[string]any, params map[string]any) map[string]any {
+	item = cloneMapAny(item)
+	repository := githubRepositoryFromParams(params)
+	payload := map[string]any{}
+
+	switch kind {
+	case githubKindPullRequest:
+		payload["event"] = githubKindPullRequest
+		payload["action"] = githubActionOpened
+		if strings.TrimSpace(stringify(item["merged_at"])) != "" {
+			payload["action"] = githubActionClosed
+			item["merged"] = true
+		}
+		payload[githubKindPullRequest] = item
+		payload["sender"] = githubUser(item["user"])
+		payload["labelNames"] = githubLabelNames(item["labels"])
+		if base, err := toMapStringAny(item["base"]); err == nil && base != nil {
+			if repo, err := toMapStringAny(base["repo"]); err == nil && repo != nil {
+				repository = repo
+			}
+		}
+	case githubKindIssue:
+		payload["event"] = githubEventIssues
+		payload["action"] = githubActionOpened
+		payload[githubKindIssue] = item
+		payload["sender"] = githubUser(item["user"])
+		payload["labelNames"] = githubLabelNames(item["labels"])
+	case githubKindRelease:
+		payload["event"] = githubKindRelease
+		payload["action"] = githubActionPublished
+		payload[githubKindRelease] = item
+		payload["sender"] = githubUser(item["author"])
+	case githubKindPush:
+		branch := strings.TrimSpace(stringify(params["branch"]))
+		commit := githubCommitFromREST(item)
+		payload["event"] = githubKindPush
+		payload["ref"] = githubBranchRefPrefix + branch
+		payload["branch"] = branch
+		payload["after"] = stringify(item["sha"])
+		payload["head_commit"] = commit
+		payload["commits"] = []any{commit}
+		payload["sender"] = githubUser(item["author"])
+		if author, err := toMapStringAny(commit["author"]); err == nil && author != nil {
+			payload["pusher"] = map[string]any{"name": author["name"], "email": author["email"]}
+		}
+	case githubKindWorkflowRun:
+		payload["event"] = githubKindWorkflowRun
+		payload["action"] = githubActionCompleted
+		payload[githubKindWorkflowRun] = item
+		payload["sender"] = githubUser(item["actor"])
+		if workflow := strings.TrimSpace(stringify(item["name"])); workflow != "" {
+			payload["workflow"] = map[string]any{"name": workflow, "id": item["workflow_id"]}
+		}
+		if repo, err := toMapStringAny(item["repository"]); err == nil && repo != nil {
+			repository = repo
+		}
+	default:
+		return item
+	}
+
+	if repository != nil {
+		payload["repository"] = repository
+	}
+	return payload
+}
+
+func githubRepositoryFromParams(params map[string]any) map[string]any {
+	owner := strings.TrimSpace(stringify(params[githubRepositoryOwnerKey]))
+	name := strings.TrimSpace(stringify(params[githubRepositoryNameKey]))
+	if owner == "" || name == "" {
+		return nil
+	}
+	return map[string]any{
+		"name":      name,
+		"full_name": owner + "/" + name,
+		"owner":     map[string]any{"login": owner},
+	}
+}
+
+func githubUser(raw any) map[string]any {
+	user, err := toMapStringAny(raw)
+	if err != nil || user == nil {
+		return map[string]any{}
+	}
+	return map[string]any{
+		"login":      user["login"],
+		"id":         user["id"],
+		"html_url":   user["html_url"],
+		"avatar_url": user["avatar_url"],
+		"type":       user["type"],
+	}
+}
+
+func githubLabelNames(raw any) []any {
+	labels, ok := raw.([]any)
+	if !ok {
+		return []any{}
+	}
+	names := make([]any, 0, len(labels))
+	for _, entry := range labels {
+		label, err := toMapStringAny(entry)
+		if err != nil || label == nil {
+			continue
+		}
+		if name := strings.TrimSpace(stringify(label["name"])); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+func githubCommitFromREST(item map[string]any) map[string]any {
+	commit := map[string]any{
+		"id":  item["sha"],
+		"url": item["html_url"],
+	}
+	details, err := toMapStringAny(item["commit"])
+	if err != nil || details == nil {
+		return commit
+	}
+	commit["message"] = details["message"]
+	if author, err := toMapStringAny(details["author"]); err == nil && author != nil {
+		entry := map[string]any{"name": author["name"], "email": author["email"]}
+		if user := githubUser(item["author"]); user["login"] != nil {
+			entry["username"] = user["login"]
+		}
+		commit["author"] = entry
+		commit["timestamp"] = author["date"]
+	}
+	if committer, err := toMapStringAny(details["committer"]); err == nil && committer != nil {
+		commit["committer"] = map[string]any{"name": committer["name"], "email": committer["email"]}
+		if date := strings.TrimSpace(stringify(committer["date"])); date != "" {
+			commit["timestamp"] = date
+		}
+	}
+	return commit
+}
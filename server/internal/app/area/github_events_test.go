@@ -0,0 +1,100 @@
+package area
+
+import "testing"
+
+func TestNormalizeGitHubWebhookPayload(t *testing.T) {
+	payload := map[string]any{
+		"ref":    "refs/heads/main",
+		"sender": map[string]any{"login": "octocat"},
+		"issue": map[string]any{
+			"title":  "Crash on start",
+			"labels": []any{map[string]any{"name": "bug"}, map[string]any{"name": "p1"}},
+		},
+		"label": map[string]any{"name": "p1"},
+	}
+
+	normalized := normalizeGitHubWebhookPayload("Issues", payload)
+	if normalized["event"] != "issues" {
+		t.Fatalf("unexpected event %v", normalized["event"])
+	}
+	if normalized["branch"] != "main" {
+		t.Fatalf("unexpected branch %v", normalized["branch"])
+	}
+	labels, ok := normalized["labelNames"].([]any)
+	if !ok || len(labels) != 2 || labels[0] != "bug" {
+		t.Fatalf("unexpected label names %v", normalized["labelNames"])
+	}
+	if normalized["labelName"] != "p1" {
+		t.Fatalf("unexpected label name %v", normalized["labelName"])
+	}
+	if _, exists := payload["event"]; exists {
+		t.Fatalf("original payload should not be mutated")
+	}
+}
+
+func TestNormalizeGitHubPollingPullRequest(t *testing.T) {
+	item := map[string]any{
+		"id":        float64(1),
+		"title":     "Add feature",
+		"merged_at": "2024-05-01T10:00:00Z",
+		"user":      map[string]any{"login": "octocat", "id": float64(7)},
+		"base": map[string]any{
+			"ref":  "main",
+			"repo": map[string]any{"full_name": "octo/repo"},
+		},
+	}
+
+	payload := normalizePollingItem("github.pull_request", item, map[string]any{"owner": "octo", "repository": "repo"})
+	if payload["action"] != "closed" {
+		t.Fatalf("unexpected action %v", payload["action"])
+	}
+	pr, ok := payload["pull_request"].(map[string]any)
+	if !ok || pr["title"] != "Add feature" || pr["merged"] != true {
+		t.Fatalf("unexpected pull request %v", payload["pull_request"])
+	}
+	if sender := payload["sender"].(map[string]any); sender["login"] != "octocat" {
+		t.Fatalf("unexpected sender %v", sender)
+	}
+	if repo := payload["repository"].(map[string]any); repo["full_name"] != "octo/repo" {
+		t.Fatalf("unexpected repository %v", repo)
+	}
+	if _, exists := item["merged"]; exists {
+		t.Fatalf("original item should not be mutated")
+	}
+}
+
+func TestNormalizeGitHubPollingPush(t *testing.T) {
+	item := map[string]any{
+		"sha":      "abc123",
+		"html_url": "https://github.com/octo/repo/commit/abc123",
+		"author":   map[string]any{"login": "octocat"},
+		"commit": map[string]any{
+			"message":   "Fix bug",
+			"author":    map[string]any{"name": "Octo Cat", "email": "octo@example.com", "date": "2024-05-01T09:00:00Z"},
+			"committer": map[string]any{"name": "GitHub", "email": "noreply@github.com", "date": "2024-05-01T09:05:00Z"},
+		},
+	}
+
+	payload := normalizePollingItem("github.push", item, map[string]any{"owner": "octo", "repository": "repo", "branch": "main"})
+	if payload["ref"] != "refs/heads/main" || payload["branch"] != "main" {
+		t.Fatalf("unexpected ref %v / %v", payload["ref"], payload["branch"])
+	}
+	head, ok := payload["head_commit"].(map[string]any)
+	if !ok || head["id"] != "abc123" || head["message"] != "Fix bug" || head["timestamp"] != "2024-05-01T09:05:00Z" {
+		t.Fatalf("unexpected head commit %v", payload["head_commit"])
+	}
+	if pusher := payload["pusher"].(map[string]any); pusher["email"] != "octo@example.com" {
+		t.Fatalf("unexpected pusher %v", pusher)
+	}
+	if repo := payload["repository"].(map[string]any); repo["full_name"] != "octo/repo" {
+		t.Fatalf("unexpected repository %v", repo)
+	}
+}
+
+func TestNormalizePollingItemWithoutNormalizer(t *testing.T) {
+	item := map[string]any{"id": "1"}
+	payload := normalizePollingItem("", item, nil)
+	if payload["id"] != "1" || len(payload) != 1 {
+		t.Fatalf("unexpected payload %v", payload)
+	}
+}
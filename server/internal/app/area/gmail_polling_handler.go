@@ -0,0 +1,524 @@
+package area
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"go.uber.org/zap"
+)
+
+const (
+	gmailPollingHandlerName    = "gmail"
+	gmailDefaultAPIBaseURL     = "https://gmail.googleapis.com/gmail/v1/users/me"
+	gmailHistoryCursorKey      = "gmail_history_id"
+	gmailDefaultMaxResults     = 10
+	gmailMaxResultsLimit       = 50
+	gmailQueryCandidateLimit   = 100
+	gmailDefaultIdentityParam  = "identityId"
+	gmailDefaultOAuthProvider  = "google"
+	gmailHistoryTypeMessageAdd = "messageAdded"
+)
+
+var (
+	errGmailHistoryExpired = errors.New("gmail history expired")
+	errGmailNotFound       = errors.New("gmail resource not found")
+)
+
+// GmailPollingHandler polls the Gmail history feed to emit events for newly received messages
+type GmailPollingHandler struct {
+	client   *http.Client
+	logger   *zap.Logger
+	resolver pollingIdentityResolver
+	baseURL  string
+}
+
+// NewGmailPollingHandler assembles a Gmail polling handler
+func NewGmailPollingHandler(client *http.Client, logger *zap.Logger, identities identityport.Repository, providers oauthProviderResolver) *GmailPollingHandler {
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &GmailPollingHandler{
+		client:   client,
+		logger:   logger,
+		resolver: pollingIdentityResolver{identities: identities, providers: providers},
+		baseURL:  gmailDefaultAPIBaseURL,
+	}
+}
+
+// Supports reports whether the component declares the Gmail polling ingestion
+func (h *GmailPollingHandler) Supports(component *componentdomain.Component) bool {
+	_, ok, err := parseGmailPollingConfig(component)
+	return err == nil && ok
+}
+
+// Poll fetches messages added since the stored history cursor and converts them into events
+func (h *GmailPollingHandler) Poll(ctx context.Context, req PollingRequest) (PollingResult, error) {
+	config, ok, err := parseGmailPollingConfig(&req.Component)
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.GmailPollingHandler.Poll: parse config: %w", err)
+	}
+	if !ok {
+		return PollingResult{}, fmt.Errorf("area.GmailPollingHandler.Poll: component %q not supported", req.Component.Name)
+	}
+
+	if req.Binding.Config.Params == nil {
+		req.Binding.Config.Params = map[string]any{}
+	}
+	if err := h.resolver.inject(ctx, &req, config.auth); err != nil {
+		return PollingResult{}, fmt.Errorf("area.GmailPollingHandler.Poll: %w", err)
+	}
+	token := stringify(req.Identity["accessToken"])
+
+	filter := parseGmailFilter(req.Binding.Config.Params)
+
+	result := PollingResult{Cursor: cloneMapAny(req.Cursor)}
+	if result.Cursor == nil {
+		result.Cursor = map[string]any{}
+	}
+	cursorState := ensureCursorState(result.Cursor)
+	assignCursorValue(result.Cursor, cursorState, "last_polled_at", req.Now.UTC().Format(time.RFC3339Nano))
+
+	startHistoryID := strings.TrimSpace(stringify(flattenCursorState(req.Cursor)[gmailHistoryCursorKey]))
+	if startHistoryID == "" {
+		historyID, err := h.currentHistoryID(ctx, token)
+		if err != nil {
+			return PollingResult{}, fmt.Errorf("area.GmailPollingHandler.Poll: %w", err)
+		}
+		assignCursorValue(result.Cursor, cursorState, gmailHistoryCursorKey, historyID)
+		return result, nil
+	}
+
+	messageIDs, latestHistoryID, err := h.addedMessageIDs(ctx, token, startHistoryID, filter)
+	if errors.Is(err, errGmailHistoryExpired) {
+		h.logger.Warn("gmail history cursor expired, resetting",
+			zap.String("area_id", req.Binding.AreaID.String()),
+			zap.String("history_id", startHistoryID),
+		)
+		historyID, resetErr := h.currentHistoryID(ctx, token)
+		if resetErr != nil {
+			return PollingResult{}, fmt.Errorf("area.GmailPollingHandler.Poll: %w", resetErr)
+		}
+		assignCursorValue(result.Cursor, cursorState, gmailHistoryCursorKey, historyID)
+		return result, nil
+	}
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.GmailPollingHandler.Poll: %w", err)
+	}
+
+	if len(messageIDs) > 0 && filter.query != "" {
+		messageIDs, err = h.filterByQuery(ctx, token, messageIDs, filter)
+		if err != nil {
+			return PollingResult{}, fmt.Errorf("area.GmailPollingHandler.Poll: %w", err)
+		}
+	}
+	if len(messageIDs) > filter.maxResults {
+		messageIDs = messageIDs[:filter.maxResults]
+	}
+
+	for _, id := range messageIDs {
+		message, err := h.fetchMessage(ctx, token, id)
+		if err != nil {
+			if errors.Is(err, errGmailNotFound) {
+				continue
+			}
+			return PollingResult{}, fmt.Errorf("area.GmailPollingHandler.Poll: %w", err)
+		}
+		event := buildGmailEvent(message)
+		if event.OccurredAt.IsZero() {
+			event.OccurredAt = req.Now.UTC()
+		}
+		result.Events = append(result.Events, event)
+	}
+
+	if latestHistoryID != "" {
+		assignCursorValue(result.Cursor, cursorState, gmailHistoryCursorKey, latestHistoryID)
+	}
+	if len(result.Events) > 0 {
+		latest := result.Events[0].OccurredAt
+		for _, event := range result.Events[1:] {
+			if event.OccurredAt.After(latest) {
+				latest = event.OccurredAt
+			}
+		}
+		assignCursorValue(result.Cursor, cursorState, "last_seen_ts", latest.UTC().Format(time.RFC3339Nano))
+	}
+	return result, nil
+}
+
+func (h *GmailPollingHandler) currentHistoryID(ctx context.Context, token string) (string, error) {
+	var profile struct {
+		HistoryID string `json:"historyId"`
+	}
+	if err := h.getJSON(ctx, token, h.baseURL+"/profile", nil, &profile); err != nil {
+		return "", fmt.Errorf("load profile: %w", err)
+	}
+	if strings.TrimSpace(profile.HistoryID) == "" {
+		return "", fmt.Errorf("load profile: historyId missing")
+	}
+	return profile.HistoryID, nil
+}
+
+type gmailHistoryPage struct {
+	History []struct {
+		MessagesAdded []struct {
+			Message gmailMessageRef `json:"message"`
+		} `json:"messagesAdded"`
+	} `json:"history"`
+	HistoryID     string `json:"historyId"`
+	NextPageToken string `json:"nextPageToken"`
+}
+
+type gmailMessageRef struct {
+	ID       string   `json:"id"`
+	ThreadID string   `json:"threadId"`
+	LabelIDs []string `json:"labelIds"`
+}
+
+func (h *GmailPollingHandler) addedMessageIDs(ctx context.Context, token string, startHistoryID string, filter gmailFilter) ([]string, string, error) {
+	query := url.Values{}
+	query.Set("startHistoryId", startHistoryID)
+	query.Set("historyTypes", gmailHistoryTypeMessageAdd)
+	if len(filter.labelIDs) == 1 {
+		query.Set("labelId", filter.labelIDs[0])
+	}
+
+	seen := map[string]struct{}{}
+	ids := make([]string, 0)
+	latest := ""
+	for {
+		var page gmailHistoryPage
+		if err := h.getJSON(ctx, token, h.baseURL+"/history", query, &page); err != nil {
+			if errors.Is(err, errGmailNotFound) {
+				return nil, "", errGmailHistoryExpired
+			}
+			return nil, "", fmt.Errorf("list history: %w", err)
+		}
+		if page.HistoryID != "" {
+			latest = page.HistoryID
+		}
+		for _, record := range page.History {
+			for _, added := range record.MessagesAdded {
+				ref := added.Message
+				if ref.ID == "" || !filter.matchesLabels(ref.LabelIDs) {
+					continue
+				}
+				if _, exists := seen[ref.ID]; exists {
+					continue
+				}
+				seen[ref.ID] = struct{}{}
+				ids = append(ids, ref.ID)
+			}
+		}
+		if page.NextPageToken == "" {
+			break
+		}
+		query.Set("pageToken", page.NextPageToken)
+	}
+	return ids, latest, nil
+}
+
+func (h *GmailPollingHandler) filterByQuery(ctx context.Context, token string, candidates []string, filter gmailFilter) ([]string, error) {
+	query := url.Values{}
+	query.Set("q", filter.query)
+	query.Set("maxResults", strconv.Itoa(gmailQueryCandidateLimit))
+	for _, label := range filter.labelIDs {
+		query.Add("labelIds", label)
+	}
+
+	var page struct {
+		Messages []gmailMessageRef `json:"messages"`
+	}
+	if err := h.getJSON(ctx, token, h.baseURL+"/messages", query, &page); err != nil {
+		return nil, fmt.Errorf("search messages: %w", err)
+	}
+	matches := make(map[string]struct{}, len(page.Messages))
+	for _, message := range page.Messages {
+		matches[message.ID] = struct{}{}
+	}
+	filtered := make([]string, 0, len(candidates))
+	for _, id := range candidates {
+		if _, ok := matches[id]; ok {
+			filtered = append(filtered, id)
+		}
+	}
+	return filtered, nil
+}
+
+type gmailMessage struct {
+	ID           string           `json:"id"`
+	ThreadID     string           `json:"threadId"`
+	LabelIDs     []string         `json:"labelIds"`
+	Snippet      string           `json:"snippet"`
+	HistoryID    string           `json:"historyId"`
+	InternalDate string           `json:"internalDate"`
+	SizeEstimate int64            `json:"sizeEstimate"`
+	Payload      gmailMessagePart `json:"payload"`
+}
+
+type gmailMessagePart struct {
+	PartID   string `json:"partId"`
+	MimeType string `json:"mimeType"`
+	Filename string `json:"filename"`
+	Headers  []struct {
+		Name  string `json:"name"`
+		Value string `json:"value"`
+	} `json:"headers"`
+	Body struct {
+		AttachmentID string `json:"attachmentId"`
+		Size         int64  `json:"size"`
+	} `json:"body"`
+	Parts []gmailMessagePart `json:"parts"`
+}
+
+func (h *GmailPollingHandler) fetchMessage(ctx context.Context, token string, id string) (gmailMessage, error) {
+	query := url.Values{}
+	query.Set("format", "full")
+	query.Set("fields", "id,threadId,labelIds,snippet,historyId,internalDate,sizeEstimate,payload(partId,mimeType,filename,headers,body/attachmentId,body/size,parts)")
+
+	var message gmailMessage
+	if err := h.getJSON(ctx, token, h.baseURL+"/messages/"+url.PathEscape(id), query, &message); err != nil {
+		if errors.Is(err, errGmailNotFound) {
+			return gmailMessage{}, err
+		}
+		return gmailMessage{}, fmt.Errorf("get message %s: %w", id, err)
+	}
+	return message, nil
+}
+
+func (h *GmailPollingHandler) getJSON(ctx context.Context, token string, endpoint string, query url.Values, target any) error {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return fmt.Errorf("parse endpoint: %w", err)
+	}
+	if len(query) > 0 {
+		u.RawQuery = query.Encode()
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return fmt.Errorf("build request: %w", err)
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Authorization", "Bearer "+token)
+
+	response, err := h.client.Do(request)
+	if err != nil {
+		return fmt.Errorf("execute request: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, 4<<20))
+	if err != nil {
+		return fmt.Errorf("read response: %w", err)
+	}
+	if response.StatusCode == http.StatusNotFound {
+		return errGmailNotFound
+	}
+	if response.StatusCode >= 400 {
+		return fmt.Errorf("unexpected status %d: %s", response.StatusCode, strings.TrimSpace(string(body)))
+	}
+	if err := json.Unmarshal(body, target); err != nil {
+		return fmt.Errorf("decode response: %w", err)
+	}
+	return nil
+}
+
+func buildGmailEvent(message gmailMessage) PollingEvent {
+	headers := map[string]string{}
+	for _, header := range message.Payload.Headers {
+		key := strings.ToLower(strings.TrimSpace(header.Name))
+		if _, exists := headers[key]; exists {
+			continue
+		}
+		headers[key] = decodeMIMEHeader(header.Value)
+	}
+
+	attachments := collectGmailAttachments(message.Payload)
+	attachmentList := make([]any, 0, len(attachments))
+	for _, attachment := range attachments {
+		attachmentList = append(attachmentList, attachment)
+	}
+
+	labels := make([]any, 0, len(message.LabelIDs))
+	for _, label := range message.LabelIDs {
+		labels = append(labels, label)
+	}
+
+	var occurredAt time.Time
+	if millis, err := strconv.ParseInt(strings.TrimSpace(message.InternalDate), 10, 64); err == nil && millis > 0 {
+		occurredAt = time.UnixMilli(millis).UTC()
+	} else if parsed, err := parseTime(headers["date"]); err == nil {
+		occurredAt = parsed
+	}
+
+	payload := map[string]any{
+		"id":              message.ID,
+		"threadId":        message.ThreadID,
+		"historyId":       message.HistoryID,
+		"labelIds":        labels,
+		"subject":         headers["subject"],
+		"from":            headers["from"],
+		"to":              headers["to"],
+		"cc":              headers["cc"],
+		"date":            headers["date"],
+		"snippet":         html.UnescapeString(message.Snippet),
+		"sizeEstimate":    message.SizeEstimate,
+		"attachments":     attachmentList,
+		"attachmentCount": len(attachmentList),
+		"hasAttachments":  len(attachmentList) > 0,
+	}
+	if address, name := splitMailbox(headers["from"]); address != "" {
+		payload["fromAddress"] = address
+		payload["fromName"] = name
+	}
+	if !occurredAt.IsZero() {
+		payload["receivedAt"] = occurredAt.Format(time.RFC3339)
+	}
+
+	return PollingEvent{
+		Payload:     payload,
+		Fingerprint: message.ID,
+		OccurredAt:  occurredAt,
+	}
+}
+
+func collectGmailAttachments(part gmailMessagePart) []map[string]any {
+	var attachments []map[string]any
+	if strings.TrimSpace(part.Filename) != "" {
+		attachments = append(attachments, map[string]any{
+			"filename":     decodeMIMEHeader(part.Filename),
+			"mimeType":     part.MimeType,
+			"size":         part.Body.Size,
+			"attachmentId": part.Body.AttachmentID,
+			"partId":       part.PartID,
+		})
+	}
+	for _, child := range part.Parts {
+		attachments = append(attachments, collectGmailAttachments(child)...)
+	}
+	return attachments
+}
+
+func decodeMIMEHeader(value string) string {
+	decoder := mime.WordDecoder{}
+	decoded, err := decoder.DecodeHeader(value)
+	if err != nil {
+		return strings.TrimSpace(value)
+	}
+	return strings.TrimSpace(decoded)
+}
+
+func splitMailbox(value string) (string, string) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return "", ""
+	}
+	start := strings.LastIndex(value, "<")
+	end := strings.LastIndex(value, ">")
+	if start >= 0 && end > start {
+		name := strings.Trim(strings.TrimSpace(value[:start]), `"`)
+		return strings.TrimSpace(value[start+1 : end]), name
+	}
+	return value, ""
+}
+
+type gmailPollingConfig struct {
+	auth httpPollingAuthConfig
+}
+
+func parseGmailPollingConfig(component *componentdomain.Component) (gmailPollingConfig, bool, error) {
+	if component == nil || len(component.Metadata) == 0 {
+		return gmailPollingConfig{}, false, nil
+	}
+	ingestionRaw, ok := component.Metadata["ingestion"]
+	if !ok {
+		return gmailPollingConfig{}, false, nil
+	}
+	ingestion, err := toMapStringAny(ingestionRaw)
+	if err != nil {
+		return gmailPollingConfig{}, false, fmt.Errorf("ingestion metadata invalid: %w", err)
+	}
+	mode, err := toString(ingestion["mode"])
+	if err != nil || strings.ToLower(strings.TrimSpace(mode)) != "polling" {
+		return gmailPollingConfig{}, false, nil
+	}
+	handlerName, err := toString(ingestion["handler"])
+	if err != nil || strings.ToLower(strings.TrimSpace(handlerName)) != gmailPollingHandlerName {
+		return gmailPollingConfig{}, false, nil
+	}
+
+	cfg := gmailPollingConfig{auth: httpPollingAuthConfig{
+		Kind:          "oauth",
+		IdentityParam: gmailDefaultIdentityParam,
+		Provider:      gmailDefaultOAuthProvider,
+	}}
+	if rawAuth, ok := ingestion["auth"]; ok {
+		authMap, err := toMapStringAny(rawAuth)
+		if err != nil {
+			return gmailPollingConfig{}, false, fmt.Errorf("auth metadata invalid: %w", err)
+		}
+		cfg.auth.IdentityParam = stringOrDefault(authMap, "identityParam", cfg.auth.IdentityParam)
+		cfg.auth.Provider = stringOrDefault(authMap, "provider", cfg.auth.Provider)
+	}
+	return cfg, true, nil
+}
+
+type gmailFilter struct {
+	labelIDs   []string
+	query      string
+	maxResults int
+}
+
+func parseGmailFilter(params map[string]any) gmailFilter {
+	filter := gmailFilter{maxResults: gmailDefaultMaxResults}
+	if labels, err := toStringSlice(params["labelIds"]); err == nil {
+		filter.labelIDs = labels
+	}
+	if query, err := toString(params["query"]); err == nil {
+		filter.query = strings.TrimSpace(query)
+	}
+	if value, ok := params["maxResults"]; ok {
+		if maxResults, err := toInt(value); err == nil && maxResults > 0 {
+			filter.maxResults = maxResults
+		}
+	}
+	if filter.maxResults > gmailMaxResultsLimit {
+		filter.maxResults = gmailMaxResultsLimit
+	}
+	return filter
+}
+
+func (f gmailFilter) matchesLabels(labels []string) bool {
+	if len(f.labelIDs) == 0 {
+		return true
+	}
+	present := make(map[string]struct{}, len(labels))
+	for _, label := range labels {
+		present[strings.ToUpper(label)] = struct{}{}
+	}
+	for _, required := range f.labelIDs {
+		if _, ok := present[strings.ToUpper(required)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// Ensure GmailPollingHandler implements ComponentPollingHandler
+var _ ComponentPollingHandler = (*GmailPollingHandler)(nil)
@@ -0,0 +1,225 @@
+package area
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type gmailRoute struct {
+	status int
+	body   string
+}
+
+type gmailRoutingTransport struct {
+	routes   map[string]gmailRoute
+	requests []*http.Request
+}
+
+func (t *gmailRoutingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.requests = append(t.requests, req)
+	route, ok := t.routes[req.URL.Path]
+	if !ok {
+		route = gmailRoute{status: http.StatusNotFound, body: `{"error":{"code":404}}`}
+	}
+	status := route.status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return &http.Response{
+		StatusCode: status,
+		Header:     http.Header{"Content-Type": []string{"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader([]byte(route.body))),
+		Request:    req,
+	}, nil
+}
+
+func gmailTestComponent() componentdomain.Component {
+	return componentdomain.Component{
+		Name:     "gmail_new_email",
+		Provider: componentdomain.Provider{Name: "google"},
+		Metadata: map[string]any{
+			"ingestion": map[string]any{
+				"mode":    "polling",
+				"handler": "gmail",
+				"auth": map[string]any{
+					"identityParam": "identityId",
+					"provider":      "google",
+				},
+			},
+		},
+	}
+}
+
+func gmailTestRequest(identityID uuid.UUID, userID uuid.UUID, params map[string]any, cursor map[string]any) PollingRequest {
+	params["identityId"] = identityID.String()
+	return PollingRequest{
+		Binding: actiondomain.PollingBinding{
+			UserID: userID,
+			Config: componentdomain.Config{Params: params},
+		},
+		Component: gmailTestComponent(),
+		Cursor:    cursor,
+		Now:       time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestGmailPollingHandlerSupports(t *testing.T) {
+	handler := NewGmailPollingHandler(nil, zap.NewNop(), nil, nil)
+	component := gmailTestComponent()
+	if !handler.Supports(&component) {
+		t.Fatalf("expected gmail component to be supported")
+	}
+
+	httpHandler := NewHTTPPollingHandler(nil, zap.NewNop(), nil, nil)
+	if httpHandler.Supports(&component) {
+		t.Fatalf("http handler should not claim gmail components")
+	}
+}
+
+func TestGmailPollingHandlerInitialisesHistoryCursor(t *testing.T) {
+	identityID := uuid.New()
+	userID := uuid.New()
+	expires := time.Now().Add(time.Hour)
+	repo := &identityRepoStub{identity: identitydomain.Identity{ID: identityID, UserID: userID, Provider: "google", AccessToken: "token", ExpiresAt: &expires}}
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{
+		"/gmail/v1/users/me/profile": {body: `{"emailAddress":"me@example.com","historyId":"1000"}`},
+	}}
+	handler := NewGmailPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	result, err := handler.Poll(context.Background(), gmailTestRequest(identityID, userID, map[string]any{}, map[string]any{}))
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 0 {
+		t.Fatalf("expected no events on first poll, got %d", len(result.Events))
+	}
+	if got := result.Cursor[gmailHistoryCursorKey]; got != "1000" {
+		t.Fatalf("unexpected history cursor %v", got)
+	}
+}
+
+func TestGmailPollingHandlerEmitsNewMessages(t *testing.T) {
+	identityID := uuid.New()
+	userID := uuid.New()
+	expires := time.Now().Add(time.Hour)
+	repo := &identityRepoStub{identity: identitydomain.Identity{ID: identityID, UserID: userID, Provider: "google", AccessToken: "token", ExpiresAt: &expires}}
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{
+		"/gmail/v1/users/me/history": {body: `{
+			"historyId": "1050",
+			"history": [
+				{"messagesAdded": [{"message": {"id": "m1", "threadId": "t1", "labelIds": ["INBOX", "UNREAD"]}}]},
+				{"messagesAdded": [{"message": {"id": "m2", "threadId": "t2", "labelIds": ["SENT"]}}]},
+				{"messagesAdded": [{"message": {"id": "m3", "threadId": "t3", "labelIds": ["INBOX"]}}]}
+			]
+		}`},
+		"/gmail/v1/users/me/messages": {body: `{"messages": [{"id": "m1", "threadId": "t1"}]}`},
+		"/gmail/v1/users/me/messages/m1": {body: `{
+			"id": "m1",
+			"threadId": "t1",
+			"labelIds": ["INBOX", "UNREAD"],
+			"snippet": "Invoice &#39;42&#39; attached",
+			"historyId": "1049",
+			"internalDate": "1740830400000",
+			"payload": {
+				"mimeType": "multipart/mixed",
+				"headers": [
+					{"name": "Subject", "value": "=?UTF-8?B?RmFjdHVyZSDDqXTDqQ==?="},
+					{"name": "From", "value": "\"Billing Team\" <billing@example.com>"},
+					{"name": "To", "value": "me@example.com"}
+				],
+				"parts": [
+					{"partId": "0", "mimeType": "text/plain", "filename": "", "body": {"size": 20}},
+					{"partId": "1", "mimeType": "application/pdf", "filename": "invoice.pdf", "body": {"attachmentId": "att-1", "size": 2048}}
+				]
+			}
+		}`},
+	}}
+	handler := NewGmailPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	params := map[string]any{
+		"labelIds": "INBOX",
+		"query":    "from:billing@example.com",
+	}
+	cursor := map[string]any{"state": map[string]any{gmailHistoryCursorKey: "1000"}}
+	result, err := handler.Poll(context.Background(), gmailTestRequest(identityID, userID, params, cursor))
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+
+	if len(result.Events) != 1 {
+		t.Fatalf("expected 1 event got %d", len(result.Events))
+	}
+	event := result.Events[0]
+	if event.Fingerprint != "m1" {
+		t.Fatalf("unexpected fingerprint %q", event.Fingerprint)
+	}
+	if !event.OccurredAt.Equal(time.UnixMilli(1740830400000).UTC()) {
+		t.Fatalf("unexpected occurredAt %v", event.OccurredAt)
+	}
+	if event.Payload["subject"] != "Facture été" {
+		t.Fatalf("unexpected subject %v", event.Payload["subject"])
+	}
+	if event.Payload["fromAddress"] != "billing@example.com" || event.Payload["fromName"] != "Billing Team" {
+		t.Fatalf("unexpected sender %v / %v", event.Payload["fromAddress"], event.Payload["fromName"])
+	}
+	if event.Payload["snippet"] != "Invoice '42' attached" {
+		t.Fatalf("unexpected snippet %v", event.Payload["snippet"])
+	}
+	attachments, ok := event.Payload["attachments"].([]any)
+	if !ok || len(attachments) != 1 {
+		t.Fatalf("expected one attachment, got %v", event.Payload["attachments"])
+	}
+	attachment := attachments[0].(map[string]any)
+	if attachment["filename"] != "invoice.pdf" || attachment["attachmentId"] != "att-1" {
+		t.Fatalf("unexpected attachment %v", attachment)
+	}
+	if got := result.Cursor[gmailHistoryCursorKey]; got != "1050" {
+		t.Fatalf("unexpected history cursor %v", got)
+	}
+
+	historyRequest := transport.requests[0]
+	if historyRequest.URL.Query().Get("startHistoryId") != "1000" || historyRequest.URL.Query().Get("labelId") != "INBOX" {
+		t.Fatalf("unexpected history query %s", historyRequest.URL.RawQuery)
+	}
+	if historyRequest.Header.Get("Authorization") != "Bearer token" {
+		t.Fatalf("unexpected authorization header %q", historyRequest.Header.Get("Authorization"))
+	}
+	searchRequest := transport.requests[1]
+	if searchRequest.URL.Query().Get("q") != "from:billing@example.com" {
+		t.Fatalf("unexpected search query %s", searchRequest.URL.RawQuery)
+	}
+}
+
+func TestGmailPollingHandlerResetsExpiredHistory(t *testing.T) {
+	identityID := uuid.New()
+	userID := uuid.New()
+	expires := time.Now().Add(time.Hour)
+	repo := &identityRepoStub{identity: identitydomain.Identity{ID: identityID, UserID: userID, Provider: "google", AccessToken: "token", ExpiresAt: &expires}}
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{
+		"/gmail/v1/users/me/history": {status: http.StatusNotFound, body: `{"error":{"code":404}}`},
+		"/gmail/v1/users/me/profile": {body: `{"historyId":"2000"}`},
+	}}
+	handler := NewGmailPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	cursor := map[string]any{"state": map[string]any{gmailHistoryCursorKey: "5"}}
+	result, err := handler.Poll(context.Background(), gmailTestRequest(identityID, userID, map[string]any{}, cursor))
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 0 {
+		t.Fatalf("expected no events after reset")
+	}
+	if got := result.Cursor[gmailHistoryCursorKey]; got != "2000" {
+		t.Fatalf("unexpected history cursor %v", got)
+	}
+}
@@ -0,0 +1,314 @@
+package area
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"go.uber.org/zap"
+)
+
+const (
+	gsheetsPollingHandlerName   = "gsheets"
+	gsheetsDefaultAPIBaseURL    = "https://sheets.googleapis.com/v4/spreadsheets"
+	gsheetsLastRowCursorKey     = "gsheets_last_row"
+	gsheetsDefaultSheetName     = "Sheet1"
+	gsheetsDefaultMaxRows       = 20
+	gsheetsMaxRowsLimit         = 100
+	gsheetsLastColumn           = "ZZZ"
+	gsheetsDefaultIdentityParam = "identityId"
+	gsheetsDefaultOAuthProvider = "google"
+)
+
+// SheetsPollingHandler polls a Google Sheets tab and emits an event for every row appended below the stored row index
+type SheetsPollingHandler struct {
+	client   *http.Client
+	logger   *zap.Logger
+	resolver pollingIdentityResolver
+	baseURL  string
+}
+
+// NewSheetsPollingHandler assembles a Google Sheets polling handler
+func NewSheetsPollingHandler(client *http.Client, logger *zap.Logger, identities identityport.Repository, providers oauthProviderResolver) *SheetsPollingHandler {
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &SheetsPollingHandler{
+		client:   client,
+		logger:   logger,
+		resolver: pollingIdentityResolver{identities: identities, providers: providers},
+		baseURL:  gsheetsDefaultAPIBaseURL,
+	}
+}
+
+// Supports reports whether the component declares the Google Sheets polling ingestion
+func (h *SheetsPollingHandler) Supports(component *componentdomain.Component) bool {
+	_, ok, err := parseSheetsPollingConfig(component)
+	return err == nil && ok
+}
+
+// Poll reads the rows appended since the stored row index and converts them into events
+func (h *SheetsPollingHandler) Poll(ctx context.Context, req PollingRequest) (PollingResult, error) {
+	config, ok, err := parseSheetsPollingConfig(&req.Component)
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.SheetsPollingHandler.Poll: parse config: %w", err)
+	}
+	if !ok {
+		return PollingResult{}, fmt.Errorf("area.SheetsPollingHandler.Poll: component %q not supported", req.Component.Name)
+	}
+
+	if req.Binding.Config.Params == nil {
+		req.Binding.Config.Params = map[string]any{}
+	}
+	target, err := parseSheetsTarget(req.Binding.Config.Params)
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.SheetsPollingHandler.Poll: %w", err)
+	}
+	if err := h.resolver.inject(ctx, &req, config.auth); err != nil {
+		return PollingResult{}, fmt.Errorf("area.SheetsPollingHandler.Poll: %w", err)
+	}
+	token := stringify(req.Identity["accessToken"])
+
+	result := PollingResult{Cursor: cloneMapAny(req.Cursor)}
+	if result.Cursor == nil {
+		result.Cursor = map[string]any{}
+	}
+	cursorState := ensureCursorState(result.Cursor)
+	assignCursorValue(result.Cursor, cursorState, "last_polled_at", req.Now.UTC().Format(time.RFC3339Nano))
+
+	lastRow, err := toInt(flattenCursorState(req.Cursor)[gsheetsLastRowCursorKey])
+	if err != nil || lastRow < 0 {
+		rows, err := h.readRange(ctx, token, target.spreadsheetID, target.rangeFor(""))
+		if err != nil {
+			return PollingResult{}, fmt.Errorf("area.SheetsPollingHandler.Poll: %w", err)
+		}
+		assignCursorValue(result.Cursor, cursorState, gsheetsLastRowCursorKey, len(rows))
+		return result, nil
+	}
+
+	firstRow := lastRow + 1
+	rows, err := h.readRange(ctx, token, target.spreadsheetID, target.rangeFor(fmt.Sprintf("A%d:%s", firstRow, gsheetsLastColumn)))
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.SheetsPollingHandler.Poll: %w", err)
+	}
+	if len(rows) == 0 {
+		return result, nil
+	}
+
+	var header []any
+	if target.hasHeader {
+		headerRows, err := h.readRange(ctx, token, target.spreadsheetID, target.rangeFor("1:1"))
+		if err != nil {
+			return PollingResult{}, fmt.Errorf("area.SheetsPollingHandler.Poll: %w", err)
+		}
+		if len(headerRows) > 0 {
+			header = headerRows[0]
+		}
+	}
+
+	processed := lastRow
+	for offset, row := range rows {
+		if len(result.Events) >= target.maxRows {
+			break
+		}
+		rowIndex := firstRow + offset
+		processed = rowIndex
+		if target.hasHeader && rowIndex == 1 {
+			continue
+		}
+		if sheetsRowEmpty(row) {
+			continue
+		}
+		result.Events = append(result.Events, buildSheetsEvent(target, rowIndex, header, row, req.Now))
+	}
+	assignCursorValue(result.Cursor, cursorState, gsheetsLastRowCursorKey, processed)
+	return result, nil
+}
+
+func (h *SheetsPollingHandler) readRange(ctx context.Context, token string, spreadsheetID string, valueRange string) ([][]any, error) {
+	endpoint := fmt.Sprintf("%s/%s/values/%s", h.baseURL, url.PathEscape(spreadsheetID), url.PathEscape(valueRange))
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("parse endpoint: %w", err)
+	}
+	query := url.Values{}
+	query.Set("majorDimension", "ROWS")
+	u.RawQuery = query.Encode()
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Authorization", "Bearer "+token)
+
+	response, err := h.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	body, err := io.ReadAll(io.LimitReader(response.Body, 8<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if response.StatusCode >= 400 {
+		return nil, fmt.Errorf("unexpected status %d: %s", response.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var payload struct {
+		Values [][]any `json:"values"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return payload.Values, nil
+}
+
+func buildSheetsEvent(target sheetsTarget, rowIndex int, header []any, row []any, now time.Time) PollingEvent {
+	values := make([]any, len(row))
+	cells := make(map[string]any, len(row))
+	named := map[string]any{}
+	for index, value := range row {
+		values[index] = value
+		cells[sheetsColumnLetter(index)] = value
+		if index < len(header) {
+			if name := strings.TrimSpace(stringify(header[index])); name != "" {
+				named[name] = value
+			}
+		}
+	}
+
+	payload := map[string]any{
+		"spreadsheetId": target.spreadsheetID,
+		"sheetName":     target.sheetName,
+		"rowIndex":      rowIndex,
+		"values":        values,
+		"cells":         cells,
+	}
+	if len(named) > 0 {
+		payload["row"] = named
+	}
+	return PollingEvent{
+		Payload:     payload,
+		Fingerprint: fmt.Sprintf("%s:%s:%d", target.spreadsheetID, target.sheetName, rowIndex),
+		OccurredAt:  now.UTC(),
+	}
+}
+
+func sheetsRowEmpty(row []any) bool {
+	for _, value := range row {
+		if strings.TrimSpace(stringify(value)) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// sheetsColumnLetter converts a zero based column index into its A1 notation letters
+func sheetsColumnLetter(index int) string {
+	letters := ""
+	for index >= 0 {
+		letters = string(rune('A'+index%26)) + letters
+		index = index/26 - 1
+	}
+	return letters
+}
+
+type sheetsPollingConfig struct {
+	auth httpPollingAuthConfig
+}
+
+func parseSheetsPollingConfig(component *componentdomain.Component) (sheetsPollingConfig, bool, error) {
+	if component == nil || len(component.Metadata) == 0 {
+		return sheetsPollingConfig{}, false, nil
+	}
+	ingestion, ok, err := ingestionMetadata(component.Metadata)
+	if err != nil {
+		return sheetsPollingConfig{}, false, fmt.Errorf("ingestion metadata invalid: %w", err)
+	}
+	if !ok || !ingestionSupportsMode(ingestion, ingestionModePolling) {
+		return sheetsPollingConfig{}, false, nil
+	}
+	handlerName, err := toString(ingestion["handler"])
+	if err != nil || strings.ToLower(strings.TrimSpace(handlerName)) != gsheetsPollingHandlerName {
+		return sheetsPollingConfig{}, false, nil
+	}
+
+	cfg := sheetsPollingConfig{auth: httpPollingAuthConfig{
+		Kind:          "oauth",
+		IdentityParam: gsheetsDefaultIdentityParam,
+		Provider:      gsheetsDefaultOAuthProvider,
+	}}
+	if rawAuth, ok := ingestion["auth"]; ok {
+		authMap, err := toMapStringAny(rawAuth)
+		if err != nil {
+			return sheetsPollingConfig{}, false, fmt.Errorf("auth metadata invalid: %w", err)
+		}
+		cfg.auth.IdentityParam = stringOrDefault(authMap, "identityParam", cfg.auth.IdentityParam)
+		cfg.auth.Provider = stringOrDefault(authMap, "provider", cfg.auth.Provider)
+	}
+	return cfg, true, nil
+}
+
+type sheetsTarget struct {
+	spreadsheetID string
+	sheetName     string
+	hasHeader     bool
+	maxRows       int
+}
+
+func parseSheetsTarget(params map[string]any) (sheetsTarget, error) {
+	target := sheetsTarget{sheetName: gsheetsDefaultSheetName, hasHeader: true, maxRows: gsheetsDefaultMaxRows}
+	if id, err := toString(params["spreadsheetId"]); err == nil {
+		target.spreadsheetID = strings.TrimSpace(id)
+	}
+	if target.spreadsheetID == "" {
+		return target, fmt.Errorf("spreadsheetId missing")
+	}
+	if name, err := toString(params["sheetName"]); err == nil && strings.TrimSpace(name) != "" {
+		target.sheetName = strings.TrimSpace(name)
+	}
+	switch v := params["hasHeader"].(type) {
+	case bool:
+		target.hasHeader = v
+	case string:
+		if parsed, err := strconv.ParseBool(strings.TrimSpace(v)); err == nil {
+			target.hasHeader = parsed
+		}
+	}
+	if value, ok := params["maxRows"]; ok {
+		if maxRows, err := toInt(value); err == nil && maxRows > 0 {
+			target.maxRows = maxRows
+		}
+	}
+	if target.maxRows > gsheetsMaxRowsLimit {
+		target.maxRows = gsheetsMaxRowsLimit
+	}
+	return target, nil
+}
+
+// rangeFor builds an A1 range on the configured sheet, quoting the sheet name as required by the API
+func (t sheetsTarget) rangeFor(cells string) string {
+	quoted := "'" + strings.ReplaceAll(t.sheetName, "'", "''") + "'"
+	if cells == "" {
+		return quoted
+	}
+	return quoted + "!" + cells
+}
+
+// Ensure SheetsPollingHandler implements ComponentPollingHandler
+var _ ComponentPollingHandler = (*SheetsPollingHandler)(nil)
@@ -0,0 +1,149 @@
+package area
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func sheetsTestComponent() componentdomain.Component {
+	return componentdomain.Component{
+		Name:     "gsheets_new_row",
+		Provider: componentdomain.Provider{Name: "google"},
+		Metadata: map[string]any{
+			"ingestion": map[string]any{
+				"mode":    "polling",
+				"handler": "gsheets",
+				"auth": map[string]any{
+					"identityParam": "identityId",
+					"provider":      "google",
+				},
+			},
+		},
+	}
+}
+
+func sheetsTestRequest(t *testing.T, params map[string]any, cursor map[string]any) (PollingRequest, *identityRepoStub) {
+	t.Helper()
+	identityID := uuid.New()
+	userID := uuid.New()
+	expires := time.Now().Add(time.Hour)
+	repo := &identityRepoStub{identity: identitydomain.Identity{ID: identityID, UserID: userID, Provider: "google", AccessToken: "token", ExpiresAt: &expires}}
+	params["identityId"] = identityID.String()
+	return PollingRequest{
+		Binding: actiondomain.PollingBinding{
+			UserID: userID,
+			Config: componentdomain.Config{Params: params},
+		},
+		Component: sheetsTestComponent(),
+		Cursor:    cursor,
+		Now:       time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}, repo
+}
+
+func TestSheetsPollingHandlerSupports(t *testing.T) {
+	handler := NewSheetsPollingHandler(nil, zap.NewNop(), nil, nil)
+	component := sheetsTestComponent()
+	if !handler.Supports(&component) {
+		t.Fatalf("expected gsheets component to be supported")
+	}
+	if NewGmailPollingHandler(nil, zap.NewNop(), nil, nil).Supports(&component) {
+		t.Fatalf("gmail handler should not claim gsheets components")
+	}
+}
+
+func TestSheetsPollingHandlerInitialisesRowCursor(t *testing.T) {
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{
+		"/v4/spreadsheets/sheet-1/values/'Leads'": {body: `{"range":"Leads!A1:C3","values":[["Name","Email"],["Ada","ada@example.com"],["Alan","alan@example.com"]]}`},
+	}}
+	req, repo := sheetsTestRequest(t, map[string]any{"spreadsheetId": "sheet-1", "sheetName": "Leads"}, map[string]any{})
+	handler := NewSheetsPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 0 {
+		t.Fatalf("expected no events on first poll, got %d", len(result.Events))
+	}
+	if got := result.Cursor[gsheetsLastRowCursorKey]; got != 3 {
+		t.Fatalf("unexpected row cursor %v", got)
+	}
+}
+
+func TestSheetsPollingHandlerEmitsAppendedRows(t *testing.T) {
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{
+		"/v4/spreadsheets/sheet-1/values/'Leads'!A4:ZZZ": {body: `{"values":[["Grace","grace@example.com"],[],["Linus","linus@example.com"]]}`},
+		"/v4/spreadsheets/sheet-1/values/'Leads'!1:1":    {body: `{"values":[["Name","Email"]]}`},
+	}}
+	cursor := map[string]any{"state": map[string]any{gsheetsLastRowCursorKey: float64(3)}}
+	req, repo := sheetsTestRequest(t, map[string]any{"spreadsheetId": "sheet-1", "sheetName": "Leads"}, cursor)
+	handler := NewSheetsPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 2 {
+		t.Fatalf("expected 2 events got %d", len(result.Events))
+	}
+	first := result.Events[0]
+	if first.Fingerprint != "sheet-1:Leads:4" || first.Payload["rowIndex"] != 4 {
+		t.Fatalf("unexpected first event %q %v", first.Fingerprint, first.Payload["rowIndex"])
+	}
+	row, ok := first.Payload["row"].(map[string]any)
+	if !ok || row["Email"] != "grace@example.com" {
+		t.Fatalf("unexpected named row %v", first.Payload["row"])
+	}
+	if cells := first.Payload["cells"].(map[string]any); cells["A"] != "Grace" {
+		t.Fatalf("unexpected cells %v", cells)
+	}
+	if result.Events[1].Payload["rowIndex"] != 6 {
+		t.Fatalf("unexpected second row index %v", result.Events[1].Payload["rowIndex"])
+	}
+	if got := result.Cursor[gsheetsLastRowCursorKey]; got != 6 {
+		t.Fatalf("unexpected row cursor %v", got)
+	}
+	if auth := transport.requests[0].Header.Get("Authorization"); auth != "Bearer token" {
+		t.Fatalf("unexpected authorization header %q", auth)
+	}
+}
+
+func TestSheetsPollingHandlerCapsRowsPerPoll(t *testing.T) {
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{
+		"/v4/spreadsheets/sheet-1/values/'Sheet1'!A1:ZZZ": {body: `{"values":[["a"],["b"],["c"]]}`},
+	}}
+	cursor := map[string]any{"state": map[string]any{gsheetsLastRowCursorKey: 0}}
+	req, repo := sheetsTestRequest(t, map[string]any{"spreadsheetId": "sheet-1", "hasHeader": false, "maxRows": float64(2)}, cursor)
+	handler := NewSheetsPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 2 {
+		t.Fatalf("expected 2 events got %d", len(result.Events))
+	}
+	if got := result.Cursor[gsheetsLastRowCursorKey]; got != 2 {
+		t.Fatalf("unexpected row cursor %v", got)
+	}
+	if _, ok := result.Events[0].Payload["row"]; ok {
+		t.Fatalf("rows without header should not expose named values")
+	}
+}
+
+func TestSheetsColumnLetter(t *testing.T) {
+	cases := map[int]string{0: "A", 25: "Z", 26: "AA", 51: "AZ", 702: "AAA"}
+	for index, want := range cases {
+		if got := sheetsColumnLetter(index); got != want {
+			t.Fatalf("sheetsColumnLetter(%d) = %q want %q", index, got, want)
+		}
+	}
+}
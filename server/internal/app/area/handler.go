@@ -22,6 +22,7 @@ import (
 	"github.com/google/uuid"
 	openapitypes "github.com/oapi-codegen/runtime/types"
 	"go.uber.org/zap"
+	"gopkg.in/yaml.v3"
 )
 
 // CookieConfig replicates the session cookie directives enforced at the edge
@@ -59,236 +60,1019 @@ func NewHandler(service *Service, sessions SessionResolver, cookies CookieConfig
 }
 
 // ListAreas handles GET /v1/areas
-func (h *Handler) ListAreas(c *gin.Context) {
+func (h *Handler) ListAreas(c *gin.Context, params openapi.ListAreasParams) {
 	usr, _, ok := h.authorize(c)
 	if !ok {
 		return
 	}
 
-	items, err := h.service.List(c.Request.Context(), usr.ID)
+	opts := ListOptions{ComponentID: params.ComponentId, TagID: params.TagId, FolderID: params.FolderId}
+	if params.Limit != nil {
+		if *params.Limit <= 0 || *params.Limit > MaxPageLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+		opts.Limit = *params.Limit
+	}
+	if params.Cursor != nil {
+		opts.Cursor = *params.Cursor
+	}
+	if params.Status != nil {
+		for _, status := range *params.Status {
+			opts.Statuses = append(opts.Statuses, areadomain.Status(status))
+		}
+	}
+	if params.Provider != nil {
+		opts.Provider = *params.Provider
+	}
+	if params.Q != nil {
+		opts.Search = *params.Q
+	}
+	if params.Sort != nil {
+		switch *params.Sort {
+		case openapi.CreatedAt:
+			opts.Sort = outbound.AreaSortCreated
+		case openapi.UpdatedAt:
+			opts.Sort = outbound.AreaSortUpdated
+		case openapi.LastRunAt:
+			opts.Sort = outbound.AreaSortLastRun
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid sort"})
+			return
+		}
+	}
+	if params.Order != nil {
+		switch *params.Order {
+		case openapi.Asc:
+			opts.Ascending = true
+		case openapi.Desc:
+		default:
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid order"})
+			return
+		}
+	}
+
+	page, err := h.service.ListPaged(c.Request.Context(), usr.ID, opts)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	response := openapi.ListAreasResponse{Areas: mapAreas(page.Areas)}
+	if page.NextCursor != "" {
+		cursor := page.NextCursor
+		response.NextCursor = &cursor
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// CreateArea handles POST /v1/areas
+func (h *Handler) CreateArea(c *gin.Context) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	var payload openapi.CreateAreaRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+	name := strings.TrimSpace(payload.Name)
+	if name == "" {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "name is required"})
+		return
+	}
+
+	desc := ""
+	if payload.Description != nil {
+		desc = strings.TrimSpace(*payload.Description)
+	}
+
+	if len(payload.Reactions) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "at least one reaction is required"})
+		return
+	}
+
+	actionInput := fromCreateAction(payload.Action)
+	reactionInputs := fromCreateReactions(payload.Reactions)
+
+	var (
+		created areadomain.Area
+		err     error
+	)
+	if payload.WorkspaceId != nil {
+		created, err = h.service.CreateInWorkspace(c.Request.Context(), usr.ID, *payload.WorkspaceId, name, desc, actionInput, reactionInputs)
+	} else {
+		created, err = h.service.Create(c.Request.Context(), usr.ID, name, desc, actionInput, reactionInputs)
+	}
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toOpenAPIArea(created))
+}
+
+// GetArea handles GET /v1/areas/{areaId}
+func (h *Handler) GetArea(c *gin.Context, areaID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	area, err := h.service.Get(c.Request.Context(), usr.ID, areaID)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOpenAPIArea(area))
+}
+
+// UpdateArea handles PATCH /v1/areas/{areaId}
+func (h *Handler) UpdateArea(c *gin.Context, areaID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	payload, err := readRequestBody(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+	if len(strings.TrimSpace(string(payload))) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "empty request payload"})
+		return
+	}
+
+	cmd, err := decodeUpdateAreaPayload(payload)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updated, err := h.service.Update(c.Request.Context(), usr.ID, areaID, cmd)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOpenAPIArea(updated))
+}
+
+// GetAreaWebhook handles GET /v1/areas/{areaId}/webhook
+func (h *Handler) GetAreaWebhook(c *gin.Context, areaID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	endpoint, err := h.service.WebhookEndpoint(c.Request.Context(), usr.ID, areaID)
+	if err != nil {
+		if errors.Is(err, ErrWebhookNotFound) {
+			c.JSON(http.StatusNotFound, gin.H{"error": "webhook not provisioned"})
+			return
+		}
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, openapi.AreaWebhook{
+		Url:    endpoint.URL,
+		Path:   endpoint.Path,
+		Secret: endpoint.Secret,
+	})
+}
+
+// UpdateAreaStatus handles PATCH /v1/areas/{areaId}/status
+func (h *Handler) UpdateAreaStatus(c *gin.Context, areaID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	var payload openapi.UpdateAreaStatusRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+
+	updated, err := h.service.UpdateStatus(c.Request.Context(), usr.ID, areaID, areadomain.Status(payload.Status))
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOpenAPIArea(updated))
+}
+
+// DuplicateArea handles POST /v1/areas/{areaId}/duplicate
+func (h *Handler) DuplicateArea(c *gin.Context, areaID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	data, err := readRequestBody(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+
+	var overrides openapi.DuplicateAreaRequest
+	if len(strings.TrimSpace(string(data))) > 0 {
+		if err := json.Unmarshal(data, &overrides); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+			return
+		}
+	}
+
+	opts := DuplicateOptions{
+		Name:        overrides.Name,
+		Description: overrides.Description,
+	}
+
+	clone, err := h.service.Duplicate(c.Request.Context(), usr.ID, areaID, opts)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toOpenAPIArea(clone))
+}
+
+// MoveAreaWorkspace handles PUT /v1/areas/{areaId}/workspace
+func (h *Handler) MoveAreaWorkspace(c *gin.Context, areaID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	var payload openapi.MoveAreaWorkspaceRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+
+	moved, err := h.service.MoveToWorkspace(c.Request.Context(), usr.ID, areaID, payload.WorkspaceId)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOpenAPIArea(moved))
+}
+
+// SetAreaRunner handles PUT /v1/areas/{areaId}/runner
+func (h *Handler) SetAreaRunner(c *gin.Context, areaID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	var payload openapi.SetAreaRunnerRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+
+	updated, err := h.service.SetRunner(c.Request.Context(), usr.ID, areaID, payload.UserId)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOpenAPIArea(updated))
+}
+
+// SetAreaHealthPolicy handles PUT /v1/areas/{areaId}/health-policy
+func (h *Handler) SetAreaHealthPolicy(c *gin.Context, areaID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	var payload openapi.UpdateAreaHealthPolicyRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+
+	var policy *areadomain.HealthPolicy
+	if payload.Policy != nil {
+		policy = &areadomain.HealthPolicy{
+			ConsecutiveFailures: payload.Policy.ConsecutiveFailures,
+			FailureRate:         payload.Policy.FailureRate,
+			Window:              time.Duration(payload.Policy.WindowSeconds) * time.Second,
+			MinJobs:             payload.Policy.MinJobs,
+		}
+	}
+
+	updated, err := h.service.SetHealthPolicy(c.Request.Context(), usr.ID, areaID, policy)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toOpenAPIArea(updated))
+}
+
+// SetAreaActivitySchedule handles PUT /v1/areas/{areaId}/activity-schedule
+func (h *Handler) SetAreaActivitySchedule(c *gin.Context, areaID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	var payload openapi.UpdateAreaActivityScheduleRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+
+	var schedule *areadomain.ActivitySchedule
+	if payload.Schedule != nil {
+		converted, err := fromOpenAPIActivitySchedule(*payload.Schedule)
+		if err != nil {
+			h.handleServiceError(c, err)
+			return
+		}
+		schedule = &converted
+	}
+
+	updated, err := h.service.SetActivitySchedule(c.Request.Context(), usr.ID, areaID, schedule)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toOpenAPIArea(updated))
+}
+
+// ListAreaHistory handles GET /v1/areas/{areaId}/history
+func (h *Handler) ListAreaHistory(c *gin.Context, areaID openapitypes.UUID, params openapi.ListAreaHistoryParams) {
+	if h.jobs == nil {
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "history unavailable"})
+		return
+	}
+
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	area, err := h.service.Get(c.Request.Context(), usr.ID, areaID)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	limit := 50
+	if params.Limit != nil {
+		if *params.Limit <= 0 || *params.Limit > 200 {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+		limit = *params.Limit
+	}
+
+	jobs, err := h.jobs.ListWithDetails(c.Request.Context(), outbound.JobListOptions{
+		UserID: area.UserID,
+		AreaID: areaID,
+		Limit:  limit,
+	})
+	if err != nil {
+		zap.L().Error("failed to list area history", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list history"})
+		return
+	}
+
+	response := toOpenAPIHistoryResponse(jobs)
+	c.JSON(http.StatusOK, response)
+}
+
+// ListAreaRevisions handles GET /v1/areas/{areaId}/revisions
+func (h *Handler) ListAreaRevisions(c *gin.Context, areaID openapitypes.UUID, params openapi.ListAreaRevisionsParams) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	limit := revisionListDefaultLimit
+	if params.Limit != nil {
+		if *params.Limit <= 0 || *params.Limit > revisionListMaxLimit {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
+			return
+		}
+		limit = *params.Limit
+	}
+
+	revisions, err := h.service.ListRevisions(c.Request.Context(), usr.ID, areaID, limit)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	response := openapi.AreaRevisionListResponse{Revisions: make([]openapi.AreaRevision, 0, len(revisions))}
+	for _, revision := range revisions {
+		response.Revisions = append(response.Revisions, toOpenAPIRevision(revision))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// DiffAreaRevisions handles GET /v1/areas/{areaId}/revisions/diff
+func (h *Handler) DiffAreaRevisions(c *gin.Context, areaID openapitypes.UUID, params openapi.DiffAreaRevisionsParams) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	if params.From <= 0 || params.To <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid revision"})
+		return
+	}
+
+	changes, err := h.service.DiffRevisions(c.Request.Context(), usr.ID, areaID, params.From, params.To)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	response := openapi.AreaRevisionDiffResponse{
+		From:    params.From,
+		To:      params.To,
+		Changes: make([]openapi.AreaRevisionChange, 0, len(changes)),
+	}
+	for _, change := range changes {
+		response.Changes = append(response.Changes, openapi.AreaRevisionChange{
+			Path:   change.Path,
+			Before: change.Before,
+			After:  change.After,
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// RollbackArea handles POST /v1/areas/{areaId}/revisions/{revision}/rollback
+func (h *Handler) RollbackArea(c *gin.Context, areaID openapitypes.UUID, revision int) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	if revision <= 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid revision"})
+		return
+	}
+
+	restored, err := h.service.Rollback(c.Request.Context(), usr.ID, areaID, revision)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOpenAPIArea(restored))
+}
+
+// ExecuteArea handles POST /v1/areas/{areaId}/execute
+func (h *Handler) ExecuteArea(c *gin.Context, areaID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Execute(c.Request.Context(), usr.ID, areaID); err != nil {
+		h.handleExecuteError(c, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// DryRunArea handles POST /v1/areas/{areaId}/dry-run
+func (h *Handler) DryRunArea(c *gin.Context, areaID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	data, err := readRequestBody(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+	var payload openapi.AreaDryRunRequest
+	if len(strings.TrimSpace(string(data))) > 0 {
+		if err := json.Unmarshal(data, &payload); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+			return
+		}
+	}
+
+	opts := DryRunOptions{}
+	if payload.Payload != nil {
+		opts.Payload = *payload.Payload
+	}
+	if payload.Event != nil {
+		opts.Event = *payload.Event
+	}
+
+	result, err := h.service.DryRun(c.Request.Context(), usr.ID, areaID, opts)
+	if err != nil {
+		if errors.Is(err, ErrAreaMisconfigured) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "area misconfigured"})
+			return
+		}
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toOpenAPIDryRunResponse(result))
+}
+
+// DeleteArea handles DELETE /v1/areas/{areaId}
+func (h *Handler) DeleteArea(c *gin.Context, areaID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), usr.ID, areaID); err != nil {
+		h.handleDeleteError(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// PreviewComponent handles POST /v1/components/{componentId}/preview
+func (h *Handler) PreviewComponent(c *gin.Context, componentID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	var payload openapi.PreviewComponentRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+
+	preview, err := h.service.PreviewPolling(c.Request.Context(), usr.ID, componentID, cloneMap(payload.Params))
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrPollingPreviewUnsupported):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "component cannot be previewed"})
+		case errors.Is(err, ErrPollingPreviewFailed):
+			c.JSON(http.StatusBadGateway, gin.H{"error": "preview request failed", "detail": err.Error()})
+		default:
+			h.handleServiceError(c, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusOK, toOpenAPIPreviewResponse(preview))
+}
+
+// ExportArea handles GET /v1/areas/{areaId}/export
+func (h *Handler) ExportArea(c *gin.Context, areaID openapitypes.UUID, params openapi.ExportAreaParams) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	format := openapi.Json
+	if params.Format != nil {
+		format = *params.Format
+	}
+	if format != openapi.Json && format != openapi.Yaml {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "unsupported export format"})
+		return
+	}
+
+	doc, err := h.service.Export(c.Request.Context(), usr.ID, areaID)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	filename := fmt.Sprintf("area-%s.%s", areaID, format)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	if format == openapi.Yaml {
+		data, err := yaml.Marshal(doc)
+		if err != nil {
+			zap.L().Error("area export encode failed", zap.Error(err))
+			c.JSON(http.StatusInternalServerError, gin.H{"error": "internal error"})
+			return
+		}
+		c.Data(http.StatusOK, "application/yaml", data)
+		return
+	}
+	c.JSON(http.StatusOK, doc)
+}
+
+// ImportArea handles POST /v1/areas/import
+func (h *Handler) ImportArea(c *gin.Context) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	payload, err := readRequestBody(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+	doc, err := decodeAreaDocument(c.ContentType(), payload)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid area document", "detail": err.Error()})
+		return
+	}
+
+	imported, err := h.service.Import(c.Request.Context(), usr.ID, doc)
+	if err != nil {
+		switch {
+		case errors.Is(err, ErrAreaDocumentInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid area document", "detail": err.Error()})
+		case errors.Is(err, ErrComponentParamsInvalid):
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid component params", "detail": err.Error()})
+		default:
+			h.handleServiceError(c, err)
+		}
+		return
+	}
+
+	c.JSON(http.StatusCreated, toOpenAPIArea(imported))
+}
+
+// ListAreaTemplates handles GET /v1/templates
+func (h *Handler) ListAreaTemplates(c *gin.Context) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	templates, err := h.service.ListTemplates(c.Request.Context(), usr.ID)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	response := openapi.AreaTemplateListResponse{Templates: make([]openapi.AreaTemplate, 0, len(templates))}
+	for _, template := range templates {
+		response.Templates = append(response.Templates, toOpenAPITemplate(template))
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// GetAreaTemplate handles GET /v1/templates/{templateId}
+func (h *Handler) GetAreaTemplate(c *gin.Context, templateID openapitypes.UUID) {
+	if _, _, ok := h.authorize(c); !ok {
+		return
+	}
+
+	template, err := h.service.GetTemplate(c.Request.Context(), templateID)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toOpenAPITemplate(template))
+}
+
+// InstantiateAreaTemplate handles POST /v1/templates/{templateId}/instantiate
+func (h *Handler) InstantiateAreaTemplate(c *gin.Context, templateID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	var payload openapi.InstantiateAreaTemplateRequest
+	body, err := readRequestBody(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+	if len(bytes.TrimSpace(body)) > 0 {
+		if err := json.Unmarshal(body, &payload); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+			return
+		}
+	}
+
+	input := TemplateInstantiation{}
+	if payload.Name != nil {
+		input.Name = *payload.Name
+	}
+	if payload.Description != nil {
+		input.Description = *payload.Description
+	}
+	if payload.Action != nil {
+		input.Action = *payload.Action
+	}
+	if payload.Reactions != nil {
+		input.Reactions = *payload.Reactions
+	}
+
+	created, err := h.service.InstantiateTemplate(c.Request.Context(), usr.ID, templateID, input)
+	if err != nil {
+		if errors.Is(err, ErrComponentParamsInvalid) {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid component params", "detail": err.Error()})
+			return
+		}
+		h.handleServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, toOpenAPIArea(created))
+}
+
+// CreateAreaTemplate handles POST /v1/admin/templates
+func (h *Handler) CreateAreaTemplate(c *gin.Context) {
+	usr, ok := h.requireAdmin(c)
+	if !ok {
+		return
+	}
+
+	var payload openapi.CreateAreaTemplateRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+
+	action := fromCreateAction(payload.Action)
+	input := TemplateInput{
+		Name:      payload.Name,
+		Action:    TemplateSlotInput{ComponentID: action.ComponentID, Name: action.Name, Params: action.Params},
+		Reactions: make([]TemplateSlotInput, 0, len(payload.Reactions)),
+	}
+	if payload.Description != nil {
+		input.Description = *payload.Description
+	}
+	for _, reaction := range fromCreateReactions(payload.Reactions) {
+		input.Reactions = append(input.Reactions, TemplateSlotInput{
+			ComponentID: reaction.ComponentID,
+			Name:        reaction.Name,
+			Params:      reaction.Params,
+		})
+	}
+
+	created, err := h.service.CreateTemplate(c.Request.Context(), usr.ID, input)
+	if err != nil {
+		if errors.Is(err, outbound.ErrConflict) {
+			c.JSON(http.StatusConflict, gin.H{"error": "template name already used"})
+			return
+		}
+		h.handleServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, toOpenAPITemplate(created))
+}
+
+// DeleteAreaTemplate handles DELETE /v1/admin/templates/{templateId}
+func (h *Handler) DeleteAreaTemplate(c *gin.Context, templateID openapitypes.UUID) {
+	if _, ok := h.requireAdmin(c); !ok {
+		return
+	}
+
+	if err := h.service.DeleteTemplate(c.Request.Context(), templateID); err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// ListTags handles GET /v1/tags
+func (h *Handler) ListTags(c *gin.Context) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	tags, err := h.service.ListTags(c.Request.Context(), usr.ID)
 	if err != nil {
 		h.handleServiceError(c, err)
 		return
 	}
 
-	response := openapi.ListAreasResponse{Areas: mapAreas(items)}
+	response := openapi.TagListResponse{Tags: make([]openapi.Tag, 0, len(tags))}
+	for _, tag := range tags {
+		response.Tags = append(response.Tags, toOpenAPITag(tag))
+	}
 	c.JSON(http.StatusOK, response)
 }
 
-// CreateArea handles POST /v1/areas
-func (h *Handler) CreateArea(c *gin.Context) {
+// CreateTag handles POST /v1/tags
+func (h *Handler) CreateTag(c *gin.Context) {
 	usr, _, ok := h.authorize(c)
 	if !ok {
 		return
 	}
 
-	var payload openapi.CreateAreaRequest
+	var payload openapi.CreateTagRequest
 	if err := c.ShouldBindJSON(&payload); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
 		return
 	}
-	name := strings.TrimSpace(payload.Name)
-	if name == "" {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "name is required"})
+
+	tag, err := h.service.CreateTag(c.Request.Context(), usr.ID, TagInput{Name: &payload.Name, Color: payload.Color})
+	if err != nil {
+		h.handleServiceError(c, err)
 		return
 	}
+	c.JSON(http.StatusCreated, toOpenAPITag(tag))
+}
 
-	desc := ""
-	if payload.Description != nil {
-		desc = strings.TrimSpace(*payload.Description)
+// UpdateTag handles PATCH /v1/tags/{tagId}
+func (h *Handler) UpdateTag(c *gin.Context, tagID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
 	}
 
-	if len(payload.Reactions) == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "at least one reaction is required"})
+	var payload openapi.UpdateTagRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
 		return
 	}
 
-	actionInput := fromCreateAction(payload.Action)
-	reactionInputs := fromCreateReactions(payload.Reactions)
-
-	created, err := h.service.Create(c.Request.Context(), usr.ID, name, desc, actionInput, reactionInputs)
+	tag, err := h.service.UpdateTag(c.Request.Context(), usr.ID, tagID, TagInput{Name: payload.Name, Color: payload.Color})
 	if err != nil {
 		h.handleServiceError(c, err)
 		return
 	}
-
-	c.JSON(http.StatusCreated, toOpenAPIArea(created))
+	c.JSON(http.StatusOK, toOpenAPITag(tag))
 }
 
-// GetArea handles GET /v1/areas/{areaId}
-func (h *Handler) GetArea(c *gin.Context, areaID openapitypes.UUID) {
+// DeleteTag handles DELETE /v1/tags/{tagId}
+func (h *Handler) DeleteTag(c *gin.Context, tagID openapitypes.UUID) {
 	usr, _, ok := h.authorize(c)
 	if !ok {
 		return
 	}
 
-	area, err := h.service.Get(c.Request.Context(), usr.ID, areaID)
-	if err != nil {
+	if err := h.service.DeleteTag(c.Request.Context(), usr.ID, tagID); err != nil {
 		h.handleServiceError(c, err)
 		return
 	}
-
-	c.JSON(http.StatusOK, toOpenAPIArea(area))
+	c.Status(http.StatusNoContent)
 }
 
-// UpdateArea handles PATCH /v1/areas/{areaId}
-func (h *Handler) UpdateArea(c *gin.Context, areaID openapitypes.UUID) {
+// BulkTagAction handles POST /v1/tags/{tagId}/bulk
+func (h *Handler) BulkTagAction(c *gin.Context, tagID openapitypes.UUID) {
 	usr, _, ok := h.authorize(c)
 	if !ok {
 		return
 	}
 
-	payload, err := readRequestBody(c)
-	if err != nil {
+	var payload openapi.BulkTagActionRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
 		return
 	}
-	if len(strings.TrimSpace(string(payload))) == 0 {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "empty request payload"})
+
+	result, err := h.service.ApplyToTag(c.Request.Context(), usr.ID, tagID, BulkAction(payload.Action))
+	if err != nil {
+		h.handleServiceError(c, err)
 		return
 	}
 
-	cmd, err := decodeUpdateAreaPayload(payload)
-	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+	response := openapi.BulkTagActionResponse{
+		Succeeded: result.Succeeded,
+		Failed:    make([]openapi.BulkTagActionFailure, 0, len(result.Failed)),
+	}
+	for _, failure := range result.Failed {
+		response.Failed = append(response.Failed, openapi.BulkTagActionFailure{
+			AreaId: failure.AreaID,
+			Error:  bulkFailureReason(failure.Err),
+		})
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+// TagArea handles PUT /v1/areas/{areaId}/tags/{tagId}
+func (h *Handler) TagArea(c *gin.Context, areaID openapitypes.UUID, tagID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
 		return
 	}
 
-	updated, err := h.service.Update(c.Request.Context(), usr.ID, areaID, cmd)
+	area, err := h.service.TagArea(c.Request.Context(), usr.ID, areaID, tagID)
 	if err != nil {
 		h.handleServiceError(c, err)
 		return
 	}
-
-	c.JSON(http.StatusOK, toOpenAPIArea(updated))
+	c.JSON(http.StatusOK, toOpenAPIArea(area))
 }
 
-// UpdateAreaStatus handles PATCH /v1/areas/{areaId}/status
-func (h *Handler) UpdateAreaStatus(c *gin.Context, areaID openapitypes.UUID) {
+// UntagArea handles DELETE /v1/areas/{areaId}/tags/{tagId}
+func (h *Handler) UntagArea(c *gin.Context, areaID openapitypes.UUID, tagID openapitypes.UUID) {
 	usr, _, ok := h.authorize(c)
 	if !ok {
 		return
 	}
 
-	var payload openapi.UpdateAreaStatusRequest
-	if err := c.ShouldBindJSON(&payload); err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
-		return
-	}
-
-	updated, err := h.service.UpdateStatus(c.Request.Context(), usr.ID, areaID, areadomain.Status(payload.Status))
+	area, err := h.service.UntagArea(c.Request.Context(), usr.ID, areaID, tagID)
 	if err != nil {
 		h.handleServiceError(c, err)
 		return
 	}
-
-	c.JSON(http.StatusOK, toOpenAPIArea(updated))
+	c.JSON(http.StatusOK, toOpenAPIArea(area))
 }
 
-// DuplicateArea handles POST /v1/areas/{areaId}/duplicate
-func (h *Handler) DuplicateArea(c *gin.Context, areaID openapitypes.UUID) {
+// ListFolders handles GET /v1/folders
+func (h *Handler) ListFolders(c *gin.Context) {
 	usr, _, ok := h.authorize(c)
 	if !ok {
 		return
 	}
 
-	data, err := readRequestBody(c)
+	folders, err := h.service.ListFolders(c.Request.Context(), usr.ID)
 	if err != nil {
-		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		h.handleServiceError(c, err)
 		return
 	}
 
-	var overrides openapi.DuplicateAreaRequest
-	if len(strings.TrimSpace(string(data))) > 0 {
-		if err := json.Unmarshal(data, &overrides); err != nil {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
-			return
-		}
+	response := openapi.FolderListResponse{Folders: make([]openapi.Folder, 0, len(folders))}
+	for _, folder := range folders {
+		response.Folders = append(response.Folders, toOpenAPIFolder(folder))
 	}
+	c.JSON(http.StatusOK, response)
+}
 
-	opts := DuplicateOptions{
-		Name:        overrides.Name,
-		Description: overrides.Description,
+// CreateFolder handles POST /v1/folders
+func (h *Handler) CreateFolder(c *gin.Context) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
 	}
 
-	clone, err := h.service.Duplicate(c.Request.Context(), usr.ID, areaID, opts)
-	if err != nil {
-		h.handleServiceError(c, err)
+	var payload openapi.FolderRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
 		return
 	}
 
-	c.JSON(http.StatusCreated, toOpenAPIArea(clone))
-}
-
-// ListAreaHistory handles GET /v1/areas/{areaId}/history
-func (h *Handler) ListAreaHistory(c *gin.Context, areaID openapitypes.UUID, params openapi.ListAreaHistoryParams) {
-	if h.jobs == nil {
-		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "history unavailable"})
+	folder, err := h.service.CreateFolder(c.Request.Context(), usr.ID, payload.Name)
+	if err != nil {
+		h.handleServiceError(c, err)
 		return
 	}
+	c.JSON(http.StatusCreated, toOpenAPIFolder(folder))
+}
 
+// UpdateFolder handles PATCH /v1/folders/{folderId}
+func (h *Handler) UpdateFolder(c *gin.Context, folderID openapitypes.UUID) {
 	usr, _, ok := h.authorize(c)
 	if !ok {
 		return
 	}
 
-	if _, err := h.service.Get(c.Request.Context(), usr.ID, areaID); err != nil {
-		h.handleServiceError(c, err)
+	var payload openapi.FolderRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
 		return
 	}
 
-	limit := 50
-	if params.Limit != nil {
-		if *params.Limit <= 0 || *params.Limit > 200 {
-			c.JSON(http.StatusBadRequest, gin.H{"error": "invalid limit"})
-			return
-		}
-		limit = *params.Limit
-	}
-
-	jobs, err := h.jobs.ListWithDetails(c.Request.Context(), outbound.JobListOptions{
-		UserID: usr.ID,
-		AreaID: areaID,
-		Limit:  limit,
-	})
+	folder, err := h.service.RenameFolder(c.Request.Context(), usr.ID, folderID, payload.Name)
 	if err != nil {
-		zap.L().Error("failed to list area history", zap.Error(err))
-		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to list history"})
+		h.handleServiceError(c, err)
 		return
 	}
-
-	response := toOpenAPIHistoryResponse(jobs)
-	c.JSON(http.StatusOK, response)
+	c.JSON(http.StatusOK, toOpenAPIFolder(folder))
 }
 
-// ExecuteArea handles POST /v1/areas/{areaId}/execute
-func (h *Handler) ExecuteArea(c *gin.Context, areaID openapitypes.UUID) {
+// DeleteFolder handles DELETE /v1/folders/{folderId}
+func (h *Handler) DeleteFolder(c *gin.Context, folderID openapitypes.UUID) {
 	usr, _, ok := h.authorize(c)
 	if !ok {
 		return
 	}
 
-	if err := h.service.Execute(c.Request.Context(), usr.ID, areaID); err != nil {
-		h.handleExecuteError(c, err)
+	if err := h.service.DeleteFolder(c.Request.Context(), usr.ID, folderID); err != nil {
+		h.handleServiceError(c, err)
 		return
 	}
-
-	c.Status(http.StatusAccepted)
+	c.Status(http.StatusNoContent)
 }
 
-// DeleteArea handles DELETE /v1/areas/{areaId}
-func (h *Handler) DeleteArea(c *gin.Context, areaID openapitypes.UUID) {
+// MoveAreaFolder handles PUT /v1/areas/{areaId}/folder
+func (h *Handler) MoveAreaFolder(c *gin.Context, areaID openapitypes.UUID) {
 	usr, _, ok := h.authorize(c)
 	if !ok {
 		return
 	}
 
-	if err := h.service.Delete(c.Request.Context(), usr.ID, areaID); err != nil {
-		h.handleDeleteError(c, err)
+	var payload openapi.MoveAreaFolderRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
 		return
 	}
 
-	c.Status(http.StatusNoContent)
+	area, err := h.service.MoveToFolder(c.Request.Context(), usr.ID, areaID, payload.FolderId)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toOpenAPIArea(area))
 }
 
 func (h *Handler) authorize(c *gin.Context) (userdomain.User, sessiondomain.Session, bool) {
@@ -313,6 +1097,18 @@ func (h *Handler) authorize(c *gin.Context) (userdomain.User, sessiondomain.Sess
 	return usr, sess, true
 }
 
+func (h *Handler) requireAdmin(c *gin.Context) (userdomain.User, bool) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return userdomain.User{}, false
+	}
+	if !usr.IsAdmin() {
+		c.JSON(http.StatusForbidden, gin.H{"error": "admin privileges required"})
+		return userdomain.User{}, false
+	}
+	return usr, true
+}
+
 func (h *Handler) refreshSessionCookie(c *gin.Context, sess sessiondomain.Session) {
 	maxAge := int(time.Until(sess.ExpiresAt).Seconds())
 	if maxAge <= 0 {
@@ -333,14 +1129,44 @@ func (h *Handler) handleServiceError(c *gin.Context, err error) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid component params"})
 	case errors.Is(err, ErrProviderSubscriptionMissing):
 		c.JSON(http.StatusForbidden, gin.H{"error": "provider subscription required"})
+	case errors.Is(err, ErrAreaQuotaExceeded):
+		c.JSON(http.StatusForbidden, gin.H{"error": "area quota exceeded", "detail": err.Error()})
 	case errors.Is(err, ErrAreaNotOwned):
 		c.JSON(http.StatusForbidden, gin.H{"error": "not owner"})
+	case errors.Is(err, ErrWorkspaceRoleInsufficient):
+		c.JSON(http.StatusForbidden, gin.H{"error": "insufficient workspace role"})
+	case errors.Is(err, ErrAreaNotShared):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "area not shared"})
+	case errors.Is(err, ErrRunnerInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "runner must be a workspace editor"})
 	case errors.Is(err, ErrAreaConfigNotFound):
 		c.JSON(http.StatusBadRequest, gin.H{"error": "unknown component configuration"})
 	case errors.Is(err, ErrAreaUpdateNoChanges):
 		c.JSON(http.StatusBadRequest, gin.H{"error": "no changes to apply"})
 	case errors.Is(err, ErrAreaStatusInvalid):
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid status"})
+	case errors.Is(err, ErrAreaListInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid list options"})
+	case errors.Is(err, ErrRevisionNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "revision not found"})
+	case errors.Is(err, ErrTemplateNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "template not found"})
+	case errors.Is(err, ErrTagNameInvalid), errors.Is(err, ErrTagColorInvalid), errors.Is(err, ErrFolderNameInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid label payload"})
+	case errors.Is(err, ErrHealthPolicyInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid health policy", "detail": err.Error()})
+	case errors.Is(err, ErrActivityScheduleInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid activity schedule", "detail": err.Error()})
+	case errors.Is(err, ErrBulkActionInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid bulk action"})
+	case errors.Is(err, ErrTagNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "tag not found"})
+	case errors.Is(err, ErrFolderNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "folder not found"})
+	case errors.Is(err, ErrTagExists):
+		c.JSON(http.StatusConflict, gin.H{"error": "tag already exists"})
+	case errors.Is(err, ErrFolderExists):
+		c.JSON(http.StatusConflict, gin.H{"error": "folder already exists"})
 	case errors.Is(err, outbound.ErrConflict):
 		c.JSON(http.StatusConflict, gin.H{"error": "area conflict"})
 	case errors.Is(err, outbound.ErrNotFound):
@@ -357,8 +1183,12 @@ func (h *Handler) handleExecuteError(c *gin.Context, err error) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "area not found"})
 	case errors.Is(err, ErrAreaNotOwned):
 		c.JSON(http.StatusForbidden, gin.H{"error": "not owner"})
+	case errors.Is(err, ErrWorkspaceRoleInsufficient):
+		c.JSON(http.StatusForbidden, gin.H{"error": "insufficient workspace role"})
 	case errors.Is(err, ErrAreaMisconfigured):
 		c.JSON(http.StatusBadRequest, gin.H{"error": "area misconfigured"})
+	case errors.Is(err, ErrExecutionQuotaExceeded):
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": "execution quota exceeded", "detail": err.Error()})
 	default:
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to execute area"})
 	}
@@ -370,6 +1200,8 @@ func (h *Handler) handleDeleteError(c *gin.Context, err error) {
 		c.JSON(http.StatusNotFound, gin.H{"error": "area not found"})
 	case errors.Is(err, ErrAreaNotOwned):
 		c.JSON(http.StatusForbidden, gin.H{"error": "not owner"})
+	case errors.Is(err, ErrWorkspaceRoleInsufficient):
+		c.JSON(http.StatusForbidden, gin.H{"error": "insufficient workspace role"})
 	default:
 		zap.L().Error("area delete error", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to delete area"})
@@ -401,7 +1233,7 @@ func mapAreas(items []areadomain.Area) []openapi.Area {
 }
 
 func toOpenAPIArea(area areadomain.Area) openapi.Area {
-	return openapi.Area{
+	result := openapi.Area{
 		Id:          area.ID,
 		Name:        area.Name,
 		Description: area.Description,
@@ -410,7 +1242,211 @@ func toOpenAPIArea(area areadomain.Area) openapi.Area {
 		UpdatedAt:   area.UpdatedAt,
 		Action:      toOpenAPIAreaAction(area.Action),
 		Reactions:   toOpenAPIAreaReactions(area.Reactions),
+		WorkspaceId: area.WorkspaceID,
+		FolderId:    area.FolderID,
+	}
+	if len(area.Tags) > 0 {
+		tags := make([]openapi.Tag, 0, len(area.Tags))
+		for _, tag := range area.Tags {
+			tags = append(tags, toOpenAPITag(tag))
+		}
+		result.Tags = &tags
+	}
+	if area.UserID != uuid.Nil {
+		runner := area.UserID
+		result.RunAsUserId = &runner
+	}
+	if area.Activity != nil {
+		result.Activity = toOpenAPIAreaActivity(*area.Activity)
+	}
+	policy := areadomain.DefaultHealthPolicy()
+	if area.HealthPolicy != nil {
+		policy = *area.HealthPolicy
+	}
+	result.HealthPolicy = &openapi.AreaHealthPolicy{
+		ConsecutiveFailures: policy.ConsecutiveFailures,
+		FailureRate:         policy.FailureRate,
+		WindowSeconds:       int(policy.Window / time.Second),
+		MinJobs:             policy.MinJobs,
+	}
+	if area.Suspension != nil {
+		result.Suspension = &openapi.AreaSuspension{
+			SuspendedAt: area.Suspension.At,
+			Reason:      area.Suspension.Reason,
+		}
+	}
+	if area.ActivitySchedule != nil {
+		result.ActivitySchedule = toOpenAPIActivitySchedule(*area.ActivitySchedule)
+	}
+	if area.Revision > 0 {
+		revision := area.Revision
+		result.Revision = &revision
+	}
+	return result
+}
+
+func toOpenAPITag(tag areadomain.Tag) openapi.Tag {
+	return openapi.Tag{
+		Id:        tag.ID,
+		Name:      tag.Name,
+		Color:     tag.Color,
+		CreatedAt: tag.CreatedAt,
+		UpdatedAt: tag.UpdatedAt,
+	}
+}
+
+func toOpenAPIFolder(folder areadomain.Folder) openapi.Folder {
+	return openapi.Folder{
+		Id:        folder.ID,
+		Name:      folder.Name,
+		CreatedAt: folder.CreatedAt,
+		UpdatedAt: folder.UpdatedAt,
+	}
+}
+
+// bulkFailureReason turns the error of one area of a bulk action into the short reason shown to clients
+func bulkFailureReason(err error) string {
+	switch {
+	case errors.Is(err, ErrAreaNotOwned):
+		return "not owner"
+	case errors.Is(err, ErrWorkspaceRoleInsufficient):
+		return "insufficient workspace role"
+	case errors.Is(err, outbound.ErrNotFound):
+		return "area not found"
+	default:
+		zap.L().Error("bulk tag action failed", zap.Error(err))
+		return "internal error"
+	}
+}
+
+func toOpenAPIAreaActivity(activity areadomain.Activity) *openapi.AreaActivity {
+	result := &openapi.AreaActivity{
+		LastTriggeredAt: activity.LastTriggeredAt,
+		LastJobAt:       activity.LastJobAt,
+		RecentFailures:  activity.RecentFailures,
+	}
+	if activity.LastJobStatus != "" {
+		status := activity.LastJobStatus
+		result.LastJobStatus = &status
+	}
+	return result
+}
+
+var activityWeekdays = map[openapi.AreaActivityWindowDays]time.Weekday{
+	openapi.Sun: time.Sunday,
+	openapi.Mon: time.Monday,
+	openapi.Tue: time.Tuesday,
+	openapi.Wed: time.Wednesday,
+	openapi.Thu: time.Thursday,
+	openapi.Fri: time.Friday,
+	openapi.Sat: time.Saturday,
+}
+
+func fromOpenAPIActivitySchedule(schedule openapi.AreaActivitySchedule) (areadomain.ActivitySchedule, error) {
+	result := areadomain.ActivitySchedule{
+		StartsAt: schedule.StartsAt,
+		EndsAt:   schedule.EndsAt,
+		Windows:  make([]areadomain.ActivityWindow, 0, len(schedule.Windows)),
+	}
+	if schedule.TimeZone != nil {
+		result.TimeZone = strings.TrimSpace(*schedule.TimeZone)
+	}
+	for _, window := range schedule.Windows {
+		start, err := parseMinuteOfDay(window.Start)
+		if err != nil {
+			return areadomain.ActivitySchedule{}, err
+		}
+		end, err := parseMinuteOfDay(window.End)
+		if err != nil {
+			return areadomain.ActivitySchedule{}, err
+		}
+		converted := areadomain.ActivityWindow{Start: start, End: end}
+		if window.Days != nil {
+			for _, day := range *window.Days {
+				weekday, ok := activityWeekdays[day]
+				if !ok {
+					return areadomain.ActivitySchedule{}, fmt.Errorf("%w: unknown weekday %q", ErrActivityScheduleInvalid, day)
+				}
+				converted.Days = append(converted.Days, weekday)
+			}
+		}
+		result.Windows = append(result.Windows, converted)
+	}
+	return result, nil
+}
+
+func toOpenAPIActivitySchedule(schedule areadomain.ActivitySchedule) *openapi.AreaActivitySchedule {
+	result := &openapi.AreaActivitySchedule{
+		StartsAt: schedule.StartsAt,
+		EndsAt:   schedule.EndsAt,
+		Windows:  make([]openapi.AreaActivityWindow, 0, len(schedule.Windows)),
+	}
+	if schedule.TimeZone != "" {
+		zone := schedule.TimeZone
+		result.TimeZone = &zone
+	}
+	for _, window := range schedule.Windows {
+		converted := openapi.AreaActivityWindow{
+			Start: formatMinuteOfDay(window.Start),
+			End:   formatMinuteOfDay(window.End),
+		}
+		if len(window.Days) > 0 {
+			days := make([]openapi.AreaActivityWindowDays, 0, len(window.Days))
+			for _, day := range window.Days {
+				days = append(days, openapi.AreaActivityWindowDays(strings.ToLower(day.String()[:3])))
+			}
+			converted.Days = &days
+		}
+		result.Windows = append(result.Windows, converted)
+	}
+	return result
+}
+
+func parseMinuteOfDay(value string) (int, error) {
+	parsed, err := time.Parse("15:04", strings.TrimSpace(value))
+	if err != nil {
+		return 0, fmt.Errorf("%w: invalid time of day %q", ErrActivityScheduleInvalid, value)
+	}
+	return parsed.Hour()*60 + parsed.Minute(), nil
+}
+
+func formatMinuteOfDay(minute int) string {
+	return fmt.Sprintf("%02d:%02d", minute/60, minute%60)
+}
+
+func toOpenAPIRevision(revision areadomain.Revision) openapi.AreaRevision {
+	result := openapi.AreaRevision{
+		Number:    revision.Number,
+		Reason:    string(revision.Reason),
+		CreatedAt: revision.CreatedAt,
+		Snapshot: openapi.AreaRevisionSnapshot{
+			Name:        revision.Snapshot.Name,
+			Description: revision.Snapshot.Description,
+			Status:      string(revision.Snapshot.Status),
+			Links:       make([]openapi.AreaRevisionLink, 0, len(revision.Snapshot.Links)),
+		},
+	}
+	if revision.AuthorID != uuid.Nil {
+		author := revision.AuthorID
+		result.AuthorId = &author
+	}
+	for _, link := range revision.Snapshot.Links {
+		item := openapi.AreaRevisionLink{
+			Role:        string(link.Role),
+			Position:    link.Position,
+			ConfigId:    link.ConfigID,
+			ComponentId: link.ComponentID,
+		}
+		if trimmed := strings.TrimSpace(link.Name); trimmed != "" {
+			item.Name = &trimmed
+		}
+		if len(link.Params) > 0 {
+			params := cloneMap(link.Params)
+			item.Params = &params
+		}
+		result.Snapshot.Links = append(result.Snapshot.Links, item)
 	}
+	return result
 }
 
 func toOpenAPIAreaAction(action *areadomain.Link) *openapi.AreaAction {
@@ -529,6 +1565,31 @@ func readRequestBody(c *gin.Context) ([]byte, error) {
 	return data, nil
 }
 
+// decodeAreaDocument parses JSON or YAML documents
+// YAML is converted through JSON so params carry the same value types as JSON requests
+func decodeAreaDocument(contentType string, data []byte) (AreaDocument, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return AreaDocument{}, fmt.Errorf("empty document")
+	}
+	switch strings.ToLower(strings.TrimSpace(contentType)) {
+	case "application/yaml", "application/x-yaml", "text/yaml", "text/x-yaml":
+		var raw any
+		if err := yaml.Unmarshal(data, &raw); err != nil {
+			return AreaDocument{}, err
+		}
+		converted, err := json.Marshal(raw)
+		if err != nil {
+			return AreaDocument{}, err
+		}
+		data = converted
+	}
+	var doc AreaDocument
+	if err := json.Unmarshal(data, &doc); err != nil {
+		return AreaDocument{}, err
+	}
+	return doc, nil
+}
+
 func decodeUpdateAreaPayload(data []byte) (UpdateAreaCommand, error) {
 	cmd := UpdateAreaCommand{}
 	var raw map[string]json.RawMessage
@@ -669,6 +1730,26 @@ func isJSONNull(data []byte) bool {
 	return bytes.Equal(bytes.TrimSpace(data), []byte("null"))
 }
 
+func toOpenAPIPreviewResponse(preview PollingPreview) openapi.PreviewComponentResponse {
+	events := make([]openapi.PreviewEvent, 0, len(preview.Events))
+	for _, event := range preview.Events {
+		item := openapi.PreviewEvent{
+			Payload:     cloneMap(event.Payload),
+			Fingerprint: event.Fingerprint,
+		}
+		if !event.OccurredAt.IsZero() {
+			occurredAt := event.OccurredAt.UTC()
+			item.OccurredAt = &occurredAt
+		}
+		events = append(events, item)
+	}
+	return openapi.PreviewComponentResponse{
+		Events:    events,
+		Total:     preview.Total,
+		Truncated: preview.Truncated,
+	}
+}
+
 func toOpenAPIHistoryResponse(details []outbound.JobDetails) openapi.AreaHistoryResponse {
 	if len(details) == 0 {
 		return openapi.AreaHistoryResponse{Executions: make([]openapi.AreaHistoryEntry, 0)}
@@ -699,3 +1780,77 @@ func toOpenAPIHistoryResponse(details []outbound.JobDetails) openapi.AreaHistory
 	}
 	return openapi.AreaHistoryResponse{Executions: executions}
 }
+
+func toOpenAPIDryRunResponse(result DryRunResult) openapi.AreaDryRunResponse {
+	response := openapi.AreaDryRunResponse{
+		Payload:       cloneMap(result.Payload),
+		PayloadSource: result.PayloadSource,
+		Accepted:      result.Accepted,
+		Reactions:     make([]openapi.AreaDryRunReaction, 0, len(result.Reactions)),
+	}
+	if result.Reason != "" {
+		reason := result.Reason
+		response.Reason = &reason
+	}
+	for _, reaction := range result.Reactions {
+		item := openapi.AreaDryRunReaction{
+			ReactionId: reaction.ReactionID,
+			ConfigId:   reaction.ConfigID,
+			Supported:  reaction.Supported,
+		}
+		if reaction.Component != "" {
+			component := reaction.Component
+			item.Component = &component
+		}
+		if reaction.Provider != "" {
+			provider := reaction.Provider
+			item.Provider = &provider
+		}
+		if reaction.Result.Endpoint != "" {
+			endpoint := reaction.Result.Endpoint
+			item.Endpoint = &endpoint
+		}
+		if len(reaction.Result.Request) > 0 {
+			request := cloneMap(reaction.Result.Request)
+			item.Request = &request
+		}
+		if reaction.Error != "" {
+			message := reaction.Error
+			item.Error = &message
+		}
+		response.Reactions = append(response.Reactions, item)
+	}
+	return response
+}
+
+func toOpenAPITemplate(template areadomain.Template) openapi.AreaTemplate {
+	result := openapi.AreaTemplate{
+		Id:        template.ID,
+		Name:      template.Name,
+		Action:    toOpenAPITemplateSlot(template.Action),
+		Reactions: make([]openapi.AreaTemplateSlot, 0, len(template.Reactions)),
+		CreatedAt: template.CreatedAt,
+		UpdatedAt: template.UpdatedAt,
+	}
+	if template.Description != "" {
+		desc := template.Description
+		result.Description = &desc
+	}
+	for _, reaction := range template.Reactions {
+		result.Reactions = append(result.Reactions, toOpenAPITemplateSlot(reaction))
+	}
+	return result
+}
+
+func toOpenAPITemplateSlot(slot areadomain.TemplateSlot) openapi.AreaTemplateSlot {
+	result := openapi.AreaTemplateSlot{
+		Component:        componentview.ToSummary(slot.Component, slot.ComponentID),
+		Params:           cloneMap(slot.Params),
+		UnresolvedParams: templateUnresolvedParams(slot),
+	}
+	if slot.Name != "" {
+		name := slot.Name
+		result.Name = &name
+	}
+	return result
+}
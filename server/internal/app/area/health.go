@@ -0,0 +1,138 @@
+package area
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+const (
+	healthMaxConsecutiveFailures = 1000
+	healthMinWindow              = time.Minute
+	healthMaxWindow              = 7 * 24 * time.Hour
+)
+
+// CheckHealth evaluates the circuit breaker of an area after one of its reaction jobs failed for good
+// It suspends the area, deactivates its action sources and notifies the member running it when the policy trips
+func (s *Service) CheckHealth(ctx context.Context, areaID uuid.UUID) (bool, error) {
+	if s.repo == nil || s.health == nil {
+		return false, nil
+	}
+	area, err := s.repo.FindByID(ctx, areaID)
+	if err != nil {
+		return false, fmt.Errorf("area.Service.CheckHealth: repo.FindByID: %w", err)
+	}
+	if area.Status != areadomain.StatusEnabled {
+		return false, nil
+	}
+
+	policy := areadomain.DefaultHealthPolicy()
+	if area.HealthPolicy != nil {
+		policy = *area.HealthPolicy
+	}
+	now := s.clock.Now().UTC()
+	stats, err := s.health.Stats(ctx, areaID, now.Add(-policy.Window))
+	if err != nil {
+		return false, fmt.Errorf("area.Service.CheckHealth: health.Stats: %w", err)
+	}
+	tripped, reason := policy.Trips(stats)
+	if !tripped {
+		return false, nil
+	}
+
+	suspension := areadomain.Suspension{At: now, Reason: reason}
+	suspended, err := s.health.Suspend(ctx, areaID, suspension)
+	if err != nil {
+		return false, fmt.Errorf("area.Service.CheckHealth: health.Suspend: %w", err)
+	}
+	if !suspended {
+		return false, nil
+	}
+	if err := s.notifySuspension(ctx, area, suspension); err != nil {
+		return true, fmt.Errorf("area.Service.CheckHealth: %w", err)
+	}
+	return true, nil
+}
+
+// SetHealthPolicy overrides the circuit breaker policy of an automation, nil restores the default policy
+func (s *Service) SetHealthPolicy(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, policy *areadomain.HealthPolicy) (areadomain.Area, error) {
+	if s.repo == nil || s.health == nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetHealthPolicy: repositories unavailable")
+	}
+	if policy != nil {
+		if err := validateHealthPolicy(*policy); err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.SetHealthPolicy: %w", err)
+		}
+	}
+	if err := s.ensureEditor(ctx, userID, areaID); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetHealthPolicy: %w", err)
+	}
+	if err := s.health.UpdatePolicy(ctx, areaID, policy); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetHealthPolicy: health.UpdatePolicy: %w", err)
+	}
+	return s.Get(ctx, userID, areaID)
+}
+
+// resumeHealth resets the breaker and reactivates the action sources when an automation gets enabled again
+// Leaving suspended_by_system for any other status only clears the suspension, the sources resume once enabled
+func (s *Service) resumeHealth(ctx context.Context, previous areadomain.Status, area areadomain.Area) error {
+	if s.health == nil || area.Status == previous {
+		return nil
+	}
+	switch {
+	case area.Status == areadomain.StatusEnabled:
+		if err := s.health.Resume(ctx, area.ID, s.clock.Now().UTC()); err != nil {
+			return fmt.Errorf("health.Resume: %w", err)
+		}
+	case previous == areadomain.StatusSuspended:
+		if err := s.health.ClearSuspension(ctx, area.ID); err != nil {
+			return fmt.Errorf("health.ClearSuspension: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) notifySuspension(ctx context.Context, area areadomain.Area, suspension areadomain.Suspension) error {
+	if s.mailer == nil || s.users == nil {
+		return nil
+	}
+	user, err := s.users.FindByID(ctx, area.UserID)
+	if err != nil {
+		return fmt.Errorf("users.FindByID: %w", err)
+	}
+	msg := outbound.Mail{
+		To:      user.Email,
+		Subject: fmt.Sprintf("AREA \"%s\" suspendue", area.Name),
+		Text: fmt.Sprintf("Ton AREA \"%s\" a été suspendue automatiquement: %s. Vérifie les comptes liés à ses réactions puis réactive-la.",
+			area.Name, suspension.Reason),
+		HTML: fmt.Sprintf("<p>Ton AREA <strong>%s</strong> a été suspendue automatiquement: %s.</p><p>Vérifie les comptes liés à ses réactions puis réactive-la.</p>",
+			html.EscapeString(area.Name), html.EscapeString(suspension.Reason)),
+	}
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		return fmt.Errorf("mailer.Send: %w", err)
+	}
+	return nil
+}
+
+func validateHealthPolicy(policy areadomain.HealthPolicy) error {
+	if policy.ConsecutiveFailures < 0 || policy.ConsecutiveFailures > healthMaxConsecutiveFailures {
+		return fmt.Errorf("%w: consecutive failures must be between 0 and %d", ErrHealthPolicyInvalid, healthMaxConsecutiveFailures)
+	}
+	if policy.FailureRate < 0 || policy.FailureRate > 1 {
+		return fmt.Errorf("%w: failure rate must be between 0 and 1", ErrHealthPolicyInvalid)
+	}
+	if policy.FailureRate > 0 {
+		if policy.Window < healthMinWindow || policy.Window > healthMaxWindow {
+			return fmt.Errorf("%w: window must be between %s and %s", ErrHealthPolicyInvalid, healthMinWindow, healthMaxWindow)
+		}
+		if policy.MinJobs < 1 {
+			return fmt.Errorf("%w: min jobs must be positive", ErrHealthPolicyInvalid)
+		}
+	}
+	return nil
+}
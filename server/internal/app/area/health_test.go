@@ -0,0 +1,261 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	userdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/user"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+type memoryHealthRepo struct {
+	areas   *memoryAreaRepo
+	stats   areadomain.HealthStats
+	window  time.Time
+	resumed []uuid.UUID
+	cleared []uuid.UUID
+}
+
+func (m *memoryHealthRepo) Stats(ctx context.Context, areaID uuid.UUID, windowStart time.Time) (areadomain.HealthStats, error) {
+	m.window = windowStart
+	return m.stats, nil
+}
+
+func (m *memoryHealthRepo) Suspend(ctx context.Context, areaID uuid.UUID, suspension areadomain.Suspension) (bool, error) {
+	area, ok := m.areas.items[areaID]
+	if !ok || area.Status != areadomain.StatusEnabled {
+		return false, nil
+	}
+	area.Status = areadomain.StatusSuspended
+	area.Suspension = &suspension
+	m.areas.items[areaID] = area
+	return true, nil
+}
+
+func (m *memoryHealthRepo) Resume(ctx context.Context, areaID uuid.UUID, at time.Time) error {
+	area, ok := m.areas.items[areaID]
+	if !ok {
+		return outbound.ErrNotFound
+	}
+	area.Suspension = nil
+	m.areas.items[areaID] = area
+	m.stats = areadomain.HealthStats{}
+	m.resumed = append(m.resumed, areaID)
+	return nil
+}
+
+func (m *memoryHealthRepo) ClearSuspension(ctx context.Context, areaID uuid.UUID) error {
+	area, ok := m.areas.items[areaID]
+	if !ok {
+		return outbound.ErrNotFound
+	}
+	area.Suspension = nil
+	m.areas.items[areaID] = area
+	m.cleared = append(m.cleared, areaID)
+	return nil
+}
+
+func (m *memoryHealthRepo) UpdatePolicy(ctx context.Context, areaID uuid.UUID, policy *areadomain.HealthPolicy) error {
+	area, ok := m.areas.items[areaID]
+	if !ok {
+		return outbound.ErrNotFound
+	}
+	area.HealthPolicy = policy
+	m.areas.items[areaID] = area
+	return nil
+}
+
+type recordingMailer struct {
+	sent []outbound.Mail
+}
+
+func (m *recordingMailer) Send(ctx context.Context, msg outbound.Mail) error {
+	m.sent = append(m.sent, msg)
+	return nil
+}
+
+type staticUsers struct {
+	user userdomain.User
+}
+
+func (s staticUsers) Create(ctx context.Context, user userdomain.User) (userdomain.User, error) {
+	return user, nil
+}
+
+func (s staticUsers) FindByEmail(ctx context.Context, email string) (userdomain.User, error) {
+	return s.user, nil
+}
+
+func (s staticUsers) FindByID(ctx context.Context, id uuid.UUID) (userdomain.User, error) {
+	if id != s.user.ID {
+		return userdomain.User{}, outbound.ErrNotFound
+	}
+	return s.user, nil
+}
+
+func (s staticUsers) Update(ctx context.Context, user userdomain.User) error {
+	return nil
+}
+
+func newHealthFixture(t *testing.T) (*Service, *memoryAreaRepo, *memoryHealthRepo, *recordingMailer, areadomain.Area) {
+	t.Helper()
+	user := userdomain.User{ID: uuid.New(), Email: "owner@example.com"}
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	area := seedListedAreas(repo, user.ID, 1)[0]
+	health := &memoryHealthRepo{areas: repo}
+	mailer := &recordingMailer{}
+	svc := NewService(repo, &memoryComponentRepo{}, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}, nil,
+		WithHealthRepository(health),
+		WithSuspensionMailer(mailer, staticUsers{user: user}),
+	)
+	return svc, repo, health, mailer, area
+}
+
+func TestServiceCheckHealthSuspendsAndNotifies(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, health, mailer, area := newHealthFixture(t)
+
+	health.stats = areadomain.HealthStats{ConsecutiveFailures: 4, WindowJobs: 4, WindowFailures: 4}
+	suspended, err := svc.CheckHealth(ctx, area.ID)
+	if err != nil {
+		t.Fatalf("CheckHealth returned error: %v", err)
+	}
+	if suspended {
+		t.Fatalf("expected breaker to hold below the default threshold")
+	}
+	if !health.window.Equal(time.Date(2024, 5, 1, 9, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected window start %s", health.window)
+	}
+
+	health.stats.ConsecutiveFailures = 5
+	suspended, err = svc.CheckHealth(ctx, area.ID)
+	if err != nil {
+		t.Fatalf("CheckHealth returned error: %v", err)
+	}
+	if !suspended {
+		t.Fatalf("expected breaker to trip")
+	}
+	stored := repo.items[area.ID]
+	if stored.Status != areadomain.StatusSuspended || stored.Suspension == nil {
+		t.Fatalf("expected area suspended got %s", stored.Status)
+	}
+	if len(mailer.sent) != 1 || mailer.sent[0].To != "owner@example.com" {
+		t.Fatalf("expected owner notified, got %+v", mailer.sent)
+	}
+	if !strings.Contains(mailer.sent[0].Text, "5 consecutive reaction failures") {
+		t.Fatalf("expected reason in notification, got %q", mailer.sent[0].Text)
+	}
+
+	suspended, err = svc.CheckHealth(ctx, area.ID)
+	if err != nil || suspended {
+		t.Fatalf("expected suspended areas to be skipped, got %v %v", suspended, err)
+	}
+	if len(mailer.sent) != 1 {
+		t.Fatalf("expected a single notification")
+	}
+}
+
+func TestServiceCheckHealthUsesFailureRate(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, health, _, area := newHealthFixture(t)
+
+	policy := &areadomain.HealthPolicy{FailureRate: 0.5, Window: 10 * time.Minute, MinJobs: 4}
+	if _, err := svc.SetHealthPolicy(ctx, area.UserID, area.ID, policy); err != nil {
+		t.Fatalf("SetHealthPolicy returned error: %v", err)
+	}
+
+	health.stats = areadomain.HealthStats{ConsecutiveFailures: 1, WindowJobs: 3, WindowFailures: 3}
+	if suspended, _ := svc.CheckHealth(ctx, area.ID); suspended {
+		t.Fatalf("expected breaker to wait for enough jobs")
+	}
+	health.stats = areadomain.HealthStats{ConsecutiveFailures: 1, WindowJobs: 4, WindowFailures: 2}
+	suspended, err := svc.CheckHealth(ctx, area.ID)
+	if err != nil {
+		t.Fatalf("CheckHealth returned error: %v", err)
+	}
+	if !suspended || repo.items[area.ID].Status != areadomain.StatusSuspended {
+		t.Fatalf("expected failure rate to trip the breaker")
+	}
+	if !health.window.Equal(time.Date(2024, 5, 1, 9, 50, 0, 0, time.UTC)) {
+		t.Fatalf("expected the custom window, got %s", health.window)
+	}
+}
+
+func TestServiceUpdateStatusResetsBreaker(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, health, _, area := newHealthFixture(t)
+
+	health.stats = areadomain.HealthStats{ConsecutiveFailures: 5}
+	if _, err := svc.CheckHealth(ctx, area.ID); err != nil {
+		t.Fatalf("CheckHealth returned error: %v", err)
+	}
+	if _, err := svc.UpdateStatus(ctx, area.UserID, area.ID, areadomain.StatusSuspended); !errors.Is(err, ErrAreaStatusInvalid) {
+		t.Fatalf("expected users not to suspend areas themselves, got %v", err)
+	}
+
+	enabled, err := svc.UpdateStatus(ctx, area.UserID, area.ID, areadomain.StatusEnabled)
+	if err != nil {
+		t.Fatalf("UpdateStatus returned error: %v", err)
+	}
+	if enabled.Status != areadomain.StatusEnabled || enabled.Suspension != nil {
+		t.Fatalf("expected area enabled without suspension, got %+v", enabled)
+	}
+	if len(health.resumed) != 1 || health.resumed[0] != area.ID {
+		t.Fatalf("expected breaker reset, got %v", health.resumed)
+	}
+	if repo.items[area.ID].Status != areadomain.StatusEnabled {
+		t.Fatalf("expected stored area enabled")
+	}
+}
+
+func TestServiceUpdateStatusClearsSuspensionWhenDisabled(t *testing.T) {
+	ctx := context.Background()
+	svc, repo, health, _, area := newHealthFixture(t)
+
+	health.stats = areadomain.HealthStats{ConsecutiveFailures: 5}
+	if _, err := svc.CheckHealth(ctx, area.ID); err != nil {
+		t.Fatalf("CheckHealth returned error: %v", err)
+	}
+	disabled, err := svc.UpdateStatus(ctx, area.UserID, area.ID, areadomain.StatusDisabled)
+	if err != nil {
+		t.Fatalf("UpdateStatus returned error: %v", err)
+	}
+	if disabled.Status != areadomain.StatusDisabled || disabled.Suspension != nil {
+		t.Fatalf("expected area disabled without suspension, got %+v", disabled)
+	}
+	if len(health.cleared) != 1 || health.cleared[0] != area.ID {
+		t.Fatalf("expected suspension cleared, got %v", health.cleared)
+	}
+	if len(health.resumed) != 0 {
+		t.Fatalf("expected sources to stay paused until enabled, got %v", health.resumed)
+	}
+	if repo.items[area.ID].Suspension != nil {
+		t.Fatalf("expected stored suspension cleared")
+	}
+}
+
+func TestServiceSetHealthPolicyValidates(t *testing.T) {
+	ctx := context.Background()
+	svc, _, _, _, area := newHealthFixture(t)
+
+	invalid := []areadomain.HealthPolicy{
+		{ConsecutiveFailures: -1},
+		{FailureRate: 1.5, Window: time.Hour, MinJobs: 1},
+		{FailureRate: 0.5, Window: time.Second, MinJobs: 1},
+		{FailureRate: 0.5, Window: time.Hour},
+	}
+	for _, policy := range invalid {
+		policy := policy
+		if _, err := svc.SetHealthPolicy(ctx, area.UserID, area.ID, &policy); !errors.Is(err, ErrHealthPolicyInvalid) {
+			t.Fatalf("expected ErrHealthPolicyInvalid for %+v got %v", policy, err)
+		}
+	}
+	if _, err := svc.SetHealthPolicy(ctx, uuid.New(), area.ID, nil); !errors.Is(err, ErrAreaNotOwned) {
+		t.Fatalf("expected ErrAreaNotOwned got %v", err)
+	}
+}
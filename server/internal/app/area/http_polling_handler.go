@@ -15,6 +15,7 @@ import (
 
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
 	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/security/netguard"
 	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
@@ -28,12 +29,36 @@ const (
 
 var placeholderPattern = regexp.MustCompile(`\{\{\s*(params|cursor|identity)\.([a-zA-Z0-9_\-]+)\s*\}\}`)
 
+var nowOffsetPattern = regexp.MustCompile(`\{\{\s*now_plus_minutes\s+params\.([a-zA-Z0-9_\-]+)\s*\}\}`)
+
 // HTTPPollingHandler polls HTTP endpoints defined in component metadata to produce action events
 type HTTPPollingHandler struct {
-	client     *http.Client
-	logger     *zap.Logger
-	identities identityport.Repository
-	providers  oauthProviderResolver
+	client       *http.Client
+	customClient *http.Client
+	secrets      ParamSealer
+	logger       *zap.Logger
+	identities   identityport.Repository
+	providers    oauthProviderResolver
+}
+
+// HTTPPollingHandlerOption configures the HTTP polling handler
+type HTTPPollingHandlerOption func(*HTTPPollingHandler)
+
+// WithCustomEndpointClient overrides the client used for endpoints configured by users
+// It must refuse loopback, link-local and private addresses
+func WithCustomEndpointClient(client *http.Client) HTTPPollingHandlerOption {
+	return func(h *HTTPPollingHandler) {
+		if client != nil {
+			h.customClient = client
+		}
+	}
+}
+
+// WithPollingParamSecrets opens the secret params sealed when the area was stored
+func WithPollingParamSecrets(secrets ParamSealer) HTTPPollingHandlerOption {
+	return func(h *HTTPPollingHandler) {
+		h.secrets = secrets
+	}
 }
 
 type oauthProviderResolver interface {
@@ -41,29 +66,57 @@ type oauthProviderResolver interface {
 }
 
 // NewHTTPPollingHandler assembles an HTTP polling handler
-func NewHTTPPollingHandler(client *http.Client, logger *zap.Logger, identities identityport.Repository, providers oauthProviderResolver) *HTTPPollingHandler {
+func NewHTTPPollingHandler(client *http.Client, logger *zap.Logger, identities identityport.Repository, providers oauthProviderResolver, opts ...HTTPPollingHandlerOption) *HTTPPollingHandler {
 	if client == nil {
 		client = &http.Client{Timeout: 15 * time.Second}
 	}
 	if logger == nil {
 		logger = zap.NewNop()
 	}
-	return &HTTPPollingHandler{
+	handler := &HTTPPollingHandler{
 		client:     client,
 		logger:     logger,
 		identities: identities,
 		providers:  providers,
 	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(handler)
+		}
+	}
+	if handler.customClient == nil {
+		handler.customClient = netguard.Client(15 * time.Second)
+	}
+	return handler
 }
 
 // Supports reports whether the component declares a compatible HTTP polling ingestion
 func (h *HTTPPollingHandler) Supports(component *componentdomain.Component) bool {
+	if isCustomHTTPPollingComponent(component) {
+		return true
+	}
 	_, ok, err := parseHTTPPollingConfig(component)
 	return err == nil && ok
 }
 
 // Poll executes the HTTP polling flow for supported components
 func (h *HTTPPollingHandler) Poll(ctx context.Context, req PollingRequest) (PollingResult, error) {
+	params, err := openSecretParams(h.secrets, req.Component, req.Binding.Config.Params)
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.HTTPPollingHandler.Poll: %w", err)
+	}
+	req.Binding.Config.Params = params
+
+	client := h.client
+	if isCustomHTTPPollingComponent(&req.Component) {
+		expanded, err := expandCustomHTTPPollingComponent(req.Component, req.Binding.Config.Params)
+		if err != nil {
+			return PollingResult{}, fmt.Errorf("area.HTTPPollingHandler.Poll: %w", err)
+		}
+		req.Component = expanded
+		client = h.customClient
+	}
+
 	config, ok, err := parseHTTPPollingConfig(&req.Component)
 	if err != nil {
 		return PollingResult{}, fmt.Errorf("area.HTTPPollingHandler.Poll: parse config: %w", err)
@@ -85,6 +138,7 @@ func (h *HTTPPollingHandler) Poll(ctx context.Context, req PollingRequest) (Poll
 	if strings.TrimSpace(endpoint) == "" {
 		return PollingResult{}, fmt.Errorf("area.HTTPPollingHandler.Poll: endpoint empty after rendering")
 	}
+	endpoint = h.rebaseEndpoint(req, endpoint)
 
 	u, err := url.Parse(endpoint)
 	if err != nil {
@@ -162,7 +216,7 @@ func (h *HTTPPollingHandler) Poll(ctx context.Context, req PollingRequest) (Poll
 		request.Header.Set("Accept", "application/json")
 	}
 
-	response, err := h.client.Do(request)
+	response, err := client.Do(request)
 	if err != nil {
 		return PollingResult{}, fmt.Errorf("area.HTTPPollingHandler.Poll: request failed: %w", err)
 	}
@@ -361,8 +415,17 @@ func (h *HTTPPollingHandler) Poll(ctx context.Context, req PollingRequest) (Poll
 			}
 		}
 
+		eventPayload := normalizePollingItem(config.Normalize, itemMap, req.Binding.Config.Params)
+		if ok, reason := matchPayload(config.Match, eventPayload, req.Binding.Config.Params); !ok {
+			h.logger.Debug("polling item rejected by match rules",
+				zap.String("component", req.Component.Name),
+				zap.String("provider", req.Component.Provider.Name),
+				zap.String("reason", reason))
+			continue
+		}
+
 		event := PollingEvent{
-			Payload:     cloneMapAny(itemMap),
+			Payload:     eventPayload,
 			Fingerprint: fingerprint,
 			OccurredAt:  occurredAt,
 		}
@@ -489,6 +552,8 @@ type httpPollingConfig struct {
 	BodyTemplate       string
 	Auth               *httpPollingAuthConfig
 	SkipRules          []httpSkipRule
+	Normalize          string
+	Match              []payloadMatchRule
 }
 
 type httpPollingAuthConfig struct {
@@ -502,6 +567,7 @@ type httpSkipRule struct {
 	Path     []string
 	Contains string
 	Equals   string
+	Exists   *bool
 }
 
 type httpQuerySpec struct {
@@ -542,8 +608,7 @@ func parseHTTPPollingConfig(component *componentdomain.Component) (httpPollingCo
 	if err != nil {
 		return httpPollingConfig{}, false, fmt.Errorf("ingestion metadata invalid: %w", err)
 	}
-	mode, err := toString(ingestion["mode"])
-	if err != nil || strings.ToLower(strings.TrimSpace(mode)) != "polling" {
+	if !ingestionSupportsMode(ingestion, ingestionModePolling) {
 		return httpPollingConfig{}, false, nil
 	}
 
@@ -622,6 +687,15 @@ func parseHTTPPollingConfig(component *componentdomain.Component) (httpPollingCo
 	}
 
 	bodyTemplate := stringOrDefault(configMap, "bodyTemplate", "")
+	normalize := strings.ToLower(strings.TrimSpace(stringOrDefault(configMap, "normalize", "")))
+	var matchRules []payloadMatchRule
+	for _, raw := range []any{ingestion["match"], configMap["match"]} {
+		rules, err := parsePayloadMatchRules(raw)
+		if err != nil {
+			return httpPollingConfig{}, false, fmt.Errorf("match metadata invalid: %w", err)
+		}
+		matchRules = append(matchRules, rules...)
+	}
 
 	config := httpPollingConfig{
 		EndpointTemplate:   endpoint,
@@ -638,6 +712,8 @@ func parseHTTPPollingConfig(component *componentdomain.Component) (httpPollingCo
 		Headers:            headerSpecs,
 		BodyTemplate:       bodyTemplate,
 		SkipRules:          skipRules,
+		Normalize:          normalize,
+		Match:              matchRules,
 	}
 	authRaw, hasAuth := configMap["auth"]
 	if !hasAuth {
@@ -805,13 +881,22 @@ func parseHTTPSkipRules(raw any) ([]httpSkipRule, error) {
 		}
 		contains := strings.TrimSpace(stringOrDefault(entry, "contains", ""))
 		equals := strings.TrimSpace(stringOrDefault(entry, "equals", ""))
-		if contains == "" && equals == "" {
-			return nil, fmt.Errorf("skipItems[%d] requires contains or equals", index)
+		var exists *bool
+		if rawExists, ok := entry["exists"]; ok {
+			value, ok := rawExists.(bool)
+			if !ok {
+				return nil, fmt.Errorf("skipItems[%d] exists must be a boolean", index)
+			}
+			exists = &value
+		}
+		if contains == "" && equals == "" && exists == nil {
+			return nil, fmt.Errorf("skipItems[%d] requires contains, equals or exists", index)
 		}
 		rule := httpSkipRule{
 			Path:     splitPath(pathValue),
 			Contains: contains,
 			Equals:   equals,
+			Exists:   exists,
 		}
 		result = append(result, rule)
 	}
@@ -931,10 +1016,33 @@ func (h *HTTPPollingHandler) injectIdentity(ctx context.Context, req *PollingReq
 	if config.Auth == nil || !strings.EqualFold(config.Auth.Kind, "oauth") {
 		return nil
 	}
-	if h.identities == nil {
+	resolver := pollingIdentityResolver{identities: h.identities, providers: h.providers}
+	return resolver.inject(ctx, req, *config.Auth)
+}
+
+// rebaseEndpoint points endpoints written for the public API at the self-hosted instance that issued the identity
+func (h *HTTPPollingHandler) rebaseEndpoint(req PollingRequest, endpoint string) string {
+	if h.providers == nil || req.Identity == nil {
+		return endpoint
+	}
+	provider, ok := h.providers.Provider(stringify(req.Identity["provider"]))
+	if !ok {
+		return endpoint
+	}
+	return identityport.RebaseEndpoint(provider, endpoint)
+}
+
+// pollingIdentityResolver loads the OAuth identity referenced by a polling binding and keeps its token fresh
+type pollingIdentityResolver struct {
+	identities identityport.Repository
+	providers  oauthProviderResolver
+}
+
+func (r pollingIdentityResolver) inject(ctx context.Context, req *PollingRequest, auth httpPollingAuthConfig) error {
+	if r.identities == nil {
 		return fmt.Errorf("identity repository unavailable")
 	}
-	identityParam := strings.TrimSpace(config.Auth.IdentityParam)
+	identityParam := strings.TrimSpace(auth.IdentityParam)
 	if identityParam == "" {
 		identityParam = "identityId"
 	}
@@ -954,18 +1062,24 @@ func (h *HTTPPollingHandler) injectIdentity(ctx context.Context, req *PollingReq
 	if err != nil {
 		return fmt.Errorf("identity param %q parse: %w", identityParam, err)
 	}
-	identity, err := h.identities.FindByID(ctx, identityID)
+	identity, err := r.identities.FindByID(ctx, identityID)
 	if err != nil {
 		return fmt.Errorf("identity lookup: %w", err)
 	}
 	if identity.UserID != req.Binding.UserID {
 		return fmt.Errorf("identity not owned by user")
 	}
-	providerName := strings.TrimSpace(config.Auth.Provider)
+	providerName := strings.TrimSpace(auth.Provider)
+	if provider, ok := r.identityProvider(identity); ok {
+		if providerName != "" && identityport.ProviderType(provider) != strings.ToLower(providerName) {
+			return fmt.Errorf("identity provider %s is not a %s instance", provider.Name(), providerName)
+		}
+		providerName = provider.Name()
+	}
 	if providerName == "" {
 		providerName = identity.Provider
 	}
-	updatedIdentity, token, err := h.ensureIdentityAccessToken(ctx, identity, providerName)
+	updatedIdentity, token, err := r.ensureAccessToken(ctx, identity, providerName)
 	if err != nil {
 		return err
 	}
@@ -982,12 +1096,21 @@ func (h *HTTPPollingHandler) injectIdentity(ctx context.Context, req *PollingReq
 	return nil
 }
 
-func (h *HTTPPollingHandler) ensureIdentityAccessToken(ctx context.Context, identity identitydomain.Identity, providerName string) (identitydomain.Identity, string, error) {
+// identityProvider resolves the OAuth provider, possibly a self-hosted instance, that issued the identity
+func (r pollingIdentityResolver) identityProvider(identity identitydomain.Identity) (identityport.Provider, bool) {
+	name := strings.TrimSpace(identity.Provider)
+	if r.providers == nil || name == "" {
+		return nil, false
+	}
+	return r.providers.Provider(name)
+}
+
+func (r pollingIdentityResolver) ensureAccessToken(ctx context.Context, identity identitydomain.Identity, providerName string) (identitydomain.Identity, string, error) {
 	token := strings.TrimSpace(identity.AccessToken)
 	if token != "" && !identity.TokenExpired(time.Now().UTC()) {
 		return identity, token, nil
 	}
-	if h.providers == nil {
+	if r.providers == nil {
 		return identity, "", fmt.Errorf("oauth provider resolver unavailable")
 	}
 	providerKey := strings.TrimSpace(strings.ToLower(providerName))
@@ -997,7 +1120,7 @@ func (h *HTTPPollingHandler) ensureIdentityAccessToken(ctx context.Context, iden
 	if providerKey == "" {
 		return identity, "", fmt.Errorf("oauth provider missing")
 	}
-	provider, ok := h.providers.Provider(providerKey)
+	provider, ok := r.providers.Provider(providerKey)
 	if !ok {
 		return identity, "", fmt.Errorf("oauth provider %s not configured", providerKey)
 	}
@@ -1020,7 +1143,7 @@ func (h *HTTPPollingHandler) ensureIdentityAccessToken(ctx context.Context, iden
 	}
 	updated := identity.WithTokens(exchange.Token.AccessToken, refreshToken, expiresAt, scopes)
 	updated.UpdatedAt = time.Now().UTC()
-	if err := h.identities.Update(ctx, updated); err != nil {
+	if err := r.identities.Update(ctx, updated); err != nil {
 		return identity, "", fmt.Errorf("update identity: %w", err)
 	}
 	return updated, updated.AccessToken, nil
@@ -1030,7 +1153,18 @@ func renderTemplate(template string, req PollingRequest) (string, error) {
 	if template == "" {
 		return "", nil
 	}
-	result := placeholderPattern.ReplaceAllStringFunc(template, func(match string) string {
+	result := nowOffsetPattern.ReplaceAllStringFunc(template, func(match string) string {
+		submatches := nowOffsetPattern.FindStringSubmatch(match)
+		if len(submatches) != 2 {
+			return ""
+		}
+		minutes, err := strconv.Atoi(strings.TrimSpace(stringify(req.Binding.Config.Params[submatches[1]])))
+		if err != nil {
+			return ""
+		}
+		return req.Now.UTC().Add(time.Duration(minutes) * time.Minute).Format(time.RFC3339)
+	})
+	result = placeholderPattern.ReplaceAllStringFunc(result, func(match string) string {
 		submatches := placeholderPattern.FindStringSubmatch(match)
 		if len(submatches) != 3 {
 			return ""
@@ -1098,10 +1232,13 @@ func shouldSkipItem(item map[string]any, rules []httpSkipRule) bool {
 			continue
 		}
 		value, err := resolvePath(item, rule.Path)
-		if err != nil {
-			continue
+		text := ""
+		if err == nil {
+			text = strings.TrimSpace(stringify(value))
+		}
+		if rule.Exists != nil && (text != "") == *rule.Exists {
+			return true
 		}
-		text := strings.TrimSpace(stringify(value))
 		if text == "" {
 			continue
 		}
@@ -1266,6 +1403,7 @@ func parseTime(value any) (time.Time, error) {
 			time.RFC3339,
 			time.RFC1123,
 			"2006-01-02 15:04:05",
+			"2006-01-02T15:04:05.9999999",
 			time.RFC822,
 		}
 		for _, layout := range candidates {
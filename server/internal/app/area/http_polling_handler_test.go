@@ -14,6 +14,8 @@ import (
 	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
 	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
 	"go.uber.org/zap"
 )
@@ -77,11 +79,14 @@ func (s *identityRepoStub) FindByID(ctx context.Context, id uuid.UUID) (identity
 	if s.identity.ID == id {
 		return s.identity, nil
 	}
-	return identitydomain.Identity{}, fmt.Errorf("not found")
+	return identitydomain.Identity{}, outbound.ErrNotFound
 }
 
 func (s *identityRepoStub) FindByUserAndProvider(ctx context.Context, userID uuid.UUID, provider string) (identitydomain.Identity, error) {
-	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+	if s.identity.UserID == userID && s.identity.Provider == provider {
+		return s.identity, nil
+	}
+	return identitydomain.Identity{}, outbound.ErrNotFound
 }
 
 func (s *identityRepoStub) FindByProviderSubject(ctx context.Context, provider string, subject string) (identitydomain.Identity, error) {
@@ -578,6 +583,76 @@ func TestHTTPPollingHandlerSkipsAutoGeneratedLinearIssues(t *testing.T) {
 	}
 }
 
+func TestHTTPPollingHandlerGitHubMergedPullRequests(t *testing.T) {
+	body := []byte(`[
+		{"id": 3, "title": "Docs", "merged_at": "2024-05-03T10:00:00Z", "user": {"login": "alice"}, "base": {"ref": "docs"}},
+		{"id": 2, "title": "Abandoned", "merged_at": null, "user": {"login": "bob"}, "base": {"ref": "main"}},
+		{"id": 1, "title": "Feature", "merged_at": "2024-05-01T10:00:00Z", "user": {"login": "carol"}, "base": {"ref": "main"}}
+	]`)
+	transport := &recordingTransport{body: body}
+	handler := NewHTTPPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), nil, nil)
+
+	component := componentdomain.Component{
+		Name:     "github_pull_request_merged",
+		Provider: componentdomain.Provider{Name: "github"},
+		Metadata: map[string]any{
+			"ingestion": map[string]any{
+				"mode":      "polling",
+				"modes":     []any{"polling", "webhook"},
+				"modeParam": "deliveryMode",
+				"handler":   "http",
+				"http": map[string]any{
+					"endpoint":         "https://api.github.com/repos/{{params.owner}}/{{params.repository}}/pulls",
+					"fingerprintField": "id",
+					"occurredAtField":  "merged_at",
+					"skipItems": []any{
+						map[string]any{"path": "merged_at", "exists": false},
+					},
+					"normalize": "github.pull_request",
+				},
+				"match": []any{
+					map[string]any{"path": "action", "equals": "closed"},
+					map[string]any{"path": "pull_request.merged", "equals": "true"},
+					map[string]any{"path": "pull_request.base.ref", "equals": "{{params.baseBranch}}", "skipIfEmpty": true},
+				},
+			},
+		},
+	}
+
+	req := PollingRequest{
+		Binding: actiondomain.PollingBinding{
+			Config: componentdomain.Config{
+				Params: map[string]any{"owner": "octo", "repository": "repo", "baseBranch": "main"},
+			},
+		},
+		Component: component,
+		Cursor:    map[string]any{},
+		Now:       time.Date(2024, 5, 4, 0, 0, 0, 0, time.UTC),
+	}
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 1 {
+		t.Fatalf("expected 1 event got %d", len(result.Events))
+	}
+	event := result.Events[0]
+	if event.Fingerprint != "1" {
+		t.Fatalf("unexpected fingerprint %q", event.Fingerprint)
+	}
+	pr, ok := event.Payload["pull_request"].(map[string]any)
+	if !ok || pr["title"] != "Feature" {
+		t.Fatalf("unexpected pull request payload %v", event.Payload["pull_request"])
+	}
+	if sender := event.Payload["sender"].(map[string]any); sender["login"] != "carol" {
+		t.Fatalf("unexpected sender %v", sender)
+	}
+	if got := transport.requests[0].URL.Path; got != "/repos/octo/repo/pulls" {
+		t.Fatalf("unexpected request path %q", got)
+	}
+}
+
 func TestHTTPPollingHandlerErrorStatus(t *testing.T) {
 	transport := &recordingTransport{
 		status: http.StatusBadGateway,
@@ -721,3 +796,116 @@ func TestHTTPPollingHandlerPollWithIdentity(t *testing.T) {
 		t.Fatalf("identity should not be updated when token is valid")
 	}
 }
+
+func TestRenderTemplateNowPlusMinutes(t *testing.T) {
+	req := PollingRequest{
+		Binding: actiondomain.PollingBinding{
+			Config: componentdomain.Config{
+				Params: map[string]any{"minutesBefore": float64(15)},
+			},
+		},
+		Now: time.Date(2025, 3, 1, 10, 0, 0, 0, time.UTC),
+	}
+
+	rendered, err := renderTemplate("{{now_rfc3339}}/{{now_plus_minutes params.minutesBefore}}", req)
+	if err != nil {
+		t.Fatalf("renderTemplate returned error: %v", err)
+	}
+	if rendered != "2025-03-01T10:00:00Z/2025-03-01T10:15:00Z" {
+		t.Fatalf("unexpected rendered template %q", rendered)
+	}
+}
+
+func TestParseTimeGraphDateTime(t *testing.T) {
+	parsed, err := parseTime("2025-03-01T09:30:00.0000000")
+	if err != nil {
+		t.Fatalf("parseTime returned error: %v", err)
+	}
+	if !parsed.Equal(time.Date(2025, 3, 1, 9, 30, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected parsed time %v", parsed)
+	}
+}
+
+type instanceProviderStub struct {
+	identityport.Provider
+	name        string
+	kind        string
+	base        string
+	defaultBase string
+}
+
+func (p instanceProviderStub) Name() string              { return p.name }
+func (p instanceProviderStub) Type() string              { return p.kind }
+func (p instanceProviderStub) APIBaseURL() string        { return p.base }
+func (p instanceProviderStub) DefaultAPIBaseURL() string { return p.defaultBase }
+
+type instanceResolverStub map[string]identityport.Provider
+
+func (r instanceResolverStub) Provider(name string) (identityport.Provider, bool) {
+	provider, ok := r[name]
+	return provider, ok
+}
+
+func TestHTTPPollingHandlerTargetsSelfHostedInstance(t *testing.T) {
+	identityID := uuid.New()
+	userID := uuid.New()
+	expires := time.Now().Add(time.Hour)
+	repo := &identityRepoStub{identity: identitydomain.Identity{
+		ID:          identityID,
+		UserID:      userID,
+		Provider:    "github-enterprise",
+		AccessToken: "token-xyz",
+		ExpiresAt:   &expires,
+	}}
+	resolver := instanceResolverStub{
+		"github-enterprise": instanceProviderStub{name: "github-enterprise", kind: "github", base: "https://github.corp.example/api/v3", defaultBase: "https://api.github.com"},
+		"gitlab-corp":       instanceProviderStub{name: "gitlab-corp", kind: "gitlab", base: "https://gitlab.corp.example/api/v4", defaultBase: "https://gitlab.com/api/v4"},
+	}
+	transport := &recordingTransport{body: []byte(`[{"id": 1}]`)}
+	handler := NewHTTPPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, resolver)
+
+	component := componentdomain.Component{
+		Name:     "github_new_release",
+		Provider: componentdomain.Provider{Name: "github"},
+		Metadata: map[string]any{
+			"ingestion": map[string]any{
+				"mode":    "polling",
+				"handler": "http",
+				"http": map[string]any{
+					"endpoint": "https://api.github.com/repos/{{params.owner}}/{{params.repository}}/releases",
+					"auth": map[string]any{
+						"type":          "oauth",
+						"identityParam": "identityId",
+						"provider":      "github",
+					},
+					"fingerprintField": "id",
+				},
+			},
+		},
+	}
+	req := PollingRequest{
+		Binding: actiondomain.PollingBinding{
+			UserID: userID,
+			Config: componentdomain.Config{Params: map[string]any{
+				"identityId": identityID.String(),
+				"owner":      "octo",
+				"repository": "repo",
+			}},
+		},
+		Component: component,
+		Cursor:    map[string]any{},
+		Now:       time.Now().UTC(),
+	}
+
+	if _, err := handler.Poll(context.Background(), req); err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if got := transport.requests[0].URL.String(); got != "https://github.corp.example/api/v3/repos/octo/repo/releases" {
+		t.Fatalf("unexpected request url %s", got)
+	}
+
+	repo.identity.Provider = "gitlab-corp"
+	if _, err := handler.Poll(context.Background(), req); err == nil {
+		t.Fatal("expected identities of another provider type to be rejected")
+	}
+}
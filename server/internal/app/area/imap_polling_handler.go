@@ -0,0 +1,245 @@
+package area
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"go.uber.org/zap"
+)
+
+const (
+	imapPollingHandlerName    = "imap"
+	imapLastUIDCursorKey      = "imap_last_uid"
+	imapUIDValidityCursorKey  = "imap_uid_validity"
+	imapDefaultFolder         = "INBOX"
+	imapDefaultMaxResults     = 10
+	imapMaxResultsLimit       = 50
+	imapDefaultIdentityParam  = "identityId"
+	imapDefaultCredentialKind = "email"
+)
+
+// IMAPPollingHandler watches a mailbox folder over IMAP and emits an event per new message
+// Messages are tracked by UID, so the cursor is reset whenever the server changes UIDVALIDITY
+type IMAPPollingHandler struct {
+	reader   outbound.MailboxReader
+	logger   *zap.Logger
+	resolver pollingIdentityResolver
+}
+
+// NewIMAPPollingHandler assembles an IMAP polling handler
+func NewIMAPPollingHandler(reader outbound.MailboxReader, logger *zap.Logger, identities identityport.Repository, providers oauthProviderResolver) *IMAPPollingHandler {
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &IMAPPollingHandler{
+		reader:   reader,
+		logger:   logger,
+		resolver: pollingIdentityResolver{identities: identities, providers: providers},
+	}
+}
+
+// Supports reports whether the component declares the IMAP polling ingestion
+func (h *IMAPPollingHandler) Supports(component *componentdomain.Component) bool {
+	_, ok, err := parseIMAPPollingConfig(component)
+	return err == nil && ok
+}
+
+// Poll reads messages received since the stored UID and converts them into events
+// The first poll only records the current UIDNEXT so existing mail is not replayed
+func (h *IMAPPollingHandler) Poll(ctx context.Context, req PollingRequest) (PollingResult, error) {
+	config, ok, err := parseIMAPPollingConfig(&req.Component)
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.IMAPPollingHandler.Poll: parse config: %w", err)
+	}
+	if !ok {
+		return PollingResult{}, fmt.Errorf("area.IMAPPollingHandler.Poll: component %q not supported", req.Component.Name)
+	}
+	if h.reader == nil {
+		return PollingResult{}, fmt.Errorf("area.IMAPPollingHandler.Poll: mailbox reader unavailable")
+	}
+
+	if req.Binding.Config.Params == nil {
+		req.Binding.Config.Params = map[string]any{}
+	}
+	if err := h.resolver.inject(ctx, &req, config.auth); err != nil {
+		return PollingResult{}, fmt.Errorf("area.IMAPPollingHandler.Poll: %w", err)
+	}
+	credentials := stringify(req.Identity["accessToken"])
+	filter := parseIMAPFilter(req.Binding.Config.Params)
+
+	result := PollingResult{Cursor: cloneMapAny(req.Cursor)}
+	if result.Cursor == nil {
+		result.Cursor = map[string]any{}
+	}
+	cursorState := ensureCursorState(result.Cursor)
+	assignCursorValue(result.Cursor, cursorState, "last_polled_at", req.Now.UTC().Format(time.RFC3339Nano))
+
+	previous := flattenCursorState(req.Cursor)
+	lastUID, hasUID := parseCursorUID(previous[imapLastUIDCursorKey])
+	validity, _ := parseCursorUID(previous[imapUIDValidityCursorKey])
+	if !hasUID {
+		return h.baseline(ctx, result, cursorState, credentials, filter.folder)
+	}
+
+	status, messages, err := h.reader.Messages(ctx, credentials, filter.folder, lastUID, filter.maxResults)
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.IMAPPollingHandler.Poll: %w", err)
+	}
+	if validity != 0 && status.UIDValidity != validity {
+		h.logger.Warn("imap uidvalidity changed, resetting cursor",
+			zap.String("area_id", req.Binding.AreaID.String()),
+			zap.String("folder", filter.folder),
+			zap.Uint32("previous", validity),
+			zap.Uint32("current", status.UIDValidity),
+		)
+		return h.baseline(ctx, result, cursorState, credentials, filter.folder)
+	}
+
+	assignCursorValue(result.Cursor, cursorState, imapUIDValidityCursorKey, strconv.FormatUint(uint64(status.UIDValidity), 10))
+	for _, message := range messages {
+		if message.UID <= lastUID {
+			continue
+		}
+		event := buildIMAPEvent(message, filter.folder, status.UIDValidity)
+		if event.OccurredAt.IsZero() {
+			event.OccurredAt = req.Now.UTC()
+		}
+		result.Events = append(result.Events, event)
+		lastUID = message.UID
+	}
+	assignCursorValue(result.Cursor, cursorState, imapLastUIDCursorKey, strconv.FormatUint(uint64(lastUID), 10))
+	return result, nil
+}
+
+// baseline records the folder position without emitting events
+func (h *IMAPPollingHandler) baseline(ctx context.Context, result PollingResult, cursorState map[string]any, credentials string, folder string) (PollingResult, error) {
+	status, err := h.reader.Status(ctx, credentials, folder)
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.IMAPPollingHandler.Poll: %w", err)
+	}
+	lastUID := uint32(0)
+	if status.UIDNext > 0 {
+		lastUID = status.UIDNext - 1
+	}
+	assignCursorValue(result.Cursor, cursorState, imapUIDValidityCursorKey, strconv.FormatUint(uint64(status.UIDValidity), 10))
+	assignCursorValue(result.Cursor, cursorState, imapLastUIDCursorKey, strconv.FormatUint(uint64(lastUID), 10))
+	return result, nil
+}
+
+func parseCursorUID(value any) (uint32, bool) {
+	if value == nil {
+		return 0, false
+	}
+	parsed, err := strconv.ParseUint(strings.TrimSpace(stringify(value)), 10, 32)
+	if err != nil {
+		return 0, false
+	}
+	return uint32(parsed), true
+}
+
+func buildIMAPEvent(message outbound.MailboxMessage, folder string, validity uint32) PollingEvent {
+	attachments := make([]any, 0, len(message.Attachments))
+	for _, name := range message.Attachments {
+		attachments = append(attachments, name)
+	}
+
+	payload := map[string]any{
+		"uid":             message.UID,
+		"folder":          folder,
+		"messageId":       message.MessageID,
+		"subject":         message.Subject,
+		"from":            message.From,
+		"to":              strings.Join(message.To, ", "),
+		"cc":              strings.Join(message.Cc, ", "),
+		"replyTo":         message.ReplyTo,
+		"text":            message.Text,
+		"size":            message.Size,
+		"attachments":     attachments,
+		"attachmentCount": len(attachments),
+		"hasAttachments":  len(attachments) > 0,
+	}
+	if address, name := splitMailbox(message.From); address != "" {
+		payload["fromAddress"] = address
+		payload["fromName"] = name
+	}
+	if !message.Date.IsZero() {
+		payload["receivedAt"] = message.Date.UTC().Format(time.RFC3339)
+	}
+
+	return PollingEvent{
+		Payload:     payload,
+		Fingerprint: fmt.Sprintf("%d:%d", validity, message.UID),
+		OccurredAt:  message.Date,
+	}
+}
+
+type imapPollingConfig struct {
+	auth httpPollingAuthConfig
+}
+
+func parseIMAPPollingConfig(component *componentdomain.Component) (imapPollingConfig, bool, error) {
+	if component == nil || len(component.Metadata) == 0 {
+		return imapPollingConfig{}, false, nil
+	}
+	ingestionRaw, ok := component.Metadata["ingestion"]
+	if !ok {
+		return imapPollingConfig{}, false, nil
+	}
+	ingestion, err := toMapStringAny(ingestionRaw)
+	if err != nil {
+		return imapPollingConfig{}, false, fmt.Errorf("ingestion metadata invalid: %w", err)
+	}
+	mode, err := toString(ingestion["mode"])
+	if err != nil || strings.ToLower(strings.TrimSpace(mode)) != "polling" {
+		return imapPollingConfig{}, false, nil
+	}
+	handlerName, err := toString(ingestion["handler"])
+	if err != nil || strings.ToLower(strings.TrimSpace(handlerName)) != imapPollingHandlerName {
+		return imapPollingConfig{}, false, nil
+	}
+
+	cfg := imapPollingConfig{auth: httpPollingAuthConfig{
+		Kind:          "credentials",
+		IdentityParam: imapDefaultIdentityParam,
+		Provider:      imapDefaultCredentialKind,
+	}}
+	if rawAuth, ok := ingestion["auth"]; ok {
+		authMap, err := toMapStringAny(rawAuth)
+		if err != nil {
+			return imapPollingConfig{}, false, fmt.Errorf("auth metadata invalid: %w", err)
+		}
+		cfg.auth.IdentityParam = stringOrDefault(authMap, "identityParam", cfg.auth.IdentityParam)
+		cfg.auth.Provider = stringOrDefault(authMap, "provider", cfg.auth.Provider)
+	}
+	return cfg, true, nil
+}
+
+type imapFilter struct {
+	folder     string
+	maxResults int
+}
+
+func parseIMAPFilter(params map[string]any) imapFilter {
+	filter := imapFilter{folder: imapDefaultFolder, maxResults: imapDefaultMaxResults}
+	if folder, err := toString(params["folder"]); err == nil && strings.TrimSpace(folder) != "" {
+		filter.folder = strings.TrimSpace(folder)
+	}
+	if value, ok := params["maxResults"]; ok {
+		if maxResults, err := toInt(value); err == nil && maxResults > 0 {
+			filter.maxResults = maxResults
+		}
+	}
+	if filter.maxResults > imapMaxResultsLimit {
+		filter.maxResults = imapMaxResultsLimit
+	}
+	return filter
+}
+
+// Ensure IMAPPollingHandler implements ComponentPollingHandler
+var _ ComponentPollingHandler = (*IMAPPollingHandler)(nil)
@@ -0,0 +1,132 @@
+package area
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type mailboxReaderStub struct {
+	status      outbound.MailboxStatus
+	messages    []outbound.MailboxMessage
+	credentials string
+	folder      string
+	afterUID    uint32
+	limit       int
+}
+
+func (s *mailboxReaderStub) Status(_ context.Context, credentials string, folder string) (outbound.MailboxStatus, error) {
+	s.credentials, s.folder = credentials, folder
+	return s.status, nil
+}
+
+func (s *mailboxReaderStub) Messages(_ context.Context, credentials string, folder string, afterUID uint32, limit int) (outbound.MailboxStatus, []outbound.MailboxMessage, error) {
+	s.credentials, s.folder, s.afterUID, s.limit = credentials, folder, afterUID, limit
+	return s.status, s.messages, nil
+}
+
+func imapTestRequest(t *testing.T, params map[string]any, cursor map[string]any) (PollingRequest, *identityRepoStub) {
+	t.Helper()
+	identityID := uuid.New()
+	userID := uuid.New()
+	repo := &identityRepoStub{identity: identitydomain.Identity{ID: identityID, UserID: userID, Provider: "email", AccessToken: `{"address":"me@example.com"}`}}
+	params["identityId"] = identityID.String()
+	return PollingRequest{
+		Binding: actiondomain.PollingBinding{
+			UserID: userID,
+			Config: componentdomain.Config{Params: params},
+		},
+		Component: componentdomain.Component{
+			Name:     "email_new_message_in_folder",
+			Provider: componentdomain.Provider{Name: "email"},
+			Metadata: map[string]any{
+				"ingestion": map[string]any{"mode": "polling", "handler": "imap"},
+			},
+		},
+		Cursor: cursor,
+		Now:    time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}, repo
+}
+
+func TestIMAPPollingHandlerRecordsBaselineOnFirstPoll(t *testing.T) {
+	reader := &mailboxReaderStub{status: outbound.MailboxStatus{UIDValidity: 42, UIDNext: 120}}
+	req, repo := imapTestRequest(t, map[string]any{"folder": "Invoices"}, nil)
+	handler := NewIMAPPollingHandler(reader, zap.NewNop(), repo, nil)
+
+	if !handler.Supports(&req.Component) {
+		t.Fatal("expected imap component to be supported")
+	}
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 0 {
+		t.Fatalf("expected no events on first poll got %d", len(result.Events))
+	}
+	if result.Cursor[imapLastUIDCursorKey] != "119" || result.Cursor[imapUIDValidityCursorKey] != "42" {
+		t.Fatalf("unexpected cursor %v", result.Cursor)
+	}
+	if reader.folder != "Invoices" || reader.credentials != `{"address":"me@example.com"}` {
+		t.Fatalf("unexpected status call %q %q", reader.folder, reader.credentials)
+	}
+}
+
+func TestIMAPPollingHandlerEmitsMessagesAfterCursor(t *testing.T) {
+	reader := &mailboxReaderStub{
+		status: outbound.MailboxStatus{UIDValidity: 42, UIDNext: 123},
+		messages: []outbound.MailboxMessage{
+			{UID: 120, Subject: "Invoice 1", From: "Billing <billing@example.com>", To: []string{"me@example.com"}, Date: time.Date(2025, 3, 1, 11, 0, 0, 0, time.UTC), Attachments: []string{"invoice.pdf"}},
+			{UID: 121, Subject: "Invoice 2", From: "billing@example.com"},
+		},
+	}
+	req, repo := imapTestRequest(t, map[string]any{"maxResults": 500}, map[string]any{imapLastUIDCursorKey: "119", imapUIDValidityCursorKey: "42"})
+	handler := NewIMAPPollingHandler(reader, zap.NewNop(), repo, nil)
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if reader.afterUID != 119 || reader.limit != imapMaxResultsLimit || reader.folder != imapDefaultFolder {
+		t.Fatalf("unexpected messages call after=%d limit=%d folder=%s", reader.afterUID, reader.limit, reader.folder)
+	}
+	if len(result.Events) != 2 {
+		t.Fatalf("expected 2 events got %d", len(result.Events))
+	}
+	first := result.Events[0]
+	if first.Fingerprint != "42:120" || first.Payload["fromAddress"] != "billing@example.com" || first.Payload["hasAttachments"] != true {
+		t.Fatalf("unexpected first event %+v", first)
+	}
+	if !result.Events[1].OccurredAt.Equal(req.Now) {
+		t.Fatalf("expected poll time for undated message got %v", result.Events[1].OccurredAt)
+	}
+	if result.Cursor[imapLastUIDCursorKey] != "121" {
+		t.Fatalf("unexpected cursor %v", result.Cursor)
+	}
+}
+
+func TestIMAPPollingHandlerResetsOnUIDValidityChange(t *testing.T) {
+	reader := &mailboxReaderStub{
+		status:   outbound.MailboxStatus{UIDValidity: 43, UIDNext: 5},
+		messages: []outbound.MailboxMessage{{UID: 4, Subject: "renumbered"}},
+	}
+	req, repo := imapTestRequest(t, map[string]any{}, map[string]any{"state": map[string]any{imapLastUIDCursorKey: "119", imapUIDValidityCursorKey: "42"}})
+	handler := NewIMAPPollingHandler(reader, zap.NewNop(), repo, nil)
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 0 {
+		t.Fatalf("expected renumbered messages to be skipped got %d", len(result.Events))
+	}
+	if result.Cursor[imapLastUIDCursorKey] != "4" || result.Cursor[imapUIDValidityCursorKey] != "43" {
+		t.Fatalf("unexpected cursor %v", result.Cursor)
+	}
+}
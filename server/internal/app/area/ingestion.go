@@ -0,0 +1,81 @@
+package area
+
+import (
+	"strings"
+
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+)
+
+const (
+	ingestionModePolling = "polling"
+	ingestionModeWebhook = "webhook"
+)
+
+// ingestionMetadata extracts the ingestion block declared by component metadata
+func ingestionMetadata(metadata map[string]any) (map[string]any, bool, error) {
+	raw, ok := metadata["ingestion"]
+	if !ok {
+		return nil, false, nil
+	}
+	ingest, err := toMapStringAny(raw)
+	if err != nil {
+		return nil, false, err
+	}
+	return ingest, true, nil
+}
+
+// resolveIngestionMode returns the effective ingestion mode, honouring the optional modeParam selector
+// that lets users pick one of the modes listed under "modes" through an action parameter
+func resolveIngestionMode(ingest map[string]any, params map[string]any) string {
+	mode, err := toStringLower(ingest["mode"])
+	if err != nil {
+		mode = ""
+	}
+	paramKey := strings.TrimSpace(stringOrDefault(ingest, "modeParam", ""))
+	if paramKey == "" || params == nil {
+		return mode
+	}
+	selected, err := toStringLower(params[paramKey])
+	if err != nil || selected == "" {
+		return mode
+	}
+	allowed, err := toStringSlice(ingest["modes"])
+	if err != nil {
+		return mode
+	}
+	for _, candidate := range allowed {
+		if normalizeProvisionKey(candidate) == selected {
+			return selected
+		}
+	}
+	return mode
+}
+
+// componentIngestionMode resolves the ingestion mode of a component for the provided action params
+func componentIngestionMode(component *componentdomain.Component, params map[string]any) string {
+	if component == nil {
+		return ""
+	}
+	ingest, ok, err := ingestionMetadata(component.Metadata)
+	if err != nil || !ok {
+		return ""
+	}
+	return resolveIngestionMode(ingest, params)
+}
+
+// ingestionSupportsMode reports whether the ingestion block declares the mode as default or selectable
+func ingestionSupportsMode(ingest map[string]any, mode string) bool {
+	if declared, err := toStringLower(ingest["mode"]); err == nil && declared == mode {
+		return true
+	}
+	allowed, err := toStringSlice(ingest["modes"])
+	if err != nil {
+		return false
+	}
+	for _, candidate := range allowed {
+		if normalizeProvisionKey(candidate) == mode {
+			return true
+		}
+	}
+	return false
+}
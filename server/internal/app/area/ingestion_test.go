@@ -0,0 +1,36 @@
+package area
+
+import "testing"
+
+func TestResolveIngestionMode(t *testing.T) {
+	ingest := map[string]any{
+		"mode":      "polling",
+		"modes":     []any{"polling", "webhook"},
+		"modeParam": "deliveryMode",
+	}
+
+	cases := []struct {
+		name   string
+		params map[string]any
+		want   string
+	}{
+		{name: "default", params: nil, want: ingestionModePolling},
+		{name: "selected", params: map[string]any{"deliveryMode": "Webhook"}, want: ingestionModeWebhook},
+		{name: "unknown falls back", params: map[string]any{"deliveryMode": "sse"}, want: ingestionModePolling},
+		{name: "empty falls back", params: map[string]any{"deliveryMode": ""}, want: ingestionModePolling},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := resolveIngestionMode(ingest, tc.params); got != tc.want {
+				t.Fatalf("resolveIngestionMode() = %q want %q", got, tc.want)
+			}
+		})
+	}
+
+	if !ingestionSupportsMode(ingest, ingestionModeWebhook) {
+		t.Fatalf("expected webhook mode to be supported")
+	}
+	if ingestionSupportsMode(map[string]any{"mode": "webhook"}, ingestionModePolling) {
+		t.Fatalf("webhook-only ingestion should not support polling")
+	}
+}
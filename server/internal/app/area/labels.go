@@ -0,0 +1,387 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+const labelNameMaxLength = 64
+
+var tagColorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+// BulkAction is an operation applied to every area carrying a tag
+type BulkAction string
+
+const (
+	// BulkActionEnable enables the tagged areas
+	BulkActionEnable BulkAction = "enable"
+	// BulkActionDisable disables the tagged areas
+	BulkActionDisable BulkAction = "disable"
+	// BulkActionArchive archives the tagged areas
+	BulkActionArchive BulkAction = "archive"
+	// BulkActionDelete deletes the tagged areas
+	BulkActionDelete BulkAction = "delete"
+)
+
+// TagInput carries the editable fields of a tag, nil fields are left untouched on update
+type TagInput struct {
+	Name  *string
+	Color *string
+}
+
+// BulkFailure reports why the bulk action was not applied to an area
+type BulkFailure struct {
+	AreaID uuid.UUID
+	Err    error
+}
+
+// BulkResult lists the areas a bulk action was applied to and those it failed on
+type BulkResult struct {
+	Succeeded []uuid.UUID
+	Failed    []BulkFailure
+}
+
+// ListTags returns the tags defined by the user
+func (s *Service) ListTags(ctx context.Context, userID uuid.UUID) ([]areadomain.Tag, error) {
+	if s.tags == nil {
+		return nil, fmt.Errorf("area.Service.ListTags: tag repository unavailable")
+	}
+	tags, err := s.tags.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("area.Service.ListTags: tags.ListByUser: %w", err)
+	}
+	return tags, nil
+}
+
+// CreateTag defines a new tag for the user, names are unique per user regardless of case
+func (s *Service) CreateTag(ctx context.Context, userID uuid.UUID, input TagInput) (areadomain.Tag, error) {
+	if s.tags == nil {
+		return areadomain.Tag{}, fmt.Errorf("area.Service.CreateTag: tag repository unavailable")
+	}
+	if input.Name == nil {
+		return areadomain.Tag{}, fmt.Errorf("area.Service.CreateTag: %w", ErrTagNameInvalid)
+	}
+	name, err := normalizeLabelName(*input.Name, ErrTagNameInvalid)
+	if err != nil {
+		return areadomain.Tag{}, fmt.Errorf("area.Service.CreateTag: %w", err)
+	}
+	color, err := normalizeTagColor(input.Color)
+	if err != nil {
+		return areadomain.Tag{}, fmt.Errorf("area.Service.CreateTag: %w", err)
+	}
+
+	now := s.clock.Now().UTC()
+	tag, err := s.tags.Create(ctx, areadomain.Tag{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		Color:     color,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		if errors.Is(err, outbound.ErrConflict) {
+			return areadomain.Tag{}, fmt.Errorf("area.Service.CreateTag: %w", ErrTagExists)
+		}
+		return areadomain.Tag{}, fmt.Errorf("area.Service.CreateTag: tags.Create: %w", err)
+	}
+	return tag, nil
+}
+
+// UpdateTag renames or recolors a tag of the user, an empty color clears it
+func (s *Service) UpdateTag(ctx context.Context, userID uuid.UUID, tagID uuid.UUID, input TagInput) (areadomain.Tag, error) {
+	tag, err := s.ownedTag(ctx, userID, tagID)
+	if err != nil {
+		return areadomain.Tag{}, fmt.Errorf("area.Service.UpdateTag: %w", err)
+	}
+	if input.Name == nil && input.Color == nil {
+		return areadomain.Tag{}, fmt.Errorf("area.Service.UpdateTag: %w", ErrAreaUpdateNoChanges)
+	}
+	if input.Name != nil {
+		name, err := normalizeLabelName(*input.Name, ErrTagNameInvalid)
+		if err != nil {
+			return areadomain.Tag{}, fmt.Errorf("area.Service.UpdateTag: %w", err)
+		}
+		tag.Name = name
+	}
+	if input.Color != nil {
+		color, err := normalizeTagColor(input.Color)
+		if err != nil {
+			return areadomain.Tag{}, fmt.Errorf("area.Service.UpdateTag: %w", err)
+		}
+		tag.Color = color
+	}
+
+	tag.UpdatedAt = s.clock.Now().UTC()
+	if err := s.tags.Update(ctx, tag); err != nil {
+		if errors.Is(err, outbound.ErrConflict) {
+			return areadomain.Tag{}, fmt.Errorf("area.Service.UpdateTag: %w", ErrTagExists)
+		}
+		return areadomain.Tag{}, fmt.Errorf("area.Service.UpdateTag: tags.Update: %w", err)
+	}
+	return tag, nil
+}
+
+// DeleteTag removes a tag of the user, the areas carrying it are left untouched
+func (s *Service) DeleteTag(ctx context.Context, userID uuid.UUID, tagID uuid.UUID) error {
+	if _, err := s.ownedTag(ctx, userID, tagID); err != nil {
+		return fmt.Errorf("area.Service.DeleteTag: %w", err)
+	}
+	if err := s.tags.Delete(ctx, tagID); err != nil {
+		return fmt.Errorf("area.Service.DeleteTag: tags.Delete: %w", err)
+	}
+	return nil
+}
+
+// TagArea attaches one of the user's tags to an area the user may edit
+func (s *Service) TagArea(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, tagID uuid.UUID) (areadomain.Area, error) {
+	if _, err := s.ownedTag(ctx, userID, tagID); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.TagArea: %w", err)
+	}
+	if err := s.ensureEditor(ctx, userID, areaID); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.TagArea: %w", err)
+	}
+	if err := s.tags.Attach(ctx, tagID, areaID); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.TagArea: tags.Attach: %w", err)
+	}
+	return s.Get(ctx, userID, areaID)
+}
+
+// UntagArea detaches one of the user's tags from an area the user may edit
+func (s *Service) UntagArea(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, tagID uuid.UUID) (areadomain.Area, error) {
+	if _, err := s.ownedTag(ctx, userID, tagID); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.UntagArea: %w", err)
+	}
+	if err := s.ensureEditor(ctx, userID, areaID); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.UntagArea: %w", err)
+	}
+	if err := s.tags.Detach(ctx, tagID, areaID); err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return areadomain.Area{}, fmt.Errorf("area.Service.UntagArea: %w", ErrTagNotFound)
+		}
+		return areadomain.Area{}, fmt.Errorf("area.Service.UntagArea: tags.Detach: %w", err)
+	}
+	return s.Get(ctx, userID, areaID)
+}
+
+// ApplyToTag runs the bulk action on every area carrying the tag
+// Each area is authorized on its own, failures are reported without stopping the others
+func (s *Service) ApplyToTag(ctx context.Context, userID uuid.UUID, tagID uuid.UUID, action BulkAction) (BulkResult, error) {
+	var status areadomain.Status
+	switch action {
+	case BulkActionEnable:
+		status = areadomain.StatusEnabled
+	case BulkActionDisable:
+		status = areadomain.StatusDisabled
+	case BulkActionArchive:
+		status = areadomain.StatusArchived
+	case BulkActionDelete:
+	default:
+		return BulkResult{}, fmt.Errorf("area.Service.ApplyToTag: %w", ErrBulkActionInvalid)
+	}
+	if _, err := s.ownedTag(ctx, userID, tagID); err != nil {
+		return BulkResult{}, fmt.Errorf("area.Service.ApplyToTag: %w", err)
+	}
+	areaIDs, err := s.tags.ListAreaIDs(ctx, tagID)
+	if err != nil {
+		return BulkResult{}, fmt.Errorf("area.Service.ApplyToTag: tags.ListAreaIDs: %w", err)
+	}
+
+	result := BulkResult{Succeeded: []uuid.UUID{}, Failed: []BulkFailure{}}
+	for _, areaID := range areaIDs {
+		var err error
+		if action == BulkActionDelete {
+			err = s.Delete(ctx, userID, areaID)
+		} else {
+			_, err = s.UpdateStatus(ctx, userID, areaID, status)
+		}
+		if err != nil {
+			result.Failed = append(result.Failed, BulkFailure{AreaID: areaID, Err: err})
+			continue
+		}
+		result.Succeeded = append(result.Succeeded, areaID)
+	}
+	return result, nil
+}
+
+// ListFolders returns the folders defined by the user
+func (s *Service) ListFolders(ctx context.Context, userID uuid.UUID) ([]areadomain.Folder, error) {
+	if s.folders == nil {
+		return nil, fmt.Errorf("area.Service.ListFolders: folder repository unavailable")
+	}
+	folders, err := s.folders.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("area.Service.ListFolders: folders.ListByUser: %w", err)
+	}
+	return folders, nil
+}
+
+// CreateFolder defines a new folder for the user, names are unique per user regardless of case
+func (s *Service) CreateFolder(ctx context.Context, userID uuid.UUID, name string) (areadomain.Folder, error) {
+	if s.folders == nil {
+		return areadomain.Folder{}, fmt.Errorf("area.Service.CreateFolder: folder repository unavailable")
+	}
+	name, err := normalizeLabelName(name, ErrFolderNameInvalid)
+	if err != nil {
+		return areadomain.Folder{}, fmt.Errorf("area.Service.CreateFolder: %w", err)
+	}
+
+	now := s.clock.Now().UTC()
+	folder, err := s.folders.Create(ctx, areadomain.Folder{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Name:      name,
+		CreatedAt: now,
+		UpdatedAt: now,
+	})
+	if err != nil {
+		if errors.Is(err, outbound.ErrConflict) {
+			return areadomain.Folder{}, fmt.Errorf("area.Service.CreateFolder: %w", ErrFolderExists)
+		}
+		return areadomain.Folder{}, fmt.Errorf("area.Service.CreateFolder: folders.Create: %w", err)
+	}
+	return folder, nil
+}
+
+// RenameFolder renames a folder of the user
+func (s *Service) RenameFolder(ctx context.Context, userID uuid.UUID, folderID uuid.UUID, name string) (areadomain.Folder, error) {
+	folder, err := s.ownedFolder(ctx, userID, folderID)
+	if err != nil {
+		return areadomain.Folder{}, fmt.Errorf("area.Service.RenameFolder: %w", err)
+	}
+	name, err = normalizeLabelName(name, ErrFolderNameInvalid)
+	if err != nil {
+		return areadomain.Folder{}, fmt.Errorf("area.Service.RenameFolder: %w", err)
+	}
+	if name == folder.Name {
+		return areadomain.Folder{}, fmt.Errorf("area.Service.RenameFolder: %w", ErrAreaUpdateNoChanges)
+	}
+
+	folder.Name = name
+	folder.UpdatedAt = s.clock.Now().UTC()
+	if err := s.folders.Update(ctx, folder); err != nil {
+		if errors.Is(err, outbound.ErrConflict) {
+			return areadomain.Folder{}, fmt.Errorf("area.Service.RenameFolder: %w", ErrFolderExists)
+		}
+		return areadomain.Folder{}, fmt.Errorf("area.Service.RenameFolder: folders.Update: %w", err)
+	}
+	return folder, nil
+}
+
+// DeleteFolder removes a folder of the user, the areas it contained are left without folder
+func (s *Service) DeleteFolder(ctx context.Context, userID uuid.UUID, folderID uuid.UUID) error {
+	if _, err := s.ownedFolder(ctx, userID, folderID); err != nil {
+		return fmt.Errorf("area.Service.DeleteFolder: %w", err)
+	}
+	if err := s.folders.Delete(ctx, folderID); err != nil {
+		return fmt.Errorf("area.Service.DeleteFolder: folders.Delete: %w", err)
+	}
+	return nil
+}
+
+// MoveToFolder files an area run by the user in one of the user's folders, a nil folder takes it out of its folder
+// The folder is stored on the area itself so workspace members cannot file areas run by someone else
+func (s *Service) MoveToFolder(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, folderID *uuid.UUID) (areadomain.Area, error) {
+	if s.folders == nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.MoveToFolder: folder repository unavailable")
+	}
+	if folderID != nil && *folderID == uuid.Nil {
+		folderID = nil
+	}
+	if folderID != nil {
+		if _, err := s.ownedFolder(ctx, userID, *folderID); err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.MoveToFolder: %w", err)
+		}
+	}
+	if s.repo == nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.MoveToFolder: repository unavailable")
+	}
+	area, err := s.repo.FindByID(ctx, areaID)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.MoveToFolder: repo.FindByID: %w", err)
+	}
+	if !area.OwnedBy(userID) {
+		return areadomain.Area{}, fmt.Errorf("area.Service.MoveToFolder: %w", ErrAreaNotOwned)
+	}
+	if err := s.folders.AssignArea(ctx, areaID, folderID); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.MoveToFolder: folders.AssignArea: %w", err)
+	}
+	return s.Get(ctx, userID, areaID)
+}
+
+func (s *Service) ownedTag(ctx context.Context, userID uuid.UUID, tagID uuid.UUID) (areadomain.Tag, error) {
+	if s.tags == nil {
+		return areadomain.Tag{}, fmt.Errorf("tag repository unavailable")
+	}
+	tag, err := s.tags.FindByID(ctx, tagID)
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return areadomain.Tag{}, ErrTagNotFound
+		}
+		return areadomain.Tag{}, fmt.Errorf("tags.FindByID: %w", err)
+	}
+	if tag.UserID != userID {
+		return areadomain.Tag{}, ErrTagNotFound
+	}
+	return tag, nil
+}
+
+func (s *Service) ownedFolder(ctx context.Context, userID uuid.UUID, folderID uuid.UUID) (areadomain.Folder, error) {
+	if s.folders == nil {
+		return areadomain.Folder{}, fmt.Errorf("folder repository unavailable")
+	}
+	folder, err := s.folders.FindByID(ctx, folderID)
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return areadomain.Folder{}, ErrFolderNotFound
+		}
+		return areadomain.Folder{}, fmt.Errorf("folders.FindByID: %w", err)
+	}
+	if folder.UserID != userID {
+		return areadomain.Folder{}, ErrFolderNotFound
+	}
+	return folder, nil
+}
+
+func (s *Service) ensureEditor(ctx context.Context, userID uuid.UUID, areaID uuid.UUID) error {
+	if s.repo == nil {
+		return fmt.Errorf("repository unavailable")
+	}
+	area, err := s.repo.FindByID(ctx, areaID)
+	if err != nil {
+		return fmt.Errorf("repo.FindByID: %w", err)
+	}
+	return s.authorizeArea(ctx, userID, area, workspacedomain.RoleEditor)
+}
+
+func normalizeLabelName(name string, invalid error) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > labelNameMaxLength {
+		return "", invalid
+	}
+	return name, nil
+}
+
+func normalizeTagColor(color *string) (*string, error) {
+	if color == nil {
+		return nil, nil
+	}
+	value := strings.TrimSpace(*color)
+	if value == "" {
+		return nil, nil
+	}
+	if !tagColorPattern.MatchString(value) {
+		return nil, ErrTagColorInvalid
+	}
+	value = strings.ToLower(value)
+	return &value, nil
+}
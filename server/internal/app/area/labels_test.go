@@ -0,0 +1,388 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+type memoryTagRepo struct {
+	areas *memoryAreaRepo
+	items map[uuid.UUID]areadomain.Tag
+}
+
+func (m *memoryTagRepo) Create(ctx context.Context, tag areadomain.Tag) (areadomain.Tag, error) {
+	if m.items == nil {
+		m.items = map[uuid.UUID]areadomain.Tag{}
+	}
+	for _, existing := range m.items {
+		if existing.UserID == tag.UserID && strings.EqualFold(existing.Name, tag.Name) {
+			return areadomain.Tag{}, outbound.ErrConflict
+		}
+	}
+	m.items[tag.ID] = tag
+	return tag, nil
+}
+
+func (m *memoryTagRepo) FindByID(ctx context.Context, id uuid.UUID) (areadomain.Tag, error) {
+	tag, ok := m.items[id]
+	if !ok {
+		return areadomain.Tag{}, outbound.ErrNotFound
+	}
+	return tag, nil
+}
+
+func (m *memoryTagRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]areadomain.Tag, error) {
+	var tags []areadomain.Tag
+	for _, tag := range m.items {
+		if tag.UserID == userID {
+			tags = append(tags, tag)
+		}
+	}
+	return tags, nil
+}
+
+func (m *memoryTagRepo) Update(ctx context.Context, tag areadomain.Tag) error {
+	if _, ok := m.items[tag.ID]; !ok {
+		return outbound.ErrNotFound
+	}
+	m.items[tag.ID] = tag
+	return nil
+}
+
+func (m *memoryTagRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	delete(m.items, id)
+	for areaID := range m.areas.items {
+		_ = m.Detach(ctx, id, areaID)
+	}
+	return nil
+}
+
+func (m *memoryTagRepo) Attach(ctx context.Context, tagID uuid.UUID, areaID uuid.UUID) error {
+	area, ok := m.areas.items[areaID]
+	if !ok {
+		return outbound.ErrNotFound
+	}
+	if !hasTag(area, tagID) {
+		area.Tags = append(area.Tags, m.items[tagID])
+		m.areas.items[areaID] = area
+	}
+	return nil
+}
+
+func (m *memoryTagRepo) Detach(ctx context.Context, tagID uuid.UUID, areaID uuid.UUID) error {
+	area, ok := m.areas.items[areaID]
+	if !ok || !hasTag(area, tagID) {
+		return outbound.ErrNotFound
+	}
+	kept := area.Tags[:0]
+	for _, tag := range area.Tags {
+		if tag.ID != tagID {
+			kept = append(kept, tag)
+		}
+	}
+	area.Tags = kept
+	m.areas.items[areaID] = area
+	return nil
+}
+
+func (m *memoryTagRepo) ListAreaIDs(ctx context.Context, tagID uuid.UUID) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for id, area := range m.areas.items {
+		if hasTag(area, tagID) {
+			ids = append(ids, id)
+		}
+	}
+	return ids, nil
+}
+
+type memoryFolderRepo struct {
+	areas *memoryAreaRepo
+	items map[uuid.UUID]areadomain.Folder
+}
+
+func (m *memoryFolderRepo) Create(ctx context.Context, folder areadomain.Folder) (areadomain.Folder, error) {
+	if m.items == nil {
+		m.items = map[uuid.UUID]areadomain.Folder{}
+	}
+	for _, existing := range m.items {
+		if existing.UserID == folder.UserID && strings.EqualFold(existing.Name, folder.Name) {
+			return areadomain.Folder{}, outbound.ErrConflict
+		}
+	}
+	m.items[folder.ID] = folder
+	return folder, nil
+}
+
+func (m *memoryFolderRepo) FindByID(ctx context.Context, id uuid.UUID) (areadomain.Folder, error) {
+	folder, ok := m.items[id]
+	if !ok {
+		return areadomain.Folder{}, outbound.ErrNotFound
+	}
+	return folder, nil
+}
+
+func (m *memoryFolderRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]areadomain.Folder, error) {
+	var folders []areadomain.Folder
+	for _, folder := range m.items {
+		if folder.UserID == userID {
+			folders = append(folders, folder)
+		}
+	}
+	return folders, nil
+}
+
+func (m *memoryFolderRepo) Update(ctx context.Context, folder areadomain.Folder) error {
+	if _, ok := m.items[folder.ID]; !ok {
+		return outbound.ErrNotFound
+	}
+	m.items[folder.ID] = folder
+	return nil
+}
+
+func (m *memoryFolderRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	delete(m.items, id)
+	return nil
+}
+
+func (m *memoryFolderRepo) AssignArea(ctx context.Context, areaID uuid.UUID, folderID *uuid.UUID) error {
+	area, ok := m.areas.items[areaID]
+	if !ok {
+		return outbound.ErrNotFound
+	}
+	area.FolderID = folderID
+	m.areas.items[areaID] = area
+	return nil
+}
+
+func hasTag(area areadomain.Area, tagID uuid.UUID) bool {
+	for _, tag := range area.Tags {
+		if tag.ID == tagID {
+			return true
+		}
+	}
+	return false
+}
+
+func newLabelService(repo *memoryAreaRepo, workspaces *memoryWorkspaceRepo) *Service {
+	opts := []ServiceOption{
+		WithTagRepository(&memoryTagRepo{areas: repo}),
+		WithFolderRepository(&memoryFolderRepo{areas: repo}),
+	}
+	if workspaces != nil {
+		opts = append(opts, WithWorkspaceRepository(workspaces))
+	}
+	return NewService(repo, &memoryComponentRepo{}, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil, opts...)
+}
+
+func TestServiceCreateTagValidatesInput(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	svc := newLabelService(&memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}, nil)
+
+	name := " Incident "
+	color := "#FF8800"
+	tag, err := svc.CreateTag(ctx, userID, TagInput{Name: &name, Color: &color})
+	if err != nil {
+		t.Fatalf("CreateTag returned error: %v", err)
+	}
+	if tag.Name != "Incident" || tag.Color == nil || *tag.Color != "#ff8800" {
+		t.Fatalf("unexpected tag %+v", tag)
+	}
+
+	duplicate := "incident"
+	if _, err := svc.CreateTag(ctx, userID, TagInput{Name: &duplicate}); !errors.Is(err, ErrTagExists) {
+		t.Fatalf("expected ErrTagExists got %v", err)
+	}
+	if _, err := svc.CreateTag(ctx, uuid.New(), TagInput{Name: &duplicate}); err != nil {
+		t.Fatalf("expected other users to reuse the name, got %v", err)
+	}
+
+	badColor := "orange"
+	if _, err := svc.CreateTag(ctx, userID, TagInput{Name: &name, Color: &badColor}); !errors.Is(err, ErrTagColorInvalid) {
+		t.Fatalf("expected ErrTagColorInvalid got %v", err)
+	}
+	long := strings.Repeat("x", labelNameMaxLength+1)
+	if _, err := svc.CreateTag(ctx, userID, TagInput{Name: &long}); !errors.Is(err, ErrTagNameInvalid) {
+		t.Fatalf("expected ErrTagNameInvalid got %v", err)
+	}
+}
+
+func TestServiceTagAreaRequiresOwnedTag(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	area := seedListedAreas(repo, userID, 1)[0]
+	svc := newLabelService(repo, nil)
+
+	otherName := "Theirs"
+	theirs, err := svc.CreateTag(ctx, uuid.New(), TagInput{Name: &otherName})
+	if err != nil {
+		t.Fatalf("CreateTag returned error: %v", err)
+	}
+	if _, err := svc.TagArea(ctx, userID, area.ID, theirs.ID); !errors.Is(err, ErrTagNotFound) {
+		t.Fatalf("expected ErrTagNotFound got %v", err)
+	}
+
+	name := "Mine"
+	mine, err := svc.CreateTag(ctx, userID, TagInput{Name: &name})
+	if err != nil {
+		t.Fatalf("CreateTag returned error: %v", err)
+	}
+	tagged, err := svc.TagArea(ctx, userID, area.ID, mine.ID)
+	if err != nil {
+		t.Fatalf("TagArea returned error: %v", err)
+	}
+	if len(tagged.Tags) != 1 || tagged.Tags[0].ID != mine.ID {
+		t.Fatalf("expected area tagged, got %+v", tagged.Tags)
+	}
+	if _, err := svc.TagArea(ctx, uuid.New(), area.ID, mine.ID); !errors.Is(err, ErrTagNotFound) {
+		t.Fatalf("expected strangers to be rejected, got %v", err)
+	}
+
+	untagged, err := svc.UntagArea(ctx, userID, area.ID, mine.ID)
+	if err != nil {
+		t.Fatalf("UntagArea returned error: %v", err)
+	}
+	if len(untagged.Tags) != 0 {
+		t.Fatalf("expected tag detached, got %+v", untagged.Tags)
+	}
+}
+
+func TestServiceMoveToFolderFiltersListing(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	areas := seedListedAreas(repo, userID, 3)
+	svc := newLabelService(repo, nil)
+
+	folder, err := svc.CreateFolder(ctx, userID, "Work")
+	if err != nil {
+		t.Fatalf("CreateFolder returned error: %v", err)
+	}
+	if _, err := svc.CreateFolder(ctx, userID, "work"); !errors.Is(err, ErrFolderExists) {
+		t.Fatalf("expected ErrFolderExists got %v", err)
+	}
+	moved, err := svc.MoveToFolder(ctx, userID, areas[1].ID, &folder.ID)
+	if err != nil {
+		t.Fatalf("MoveToFolder returned error: %v", err)
+	}
+	if moved.FolderID == nil || *moved.FolderID != folder.ID {
+		t.Fatalf("expected area filed in folder, got %v", moved.FolderID)
+	}
+
+	page, err := svc.ListPaged(ctx, userID, ListOptions{FolderID: &folder.ID})
+	if err != nil {
+		t.Fatalf("ListPaged returned error: %v", err)
+	}
+	if len(page.Areas) != 1 || page.Areas[0].ID != areas[1].ID {
+		t.Fatalf("expected only the filed area, got %d areas", len(page.Areas))
+	}
+
+	other, err := svc.CreateFolder(ctx, uuid.New(), "Work")
+	if err != nil {
+		t.Fatalf("CreateFolder returned error: %v", err)
+	}
+	if _, err := svc.MoveToFolder(ctx, userID, areas[0].ID, &other.ID); !errors.Is(err, ErrFolderNotFound) {
+		t.Fatalf("expected ErrFolderNotFound got %v", err)
+	}
+
+	removed, err := svc.MoveToFolder(ctx, userID, areas[1].ID, nil)
+	if err != nil {
+		t.Fatalf("MoveToFolder returned error: %v", err)
+	}
+	if removed.FolderID != nil {
+		t.Fatalf("expected area taken out of its folder")
+	}
+}
+
+func TestServiceMoveToFolderRequiresRunner(t *testing.T) {
+	ctx := context.Background()
+	editorID := uuid.New()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	workspaceID := uuid.New()
+	workspaces := &memoryWorkspaceRepo{}
+	workspaces.add(workspaceID, editorID, workspacedomain.RoleEditor)
+	shared := seedListedAreas(repo, uuid.New(), 1)[0]
+	shared.WorkspaceID = &workspaceID
+	repo.items[shared.ID] = shared
+
+	svc := newLabelService(repo, workspaces)
+	folder, err := svc.CreateFolder(ctx, editorID, "Mine")
+	if err != nil {
+		t.Fatalf("CreateFolder returned error: %v", err)
+	}
+	if _, err := svc.MoveToFolder(ctx, editorID, shared.ID, &folder.ID); !errors.Is(err, ErrAreaNotOwned) {
+		t.Fatalf("expected ErrAreaNotOwned got %v", err)
+	}
+	if repo.items[shared.ID].FolderID != nil {
+		t.Fatalf("expected the shared area left out of the editor's folder")
+	}
+}
+
+func TestServiceApplyToTagReportsPerArea(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	personal := seedListedAreas(repo, userID, 2)
+
+	workspaceID := uuid.New()
+	workspaces := &memoryWorkspaceRepo{}
+	workspaces.add(workspaceID, userID, workspacedomain.RoleEditor)
+	shared := seedListedAreas(repo, uuid.New(), 1)[0]
+	shared.WorkspaceID = &workspaceID
+	repo.items[shared.ID] = shared
+
+	svc := newLabelService(repo, workspaces)
+	name := "Incident"
+	tag, err := svc.CreateTag(ctx, userID, TagInput{Name: &name})
+	if err != nil {
+		t.Fatalf("CreateTag returned error: %v", err)
+	}
+	for _, area := range append(personal, shared) {
+		if _, err := svc.TagArea(ctx, userID, area.ID, tag.ID); err != nil {
+			t.Fatalf("TagArea returned error: %v", err)
+		}
+	}
+
+	disabled, err := svc.ApplyToTag(ctx, userID, tag.ID, BulkActionDisable)
+	if err != nil {
+		t.Fatalf("ApplyToTag returned error: %v", err)
+	}
+	if len(disabled.Succeeded) != 3 || len(disabled.Failed) != 0 {
+		t.Fatalf("expected every area disabled, got %+v", disabled)
+	}
+	for _, area := range repo.items {
+		if area.Status != areadomain.StatusDisabled {
+			t.Fatalf("expected area %s disabled got %s", area.ID, area.Status)
+		}
+	}
+
+	deleted, err := svc.ApplyToTag(ctx, userID, tag.ID, BulkActionDelete)
+	if err != nil {
+		t.Fatalf("ApplyToTag returned error: %v", err)
+	}
+	if len(deleted.Succeeded) != 2 || len(deleted.Failed) != 1 {
+		t.Fatalf("expected the shared area to fail deletion, got %+v", deleted)
+	}
+	if deleted.Failed[0].AreaID != shared.ID || !errors.Is(deleted.Failed[0].Err, ErrWorkspaceRoleInsufficient) {
+		t.Fatalf("unexpected failure %+v", deleted.Failed[0])
+	}
+	if _, ok := repo.items[shared.ID]; !ok || len(repo.items) != 1 {
+		t.Fatalf("expected only the shared area left")
+	}
+
+	if _, err := svc.ApplyToTag(ctx, userID, tag.ID, BulkAction("pause")); !errors.Is(err, ErrBulkActionInvalid) {
+		t.Fatalf("expected ErrBulkActionInvalid got %v", err)
+	}
+	if _, err := svc.ApplyToTag(ctx, uuid.New(), tag.ID, BulkActionEnable); !errors.Is(err, ErrTagNotFound) {
+		t.Fatalf("expected ErrTagNotFound got %v", err)
+	}
+}
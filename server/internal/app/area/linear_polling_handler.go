@@ -0,0 +1,285 @@
+package area
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"go.uber.org/zap"
+)
+
+const (
+	linearPollingHandlerName   = "linear"
+	linearDefaultGraphQLURL    = "https://api.linear.app/graphql"
+	linearStateChangeCursorKey = "linear_state_changed_at"
+	linearDefaultIssueCount    = 50
+	linearMaxIssueCount        = 100
+	linearDefaultIdentityParam = "identityId"
+	linearDefaultOAuthProvider = "linear"
+)
+
+const linearStateChangesQuery = `query IssueStateChanges($teamId: ID!, $since: DateTimeOrDuration!, $first: Int!) {
+  issues(first: $first, orderBy: updatedAt, filter: { team: { id: { eq: $teamId } }, updatedAt: { gte: $since } }) {
+    nodes {
+      id identifier title url
+      team { id }
+      assignee { id email name }
+      history(first: 25) { nodes { id createdAt fromState { id name type } toState { id name type } } }
+    }
+  }
+}`
+
+// LinearPollingHandler polls a Linear team and emits an event for every workflow state transition
+// recorded in issue history since the stored cursor
+type LinearPollingHandler struct {
+	client   *http.Client
+	logger   *zap.Logger
+	resolver pollingIdentityResolver
+	endpoint string
+}
+
+// NewLinearPollingHandler assembles a Linear issue state polling handler
+func NewLinearPollingHandler(client *http.Client, logger *zap.Logger, identities identityport.Repository, providers oauthProviderResolver) *LinearPollingHandler {
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &LinearPollingHandler{
+		client:   client,
+		logger:   logger,
+		resolver: pollingIdentityResolver{identities: identities, providers: providers},
+		endpoint: linearDefaultGraphQLURL,
+	}
+}
+
+// Supports reports whether the component declares the Linear polling ingestion
+func (h *LinearPollingHandler) Supports(component *componentdomain.Component) bool {
+	_, ok, err := parseLinearPollingConfig(component)
+	return err == nil && ok
+}
+
+// Poll fetches the issues updated since the cursor and converts their state transitions into events
+func (h *LinearPollingHandler) Poll(ctx context.Context, req PollingRequest) (PollingResult, error) {
+	config, ok, err := parseLinearPollingConfig(&req.Component)
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.LinearPollingHandler.Poll: parse config: %w", err)
+	}
+	if !ok {
+		return PollingResult{}, fmt.Errorf("area.LinearPollingHandler.Poll: component %q not supported", req.Component.Name)
+	}
+
+	if req.Binding.Config.Params == nil {
+		req.Binding.Config.Params = map[string]any{}
+	}
+	params := req.Binding.Config.Params
+	teamID := ""
+	if id, err := toString(params["teamId"]); err == nil {
+		teamID = strings.TrimSpace(id)
+	}
+	if teamID == "" {
+		return PollingResult{}, fmt.Errorf("area.LinearPollingHandler.Poll: teamId missing")
+	}
+	stateFilter := ""
+	if name, err := toString(params["stateName"]); err == nil {
+		stateFilter = strings.TrimSpace(name)
+	}
+	count := linearDefaultIssueCount
+	if value, ok := params["maxItems"]; ok {
+		if parsed, err := toInt(value); err == nil && parsed > 0 {
+			count = parsed
+		}
+	}
+	if count > linearMaxIssueCount {
+		count = linearMaxIssueCount
+	}
+
+	result := PollingResult{Cursor: cloneMapAny(req.Cursor)}
+	if result.Cursor == nil {
+		result.Cursor = map[string]any{}
+	}
+	cursorState := ensureCursorState(result.Cursor)
+	assignCursorValue(result.Cursor, cursorState, "last_polled_at", req.Now.UTC().Format(time.RFC3339Nano))
+
+	since, err := parseTime(flattenCursorState(req.Cursor)[linearStateChangeCursorKey])
+	if err != nil {
+		// The first poll only records the starting point so past transitions do not fire
+		assignCursorValue(result.Cursor, cursorState, linearStateChangeCursorKey, req.Now.UTC().Format(time.RFC3339Nano))
+		return result, nil
+	}
+
+	if err := h.resolver.inject(ctx, &req, config.auth); err != nil {
+		return PollingResult{}, fmt.Errorf("area.LinearPollingHandler.Poll: %w", err)
+	}
+	token := stringify(req.Identity["accessToken"])
+
+	issues, err := h.fetchIssues(ctx, token, map[string]any{
+		"teamId": teamID,
+		"since":  since.UTC().Format(time.RFC3339Nano),
+		"first":  count,
+	})
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.LinearPollingHandler.Poll: %w", err)
+	}
+
+	latest := since.UTC()
+	for _, issue := range issues {
+		history, _ := toMapStringAny(issue["history"])
+		entries, _ := history["nodes"].([]any)
+		for _, raw := range entries {
+			entry, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			toState, ok := entry["toState"].(map[string]any)
+			if !ok {
+				continue
+			}
+			changedAt, err := parseTime(entry["createdAt"])
+			if err != nil || changedAt.Before(since) {
+				continue
+			}
+			if changedAt.After(latest) {
+				latest = changedAt.UTC()
+			}
+			if stateFilter != "" && !strings.EqualFold(stringify(toState["name"]), stateFilter) {
+				continue
+			}
+			result.Events = append(result.Events, buildLinearStateEvent(issue, entry, toState, changedAt))
+		}
+	}
+	sort.SliceStable(result.Events, func(i, j int) bool {
+		return result.Events[i].OccurredAt.Before(result.Events[j].OccurredAt)
+	})
+	assignCursorValue(result.Cursor, cursorState, linearStateChangeCursorKey, latest.Format(time.RFC3339Nano))
+	return result, nil
+}
+
+func (h *LinearPollingHandler) fetchIssues(ctx context.Context, token string, variables map[string]any) ([]map[string]any, error) {
+	payload, err := json.Marshal(map[string]any{"query": linearStateChangesQuery, "variables": variables})
+	if err != nil {
+		return nil, fmt.Errorf("marshal query: %w", err)
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, h.endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+token)
+	request.Header.Set("User-Agent", "AREA-Server")
+
+	response, err := h.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	raw, err := io.ReadAll(io.LimitReader(response.Body, 8<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if response.StatusCode >= 400 {
+		return nil, fmt.Errorf("unexpected status %d: %s", response.StatusCode, strings.TrimSpace(string(raw)))
+	}
+
+	var decoded struct {
+		Data struct {
+			Issues struct {
+				Nodes []map[string]any `json:"nodes"`
+			} `json:"issues"`
+		} `json:"data"`
+		Errors []struct {
+			Message string `json:"message"`
+		} `json:"errors"`
+	}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	if len(decoded.Errors) > 0 {
+		messages := make([]string, 0, len(decoded.Errors))
+		for _, entry := range decoded.Errors {
+			messages = append(messages, strings.TrimSpace(entry.Message))
+		}
+		return nil, fmt.Errorf("graphql errors: %s", strings.Join(messages, "; "))
+	}
+	return decoded.Data.Issues.Nodes, nil
+}
+
+func buildLinearStateEvent(issue map[string]any, entry map[string]any, toState map[string]any, changedAt time.Time) PollingEvent {
+	payload := map[string]any{
+		"id":          issue["id"],
+		"identifier":  issue["identifier"],
+		"title":       issue["title"],
+		"url":         issue["url"],
+		"toState":     toState["name"],
+		"toStateType": toState["type"],
+		"changedAt":   changedAt.UTC().Format(time.RFC3339),
+	}
+	if team, ok := issue["team"].(map[string]any); ok {
+		payload["teamId"] = team["id"]
+	}
+	if fromState, ok := entry["fromState"].(map[string]any); ok {
+		payload["fromState"] = fromState["name"]
+		payload["fromStateType"] = fromState["type"]
+	}
+	if assignee, ok := issue["assignee"].(map[string]any); ok {
+		payload["assigneeEmail"] = assignee["email"]
+		payload["assigneeName"] = assignee["name"]
+	}
+	return PollingEvent{
+		Payload:     payload,
+		Fingerprint: stringify(entry["id"]),
+		OccurredAt:  changedAt.UTC(),
+	}
+}
+
+type linearPollingConfig struct {
+	auth httpPollingAuthConfig
+}
+
+func parseLinearPollingConfig(component *componentdomain.Component) (linearPollingConfig, bool, error) {
+	if component == nil || len(component.Metadata) == 0 {
+		return linearPollingConfig{}, false, nil
+	}
+	ingestion, ok, err := ingestionMetadata(component.Metadata)
+	if err != nil {
+		return linearPollingConfig{}, false, fmt.Errorf("ingestion metadata invalid: %w", err)
+	}
+	if !ok || !ingestionSupportsMode(ingestion, ingestionModePolling) {
+		return linearPollingConfig{}, false, nil
+	}
+	handlerName, err := toString(ingestion["handler"])
+	if err != nil || strings.ToLower(strings.TrimSpace(handlerName)) != linearPollingHandlerName {
+		return linearPollingConfig{}, false, nil
+	}
+
+	cfg := linearPollingConfig{auth: httpPollingAuthConfig{
+		Kind:          "oauth",
+		IdentityParam: linearDefaultIdentityParam,
+		Provider:      linearDefaultOAuthProvider,
+	}}
+	if rawAuth, ok := ingestion["auth"]; ok {
+		authMap, err := toMapStringAny(rawAuth)
+		if err != nil {
+			return linearPollingConfig{}, false, fmt.Errorf("auth metadata invalid: %w", err)
+		}
+		cfg.auth.IdentityParam = stringOrDefault(authMap, "identityParam", cfg.auth.IdentityParam)
+		cfg.auth.Provider = stringOrDefault(authMap, "provider", cfg.auth.Provider)
+	}
+	return cfg, true, nil
+}
+
+// Ensure LinearPollingHandler implements ComponentPollingHandler
+var _ ComponentPollingHandler = (*LinearPollingHandler)(nil)
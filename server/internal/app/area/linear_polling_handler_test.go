@@ -0,0 +1,130 @@
+package area
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func linearTestRequest(cursor map[string]any, params map[string]any) (PollingRequest, *identityRepoStub) {
+	identityID := uuid.New()
+	userID := uuid.New()
+	repo := &identityRepoStub{identity: identitydomain.Identity{ID: identityID, UserID: userID, Provider: "linear", AccessToken: "secret"}}
+	params["identityId"] = identityID.String()
+	params["teamId"] = "team-1"
+	return PollingRequest{
+		Binding: actiondomain.PollingBinding{
+			UserID: userID,
+			Config: componentdomain.Config{Params: params},
+		},
+		Component: componentdomain.Component{
+			Name:     "linear_issue_state_changed",
+			Provider: componentdomain.Provider{Name: "linear"},
+			Metadata: map[string]any{
+				"ingestion": map[string]any{"mode": "polling", "handler": "linear"},
+			},
+		},
+		Cursor: cursor,
+		Now:    time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}, repo
+}
+
+const linearHistoryResponse = `{"data":{"issues":{"nodes":[
+	{"id":"i1","identifier":"ENG-1","title":"Crash on login","url":"https://linear.app/x/issue/ENG-1","team":{"id":"team-1"},
+	 "assignee":{"id":"u1","email":"ada@example.com","name":"Ada"},
+	 "history":{"nodes":[
+		{"id":"h3","createdAt":"2025-03-01T11:58:00.000Z","fromState":{"id":"s2","name":"In Progress","type":"started"},"toState":{"id":"s3","name":"Done","type":"completed"}},
+		{"id":"h2","createdAt":"2025-03-01T11:50:00.000Z","fromState":null,"toState":null},
+		{"id":"h1","createdAt":"2025-02-27T09:00:00.000Z","fromState":{"id":"s1","name":"Todo","type":"unstarted"},"toState":{"id":"s2","name":"In Progress","type":"started"}}
+	 ]}},
+	{"id":"i2","identifier":"ENG-2","title":"Docs","url":"https://linear.app/x/issue/ENG-2","team":{"id":"team-1"},
+	 "history":{"nodes":[
+		{"id":"h4","createdAt":"2025-03-01T11:55:00.000Z","fromState":{"id":"s1","name":"Todo","type":"unstarted"},"toState":{"id":"s2","name":"In Progress","type":"started"}}
+	 ]}}
+]}}}`
+
+func TestLinearPollingHandlerInitialisesCursor(t *testing.T) {
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{}}
+	req, repo := linearTestRequest(map[string]any{}, map[string]any{})
+	handler := NewLinearPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	if !handler.Supports(&req.Component) {
+		t.Fatalf("expected linear component to be supported")
+	}
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 0 || len(transport.requests) != 0 {
+		t.Fatalf("expected a silent first poll, got %d events and %d requests", len(result.Events), len(transport.requests))
+	}
+	if got := result.Cursor[linearStateChangeCursorKey]; got != "2025-03-01T12:00:00Z" {
+		t.Fatalf("unexpected cursor %v", got)
+	}
+}
+
+func TestLinearPollingHandlerEmitsStateTransitions(t *testing.T) {
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{
+		"/graphql": {body: linearHistoryResponse},
+	}}
+	cursor := map[string]any{"state": map[string]any{linearStateChangeCursorKey: "2025-03-01T11:45:00Z"}}
+	req, repo := linearTestRequest(cursor, map[string]any{})
+	handler := NewLinearPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 2 {
+		t.Fatalf("expected 2 events got %d", len(result.Events))
+	}
+	first, second := result.Events[0], result.Events[1]
+	if first.Fingerprint != "h4" || second.Fingerprint != "h3" {
+		t.Fatalf("expected events ordered by transition time, got %s then %s", first.Fingerprint, second.Fingerprint)
+	}
+	if second.Payload["fromState"] != "In Progress" || second.Payload["toState"] != "Done" || second.Payload["assigneeEmail"] != "ada@example.com" {
+		t.Fatalf("unexpected payload %v", second.Payload)
+	}
+	if got := result.Cursor[linearStateChangeCursorKey]; got != "2025-03-01T11:58:00Z" {
+		t.Fatalf("unexpected cursor %v", got)
+	}
+
+	request := transport.requests[0]
+	if request.Header.Get("Authorization") != "Bearer secret" {
+		t.Fatalf("unexpected authorization header %q", request.Header.Get("Authorization"))
+	}
+	body, _ := request.GetBody()
+	raw, _ := io.ReadAll(body)
+	if !strings.Contains(string(raw), `"since":"2025-03-01T11:45:00Z"`) || !strings.Contains(string(raw), `"teamId":"team-1"`) {
+		t.Fatalf("unexpected variables %s", raw)
+	}
+}
+
+func TestLinearPollingHandlerFiltersByStateName(t *testing.T) {
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{
+		"/graphql": {body: linearHistoryResponse},
+	}}
+	cursor := map[string]any{"state": map[string]any{linearStateChangeCursorKey: "2025-03-01T11:45:00Z"}}
+	req, repo := linearTestRequest(cursor, map[string]any{"stateName": "done"})
+	handler := NewLinearPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 1 || result.Events[0].Payload["identifier"] != "ENG-1" {
+		t.Fatalf("expected only the Done transition, got %v", result.Events)
+	}
+	if got := result.Cursor[linearStateChangeCursorKey]; got != "2025-03-01T11:58:00Z" {
+		t.Fatalf("cursor should advance past filtered transitions, got %v", got)
+	}
+}
@@ -0,0 +1,180 @@
+package area
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+const (
+	// DefaultPageLimit is the page size used when the caller resumes from a cursor without a limit
+	DefaultPageLimit = 50
+	// MaxPageLimit bounds the page size of area listings
+	MaxPageLimit = 200
+)
+
+// ListOptions filters and paginates the areas visible to a user
+type ListOptions struct {
+	Statuses    []areadomain.Status
+	Provider    string
+	ComponentID *uuid.UUID
+	TagID       *uuid.UUID
+	FolderID    *uuid.UUID
+	Search      string
+	Sort        outbound.AreaSort
+	Ascending   bool
+	Cursor      string
+	Limit       int
+}
+
+// ListPage is one page of areas, NextCursor is empty on the last page
+type ListPage struct {
+	Areas      []areadomain.Area
+	NextCursor string
+}
+
+// listCursor is the opaque cursor handed to clients, it pins the sort so pages stay consistent
+type listCursor struct {
+	Sort      outbound.AreaSort `json:"s"`
+	Ascending bool              `json:"a,omitempty"`
+	Value     time.Time         `json:"v"`
+	ID        uuid.UUID         `json:"id"`
+}
+
+// ListPaged returns one page of the areas owned by or shared with the user along with their activity summary
+// Without limit nor cursor every matching area is returned on a single page, as before pagination existed
+func (s *Service) ListPaged(ctx context.Context, userID uuid.UUID, opts ListOptions) (ListPage, error) {
+	if s.repo == nil {
+		return ListPage{}, fmt.Errorf("area.Service.ListPaged: repository unavailable")
+	}
+	if opts.Limit == 0 && strings.TrimSpace(opts.Cursor) == "" {
+		return s.listAllPages(ctx, userID, opts)
+	}
+
+	query, err := s.listQuery(ctx, userID, opts)
+	if err != nil {
+		return ListPage{}, fmt.Errorf("area.Service.ListPaged: %w", err)
+	}
+	page, err := s.repo.ListPage(ctx, query)
+	if err != nil {
+		return ListPage{}, fmt.Errorf("area.Service.ListPaged: repo.ListPage: %w", err)
+	}
+	areas, err := s.populateComponents(ctx, page.Areas)
+	if err != nil {
+		return ListPage{}, fmt.Errorf("area.Service.ListPaged: %w", err)
+	}
+
+	result := ListPage{Areas: areas}
+	if page.Next != nil {
+		result.NextCursor = encodeListCursor(listCursor{
+			Sort:      query.Sort,
+			Ascending: query.Ascending,
+			Value:     page.Next.SortValue,
+			ID:        page.Next.ID,
+		})
+	}
+	return result, nil
+}
+
+// listAllPages walks the pages of the largest size until the last one
+func (s *Service) listAllPages(ctx context.Context, userID uuid.UUID, opts ListOptions) (ListPage, error) {
+	opts.Limit = MaxPageLimit
+	var result ListPage
+	for {
+		page, err := s.ListPaged(ctx, userID, opts)
+		if err != nil {
+			return ListPage{}, err
+		}
+		result.Areas = append(result.Areas, page.Areas...)
+		if page.NextCursor == "" {
+			return result, nil
+		}
+		opts.Cursor = page.NextCursor
+	}
+}
+
+func (s *Service) listQuery(ctx context.Context, userID uuid.UUID, opts ListOptions) (outbound.AreaListOptions, error) {
+	query := outbound.AreaListOptions{
+		UserID:      userID,
+		Provider:    strings.TrimSpace(opts.Provider),
+		ComponentID: opts.ComponentID,
+		TagID:       opts.TagID,
+		FolderID:    opts.FolderID,
+		Search:      strings.TrimSpace(opts.Search),
+		Sort:        opts.Sort,
+		Ascending:   opts.Ascending,
+		Limit:       opts.Limit,
+		Now:         s.clock.Now().UTC(),
+	}
+	if query.Sort == "" {
+		query.Sort = outbound.AreaSortCreated
+	}
+	switch query.Sort {
+	case outbound.AreaSortCreated, outbound.AreaSortUpdated, outbound.AreaSortLastRun:
+	default:
+		return outbound.AreaListOptions{}, fmt.Errorf("%w: sort %q not supported", ErrAreaListInvalid, opts.Sort)
+	}
+	if query.Limit == 0 {
+		query.Limit = DefaultPageLimit
+	}
+	if query.Limit < 0 || query.Limit > MaxPageLimit {
+		return outbound.AreaListOptions{}, fmt.Errorf("%w: limit must be between 1 and %d", ErrAreaListInvalid, MaxPageLimit)
+	}
+	for _, status := range opts.Statuses {
+		switch status {
+		case areadomain.StatusEnabled, areadomain.StatusDisabled, areadomain.StatusArchived, areadomain.StatusSuspended:
+			query.Statuses = append(query.Statuses, status)
+		default:
+			return outbound.AreaListOptions{}, fmt.Errorf("%w: status %q not supported", ErrAreaListInvalid, status)
+		}
+	}
+
+	if cursor := strings.TrimSpace(opts.Cursor); cursor != "" {
+		decoded, err := decodeListCursor(cursor)
+		if err != nil {
+			return outbound.AreaListOptions{}, err
+		}
+		if decoded.Sort != query.Sort || decoded.Ascending != query.Ascending {
+			return outbound.AreaListOptions{}, fmt.Errorf("%w: cursor does not match the requested sort", ErrAreaListInvalid)
+		}
+		query.After = &outbound.AreaCursor{SortValue: decoded.Value, ID: decoded.ID}
+	}
+
+	if s.workspaces != nil {
+		memberships, err := s.workspaces.ListByUser(ctx, userID)
+		if err != nil {
+			return outbound.AreaListOptions{}, fmt.Errorf("workspaces.ListByUser: %w", err)
+		}
+		for _, membership := range memberships {
+			query.WorkspaceIDs = append(query.WorkspaceIDs, membership.Workspace.ID)
+		}
+	}
+	return query, nil
+}
+
+func encodeListCursor(cursor listCursor) string {
+	payload, err := json.Marshal(cursor)
+	if err != nil {
+		return ""
+	}
+	return base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func decodeListCursor(value string) (listCursor, error) {
+	payload, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return listCursor{}, fmt.Errorf("%w: malformed cursor", ErrAreaListInvalid)
+	}
+	var cursor listCursor
+	if err := json.Unmarshal(payload, &cursor); err != nil || cursor.ID == uuid.Nil {
+		return listCursor{}, fmt.Errorf("%w: malformed cursor", ErrAreaListInvalid)
+	}
+	return cursor, nil
+}
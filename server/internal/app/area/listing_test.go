@@ -0,0 +1,151 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+func seedListedAreas(repo *memoryAreaRepo, userID uuid.UUID, count int) []areadomain.Area {
+	base := time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+	areas := make([]areadomain.Area, 0, count)
+	for i := 0; i < count; i++ {
+		area := areadomain.Area{
+			ID:        uuid.New(),
+			UserID:    userID,
+			Name:      "Area",
+			Status:    areadomain.StatusEnabled,
+			CreatedAt: base.Add(time.Duration(i) * time.Minute),
+		}
+		repo.items[area.ID] = area
+		areas = append(areas, area)
+	}
+	return areas
+}
+
+func TestServiceListPagedWalksPages(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	seeded := seedListedAreas(repo, userID, 5)
+	seedListedAreas(repo, uuid.New(), 2)
+	svc := NewService(repo, &memoryComponentRepo{}, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil)
+
+	var listed []uuid.UUID
+	cursor := ""
+	for pages := 0; ; pages++ {
+		if pages > 3 {
+			t.Fatalf("pagination did not terminate")
+		}
+		page, err := svc.ListPaged(ctx, userID, ListOptions{Limit: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatalf("ListPaged returned error: %v", err)
+		}
+		for _, area := range page.Areas {
+			if area.Activity == nil {
+				t.Fatalf("expected activity summary on listed areas")
+			}
+			listed = append(listed, area.ID)
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+
+	if len(listed) != len(seeded) {
+		t.Fatalf("expected %d areas got %d", len(seeded), len(listed))
+	}
+	for idx, id := range listed {
+		if id != seeded[len(seeded)-1-idx].ID {
+			t.Fatalf("expected newest first, mismatch at %d", idx)
+		}
+	}
+}
+
+func TestServiceListPagedWithoutLimitReturnsEveryArea(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	seeded := seedListedAreas(repo, userID, MaxPageLimit+5)
+	svc := NewService(repo, &memoryComponentRepo{}, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil)
+
+	page, err := svc.ListPaged(ctx, userID, ListOptions{})
+	if err != nil {
+		t.Fatalf("ListPaged returned error: %v", err)
+	}
+	if len(page.Areas) != len(seeded) || page.NextCursor != "" {
+		t.Fatalf("expected %d areas on a single page got %d with cursor %q", len(seeded), len(page.Areas), page.NextCursor)
+	}
+}
+
+func TestServiceListPagedRejectsInvalidOptions(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	seedListedAreas(repo, userID, 3)
+	svc := NewService(repo, &memoryComponentRepo{}, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil)
+
+	first, err := svc.ListPaged(ctx, userID, ListOptions{Limit: 1})
+	if err != nil {
+		t.Fatalf("ListPaged returned error: %v", err)
+	}
+
+	cases := map[string]ListOptions{
+		"status":           {Statuses: []areadomain.Status{"paused"}},
+		"sort":             {Sort: "name"},
+		"limit":            {Limit: MaxPageLimit + 1},
+		"malformed cursor": {Cursor: "not-a-cursor"},
+		"sort mismatch":    {Cursor: first.NextCursor, Sort: outbound.AreaSortUpdated},
+		"order mismatch":   {Cursor: first.NextCursor, Ascending: true},
+	}
+	for name, opts := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := svc.ListPaged(ctx, userID, opts); !errors.Is(err, ErrAreaListInvalid) {
+				t.Fatalf("expected ErrAreaListInvalid got %v", err)
+			}
+		})
+	}
+}
+
+func TestServiceListPagedFilters(t *testing.T) {
+	ctx := context.Background()
+	userID := uuid.New()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	seeded := seedListedAreas(repo, userID, 2)
+	archived := seeded[0]
+	archived.Name = "Weekly report"
+	archived.Status = areadomain.StatusArchived
+	repo.items[archived.ID] = archived
+
+	workspaceID := uuid.New()
+	shared := areadomain.Area{ID: uuid.New(), UserID: uuid.New(), WorkspaceID: &workspaceID, Name: "Team report", Status: areadomain.StatusEnabled}
+	repo.items[shared.ID] = shared
+	workspaces := &memoryWorkspaceRepo{}
+	workspaces.add(workspaceID, userID, workspacedomain.RoleViewer)
+
+	svc := NewService(repo, &memoryComponentRepo{}, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil,
+		WithWorkspaceRepository(workspaces))
+
+	page, err := svc.ListPaged(ctx, userID, ListOptions{Search: "  REPORT "})
+	if err != nil {
+		t.Fatalf("ListPaged returned error: %v", err)
+	}
+	if len(page.Areas) != 2 {
+		t.Fatalf("expected own and shared reports got %d", len(page.Areas))
+	}
+
+	page, err = svc.ListPaged(ctx, userID, ListOptions{Statuses: []areadomain.Status{areadomain.StatusArchived}})
+	if err != nil {
+		t.Fatalf("ListPaged returned error: %v", err)
+	}
+	if len(page.Areas) != 1 || page.Areas[0].ID != archived.ID {
+		t.Fatalf("expected only the archived area got %+v", page.Areas)
+	}
+}
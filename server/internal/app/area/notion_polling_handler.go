@@ -0,0 +1,315 @@
+package area
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"go.uber.org/zap"
+)
+
+const (
+	notionPollingHandlerName   = "notion"
+	notionDefaultAPIBaseURL    = "https://api.notion.com/v1"
+	notionAPIVersion           = "2022-06-28"
+	notionLastEditedCursorKey  = "notion_last_edited_time"
+	notionDefaultPageSize      = 50
+	notionMaxPageSize          = 100
+	notionDefaultIdentityParam = "identityId"
+	notionDefaultOAuthProvider = "notion"
+)
+
+// NotionPollingHandler polls a Notion database and emits an event for every row edited since the stored edit time
+// Rows that were created without being edited since are left to the page created action
+type NotionPollingHandler struct {
+	client   *http.Client
+	logger   *zap.Logger
+	resolver pollingIdentityResolver
+	baseURL  string
+}
+
+// NewNotionPollingHandler assembles a Notion database polling handler
+func NewNotionPollingHandler(client *http.Client, logger *zap.Logger, identities identityport.Repository, providers oauthProviderResolver) *NotionPollingHandler {
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &NotionPollingHandler{
+		client:   client,
+		logger:   logger,
+		resolver: pollingIdentityResolver{identities: identities, providers: providers},
+		baseURL:  notionDefaultAPIBaseURL,
+	}
+}
+
+// Supports reports whether the component declares the Notion polling ingestion
+func (h *NotionPollingHandler) Supports(component *componentdomain.Component) bool {
+	_, ok, err := parseNotionPollingConfig(component)
+	return err == nil && ok
+}
+
+// Poll queries the rows edited since the stored edit time and converts them into events
+func (h *NotionPollingHandler) Poll(ctx context.Context, req PollingRequest) (PollingResult, error) {
+	config, ok, err := parseNotionPollingConfig(&req.Component)
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.NotionPollingHandler.Poll: parse config: %w", err)
+	}
+	if !ok {
+		return PollingResult{}, fmt.Errorf("area.NotionPollingHandler.Poll: component %q not supported", req.Component.Name)
+	}
+
+	if req.Binding.Config.Params == nil {
+		req.Binding.Config.Params = map[string]any{}
+	}
+	databaseID := ""
+	if id, err := toString(req.Binding.Config.Params["databaseId"]); err == nil {
+		databaseID = strings.TrimSpace(id)
+	}
+	if databaseID == "" {
+		return PollingResult{}, fmt.Errorf("area.NotionPollingHandler.Poll: databaseId missing")
+	}
+	pageSize := notionDefaultPageSize
+	if value, ok := req.Binding.Config.Params["maxItems"]; ok {
+		if parsed, err := toInt(value); err == nil && parsed > 0 {
+			pageSize = parsed
+		}
+	}
+	if pageSize > notionMaxPageSize {
+		pageSize = notionMaxPageSize
+	}
+
+	if err := h.resolver.inject(ctx, &req, config.auth); err != nil {
+		return PollingResult{}, fmt.Errorf("area.NotionPollingHandler.Poll: %w", err)
+	}
+	token := stringify(req.Identity["accessToken"])
+
+	result := PollingResult{Cursor: cloneMapAny(req.Cursor)}
+	if result.Cursor == nil {
+		result.Cursor = map[string]any{}
+	}
+	cursorState := ensureCursorState(result.Cursor)
+	assignCursorValue(result.Cursor, cursorState, "last_polled_at", req.Now.UTC().Format(time.RFC3339Nano))
+
+	lastEdited, err := parseTime(flattenCursorState(req.Cursor)[notionLastEditedCursorKey])
+	if err != nil {
+		// The first poll only records the latest edit so existing rows do not fire
+		pages, err := h.query(ctx, token, databaseID, map[string]any{
+			"page_size": 1,
+			"sorts":     []map[string]any{{"timestamp": "last_edited_time", "direction": "descending"}},
+		})
+		if err != nil {
+			return PollingResult{}, fmt.Errorf("area.NotionPollingHandler.Poll: %w", err)
+		}
+		latest := req.Now.UTC()
+		if len(pages) > 0 {
+			if edited, err := parseTime(pages[0]["last_edited_time"]); err == nil {
+				latest = edited.UTC()
+			}
+		}
+		assignCursorValue(result.Cursor, cursorState, notionLastEditedCursorKey, latest.Format(time.RFC3339Nano))
+		return result, nil
+	}
+
+	// Notion rounds edit times to the minute so the boundary minute is queried again and duplicates are dropped by fingerprint
+	pages, err := h.query(ctx, token, databaseID, map[string]any{
+		"page_size": pageSize,
+		"filter": map[string]any{
+			"timestamp":        "last_edited_time",
+			"last_edited_time": map[string]any{"on_or_after": lastEdited.UTC().Format(time.RFC3339)},
+		},
+		"sorts": []map[string]any{{"timestamp": "last_edited_time", "direction": "ascending"}},
+	})
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.NotionPollingHandler.Poll: %w", err)
+	}
+
+	latest := lastEdited.UTC()
+	for _, page := range pages {
+		edited, err := parseTime(page["last_edited_time"])
+		if err != nil || edited.Before(lastEdited) {
+			continue
+		}
+		if edited.After(latest) {
+			latest = edited.UTC()
+		}
+		if created, err := parseTime(page["created_time"]); err == nil && created.Equal(edited) {
+			continue
+		}
+		result.Events = append(result.Events, buildNotionEvent(databaseID, page, edited))
+	}
+	assignCursorValue(result.Cursor, cursorState, notionLastEditedCursorKey, latest.Format(time.RFC3339Nano))
+	return result, nil
+}
+
+func (h *NotionPollingHandler) query(ctx context.Context, token string, databaseID string, body map[string]any) ([]map[string]any, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("marshal query: %w", err)
+	}
+	endpoint := fmt.Sprintf("%s/databases/%s/query", h.baseURL, url.PathEscape(databaseID))
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("Authorization", "Bearer "+token)
+	request.Header.Set("Notion-Version", notionAPIVersion)
+	request.Header.Set("User-Agent", "AREA-Server")
+
+	response, err := h.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	raw, err := io.ReadAll(io.LimitReader(response.Body, 8<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if response.StatusCode >= 400 {
+		return nil, fmt.Errorf("unexpected status %d: %s", response.StatusCode, strings.TrimSpace(string(raw)))
+	}
+
+	var decoded struct {
+		Results []map[string]any `json:"results"`
+	}
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return decoded.Results, nil
+}
+
+func buildNotionEvent(databaseID string, page map[string]any, edited time.Time) PollingEvent {
+	id := stringify(page["id"])
+	payload := map[string]any{
+		"databaseId":     databaseID,
+		"id":             id,
+		"url":            page["url"],
+		"createdTime":    page["created_time"],
+		"lastEditedTime": page["last_edited_time"],
+		"properties":     flattenNotionProperties(page["properties"]),
+	}
+	if editor, ok := page["last_edited_by"].(map[string]any); ok {
+		payload["lastEditedBy"] = editor["id"]
+	}
+	return PollingEvent{
+		Payload:     payload,
+		Fingerprint: fmt.Sprintf("%s:%s", id, edited.UTC().Format(time.RFC3339)),
+		OccurredAt:  edited.UTC(),
+	}
+}
+
+// flattenNotionProperties reduces Notion property objects to plain values usable in templates
+func flattenNotionProperties(raw any) map[string]any {
+	properties, _ := raw.(map[string]any)
+	flattened := make(map[string]any, len(properties))
+	for name, value := range properties {
+		property, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+		flattened[name] = flattenNotionProperty(property)
+	}
+	return flattened
+}
+
+func flattenNotionProperty(property map[string]any) any {
+	kind := stringify(property["type"])
+	value := property[kind]
+	switch kind {
+	case "title", "rich_text":
+		items, _ := value.([]any)
+		var builder strings.Builder
+		for _, item := range items {
+			if entry, ok := item.(map[string]any); ok {
+				builder.WriteString(stringify(entry["plain_text"]))
+			}
+		}
+		return builder.String()
+	case "select", "status":
+		if option, ok := value.(map[string]any); ok {
+			return option["name"]
+		}
+		return nil
+	case "multi_select", "relation", "people":
+		items, _ := value.([]any)
+		key := "name"
+		if kind == "relation" {
+			key = "id"
+		}
+		values := make([]any, 0, len(items))
+		for _, item := range items {
+			if entry, ok := item.(map[string]any); ok {
+				values = append(values, entry[key])
+			}
+		}
+		return values
+	case "date":
+		if date, ok := value.(map[string]any); ok {
+			if end := date["end"]; end != nil {
+				return map[string]any{"start": date["start"], "end": end}
+			}
+			return date["start"]
+		}
+		return nil
+	case "formula":
+		if formula, ok := value.(map[string]any); ok {
+			return formula[stringify(formula["type"])]
+		}
+		return nil
+	default:
+		return value
+	}
+}
+
+type notionPollingConfig struct {
+	auth httpPollingAuthConfig
+}
+
+func parseNotionPollingConfig(component *componentdomain.Component) (notionPollingConfig, bool, error) {
+	if component == nil || len(component.Metadata) == 0 {
+		return notionPollingConfig{}, false, nil
+	}
+	ingestion, ok, err := ingestionMetadata(component.Metadata)
+	if err != nil {
+		return notionPollingConfig{}, false, fmt.Errorf("ingestion metadata invalid: %w", err)
+	}
+	if !ok || !ingestionSupportsMode(ingestion, ingestionModePolling) {
+		return notionPollingConfig{}, false, nil
+	}
+	handlerName, err := toString(ingestion["handler"])
+	if err != nil || strings.ToLower(strings.TrimSpace(handlerName)) != notionPollingHandlerName {
+		return notionPollingConfig{}, false, nil
+	}
+
+	cfg := notionPollingConfig{auth: httpPollingAuthConfig{
+		Kind:          "oauth",
+		IdentityParam: notionDefaultIdentityParam,
+		Provider:      notionDefaultOAuthProvider,
+	}}
+	if rawAuth, ok := ingestion["auth"]; ok {
+		authMap, err := toMapStringAny(rawAuth)
+		if err != nil {
+			return notionPollingConfig{}, false, fmt.Errorf("auth metadata invalid: %w", err)
+		}
+		cfg.auth.IdentityParam = stringOrDefault(authMap, "identityParam", cfg.auth.IdentityParam)
+		cfg.auth.Provider = stringOrDefault(authMap, "provider", cfg.auth.Provider)
+	}
+	return cfg, true, nil
+}
+
+// Ensure NotionPollingHandler implements ComponentPollingHandler
+var _ ComponentPollingHandler = (*NotionPollingHandler)(nil)
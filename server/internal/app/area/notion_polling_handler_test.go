@@ -0,0 +1,124 @@
+package area
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func notionTestComponent() componentdomain.Component {
+	return componentdomain.Component{
+		Name:     "notion_database_item_updated",
+		Provider: componentdomain.Provider{Name: "notion"},
+		Metadata: map[string]any{
+			"ingestion": map[string]any{
+				"mode":    "polling",
+				"handler": "notion",
+			},
+		},
+	}
+}
+
+func notionTestRequest(cursor map[string]any) (PollingRequest, *identityRepoStub) {
+	identityID := uuid.New()
+	userID := uuid.New()
+	repo := &identityRepoStub{identity: identitydomain.Identity{ID: identityID, UserID: userID, Provider: "notion", AccessToken: "secret"}}
+	return PollingRequest{
+		Binding: actiondomain.PollingBinding{
+			UserID: userID,
+			Config: componentdomain.Config{Params: map[string]any{"identityId": identityID.String(), "databaseId": "db-1"}},
+		},
+		Component: notionTestComponent(),
+		Cursor:    cursor,
+		Now:       time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC),
+	}, repo
+}
+
+func TestNotionPollingHandlerSupports(t *testing.T) {
+	component := notionTestComponent()
+	if !NewNotionPollingHandler(nil, zap.NewNop(), nil, nil).Supports(&component) {
+		t.Fatalf("expected notion component to be supported")
+	}
+	if NewSheetsPollingHandler(nil, zap.NewNop(), nil, nil).Supports(&component) {
+		t.Fatalf("sheets handler should not claim notion components")
+	}
+}
+
+func TestNotionPollingHandlerInitialisesCursor(t *testing.T) {
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{
+		"/v1/databases/db-1/query": {body: `{"results":[{"id":"p1","last_edited_time":"2025-02-28T10:15:00.000Z"}]}`},
+	}}
+	req, repo := notionTestRequest(map[string]any{})
+	handler := NewNotionPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 0 {
+		t.Fatalf("expected no events on first poll, got %d", len(result.Events))
+	}
+	if got := result.Cursor[notionLastEditedCursorKey]; got != "2025-02-28T10:15:00Z" {
+		t.Fatalf("unexpected cursor %v", got)
+	}
+}
+
+func TestNotionPollingHandlerEmitsEditedRows(t *testing.T) {
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{
+		"/v1/databases/db-1/query": {body: `{"results":[
+			{"id":"p1","created_time":"2025-02-01T09:00:00.000Z","last_edited_time":"2025-02-28T10:15:00.000Z","properties":{}},
+			{"id":"p2","created_time":"2025-02-28T10:20:00.000Z","last_edited_time":"2025-02-28T10:20:00.000Z","properties":{}},
+			{"id":"p3","created_time":"2025-02-02T09:00:00.000Z","last_edited_time":"2025-02-28T10:30:00.000Z","url":"https://www.notion.so/p3","properties":{
+				"Name":{"type":"title","title":[{"plain_text":"Acme"},{"plain_text":" Corp"}]},
+				"Stage":{"type":"status","status":{"name":"Won"}},
+				"Tags":{"type":"multi_select","multi_select":[{"name":"b2b"},{"name":"eu"}]},
+				"Amount":{"type":"number","number":1200},
+				"Close":{"type":"date","date":{"start":"2025-03-01","end":null}}
+			}}
+		]}`},
+	}}
+	cursor := map[string]any{"state": map[string]any{notionLastEditedCursorKey: "2025-02-28T10:15:00Z"}}
+	req, repo := notionTestRequest(cursor)
+	handler := NewNotionPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 2 {
+		t.Fatalf("expected 2 events got %d", len(result.Events))
+	}
+	event := result.Events[1]
+	if event.Fingerprint != "p3:2025-02-28T10:30:00Z" {
+		t.Fatalf("unexpected fingerprint %q", event.Fingerprint)
+	}
+	properties := event.Payload["properties"].(map[string]any)
+	if properties["Name"] != "Acme Corp" || properties["Stage"] != "Won" || properties["Close"] != "2025-03-01" || properties["Amount"] != float64(1200) {
+		t.Fatalf("unexpected flattened properties %v", properties)
+	}
+	if tags := properties["Tags"].([]any); len(tags) != 2 || tags[0] != "b2b" {
+		t.Fatalf("unexpected tags %v", properties["Tags"])
+	}
+	if got := result.Cursor[notionLastEditedCursorKey]; got != "2025-02-28T10:30:00Z" {
+		t.Fatalf("unexpected cursor %v", got)
+	}
+
+	request := transport.requests[0]
+	if request.Header.Get("Notion-Version") != notionAPIVersion || request.Header.Get("Authorization") != "Bearer secret" {
+		t.Fatalf("unexpected headers %v", request.Header)
+	}
+	body, _ := request.GetBody()
+	raw, _ := io.ReadAll(body)
+	if !strings.Contains(string(raw), `"on_or_after":"2025-02-28T10:15:00Z"`) {
+		t.Fatalf("expected edit time filter, got %s", raw)
+	}
+}
@@ -21,6 +21,9 @@ type ExecutionInput struct {
 	Payload     map[string]any
 	Fingerprint string
 	OccurredAt  time.Time
+	// Rejection records the trigger as filtered for that reason instead of enqueuing jobs
+	// Events outside the activity schedule of the area are filtered the same way
+	Rejection string
 }
 
 // ExecutionPipeline persists action events, triggers, and jobs
@@ -95,10 +98,22 @@ func (p *dbExecutionPipeline) Enqueue(ctx context.Context, input ExecutionInput)
 		CreatedAt: now,
 		UpdatedAt: now,
 	}
+	rejection := input.Rejection
+	if rejection == "" {
+		if active, reason := activeAt(input.Area, occurredAt); !active {
+			rejection = reason
+		}
+	}
+	reactions := input.Area.Reactions
+	if rejection != "" {
+		trigger.Status = actiondomain.TriggerStatusFiltered
+		trigger.MatchInfo = map[string]any{"rejection_reason": rejection}
+		reactions = nil
+	}
 	triggers = append(triggers, trigger)
 
-	jobs := make([]jobdomain.Job, 0, len(input.Area.Reactions))
-	for _, reaction := range input.Area.Reactions {
+	jobs := make([]jobdomain.Job, 0, len(reactions))
+	for _, reaction := range reactions {
 		job := jobdomain.Job{
 			ID:           uuid.New(),
 			TriggerID:    trigger.ID,
@@ -120,6 +135,9 @@ func (p *dbExecutionPipeline) Enqueue(ctx context.Context, input ExecutionInput)
 		}
 		return fmt.Errorf("area.ExecutionPipeline.Enqueue: %w", err)
 	}
+	if len(jobs) == 0 {
+		return nil
+	}
 	if p.queue == nil {
 		return fmt.Errorf("area.ExecutionPipeline.Enqueue: queue unavailable")
 	}
@@ -145,6 +163,9 @@ func buildJobInputPayload(area areadomain.Area, reaction areadomain.Link, eventP
 		"params":       cloneMap(reaction.Config.Params),
 		"eventPayload": cloneMap(eventPayload),
 	}
+	if area.Revision > 0 {
+		payload["revision"] = area.Revision
+	}
 	if reaction.Config.Component != nil {
 		payload["componentName"] = reaction.Config.Component.Name
 		payload["provider"] = reaction.Config.Component.Provider.Name
@@ -0,0 +1,96 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+// pollingPreviewLimit bounds the number of events returned by a preview
+const pollingPreviewLimit = 20
+
+// PollingPreview lists the events a polling action would emit on its first poll
+type PollingPreview struct {
+	Events    []PollingEvent
+	Total     int
+	Truncated bool
+}
+
+// PreviewPolling runs a single poll of the action with an empty cursor without storing anything
+// Handlers that only record a baseline on their first poll return no events
+func (s *Service) PreviewPolling(ctx context.Context, userID uuid.UUID, componentID uuid.UUID, params map[string]any) (PollingPreview, error) {
+	if s.components == nil {
+		return PollingPreview{}, fmt.Errorf("area.Service.PreviewPolling: component repository unavailable")
+	}
+	if componentID == uuid.Nil {
+		return PollingPreview{}, fmt.Errorf("area.Service.PreviewPolling: %w", ErrActionComponentRequired)
+	}
+
+	component, err := s.components.FindByID(ctx, componentID)
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return PollingPreview{}, fmt.Errorf("area.Service.PreviewPolling: %w", ErrActionComponentInvalid)
+		}
+		return PollingPreview{}, fmt.Errorf("area.Service.PreviewPolling: components.FindByID: %w", err)
+	}
+	if component.Kind != componentdomain.KindAction {
+		return PollingPreview{}, fmt.Errorf("area.Service.PreviewPolling: %w", ErrActionComponentInvalid)
+	}
+	if !component.Enabled {
+		return PollingPreview{}, fmt.Errorf("area.Service.PreviewPolling: %w", ErrActionComponentDisabled)
+	}
+	if err := s.ensureProviderSubscription(ctx, userID, component.ProviderID); err != nil {
+		return PollingPreview{}, fmt.Errorf("area.Service.PreviewPolling: ensure action subscription: %w", err)
+	}
+
+	if params == nil {
+		params = map[string]any{}
+	}
+	if err := s.validateComponentParams(component, params); err != nil {
+		return PollingPreview{}, fmt.Errorf("area.Service.PreviewPolling: %w", err)
+	}
+	if mode := componentIngestionMode(&component, params); mode != ingestionModePolling {
+		return PollingPreview{}, fmt.Errorf("area.Service.PreviewPolling: %w", ErrPollingPreviewUnsupported)
+	}
+
+	var handler ComponentPollingHandler
+	for _, candidate := range s.pollers {
+		if candidate != nil && candidate.Supports(&component) {
+			handler = candidate
+			break
+		}
+	}
+	if handler == nil {
+		return PollingPreview{}, fmt.Errorf("area.Service.PreviewPolling: %w", ErrPollingPreviewUnsupported)
+	}
+
+	result, err := handler.Poll(ctx, PollingRequest{
+		Binding: actiondomain.PollingBinding{
+			UserID: userID,
+			Config: componentdomain.Config{
+				UserID:      userID,
+				ComponentID: component.ID,
+				Params:      cloneParamsMap(params),
+				Component:   &component,
+			},
+		},
+		Component: component,
+		Cursor:    map[string]any{},
+		Now:       s.clock.Now().UTC(),
+	})
+	if err != nil {
+		return PollingPreview{}, fmt.Errorf("area.Service.PreviewPolling: %w: %v", ErrPollingPreviewFailed, err)
+	}
+
+	preview := PollingPreview{Events: result.Events, Total: len(result.Events)}
+	if len(preview.Events) > pollingPreviewLimit {
+		preview.Events = preview.Events[:pollingPreviewLimit]
+		preview.Truncated = true
+	}
+	return preview, nil
+}
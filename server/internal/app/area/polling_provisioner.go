@@ -18,11 +18,28 @@ const (
 type PollingProvisioner struct {
 	sources outbound.ActionSourceRepository
 	clock   Clock
+	limiter PollingIntervalLimiter
+}
+
+// PollingProvisionerOption customises optional PollingProvisioner collaborators
+type PollingProvisionerOption func(*PollingProvisioner)
+
+// WithPollingIntervalLimiter raises the polling interval of each source to the minimum allowed by the quota of its member
+func WithPollingIntervalLimiter(limiter PollingIntervalLimiter) PollingProvisionerOption {
+	return func(p *PollingProvisioner) {
+		p.limiter = limiter
+	}
 }
 
 // NewPollingProvisioner constructs a PollingProvisioner bound to the provided repositories
-func NewPollingProvisioner(sources outbound.ActionSourceRepository, clock Clock) *PollingProvisioner {
-	return &PollingProvisioner{sources: sources, clock: clock}
+func NewPollingProvisioner(sources outbound.ActionSourceRepository, clock Clock, opts ...PollingProvisionerOption) *PollingProvisioner {
+	provisioner := &PollingProvisioner{sources: sources, clock: clock}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(provisioner)
+		}
+	}
+	return provisioner
 }
 
 // Provision ensures polling sources are configured when the action metadata declares a polling ingestion mode
@@ -39,7 +56,7 @@ func (p *PollingProvisioner) Provision(ctx context.Context, area areadomain.Area
 		return nil
 	}
 
-	cfg, ok, err := decodePollingConfig(component.Metadata)
+	cfg, ok, err := decodePollingConfig(component.Metadata, area.Action.Config.Params)
 	if err != nil {
 		return fmt.Errorf("area.PollingProvisioner.Provision: decode polling config: %w", err)
 	}
@@ -52,8 +69,14 @@ func (p *PollingProvisioner) Provision(ctx context.Context, area areadomain.Area
 	if interval <= 0 {
 		interval = defaultPollingInterval
 	}
+	if p.limiter != nil {
+		interval, err = p.limiter.PollingInterval(ctx, area.UserID, interval)
+		if err != nil {
+			return fmt.Errorf("area.PollingProvisioner.Provision: limit interval: %w", err)
+		}
+	}
 	cursor := map[string]any{
-		"interval_seconds": cfg.intervalSeconds,
+		"interval_seconds": int(interval / time.Second),
 		"last_run":         now.Format(time.RFC3339Nano),
 		"next_run":         now.Add(interval).Format(time.RFC3339Nano),
 	}
@@ -94,24 +117,18 @@ type pollingConfig struct {
 	initialCursor   map[string]any
 }
 
-func decodePollingConfig(metadata map[string]any) (pollingConfig, bool, error) {
+func decodePollingConfig(metadata map[string]any, params map[string]any) (pollingConfig, bool, error) {
 	cfg := pollingConfig{intervalSeconds: int(defaultPollingInterval / time.Second)}
 
-	ingestRaw, ok := metadata["ingestion"]
-	if !ok {
-		return cfg, false, nil
-	}
-
-	ingest, err := toMapStringAny(ingestRaw)
+	ingest, ok, err := ingestionMetadata(metadata)
 	if err != nil {
 		return cfg, false, err
 	}
-
-	mode, err := toStringLower(ingest["mode"])
-	if err != nil {
+	if !ok {
 		return cfg, false, nil
 	}
-	if mode != "polling" {
+
+	if resolveIngestionMode(ingest, params) != ingestionModePolling {
 		return cfg, false, nil
 	}
 
@@ -151,3 +151,51 @@ func TestPollingProvisioner_NoOpWhenNotPolling(t *testing.T) {
 		t.Fatalf("polling provisioner should have skipped non-polling component")
 	}
 }
+
+type stubIntervalLimiter struct {
+	min  time.Duration
+	user uuid.UUID
+}
+
+func (s *stubIntervalLimiter) PollingInterval(ctx context.Context, userID uuid.UUID, interval time.Duration) (time.Duration, error) {
+	s.user = userID
+	if interval < s.min {
+		return s.min, nil
+	}
+	return interval, nil
+}
+
+func TestPollingProvisioner_RaisesIntervalToQuota(t *testing.T) {
+	repo := &recordingActionSourceRepo{}
+	limiter := &stubIntervalLimiter{min: 5 * time.Minute}
+	now := time.Unix(1720000000, 0).UTC()
+	prov := NewPollingProvisioner(repo, stubClock{now: now}, WithPollingIntervalLimiter(limiter))
+
+	area := areadomain.Area{
+		UserID: uuid.New(),
+		Status: areadomain.StatusEnabled,
+		Action: &areadomain.Link{
+			Config: componentdomain.Config{
+				ID: uuid.New(),
+				Component: &componentdomain.Component{
+					Metadata: map[string]any{
+						"ingestion": map[string]any{"mode": "polling", "intervalSeconds": 30},
+					},
+				},
+			},
+		},
+	}
+
+	if err := prov.Provision(context.Background(), area); err != nil {
+		t.Fatalf("Provision returned error: %v", err)
+	}
+	if limiter.user != area.UserID {
+		t.Fatalf("expected the quota of the area member to apply")
+	}
+	if repo.pollingInput.Cursor["interval_seconds"] != 300 {
+		t.Fatalf("expected interval raised to 300 seconds, got %v", repo.pollingInput.Cursor["interval_seconds"])
+	}
+	if repo.pollingInput.Cursor["next_run"] != now.Add(5*time.Minute).Format(time.RFC3339Nano) {
+		t.Fatalf("unexpected next run %v", repo.pollingInput.Cursor["next_run"])
+	}
+}
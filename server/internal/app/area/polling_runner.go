@@ -2,6 +2,8 @@ package area
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
@@ -12,6 +14,8 @@ import (
 
 const (
 	defaultPollingIntervalSeconds = int(defaultPollingInterval / time.Second)
+	// pollSkippedSinceKey marks in the cursor the start of the inactive window already recorded
+	pollSkippedSinceKey = "skipped_since"
 )
 
 // PollingEvent captures an event emitted by a polling action
@@ -48,6 +52,7 @@ type PollingRunner struct {
 	components outbound.ComponentRepository
 	executor   AreaExecutor
 	handlers   []ComponentPollingHandler
+	activity   ActivityChecker
 	clock      Clock
 	logger     *zap.Logger
 	interval   time.Duration
@@ -84,6 +89,13 @@ func WithPollingLogger(logger *zap.Logger) PollingRunnerOption {
 	}
 }
 
+// WithPollingActivityChecker skips polling areas outside their activity schedule and records each skipped poll as a filtered trigger
+func WithPollingActivityChecker(checker ActivityChecker) PollingRunnerOption {
+	return func(r *PollingRunner) {
+		r.activity = checker
+	}
+}
+
 // NewPollingRunner assembles a polling runner from its dependencies
 func NewPollingRunner(
 	sources outbound.ActionSourceRepository,
@@ -162,6 +174,32 @@ func (r *PollingRunner) process(ctx context.Context) {
 }
 
 func (r *PollingRunner) processBinding(ctx context.Context, binding actiondomain.PollingBinding, now time.Time) {
+	if r.activity != nil {
+		active, reason, err := r.activity.ActivityAt(ctx, binding.AreaID, now)
+		if err != nil {
+			r.log().Error("polling activity check failed",
+				zap.Error(err),
+				zap.String("area_id", binding.AreaID.String()),
+			)
+		} else if !active {
+			r.log().Debug("polling skipped outside activity schedule",
+				zap.String("reason", reason),
+				zap.String("area_id", binding.AreaID.String()),
+			)
+			var updates map[string]any
+			if _, recorded := binding.Source.Cursor[pollSkippedSinceKey]; !recorded {
+				r.recordSkippedPoll(ctx, binding, now)
+				updates = map[string]any{pollSkippedSinceKey: now.Format(time.RFC3339Nano)}
+			}
+			r.bumpCursor(ctx, binding, now, intervalFromCursor(binding.Source.Cursor), updates)
+			return
+		}
+	}
+	if _, skipped := binding.Source.Cursor[pollSkippedSinceKey]; skipped {
+		binding.Source.Cursor = cloneMapAny(binding.Source.Cursor)
+		delete(binding.Source.Cursor, pollSkippedSinceKey)
+	}
+
 	component, err := r.components.FindByID(ctx, binding.Config.ComponentID)
 	if err != nil {
 		r.log().Error("load polling component failed",
@@ -173,6 +211,16 @@ func (r *PollingRunner) processBinding(ctx context.Context, binding actiondomain
 		return
 	}
 
+	if mode := componentIngestionMode(&component, binding.Config.Params); mode != "" && mode != ingestionModePolling {
+		r.log().Debug("polling skipped for non-polling ingestion mode",
+			zap.String("component", component.Name),
+			zap.String("mode", mode),
+			zap.String("area_id", binding.AreaID.String()),
+		)
+		r.bumpCursor(ctx, binding, now, intervalFromCursor(binding.Source.Cursor), nil)
+		return
+	}
+
 	handler := r.findHandler(&component)
 	if handler == nil {
 		r.log().Warn("no polling handler for component",
@@ -220,7 +268,12 @@ func (r *PollingRunner) processBinding(ctx context.Context, binding actiondomain
 			Fingerprint: event.Fingerprint,
 			OccurredAt:  event.OccurredAt,
 		}
-		if err := r.executor.ExecuteWithOptions(ctx, binding.UserID, binding.AreaID, options); err != nil {
+		if err := r.executor.ExecuteWithOptions(ctx, binding.UserID, binding.AreaID, options); errors.Is(err, ErrExecutionQuotaExceeded) {
+			r.log().Warn("polling execution rejected",
+				zap.Error(err),
+				zap.String("area_id", binding.AreaID.String()),
+			)
+		} else if err != nil {
 			r.log().Error("polling execution failed",
 				zap.Error(err),
 				zap.String("area_id", binding.AreaID.String()),
@@ -229,6 +282,23 @@ func (r *PollingRunner) processBinding(ctx context.Context, binding actiondomain
 	}
 }
 
+// recordSkippedPoll hands the skipped poll to the pipeline so it is recorded as a filtered trigger with the schedule reason
+// It runs once per inactive window, now being the start of the window
+func (r *PollingRunner) recordSkippedPoll(ctx context.Context, binding actiondomain.PollingBinding, now time.Time) {
+	options := ExecutionOptions{
+		SourceID:    binding.Source.ID,
+		Payload:     map[string]any{"poll_skipped": true},
+		Fingerprint: fmt.Sprintf("poll-skipped:%s:%d", binding.Source.ID, now.Unix()),
+		OccurredAt:  now,
+	}
+	if err := r.executor.ExecuteWithOptions(ctx, binding.UserID, binding.AreaID, options); err != nil {
+		r.log().Error("polling skip record failed",
+			zap.Error(err),
+			zap.String("area_id", binding.AreaID.String()),
+		)
+	}
+}
+
 func (r *PollingRunner) bumpCursor(ctx context.Context, binding actiondomain.PollingBinding, now time.Time, intervalSeconds int, updates map[string]any) {
 	if intervalSeconds <= 0 {
 		intervalSeconds = defaultPollingIntervalSeconds
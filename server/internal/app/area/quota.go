@@ -0,0 +1,46 @@
+package area
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// QuotaChecker applies the plan limits of the member running an automation
+// Checks return the reason the quota rejects the operation, empty when it is allowed
+type QuotaChecker interface {
+	CheckArea(ctx context.Context, userID uuid.UUID, reactions int) (string, error)
+	CheckExecution(ctx context.Context, userID uuid.UUID) (string, error)
+}
+
+// PollingIntervalLimiter raises polling intervals to the minimum allowed to the member running an automation
+type PollingIntervalLimiter interface {
+	PollingInterval(ctx context.Context, userID uuid.UUID, interval time.Duration) (time.Duration, error)
+}
+
+func (s *Service) checkAreaQuota(ctx context.Context, userID uuid.UUID, reactions int) error {
+	if s.quotas == nil {
+		return nil
+	}
+	reason, err := s.quotas.CheckArea(ctx, userID, reactions)
+	if err != nil {
+		return fmt.Errorf("quotas.CheckArea: %w", err)
+	}
+	if reason != "" {
+		return fmt.Errorf("%w: %s", ErrAreaQuotaExceeded, reason)
+	}
+	return nil
+}
+
+func (s *Service) executionRejection(ctx context.Context, userID uuid.UUID) (string, error) {
+	if s.quotas == nil {
+		return "", nil
+	}
+	reason, err := s.quotas.CheckExecution(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("quotas.CheckExecution: %w", err)
+	}
+	return reason, nil
+}
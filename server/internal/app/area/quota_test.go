@@ -0,0 +1,112 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/google/uuid"
+)
+
+type stubQuotas struct {
+	areaReason      string
+	executionReason string
+	reactions       int
+	users           []uuid.UUID
+}
+
+func (s *stubQuotas) CheckArea(ctx context.Context, userID uuid.UUID, reactions int) (string, error) {
+	s.reactions = reactions
+	s.users = append(s.users, userID)
+	return s.areaReason, nil
+}
+
+func (s *stubQuotas) CheckExecution(ctx context.Context, userID uuid.UUID) (string, error) {
+	s.users = append(s.users, userID)
+	return s.executionReason, nil
+}
+
+func TestServiceCreateRejectsAreasBeyondQuota(t *testing.T) {
+	ctx := context.Background()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	actionID := uuid.New()
+	reactionID := uuid.New()
+	providerID := uuid.New()
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{
+		actionID:   {ID: actionID, Kind: componentdomain.KindAction, Enabled: true, ProviderID: providerID},
+		reactionID: {ID: reactionID, Kind: componentdomain.KindReaction, Enabled: true, ProviderID: providerID},
+	}}
+	quotas := &stubQuotas{areaReason: "limit of 2 areas reached"}
+	svc := NewService(repo, components, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil, WithQuotas(quotas))
+
+	userID := uuid.New()
+	reactions := []ReactionInput{{ComponentID: reactionID}, {ComponentID: reactionID}}
+	_, err := svc.Create(ctx, userID, "Capped", "", ActionInput{ComponentID: actionID}, reactions)
+	if !errors.Is(err, ErrAreaQuotaExceeded) {
+		t.Fatalf("expected ErrAreaQuotaExceeded got %v", err)
+	}
+	if len(repo.items) != 0 {
+		t.Fatalf("expected no area stored")
+	}
+	if quotas.reactions != 2 || len(quotas.users) != 1 || quotas.users[0] != userID {
+		t.Fatalf("unexpected quota check %+v", quotas)
+	}
+
+	quotas.areaReason = ""
+	if _, err := svc.Create(ctx, userID, "Allowed", "", ActionInput{ComponentID: actionID}, reactions); err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+}
+
+func TestServiceExecuteRecordsRejectedTrigger(t *testing.T) {
+	ctx := context.Background()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	executions := &fakeExecutionRepository{}
+	queue := &recordingQueue{}
+	clock := stubClock{now: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}
+	quotas := &stubQuotas{executionReason: "limit of 200 executions per hour reached"}
+	svc := NewService(repo, &memoryComponentRepo{}, allowAllSubscriptions{}, nil, NewExecutionPipeline(executions, clock, queue), clock, nil, WithQuotas(quotas))
+
+	ownerID := uuid.New()
+	area := areadomain.Area{
+		ID:     uuid.New(),
+		UserID: ownerID,
+		Name:   "Busy",
+		Status: areadomain.StatusEnabled,
+		Action: &areadomain.Link{ID: uuid.New(), Role: areadomain.LinkRoleAction, Config: componentdomain.Config{ID: uuid.New()}},
+		Reactions: []areadomain.Link{
+			{ID: uuid.New(), Role: areadomain.LinkRoleReaction, Config: componentdomain.Config{ID: uuid.New()}},
+		},
+	}
+	repo.items[area.ID] = area
+
+	err := svc.ExecuteWithOptions(ctx, ownerID, area.ID, ExecutionOptions{SourceID: uuid.New()})
+	if !errors.Is(err, ErrExecutionQuotaExceeded) {
+		t.Fatalf("expected ErrExecutionQuotaExceeded got %v", err)
+	}
+	if len(executions.triggers) != 1 {
+		t.Fatalf("expected the rejected trigger recorded, got %d", len(executions.triggers))
+	}
+	trigger := executions.triggers[0]
+	if trigger.Status != actiondomain.TriggerStatusFiltered || trigger.MatchInfo["rejection_reason"] != quotas.executionReason {
+		t.Fatalf("unexpected trigger %+v", trigger)
+	}
+	if len(executions.jobs) != 0 || len(queue.messages) != 0 {
+		t.Fatalf("expected no jobs for a rejected trigger")
+	}
+
+	quotas.executionReason = ""
+	if err := svc.ExecuteWithOptions(ctx, ownerID, area.ID, ExecutionOptions{SourceID: uuid.New()}); err != nil {
+		t.Fatalf("ExecuteWithOptions returned error: %v", err)
+	}
+	if len(executions.jobs) != 1 || len(queue.messages) != 1 {
+		t.Fatalf("expected jobs once the quota allows executions")
+	}
+	if executions.triggers[1].Status != actiondomain.TriggerStatusMatched {
+		t.Fatalf("expected matched trigger got %s", executions.triggers[1].Status)
+	}
+}
@@ -16,6 +16,11 @@ type ComponentReactionHandler interface {
 	Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error)
 }
 
+// ComponentReactionPreviewer is implemented by handlers able to describe a reaction without sending it
+type ComponentReactionPreviewer interface {
+	Preview(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error)
+}
+
 // CompositeReactionExecutor routes reaction execution across component-specific handlers
 type CompositeReactionExecutor struct {
 	handlers []ComponentReactionHandler
@@ -48,3 +53,23 @@ func (c *CompositeReactionExecutor) ExecuteReaction(ctx context.Context, area ar
 	}
 	return outbound.ReactionResult{}, fmt.Errorf("area.CompositeReactionExecutor: component %q unsupported", name)
 }
+
+// PreviewReaction describes the request the supporting handler would send
+// Handlers without a preview mode report outbound.ErrPreviewUnsupported so nothing is ever sent
+func (c *CompositeReactionExecutor) PreviewReaction(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
+	component := link.Config.Component
+	for _, handler := range c.handlers {
+		if handler == nil || !handler.Supports(component) {
+			continue
+		}
+		previewer, ok := handler.(ComponentReactionPreviewer)
+		if !ok {
+			return outbound.ReactionResult{}, outbound.ErrPreviewUnsupported
+		}
+		return previewer.Preview(ctx, area, link)
+	}
+	if previewer, ok := c.fallback.(outbound.ReactionPreviewer); ok {
+		return previewer.PreviewReaction(ctx, area, link)
+	}
+	return outbound.ReactionResult{}, outbound.ErrPreviewUnsupported
+}
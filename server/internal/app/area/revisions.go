@@ -0,0 +1,347 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+const (
+	revisionListDefaultLimit = 50
+	revisionListMaxLimit     = 200
+)
+
+// RevisionChange describes one field that differs between two revisions
+// Path uses dotted notation such as reactions[0].params.to
+type RevisionChange struct {
+	Path   string
+	Before any
+	After  any
+}
+
+// recordRevision stores a snapshot of area and stamps the resulting number on it
+// It is a no-op when no revision repository is configured
+func (s *Service) recordRevision(ctx context.Context, authorID uuid.UUID, area *areadomain.Area, reason areadomain.RevisionReason) error {
+	if s.revisions == nil {
+		return nil
+	}
+	revision, err := s.revisions.Create(ctx, s.newRevision(authorID, *area, reason))
+	if err != nil {
+		return fmt.Errorf("revisions.Create: %w", err)
+	}
+	area.Revision = revision.Number
+	return nil
+}
+
+// commitChange stores the change along with the revision snapshotting area in one transaction
+// area must already carry the change, the changes are written directly when no revision repository is configured
+func (s *Service) commitChange(ctx context.Context, authorID uuid.UUID, change outbound.AreaChange, area *areadomain.Area, reason areadomain.RevisionReason) error {
+	if s.revisions == nil {
+		return s.applyChange(ctx, change)
+	}
+	revision, err := s.revisions.Commit(ctx, change, s.newRevision(authorID, *area, reason))
+	if err != nil {
+		return fmt.Errorf("revisions.Commit: %w", err)
+	}
+	area.Revision = revision.Number
+	return nil
+}
+
+func (s *Service) applyChange(ctx context.Context, change outbound.AreaChange) error {
+	if change.Ownership {
+		if err := s.repo.UpdateOwnership(ctx, change.Area); err != nil {
+			return fmt.Errorf("repo.UpdateOwnership: %w", err)
+		}
+	}
+	if change.Metadata {
+		if err := s.repo.UpdateMetadata(ctx, change.Area); err != nil {
+			return fmt.Errorf("repo.UpdateMetadata: %w", err)
+		}
+	}
+	for _, config := range change.Configs {
+		if err := s.repo.UpdateConfig(ctx, config); err != nil {
+			return fmt.Errorf("repo.UpdateConfig: %w", err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) newRevision(authorID uuid.UUID, area areadomain.Area, reason areadomain.RevisionReason) areadomain.Revision {
+	return areadomain.Revision{
+		AreaID:    area.ID,
+		AuthorID:  authorID,
+		Reason:    reason,
+		Snapshot:  area.Snapshot(),
+		CreatedAt: s.clock.Now().UTC(),
+	}
+}
+
+// ListRevisions returns the revisions of an automation owned by the user, newest first
+func (s *Service) ListRevisions(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, limit int) ([]areadomain.Revision, error) {
+	if s.revisions == nil {
+		return nil, fmt.Errorf("area.Service.ListRevisions: revision repository unavailable")
+	}
+	if err := s.ensureViewer(ctx, userID, areaID); err != nil {
+		return nil, fmt.Errorf("area.Service.ListRevisions: %w", err)
+	}
+	if limit <= 0 {
+		limit = revisionListDefaultLimit
+	}
+	if limit > revisionListMaxLimit {
+		limit = revisionListMaxLimit
+	}
+	revisions, err := s.revisions.ListByArea(ctx, areaID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("area.Service.ListRevisions: revisions.ListByArea: %w", err)
+	}
+	return revisions, nil
+}
+
+// DiffRevisions lists the fields that changed between two revisions of an automation
+func (s *Service) DiffRevisions(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, from int, to int) ([]RevisionChange, error) {
+	if s.revisions == nil {
+		return nil, fmt.Errorf("area.Service.DiffRevisions: revision repository unavailable")
+	}
+	if err := s.ensureViewer(ctx, userID, areaID); err != nil {
+		return nil, fmt.Errorf("area.Service.DiffRevisions: %w", err)
+	}
+	before, err := s.findRevision(ctx, areaID, from)
+	if err != nil {
+		return nil, fmt.Errorf("area.Service.DiffRevisions: %w", err)
+	}
+	after, err := s.findRevision(ctx, areaID, to)
+	if err != nil {
+		return nil, fmt.Errorf("area.Service.DiffRevisions: %w", err)
+	}
+	return diffSnapshots(before.Snapshot, after.Snapshot), nil
+}
+
+// Rollback restores the name, description, status and params stored in a revision
+// The rollback itself is recorded as a new revision so it can be undone too
+func (s *Service) Rollback(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, number int) (areadomain.Area, error) {
+	if s.revisions == nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Rollback: revision repository unavailable")
+	}
+	if s.repo == nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Rollback: repository unavailable")
+	}
+	area, err := s.repo.FindByID(ctx, areaID)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Rollback: repo.FindByID: %w", err)
+	}
+	if err := s.authorizeArea(ctx, userID, area, workspacedomain.RoleEditor); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Rollback: %w", err)
+	}
+	enriched, err := s.populateComponents(ctx, []areadomain.Area{area})
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Rollback: populateComponents: %w", err)
+	}
+	if len(enriched) == 0 {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Rollback: enrichment failed")
+	}
+	area = enriched[0]
+	components := make(map[uuid.UUID]*componentdomain.Component)
+	if area.Action != nil {
+		components[area.Action.Config.ID] = area.Action.Config.Component
+	}
+	for _, reaction := range area.Reactions {
+		components[reaction.Config.ID] = reaction.Config.Component
+	}
+
+	revision, err := s.findRevision(ctx, areaID, number)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Rollback: %w", err)
+	}
+
+	snapshot := revision.Snapshot
+	name := snapshot.Name
+	cmd := UpdateAreaCommand{
+		Name:           &name,
+		Description:    snapshot.Description,
+		DescriptionSet: true,
+	}
+	for _, link := range snapshot.Links {
+		linkName := link.Name
+		// Secrets sealed at the time of the revision are opened and sealed again by the update
+		params, err := s.openStoredParams(link.Params)
+		if err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.Rollback: %w", err)
+		}
+		// The runner may have changed since the revision, its accounts are the only ones the area may use
+		if component := components[link.ConfigID]; component != nil {
+			if err := s.checkIdentityParams(ctx, area.UserID, *component, params); err != nil {
+				return areadomain.Area{}, fmt.Errorf("area.Service.Rollback: %w", err)
+			}
+		}
+		switch link.Role {
+		case areadomain.LinkRoleAction:
+			cmd.Action = &UpdateActionCommand{
+				ConfigID:  link.ConfigID,
+				Name:      &linkName,
+				NameSet:   true,
+				Params:    params,
+				ParamsSet: true,
+			}
+		case areadomain.LinkRoleReaction:
+			cmd.Reactions = append(cmd.Reactions, UpdateReactionCommand{
+				ConfigID:  link.ConfigID,
+				Name:      &linkName,
+				NameSet:   true,
+				Params:    params,
+				ParamsSet: true,
+			})
+		}
+	}
+	status := snapshot.Status
+
+	restored, err := s.update(ctx, userID, areaID, cmd, &status, areadomain.RevisionReasonRollback)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Rollback: %w", err)
+	}
+	return restored, nil
+}
+
+// checkIdentityParams rejects identity params referencing an account not linked by the runner
+func (s *Service) checkIdentityParams(ctx context.Context, runnerID uuid.UUID, component componentdomain.Component, params map[string]any) error {
+	specs, err := extractParameterSpecs(component.Metadata)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrComponentParamsInvalid, err)
+	}
+	for _, spec := range specs {
+		if spec.Type != parameterTypeIdentity {
+			continue
+		}
+		raw, _ := params[spec.Key].(string)
+		if strings.TrimSpace(raw) == "" {
+			continue
+		}
+		if s.identities == nil {
+			return fmt.Errorf("%w: identity repository unavailable", ErrComponentParamsInvalid)
+		}
+		identityID, err := uuid.Parse(strings.TrimSpace(raw))
+		if err != nil {
+			return fmt.Errorf("%w: parameter %q is not an identity", ErrComponentParamsInvalid, spec.Key)
+		}
+		identity, err := s.identities.FindByID(ctx, identityID)
+		if err != nil {
+			if errors.Is(err, outbound.ErrNotFound) {
+				return fmt.Errorf("%w: parameter %q references an unlinked account", ErrComponentParamsInvalid, spec.Key)
+			}
+			return fmt.Errorf("identities.FindByID: %w", err)
+		}
+		if identity.UserID != runnerID {
+			return fmt.Errorf("%w: parameter %q references an account of another user", ErrComponentParamsInvalid, spec.Key)
+		}
+	}
+	return nil
+}
+
+func (s *Service) ensureViewer(ctx context.Context, userID uuid.UUID, areaID uuid.UUID) error {
+	if s.repo == nil {
+		return fmt.Errorf("repository unavailable")
+	}
+	area, err := s.repo.FindByID(ctx, areaID)
+	if err != nil {
+		return fmt.Errorf("repo.FindByID: %w", err)
+	}
+	return s.authorizeArea(ctx, userID, area, workspacedomain.RoleViewer)
+}
+
+func (s *Service) findRevision(ctx context.Context, areaID uuid.UUID, number int) (areadomain.Revision, error) {
+	revision, err := s.revisions.FindByNumber(ctx, areaID, number)
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return areadomain.Revision{}, fmt.Errorf("%w: %d", ErrRevisionNotFound, number)
+		}
+		return areadomain.Revision{}, fmt.Errorf("revisions.FindByNumber: %w", err)
+	}
+	return revision, nil
+}
+
+func diffSnapshots(before areadomain.Snapshot, after areadomain.Snapshot) []RevisionChange {
+	changes := make([]RevisionChange, 0)
+	if before.Name != after.Name {
+		changes = append(changes, RevisionChange{Path: "name", Before: before.Name, After: after.Name})
+	}
+	if !stringPointersEqual(before.Description, after.Description) {
+		changes = append(changes, RevisionChange{Path: "description", Before: optionalString(before.Description), After: optionalString(after.Description)})
+	}
+	if before.Status != after.Status {
+		changes = append(changes, RevisionChange{Path: "status", Before: string(before.Status), After: string(after.Status)})
+	}
+
+	beforeLinks := snapshotLinksByConfig(before.Links)
+	afterLinks := snapshotLinksByConfig(after.Links)
+	for _, link := range after.Links {
+		previous, ok := beforeLinks[link.ConfigID]
+		if !ok {
+			changes = append(changes, RevisionChange{Path: snapshotLinkPath(link), After: link.Params})
+			continue
+		}
+		prefix := snapshotLinkPath(link)
+		if previous.Name != link.Name {
+			changes = append(changes, RevisionChange{Path: prefix + ".name", Before: previous.Name, After: link.Name})
+		}
+		for _, key := range paramKeys(previous.Params, link.Params) {
+			oldValue, hadOld := previous.Params[key]
+			newValue, hasNew := link.Params[key]
+			if hadOld == hasNew && reflect.DeepEqual(oldValue, newValue) {
+				continue
+			}
+			changes = append(changes, RevisionChange{Path: prefix + ".params." + key, Before: oldValue, After: newValue})
+		}
+	}
+	for _, link := range before.Links {
+		if _, ok := afterLinks[link.ConfigID]; !ok {
+			changes = append(changes, RevisionChange{Path: snapshotLinkPath(link), Before: link.Params})
+		}
+	}
+	return changes
+}
+
+func snapshotLinksByConfig(links []areadomain.SnapshotLink) map[uuid.UUID]areadomain.SnapshotLink {
+	result := make(map[uuid.UUID]areadomain.SnapshotLink, len(links))
+	for _, link := range links {
+		result[link.ConfigID] = link
+	}
+	return result
+}
+
+func snapshotLinkPath(link areadomain.SnapshotLink) string {
+	if link.Role == areadomain.LinkRoleAction {
+		return "action"
+	}
+	return fmt.Sprintf("reactions[%d]", link.Position-1)
+}
+
+func paramKeys(a map[string]any, b map[string]any) []string {
+	keys := make([]string, 0, len(a)+len(b))
+	seen := make(map[string]struct{}, len(a)+len(b))
+	for _, source := range []map[string]any{a, b} {
+		for key := range source {
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func optionalString(value *string) any {
+	if value == nil {
+		return nil
+	}
+	return *value
+}
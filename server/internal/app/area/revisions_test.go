@@ -0,0 +1,252 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+type memoryRevisionRepo struct {
+	items map[uuid.UUID][]areadomain.Revision
+	areas *memoryAreaRepo
+	err   error
+}
+
+func (m *memoryRevisionRepo) Create(ctx context.Context, revision areadomain.Revision) (areadomain.Revision, error) {
+	if m.items == nil {
+		m.items = map[uuid.UUID][]areadomain.Revision{}
+	}
+	revision.ID = uuid.New()
+	revision.Number = len(m.items[revision.AreaID]) + 1
+	m.items[revision.AreaID] = append(m.items[revision.AreaID], revision)
+	return revision, nil
+}
+
+func (m *memoryRevisionRepo) Commit(ctx context.Context, change outbound.AreaChange, revision areadomain.Revision) (areadomain.Revision, error) {
+	if m.err != nil {
+		return areadomain.Revision{}, m.err
+	}
+	if change.Ownership {
+		if err := m.areas.UpdateOwnership(ctx, change.Area); err != nil {
+			return areadomain.Revision{}, err
+		}
+	}
+	if change.Metadata {
+		if err := m.areas.UpdateMetadata(ctx, change.Area); err != nil {
+			return areadomain.Revision{}, err
+		}
+	}
+	for _, config := range change.Configs {
+		if err := m.areas.UpdateConfig(ctx, config); err != nil {
+			return areadomain.Revision{}, err
+		}
+	}
+	stored, err := m.Create(ctx, revision)
+	if err != nil {
+		return areadomain.Revision{}, err
+	}
+	area := m.areas.items[revision.AreaID]
+	area.Revision = stored.Number
+	m.areas.items[revision.AreaID] = area
+	return stored, nil
+}
+
+func (m *memoryRevisionRepo) ListByArea(ctx context.Context, areaID uuid.UUID, limit int) ([]areadomain.Revision, error) {
+	items := append([]areadomain.Revision(nil), m.items[areaID]...)
+	sort.Slice(items, func(i, j int) bool { return items[i].Number > items[j].Number })
+	if limit > 0 && len(items) > limit {
+		items = items[:limit]
+	}
+	return items, nil
+}
+
+func (m *memoryRevisionRepo) FindByNumber(ctx context.Context, areaID uuid.UUID, number int) (areadomain.Revision, error) {
+	for _, revision := range m.items[areaID] {
+		if revision.Number == number {
+			return revision, nil
+		}
+	}
+	return areadomain.Revision{}, outbound.ErrNotFound
+}
+
+func newRevisionTestService(t *testing.T) (*Service, *memoryRevisionRepo, uuid.UUID, areadomain.Area) {
+	t.Helper()
+	action := componentdomain.Component{ID: uuid.New(), ProviderID: uuid.New(), Kind: componentdomain.KindAction, Name: "timer_interval", Enabled: true}
+	reaction := componentdomain.Component{ID: uuid.New(), ProviderID: uuid.New(), Kind: componentdomain.KindReaction, Name: "gmail_send_email", Enabled: true}
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{action.ID: action, reaction.ID: reaction}}
+	areas := &memoryAreaRepo{}
+	revisions := &memoryRevisionRepo{areas: areas}
+	svc := NewService(areas, components, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil,
+		WithRevisionRepository(revisions))
+
+	userID := uuid.New()
+	created, err := svc.Create(context.Background(), userID, "Digest", "", ActionInput{
+		ComponentID: action.ID,
+		Params:      map[string]any{"frequencyValue": 1.0},
+	}, []ReactionInput{{
+		ComponentID: reaction.ID,
+		Params:      map[string]any{"to": "a@example.com"},
+	}})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	return svc, revisions, userID, created
+}
+
+func TestServiceRecordsRevisions(t *testing.T) {
+	ctx := context.Background()
+	svc, revisions, userID, created := newRevisionTestService(t)
+	if created.Revision != 1 {
+		t.Fatalf("expected revision 1 after create got %d", created.Revision)
+	}
+
+	name := "Weekly digest"
+	updated, err := svc.Update(ctx, userID, created.ID, UpdateAreaCommand{Name: &name})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	if updated.Revision != 2 {
+		t.Fatalf("expected revision 2 after update got %d", updated.Revision)
+	}
+	if _, err := svc.UpdateStatus(ctx, userID, created.ID, areadomain.StatusDisabled); err != nil {
+		t.Fatalf("UpdateStatus returned error: %v", err)
+	}
+
+	listed, err := svc.ListRevisions(ctx, userID, created.ID, 0)
+	if err != nil {
+		t.Fatalf("ListRevisions returned error: %v", err)
+	}
+	reasons := make([]areadomain.RevisionReason, 0, len(listed))
+	for _, revision := range listed {
+		if revision.AuthorID != userID {
+			t.Fatalf("unexpected author %s", revision.AuthorID)
+		}
+		reasons = append(reasons, revision.Reason)
+	}
+	want := []areadomain.RevisionReason{areadomain.RevisionReasonStatus, areadomain.RevisionReasonUpdated, areadomain.RevisionReasonCreated}
+	if len(reasons) != len(want) || reasons[0] != want[0] || reasons[1] != want[1] || reasons[2] != want[2] {
+		t.Fatalf("unexpected revision reasons %v", reasons)
+	}
+	if got := revisions.items[created.ID][2].Snapshot.Status; got != areadomain.StatusDisabled {
+		t.Fatalf("expected status snapshot to be disabled got %s", got)
+	}
+
+	if _, err := svc.ListRevisions(ctx, uuid.New(), created.ID, 0); !errors.Is(err, ErrAreaNotOwned) {
+		t.Fatalf("expected ErrAreaNotOwned got %v", err)
+	}
+}
+
+func TestServiceDiffAndRollbackRevisions(t *testing.T) {
+	ctx := context.Background()
+	svc, _, userID, created := newRevisionTestService(t)
+	reactionConfig := created.Reactions[0].Config.ID
+
+	name := "Renamed"
+	_, err := svc.Update(ctx, userID, created.ID, UpdateAreaCommand{
+		Name: &name,
+		Reactions: []UpdateReactionCommand{{
+			ConfigID:  reactionConfig,
+			Params:    map[string]any{"to": "b@example.com", "subject": "hi"},
+			ParamsSet: true,
+		}},
+	})
+	if err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+
+	changes, err := svc.DiffRevisions(ctx, userID, created.ID, 1, 2)
+	if err != nil {
+		t.Fatalf("DiffRevisions returned error: %v", err)
+	}
+	paths := make([]string, 0, len(changes))
+	for _, change := range changes {
+		paths = append(paths, change.Path)
+	}
+	want := []string{"name", "reactions[0].params.subject", "reactions[0].params.to"}
+	if len(paths) != len(want) || paths[0] != want[0] || paths[1] != want[1] || paths[2] != want[2] {
+		t.Fatalf("unexpected diff paths %v", paths)
+	}
+	if changes[2].Before != "a@example.com" || changes[2].After != "b@example.com" {
+		t.Fatalf("unexpected change %+v", changes[2])
+	}
+	if changes[1].Before != nil {
+		t.Fatalf("added param should have no previous value got %+v", changes[1])
+	}
+
+	restored, err := svc.Rollback(ctx, userID, created.ID, 1)
+	if err != nil {
+		t.Fatalf("Rollback returned error: %v", err)
+	}
+	if restored.Name != "Digest" || restored.Revision != 3 {
+		t.Fatalf("unexpected restored area %+v", restored)
+	}
+	if params := restored.Reactions[0].Config.Params; params["to"] != "a@example.com" || len(params) != 1 {
+		t.Fatalf("reaction params not restored: %+v", params)
+	}
+
+	if _, err := svc.Rollback(ctx, userID, created.ID, 1); !errors.Is(err, ErrAreaUpdateNoChanges) {
+		t.Fatalf("expected ErrAreaUpdateNoChanges got %v", err)
+	}
+	if _, err := svc.Rollback(ctx, userID, created.ID, 9); !errors.Is(err, ErrRevisionNotFound) {
+		t.Fatalf("expected ErrRevisionNotFound got %v", err)
+	}
+}
+
+func TestServiceCommitsChangesWithTheirRevision(t *testing.T) {
+	ctx := context.Background()
+	svc, revisions, userID, created := newRevisionTestService(t)
+
+	revisions.err = errors.New("revision store down")
+	name := "Weekly digest"
+	if _, err := svc.Update(ctx, userID, created.ID, UpdateAreaCommand{Name: &name}); err == nil {
+		t.Fatalf("expected Update to fail when the revision cannot be stored")
+	}
+	if _, err := svc.UpdateStatus(ctx, userID, created.ID, areadomain.StatusDisabled); err == nil {
+		t.Fatalf("expected UpdateStatus to fail when the revision cannot be stored")
+	}
+	stored, err := svc.Get(ctx, userID, created.ID)
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if stored.Name != "Digest" || stored.Status != areadomain.StatusEnabled {
+		t.Fatalf("expected the change left unapplied, got %q %s", stored.Name, stored.Status)
+	}
+	if len(revisions.items[created.ID]) != 1 {
+		t.Fatalf("expected only the creation revision, got %d", len(revisions.items[created.ID]))
+	}
+	revisions.err = nil
+
+	if _, err := svc.UpdateStatus(ctx, userID, created.ID, areadomain.StatusDisabled); err != nil {
+		t.Fatalf("UpdateStatus returned error: %v", err)
+	}
+	duplicate, err := svc.Duplicate(ctx, userID, created.ID, DuplicateOptions{})
+	if err != nil {
+		t.Fatalf("Duplicate returned error: %v", err)
+	}
+	history := revisions.items[duplicate.ID]
+	if duplicate.Status != areadomain.StatusDisabled || duplicate.Revision != 1 || len(history) != 1 {
+		t.Fatalf("expected one revision for the disabled copy, got %d (status %s)", len(history), duplicate.Status)
+	}
+	if history[0].Reason != areadomain.RevisionReasonCreated || history[0].Snapshot.Status != areadomain.StatusDisabled {
+		t.Fatalf("unexpected duplicate revision %+v", history[0])
+	}
+}
+
+func TestBuildJobInputPayloadIncludesRevision(t *testing.T) {
+	area := areadomain.Area{ID: uuid.New(), UserID: uuid.New(), Revision: 4}
+	payload := buildJobInputPayload(area, areadomain.Link{}, nil)
+	if payload["revision"] != 4 {
+		t.Fatalf("expected revision 4 got %v", payload["revision"])
+	}
+	area.Revision = 0
+	if _, ok := buildJobInputPayload(area, areadomain.Link{}, nil)["revision"]; ok {
+		t.Fatalf("revision should be omitted when unknown")
+	}
+}
@@ -0,0 +1,88 @@
+package area
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/google/uuid"
+)
+
+const scheduleMaxWindows = 28
+
+// ActivityChecker reports whether an area reacts to events at a given time
+type ActivityChecker interface {
+	ActivityAt(ctx context.Context, areaID uuid.UUID, at time.Time) (bool, string, error)
+}
+
+// SetActivitySchedule restricts when an automation reacts to events, nil keeps it always active
+func (s *Service) SetActivitySchedule(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, schedule *areadomain.ActivitySchedule) (areadomain.Area, error) {
+	if s.repo == nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetActivitySchedule: repository unavailable")
+	}
+	if schedule != nil {
+		if err := validateActivitySchedule(*schedule); err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.SetActivitySchedule: %w", err)
+		}
+	}
+	if err := s.ensureEditor(ctx, userID, areaID); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetActivitySchedule: %w", err)
+	}
+	if err := s.repo.UpdateActivitySchedule(ctx, areaID, schedule); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetActivitySchedule: repo.UpdateActivitySchedule: %w", err)
+	}
+	return s.Get(ctx, userID, areaID)
+}
+
+// ActivityAt reports whether the automation reacts to events occurring at the provided time along with the reason when it does not
+func (s *Service) ActivityAt(ctx context.Context, areaID uuid.UUID, at time.Time) (bool, string, error) {
+	if s.repo == nil {
+		return false, "", fmt.Errorf("area.Service.ActivityAt: repository unavailable")
+	}
+	area, err := s.repo.FindByID(ctx, areaID)
+	if err != nil {
+		return false, "", fmt.Errorf("area.Service.ActivityAt: repo.FindByID: %w", err)
+	}
+	active, reason := activeAt(area, at)
+	return active, reason, nil
+}
+
+func activeAt(area areadomain.Area, at time.Time) (bool, string) {
+	if area.ActivitySchedule == nil {
+		return true, ""
+	}
+	return area.ActivitySchedule.Active(at)
+}
+
+func validateActivitySchedule(schedule areadomain.ActivitySchedule) error {
+	if schedule.TimeZone != "" {
+		if _, err := time.LoadLocation(schedule.TimeZone); err != nil {
+			return fmt.Errorf("%w: unknown time zone %q", ErrActivityScheduleInvalid, schedule.TimeZone)
+		}
+	}
+	if schedule.StartsAt != nil && schedule.EndsAt != nil && !schedule.StartsAt.Before(*schedule.EndsAt) {
+		return fmt.Errorf("%w: start date must be before end date", ErrActivityScheduleInvalid)
+	}
+	if len(schedule.Windows) > scheduleMaxWindows {
+		return fmt.Errorf("%w: at most %d windows are allowed", ErrActivityScheduleInvalid, scheduleMaxWindows)
+	}
+	for _, window := range schedule.Windows {
+		if window.Start < 0 || window.Start >= areadomain.MinutesPerDay || window.End < 0 || window.End >= areadomain.MinutesPerDay {
+			return fmt.Errorf("%w: window bounds must be between 00:00 and 23:59", ErrActivityScheduleInvalid)
+		}
+		for _, day := range window.Days {
+			if day < time.Sunday || day > time.Saturday {
+				return fmt.Errorf("%w: unknown weekday %d", ErrActivityScheduleInvalid, day)
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Service) occurredAt(at time.Time) time.Time {
+	if at.IsZero() {
+		return s.clock.Now().UTC()
+	}
+	return at.UTC()
+}
@@ -0,0 +1,210 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/google/uuid"
+)
+
+type stubActivityChecker struct {
+	active bool
+	reason string
+	areas  []uuid.UUID
+}
+
+func (s *stubActivityChecker) ActivityAt(ctx context.Context, areaID uuid.UUID, at time.Time) (bool, string, error) {
+	s.areas = append(s.areas, areaID)
+	return s.active, s.reason, nil
+}
+
+func newScheduledArea(userID uuid.UUID) areadomain.Area {
+	return areadomain.Area{
+		ID:     uuid.New(),
+		UserID: userID,
+		Name:   "Office hours",
+		Status: areadomain.StatusEnabled,
+		Action: &areadomain.Link{ID: uuid.New(), Role: areadomain.LinkRoleAction, Config: componentdomain.Config{ID: uuid.New()}},
+		Reactions: []areadomain.Link{
+			{ID: uuid.New(), Role: areadomain.LinkRoleReaction, Config: componentdomain.Config{ID: uuid.New()}},
+		},
+	}
+}
+
+func TestServiceSetActivityScheduleValidates(t *testing.T) {
+	ctx := context.Background()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	svc := NewService(repo, &memoryComponentRepo{}, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil)
+	area := newScheduledArea(uuid.New())
+	repo.items[area.ID] = area
+
+	startsAt := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	endsAt := startsAt.Add(-time.Hour)
+	invalid := []areadomain.ActivitySchedule{
+		{TimeZone: "Mars/Olympus"},
+		{StartsAt: &startsAt, EndsAt: &endsAt},
+		{Windows: []areadomain.ActivityWindow{{Start: -1, End: 60}}},
+		{Windows: []areadomain.ActivityWindow{{Start: 0, End: areadomain.MinutesPerDay}}},
+		{Windows: []areadomain.ActivityWindow{{Days: []time.Weekday{7}, Start: 0, End: 60}}},
+	}
+	for _, schedule := range invalid {
+		schedule := schedule
+		if _, err := svc.SetActivitySchedule(ctx, area.UserID, area.ID, &schedule); !errors.Is(err, ErrActivityScheduleInvalid) {
+			t.Fatalf("expected ErrActivityScheduleInvalid for %+v got %v", schedule, err)
+		}
+	}
+
+	schedule := &areadomain.ActivitySchedule{
+		TimeZone: "Europe/Paris",
+		Windows:  []areadomain.ActivityWindow{{Days: []time.Weekday{time.Monday}, Start: 9 * 60, End: 18 * 60}},
+	}
+	if _, err := svc.SetActivitySchedule(ctx, uuid.New(), area.ID, schedule); !errors.Is(err, ErrAreaNotOwned) {
+		t.Fatalf("expected ErrAreaNotOwned got %v", err)
+	}
+	updated, err := svc.SetActivitySchedule(ctx, area.UserID, area.ID, schedule)
+	if err != nil {
+		t.Fatalf("SetActivitySchedule returned error: %v", err)
+	}
+	if updated.ActivitySchedule == nil || updated.ActivitySchedule.TimeZone != "Europe/Paris" {
+		t.Fatalf("expected schedule stored, got %+v", updated.ActivitySchedule)
+	}
+}
+
+func TestServiceExecuteFiltersEventsOutsideSchedule(t *testing.T) {
+	ctx := context.Background()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	executions := &fakeExecutionRepository{}
+	queue := &recordingQueue{}
+	clock := stubClock{now: time.Date(2024, 5, 4, 10, 0, 0, 0, time.UTC)}
+	quotas := &stubQuotas{executionReason: "limit of 200 executions per hour reached"}
+	svc := NewService(repo, &memoryComponentRepo{}, allowAllSubscriptions{}, nil, NewExecutionPipeline(executions, clock, queue), clock, nil, WithQuotas(quotas))
+
+	ownerID := uuid.New()
+	area := newScheduledArea(ownerID)
+	area.ActivitySchedule = &areadomain.ActivitySchedule{
+		TimeZone: "Europe/Paris",
+		Windows: []areadomain.ActivityWindow{{
+			Days:  []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday},
+			Start: 9 * 60,
+			End:   18 * 60,
+		}},
+	}
+	repo.items[area.ID] = area
+
+	// Saturday 4 May 2024 is outside the weekday window and must not reach the quota check
+	if err := svc.ExecuteWithOptions(ctx, ownerID, area.ID, ExecutionOptions{SourceID: uuid.New()}); err != nil {
+		t.Fatalf("ExecuteWithOptions returned error: %v", err)
+	}
+	if len(quotas.users) != 0 {
+		t.Fatalf("expected no quota check outside the schedule")
+	}
+	if len(executions.triggers) != 1 {
+		t.Fatalf("expected the filtered trigger recorded, got %d", len(executions.triggers))
+	}
+	trigger := executions.triggers[0]
+	reason, _ := trigger.MatchInfo["rejection_reason"].(string)
+	if trigger.Status != actiondomain.TriggerStatusFiltered || !strings.HasPrefix(reason, "outside activity windows") {
+		t.Fatalf("unexpected trigger %+v", trigger)
+	}
+	if len(executions.jobs) != 0 || len(queue.messages) != 0 {
+		t.Fatalf("expected no jobs outside the schedule")
+	}
+
+	quotas.executionReason = ""
+	monday := time.Date(2024, 5, 6, 8, 30, 0, 0, time.UTC)
+	if err := svc.ExecuteWithOptions(ctx, ownerID, area.ID, ExecutionOptions{SourceID: uuid.New(), OccurredAt: monday}); err != nil {
+		t.Fatalf("ExecuteWithOptions returned error: %v", err)
+	}
+	if executions.triggers[1].Status != actiondomain.TriggerStatusMatched || len(executions.jobs) != 1 {
+		t.Fatalf("expected a matched trigger within the schedule")
+	}
+}
+
+func TestActivityScheduleWindowsSpanningMidnight(t *testing.T) {
+	schedule := areadomain.ActivitySchedule{
+		Windows: []areadomain.ActivityWindow{{Days: []time.Weekday{time.Friday}, Start: 22 * 60, End: 2 * 60}},
+	}
+	cases := []struct {
+		at     time.Time
+		active bool
+	}{
+		{time.Date(2024, 5, 3, 23, 0, 0, 0, time.UTC), true},
+		{time.Date(2024, 5, 4, 1, 59, 0, 0, time.UTC), true},
+		{time.Date(2024, 5, 4, 2, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 5, 4, 23, 0, 0, 0, time.UTC), false},
+		{time.Date(2024, 5, 3, 1, 0, 0, 0, time.UTC), false},
+	}
+	for _, tc := range cases {
+		if active, reason := schedule.Active(tc.at); active != tc.active {
+			t.Fatalf("Active(%s) = %v (%s), want %v", tc.at, active, reason, tc.active)
+		}
+	}
+
+	endsAt := time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)
+	expired := areadomain.ActivitySchedule{EndsAt: &endsAt}
+	if active, reason := expired.Active(endsAt); active || !strings.HasPrefix(reason, "area inactive since") {
+		t.Fatalf("expected expired schedule inactive, got %v %q", active, reason)
+	}
+}
+
+func TestPollingRunnerSkipsAreasOutsideSchedule(t *testing.T) {
+	now := time.Unix(1720000000, 0).UTC()
+	configID := uuid.New()
+	binding := actiondomain.PollingBinding{
+		Source: actiondomain.Source{
+			ID:                uuid.New(),
+			ComponentConfigID: configID,
+			Mode:              actiondomain.ModePolling,
+			Cursor:            map[string]any{"interval_seconds": 300},
+			IsActive:          true,
+		},
+		AreaID:  uuid.New(),
+		UserID:  uuid.New(),
+		NextRun: now,
+		Config:  componentdomain.Config{ID: configID, ComponentID: uuid.New()},
+	}
+	repo := &stubPollingSourceRepo{bindings: []actiondomain.PollingBinding{binding}}
+	handler := &recordingPollingHandler{supports: true}
+	executor := &recordingExecutor{}
+	checker := &stubActivityChecker{reason: "outside activity windows"}
+
+	runner := NewPollingRunner(repo, stubComponentRepo{}, executor, stubClock{now: now}, []ComponentPollingHandler{handler}, WithPollingActivityChecker(checker))
+	runner.process(context.Background())
+
+	if len(checker.areas) != 1 || checker.areas[0] != binding.AreaID {
+		t.Fatalf("expected activity checked for the area, got %v", checker.areas)
+	}
+	if len(handler.calls) != 0 {
+		t.Fatalf("expected no poll outside the schedule")
+	}
+	if len(executor.calls) != 1 || executor.calls[0].Payload["poll_skipped"] != true || !executor.calls[0].OccurredAt.Equal(now) {
+		t.Fatalf("expected the skipped poll handed to the pipeline, got %+v", executor.calls)
+	}
+	if len(repo.cursorUpdates) != 1 || repo.cursorUpdates[0]["interval_seconds"] != 300 {
+		t.Fatalf("expected cursor rescheduled, got %+v", repo.cursorUpdates)
+	}
+
+	binding.Source.Cursor = repo.cursorUpdates[0]
+	repo.bindings = []actiondomain.PollingBinding{binding}
+	runner.process(context.Background())
+	if len(executor.calls) != 1 {
+		t.Fatalf("expected one record per inactive window, got %d", len(executor.calls))
+	}
+	if _, ok := repo.cursorUpdates[1][pollSkippedSinceKey]; !ok {
+		t.Fatalf("expected the window start kept in the cursor, got %+v", repo.cursorUpdates[1])
+	}
+
+	checker.active = true
+	binding.Source.Cursor = repo.cursorUpdates[1]
+	repo.bindings = []actiondomain.PollingBinding{binding}
+	runner.process(context.Background())
+	if _, ok := repo.cursorUpdates[2][pollSkippedSinceKey]; ok {
+		t.Fatalf("expected the window start cleared once active, got %+v", repo.cursorUpdates[2])
+	}
+}
@@ -0,0 +1,146 @@
+package area
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+)
+
+// sealedParamPrefix marks param values encrypted before being stored
+const sealedParamPrefix = "sealed:v1:"
+
+// ParamSealer encrypts the secret params of component configurations at rest
+type ParamSealer interface {
+	Encrypt(value string) (string, error)
+	Decrypt(value string) (string, error)
+}
+
+// WithParamSecrets seals password params before they are stored
+func WithParamSecrets(secrets ParamSealer) ServiceOption {
+	return func(s *Service) {
+		s.secrets = secrets
+	}
+}
+
+// sealedParamGuards names the params deciding where and how sealed secrets are sent
+var sealedParamGuards = map[string]struct{}{
+	"authType": {}, "username": {}, "apiKeyName": {}, "apiKeyIn": {},
+}
+
+// sealSecretParams encrypts in place the password params of the component that are not sealed yet
+// Sealed values are only accepted when they are unchanged from previous, the params currently stored,
+// and the destination and authentication params are unchanged too,
+// so a sealed value cannot be replayed against an endpoint of the caller's choice
+func (s *Service) sealSecretParams(component componentdomain.Component, params map[string]any, previous map[string]any) error {
+	for key, raw := range params {
+		value, ok := raw.(string)
+		if !ok || !strings.HasPrefix(value, sealedParamPrefix) {
+			continue
+		}
+		if stored, _ := previous[key].(string); stored != value {
+			return fmt.Errorf("%w: parameter %q holds a sealed value", ErrComponentParamsInvalid, key)
+		}
+		if guard, changed := changedSealedParamGuard(params, previous); changed {
+			return fmt.Errorf("%w: parameter %q must be entered again when %q changes", ErrComponentParamsInvalid, key, guard)
+		}
+	}
+	if s.secrets == nil || len(params) == 0 {
+		return nil
+	}
+	specs, err := extractParameterSpecs(component.Metadata)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrComponentParamsInvalid, err)
+	}
+	for _, spec := range specs {
+		if spec.Type != parameterTypePassword {
+			continue
+		}
+		value, ok := params[spec.Key].(string)
+		if !ok || value == "" || strings.HasPrefix(value, sealedParamPrefix) {
+			continue
+		}
+		sealed, err := s.secrets.Encrypt(value)
+		if err != nil {
+			return fmt.Errorf("seal param %q: %w", spec.Key, err)
+		}
+		params[spec.Key] = sealedParamPrefix + sealed
+	}
+	return nil
+}
+
+// changedSealedParamGuard reports the first destination or authentication param differing from previous
+// URL and host params count as destinations whatever their name
+func changedSealedParamGuard(params map[string]any, previous map[string]any) (string, bool) {
+	keys := make([]string, 0, len(params)+len(previous))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	for key := range previous {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if !isSealedParamGuard(key) {
+			continue
+		}
+		if fmt.Sprint(params[key]) != fmt.Sprint(previous[key]) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+func isSealedParamGuard(key string) bool {
+	if _, ok := sealedParamGuards[key]; ok {
+		return true
+	}
+	lower := strings.ToLower(key)
+	return strings.HasSuffix(lower, "url") || strings.HasSuffix(lower, "host")
+}
+
+// openStoredParams decrypts every sealed value of params already stored for the caller's automations
+func (s *Service) openStoredParams(params map[string]any) (map[string]any, error) {
+	opened := cloneParamsMap(params)
+	for key := range opened {
+		if err := openSealedParam(s.secrets, opened, key); err != nil {
+			return nil, err
+		}
+	}
+	return opened, nil
+}
+
+// openSecretParams returns a copy of params with the sealed password params of the component decrypted
+func openSecretParams(secrets ParamSealer, component componentdomain.Component, params map[string]any) (map[string]any, error) {
+	opened := cloneMapAny(params)
+	specs, err := extractParameterSpecs(component.Metadata)
+	if err != nil {
+		return nil, fmt.Errorf("parameter specs: %w", err)
+	}
+	for _, spec := range specs {
+		if spec.Type != parameterTypePassword {
+			continue
+		}
+		if err := openSealedParam(secrets, opened, spec.Key); err != nil {
+			return nil, err
+		}
+	}
+	return opened, nil
+}
+
+func openSealedParam(secrets ParamSealer, params map[string]any, key string) error {
+	value, ok := params[key].(string)
+	if !ok || !strings.HasPrefix(value, sealedParamPrefix) {
+		return nil
+	}
+	if secrets == nil {
+		return fmt.Errorf("param %q is sealed but no secrets are configured", key)
+	}
+	plain, err := secrets.Decrypt(strings.TrimPrefix(value, sealedParamPrefix))
+	if err != nil {
+		return fmt.Errorf("open param %q: %w", key, err)
+	}
+	params[key] = plain
+	return nil
+}
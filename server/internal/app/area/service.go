@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
 	"reflect"
 	"strings"
 	"time"
@@ -14,7 +15,9 @@ import (
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
 	subscriptiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/subscription"
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
 	"github.com/google/uuid"
 )
 
@@ -36,6 +39,124 @@ type Service struct {
 	pipeline      ExecutionPipeline
 	clock         Clock
 	provisioner   ActionProvisioner
+	pollers       []ComponentPollingHandler
+	webhookBase   string
+	identities    identityport.Repository
+	revisions     outbound.AreaRevisionRepository
+	previewer     outbound.ReactionPreviewer
+	examples      outbound.ComponentExampleRepository
+	templates     outbound.AreaTemplateRepository
+	catalog       ComponentCatalog
+	workspaces    outbound.WorkspaceRepository
+	tags          outbound.AreaTagRepository
+	folders       outbound.AreaFolderRepository
+	health        outbound.AreaHealthRepository
+	mailer        outbound.Mailer
+	users         outbound.UserRepository
+	quotas        QuotaChecker
+	secrets       ParamSealer
+}
+
+// ServiceOption customises optional Service collaborators
+type ServiceOption func(*Service)
+
+// WithPollingHandlers registers the polling handlers used to preview polling actions
+func WithPollingHandlers(handlers ...ComponentPollingHandler) ServiceOption {
+	return func(s *Service) {
+		s.pollers = append(s.pollers, handlers...)
+	}
+}
+
+// WithWebhookBaseURL sets the public base URL used to build the webhook URLs shown to users
+func WithWebhookBaseURL(baseURL string) ServiceOption {
+	return func(s *Service) {
+		s.webhookBase = strings.TrimSuffix(strings.TrimSpace(baseURL), "/")
+	}
+}
+
+// WithIdentityRepository lets imports bind identity parameters to the importing user's linked accounts
+func WithIdentityRepository(identities identityport.Repository) ServiceOption {
+	return func(s *Service) {
+		s.identities = identities
+	}
+}
+
+// WithRevisionRepository records a revision after every change so edits can be diffed and rolled back
+func WithRevisionRepository(revisions outbound.AreaRevisionRepository) ServiceOption {
+	return func(s *Service) {
+		s.revisions = revisions
+	}
+}
+
+// WithReactionPreviewer enables dry runs by describing reactions without sending them
+func WithReactionPreviewer(previewer outbound.ReactionPreviewer) ServiceOption {
+	return func(s *Service) {
+		s.previewer = previewer
+	}
+}
+
+// WithComponentExamples lets dry runs fall back to the sample event documented for the action
+func WithComponentExamples(examples outbound.ComponentExampleRepository) ServiceOption {
+	return func(s *Service) {
+		s.examples = examples
+	}
+}
+
+// WithTemplateRepository enables the template gallery
+func WithTemplateRepository(templates outbound.AreaTemplateRepository) ServiceOption {
+	return func(s *Service) {
+		s.templates = templates
+	}
+}
+
+// WithWorkspaceRepository lets workspace members access shared automations according to their role
+func WithWorkspaceRepository(workspaces outbound.WorkspaceRepository) ServiceOption {
+	return func(s *Service) {
+		s.workspaces = workspaces
+	}
+}
+
+// WithTagRepository enables user-defined tags and the bulk operations run on them
+func WithTagRepository(tags outbound.AreaTagRepository) ServiceOption {
+	return func(s *Service) {
+		s.tags = tags
+	}
+}
+
+// WithFolderRepository enables user-defined folders
+func WithFolderRepository(folders outbound.AreaFolderRepository) ServiceOption {
+	return func(s *Service) {
+		s.folders = folders
+	}
+}
+
+// WithHealthRepository enables the circuit breaker suspending areas whose reactions keep failing
+func WithHealthRepository(health outbound.AreaHealthRepository) ServiceOption {
+	return func(s *Service) {
+		s.health = health
+	}
+}
+
+// WithSuspensionMailer emails the member running an area when the circuit breaker suspends it
+func WithSuspensionMailer(mailer outbound.Mailer, users outbound.UserRepository) ServiceOption {
+	return func(s *Service) {
+		s.mailer = mailer
+		s.users = users
+	}
+}
+
+// WithQuotas enforces the plan limits of the member running each automation
+func WithQuotas(quotas QuotaChecker) ServiceOption {
+	return func(s *Service) {
+		s.quotas = quotas
+	}
+}
+
+// WithComponentCatalog hides templates relying on components the user cannot configure
+func WithComponentCatalog(catalog ComponentCatalog) ServiceOption {
+	return func(s *Service) {
+		s.catalog = catalog
+	}
 }
 
 // Validation errors returned by the service
@@ -56,9 +177,32 @@ var (
 	ErrWebhookNotFound             = errors.New("area: webhook source not found")
 	ErrWebhookSecretMissing        = errors.New("area: webhook secret missing")
 	ErrWebhookSecretInvalid        = errors.New("area: webhook secret invalid")
+	ErrWebhookEventIgnored         = errors.New("area: webhook event ignored")
+	ErrWebhookPayloadInvalid       = errors.New("area: webhook payload does not match schema")
 	ErrAreaUpdateNoChanges         = errors.New("area: no changes detected")
 	ErrAreaConfigNotFound          = errors.New("area: component config not found")
 	ErrAreaStatusInvalid           = errors.New("area: invalid status")
+	ErrPollingPreviewUnsupported   = errors.New("area: component cannot be previewed")
+	ErrPollingPreviewFailed        = errors.New("area: preview poll failed")
+	ErrAreaDocumentInvalid         = errors.New("area: document invalid")
+	ErrRevisionNotFound            = errors.New("area: revision not found")
+	ErrTemplateNotFound            = errors.New("area: template not found")
+	ErrWorkspaceRoleInsufficient   = errors.New("area: workspace role insufficient")
+	ErrAreaNotShared               = errors.New("area: area is not shared")
+	ErrRunnerInvalid               = errors.New("area: runner must be a workspace editor")
+	ErrAreaListInvalid             = errors.New("area: invalid list options")
+	ErrTagNotFound                 = errors.New("area: tag not found")
+	ErrTagNameInvalid              = errors.New("area: invalid tag name")
+	ErrTagColorInvalid             = errors.New("area: invalid tag color")
+	ErrTagExists                   = errors.New("area: tag already exists")
+	ErrFolderNotFound              = errors.New("area: folder not found")
+	ErrFolderNameInvalid           = errors.New("area: invalid folder name")
+	ErrFolderExists                = errors.New("area: folder already exists")
+	ErrBulkActionInvalid           = errors.New("area: invalid bulk action")
+	ErrHealthPolicyInvalid         = errors.New("area: invalid health policy")
+	ErrAreaQuotaExceeded           = errors.New("area: area quota exceeded")
+	ErrExecutionQuotaExceeded      = errors.New("area: execution quota exceeded")
+	ErrActivityScheduleInvalid     = errors.New("area: invalid activity schedule")
 )
 
 const (
@@ -72,11 +216,11 @@ type ActionProvisioner interface {
 }
 
 // NewService builds a Service bound to the provided repository
-func NewService(repo outbound.AreaRepository, components outbound.ComponentRepository, subscriptions outbound.SubscriptionRepository, sources outbound.ActionSourceRepository, pipeline ExecutionPipeline, clock Clock, provisioner ActionProvisioner) *Service {
+func NewService(repo outbound.AreaRepository, components outbound.ComponentRepository, subscriptions outbound.SubscriptionRepository, sources outbound.ActionSourceRepository, pipeline ExecutionPipeline, clock Clock, provisioner ActionProvisioner, opts ...ServiceOption) *Service {
 	if clock == nil {
 		clock = systemClock{}
 	}
-	return &Service{
+	service := &Service{
 		repo:          repo,
 		components:    components,
 		subscriptions: subscriptions,
@@ -85,6 +229,12 @@ func NewService(repo outbound.AreaRepository, components outbound.ComponentRepos
 		clock:         clock,
 		provisioner:   provisioner,
 	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(service)
+		}
+	}
+	return service
 }
 
 // ActionInput carries action configuration used when creating an AREA
@@ -99,6 +249,7 @@ type ReactionInput struct {
 	ComponentID uuid.UUID
 	Name        string
 	Params      map[string]any
+	RetryPolicy *areadomain.RetryPolicy
 }
 
 // UpdateAreaCommand carries optional fields that can be patched on an automation
@@ -144,6 +295,12 @@ type ExecutionOptions struct {
 
 // Create registers a new automation owned by the given user
 func (s *Service) Create(ctx context.Context, userID uuid.UUID, name string, description string, action ActionInput, reactions []ReactionInput) (areadomain.Area, error) {
+	return s.create(ctx, userID, userID, nil, name, description, areadomain.StatusEnabled, action, reactions)
+}
+
+// create registers an automation run by userID, shared in workspaceID when set, and attributes it to actorID
+// The area is stored with its initial status so the creation is its only revision
+func (s *Service) create(ctx context.Context, actorID uuid.UUID, userID uuid.UUID, workspaceID *uuid.UUID, name string, description string, status areadomain.Status, action ActionInput, reactions []ReactionInput) (areadomain.Area, error) {
 	if s.repo == nil {
 		return areadomain.Area{}, fmt.Errorf("area.Service.Create: repository unavailable")
 	}
@@ -188,6 +345,9 @@ func (s *Service) Create(ctx context.Context, userID uuid.UUID, name string, des
 	if len(reactions) == 0 {
 		return areadomain.Area{}, fmt.Errorf("area.Service.Create: %w", ErrReactionsRequired)
 	}
+	if err := s.checkAreaQuota(ctx, userID, len(reactions)); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Create: %w", err)
+	}
 
 	reactionIDs := make([]uuid.UUID, 0, len(reactions))
 	for _, reaction := range reactions {
@@ -219,22 +379,23 @@ func (s *Service) Create(ctx context.Context, userID uuid.UUID, name string, des
 		reactionModels = append(reactionModels, component)
 	}
 
-	params := action.Params
-	if params == nil {
-		params = map[string]any{}
-	}
+	params := cloneParamsMap(action.Params)
 	if err := s.validateComponentParams(component, params); err != nil {
 		return areadomain.Area{}, fmt.Errorf("area.Service.Create: %w", err)
 	}
+	if err := s.sealSecretParams(component, params, nil); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Create: %w", err)
+	}
 
 	now := s.clock.Now().UTC()
 	area := areadomain.Area{
-		ID:        uuid.New(),
-		UserID:    userID,
-		Name:      name,
-		Status:    areadomain.StatusEnabled,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:          uuid.New(),
+		UserID:      userID,
+		WorkspaceID: workspaceID,
+		Name:        name,
+		Status:      status,
+		CreatedAt:   now,
+		UpdatedAt:   now,
 	}
 	if desc != "" {
 		area = area.WithDescription(desc)
@@ -260,14 +421,14 @@ func (s *Service) Create(ctx context.Context, userID uuid.UUID, name string, des
 
 	reactionLinks := make([]areadomain.Link, 0, len(reactions))
 	for idx, input := range reactions {
-		params := input.Params
-		if params == nil {
-			params = map[string]any{}
-		}
+		params := cloneParamsMap(input.Params)
 		component := reactionModels[idx]
 		if err := s.validateComponentParams(component, params); err != nil {
 			return areadomain.Area{}, fmt.Errorf("area.Service.Create: %w", err)
 		}
+		if err := s.sealSecretParams(component, params, nil); err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.Create: %w", err)
+		}
 		reactionConfig := componentdomain.Config{
 			UserID:      userID,
 			ComponentID: component.ID,
@@ -278,11 +439,12 @@ func (s *Service) Create(ctx context.Context, userID uuid.UUID, name string, des
 			UpdatedAt:   now,
 		}
 		reactionLink := areadomain.Link{
-			Role:      areadomain.LinkRoleReaction,
-			Position:  idx + 1,
-			Config:    reactionConfig,
-			CreatedAt: now,
-			UpdatedAt: now,
+			Role:        areadomain.LinkRoleReaction,
+			Position:    idx + 1,
+			Config:      reactionConfig,
+			RetryPolicy: input.RetryPolicy,
+			CreatedAt:   now,
+			UpdatedAt:   now,
 		}
 		reactionLinks = append(reactionLinks, reactionLink)
 	}
@@ -291,6 +453,12 @@ func (s *Service) Create(ctx context.Context, userID uuid.UUID, name string, des
 	if err != nil {
 		return areadomain.Area{}, fmt.Errorf("area.Service.Create: repo.Create: %w", err)
 	}
+	if err := s.recordRevision(ctx, actorID, &stored, areadomain.RevisionReasonCreated); err != nil {
+		if cleanupErr := s.repo.Delete(ctx, stored.ID); cleanupErr != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.Create: %w (cleanup failed: %v)", err, cleanupErr)
+		}
+		return areadomain.Area{}, fmt.Errorf("area.Service.Create: %w", err)
+	}
 	if stored.Action != nil {
 		stored.Action.Config.Component = &component
 	}
@@ -321,6 +489,7 @@ func (s *Service) Execute(ctx context.Context, userID uuid.UUID, areaID uuid.UUI
 }
 
 // ExecuteWithOptions enqueues jobs for the specified area, allowing source overrides
+// Executions beyond the quota of the member running the area are recorded as filtered triggers
 func (s *Service) ExecuteWithOptions(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, opts ExecutionOptions) error {
 	if s.repo == nil {
 		return fmt.Errorf("area.Service.Execute: repository unavailable")
@@ -333,8 +502,10 @@ func (s *Service) ExecuteWithOptions(ctx context.Context, userID uuid.UUID, area
 	if err != nil {
 		return fmt.Errorf("area.Service.Execute: repo.FindByID: %w", err)
 	}
-	if !area.OwnedBy(userID) {
-		return fmt.Errorf("area.Service.Execute: %w", ErrAreaNotOwned)
+	if area.UserID != userID {
+		if err := s.authorizeArea(ctx, userID, area, workspacedomain.RoleEditor); err != nil {
+			return fmt.Errorf("area.Service.Execute: %w", err)
+		}
 	}
 	if area.Action == nil || len(area.Reactions) == 0 {
 		return fmt.Errorf("area.Service.Execute: %w", ErrAreaMisconfigured)
@@ -362,26 +533,61 @@ func (s *Service) ExecuteWithOptions(ctx context.Context, userID uuid.UUID, area
 		payload = map[string]any{}
 	}
 
+	// Events outside the activity schedule are filtered by the pipeline and never count against the quota
+	var rejection string
+	if active, _ := activeAt(area, s.occurredAt(opts.OccurredAt)); active {
+		rejection, err = s.executionRejection(ctx, area.UserID)
+		if err != nil {
+			return fmt.Errorf("area.Service.Execute: %w", err)
+		}
+	}
+
 	err = s.pipeline.Enqueue(ctx, ExecutionInput{
 		Area:        area,
 		SourceID:    sourceID,
 		Payload:     payload,
 		Fingerprint: opts.Fingerprint,
 		OccurredAt:  opts.OccurredAt,
+		Rejection:   rejection,
 	})
 	if err != nil {
 		return fmt.Errorf("area.Service.Execute: enqueue pipeline: %w", err)
 	}
+	if rejection != "" {
+		return fmt.Errorf("area.Service.Execute: %w: %s", ErrExecutionQuotaExceeded, rejection)
+	}
 	return nil
 }
 
+// WebhookRequest carries a webhook delivery as received by the HTTP layer
+type WebhookRequest struct {
+	Path        string
+	Secret      string
+	Headers     http.Header
+	Body        []byte
+	Payload     map[string]any
+	Fingerprint string
+	OccurredAt  time.Time
+}
+
 // ProcessWebhook ingests a webhook event for the specified path and secret
 func (s *Service) ProcessWebhook(ctx context.Context, path string, secret string, payload map[string]any, fingerprint string, occurredAt time.Time) error {
+	return s.ProcessWebhookRequest(ctx, WebhookRequest{
+		Path:        path,
+		Secret:      secret,
+		Payload:     payload,
+		Fingerprint: fingerprint,
+		OccurredAt:  occurredAt,
+	})
+}
+
+// ProcessWebhookRequest authenticates, filters and normalises a webhook delivery before executing the bound area
+func (s *Service) ProcessWebhookRequest(ctx context.Context, req WebhookRequest) error {
 	if s.sources == nil {
 		return fmt.Errorf("area.Service.ProcessWebhook: source repository unavailable")
 	}
 
-	cleanPath := strings.Trim(strings.TrimSpace(path), "/")
+	cleanPath := strings.Trim(strings.TrimSpace(req.Path), "/")
 	if cleanPath == "" {
 		return fmt.Errorf("area.Service.ProcessWebhook: path missing")
 	}
@@ -394,23 +600,76 @@ func (s *Service) ProcessWebhook(ctx context.Context, path string, secret string
 		return fmt.Errorf("area.Service.ProcessWebhook: sources.FindWebhookBindingByPath: %w", err)
 	}
 
+	// The shared secret is checked before the area is loaded, signed deliveries load it to learn how to verify them
 	expected := ""
 	if binding.Source.WebhookSecret != nil {
 		expected = strings.TrimSpace(*binding.Source.WebhookSecret)
 	}
-	incoming := strings.TrimSpace(secret)
-	if incoming == "" {
+	incoming := strings.TrimSpace(req.Secret)
+	switch {
+	case incoming != "":
+		if expected == "" || subtle.ConstantTimeCompare([]byte(expected), []byte(incoming)) != 1 {
+			return ErrWebhookSecretInvalid
+		}
+	case expected == "" || len(req.Headers) == 0:
 		return ErrWebhookSecretMissing
 	}
-	if expected == "" || subtle.ConstantTimeCompare([]byte(expected), []byte(incoming)) != 1 {
-		return ErrWebhookSecretInvalid
+
+	action, err := s.webhookAction(ctx, binding.AreaID)
+	if err != nil {
+		return fmt.Errorf("area.Service.ProcessWebhook: %w", err)
+	}
+	var metadata map[string]any
+	if action.Config.Component != nil {
+		metadata = action.Config.Component.Metadata
+	}
+	eventCfg, hasEventCfg, err := parseWebhookEventConfig(metadata)
+	if err != nil {
+		return fmt.Errorf("area.Service.ProcessWebhook: %w", err)
 	}
 
+	// Without a shared secret the delivery must be signed the way the action component documents
+	if incoming == "" {
+		if !hasEventCfg || eventCfg.Signature == nil || req.Headers.Get(eventCfg.Signature.Header) == "" {
+			return ErrWebhookSecretMissing
+		}
+		if !eventCfg.Signature.verify(expected, req.Headers, req.Body) {
+			return ErrWebhookSecretInvalid
+		}
+	}
+
+	if mode := componentIngestionMode(action.Config.Component, action.Config.Params); mode != "" && mode != ingestionModeWebhook {
+		return ErrWebhookNotFound
+	}
+
+	payload := req.Payload
 	if payload == nil {
 		payload = map[string]any{}
 	}
+	fingerprint := req.Fingerprint
+
+	if hasEventCfg {
+		event := eventCfg.eventName(req.Headers)
+		payload = normalizeWebhookPayload(eventCfg.Normalize, event, payload)
+		if ok, reason := eventCfg.accepts(event, payload, action.Config.Params); !ok {
+			return fmt.Errorf("%w: %s", ErrWebhookEventIgnored, reason)
+		}
+		if fingerprint == "" {
+			fingerprint = eventCfg.deliveryID(req.Headers)
+		}
+	}
+
+	schemaCfg, hasSchema, err := parseWebhookSchemaConfig(metadata, action.Config.Params)
+	if err != nil {
+		return fmt.Errorf("area.Service.ProcessWebhook: %w", err)
+	}
+	if hasSchema {
+		if err := schemaCfg.check(req.Body, payload); err != nil {
+			return err
+		}
+	}
 
-	eventTime := occurredAt.UTC()
+	eventTime := req.OccurredAt.UTC()
 	if eventTime.IsZero() {
 		eventTime = s.clock.Now().UTC()
 	}
@@ -439,7 +698,25 @@ func (s *Service) ProcessWebhook(ctx context.Context, path string, secret string
 	return nil
 }
 
-// List fetches all areas owned by the given user
+func (s *Service) webhookAction(ctx context.Context, areaID uuid.UUID) (areadomain.Link, error) {
+	if s.repo == nil {
+		return areadomain.Link{}, fmt.Errorf("repository unavailable")
+	}
+	area, err := s.repo.FindByID(ctx, areaID)
+	if err != nil {
+		return areadomain.Link{}, fmt.Errorf("repo.FindByID: %w", err)
+	}
+	enriched, err := s.populateComponents(ctx, []areadomain.Area{area})
+	if err != nil {
+		return areadomain.Link{}, err
+	}
+	if len(enriched) == 0 || enriched[0].Action == nil {
+		return areadomain.Link{}, ErrAreaMisconfigured
+	}
+	return *enriched[0].Action, nil
+}
+
+// List fetches the areas owned by the given user and those shared in the user's workspaces
 func (s *Service) List(ctx context.Context, userID uuid.UUID) ([]areadomain.Area, error) {
 	if s.repo == nil {
 		return nil, fmt.Errorf("area.Service.List: repository unavailable")
@@ -448,6 +725,13 @@ func (s *Service) List(ctx context.Context, userID uuid.UUID) ([]areadomain.Area
 	if err != nil {
 		return nil, fmt.Errorf("area.Service.List: repo.ListByUser: %w", err)
 	}
+	shared, err := s.listSharedAreas(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("area.Service.List: %w", err)
+	}
+	if len(shared) > 0 {
+		areas = mergeAreas(areas, shared)
+	}
 	return s.populateComponents(ctx, areas)
 }
 
@@ -460,8 +744,8 @@ func (s *Service) Get(ctx context.Context, userID uuid.UUID, areaID uuid.UUID) (
 	if err != nil {
 		return areadomain.Area{}, fmt.Errorf("area.Service.Get: repo.FindByID: %w", err)
 	}
-	if !area.OwnedBy(userID) {
-		return areadomain.Area{}, fmt.Errorf("area.Service.Get: %w", ErrAreaNotOwned)
+	if err := s.authorizeArea(ctx, userID, area, workspacedomain.RoleViewer); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Get: %w", err)
 	}
 	areas, err := s.populateComponents(ctx, []areadomain.Area{area})
 	if err != nil {
@@ -475,6 +759,11 @@ func (s *Service) Get(ctx context.Context, userID uuid.UUID, areaID uuid.UUID) (
 
 // Update applies partial modifications to an automation ensuring ownership
 func (s *Service) Update(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, cmd UpdateAreaCommand) (areadomain.Area, error) {
+	return s.update(ctx, userID, areaID, cmd, nil, areadomain.RevisionReasonUpdated)
+}
+
+// update applies cmd and optionally a status change, then records a revision with the given reason
+func (s *Service) update(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, cmd UpdateAreaCommand, status *areadomain.Status, reason areadomain.RevisionReason) (areadomain.Area, error) {
 	if s.repo == nil {
 		return areadomain.Area{}, fmt.Errorf("area.Service.Update: repository unavailable")
 	}
@@ -482,8 +771,8 @@ func (s *Service) Update(ctx context.Context, userID uuid.UUID, areaID uuid.UUID
 	if err != nil {
 		return areadomain.Area{}, fmt.Errorf("area.Service.Update: repo.FindByID: %w", err)
 	}
-	if !area.OwnedBy(userID) {
-		return areadomain.Area{}, fmt.Errorf("area.Service.Update: %w", ErrAreaNotOwned)
+	if err := s.authorizeArea(ctx, userID, area, workspacedomain.RoleEditor); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Update: %w", err)
 	}
 
 	enriched, err := s.populateComponents(ctx, []areadomain.Area{area})
@@ -525,6 +814,11 @@ func (s *Service) Update(ctx context.Context, userID uuid.UUID, areaID uuid.UUID
 		}
 	}
 
+	if status != nil && *status != area.Status && *status != areadomain.StatusSuspended {
+		updated.Status = *status
+		metadataChanged = true
+	}
+
 	if cmd.Action != nil {
 		if updated.Action == nil || updated.Action.Config.ID == uuid.Nil {
 			return areadomain.Area{}, fmt.Errorf("area.Service.Update: %w", ErrAreaMisconfigured)
@@ -557,6 +851,9 @@ func (s *Service) Update(ctx context.Context, userID uuid.UUID, areaID uuid.UUID
 			if err := s.validateComponentParams(*actionConfig.Component, params); err != nil {
 				return areadomain.Area{}, fmt.Errorf("area.Service.Update: %w", err)
 			}
+			if err := s.sealSecretParams(*actionConfig.Component, params, actionConfig.Params); err != nil {
+				return areadomain.Area{}, fmt.Errorf("area.Service.Update: %w", err)
+			}
 			if !mapsEqual(actionConfig.Params, params) {
 				actionConfig.Params = params
 				configChanged = true
@@ -609,6 +906,9 @@ func (s *Service) Update(ctx context.Context, userID uuid.UUID, areaID uuid.UUID
 				if err := s.validateComponentParams(*reactionConfig.Component, params); err != nil {
 					return areadomain.Area{}, fmt.Errorf("area.Service.Update: %w", err)
 				}
+				if err := s.sealSecretParams(*reactionConfig.Component, params, reactionConfig.Params); err != nil {
+					return areadomain.Area{}, fmt.Errorf("area.Service.Update: %w", err)
+				}
 				if !mapsEqual(reactionConfig.Params, params) {
 					reactionConfig.Params = params
 					configChanged = true
@@ -628,17 +928,12 @@ func (s *Service) Update(ctx context.Context, userID uuid.UUID, areaID uuid.UUID
 	}
 
 	updated.UpdatedAt = now
-	if metadataChanged || len(configChanges) > 0 {
-		updated.Status = area.Status
-		if err := s.repo.UpdateMetadata(ctx, updated); err != nil {
-			return areadomain.Area{}, fmt.Errorf("area.Service.Update: repo.UpdateMetadata: %w", err)
-		}
+	change := outbound.AreaChange{Area: updated, Metadata: true, Configs: configChanges}
+	if err := s.commitChange(ctx, userID, change, &updated, reason); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Update: %w", err)
 	}
-
-	for _, cfg := range configChanges {
-		if err := s.repo.UpdateConfig(ctx, cfg); err != nil {
-			return areadomain.Area{}, fmt.Errorf("area.Service.Update: repo.UpdateConfig: %w", err)
-		}
+	if err := s.resumeHealth(ctx, area.Status, updated); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Update: %w", err)
 	}
 
 	result, err := s.populateComponents(ctx, []areadomain.Area{updated})
@@ -652,6 +947,7 @@ func (s *Service) Update(ctx context.Context, userID uuid.UUID, areaID uuid.UUID
 }
 
 // UpdateStatus toggles the lifecycle status of an automation
+// Enabling an automation suspended by the circuit breaker resets the breaker
 func (s *Service) UpdateStatus(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, status areadomain.Status) (areadomain.Area, error) {
 	if s.repo == nil {
 		return areadomain.Area{}, fmt.Errorf("area.Service.UpdateStatus: repository unavailable")
@@ -664,23 +960,28 @@ func (s *Service) UpdateStatus(ctx context.Context, userID uuid.UUID, areaID uui
 	if err != nil {
 		return areadomain.Area{}, fmt.Errorf("area.Service.UpdateStatus: repo.FindByID: %w", err)
 	}
-	if !area.OwnedBy(userID) {
-		return areadomain.Area{}, fmt.Errorf("area.Service.UpdateStatus: %w", ErrAreaNotOwned)
+	if err := s.authorizeArea(ctx, userID, area, workspacedomain.RoleEditor); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.UpdateStatus: %w", err)
 	}
 
 	if area.Status == status {
 		return s.Get(ctx, userID, areaID)
 	}
 
+	previous := area.Status
 	area.Status = status
 	area.UpdatedAt = s.clock.Now().UTC()
-	if err := s.repo.UpdateMetadata(ctx, area); err != nil {
-		return areadomain.Area{}, fmt.Errorf("area.Service.UpdateStatus: repo.UpdateMetadata: %w", err)
+	if err := s.commitChange(ctx, userID, outbound.AreaChange{Area: area, Metadata: true}, &area, areadomain.RevisionReasonStatus); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.UpdateStatus: %w", err)
+	}
+	if err := s.resumeHealth(ctx, previous, area); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.UpdateStatus: %w", err)
 	}
 	return s.Get(ctx, userID, areaID)
 }
 
 // Duplicate clones an existing automation and persists it for the same user
+// Copies of shared automations stay in their workspace and keep running with the same member's accounts
 func (s *Service) Duplicate(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, opts DuplicateOptions) (areadomain.Area, error) {
 	if s.repo == nil {
 		return areadomain.Area{}, fmt.Errorf("area.Service.Duplicate: repository unavailable")
@@ -689,8 +990,8 @@ func (s *Service) Duplicate(ctx context.Context, userID uuid.UUID, areaID uuid.U
 	if err != nil {
 		return areadomain.Area{}, fmt.Errorf("area.Service.Duplicate: repo.FindByID: %w", err)
 	}
-	if !area.OwnedBy(userID) {
-		return areadomain.Area{}, fmt.Errorf("area.Service.Duplicate: %w", ErrAreaNotOwned)
+	if err := s.authorizeArea(ctx, userID, area, workspacedomain.RoleEditor); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Duplicate: %w", err)
 	}
 
 	enriched, err := s.populateComponents(ctx, []areadomain.Area{area})
@@ -727,34 +1028,38 @@ func (s *Service) Duplicate(ctx context.Context, userID uuid.UUID, areaID uuid.U
 		return areadomain.Area{}, fmt.Errorf("area.Service.Duplicate: %w", ErrAreaMisconfigured)
 	}
 
+	// Secrets are opened so the copy seals them again as its own values
+	actionParams, err := s.openStoredParams(area.Action.Config.Params)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.Duplicate: %w", err)
+	}
 	actionInput := ActionInput{
 		ComponentID: area.Action.Config.ComponentID,
 		Name:        strings.TrimSpace(area.Action.Config.Name),
-		Params:      cloneParamsMap(area.Action.Config.Params),
+		Params:      actionParams,
 	}
 
 	reactionInputs := make([]ReactionInput, 0, len(area.Reactions))
 	for _, reaction := range area.Reactions {
+		params, err := s.openStoredParams(reaction.Config.Params)
+		if err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.Duplicate: %w", err)
+		}
 		reactionInputs = append(reactionInputs, ReactionInput{
 			ComponentID: reaction.Config.ComponentID,
 			Name:        strings.TrimSpace(reaction.Config.Name),
-			Params:      cloneParamsMap(reaction.Config.Params),
+			Params:      params,
 		})
 	}
 
-	duplicate, err := s.Create(ctx, userID, name, desc, actionInput, reactionInputs)
+	status := area.Status
+	if status == areadomain.StatusSuspended {
+		status = areadomain.StatusDisabled
+	}
+	duplicate, err := s.create(ctx, userID, area.UserID, area.WorkspaceID, name, desc, status, actionInput, reactionInputs)
 	if err != nil {
 		return areadomain.Area{}, fmt.Errorf("area.Service.Duplicate: create: %w", err)
 	}
-
-	if area.Status != areadomain.StatusEnabled {
-		duplicate.Status = area.Status
-		duplicate.UpdatedAt = s.clock.Now().UTC()
-		if err := s.repo.UpdateMetadata(ctx, duplicate); err != nil {
-			return areadomain.Area{}, fmt.Errorf("area.Service.Duplicate: repo.UpdateMetadata: %w", err)
-		}
-	}
-
 	return duplicate, nil
 }
 
@@ -768,8 +1073,8 @@ func (s *Service) Delete(ctx context.Context, userID uuid.UUID, areaID uuid.UUID
 	if err != nil {
 		return fmt.Errorf("area.Service.Delete: repo.FindByID: %w", err)
 	}
-	if !area.OwnedBy(userID) {
-		return fmt.Errorf("area.Service.Delete: %w", ErrAreaNotOwned)
+	if err := s.authorizeArea(ctx, userID, area, workspacedomain.RoleOwner); err != nil {
+		return fmt.Errorf("area.Service.Delete: %w", err)
 	}
 
 	if err := s.repo.Delete(ctx, areaID); err != nil {
@@ -971,12 +1276,25 @@ func (s *Service) validateComponentParams(component componentdomain.Component, p
 	if err := validateParamsAgainstMetadata(component.Metadata, params); err != nil {
 		return fmt.Errorf("%w: %v", ErrComponentParamsInvalid, err)
 	}
+	if isCustomHTTPPollingComponent(&component) {
+		expanded, err := expandCustomHTTPPollingComponent(component, params)
+		if err != nil {
+			return fmt.Errorf("%w: %v", ErrComponentParamsInvalid, err)
+		}
+		if _, _, err := parseHTTPPollingConfig(&expanded); err != nil {
+			return fmt.Errorf("%w: %v", ErrComponentParamsInvalid, err)
+		}
+	}
+	if _, _, err := parseWebhookSchemaConfig(component.Metadata, params); err != nil {
+		return fmt.Errorf("%w: %v", ErrComponentParamsInvalid, err)
+	}
 	return nil
 }
 
 type parameterSpec struct {
 	Key      string
 	Type     string
+	Provider string
 	Required bool
 	Options  []string
 	Minimum  *float64
@@ -1031,6 +1349,9 @@ func extractParameterSpecs(metadata map[string]any) ([]parameterSpec, error) {
 		if typ, ok := obj["type"].(string); ok {
 			spec.Type = strings.ToLower(strings.TrimSpace(typ))
 		}
+		if provider, ok := obj["provider"].(string); ok {
+			spec.Provider = strings.TrimSpace(provider)
+		}
 		if required, ok := obj["required"].(bool); ok {
 			spec.Required = required
 		}
@@ -2,8 +2,11 @@ package area
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"net/http"
+	"sort"
 	"strings"
 	"testing"
 	"time"
@@ -375,9 +378,140 @@ func TestService_ProcessWebhook(t *testing.T) {
 		t.Fatalf("unexpected fingerprint %s", input.Fingerprint)
 	}
 
+	lookups := repo.lookups
 	if err := svc.ProcessWebhook(ctx, sourcePath, "wrong", payload, "", time.Time{}); !errors.Is(err, ErrWebhookSecretInvalid) {
 		t.Fatalf("expected ErrWebhookSecretInvalid, got %v", err)
 	}
+	if err := svc.ProcessWebhook(ctx, sourcePath, "", payload, "", time.Time{}); !errors.Is(err, ErrWebhookSecretMissing) {
+		t.Fatalf("expected ErrWebhookSecretMissing, got %v", err)
+	}
+	if repo.lookups != lookups {
+		t.Fatalf("expected rejected deliveries not to load the area, got %d lookups", repo.lookups-lookups)
+	}
+}
+
+func TestService_ProcessWebhookRequestGitHubSignature(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1720000000, 0).UTC()
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	actionComponentID := uuid.New()
+	reactionComponentID := uuid.New()
+	actionConfigID := uuid.New()
+	areaID := uuid.New()
+	userID := uuid.New()
+	sourceID := uuid.New()
+	secret := "github-secret"
+
+	repo.items[areaID] = areadomain.Area{
+		ID:     areaID,
+		UserID: userID,
+		Name:   "GitHub push area",
+		Status: areadomain.StatusEnabled,
+		Action: &areadomain.Link{
+			ID:   uuid.New(),
+			Role: areadomain.LinkRoleAction,
+			Config: componentdomain.Config{
+				ID:          actionConfigID,
+				ComponentID: actionComponentID,
+				Params:      map[string]any{"deliveryMode": "webhook", "branch": "main"},
+			},
+		},
+		Reactions: []areadomain.Link{{
+			ID:     uuid.New(),
+			Role:   areadomain.LinkRoleReaction,
+			Config: componentdomain.Config{ID: uuid.New(), ComponentID: reactionComponentID},
+		}},
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{
+		actionComponentID: {
+			ID:       actionComponentID,
+			Kind:     componentdomain.KindAction,
+			Name:     "github_push_to_branch",
+			Enabled:  true,
+			Provider: componentdomain.Provider{Name: "github"},
+			Metadata: githubWebhookMetadata([]any{"push"}, []any{
+				map[string]any{"path": "branch", "equals": "{{params.branch}}"},
+			}),
+		},
+		reactionComponentID: {
+			ID:       reactionComponentID,
+			Kind:     componentdomain.KindReaction,
+			Enabled:  true,
+			Provider: componentdomain.Provider{Name: "slack"},
+		},
+	}}
+
+	sourcePath := "hooks/github/webhook/" + actionConfigID.String()
+	source := actiondomain.Source{
+		ID:                sourceID,
+		ComponentConfigID: actionConfigID,
+		Mode:              actiondomain.ModeWebhook,
+		WebhookSecret:     strPtr(secret),
+		WebhookURLPath:    strPtr(sourcePath),
+		IsActive:          true,
+	}
+	sources := &stubActionSourceRepo{
+		sources: map[uuid.UUID]actiondomain.Source{actionConfigID: source},
+		webhooks: map[string]actiondomain.WebhookBinding{
+			sourcePath: {
+				Source:     source,
+				AreaID:     areaID,
+				AreaLinkID: repo.items[areaID].Action.ID,
+				UserID:     userID,
+			},
+		},
+	}
+
+	pipeline := &recordingPipeline{}
+	svc := NewService(repo, components, allowAllSubscriptions{}, sources, pipeline, stubClock{now: now}, nil)
+
+	deliver := func(body string, signature string) error {
+		headers := http.Header{}
+		headers.Set("X-GitHub-Event", "push")
+		headers.Set("X-GitHub-Delivery", "delivery-1")
+		headers.Set("X-Hub-Signature-256", signature)
+		var payload map[string]any
+		if err := json.Unmarshal([]byte(body), &payload); err != nil {
+			t.Fatalf("unmarshal payload: %v", err)
+		}
+		return svc.ProcessWebhookRequest(ctx, WebhookRequest{
+			Path:    sourcePath,
+			Headers: headers,
+			Body:    []byte(body),
+			Payload: payload,
+		})
+	}
+
+	mainPush := `{"ref":"refs/heads/main"}`
+	if err := deliver(mainPush, githubSignature(secret, []byte(mainPush))); err != nil {
+		t.Fatalf("ProcessWebhookRequest returned error: %v", err)
+	}
+	if len(pipeline.inputs) != 1 {
+		t.Fatalf("expected pipeline to receive 1 input, got %d", len(pipeline.inputs))
+	}
+	if pipeline.inputs[0].Fingerprint != "delivery-1" {
+		t.Fatalf("unexpected fingerprint %s", pipeline.inputs[0].Fingerprint)
+	}
+
+	if err := deliver(mainPush, githubSignature("wrong", []byte(mainPush))); !errors.Is(err, ErrWebhookSecretInvalid) {
+		t.Fatalf("expected ErrWebhookSecretInvalid, got %v", err)
+	}
+
+	featurePush := `{"ref":"refs/heads/feature"}`
+	if err := deliver(featurePush, githubSignature(secret, []byte(featurePush))); !errors.Is(err, ErrWebhookEventIgnored) {
+		t.Fatalf("expected ErrWebhookEventIgnored, got %v", err)
+	}
+	if len(pipeline.inputs) != 1 {
+		t.Fatalf("ignored deliveries should not reach the pipeline")
+	}
+
+	repo.items[areaID].Action.Config.Params["deliveryMode"] = "polling"
+	if err := deliver(mainPush, githubSignature(secret, []byte(mainPush))); !errors.Is(err, ErrWebhookNotFound) {
+		t.Fatalf("expected ErrWebhookNotFound in polling mode, got %v", err)
+	}
 }
 
 func TestService_CreateReactionValidation(t *testing.T) {
@@ -833,7 +967,9 @@ func TestService_DuplicateCreatesIndependentCopy(t *testing.T) {
 }
 
 type memoryAreaRepo struct {
-	items map[uuid.UUID]areadomain.Area
+	items            map[uuid.UUID]areadomain.Area
+	workspaceQueries int
+	lookups          int
 }
 
 func (m *memoryAreaRepo) Create(ctx context.Context, area areadomain.Area, action areadomain.Link, reactions []areadomain.Link) (areadomain.Area, error) {
@@ -871,6 +1007,7 @@ func (m *memoryAreaRepo) Create(ctx context.Context, area areadomain.Area, actio
 }
 
 func (m *memoryAreaRepo) FindByID(ctx context.Context, id uuid.UUID) (areadomain.Area, error) {
+	m.lookups++
 	area, ok := m.items[id]
 	if !ok {
 		return areadomain.Area{}, outbound.ErrNotFound
@@ -888,6 +1025,90 @@ func (m *memoryAreaRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]ar
 	return areas, nil
 }
 
+func (m *memoryAreaRepo) ListByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]areadomain.Area, error) {
+	var areas []areadomain.Area
+	for _, area := range m.items {
+		if area.WorkspaceID != nil && *area.WorkspaceID == workspaceID {
+			areas = append(areas, area)
+		}
+	}
+	return areas, nil
+}
+
+func (m *memoryAreaRepo) ListByWorkspaces(ctx context.Context, workspaceIDs []uuid.UUID) ([]areadomain.Area, error) {
+	m.workspaceQueries++
+	var areas []areadomain.Area
+	for _, workspaceID := range workspaceIDs {
+		shared, _ := m.ListByWorkspace(ctx, workspaceID)
+		areas = append(areas, shared...)
+	}
+	return areas, nil
+}
+
+func (m *memoryAreaRepo) ListPage(ctx context.Context, opts outbound.AreaListOptions) (outbound.AreaPage, error) {
+	workspaces := make(map[uuid.UUID]bool, len(opts.WorkspaceIDs))
+	for _, id := range opts.WorkspaceIDs {
+		workspaces[id] = true
+	}
+	statuses := make(map[areadomain.Status]bool, len(opts.Statuses))
+	for _, status := range opts.Statuses {
+		statuses[status] = true
+	}
+	var matches []areadomain.Area
+	for _, area := range m.items {
+		if area.UserID != opts.UserID && (area.WorkspaceID == nil || !workspaces[*area.WorkspaceID]) {
+			continue
+		}
+		if len(statuses) > 0 && !statuses[area.Status] {
+			continue
+		}
+		if opts.Search != "" && !strings.Contains(strings.ToLower(area.Name), strings.ToLower(opts.Search)) {
+			continue
+		}
+		if opts.FolderID != nil && (area.FolderID == nil || *area.FolderID != *opts.FolderID) {
+			continue
+		}
+		if opts.TagID != nil && !hasTag(area, *opts.TagID) {
+			continue
+		}
+		matches = append(matches, area)
+	}
+	less := func(a, b areadomain.Area) bool {
+		if !a.CreatedAt.Equal(b.CreatedAt) {
+			return a.CreatedAt.Before(b.CreatedAt)
+		}
+		return a.ID.String() < b.ID.String()
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if opts.Ascending {
+			return less(matches[i], matches[j])
+		}
+		return less(matches[j], matches[i])
+	})
+	if opts.After != nil {
+		cursor := areadomain.Area{ID: opts.After.ID, CreatedAt: opts.After.SortValue}
+		for idx, area := range matches {
+			if (opts.Ascending && less(cursor, area)) || (!opts.Ascending && less(area, cursor)) {
+				matches = matches[idx:]
+				break
+			}
+			if idx == len(matches)-1 {
+				matches = nil
+			}
+		}
+	}
+	page := outbound.AreaPage{Areas: matches}
+	if len(matches) > opts.Limit {
+		page.Areas = matches[:opts.Limit]
+		last := page.Areas[len(page.Areas)-1]
+		page.Next = &outbound.AreaCursor{SortValue: last.CreatedAt, ID: last.ID}
+	}
+	for idx := range page.Areas {
+		page.Areas[idx].Activity = &areadomain.Activity{}
+	}
+	return page, nil
+}
+
 func (m *memoryAreaRepo) Delete(ctx context.Context, id uuid.UUID) error {
 	delete(m.items, id)
 	return nil
@@ -906,6 +1127,16 @@ func (m *memoryAreaRepo) UpdateMetadata(ctx context.Context, area areadomain.Are
 	return nil
 }
 
+func (m *memoryAreaRepo) UpdateActivitySchedule(ctx context.Context, areaID uuid.UUID, schedule *areadomain.ActivitySchedule) error {
+	stored, ok := m.items[areaID]
+	if !ok {
+		return outbound.ErrNotFound
+	}
+	stored.ActivitySchedule = schedule
+	m.items[areaID] = stored
+	return nil
+}
+
 func (m *memoryAreaRepo) UpdateConfig(ctx context.Context, config componentdomain.Config) error {
 	for id, area := range m.items {
 		if area.Action != nil && area.Action.Config.ID == config.ID {
@@ -928,6 +1159,21 @@ func (m *memoryAreaRepo) UpdateConfig(ctx context.Context, config componentdomai
 	return outbound.ErrNotFound
 }
 
+func (m *memoryAreaRepo) UpdateOwnership(ctx context.Context, area areadomain.Area) error {
+	stored, ok := m.items[area.ID]
+	if !ok {
+		return outbound.ErrNotFound
+	}
+	if stored.UserID != area.UserID {
+		stored.FolderID = nil
+	}
+	stored.UserID = area.UserID
+	stored.WorkspaceID = area.WorkspaceID
+	stored.UpdatedAt = area.UpdatedAt
+	m.items[area.ID] = stored
+	return nil
+}
+
 func ptrString(value string) *string {
 	v := value
 	return &v
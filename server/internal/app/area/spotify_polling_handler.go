@@ -0,0 +1,284 @@
+package area
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"go.uber.org/zap"
+)
+
+const (
+	spotifyPollingHandlerName   = "spotify"
+	spotifyDefaultAPIBaseURL    = "https://api.spotify.com/v1"
+	spotifyReleaseCursorKey     = "spotify_release_date"
+	spotifyReleaseDateLayout    = "2006-01-02"
+	spotifyDefaultArtistCount   = 50
+	spotifyMaxArtistCount       = 200
+	spotifyAlbumsPerArtist      = 10
+	spotifyDefaultIdentityParam = "identityId"
+	spotifyDefaultOAuthProvider = "spotify"
+)
+
+// SpotifyPollingHandler polls the artists followed by the account and emits an event for each release
+// dated on or after the stored release date
+type SpotifyPollingHandler struct {
+	client   *http.Client
+	logger   *zap.Logger
+	resolver pollingIdentityResolver
+	baseURL  string
+}
+
+// NewSpotifyPollingHandler assembles a Spotify followed artist release polling handler
+func NewSpotifyPollingHandler(client *http.Client, logger *zap.Logger, identities identityport.Repository, providers oauthProviderResolver) *SpotifyPollingHandler {
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	if logger == nil {
+		logger = zap.NewNop()
+	}
+	return &SpotifyPollingHandler{
+		client:   client,
+		logger:   logger,
+		resolver: pollingIdentityResolver{identities: identities, providers: providers},
+		baseURL:  spotifyDefaultAPIBaseURL,
+	}
+}
+
+// Supports reports whether the component declares the Spotify polling ingestion
+func (h *SpotifyPollingHandler) Supports(component *componentdomain.Component) bool {
+	_, ok, err := parseSpotifyPollingConfig(component)
+	return err == nil && ok
+}
+
+// Poll lists the followed artists, reads their latest albums and singles and converts new releases into events
+func (h *SpotifyPollingHandler) Poll(ctx context.Context, req PollingRequest) (PollingResult, error) {
+	config, ok, err := parseSpotifyPollingConfig(&req.Component)
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.SpotifyPollingHandler.Poll: parse config: %w", err)
+	}
+	if !ok {
+		return PollingResult{}, fmt.Errorf("area.SpotifyPollingHandler.Poll: component %q not supported", req.Component.Name)
+	}
+
+	if req.Binding.Config.Params == nil {
+		req.Binding.Config.Params = map[string]any{}
+	}
+	maxArtists := spotifyDefaultArtistCount
+	if value, ok := req.Binding.Config.Params["maxArtists"]; ok {
+		if parsed, err := toInt(value); err == nil && parsed > 0 {
+			maxArtists = parsed
+		}
+	}
+	if maxArtists > spotifyMaxArtistCount {
+		maxArtists = spotifyMaxArtistCount
+	}
+
+	result := PollingResult{Cursor: cloneMapAny(req.Cursor)}
+	if result.Cursor == nil {
+		result.Cursor = map[string]any{}
+	}
+	cursorState := ensureCursorState(result.Cursor)
+	assignCursorValue(result.Cursor, cursorState, "last_polled_at", req.Now.UTC().Format(time.RFC3339Nano))
+
+	since, err := time.Parse(spotifyReleaseDateLayout, strings.TrimSpace(stringify(flattenCursorState(req.Cursor)[spotifyReleaseCursorKey])))
+	if err != nil {
+		// The first poll only records today so the back catalogue of followed artists does not fire
+		assignCursorValue(result.Cursor, cursorState, spotifyReleaseCursorKey, req.Now.UTC().Format(spotifyReleaseDateLayout))
+		return result, nil
+	}
+
+	if err := h.resolver.inject(ctx, &req, config.auth); err != nil {
+		return PollingResult{}, fmt.Errorf("area.SpotifyPollingHandler.Poll: %w", err)
+	}
+	token := stringify(req.Identity["accessToken"])
+
+	artists, err := h.followedArtists(ctx, token, maxArtists)
+	if err != nil {
+		return PollingResult{}, fmt.Errorf("area.SpotifyPollingHandler.Poll: %w", err)
+	}
+
+	latest := since
+	for _, artist := range artists {
+		artistID := stringify(artist["id"])
+		query := url.Values{
+			"include_groups": {"album,single"},
+			"limit":          {fmt.Sprint(spotifyAlbumsPerArtist)},
+		}
+		body, err := h.get(ctx, token, "/artists/"+url.PathEscape(artistID)+"/albums", query)
+		if err != nil {
+			return PollingResult{}, fmt.Errorf("area.SpotifyPollingHandler.Poll: artist %s albums: %w", artistID, err)
+		}
+		albums, _ := body["items"].([]any)
+		for _, raw := range albums {
+			album, ok := raw.(map[string]any)
+			if !ok {
+				continue
+			}
+			// Releases are dated by day at best, the boundary day is read again and repeats are dropped by album ID
+			released, ok := parseSpotifyReleaseDate(album)
+			if !ok || released.Before(since) {
+				continue
+			}
+			if released.After(latest) {
+				latest = released
+			}
+			result.Events = append(result.Events, buildSpotifyReleaseEvent(artist, album, released))
+		}
+	}
+	sort.SliceStable(result.Events, func(i, j int) bool {
+		return result.Events[i].OccurredAt.Before(result.Events[j].OccurredAt)
+	})
+	assignCursorValue(result.Cursor, cursorState, spotifyReleaseCursorKey, latest.Format(spotifyReleaseDateLayout))
+	return result, nil
+}
+
+// followedArtists walks the cursor paginated list of followed artists up to limit entries
+func (h *SpotifyPollingHandler) followedArtists(ctx context.Context, token string, limit int) ([]map[string]any, error) {
+	artists := make([]map[string]any, 0, limit)
+	after := ""
+	for len(artists) < limit {
+		query := url.Values{"type": {"artist"}, "limit": {fmt.Sprint(min(50, limit-len(artists)))}}
+		if after != "" {
+			query.Set("after", after)
+		}
+		body, err := h.get(ctx, token, "/me/following", query)
+		if err != nil {
+			return nil, fmt.Errorf("followed artists: %w", err)
+		}
+		page, _ := body["artists"].(map[string]any)
+		items, _ := page["items"].([]any)
+		for _, raw := range items {
+			if artist, ok := raw.(map[string]any); ok && stringify(artist["id"]) != "" {
+				artists = append(artists, artist)
+			}
+		}
+		cursors, _ := page["cursors"].(map[string]any)
+		after = stringify(cursors["after"])
+		if after == "" || len(items) == 0 {
+			break
+		}
+	}
+	return artists, nil
+}
+
+func (h *SpotifyPollingHandler) get(ctx context.Context, token string, path string, query url.Values) (map[string]any, error) {
+	endpoint := h.baseURL + path
+	if encoded := query.Encode(); encoded != "" {
+		endpoint += "?" + encoded
+	}
+	request, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("build request: %w", err)
+	}
+	request.Header.Set("Accept", "application/json")
+	request.Header.Set("Authorization", "Bearer "+token)
+	request.Header.Set("User-Agent", "AREA-Server")
+
+	response, err := h.client.Do(request)
+	if err != nil {
+		return nil, fmt.Errorf("execute request: %w", err)
+	}
+	defer func() {
+		_ = response.Body.Close()
+	}()
+
+	raw, err := io.ReadAll(io.LimitReader(response.Body, 8<<20))
+	if err != nil {
+		return nil, fmt.Errorf("read response: %w", err)
+	}
+	if response.StatusCode >= 400 {
+		return nil, fmt.Errorf("unexpected status %d: %s", response.StatusCode, strings.TrimSpace(string(raw)))
+	}
+	var decoded map[string]any
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		return nil, fmt.Errorf("decode response: %w", err)
+	}
+	return decoded, nil
+}
+
+// parseSpotifyReleaseDate reads release_date according to its precision, month and year precision map to the first day
+func parseSpotifyReleaseDate(album map[string]any) (time.Time, bool) {
+	value := strings.TrimSpace(stringify(album["release_date"]))
+	layout := spotifyReleaseDateLayout
+	switch stringify(album["release_date_precision"]) {
+	case "month":
+		layout = "2006-01"
+	case "year":
+		layout = "2006"
+	}
+	released, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return released.UTC(), true
+}
+
+func buildSpotifyReleaseEvent(artist map[string]any, album map[string]any, released time.Time) PollingEvent {
+	id := stringify(album["id"])
+	payload := map[string]any{
+		"id":          id,
+		"name":        album["name"],
+		"uri":         album["uri"],
+		"albumType":   album["album_type"],
+		"releaseDate": album["release_date"],
+		"totalTracks": album["total_tracks"],
+		"artistId":    artist["id"],
+		"artistName":  artist["name"],
+	}
+	if urls, ok := album["external_urls"].(map[string]any); ok {
+		payload["url"] = urls["spotify"]
+	}
+	return PollingEvent{
+		Payload:     payload,
+		Fingerprint: id,
+		OccurredAt:  released,
+	}
+}
+
+type spotifyPollingConfig struct {
+	auth httpPollingAuthConfig
+}
+
+func parseSpotifyPollingConfig(component *componentdomain.Component) (spotifyPollingConfig, bool, error) {
+	if component == nil || len(component.Metadata) == 0 {
+		return spotifyPollingConfig{}, false, nil
+	}
+	ingestion, ok, err := ingestionMetadata(component.Metadata)
+	if err != nil {
+		return spotifyPollingConfig{}, false, fmt.Errorf("ingestion metadata invalid: %w", err)
+	}
+	if !ok || !ingestionSupportsMode(ingestion, ingestionModePolling) {
+		return spotifyPollingConfig{}, false, nil
+	}
+	handlerName, err := toString(ingestion["handler"])
+	if err != nil || strings.ToLower(strings.TrimSpace(handlerName)) != spotifyPollingHandlerName {
+		return spotifyPollingConfig{}, false, nil
+	}
+
+	cfg := spotifyPollingConfig{auth: httpPollingAuthConfig{
+		Kind:          "oauth",
+		IdentityParam: spotifyDefaultIdentityParam,
+		Provider:      spotifyDefaultOAuthProvider,
+	}}
+	if rawAuth, ok := ingestion["auth"]; ok {
+		authMap, err := toMapStringAny(rawAuth)
+		if err != nil {
+			return spotifyPollingConfig{}, false, fmt.Errorf("auth metadata invalid: %w", err)
+		}
+		cfg.auth.IdentityParam = stringOrDefault(authMap, "identityParam", cfg.auth.IdentityParam)
+		cfg.auth.Provider = stringOrDefault(authMap, "provider", cfg.auth.Provider)
+	}
+	return cfg, true, nil
+}
+
+// Ensure SpotifyPollingHandler implements ComponentPollingHandler
+var _ ComponentPollingHandler = (*SpotifyPollingHandler)(nil)
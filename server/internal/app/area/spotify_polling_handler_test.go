@@ -0,0 +1,92 @@
+package area
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+func spotifyTestRequest(cursor map[string]any) (PollingRequest, *identityRepoStub) {
+	identityID := uuid.New()
+	userID := uuid.New()
+	repo := &identityRepoStub{identity: identitydomain.Identity{ID: identityID, UserID: userID, Provider: "spotify", AccessToken: "secret"}}
+	return PollingRequest{
+		Binding: actiondomain.PollingBinding{
+			UserID: userID,
+			Config: componentdomain.Config{Params: map[string]any{"identityId": identityID.String()}},
+		},
+		Component: componentdomain.Component{
+			Name:     "spotify_new_release_from_followed_artist",
+			Provider: componentdomain.Provider{Name: "spotify"},
+			Metadata: map[string]any{
+				"ingestion": map[string]any{"mode": "polling", "handler": "spotify"},
+			},
+		},
+		Cursor: cursor,
+		Now:    time.Date(2025, 3, 7, 12, 0, 0, 0, time.UTC),
+	}, repo
+}
+
+func TestSpotifyPollingHandlerInitialisesCursor(t *testing.T) {
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{}}
+	req, repo := spotifyTestRequest(map[string]any{})
+	handler := NewSpotifyPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	if !handler.Supports(&req.Component) {
+		t.Fatalf("expected spotify component to be supported")
+	}
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 0 || len(transport.requests) != 0 {
+		t.Fatalf("expected a silent first poll, got %d events and %d requests", len(result.Events), len(transport.requests))
+	}
+	if got := result.Cursor[spotifyReleaseCursorKey]; got != "2025-03-07" {
+		t.Fatalf("unexpected cursor %v", got)
+	}
+}
+
+func TestSpotifyPollingHandlerEmitsNewReleases(t *testing.T) {
+	transport := &gmailRoutingTransport{routes: map[string]gmailRoute{
+		"/v1/me/following": {body: `{"artists":{"items":[{"id":"ar1","name":"Band"},{"id":"ar2","name":"Singer"}],"cursors":{"after":null}}}`},
+		"/v1/artists/ar1/albums": {body: `{"items":[
+			{"id":"al1","name":"Fresh","album_type":"single","release_date":"2025-03-06","release_date_precision":"day","external_urls":{"spotify":"https://open.spotify.com/album/al1"}},
+			{"id":"al0","name":"Old","album_type":"album","release_date":"2025-02-28","release_date_precision":"day"}
+		]}`},
+		"/v1/artists/ar2/albums": {body: `{"items":[
+			{"id":"al2","name":"Boundary","album_type":"album","release_date":"2025-03-05","release_date_precision":"day"},
+			{"id":"al3","name":"Vintage","album_type":"album","release_date":"1999","release_date_precision":"year"}
+		]}`},
+	}}
+	req, repo := spotifyTestRequest(map[string]any{"state": map[string]any{spotifyReleaseCursorKey: "2025-03-05"}})
+	handler := NewSpotifyPollingHandler(&http.Client{Transport: transport}, zap.NewNop(), repo, nil)
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 2 {
+		t.Fatalf("expected 2 events got %d", len(result.Events))
+	}
+	if result.Events[0].Fingerprint != "al2" || result.Events[1].Fingerprint != "al1" {
+		t.Fatalf("expected releases ordered by date, got %s then %s", result.Events[0].Fingerprint, result.Events[1].Fingerprint)
+	}
+	fresh := result.Events[1].Payload
+	if fresh["artistName"] != "Band" || fresh["url"] != "https://open.spotify.com/album/al1" || fresh["albumType"] != "single" {
+		t.Fatalf("unexpected payload %v", fresh)
+	}
+	if got := result.Cursor[spotifyReleaseCursorKey]; got != "2025-03-06" {
+		t.Fatalf("unexpected cursor %v", got)
+	}
+	if groups := transport.requests[1].URL.Query().Get("include_groups"); groups != "album,single" {
+		t.Fatalf("unexpected include_groups %q", groups)
+	}
+}
@@ -0,0 +1,376 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	componentview "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/components"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+// ComponentCatalog lists the catalog components a user can configure
+type ComponentCatalog interface {
+	ListAvailable(ctx context.Context, userID uuid.UUID, opts componentview.ListOptions) ([]componentdomain.Component, error)
+}
+
+// TemplateInput describes a template published by an administrator
+type TemplateInput struct {
+	Name        string
+	Description string
+	Action      TemplateSlotInput
+	Reactions   []TemplateSlotInput
+}
+
+// TemplateSlotInput references a catalog component and the params preset for it
+type TemplateSlotInput struct {
+	ComponentID uuid.UUID
+	Name        string
+	Params      map[string]any
+}
+
+// TemplateInstantiation carries the values a user supplies for the unresolved params of a template
+// Reactions are matched to the template reactions by position
+type TemplateInstantiation struct {
+	Name        string
+	Description string
+	Action      map[string]any
+	Reactions   []map[string]any
+}
+
+// ListTemplates returns the templates whose components are all available to the user
+func (s *Service) ListTemplates(ctx context.Context, userID uuid.UUID) ([]areadomain.Template, error) {
+	if s.templates == nil {
+		return nil, fmt.Errorf("area.Service.ListTemplates: template repository unavailable")
+	}
+	templates, err := s.templates.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("area.Service.ListTemplates: templates.List: %w", err)
+	}
+	if err := s.hydrateTemplates(ctx, templates); err != nil {
+		return nil, fmt.Errorf("area.Service.ListTemplates: %w", err)
+	}
+
+	var available map[uuid.UUID]struct{}
+	if s.catalog != nil {
+		components, err := s.catalog.ListAvailable(ctx, userID, componentview.ListOptions{})
+		if err != nil {
+			return nil, fmt.Errorf("area.Service.ListTemplates: catalog.ListAvailable: %w", err)
+		}
+		available = make(map[uuid.UUID]struct{}, len(components))
+		for _, component := range components {
+			available[component.ID] = struct{}{}
+		}
+	}
+
+	filtered := make([]areadomain.Template, 0, len(templates))
+	for _, template := range templates {
+		if templateUsable(template, available) {
+			filtered = append(filtered, template)
+		}
+	}
+	return filtered, nil
+}
+
+// GetTemplate retrieves a template with its components
+func (s *Service) GetTemplate(ctx context.Context, templateID uuid.UUID) (areadomain.Template, error) {
+	if s.templates == nil {
+		return areadomain.Template{}, fmt.Errorf("area.Service.GetTemplate: template repository unavailable")
+	}
+	template, err := s.templates.FindByID(ctx, templateID)
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return areadomain.Template{}, fmt.Errorf("area.Service.GetTemplate: %w", ErrTemplateNotFound)
+		}
+		return areadomain.Template{}, fmt.Errorf("area.Service.GetTemplate: templates.FindByID: %w", err)
+	}
+	templates := []areadomain.Template{template}
+	if err := s.hydrateTemplates(ctx, templates); err != nil {
+		return areadomain.Template{}, fmt.Errorf("area.Service.GetTemplate: %w", err)
+	}
+	return templates[0], nil
+}
+
+// CreateTemplate publishes a template built from catalog components
+// Identity params are dropped since linked accounts belong to the user instantiating the template
+func (s *Service) CreateTemplate(ctx context.Context, authorID uuid.UUID, input TemplateInput) (areadomain.Template, error) {
+	if s.templates == nil {
+		return areadomain.Template{}, fmt.Errorf("area.Service.CreateTemplate: template repository unavailable")
+	}
+	if s.components == nil {
+		return areadomain.Template{}, fmt.Errorf("area.Service.CreateTemplate: component repository unavailable")
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		return areadomain.Template{}, fmt.Errorf("area.Service.CreateTemplate: %w", ErrNameRequired)
+	}
+	if utf8.RuneCountInString(name) > nameMaxLength {
+		return areadomain.Template{}, fmt.Errorf("area.Service.CreateTemplate: %w", ErrNameTooLong)
+	}
+	desc := strings.TrimSpace(input.Description)
+	if utf8.RuneCountInString(desc) > descriptionMaxLength {
+		return areadomain.Template{}, fmt.Errorf("area.Service.CreateTemplate: %w", ErrDescriptionTooLong)
+	}
+	if input.Action.ComponentID == uuid.Nil {
+		return areadomain.Template{}, fmt.Errorf("area.Service.CreateTemplate: %w", ErrActionComponentRequired)
+	}
+	if len(input.Reactions) == 0 {
+		return areadomain.Template{}, fmt.Errorf("area.Service.CreateTemplate: %w", ErrReactionsRequired)
+	}
+
+	ids := make([]uuid.UUID, 0, len(input.Reactions)+1)
+	ids = append(ids, input.Action.ComponentID)
+	for _, reaction := range input.Reactions {
+		ids = append(ids, reaction.ComponentID)
+	}
+	components, err := s.components.FindByIDs(ctx, ids)
+	if err != nil {
+		return areadomain.Template{}, fmt.Errorf("area.Service.CreateTemplate: components.FindByIDs: %w", err)
+	}
+
+	action, err := templateSlotFromInput(components, componentdomain.KindAction, input.Action)
+	if err != nil {
+		return areadomain.Template{}, fmt.Errorf("area.Service.CreateTemplate: action: %w", err)
+	}
+	now := s.clock.Now().UTC()
+	template := areadomain.Template{
+		Name:        name,
+		Description: desc,
+		Action:      action,
+		Reactions:   make([]areadomain.TemplateSlot, 0, len(input.Reactions)),
+		CreatedBy:   authorID,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	for idx, item := range input.Reactions {
+		reaction, err := templateSlotFromInput(components, componentdomain.KindReaction, item)
+		if err != nil {
+			return areadomain.Template{}, fmt.Errorf("area.Service.CreateTemplate: reaction %d: %w", idx+1, err)
+		}
+		template.Reactions = append(template.Reactions, reaction)
+	}
+
+	created, err := s.templates.Create(ctx, template)
+	if err != nil {
+		return areadomain.Template{}, fmt.Errorf("area.Service.CreateTemplate: templates.Create: %w", err)
+	}
+	created.Action.Component = template.Action.Component
+	for idx := range created.Reactions {
+		created.Reactions[idx].Component = template.Reactions[idx].Component
+	}
+	return created, nil
+}
+
+// DeleteTemplate unpublishes a template, automations created from it are left untouched
+func (s *Service) DeleteTemplate(ctx context.Context, templateID uuid.UUID) error {
+	if s.templates == nil {
+		return fmt.Errorf("area.Service.DeleteTemplate: template repository unavailable")
+	}
+	if err := s.templates.Delete(ctx, templateID); err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return fmt.Errorf("area.Service.DeleteTemplate: %w", ErrTemplateNotFound)
+		}
+		return fmt.Errorf("area.Service.DeleteTemplate: templates.Delete: %w", err)
+	}
+	return nil
+}
+
+// InstantiateTemplate creates an automation for the user from a template
+// Only unresolved params may be supplied, identity params left empty are bound to the user's linked account
+func (s *Service) InstantiateTemplate(ctx context.Context, userID uuid.UUID, templateID uuid.UUID, input TemplateInstantiation) (areadomain.Area, error) {
+	template, err := s.GetTemplate(ctx, templateID)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.InstantiateTemplate: %w", err)
+	}
+	if template.Action.Component == nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.InstantiateTemplate: %w", ErrActionComponentInvalid)
+	}
+	if len(input.Reactions) > len(template.Reactions) {
+		return areadomain.Area{}, fmt.Errorf("area.Service.InstantiateTemplate: %w: template has %d reactions", ErrComponentParamsInvalid, len(template.Reactions))
+	}
+
+	actionParams, err := s.fillTemplateParams(ctx, userID, template.Action, input.Action)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.InstantiateTemplate: action: %w", err)
+	}
+	reactions := make([]ReactionInput, 0, len(template.Reactions))
+	for idx, slot := range template.Reactions {
+		if slot.Component == nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.InstantiateTemplate: %w", ErrReactionComponentInvalid)
+		}
+		var values map[string]any
+		if idx < len(input.Reactions) {
+			values = input.Reactions[idx]
+		}
+		params, err := s.fillTemplateParams(ctx, userID, slot, values)
+		if err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.InstantiateTemplate: reaction %d: %w", idx+1, err)
+		}
+		reactions = append(reactions, ReactionInput{
+			ComponentID: slot.ComponentID,
+			Name:        slot.Name,
+			Params:      params,
+		})
+	}
+
+	name := strings.TrimSpace(input.Name)
+	if name == "" {
+		name = template.Name
+	}
+	desc := strings.TrimSpace(input.Description)
+	if desc == "" {
+		desc = template.Description
+	}
+	created, err := s.Create(ctx, userID, name, desc, ActionInput{
+		ComponentID: template.Action.ComponentID,
+		Name:        template.Action.Name,
+		Params:      actionParams,
+	}, reactions)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.InstantiateTemplate: %w", err)
+	}
+	return created, nil
+}
+
+// templateUnresolvedParams lists the component parameters a template leaves to the user
+func templateUnresolvedParams(slot areadomain.TemplateSlot) []string {
+	if slot.Component == nil {
+		return []string{}
+	}
+	specs, _ := extractParameterSpecs(slot.Component.Metadata)
+	keys := make([]string, 0, len(specs))
+	for _, spec := range specs {
+		if _, preset := slot.Params[spec.Key]; !preset {
+			keys = append(keys, spec.Key)
+		}
+	}
+	return keys
+}
+
+func (s *Service) hydrateTemplates(ctx context.Context, templates []areadomain.Template) error {
+	if len(templates) == 0 || s.components == nil {
+		return nil
+	}
+	ids := make([]uuid.UUID, 0, len(templates)*2)
+	for _, template := range templates {
+		ids = append(ids, template.ComponentIDs()...)
+	}
+	components, err := s.components.FindByIDs(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("components.FindByIDs: %w", err)
+	}
+	for idx := range templates {
+		templates[idx].Action.Component = lookupComponent(components, templates[idx].Action.ComponentID)
+		for pos := range templates[idx].Reactions {
+			templates[idx].Reactions[pos].Component = lookupComponent(components, templates[idx].Reactions[pos].ComponentID)
+		}
+	}
+	return nil
+}
+
+func lookupComponent(components map[uuid.UUID]componentdomain.Component, id uuid.UUID) *componentdomain.Component {
+	component, ok := components[id]
+	if !ok {
+		return nil
+	}
+	return &component
+}
+
+// templateUsable reports whether every component of the template exists, is enabled and belongs to the available set
+// A nil available set skips the subscription check
+func templateUsable(template areadomain.Template, available map[uuid.UUID]struct{}) bool {
+	slots := append([]areadomain.TemplateSlot{template.Action}, template.Reactions...)
+	for _, slot := range slots {
+		if slot.Component == nil || !slot.Component.Enabled {
+			return false
+		}
+		if available == nil {
+			continue
+		}
+		if _, ok := available[slot.ComponentID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func templateSlotFromInput(components map[uuid.UUID]componentdomain.Component, kind componentdomain.Kind, input TemplateSlotInput) (areadomain.TemplateSlot, error) {
+	invalid := ErrActionComponentInvalid
+	disabled := ErrActionComponentDisabled
+	if kind == componentdomain.KindReaction {
+		invalid = ErrReactionComponentInvalid
+		disabled = ErrReactionComponentDisabled
+	}
+	component, ok := components[input.ComponentID]
+	if !ok || component.Kind != kind {
+		return areadomain.TemplateSlot{}, invalid
+	}
+	if !component.Enabled {
+		return areadomain.TemplateSlot{}, disabled
+	}
+
+	specs, err := extractParameterSpecs(component.Metadata)
+	if err != nil {
+		return areadomain.TemplateSlot{}, fmt.Errorf("%w: %v", ErrComponentParamsInvalid, err)
+	}
+	params := cloneParamsMap(input.Params)
+	for _, spec := range specs {
+		value, present := params[spec.Key]
+		if !present {
+			continue
+		}
+		if spec.Type == parameterTypeIdentity {
+			delete(params, spec.Key)
+			continue
+		}
+		if err := spec.validate(value); err != nil {
+			return areadomain.TemplateSlot{}, fmt.Errorf("%w: parameter %q invalid: %v", ErrComponentParamsInvalid, spec.Key, err)
+		}
+	}
+	return areadomain.TemplateSlot{
+		ComponentID: component.ID,
+		Name:        strings.TrimSpace(input.Name),
+		Params:      params,
+		Component:   &component,
+	}, nil
+}
+
+// fillTemplateParams merges the user's values into the params preset by the template
+func (s *Service) fillTemplateParams(ctx context.Context, userID uuid.UUID, slot areadomain.TemplateSlot, values map[string]any) (map[string]any, error) {
+	unresolved := make(map[string]struct{})
+	for _, key := range templateUnresolvedParams(slot) {
+		unresolved[key] = struct{}{}
+	}
+	params := cloneParamsMap(slot.Params)
+	for key, value := range values {
+		if _, ok := unresolved[key]; !ok {
+			return nil, fmt.Errorf("%w: parameter %q is not configurable", ErrComponentParamsInvalid, key)
+		}
+		params[key] = value
+	}
+
+	specs, _ := extractParameterSpecs(slot.Component.Metadata)
+	for _, spec := range specs {
+		if spec.Type != parameterTypeIdentity || spec.Provider == "" || s.identities == nil {
+			continue
+		}
+		if _, ok := params[spec.Key]; ok {
+			continue
+		}
+		identity, err := s.identities.FindByUserAndProvider(ctx, userID, spec.Provider)
+		if err != nil {
+			if errors.Is(err, outbound.ErrNotFound) {
+				continue
+			}
+			return nil, fmt.Errorf("identities.FindByUserAndProvider: %w", err)
+		}
+		params[spec.Key] = identity.ID.String()
+	}
+	return params, nil
+}
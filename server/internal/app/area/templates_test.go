@@ -0,0 +1,211 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	componentview "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/components"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+type memoryTemplateRepo struct {
+	items map[uuid.UUID]areadomain.Template
+}
+
+func (r *memoryTemplateRepo) Create(ctx context.Context, template areadomain.Template) (areadomain.Template, error) {
+	if r.items == nil {
+		r.items = map[uuid.UUID]areadomain.Template{}
+	}
+	template.ID = uuid.New()
+	template.Action.Component = nil
+	reactions := make([]areadomain.TemplateSlot, 0, len(template.Reactions))
+	for _, reaction := range template.Reactions {
+		reaction.Component = nil
+		reactions = append(reactions, reaction)
+	}
+	template.Reactions = reactions
+	r.items[template.ID] = template
+	return template, nil
+}
+
+func (r *memoryTemplateRepo) FindByID(ctx context.Context, id uuid.UUID) (areadomain.Template, error) {
+	template, ok := r.items[id]
+	if !ok {
+		return areadomain.Template{}, outbound.ErrNotFound
+	}
+	return template, nil
+}
+
+func (r *memoryTemplateRepo) List(ctx context.Context) ([]areadomain.Template, error) {
+	templates := make([]areadomain.Template, 0, len(r.items))
+	for _, template := range r.items {
+		templates = append(templates, template)
+	}
+	return templates, nil
+}
+
+func (r *memoryTemplateRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	if _, ok := r.items[id]; !ok {
+		return outbound.ErrNotFound
+	}
+	delete(r.items, id)
+	return nil
+}
+
+type stubCatalog struct {
+	components []componentdomain.Component
+}
+
+func (s stubCatalog) ListAvailable(ctx context.Context, userID uuid.UUID, opts componentview.ListOptions) ([]componentdomain.Component, error) {
+	return s.components, nil
+}
+
+func templateTestComponents() (componentdomain.Component, componentdomain.Component) {
+	action := componentdomain.Component{
+		ID:         uuid.New(),
+		ProviderID: uuid.New(),
+		Kind:       componentdomain.KindAction,
+		Name:       "repo_new_stars",
+		Enabled:    true,
+		Provider:   componentdomain.Provider{Name: "github"},
+		Metadata: map[string]any{
+			"parameters": []any{
+				map[string]any{"key": "owner", "type": "text", "required": true},
+				map[string]any{"key": "repository", "type": "text", "required": true},
+			},
+		},
+	}
+	reaction := componentdomain.Component{
+		ID:         uuid.New(),
+		ProviderID: uuid.New(),
+		Kind:       componentdomain.KindReaction,
+		Name:       "slack_post_message",
+		Enabled:    true,
+		Provider:   componentdomain.Provider{Name: "slack"},
+		Metadata: map[string]any{
+			"parameters": []any{
+				map[string]any{"key": "identityId", "type": "identity", "provider": "slack", "required": true},
+				map[string]any{"key": "channelId", "type": "text", "required": true},
+				map[string]any{"key": "text", "type": "text", "required": true},
+			},
+		},
+	}
+	return action, reaction
+}
+
+func TestServiceTemplateLifecycle(t *testing.T) {
+	ctx := context.Background()
+	action, reaction := templateTestComponents()
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{action.ID: action, reaction.ID: reaction}}
+	userID := uuid.New()
+	identity := identitydomain.Identity{ID: uuid.New(), UserID: userID, Provider: "slack"}
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}}
+	templates := &memoryTemplateRepo{}
+	svc := NewService(repo, components, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil,
+		WithTemplateRepository(templates),
+		WithComponentCatalog(stubCatalog{components: []componentdomain.Component{action, reaction}}),
+		WithIdentityRepository(&identityRepoStub{identity: identity}))
+
+	template, err := svc.CreateTemplate(ctx, uuid.New(), TemplateInput{
+		Name:   "GitHub star to Slack message",
+		Action: TemplateSlotInput{ComponentID: action.ID},
+		Reactions: []TemplateSlotInput{{
+			ComponentID: reaction.ID,
+			Params:      map[string]any{"text": "New star", "identityId": uuid.NewString()},
+		}},
+	})
+	if err != nil {
+		t.Fatalf("CreateTemplate returned error: %v", err)
+	}
+	if _, ok := template.Reactions[0].Params["identityId"]; ok {
+		t.Fatalf("identity param should be stripped from templates")
+	}
+	if got := templateUnresolvedParams(template.Reactions[0]); len(got) != 2 || got[0] != "identityId" || got[1] != "channelId" {
+		t.Fatalf("unexpected unresolved params %v", got)
+	}
+
+	listed, err := svc.ListTemplates(ctx, userID)
+	if err != nil {
+		t.Fatalf("ListTemplates returned error: %v", err)
+	}
+	if len(listed) != 1 || listed[0].Action.Component == nil || listed[0].Action.Component.Name != "repo_new_stars" {
+		t.Fatalf("unexpected templates %+v", listed)
+	}
+
+	_, err = svc.InstantiateTemplate(ctx, userID, template.ID, TemplateInstantiation{
+		Action:    map[string]any{"owner": "octo", "repository": "area"},
+		Reactions: []map[string]any{{"text": "override"}},
+	})
+	if !errors.Is(err, ErrComponentParamsInvalid) {
+		t.Fatalf("expected preset params to be rejected got %v", err)
+	}
+
+	created, err := svc.InstantiateTemplate(ctx, userID, template.ID, TemplateInstantiation{
+		Action:    map[string]any{"owner": "octo", "repository": "area"},
+		Reactions: []map[string]any{{"channelId": "C123"}},
+	})
+	if err != nil {
+		t.Fatalf("InstantiateTemplate returned error: %v", err)
+	}
+	stored := repo.items[created.ID]
+	if stored.UserID != userID || stored.Name != "GitHub star to Slack message" {
+		t.Fatalf("unexpected area %+v", stored)
+	}
+	params := stored.Reactions[0].Config.Params
+	if params["text"] != "New star" || params["channelId"] != "C123" || params["identityId"] != identity.ID.String() {
+		t.Fatalf("unexpected reaction params %+v", params)
+	}
+
+	if err := svc.DeleteTemplate(ctx, template.ID); err != nil {
+		t.Fatalf("DeleteTemplate returned error: %v", err)
+	}
+	if _, err := svc.GetTemplate(ctx, template.ID); !errors.Is(err, ErrTemplateNotFound) {
+		t.Fatalf("expected ErrTemplateNotFound got %v", err)
+	}
+}
+
+func TestServiceListTemplatesFiltersUnavailableProviders(t *testing.T) {
+	action, reaction := templateTestComponents()
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{action.ID: action, reaction.ID: reaction}}
+	templates := &memoryTemplateRepo{items: map[uuid.UUID]areadomain.Template{}}
+	id := uuid.New()
+	templates.items[id] = areadomain.Template{
+		ID:        id,
+		Name:      "Stars",
+		Action:    areadomain.TemplateSlot{ComponentID: action.ID},
+		Reactions: []areadomain.TemplateSlot{{ComponentID: reaction.ID}},
+	}
+	svc := NewService(&memoryAreaRepo{}, components, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil,
+		WithTemplateRepository(templates),
+		WithComponentCatalog(stubCatalog{components: []componentdomain.Component{action}}))
+
+	listed, err := svc.ListTemplates(context.Background(), uuid.New())
+	if err != nil {
+		t.Fatalf("ListTemplates returned error: %v", err)
+	}
+	if len(listed) != 0 {
+		t.Fatalf("expected template needing slack to be hidden got %d", len(listed))
+	}
+}
+
+func TestServiceCreateTemplateRejectsKindMismatch(t *testing.T) {
+	action, reaction := templateTestComponents()
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{action.ID: action, reaction.ID: reaction}}
+	svc := NewService(&memoryAreaRepo{}, components, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil,
+		WithTemplateRepository(&memoryTemplateRepo{}))
+
+	_, err := svc.CreateTemplate(context.Background(), uuid.New(), TemplateInput{
+		Name:      "Broken",
+		Action:    TemplateSlotInput{ComponentID: reaction.ID},
+		Reactions: []TemplateSlotInput{{ComponentID: reaction.ID}},
+	})
+	if !errors.Is(err, ErrActionComponentInvalid) {
+		t.Fatalf("expected ErrActionComponentInvalid got %v", err)
+	}
+}
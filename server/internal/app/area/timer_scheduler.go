@@ -2,6 +2,7 @@ package area
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
@@ -119,12 +120,15 @@ func (s *TimerScheduler) executeBinding(ctx context.Context, binding actiondomai
 		return
 	}
 
+	// Ticks outside the activity schedule still reach the pipeline so they are recorded as filtered triggers
 	execErr := s.executor.ExecuteWithOptions(ctx, binding.UserID, binding.AreaID, ExecutionOptions{
 		SourceID:   binding.Source.ID,
 		Payload:    cloneMap(binding.Source.Cursor),
 		OccurredAt: now,
 	})
-	if execErr != nil {
+	if errors.Is(execErr, ErrExecutionQuotaExceeded) {
+		s.log().Warn("timer execution rejected", zap.Error(execErr), zap.String("area_id", binding.AreaID.String()))
+	} else if execErr != nil {
 		s.log().Error("timer execution failed", zap.Error(execErr), zap.String("area_id", binding.AreaID.String()))
 	}
 
@@ -0,0 +1,62 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+// WebhookEndpoint describes where an area with a webhook action receives its events
+type WebhookEndpoint struct {
+	Path   string
+	URL    string
+	Secret string
+}
+
+// WebhookEndpoint returns the URL and secret provisioned for the area's webhook action
+// Areas are provisioned when enabled, so disabled areas that never ran report ErrWebhookNotFound
+// The secret signs requests on behalf of the area, so shared areas require the editor role
+func (s *Service) WebhookEndpoint(ctx context.Context, userID uuid.UUID, areaID uuid.UUID) (WebhookEndpoint, error) {
+	if s.sources == nil {
+		return WebhookEndpoint{}, fmt.Errorf("area.Service.WebhookEndpoint: source repository unavailable")
+	}
+	area, err := s.Get(ctx, userID, areaID)
+	if err != nil {
+		return WebhookEndpoint{}, fmt.Errorf("area.Service.WebhookEndpoint: %w", err)
+	}
+	if err := s.authorizeArea(ctx, userID, area, workspacedomain.RoleEditor); err != nil {
+		return WebhookEndpoint{}, fmt.Errorf("area.Service.WebhookEndpoint: %w", err)
+	}
+	if area.Action == nil {
+		return WebhookEndpoint{}, fmt.Errorf("area.Service.WebhookEndpoint: %w", ErrAreaMisconfigured)
+	}
+	if componentIngestionMode(area.Action.Config.Component, area.Action.Config.Params) != ingestionModeWebhook {
+		return WebhookEndpoint{}, fmt.Errorf("area.Service.WebhookEndpoint: %w", ErrWebhookNotFound)
+	}
+
+	source, err := s.sources.FindByComponentConfig(ctx, area.Action.Config.ID)
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return WebhookEndpoint{}, fmt.Errorf("area.Service.WebhookEndpoint: %w", ErrWebhookNotFound)
+		}
+		return WebhookEndpoint{}, fmt.Errorf("area.Service.WebhookEndpoint: sources.FindByComponentConfig: %w", err)
+	}
+	if source.WebhookURLPath == nil || strings.TrimSpace(*source.WebhookURLPath) == "" {
+		return WebhookEndpoint{}, fmt.Errorf("area.Service.WebhookEndpoint: %w", ErrWebhookNotFound)
+	}
+
+	endpoint := WebhookEndpoint{Path: strings.Trim(strings.TrimSpace(*source.WebhookURLPath), "/")}
+	if source.WebhookSecret != nil {
+		endpoint.Secret = *source.WebhookSecret
+	}
+	endpoint.URL = "/" + endpoint.Path
+	if s.webhookBase != "" {
+		endpoint.URL = s.webhookBase + endpoint.URL
+	}
+	return endpoint, nil
+}
@@ -0,0 +1,248 @@
+package area
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+const (
+	webhookSignatureHMACSHA256 = "hmac-sha256"
+)
+
+// webhookEventConfig describes how webhook deliveries are authenticated, filtered and normalised
+type webhookEventConfig struct {
+	Signature      *webhookSignatureConfig
+	EventHeader    string
+	DeliveryHeader string
+	Events         []string
+	Match          []payloadMatchRule
+	Normalize      string
+}
+
+type webhookSignatureConfig struct {
+	Header    string
+	Algorithm string
+	Prefix    string
+}
+
+// payloadMatchRule requires the value at Path to equal the rendered Equals template or one of OneOf
+type payloadMatchRule struct {
+	Path        []string
+	Equals      string
+	OneOf       []string
+	SkipIfEmpty bool
+}
+
+func parseWebhookEventConfig(metadata map[string]any) (webhookEventConfig, bool, error) {
+	ingest, ok, err := ingestionMetadata(metadata)
+	if err != nil || !ok {
+		return webhookEventConfig{}, false, err
+	}
+	raw, ok := ingest["webhook"]
+	if !ok {
+		return webhookEventConfig{}, false, nil
+	}
+	values, err := toMapStringAny(raw)
+	if err != nil {
+		return webhookEventConfig{}, false, fmt.Errorf("webhook metadata invalid: %w", err)
+	}
+
+	cfg := webhookEventConfig{
+		EventHeader:    strings.TrimSpace(stringOrDefault(values, "eventHeader", "")),
+		DeliveryHeader: strings.TrimSpace(stringOrDefault(values, "deliveryHeader", "")),
+		Normalize:      strings.ToLower(strings.TrimSpace(stringOrDefault(values, "normalize", ""))),
+	}
+	if cfg.Events, err = toStringSlice(values["events"]); err != nil {
+		return webhookEventConfig{}, false, fmt.Errorf("webhook events invalid: %w", err)
+	}
+
+	if rawSignature, ok := values["signature"]; ok {
+		signature, err := toMapStringAny(rawSignature)
+		if err != nil {
+			return webhookEventConfig{}, false, fmt.Errorf("webhook signature invalid: %w", err)
+		}
+		cfg.Signature = &webhookSignatureConfig{
+			Header:    strings.TrimSpace(stringOrDefault(signature, "header", "")),
+			Algorithm: strings.ToLower(strings.TrimSpace(stringOrDefault(signature, "algorithm", webhookSignatureHMACSHA256))),
+			Prefix:    stringOrDefault(signature, "prefix", ""),
+		}
+		if cfg.Signature.Header == "" {
+			return webhookEventConfig{}, false, fmt.Errorf("webhook signature header missing")
+		}
+		if cfg.Signature.Algorithm != webhookSignatureHMACSHA256 {
+			return webhookEventConfig{}, false, fmt.Errorf("webhook signature algorithm %q unsupported", cfg.Signature.Algorithm)
+		}
+	}
+
+	for _, raw := range []any{ingest["match"], values["match"]} {
+		rules, err := parsePayloadMatchRules(raw)
+		if err != nil {
+			return webhookEventConfig{}, false, fmt.Errorf("webhook %w", err)
+		}
+		cfg.Match = append(cfg.Match, rules...)
+	}
+	return cfg, true, nil
+}
+
+func parsePayloadMatchRules(raw any) ([]payloadMatchRule, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	entries, ok := raw.([]any)
+	if !ok {
+		return nil, fmt.Errorf("match not an array")
+	}
+	rules := make([]payloadMatchRule, 0, len(entries))
+	for index, entry := range entries {
+		ruleMap, err := toMapStringAny(entry)
+		if err != nil {
+			return nil, fmt.Errorf("match[%d] invalid: %w", index, err)
+		}
+		path := strings.TrimSpace(stringOrDefault(ruleMap, "path", ""))
+		if path == "" {
+			return nil, fmt.Errorf("match[%d] path missing", index)
+		}
+		oneOf, err := toStringSlice(ruleMap["oneOf"])
+		if err != nil {
+			return nil, fmt.Errorf("match[%d] oneOf invalid: %w", index, err)
+		}
+		rule := payloadMatchRule{
+			Path:        splitPath(path),
+			Equals:      stringOrDefault(ruleMap, "equals", ""),
+			OneOf:       oneOf,
+			SkipIfEmpty: boolOrDefault(ruleMap, "skipIfEmpty"),
+		}
+		if rule.Equals == "" && len(rule.OneOf) == 0 {
+			return nil, fmt.Errorf("match[%d] requires equals or oneOf", index)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+// verify checks the delivery signature against the shared secret of the webhook source
+func (s webhookSignatureConfig) verify(secret string, headers http.Header, body []byte) bool {
+	provided := strings.TrimSpace(headers.Get(s.Header))
+	if provided == "" || secret == "" {
+		return false
+	}
+	if s.Prefix != "" {
+		if !strings.HasPrefix(provided, s.Prefix) {
+			return false
+		}
+		provided = strings.TrimPrefix(provided, s.Prefix)
+	}
+	signature, err := hex.DecodeString(provided)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return hmac.Equal(signature, mac.Sum(nil))
+}
+
+func (cfg webhookEventConfig) eventName(headers http.Header) string {
+	if cfg.EventHeader == "" || headers == nil {
+		return ""
+	}
+	return strings.ToLower(strings.TrimSpace(headers.Get(cfg.EventHeader)))
+}
+
+func (cfg webhookEventConfig) deliveryID(headers http.Header) string {
+	if cfg.DeliveryHeader == "" || headers == nil {
+		return ""
+	}
+	return strings.TrimSpace(headers.Get(cfg.DeliveryHeader))
+}
+
+// accepts reports whether the delivery should trigger the area along with the rejection reason
+func (cfg webhookEventConfig) accepts(event string, payload map[string]any, params map[string]any) (bool, string) {
+	if len(cfg.Events) > 0 {
+		matched := false
+		for _, candidate := range cfg.Events {
+			if strings.EqualFold(candidate, event) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false, fmt.Sprintf("event %q not subscribed", event)
+		}
+	}
+	return matchPayload(cfg.Match, payload, params)
+}
+
+// matchPayload evaluates every rule against the payload and returns the first rejection reason
+func matchPayload(rules []payloadMatchRule, payload map[string]any, params map[string]any) (bool, string) {
+	for _, rule := range rules {
+		expected := make([]string, 0, len(rule.OneOf)+1)
+		if rule.Equals != "" {
+			value := renderParamsTemplate(rule.Equals, params)
+			base, hasPlaceholder := computeTemplateInfo(rule.Equals)
+			if shouldTreatAsEmpty(value, hasPlaceholder, base) {
+				if rule.SkipIfEmpty {
+					continue
+				}
+				return false, fmt.Sprintf("%s expected value empty", strings.Join(rule.Path, "."))
+			}
+			expected = append(expected, value)
+		}
+		expected = append(expected, rule.OneOf...)
+
+		actual, err := resolvePath(payload, rule.Path)
+		if err != nil || !matchesAnyValue(actual, expected) {
+			return false, fmt.Sprintf("%s does not match", strings.Join(rule.Path, "."))
+		}
+	}
+	return true, ""
+}
+
+func matchesAnyValue(actual any, expected []string) bool {
+	if values, ok := actual.([]any); ok {
+		for _, value := range values {
+			if matchesAnyValue(value, expected) {
+				return true
+			}
+		}
+		return false
+	}
+	text := strings.TrimSpace(stringify(actual))
+	for _, candidate := range expected {
+		if strings.EqualFold(text, strings.TrimSpace(candidate)) {
+			return true
+		}
+	}
+	return false
+}
+
+func renderParamsTemplate(template string, params map[string]any) string {
+	return placeholderPattern.ReplaceAllStringFunc(template, func(match string) string {
+		submatches := placeholderPattern.FindStringSubmatch(match)
+		if len(submatches) != 3 || submatches[1] != "params" {
+			return ""
+		}
+		return stringify(params[submatches[2]])
+	})
+}
+
+// normalizeWebhookPayload applies the provider specific payload normaliser declared by the component
+func normalizeWebhookPayload(normalizer string, event string, payload map[string]any) map[string]any {
+	switch normalizer {
+	case githubNormalizer:
+		return normalizeGitHubWebhookPayload(event, payload)
+	default:
+		return payload
+	}
+}
+
+// normalizePollingItem applies the provider specific item normaliser declared by the component
+func normalizePollingItem(normalizer string, item map[string]any, params map[string]any) map[string]any {
+	if strings.HasPrefix(normalizer, githubNormalizerPrefix) {
+		return normalizeGitHubPollingItem(strings.TrimPrefix(normalizer, githubNormalizerPrefix), item, params)
+	}
+	return cloneMapAny(item)
+}
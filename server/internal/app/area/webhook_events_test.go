@@ -0,0 +1,118 @@
+package area
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func githubSignature(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func githubWebhookMetadata(events []any, match []any) map[string]any {
+	return map[string]any{
+		"ingestion": map[string]any{
+			"mode":      "polling",
+			"modes":     []any{"polling", "webhook"},
+			"modeParam": "deliveryMode",
+			"webhook": map[string]any{
+				"signature": map[string]any{
+					"header":    "X-Hub-Signature-256",
+					"algorithm": "hmac-sha256",
+					"prefix":    "sha256=",
+				},
+				"eventHeader":    "X-GitHub-Event",
+				"deliveryHeader": "X-GitHub-Delivery",
+				"events":         events,
+				"normalize":      "github",
+			},
+			"match": match,
+		},
+	}
+}
+
+func TestWebhookSignatureVerify(t *testing.T) {
+	body := []byte(`{"zen":"Keep it logically awesome."}`)
+	signature := webhookSignatureConfig{Header: "X-Hub-Signature-256", Algorithm: webhookSignatureHMACSHA256, Prefix: "sha256="}
+
+	headers := http.Header{}
+	headers.Set("X-Hub-Signature-256", githubSignature("secret", body))
+	if !signature.verify("secret", headers, body) {
+		t.Fatalf("expected valid signature")
+	}
+	if signature.verify("other", headers, body) {
+		t.Fatalf("expected signature mismatch with another secret")
+	}
+	if signature.verify("secret", headers, append(body, ' ')) {
+		t.Fatalf("expected signature mismatch with a tampered body")
+	}
+
+	headers.Set("X-Hub-Signature-256", strings.TrimPrefix(githubSignature("secret", body), "sha256="))
+	if signature.verify("secret", headers, body) {
+		t.Fatalf("expected missing prefix to be rejected")
+	}
+}
+
+func TestParseWebhookEventConfig(t *testing.T) {
+	metadata := githubWebhookMetadata([]any{"issues"}, []any{
+		map[string]any{"path": "action", "oneOf": []any{"opened", "labeled"}},
+	})
+	cfg, ok, err := parseWebhookEventConfig(metadata)
+	if err != nil || !ok {
+		t.Fatalf("parseWebhookEventConfig() ok=%v err=%v", ok, err)
+	}
+	if cfg.Signature == nil || cfg.Signature.Header != "X-Hub-Signature-256" {
+		t.Fatalf("unexpected signature config %+v", cfg.Signature)
+	}
+	if len(cfg.Match) != 1 || len(cfg.Events) != 1 {
+		t.Fatalf("unexpected config %+v", cfg)
+	}
+
+	metadata["ingestion"].(map[string]any)["webhook"].(map[string]any)["signature"] = map[string]any{
+		"header":    "X-Signature",
+		"algorithm": "md5",
+	}
+	if _, _, err := parseWebhookEventConfig(metadata); err == nil {
+		t.Fatalf("expected unsupported algorithm error")
+	}
+
+	if _, ok, err := parseWebhookEventConfig(map[string]any{"ingestion": map[string]any{"mode": "webhook"}}); err != nil || ok {
+		t.Fatalf("expected no event config, got ok=%v err=%v", ok, err)
+	}
+}
+
+func TestWebhookEventConfigAccepts(t *testing.T) {
+	cfg, _, err := parseWebhookEventConfig(githubWebhookMetadata([]any{"issues"}, []any{
+		map[string]any{"path": "action", "oneOf": []any{"opened", "labeled"}},
+		map[string]any{"path": "labelNames", "equals": "{{params.label}}", "skipIfEmpty": true},
+	}))
+	if err != nil {
+		t.Fatalf("parseWebhookEventConfig() error = %v", err)
+	}
+
+	payload := map[string]any{
+		"action":     "labeled",
+		"labelNames": []any{"triage", "bug"},
+	}
+	if ok, reason := cfg.accepts("issues", payload, map[string]any{"label": "Bug"}); !ok {
+		t.Fatalf("expected labeled issue to be accepted: %s", reason)
+	}
+	if ok, _ := cfg.accepts("issues", payload, map[string]any{"label": "docs"}); ok {
+		t.Fatalf("expected issue without the label to be rejected")
+	}
+	if ok, reason := cfg.accepts("issues", payload, map[string]any{}); !ok {
+		t.Fatalf("expected empty label filter to be skipped: %s", reason)
+	}
+	if ok, _ := cfg.accepts("issues", map[string]any{"action": "closed"}, nil); ok {
+		t.Fatalf("expected closed action to be rejected")
+	}
+	if ok, reason := cfg.accepts("push", payload, nil); ok || !strings.Contains(reason, "push") {
+		t.Fatalf("expected unsubscribed event to be rejected, got %v %q", ok, reason)
+	}
+}
@@ -1,6 +1,7 @@
 package area
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"io"
@@ -50,7 +51,12 @@ func (h *WebhookHandler) Handle(c *gin.Context) {
 	fingerprint := strings.TrimSpace(c.GetHeader(webhookEventIDHeader))
 	occurredAt := parseEventTime(c.GetHeader(webhookEventTimeHeader))
 
-	payload, err := decodeWebhookPayload(c)
+	body, err := readWebhookBody(c)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+	payload, err := decodeWebhookPayload(c, body)
 	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
 		return
@@ -62,7 +68,16 @@ func (h *WebhookHandler) Handle(c *gin.Context) {
 		payload["headers"] = headersToMap(c.Request.Header)
 	}
 
-	if err := h.service.ProcessWebhook(c.Request.Context(), fullPath, secret, payload, fingerprint, occurredAt); err != nil {
+	request := WebhookRequest{
+		Path:        fullPath,
+		Secret:      secret,
+		Headers:     c.Request.Header.Clone(),
+		Body:        body,
+		Payload:     payload,
+		Fingerprint: fingerprint,
+		OccurredAt:  occurredAt,
+	}
+	if err := h.service.ProcessWebhookRequest(c.Request.Context(), request); err != nil {
 		h.handleError(c, err)
 		return
 	}
@@ -80,25 +95,45 @@ func (h *WebhookHandler) handleError(c *gin.Context, err error) {
 		c.JSON(http.StatusUnauthorized, gin.H{"error": "webhook secret missing"})
 	case errors.Is(err, ErrWebhookSecretInvalid):
 		c.JSON(http.StatusForbidden, gin.H{"error": "webhook secret invalid"})
+	case errors.Is(err, ErrWebhookPayloadInvalid):
+		var payloadErr *WebhookPayloadError
+		if errors.As(err, &payloadErr) {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "webhook payload invalid", "details": payloadErr.Problems})
+			return
+		}
+		c.JSON(http.StatusUnprocessableEntity, gin.H{"error": "webhook payload invalid"})
+	case errors.Is(err, ErrWebhookEventIgnored):
+		h.log().Debug("webhook event ignored", zap.Error(err))
+		c.JSON(http.StatusAccepted, gin.H{"status": "ignored"})
 	case errors.Is(err, ErrAreaNotOwned):
 		c.JSON(http.StatusForbidden, gin.H{"error": "not owner"})
+	case errors.Is(err, ErrExecutionQuotaExceeded):
+		h.log().Warn("webhook execution rejected", zap.Error(err))
+		c.JSON(http.StatusTooManyRequests, gin.H{"error": "execution quota exceeded"})
 	default:
 		h.log().Error("webhook processing failed", zap.Error(err))
 		c.JSON(http.StatusInternalServerError, gin.H{"error": "webhook processing failed"})
 	}
 }
 
-func decodeWebhookPayload(c *gin.Context) (map[string]any, error) {
-	payload := make(map[string]any)
+func readWebhookBody(c *gin.Context) ([]byte, error) {
 	body := c.Request.Body
 	if body == nil {
-		return payload, nil
+		return nil, nil
 	}
 	defer func() { _ = body.Close() }()
+	return io.ReadAll(body)
+}
+
+func decodeWebhookPayload(c *gin.Context, body []byte) (map[string]any, error) {
+	payload := make(map[string]any)
+	if len(body) == 0 {
+		return payload, nil
+	}
 
 	contentType := strings.ToLower(strings.TrimSpace(c.GetHeader("Content-Type")))
 	if strings.Contains(contentType, "application/json") {
-		decoder := json.NewDecoder(body)
+		decoder := json.NewDecoder(bytes.NewReader(body))
 		decoder.UseNumber()
 		if err := decoder.Decode(&payload); err != nil && err != io.EOF {
 			return nil, err
@@ -109,13 +144,7 @@ func decodeWebhookPayload(c *gin.Context) (map[string]any, error) {
 		return payload, nil
 	}
 
-	bytes, err := io.ReadAll(body)
-	if err != nil {
-		return nil, err
-	}
-	if len(bytes) > 0 {
-		payload["body"] = string(bytes)
-	}
+	payload["body"] = string(body)
 	return payload, nil
 }
 
@@ -109,21 +109,19 @@ type webhookConfig struct {
 func decodeWebhookConfig(metadata map[string]any, area areadomain.Area) (webhookConfig, bool, error) {
 	cfg := webhookConfig{}
 
-	ingestRaw, ok := metadata["ingestion"]
-	if !ok {
-		return cfg, false, nil
-	}
-
-	ingest, err := toMapStringAny(ingestRaw)
+	ingest, ok, err := ingestionMetadata(metadata)
 	if err != nil {
 		return cfg, false, err
 	}
-
-	mode, err := toStringLower(ingest["mode"])
-	if err != nil {
+	if !ok {
 		return cfg, false, nil
 	}
-	if mode != "webhook" {
+
+	var params map[string]any
+	if area.Action != nil {
+		params = area.Action.Config.Params
+	}
+	if resolveIngestionMode(ingest, params) != ingestionModeWebhook {
 		return cfg, false, nil
 	}
 
@@ -0,0 +1,505 @@
+package area
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	webhookSchemaInvalidReject = "reject"
+	webhookSchemaInvalidMark   = "mark"
+
+	// webhookSchemaPayloadKey holds the validation outcome when invalid payloads are marked
+	webhookSchemaPayloadKey = "validation"
+	// webhookSchemaMaxProblems bounds the number of violations reported for a payload
+	webhookSchemaMaxProblems = 20
+)
+
+// WebhookPayloadError lists the schema violations of a rejected webhook payload
+type WebhookPayloadError struct {
+	Problems []string
+}
+
+func (e *WebhookPayloadError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrWebhookPayloadInvalid, strings.Join(e.Problems, "; "))
+}
+
+// Is matches ErrWebhookPayloadInvalid so callers can test the error with errors.Is
+func (e *WebhookPayloadError) Is(target error) bool {
+	return target == ErrWebhookPayloadInvalid
+}
+
+// webhookSchemaConfig binds a user supplied JSON Schema to the way invalid payloads are handled
+type webhookSchemaConfig struct {
+	schema    *payloadSchema
+	onInvalid string
+}
+
+// parseWebhookSchemaConfig reads the ingestion.schema block which names the params holding the
+// JSON Schema document and the invalid payload policy
+// It reports false when the component does not validate payloads or the user left the schema empty
+func parseWebhookSchemaConfig(metadata map[string]any, params map[string]any) (webhookSchemaConfig, bool, error) {
+	ingestion, ok, err := ingestionMetadata(metadata)
+	if err != nil || !ok {
+		return webhookSchemaConfig{}, false, err
+	}
+	rawSchema, ok := ingestion["schema"]
+	if !ok {
+		return webhookSchemaConfig{}, false, nil
+	}
+	spec, err := toMapStringAny(rawSchema)
+	if err != nil {
+		return webhookSchemaConfig{}, false, fmt.Errorf("schema metadata invalid: %w", err)
+	}
+
+	document := strings.TrimSpace(stringOrDefault(params, stringOrDefault(spec, "param", "schema"), ""))
+	if document == "" {
+		return webhookSchemaConfig{}, false, nil
+	}
+	schema, err := compilePayloadSchema(document)
+	if err != nil {
+		return webhookSchemaConfig{}, false, err
+	}
+
+	onInvalid := strings.ToLower(strings.TrimSpace(stringOrDefault(params, stringOrDefault(spec, "onInvalidParam", "onInvalid"), "")))
+	switch onInvalid {
+	case "":
+		onInvalid = webhookSchemaInvalidReject
+	case webhookSchemaInvalidReject, webhookSchemaInvalidMark:
+	default:
+		return webhookSchemaConfig{}, false, fmt.Errorf("invalid payload policy %q not supported", onInvalid)
+	}
+	return webhookSchemaConfig{schema: schema, onInvalid: onInvalid}, true, nil
+}
+
+// check validates the raw request body and either rejects it or records the outcome in the payload
+func (c webhookSchemaConfig) check(body []byte, payload map[string]any) error {
+	problems := c.schema.validateBody(body)
+	if len(problems) > 0 && c.onInvalid == webhookSchemaInvalidReject {
+		return &WebhookPayloadError{Problems: problems}
+	}
+	outcome := map[string]any{"valid": len(problems) == 0}
+	if len(problems) > 0 {
+		errs := make([]any, 0, len(problems))
+		for _, problem := range problems {
+			errs = append(errs, problem)
+		}
+		outcome["errors"] = errs
+	}
+	payload[webhookSchemaPayloadKey] = outcome
+	return nil
+}
+
+// payloadSchema is a compiled subset of JSON Schema covering the keywords useful to vet webhook bodies:
+// type, enum, const, required, properties, additionalProperties, items, minItems, maxItems,
+// minLength, maxLength, pattern, minimum, maximum, exclusiveMinimum and exclusiveMaximum
+// Annotations are accepted, any other keyword is refused so a schema never validates less than it reads
+type payloadSchema struct {
+	types                []string
+	enum                 []any
+	constant             any
+	hasConst             bool
+	required             []string
+	properties           map[string]*payloadSchema
+	additional           *payloadSchema
+	additionalForbidden  bool
+	items                *payloadSchema
+	minItems, maxItems   *int
+	minLength, maxLength *int
+	pattern              *regexp.Regexp
+	minimum, maximum     *float64
+	exclusiveMin         *float64
+	exclusiveMax         *float64
+}
+
+var payloadSchemaTypes = map[string]struct{}{
+	"object": {}, "array": {}, "string": {}, "number": {}, "integer": {}, "boolean": {}, "null": {},
+}
+
+// payloadSchemaKeywords lists the keywords a schema may use, the annotations having no effect on validation
+var payloadSchemaKeywords = map[string]struct{}{
+	"type": {}, "enum": {}, "const": {}, "required": {}, "properties": {}, "additionalProperties": {},
+	"items": {}, "minItems": {}, "maxItems": {}, "minLength": {}, "maxLength": {}, "pattern": {},
+	"minimum": {}, "maximum": {}, "exclusiveMinimum": {}, "exclusiveMaximum": {},
+	"$schema": {}, "$id": {}, "$comment": {}, "title": {}, "description": {}, "default": {},
+	"examples": {}, "deprecated": {}, "readOnly": {}, "writeOnly": {},
+}
+
+func compilePayloadSchema(document string) (*payloadSchema, error) {
+	raw, err := decodeJSONDocument([]byte(document))
+	if err != nil {
+		return nil, fmt.Errorf("schema is not valid JSON: %w", err)
+	}
+	definition, ok := raw.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("schema must be a JSON object")
+	}
+	return compileSchemaNode(definition, "$")
+}
+
+func compileSchemaNode(definition map[string]any, location string) (*payloadSchema, error) {
+	var unsupported []string
+	for keyword := range definition {
+		if _, ok := payloadSchemaKeywords[keyword]; !ok {
+			unsupported = append(unsupported, strconv.Quote(keyword))
+		}
+	}
+	if len(unsupported) > 0 {
+		sort.Strings(unsupported)
+		return nil, fmt.Errorf("%s: keywords %s not supported", location, strings.Join(unsupported, ", "))
+	}
+
+	schema := &payloadSchema{}
+
+	if raw, ok := definition["type"]; ok {
+		var names []string
+		switch v := raw.(type) {
+		case string:
+			names = []string{v}
+		case []any:
+			for _, item := range v {
+				name, ok := item.(string)
+				if !ok {
+					return nil, fmt.Errorf("%s: type entries must be strings", location)
+				}
+				names = append(names, name)
+			}
+		default:
+			return nil, fmt.Errorf("%s: type must be a string or an array", location)
+		}
+		for _, name := range names {
+			if _, known := payloadSchemaTypes[name]; !known {
+				return nil, fmt.Errorf("%s: unknown type %q", location, name)
+			}
+		}
+		schema.types = names
+	}
+
+	if raw, ok := definition["enum"]; ok {
+		values, ok := raw.([]any)
+		if !ok || len(values) == 0 {
+			return nil, fmt.Errorf("%s: enum must be a non-empty array", location)
+		}
+		schema.enum = values
+	}
+	if raw, ok := definition["const"]; ok {
+		schema.constant = raw
+		schema.hasConst = true
+	}
+
+	if raw, ok := definition["required"]; ok {
+		values, ok := raw.([]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: required must be an array", location)
+		}
+		for _, item := range values {
+			name, ok := item.(string)
+			if !ok {
+				return nil, fmt.Errorf("%s: required entries must be strings", location)
+			}
+			schema.required = append(schema.required, name)
+		}
+	}
+
+	if raw, ok := definition["properties"]; ok {
+		properties, ok := raw.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%s: properties must be an object", location)
+		}
+		schema.properties = make(map[string]*payloadSchema, len(properties))
+		for name, item := range properties {
+			child, err := compileSchemaChild(item, location+"."+name)
+			if err != nil {
+				return nil, err
+			}
+			schema.properties[name] = child
+		}
+	}
+
+	if raw, ok := definition["additionalProperties"]; ok {
+		switch v := raw.(type) {
+		case bool:
+			schema.additionalForbidden = !v
+		default:
+			child, err := compileSchemaChild(v, location+".additionalProperties")
+			if err != nil {
+				return nil, err
+			}
+			schema.additional = child
+		}
+	}
+
+	if raw, ok := definition["items"]; ok {
+		child, err := compileSchemaChild(raw, location+"[]")
+		if err != nil {
+			return nil, err
+		}
+		schema.items = child
+	}
+
+	var err error
+	if schema.minItems, err = schemaCount(definition, "minItems", location); err != nil {
+		return nil, err
+	}
+	if schema.maxItems, err = schemaCount(definition, "maxItems", location); err != nil {
+		return nil, err
+	}
+	if schema.minLength, err = schemaCount(definition, "minLength", location); err != nil {
+		return nil, err
+	}
+	if schema.maxLength, err = schemaCount(definition, "maxLength", location); err != nil {
+		return nil, err
+	}
+	if schema.minimum, err = schemaBound(definition, "minimum", location); err != nil {
+		return nil, err
+	}
+	if schema.maximum, err = schemaBound(definition, "maximum", location); err != nil {
+		return nil, err
+	}
+	if schema.exclusiveMin, err = schemaBound(definition, "exclusiveMinimum", location); err != nil {
+		return nil, err
+	}
+	if schema.exclusiveMax, err = schemaBound(definition, "exclusiveMaximum", location); err != nil {
+		return nil, err
+	}
+
+	if raw, ok := definition["pattern"]; ok {
+		expr, ok := raw.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s: pattern must be a string", location)
+		}
+		compiled, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: pattern invalid: %w", location, err)
+		}
+		schema.pattern = compiled
+	}
+
+	return schema, nil
+}
+
+func compileSchemaChild(raw any, location string) (*payloadSchema, error) {
+	switch v := raw.(type) {
+	case map[string]any:
+		return compileSchemaNode(v, location)
+	case bool:
+		if v {
+			return &payloadSchema{}, nil
+		}
+		return &payloadSchema{enum: []any{}}, nil
+	default:
+		return nil, fmt.Errorf("%s: schema must be an object or a boolean", location)
+	}
+}
+
+func schemaCount(definition map[string]any, key string, location string) (*int, error) {
+	raw, ok := definition[key]
+	if !ok {
+		return nil, nil
+	}
+	value, ok := jsonNumber(raw)
+	if !ok || value < 0 || value != math.Trunc(value) {
+		return nil, fmt.Errorf("%s: %s must be a non-negative integer", location, key)
+	}
+	count := int(value)
+	return &count, nil
+}
+
+func schemaBound(definition map[string]any, key string, location string) (*float64, error) {
+	raw, ok := definition[key]
+	if !ok {
+		return nil, nil
+	}
+	value, ok := jsonNumber(raw)
+	if !ok {
+		return nil, fmt.Errorf("%s: %s must be a number", location, key)
+	}
+	return &value, nil
+}
+
+// validateBody decodes the raw body as JSON and returns the violations found, sorted by location
+func (s *payloadSchema) validateBody(body []byte) []string {
+	if len(bytes.TrimSpace(body)) == 0 {
+		return []string{"$: body is empty"}
+	}
+	document, err := decodeJSONDocument(body)
+	if err != nil {
+		return []string{"$: body is not valid JSON"}
+	}
+	problems := s.validate(document, "$", nil)
+	sort.Strings(problems)
+	if len(problems) > webhookSchemaMaxProblems {
+		problems = problems[:webhookSchemaMaxProblems]
+	}
+	return problems
+}
+
+func (s *payloadSchema) validate(value any, location string, problems []string) []string {
+	if s.enum != nil && !containsJSONValue(s.enum, value) {
+		if len(s.enum) == 0 {
+			return append(problems, location+": value not allowed")
+		}
+		problems = append(problems, location+": value is not one of the allowed values")
+	}
+	if s.hasConst && !jsonValuesEqual(s.constant, value) {
+		problems = append(problems, location+": value does not match the expected constant")
+	}
+	if len(s.types) > 0 && !matchesAnyType(s.types, value) {
+		return append(problems, fmt.Sprintf("%s: expected %s got %s", location, strings.Join(s.types, " or "), jsonTypeName(value)))
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		for _, name := range s.required {
+			if _, ok := v[name]; !ok {
+				problems = append(problems, fmt.Sprintf("%s: missing required property %q", location, name))
+			}
+		}
+		for name, item := range v {
+			child := location + "." + name
+			if property, ok := s.properties[name]; ok {
+				problems = property.validate(item, child, problems)
+				continue
+			}
+			switch {
+			case s.additionalForbidden:
+				problems = append(problems, child+": property not allowed")
+			case s.additional != nil:
+				problems = s.additional.validate(item, child, problems)
+			}
+		}
+	case []any:
+		if s.minItems != nil && len(v) < *s.minItems {
+			problems = append(problems, fmt.Sprintf("%s: expected at least %d items", location, *s.minItems))
+		}
+		if s.maxItems != nil && len(v) > *s.maxItems {
+			problems = append(problems, fmt.Sprintf("%s: expected at most %d items", location, *s.maxItems))
+		}
+		if s.items != nil {
+			for index, item := range v {
+				problems = s.items.validate(item, location+"["+strconv.Itoa(index)+"]", problems)
+			}
+		}
+	case string:
+		length := utf8.RuneCountInString(v)
+		if s.minLength != nil && length < *s.minLength {
+			problems = append(problems, fmt.Sprintf("%s: expected at least %d characters", location, *s.minLength))
+		}
+		if s.maxLength != nil && length > *s.maxLength {
+			problems = append(problems, fmt.Sprintf("%s: expected at most %d characters", location, *s.maxLength))
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			problems = append(problems, fmt.Sprintf("%s: does not match pattern %q", location, s.pattern.String()))
+		}
+	default:
+		number, ok := jsonNumber(v)
+		if !ok {
+			break
+		}
+		if s.minimum != nil && number < *s.minimum {
+			problems = append(problems, fmt.Sprintf("%s: must be >= %v", location, *s.minimum))
+		}
+		if s.maximum != nil && number > *s.maximum {
+			problems = append(problems, fmt.Sprintf("%s: must be <= %v", location, *s.maximum))
+		}
+		if s.exclusiveMin != nil && number <= *s.exclusiveMin {
+			problems = append(problems, fmt.Sprintf("%s: must be > %v", location, *s.exclusiveMin))
+		}
+		if s.exclusiveMax != nil && number >= *s.exclusiveMax {
+			problems = append(problems, fmt.Sprintf("%s: must be < %v", location, *s.exclusiveMax))
+		}
+	}
+	return problems
+}
+
+func decodeJSONDocument(data []byte) (any, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var document any
+	if err := decoder.Decode(&document); err != nil {
+		return nil, err
+	}
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, fmt.Errorf("unexpected data after JSON document")
+	}
+	return document, nil
+}
+
+func matchesAnyType(types []string, value any) bool {
+	actual := jsonTypeName(value)
+	for _, name := range types {
+		if name == actual || (name == "number" && actual == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonTypeName(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	default:
+		number, ok := jsonNumber(v)
+		if !ok {
+			return fmt.Sprintf("%T", v)
+		}
+		if number == math.Trunc(number) && !math.IsInf(number, 0) {
+			return "integer"
+		}
+		return "number"
+	}
+}
+
+func jsonNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		parsed, err := v.Float64()
+		return parsed, err == nil
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	default:
+		return 0, false
+	}
+}
+
+func containsJSONValue(values []any, value any) bool {
+	for _, candidate := range values {
+		if jsonValuesEqual(candidate, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func jsonValuesEqual(left any, right any) bool {
+	leftNumber, leftIsNumber := jsonNumber(left)
+	rightNumber, rightIsNumber := jsonNumber(right)
+	if leftIsNumber || rightIsNumber {
+		return leftIsNumber && rightIsNumber && leftNumber == rightNumber
+	}
+	return reflect.DeepEqual(left, right)
+}
@@ -0,0 +1,275 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/google/uuid"
+)
+
+const orderSchema = `{
+	"type": "object",
+	"required": ["id", "total"],
+	"additionalProperties": false,
+	"properties": {
+		"id": {"type": "string", "pattern": "^ord_"},
+		"total": {"type": "number", "minimum": 0},
+		"status": {"enum": ["paid", "refunded"]},
+		"items": {"type": "array", "minItems": 1, "items": {"type": "object", "required": ["sku"]}}
+	}
+}`
+
+func TestPayloadSchemaValidate(t *testing.T) {
+	schema, err := compilePayloadSchema(orderSchema)
+	if err != nil {
+		t.Fatalf("compilePayloadSchema returned error: %v", err)
+	}
+
+	cases := map[string]struct {
+		body     string
+		problems []string
+	}{
+		"valid":        {body: `{"id":"ord_1","total":12.5,"status":"paid","items":[{"sku":"a"}]}`},
+		"not json":     {body: `{"id":`, problems: []string{"$: body is not valid JSON"}},
+		"empty":        {body: ` `, problems: []string{"$: body is empty"}},
+		"wrong root":   {body: `[1]`, problems: []string{"$: expected object got array"}},
+		"missing":      {body: `{"id":"ord_1"}`, problems: []string{`$: missing required property "total"`}},
+		"extra":        {body: `{"id":"ord_1","total":1,"coupon":"x"}`, problems: []string{"$.coupon: property not allowed"}},
+		"pattern":      {body: `{"id":"1","total":1}`, problems: []string{`$.id: does not match pattern "^ord_"`}},
+		"minimum":      {body: `{"id":"ord_1","total":-1}`, problems: []string{"$.total: must be >= 0"}},
+		"enum":         {body: `{"id":"ord_1","total":1,"status":"open"}`, problems: []string{"$.status: value is not one of the allowed values"}},
+		"nested items": {body: `{"id":"ord_1","total":1,"items":[{"name":"a"}]}`, problems: []string{`$.items[0]: missing required property "sku"`}},
+		"min items":    {body: `{"id":"ord_1","total":1,"items":[]}`, problems: []string{"$.items: expected at least 1 items"}},
+	}
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			problems := schema.validateBody([]byte(tc.body))
+			if strings.Join(problems, "|") != strings.Join(tc.problems, "|") {
+				t.Fatalf("unexpected problems %q want %q", problems, tc.problems)
+			}
+		})
+	}
+}
+
+func TestCompilePayloadSchemaRejectsInvalidSchemas(t *testing.T) {
+	cases := map[string]string{
+		"not json":      `{"type":`,
+		"not object":    `["object"]`,
+		"unknown type":  `{"type":"date"}`,
+		"bad required":  `{"required":"id"}`,
+		"bad pattern":   `{"properties":{"id":{"pattern":"("}}}`,
+		"bad minLength": `{"minLength":-1}`,
+		"bad items":     `{"items":"string"}`,
+		"allOf":         `{"allOf":[{"type":"object"}]}`,
+		"anyOf":         `{"anyOf":[{"type":"string"},{"type":"number"}]}`,
+		"oneOf":         `{"oneOf":[{"type":"string"}]}`,
+		"not":           `{"not":{"type":"null"}}`,
+		"ref":           `{"properties":{"id":{"$ref":"#/definitions/id"}}}`,
+		"format":        `{"properties":{"email":{"type":"string","format":"email"}}}`,
+		"nested items":  `{"items":{"uniqueItems":true}}`,
+	}
+	for name, document := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := compilePayloadSchema(document); err == nil {
+				t.Fatalf("expected error")
+			}
+		})
+	}
+}
+
+type incomingWebhookFixture struct {
+	svc      *Service
+	pipeline *recordingPipeline
+	path     string
+	secret   string
+	userID   uuid.UUID
+	areaID   uuid.UUID
+}
+
+func newIncomingWebhookFixture(t *testing.T, params map[string]any) incomingWebhookFixture {
+	t.Helper()
+	now := time.Unix(1720000000, 0).UTC()
+	actionComponent := componentdomain.Component{
+		ID:         uuid.New(),
+		ProviderID: uuid.New(),
+		Kind:       componentdomain.KindAction,
+		Enabled:    true,
+		Name:       "webhook_incoming",
+		Provider:   componentdomain.Provider{Name: "webhook"},
+		Metadata: map[string]any{
+			"ingestion": map[string]any{
+				"mode":   "webhook",
+				"schema": map[string]any{"param": "schema", "onInvalidParam": "onInvalid"},
+			},
+		},
+	}
+	reactionComponent := componentdomain.Component{
+		ID:         uuid.New(),
+		ProviderID: uuid.New(),
+		Kind:       componentdomain.KindReaction,
+		Enabled:    true,
+	}
+	fixture := incomingWebhookFixture{
+		pipeline: &recordingPipeline{},
+		secret:   "s3cret",
+		userID:   uuid.New(),
+		areaID:   uuid.New(),
+	}
+	actionConfigID := uuid.New()
+	fixture.path = "hooks/webhook/webhook_incoming/" + strings.ReplaceAll(actionConfigID.String(), "-", "")
+
+	repo := &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{
+		fixture.areaID: {
+			ID:     fixture.areaID,
+			UserID: fixture.userID,
+			Name:   "Incoming",
+			Status: areadomain.StatusEnabled,
+			Action: &areadomain.Link{
+				ID:     uuid.New(),
+				Role:   areadomain.LinkRoleAction,
+				Config: componentdomain.Config{ID: actionConfigID, ComponentID: actionComponent.ID, Params: params},
+			},
+			Reactions: []areadomain.Link{{
+				ID:     uuid.New(),
+				Role:   areadomain.LinkRoleReaction,
+				Config: componentdomain.Config{ID: uuid.New(), ComponentID: reactionComponent.ID},
+			}},
+		},
+	}}
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{
+		actionComponent.ID:   actionComponent,
+		reactionComponent.ID: reactionComponent,
+	}}
+	source := actiondomain.Source{
+		ID:                uuid.New(),
+		ComponentConfigID: actionConfigID,
+		Mode:              actiondomain.ModeWebhook,
+		WebhookSecret:     strPtr(fixture.secret),
+		WebhookURLPath:    strPtr(fixture.path),
+		IsActive:          true,
+	}
+	sources := &stubActionSourceRepo{
+		sources:  map[uuid.UUID]actiondomain.Source{actionConfigID: source},
+		webhooks: map[string]actiondomain.WebhookBinding{fixture.path: {Source: source, AreaID: fixture.areaID, UserID: fixture.userID}},
+	}
+	fixture.svc = NewService(repo, components, allowAllSubscriptions{}, sources, fixture.pipeline, stubClock{now: now}, nil, WithWebhookBaseURL("https://area.example.com/"))
+	return fixture
+}
+
+func (f incomingWebhookFixture) post(body string) error {
+	return f.svc.ProcessWebhookRequest(context.Background(), WebhookRequest{
+		Path:    f.path,
+		Secret:  f.secret,
+		Headers: http.Header{},
+		Body:    []byte(body),
+		Payload: map[string]any{"id": "ord_1"},
+	})
+}
+
+func TestService_ProcessWebhookRequestSchemaReject(t *testing.T) {
+	fixture := newIncomingWebhookFixture(t, map[string]any{"schema": orderSchema})
+
+	err := fixture.post(`{"id":"ord_1"}`)
+	if !errors.Is(err, ErrWebhookPayloadInvalid) {
+		t.Fatalf("expected ErrWebhookPayloadInvalid got %v", err)
+	}
+	var payloadErr *WebhookPayloadError
+	if !errors.As(err, &payloadErr) || len(payloadErr.Problems) != 1 {
+		t.Fatalf("expected problems to be reported got %v", err)
+	}
+	if len(fixture.pipeline.inputs) != 0 {
+		t.Fatalf("invalid payload reached the pipeline")
+	}
+
+	if err := fixture.post(`{"id":"ord_1","total":3}`); err != nil {
+		t.Fatalf("valid payload returned error: %v", err)
+	}
+	if len(fixture.pipeline.inputs) != 1 {
+		t.Fatalf("expected 1 pipeline input got %d", len(fixture.pipeline.inputs))
+	}
+}
+
+func TestService_ProcessWebhookRequestSchemaMark(t *testing.T) {
+	fixture := newIncomingWebhookFixture(t, map[string]any{"schema": orderSchema, "onInvalid": "mark"})
+
+	if err := fixture.post(`{"id":"ord_1"}`); err != nil {
+		t.Fatalf("ProcessWebhookRequest returned error: %v", err)
+	}
+	if len(fixture.pipeline.inputs) != 1 {
+		t.Fatalf("expected 1 pipeline input got %d", len(fixture.pipeline.inputs))
+	}
+	validation, ok := fixture.pipeline.inputs[0].Payload[webhookSchemaPayloadKey].(map[string]any)
+	if !ok || validation["valid"] != false {
+		t.Fatalf("expected payload to be marked invalid got %+v", fixture.pipeline.inputs[0].Payload)
+	}
+	if errs, _ := validation["errors"].([]any); len(errs) != 1 {
+		t.Fatalf("expected 1 validation error got %+v", validation["errors"])
+	}
+}
+
+func TestService_WebhookEndpoint(t *testing.T) {
+	fixture := newIncomingWebhookFixture(t, map[string]any{})
+
+	endpoint, err := fixture.svc.WebhookEndpoint(context.Background(), fixture.userID, fixture.areaID)
+	if err != nil {
+		t.Fatalf("WebhookEndpoint returned error: %v", err)
+	}
+	if endpoint.URL != "https://area.example.com/"+fixture.path || endpoint.Secret != fixture.secret {
+		t.Fatalf("unexpected endpoint %+v", endpoint)
+	}
+
+	if _, err := fixture.svc.WebhookEndpoint(context.Background(), uuid.New(), fixture.areaID); !errors.Is(err, ErrAreaNotOwned) {
+		t.Fatalf("expected ErrAreaNotOwned got %v", err)
+	}
+}
+
+func TestService_WebhookEndpointRequiresEditorOnSharedAreas(t *testing.T) {
+	fixture := newIncomingWebhookFixture(t, map[string]any{})
+	repo := fixture.svc.repo.(*memoryAreaRepo)
+	workspaceID := uuid.New()
+	area := repo.items[fixture.areaID]
+	area.WorkspaceID = &workspaceID
+	repo.items[fixture.areaID] = area
+
+	editor, viewer := uuid.New(), uuid.New()
+	workspaces := &memoryWorkspaceRepo{}
+	workspaces.add(workspaceID, fixture.userID, workspacedomain.RoleOwner)
+	workspaces.add(workspaceID, editor, workspacedomain.RoleEditor)
+	workspaces.add(workspaceID, viewer, workspacedomain.RoleViewer)
+	fixture.svc.workspaces = workspaces
+
+	endpoint, err := fixture.svc.WebhookEndpoint(context.Background(), editor, fixture.areaID)
+	if err != nil {
+		t.Fatalf("WebhookEndpoint returned error: %v", err)
+	}
+	if endpoint.Secret != fixture.secret {
+		t.Fatalf("unexpected endpoint %+v", endpoint)
+	}
+	if _, err := fixture.svc.WebhookEndpoint(context.Background(), viewer, fixture.areaID); !errors.Is(err, ErrWorkspaceRoleInsufficient) {
+		t.Fatalf("expected ErrWorkspaceRoleInsufficient got %v", err)
+	}
+}
+
+func TestService_ValidateComponentParamsRejectsInvalidSchema(t *testing.T) {
+	svc := NewService(nil, nil, nil, nil, nil, stubClock{now: time.Now()}, nil)
+	component := componentdomain.Component{Metadata: map[string]any{
+		"ingestion": map[string]any{"mode": "webhook", "schema": map[string]any{"param": "schema"}},
+	}}
+	if err := svc.validateComponentParams(component, map[string]any{"schema": `{"type":"date"}`}); !errors.Is(err, ErrComponentParamsInvalid) {
+		t.Fatalf("expected ErrComponentParamsInvalid got %v", err)
+	}
+	if err := svc.validateComponentParams(component, map[string]any{"schema": `{"anyOf":[{"type":"object"}]}`}); !errors.Is(err, ErrComponentParamsInvalid) {
+		t.Fatalf("expected ErrComponentParamsInvalid got %v", err)
+	}
+	if err := svc.validateComponentParams(component, map[string]any{"schema": orderSchema, "onInvalid": "drop"}); !errors.Is(err, ErrComponentParamsInvalid) {
+		t.Fatalf("expected ErrComponentParamsInvalid got %v", err)
+	}
+}
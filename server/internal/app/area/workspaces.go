@@ -0,0 +1,253 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+// authorizeArea checks that the user may access the area with at least the required workspace role
+// Personal areas are only accessible to their owner, who holds every role on them
+func (s *Service) authorizeArea(ctx context.Context, userID uuid.UUID, area areadomain.Area, required workspacedomain.Role) error {
+	if !area.Shared() || s.workspaces == nil {
+		if !area.OwnedBy(userID) {
+			return ErrAreaNotOwned
+		}
+		return nil
+	}
+	return s.requireWorkspaceRole(ctx, userID, *area.WorkspaceID, required)
+}
+
+func (s *Service) requireWorkspaceRole(ctx context.Context, userID uuid.UUID, workspaceID uuid.UUID, required workspacedomain.Role) error {
+	if s.workspaces == nil {
+		return fmt.Errorf("workspace repository unavailable")
+	}
+	member, err := s.workspaces.FindMember(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return ErrAreaNotOwned
+		}
+		return fmt.Errorf("workspaces.FindMember: %w", err)
+	}
+	if !member.Role.Allows(required) {
+		return ErrWorkspaceRoleInsufficient
+	}
+	return nil
+}
+
+// CreateInWorkspace registers an automation shared in the workspace and run with the creator's accounts
+func (s *Service) CreateInWorkspace(ctx context.Context, userID uuid.UUID, workspaceID uuid.UUID, name string, description string, action ActionInput, reactions []ReactionInput) (areadomain.Area, error) {
+	if err := s.requireWorkspaceRole(ctx, userID, workspaceID, workspacedomain.RoleEditor); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.CreateInWorkspace: %w", err)
+	}
+	return s.create(ctx, userID, userID, &workspaceID, name, description, areadomain.StatusEnabled, action, reactions)
+}
+
+// MoveToWorkspace shares a personal automation in a workspace, moves it to another one, or makes it personal again when workspaceID is nil
+// A shared automation made personal goes back to the member whose accounts run it
+func (s *Service) MoveToWorkspace(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, workspaceID *uuid.UUID) (areadomain.Area, error) {
+	if s.repo == nil || s.workspaces == nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.MoveToWorkspace: repositories unavailable")
+	}
+	area, err := s.repo.FindByID(ctx, areaID)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.MoveToWorkspace: repo.FindByID: %w", err)
+	}
+	if err := s.authorizeArea(ctx, userID, area, workspacedomain.RoleOwner); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.MoveToWorkspace: %w", err)
+	}
+	if workspaceID != nil && *workspaceID == uuid.Nil {
+		workspaceID = nil
+	}
+	if sameWorkspace(area.WorkspaceID, workspaceID) {
+		return areadomain.Area{}, fmt.Errorf("area.Service.MoveToWorkspace: %w", ErrAreaUpdateNoChanges)
+	}
+	if workspaceID != nil {
+		if err := s.requireWorkspaceRole(ctx, userID, *workspaceID, workspacedomain.RoleEditor); err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.MoveToWorkspace: %w", err)
+		}
+		if err := s.requireWorkspaceRole(ctx, area.UserID, *workspaceID, workspacedomain.RoleEditor); err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.MoveToWorkspace: %w", ErrRunnerInvalid)
+		}
+	}
+
+	area.WorkspaceID = workspaceID
+	area.UpdatedAt = s.clock.Now().UTC()
+	if err := s.commitChange(ctx, userID, outbound.AreaChange{Area: area, Ownership: true}, &area, areadomain.RevisionReasonUpdated); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.MoveToWorkspace: %w", err)
+	}
+	return s.reloadAfterOwnershipChange(ctx, area.ID)
+}
+
+// SetRunner selects the workspace member whose linked accounts run a shared automation
+// Identity params are rebound to the runner's accounts, editors may only select themselves
+func (s *Service) SetRunner(ctx context.Context, userID uuid.UUID, areaID uuid.UUID, runnerID uuid.UUID) (areadomain.Area, error) {
+	if s.repo == nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetRunner: repository unavailable")
+	}
+	area, err := s.repo.FindByID(ctx, areaID)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetRunner: repo.FindByID: %w", err)
+	}
+	if !area.Shared() || s.workspaces == nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetRunner: %w", ErrAreaNotShared)
+	}
+	required := workspacedomain.RoleOwner
+	if runnerID == userID {
+		required = workspacedomain.RoleEditor
+	}
+	if err := s.authorizeArea(ctx, userID, area, required); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetRunner: %w", err)
+	}
+	if runnerID == area.UserID {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetRunner: %w", ErrAreaUpdateNoChanges)
+	}
+	if err := s.requireWorkspaceRole(ctx, runnerID, *area.WorkspaceID, workspacedomain.RoleEditor); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetRunner: %w", ErrRunnerInvalid)
+	}
+
+	enriched, err := s.populateComponents(ctx, []areadomain.Area{area})
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetRunner: populateComponents: %w", err)
+	}
+	if len(enriched) == 0 {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetRunner: enrichment failed")
+	}
+	area = enriched[0]
+
+	links := make([]*areadomain.Link, 0, len(area.Reactions)+1)
+	if area.Action != nil {
+		links = append(links, area.Action)
+	}
+	for i := range area.Reactions {
+		links = append(links, &area.Reactions[i])
+	}
+
+	now := s.clock.Now().UTC()
+	configs := make([]componentdomain.Config, 0, len(links))
+	for _, link := range links {
+		if link.Config.Component == nil {
+			continue
+		}
+		if err := s.ensureProviderSubscription(ctx, runnerID, link.Config.Component.ProviderID); err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.SetRunner: %w", err)
+		}
+		params, changed, err := s.rebindIdentityParams(ctx, runnerID, *link.Config.Component, link.Config.Params)
+		if err != nil {
+			return areadomain.Area{}, fmt.Errorf("area.Service.SetRunner: %w", err)
+		}
+		if !changed {
+			continue
+		}
+		link.Config.Params = params
+		link.Config.UpdatedAt = now
+		configs = append(configs, link.Config)
+	}
+
+	area.UserID = runnerID
+	area.UpdatedAt = now
+	change := outbound.AreaChange{Area: area, Ownership: true, Configs: configs}
+	if err := s.commitChange(ctx, userID, change, &area, areadomain.RevisionReasonUpdated); err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service.SetRunner: %w", err)
+	}
+	return s.reloadAfterOwnershipChange(ctx, area.ID)
+}
+
+// rebindIdentityParams points identity params at the runner's linked account for the expected provider
+func (s *Service) rebindIdentityParams(ctx context.Context, runnerID uuid.UUID, component componentdomain.Component, params map[string]any) (map[string]any, bool, error) {
+	specs, err := extractParameterSpecs(component.Metadata)
+	if err != nil {
+		return nil, false, fmt.Errorf("%w: %v", ErrComponentParamsInvalid, err)
+	}
+	rebound := cloneParamsMap(params)
+	changed := false
+	for _, spec := range specs {
+		if spec.Type != parameterTypeIdentity || spec.Provider == "" {
+			continue
+		}
+		if _, present := rebound[spec.Key]; !present && !spec.Required {
+			continue
+		}
+		if s.identities == nil {
+			return nil, false, fmt.Errorf("%w: identity repository unavailable", ErrComponentParamsInvalid)
+		}
+		identity, err := s.identities.FindByUserAndProvider(ctx, runnerID, spec.Provider)
+		if err != nil {
+			if errors.Is(err, outbound.ErrNotFound) {
+				return nil, false, fmt.Errorf("%w: runner has no linked %s account", ErrComponentParamsInvalid, spec.Provider)
+			}
+			return nil, false, fmt.Errorf("identities.FindByUserAndProvider: %w", err)
+		}
+		if rebound[spec.Key] != identity.ID.String() {
+			rebound[spec.Key] = identity.ID.String()
+			changed = true
+		}
+	}
+	return rebound, changed, nil
+}
+
+func (s *Service) reloadAfterOwnershipChange(ctx context.Context, areaID uuid.UUID) (areadomain.Area, error) {
+	stored, err := s.repo.FindByID(ctx, areaID)
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service: repo.FindByID: %w", err)
+	}
+	enriched, err := s.populateComponents(ctx, []areadomain.Area{stored})
+	if err != nil {
+		return areadomain.Area{}, fmt.Errorf("area.Service: populateComponents: %w", err)
+	}
+	if len(enriched) == 0 {
+		return areadomain.Area{}, fmt.Errorf("area.Service: enrichment failed")
+	}
+	return enriched[0], nil
+}
+
+func (s *Service) listSharedAreas(ctx context.Context, userID uuid.UUID) ([]areadomain.Area, error) {
+	if s.workspaces == nil {
+		return nil, nil
+	}
+	memberships, err := s.workspaces.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("workspaces.ListByUser: %w", err)
+	}
+	workspaceIDs := make([]uuid.UUID, 0, len(memberships))
+	for _, membership := range memberships {
+		workspaceIDs = append(workspaceIDs, membership.Workspace.ID)
+	}
+	if len(workspaceIDs) == 0 {
+		return nil, nil
+	}
+	shared, err := s.repo.ListByWorkspaces(ctx, workspaceIDs)
+	if err != nil {
+		return nil, fmt.Errorf("repo.ListByWorkspaces: %w", err)
+	}
+	return shared, nil
+}
+
+// mergeAreas combines personal and shared areas without duplicates, most recent first
+func mergeAreas(personal []areadomain.Area, shared []areadomain.Area) []areadomain.Area {
+	seen := make(map[uuid.UUID]struct{}, len(personal)+len(shared))
+	merged := make([]areadomain.Area, 0, len(personal)+len(shared))
+	for _, area := range append(personal, shared...) {
+		if _, ok := seen[area.ID]; ok {
+			continue
+		}
+		seen[area.ID] = struct{}{}
+		merged = append(merged, area)
+	}
+	sort.SliceStable(merged, func(i, j int) bool { return merged[i].CreatedAt.After(merged[j].CreatedAt) })
+	return merged
+}
+
+func sameWorkspace(a *uuid.UUID, b *uuid.UUID) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return *a == *b
+}
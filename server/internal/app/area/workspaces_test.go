@@ -0,0 +1,352 @@
+package area
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+)
+
+type memoryWorkspaceRepo struct {
+	members map[uuid.UUID]map[uuid.UUID]workspacedomain.Role
+}
+
+func (m *memoryWorkspaceRepo) add(workspaceID uuid.UUID, userID uuid.UUID, role workspacedomain.Role) {
+	if m.members == nil {
+		m.members = map[uuid.UUID]map[uuid.UUID]workspacedomain.Role{}
+	}
+	if m.members[workspaceID] == nil {
+		m.members[workspaceID] = map[uuid.UUID]workspacedomain.Role{}
+	}
+	m.members[workspaceID][userID] = role
+}
+
+func (m *memoryWorkspaceRepo) Create(ctx context.Context, workspace workspacedomain.Workspace, owner workspacedomain.Member) (workspacedomain.Workspace, error) {
+	workspace.ID = uuid.New()
+	m.add(workspace.ID, owner.UserID, owner.Role)
+	return workspace, nil
+}
+
+func (m *memoryWorkspaceRepo) FindByID(ctx context.Context, id uuid.UUID) (workspacedomain.Workspace, error) {
+	if _, ok := m.members[id]; !ok {
+		return workspacedomain.Workspace{}, outbound.ErrNotFound
+	}
+	return workspacedomain.Workspace{ID: id}, nil
+}
+
+func (m *memoryWorkspaceRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]workspacedomain.Membership, error) {
+	var memberships []workspacedomain.Membership
+	for workspaceID, members := range m.members {
+		if role, ok := members[userID]; ok {
+			memberships = append(memberships, workspacedomain.Membership{Workspace: workspacedomain.Workspace{ID: workspaceID}, Role: role})
+		}
+	}
+	return memberships, nil
+}
+
+func (m *memoryWorkspaceRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	delete(m.members, id)
+	return nil
+}
+
+func (m *memoryWorkspaceRepo) FindMember(ctx context.Context, workspaceID uuid.UUID, userID uuid.UUID) (workspacedomain.Member, error) {
+	role, ok := m.members[workspaceID][userID]
+	if !ok {
+		return workspacedomain.Member{}, outbound.ErrNotFound
+	}
+	return workspacedomain.Member{WorkspaceID: workspaceID, UserID: userID, Role: role}, nil
+}
+
+func (m *memoryWorkspaceRepo) ListMembers(ctx context.Context, workspaceID uuid.UUID) ([]workspacedomain.Member, error) {
+	var members []workspacedomain.Member
+	for userID, role := range m.members[workspaceID] {
+		members = append(members, workspacedomain.Member{WorkspaceID: workspaceID, UserID: userID, Role: role})
+	}
+	return members, nil
+}
+
+func (m *memoryWorkspaceRepo) SaveMember(ctx context.Context, member workspacedomain.Member) error {
+	m.add(member.WorkspaceID, member.UserID, member.Role)
+	return nil
+}
+
+func (m *memoryWorkspaceRepo) DeleteMember(ctx context.Context, workspaceID uuid.UUID, userID uuid.UUID) error {
+	delete(m.members[workspaceID], userID)
+	return nil
+}
+
+type workspaceFixture struct {
+	svc         *Service
+	repo        *memoryAreaRepo
+	workspaces  *memoryWorkspaceRepo
+	workspaceID uuid.UUID
+	owner       uuid.UUID
+	editor      uuid.UUID
+	viewer      uuid.UUID
+	action      componentdomain.Component
+	reaction    componentdomain.Component
+}
+
+func newWorkspaceFixture(t *testing.T, identities identityport.Repository) workspaceFixture {
+	t.Helper()
+	action := componentdomain.Component{
+		ID:         uuid.New(),
+		ProviderID: uuid.New(),
+		Kind:       componentdomain.KindAction,
+		Name:       "timer_interval",
+		Enabled:    true,
+	}
+	reaction := componentdomain.Component{
+		ID:         uuid.New(),
+		ProviderID: uuid.New(),
+		Kind:       componentdomain.KindReaction,
+		Name:       "gmail_send_email",
+		Enabled:    true,
+		Metadata: map[string]any{
+			"parameters": []any{
+				map[string]any{"key": "identityId", "type": "identity", "provider": "google", "required": true},
+			},
+		},
+	}
+	fixture := workspaceFixture{
+		repo:        &memoryAreaRepo{items: map[uuid.UUID]areadomain.Area{}},
+		workspaces:  &memoryWorkspaceRepo{},
+		workspaceID: uuid.New(),
+		owner:       uuid.New(),
+		editor:      uuid.New(),
+		viewer:      uuid.New(),
+		action:      action,
+		reaction:    reaction,
+	}
+	fixture.workspaces.add(fixture.workspaceID, fixture.owner, workspacedomain.RoleOwner)
+	fixture.workspaces.add(fixture.workspaceID, fixture.editor, workspacedomain.RoleEditor)
+	fixture.workspaces.add(fixture.workspaceID, fixture.viewer, workspacedomain.RoleViewer)
+
+	components := &memoryComponentRepo{items: map[uuid.UUID]componentdomain.Component{action.ID: action, reaction.ID: reaction}}
+	opts := []ServiceOption{WithWorkspaceRepository(fixture.workspaces)}
+	if identities != nil {
+		opts = append(opts, WithIdentityRepository(identities))
+	}
+	fixture.svc = NewService(fixture.repo, components, allowAllSubscriptions{}, nil, nil, stubClock{now: time.Now()}, nil, opts...)
+	return fixture
+}
+
+func (f workspaceFixture) createShared(t *testing.T, userID uuid.UUID) areadomain.Area {
+	t.Helper()
+	created, err := f.svc.CreateInWorkspace(context.Background(), userID, f.workspaceID, "Shared digest", "", ActionInput{
+		ComponentID: f.action.ID,
+	}, []ReactionInput{{
+		ComponentID: f.reaction.ID,
+		Params:      map[string]any{"identityId": uuid.NewString()},
+	}})
+	if err != nil {
+		t.Fatalf("CreateInWorkspace returned error: %v", err)
+	}
+	return created
+}
+
+func TestServiceWorkspaceRoles(t *testing.T) {
+	ctx := context.Background()
+	f := newWorkspaceFixture(t, nil)
+
+	if _, err := f.svc.CreateInWorkspace(ctx, f.viewer, f.workspaceID, "nope", "", ActionInput{ComponentID: f.action.ID}, nil); !errors.Is(err, ErrWorkspaceRoleInsufficient) {
+		t.Fatalf("expected viewers to be unable to create got %v", err)
+	}
+	created := f.createShared(t, f.editor)
+	if created.WorkspaceID == nil || *created.WorkspaceID != f.workspaceID || created.UserID != f.editor {
+		t.Fatalf("unexpected shared area %+v", created)
+	}
+
+	if _, err := f.svc.Get(ctx, f.viewer, created.ID); err != nil {
+		t.Fatalf("viewer Get returned error: %v", err)
+	}
+	if _, err := f.svc.Get(ctx, uuid.New(), created.ID); !errors.Is(err, ErrAreaNotOwned) {
+		t.Fatalf("expected outsiders to be rejected got %v", err)
+	}
+
+	rename := "Renamed"
+	if _, err := f.svc.Update(ctx, f.viewer, created.ID, UpdateAreaCommand{Name: &rename}); !errors.Is(err, ErrWorkspaceRoleInsufficient) {
+		t.Fatalf("expected viewer update to be rejected got %v", err)
+	}
+	updated, err := f.svc.Update(ctx, f.owner, created.ID, UpdateAreaCommand{Name: &rename})
+	if err != nil {
+		t.Fatalf("owner Update returned error: %v", err)
+	}
+	if updated.Name != rename || updated.UserID != f.editor {
+		t.Fatalf("update should keep the runner got %+v", updated)
+	}
+
+	if err := f.svc.Delete(ctx, f.editor, created.ID); !errors.Is(err, ErrWorkspaceRoleInsufficient) {
+		t.Fatalf("expected editor delete to be rejected got %v", err)
+	}
+	if err := f.svc.Delete(ctx, f.owner, created.ID); err != nil {
+		t.Fatalf("owner Delete returned error: %v", err)
+	}
+}
+
+func TestServiceListIncludesSharedAreas(t *testing.T) {
+	ctx := context.Background()
+	f := newWorkspaceFixture(t, nil)
+	shared := f.createShared(t, f.editor)
+	personal, err := f.svc.Create(ctx, f.viewer, "Mine", "", ActionInput{ComponentID: f.action.ID}, []ReactionInput{{
+		ComponentID: f.reaction.ID,
+		Params:      map[string]any{"identityId": uuid.NewString()},
+	}})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	otherWorkspace := uuid.New()
+	f.workspaces.add(otherWorkspace, f.owner, workspacedomain.RoleOwner)
+	f.workspaces.add(otherWorkspace, f.viewer, workspacedomain.RoleViewer)
+	other, err := f.svc.CreateInWorkspace(ctx, f.owner, otherWorkspace, "Other digest", "", ActionInput{ComponentID: f.action.ID}, []ReactionInput{{
+		ComponentID: f.reaction.ID,
+		Params:      map[string]any{"identityId": uuid.NewString()},
+	}})
+	if err != nil {
+		t.Fatalf("CreateInWorkspace returned error: %v", err)
+	}
+
+	f.repo.workspaceQueries = 0
+	areas, err := f.svc.List(ctx, f.viewer)
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(areas) != 3 {
+		t.Fatalf("expected personal and shared areas got %d", len(areas))
+	}
+	ids := map[uuid.UUID]bool{areas[0].ID: true, areas[1].ID: true, areas[2].ID: true}
+	if !ids[shared.ID] || !ids[personal.ID] || !ids[other.ID] {
+		t.Fatalf("unexpected areas %+v", areas)
+	}
+	if f.repo.workspaceQueries != 1 {
+		t.Fatalf("expected shared areas loaded in one query, got %d", f.repo.workspaceQueries)
+	}
+
+	runnerAreas, err := f.svc.List(ctx, f.editor)
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(runnerAreas) != 1 {
+		t.Fatalf("expected shared area to be listed once got %d", len(runnerAreas))
+	}
+}
+
+func TestServiceMoveToWorkspace(t *testing.T) {
+	ctx := context.Background()
+	f := newWorkspaceFixture(t, nil)
+	personal, err := f.svc.Create(ctx, f.viewer, "Mine", "", ActionInput{ComponentID: f.action.ID}, []ReactionInput{{
+		ComponentID: f.reaction.ID,
+		Params:      map[string]any{"identityId": uuid.NewString()},
+	}})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	if _, err := f.svc.MoveToWorkspace(ctx, f.viewer, personal.ID, &f.workspaceID); !errors.Is(err, ErrWorkspaceRoleInsufficient) {
+		t.Fatalf("expected viewers to be unable to share got %v", err)
+	}
+
+	f.workspaces.add(f.workspaceID, f.viewer, workspacedomain.RoleEditor)
+	moved, err := f.svc.MoveToWorkspace(ctx, f.viewer, personal.ID, &f.workspaceID)
+	if err != nil {
+		t.Fatalf("MoveToWorkspace returned error: %v", err)
+	}
+	if moved.WorkspaceID == nil || *moved.WorkspaceID != f.workspaceID {
+		t.Fatalf("expected area to be shared got %+v", moved.WorkspaceID)
+	}
+
+	if _, err := f.svc.MoveToWorkspace(ctx, f.editor, personal.ID, nil); !errors.Is(err, ErrWorkspaceRoleInsufficient) {
+		t.Fatalf("expected editors to be unable to unshare got %v", err)
+	}
+	unshared, err := f.svc.MoveToWorkspace(ctx, f.owner, personal.ID, nil)
+	if err != nil {
+		t.Fatalf("MoveToWorkspace returned error: %v", err)
+	}
+	if unshared.WorkspaceID != nil || unshared.UserID != f.viewer {
+		t.Fatalf("expected area to return to its runner got %+v", unshared)
+	}
+}
+
+func TestServiceSetRunnerRebindsIdentities(t *testing.T) {
+	ctx := context.Background()
+	ownerIdentity := identitydomain.Identity{ID: uuid.New(), Provider: "google"}
+	identities := &identityRepoStub{}
+	f := newWorkspaceFixture(t, identities)
+	ownerIdentity.UserID = f.owner
+	identities.identity = ownerIdentity
+
+	created := f.createShared(t, f.editor)
+	if _, err := f.svc.SetRunner(ctx, f.editor, created.ID, f.owner); !errors.Is(err, ErrWorkspaceRoleInsufficient) {
+		t.Fatalf("expected editors to only take over themselves got %v", err)
+	}
+	if _, err := f.svc.SetRunner(ctx, f.owner, created.ID, f.viewer); !errors.Is(err, ErrRunnerInvalid) {
+		t.Fatalf("expected viewers to be rejected as runner got %v", err)
+	}
+
+	updated, err := f.svc.SetRunner(ctx, f.owner, created.ID, f.owner)
+	if err != nil {
+		t.Fatalf("SetRunner returned error: %v", err)
+	}
+	if updated.UserID != f.owner {
+		t.Fatalf("expected owner to run the area got %s", updated.UserID)
+	}
+	if got := f.repo.items[created.ID].Reactions[0].Config.Params["identityId"]; got != ownerIdentity.ID.String() {
+		t.Fatalf("identity not rebound got %v", got)
+	}
+
+	if _, err := f.svc.SetRunner(ctx, f.owner, created.ID, f.editor); !errors.Is(err, ErrComponentParamsInvalid) {
+		t.Fatalf("expected runner without linked account to be rejected got %v", err)
+	}
+
+	personal, err := f.svc.Create(ctx, f.owner, "Mine", "", ActionInput{ComponentID: f.action.ID}, []ReactionInput{{
+		ComponentID: f.reaction.ID,
+		Params:      map[string]any{"identityId": ownerIdentity.ID.String()},
+	}})
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if _, err := f.svc.SetRunner(ctx, f.owner, personal.ID, f.editor); !errors.Is(err, ErrAreaNotShared) {
+		t.Fatalf("expected ErrAreaNotShared got %v", err)
+	}
+}
+
+func TestServiceRollbackChecksRoleAndIdentities(t *testing.T) {
+	ctx := context.Background()
+	ownerIdentity := identitydomain.Identity{ID: uuid.New(), Provider: "google"}
+	identities := &identityRepoStub{}
+	f := newWorkspaceFixture(t, identities)
+	ownerIdentity.UserID = f.owner
+	identities.identity = ownerIdentity
+	f.svc.revisions = &memoryRevisionRepo{areas: f.repo}
+
+	created := f.createShared(t, f.editor)
+	if _, err := f.svc.SetRunner(ctx, f.owner, created.ID, f.owner); err != nil {
+		t.Fatalf("SetRunner returned error: %v", err)
+	}
+	name := "Renamed digest"
+	if _, err := f.svc.Update(ctx, f.owner, created.ID, UpdateAreaCommand{Name: &name}); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+
+	if _, err := f.svc.Rollback(ctx, f.viewer, created.ID, 2); !errors.Is(err, ErrWorkspaceRoleInsufficient) {
+		t.Fatalf("expected viewers to be rejected got %v", err)
+	}
+	if _, err := f.svc.Rollback(ctx, f.owner, created.ID, 1); !errors.Is(err, ErrComponentParamsInvalid) {
+		t.Fatalf("expected the previous runner's account to be rejected got %v", err)
+	}
+	restored, err := f.svc.Rollback(ctx, f.owner, created.ID, 2)
+	if err != nil {
+		t.Fatalf("Rollback returned error: %v", err)
+	}
+	if restored.Name != "Shared digest" || restored.Reactions[0].Config.Params["identityId"] != ownerIdentity.ID.String() {
+		t.Fatalf("unexpected restored area %+v", restored)
+	}
+}
@@ -1,6 +1,7 @@
 package auth
 
 import (
+	"context"
 	"errors"
 	"io"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/inbound/http/openapi"
+	quotaapp "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/quota"
 	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
 	servicedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/service"
 	sessiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/session"
@@ -19,6 +21,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	openapitypes "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
 )
 
 // CookieConfig encapsulates browser cookie attributes enforced by the handler
@@ -36,14 +39,36 @@ type Handler struct {
 	service *Service
 	oauth   *OAuthService
 	cookies CookieConfig
+	quotas  QuotaReporter
+}
+
+// QuotaReporter describes the plan limits and usage of an account
+type QuotaReporter interface {
+	Report(ctx context.Context, user userdomain.User) (quotaapp.Report, error)
+}
+
+// HandlerOption customises optional Handler collaborators
+type HandlerOption func(*Handler)
+
+// WithQuotaReporter exposes the plan limits and usage counters of the account on GET /v1/auth/me
+func WithQuotaReporter(quotas QuotaReporter) HandlerOption {
+	return func(h *Handler) {
+		h.quotas = quotas
+	}
 }
 
 // NewHandler constructs the HTTP handler
-func NewHandler(service *Service, oauth *OAuthService, cookies CookieConfig) *Handler {
+func NewHandler(service *Service, oauth *OAuthService, cookies CookieConfig, opts ...HandlerOption) *Handler {
 	if cookies.Path == "" {
 		cookies.Path = "/"
 	}
-	return &Handler{service: service, oauth: oauth, cookies: cookies}
+	handler := &Handler{service: service, oauth: oauth, cookies: cookies}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(handler)
+		}
+	}
+	return handler
 }
 
 // RegisterUser handles POST /v1/users
@@ -164,10 +189,37 @@ func (h *Handler) GetCurrentUser(c *gin.Context) {
 	if !ok {
 		return
 	}
-	c.JSON(http.StatusOK, openapi.UserResponse{
+	response := openapi.UserResponse{
 		User:        toOpenAPIUser(usr),
 		SessionAuth: toSessionAuth(sess),
-	})
+	}
+	if h.quotas != nil {
+		report, err := h.quotas.Report(c.Request.Context(), usr)
+		if err != nil {
+			zap.L().Warn("quota report unavailable", zap.Error(err), zap.String("user_id", usr.ID.String()))
+		} else {
+			response.Quota = toOpenAPIQuotaReport(report)
+		}
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func toOpenAPIQuotaReport(report quotaapp.Report) *openapi.QuotaReport {
+	return &openapi.QuotaReport{
+		Plan: string(report.Plan),
+		Limits: openapi.QuotaLimits{
+			MaxAreas:                  report.Quota.MaxAreas,
+			MaxExecutionsPerHour:      report.Quota.MaxExecutionsPerHour,
+			MaxExecutionsPerDay:       report.Quota.MaxExecutionsPerDay,
+			MinPollingIntervalSeconds: int(report.Quota.MinPollingInterval / time.Second),
+			MaxReactionsPerArea:       report.Quota.MaxReactionsPerArea,
+		},
+		Usage: openapi.QuotaUsage{
+			Areas:              report.Usage.Areas,
+			ExecutionsLastHour: report.Usage.ExecutionsLastHour,
+			ExecutionsLastDay:  report.Usage.ExecutionsLastDay,
+		},
+	}
 }
 
 // ChangePassword handles PATCH /v1/auth/password
@@ -447,6 +499,25 @@ func (h *Handler) SubscribeService(c *gin.Context, provider string) {
 		return
 	}
 
+	if payload.Credentials != nil {
+		subscription, identity, linkErr := h.oauth.LinkCredentials(c.Request.Context(), usr, provider, *payload.Credentials)
+		if linkErr != nil {
+			h.handleSubscriptionError(c, linkErr)
+			return
+		}
+
+		h.refreshSessionCookie(c, sess)
+
+		summary := toOpenAPISubscription(subscription)
+		identitySummary := toOpenAPIIdentity(identity)
+		c.JSON(http.StatusOK, openapi.SubscribeServiceResponse{
+			Status:       "subscribed",
+			Subscription: &summary,
+			Identity:     &identitySummary,
+		})
+		return
+	}
+
 	req := identityport.AuthorizationRequest{
 		RedirectURI: stringValue(payload.RedirectUri),
 		State:       stringValue(payload.State),
@@ -763,6 +834,10 @@ func (h *Handler) handleSubscriptionError(c *gin.Context, err error) {
 		c.JSON(http.StatusBadRequest, gin.H{"error": "subscription not supported"})
 	case errors.Is(err, ErrOAuthEmailMissing):
 		c.JSON(http.StatusBadRequest, gin.H{"error": "email missing from provider"})
+	case errors.Is(err, ErrCredentialsRequired):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "credentials required"})
+	case errors.Is(err, identityport.ErrInvalidCredentials):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "credentials rejected"})
 	case errors.Is(err, outbound.ErrNotFound):
 		c.JSON(http.StatusNotFound, gin.H{"error": "subscription not found"})
 	default:
@@ -30,6 +30,9 @@ var ErrIdentityOwnershipConflict = errors.New("auth: identity already linked to
 // ErrSubscriptionNotSupported indicates the provider does not support automated subscriptions yet.
 var ErrSubscriptionNotSupported = errors.New("auth: provider does not support subscriptions")
 
+// ErrCredentialsRequired is returned when subscribing to a credential based provider without credentials.
+var ErrCredentialsRequired = errors.New("auth: provider requires credentials")
+
 // ProviderResolver exposes configured OAuth providers by name
 // Implementations are expected to return providers for normalized (lowercase) identifiers
 type ProviderResolver interface {
@@ -47,6 +50,24 @@ type OAuthService struct {
 	clock            Clock
 	logger           *zap.Logger
 	cfg              Config
+	verifiers        map[string]identityport.CredentialVerifier
+}
+
+// OAuthServiceOption customises an OAuth service.
+type OAuthServiceOption func(*OAuthService)
+
+// WithCredentialVerifier lets users link the provider with credentials checked by verifier instead of an OAuth flow.
+func WithCredentialVerifier(provider string, verifier identityport.CredentialVerifier) OAuthServiceOption {
+	return func(s *OAuthService) {
+		key := strings.ToLower(strings.TrimSpace(provider))
+		if key == "" || verifier == nil {
+			return
+		}
+		if s.verifiers == nil {
+			s.verifiers = make(map[string]identityport.CredentialVerifier)
+		}
+		s.verifiers[key] = verifier
+	}
 }
 
 // SubscriptionInitResult reports the outcome of initiating a subscription flow.
@@ -62,7 +83,7 @@ type SubscriptionOverview struct {
 }
 
 // NewOAuthService assembles an OAuth service from persistence stores and provider registry.
-func NewOAuthService(providers ProviderResolver, identities identityport.Repository, users outbound.UserRepository, sessions outbound.SessionRepository, serviceProviders outbound.ServiceProviderRepository, subscriptions outbound.SubscriptionRepository, clock Clock, logger *zap.Logger, cfg Config) *OAuthService {
+func NewOAuthService(providers ProviderResolver, identities identityport.Repository, users outbound.UserRepository, sessions outbound.SessionRepository, serviceProviders outbound.ServiceProviderRepository, subscriptions outbound.SubscriptionRepository, clock Clock, logger *zap.Logger, cfg Config, opts ...OAuthServiceOption) *OAuthService {
 	if clock == nil {
 		clock = systemClock{}
 	}
@@ -75,7 +96,7 @@ func NewOAuthService(providers ProviderResolver, identities identityport.Reposit
 	if cfg.CookieName == "" {
 		cfg.CookieName = "area_session"
 	}
-	return &OAuthService{
+	service := &OAuthService{
 		providers:        providers,
 		identities:       identities,
 		users:            users,
@@ -86,6 +107,12 @@ func NewOAuthService(providers ProviderResolver, identities identityport.Reposit
 		logger:           logger,
 		cfg:              cfg,
 	}
+	for _, opt := range opts {
+		if opt != nil {
+			opt(service)
+		}
+	}
+	return service
 }
 
 // AuthorizationURL delegates to the provider to generate an authorization redirect payload
@@ -191,7 +218,7 @@ func (s *OAuthService) Unsubscribe(ctx context.Context, userID uuid.UUID, provid
 		return subscriptiondomain.Subscription{}, fmt.Errorf("auth.OAuthService.Unsubscribe: provider name empty")
 	}
 
-	providerRecord, err := s.serviceProviders.FindByName(ctx, normalized)
+	providerRecord, err := s.findServiceProvider(ctx, normalized)
 	if err != nil {
 		if errors.Is(err, outbound.ErrNotFound) {
 			return subscriptiondomain.Subscription{}, fmt.Errorf("auth.OAuthService.Unsubscribe[%s]: %w", normalized, ErrProviderNotConfigured)
@@ -239,7 +266,7 @@ func (s *OAuthService) BeginSubscription(ctx context.Context, user userdomain.Us
 		return SubscriptionInitResult{}, fmt.Errorf("auth.OAuthService.BeginSubscription: provider name empty")
 	}
 
-	providerRecord, err := s.serviceProviders.FindByName(ctx, normalized)
+	providerRecord, err := s.findServiceProvider(ctx, normalized)
 	if err != nil {
 		if errors.Is(err, outbound.ErrNotFound) {
 			return SubscriptionInitResult{}, fmt.Errorf("auth.OAuthService.BeginSubscription[%s]: %w", normalized, ErrProviderNotConfigured)
@@ -257,6 +284,9 @@ func (s *OAuthService) BeginSubscription(ctx context.Context, user userdomain.Us
 		}
 		return SubscriptionInitResult{Subscription: &subscription}, nil
 	case servicedomain.OAuthTypeAPIKey:
+		if _, ok := s.verifiers[providerRecord.Name]; ok {
+			return SubscriptionInitResult{}, fmt.Errorf("auth.OAuthService.BeginSubscription[%s]: %w", normalized, ErrCredentialsRequired)
+		}
 		subscription, ensureErr := s.ensureSubscription(ctx, user.ID, providerRecord, nil, nil, now)
 		if ensureErr != nil {
 			return SubscriptionInitResult{}, ensureErr
@@ -291,7 +321,7 @@ func (s *OAuthService) CompleteSubscription(ctx context.Context, user userdomain
 		return subscriptiondomain.Subscription{}, identitydomain.Identity{}, fmt.Errorf("auth.OAuthService.CompleteSubscription: provider name empty")
 	}
 
-	providerRecord, err := s.serviceProviders.FindByName(ctx, normalized)
+	providerRecord, err := s.findServiceProvider(ctx, normalized)
 	if err != nil {
 		if errors.Is(err, outbound.ErrNotFound) {
 			return subscriptiondomain.Subscription{}, identitydomain.Identity{}, fmt.Errorf("auth.OAuthService.CompleteSubscription[%s]: %w", normalized, ErrProviderNotConfigured)
@@ -348,6 +378,59 @@ func (s *OAuthService) LinkService(ctx context.Context, user userdomain.User, pr
 	return s.CompleteSubscription(ctx, user, provider, code, req)
 }
 
+// LinkCredentials verifies credentials for a credential based provider, stores them as an identity and records the subscription.
+// The verified secret is kept as the identity access token so the identity repository encrypts it at rest.
+func (s *OAuthService) LinkCredentials(ctx context.Context, user userdomain.User, provider string, credentials map[string]string) (subscriptiondomain.Subscription, identitydomain.Identity, error) {
+	if s.identities == nil || s.serviceProviders == nil || s.subscriptions == nil {
+		return subscriptiondomain.Subscription{}, identitydomain.Identity{}, fmt.Errorf("auth.OAuthService.LinkCredentials: persistence not configured")
+	}
+	if user.ID == uuid.Nil {
+		return subscriptiondomain.Subscription{}, identitydomain.Identity{}, fmt.Errorf("auth.OAuthService.LinkCredentials: missing user")
+	}
+
+	normalized := strings.ToLower(strings.TrimSpace(provider))
+	if normalized == "" {
+		return subscriptiondomain.Subscription{}, identitydomain.Identity{}, fmt.Errorf("auth.OAuthService.LinkCredentials: provider name empty")
+	}
+
+	providerRecord, err := s.serviceProviders.FindByName(ctx, normalized)
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return subscriptiondomain.Subscription{}, identitydomain.Identity{}, fmt.Errorf("auth.OAuthService.LinkCredentials[%s]: %w", normalized, ErrProviderNotConfigured)
+		}
+		return subscriptiondomain.Subscription{}, identitydomain.Identity{}, fmt.Errorf("auth.OAuthService.LinkCredentials[%s]: serviceProviders.FindByName: %w", normalized, err)
+	}
+	verifier, ok := s.verifiers[normalized]
+	if !ok || providerRecord.OAuthType != servicedomain.OAuthTypeAPIKey {
+		return subscriptiondomain.Subscription{}, identitydomain.Identity{}, fmt.Errorf("auth.OAuthService.LinkCredentials[%s]: %w", normalized, ErrSubscriptionNotSupported)
+	}
+
+	exchange, err := verifier.Verify(ctx, credentials)
+	if err != nil {
+		s.logger.Info("credential verification failed",
+			zap.String("provider", normalized),
+			zap.String("user_id", user.ID.String()),
+			zap.Error(err))
+		return subscriptiondomain.Subscription{}, identitydomain.Identity{}, fmt.Errorf("auth.OAuthService.LinkCredentials[%s]: %w", normalized, err)
+	}
+	if exchange.Profile.Subject == "" {
+		return subscriptiondomain.Subscription{}, identitydomain.Identity{}, fmt.Errorf("auth.OAuthService.LinkCredentials[%s]: empty profile", normalized)
+	}
+
+	now := s.clock.Now().UTC()
+
+	identity, err := s.linkIdentityToUser(ctx, user, normalized, exchange, now)
+	if err != nil {
+		return subscriptiondomain.Subscription{}, identitydomain.Identity{}, err
+	}
+
+	subscription, err := s.ensureSubscription(ctx, user.ID, providerRecord, &identity.ID, nil, now)
+	if err != nil {
+		return subscriptiondomain.Subscription{}, identity, err
+	}
+	return subscription, identity, nil
+}
+
 // ListIdentities lists linked identities for the specified user
 func (s *OAuthService) ListIdentities(ctx context.Context, userID uuid.UUID) ([]identitydomain.Identity, error) {
 	if s == nil || s.identities == nil {
@@ -364,6 +447,23 @@ func (s *OAuthService) ListIdentities(ctx context.Context, userID uuid.UUID) ([]
 	return items, nil
 }
 
+// findServiceProvider loads the catalogue entry of a provider, mapping self-hosted instances onto their provider type
+func (s *OAuthService) findServiceProvider(ctx context.Context, name string) (servicedomain.Provider, error) {
+	record, err := s.serviceProviders.FindByName(ctx, name)
+	if err == nil || !errors.Is(err, outbound.ErrNotFound) || s.providers == nil {
+		return record, err
+	}
+	prov, ok := s.providers.Provider(name)
+	if !ok {
+		return record, err
+	}
+	kind := identityport.ProviderType(prov)
+	if kind == name {
+		return record, err
+	}
+	return s.serviceProviders.FindByName(ctx, kind)
+}
+
 func (s *OAuthService) resolveProvider(name string) (identityport.Provider, string, error) {
 	if s.providers == nil {
 		return nil, "", fmt.Errorf("auth.OAuthService.resolveProvider: providers unavailable")
@@ -221,6 +221,45 @@ func TestOAuthServiceLinkServiceCreatesSubscription(t *testing.T) {
 	}
 }
 
+func TestOAuthServiceLinkServiceSelfHostedInstance(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1725000000, 0).UTC()
+	clock := &fakeClock{t: now}
+
+	user := userdomain.User{ID: uuid.New(), Email: "user@example.com", Status: userdomain.StatusActive, Role: userdomain.RoleMember}
+	identities := &memoryIdentityRepo{items: map[uuid.UUID]identitydomain.Identity{}, byKey: map[string]uuid.UUID{}}
+	providerID := uuid.New()
+	serviceProviders := &memoryServiceProviderRepo{items: map[string]servicedomain.Provider{
+		"gitlab": {ID: providerID, Name: "gitlab", OAuthType: servicedomain.OAuthTypeOAuth2},
+	}}
+	subscriptions := &memorySubscriptionRepo{items: map[uuid.UUID]subscriptiondomain.Subscription{}, byKey: map[string]uuid.UUID{}}
+
+	provider := &instanceProviderStub{
+		stubProvider: stubProvider{
+			name: "gitlab-corp",
+			exchange: identityport.TokenExchange{
+				Token:   oauth2.Token{AccessToken: "access-123"},
+				Profile: identitydomain.Profile{Provider: "gitlab", Subject: "42", Email: "user@example.com"},
+			},
+		},
+		kind: "gitlab",
+	}
+	resolver := staticProviderResolver{"gitlab-corp": provider}
+
+	svc := NewOAuthService(resolver, identities, nil, nil, serviceProviders, subscriptions, clock, zaptest.NewLogger(t), Config{SessionTTL: time.Hour, CookieName: "session"})
+
+	subscription, identity, err := svc.LinkService(ctx, user, "gitlab-corp", "code-abc", identityport.ExchangeRequest{})
+	if err != nil {
+		t.Fatalf("LinkService returned error: %v", err)
+	}
+	if identity.Provider != "gitlab-corp" {
+		t.Fatalf("identity should keep the instance key, got %q", identity.Provider)
+	}
+	if subscription.ProviderID != providerID {
+		t.Fatalf("subscription should target the gitlab service provider")
+	}
+}
+
 func TestOAuthServiceLinkServiceIdentityConflict(t *testing.T) {
 	ctx := context.Background()
 	now := time.Unix(1726000000, 0).UTC()
@@ -606,6 +645,15 @@ func (s *stubProvider) Refresh(ctx context.Context, identity identitydomain.Iden
 	return identityport.TokenExchange{}, nil
 }
 
+type instanceProviderStub struct {
+	stubProvider
+	kind string
+}
+
+func (s *instanceProviderStub) Type() string              { return s.kind }
+func (s *instanceProviderStub) APIBaseURL() string        { return "" }
+func (s *instanceProviderStub) DefaultAPIBaseURL() string { return "" }
+
 type memoryServiceProviderRepo struct {
 	items map[string]servicedomain.Provider
 }
@@ -190,6 +190,7 @@ func (w *Worker) processReservation(ctx context.Context, reservation queueport.R
 			return fmt.Errorf("automation.Worker.processReservation: update failed job: %w (original error: %v)", updateErr, execErr)
 		}
 		w.recordDeliveryLog(ctx, job, reactionLink, result, execErr)
+		w.checkAreaHealth(ctx, job)
 		if ackErr := reservation.Ack(ctx); ackErr != nil {
 			return fmt.Errorf("automation.Worker.processReservation: ack failed job: %w", ackErr)
 		}
@@ -272,6 +273,10 @@ func (w *Worker) executeJob(ctx context.Context, job jobdomain.Job) (outbound.Re
 		reactionCopy.Config.Component = &component
 	}
 
+	if eventPayload, ok := payload["eventPayload"].(map[string]any); ok {
+		ctx = outbound.WithTriggerEvent(ctx, eventPayload)
+	}
+
 	started := w.now()
 	result, err := w.executor.ExecuteReaction(ctx, areaModel, reactionCopy)
 	if result.Endpoint == "" && reactionCopy.Config.Component != nil {
@@ -327,6 +332,24 @@ func (w *Worker) scheduleRetry(ctx context.Context, reservation queueport.Reserv
 	return true
 }
 
+// checkAreaHealth lets the circuit breaker suspend the area once a job failed for good
+func (w *Worker) checkAreaHealth(ctx context.Context, job jobdomain.Job) {
+	if w.areas == nil {
+		return
+	}
+	areaID, err := parseUUIDField(job.InputPayload, "areaId")
+	if err != nil {
+		return
+	}
+	suspended, err := w.areas.CheckHealth(ctx, areaID)
+	if err != nil {
+		w.logger.Warn("area health check failed", zap.Error(err), zap.String("area_id", areaID.String()))
+	}
+	if suspended {
+		w.logger.Info("area suspended by circuit breaker", zap.String("area_id", areaID.String()))
+	}
+}
+
 func parseUUIDField(payload map[string]any, key string) (uuid.UUID, error) {
 	value, ok := stringField(payload, key)
 	if !ok || strings.TrimSpace(value) == "" {
@@ -33,6 +33,7 @@ func (f fixedClock) Now() time.Time {
 
 type recordingHandler struct {
 	called bool
+	event  map[string]any
 	err    error
 }
 
@@ -42,6 +43,7 @@ func (h *recordingHandler) Supports(component *componentdomain.Component) bool {
 
 func (h *recordingHandler) Execute(ctx context.Context, area areadomain.Area, link areadomain.Link) (outbound.ReactionResult, error) {
 	h.called = true
+	h.event, _ = outbound.TriggerEvent(ctx)
 	status := 200
 	if h.err != nil {
 		status = 500
@@ -180,6 +182,18 @@ func (s stubAreaRepository) ListByUser(ctx context.Context, userID uuid.UUID) ([
 	return []areadomain.Area{s.area}, nil
 }
 
+func (s stubAreaRepository) ListByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]areadomain.Area, error) {
+	return nil, nil
+}
+
+func (s stubAreaRepository) ListByWorkspaces(ctx context.Context, workspaceIDs []uuid.UUID) ([]areadomain.Area, error) {
+	return nil, nil
+}
+
+func (s stubAreaRepository) ListPage(ctx context.Context, opts outbound.AreaListOptions) (outbound.AreaPage, error) {
+	return outbound.AreaPage{Areas: []areadomain.Area{s.area}}, nil
+}
+
 func (s stubAreaRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
@@ -192,6 +206,14 @@ func (s stubAreaRepository) UpdateConfig(ctx context.Context, config componentdo
 	return nil
 }
 
+func (s stubAreaRepository) UpdateOwnership(ctx context.Context, area areadomain.Area) error {
+	return nil
+}
+
+func (s stubAreaRepository) UpdateActivitySchedule(ctx context.Context, areaID uuid.UUID, schedule *areadomain.ActivitySchedule) error {
+	return nil
+}
+
 type stubComponentRepository struct {
 	components map[uuid.UUID]componentdomain.Component
 }
@@ -317,6 +339,9 @@ func TestWorkerProcessesJob(t *testing.T) {
 			"params": map[string]any{
 				"url": "https://example.com",
 			},
+			"eventPayload": map[string]any{
+				"ts": "1720000000.000100",
+			},
 		},
 		RunAt:  now,
 		Status: jobdomain.StatusQueued,
@@ -351,6 +376,9 @@ func TestWorkerProcessesJob(t *testing.T) {
 	if !handler.called {
 		t.Fatalf("expected reaction handler to be executed")
 	}
+	if handler.event["ts"] != "1720000000.000100" {
+		t.Fatalf("expected triggering event to reach the handler, got %v", handler.event)
+	}
 	if len(logRepo.logs) != 1 {
 		t.Fatalf("expected one delivery log entry, got %d", len(logRepo.logs))
 	}
@@ -0,0 +1,189 @@
+package quota
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	userdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/user"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+// Clock exposes the current time for deterministic tests
+type Clock interface {
+	Now() time.Time
+}
+
+// executionCounterTTL bounds how long execution counts are trusted before being counted again
+// Executions enqueued by other instances within that delay are not seen by this one,
+// so with several replicas each may admit up to the whole remaining quota before the counts catch up
+const executionCounterTTL = time.Minute
+
+// executionCounterSweepSize is the number of cached counters above which expired ones are dropped
+const executionCounterSweepSize = 1024
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+// Report bundles the quota of an account with what it currently consumes
+type Report struct {
+	Plan  userdomain.Role
+	Quota userdomain.Quota
+	Usage userdomain.Usage
+}
+
+// Service resolves the quota of accounts from their role and checks it against their usage
+type Service struct {
+	plans map[userdomain.Role]userdomain.Quota
+	users outbound.UserRepository
+	usage outbound.UsageRepository
+	clock Clock
+
+	mu       sync.Mutex
+	counters map[uuid.UUID]executionCounter
+}
+
+// executionCounter holds the quota and execution counts of a user loaded at loadedAt
+// The counts are bumped for every execution allowed since then
+type executionCounter struct {
+	quota    userdomain.Quota
+	usage    userdomain.Usage
+	loadedAt time.Time
+}
+
+// NewService assembles a quota service, roles without a plan fall back to the member plan
+func NewService(plans map[userdomain.Role]userdomain.Quota, users outbound.UserRepository, usage outbound.UsageRepository, clock Clock) *Service {
+	if clock == nil {
+		clock = systemClock{}
+	}
+	return &Service{plans: plans, users: users, usage: usage, clock: clock, counters: make(map[uuid.UUID]executionCounter)}
+}
+
+// Report returns the quota of the user along with its current usage
+func (s *Service) Report(ctx context.Context, user userdomain.User) (Report, error) {
+	if s.usage == nil {
+		return Report{}, fmt.Errorf("quota.Service.Report: usage repository unavailable")
+	}
+	usage, err := s.usage.Usage(ctx, user.ID, s.clock.Now().UTC())
+	if err != nil {
+		return Report{}, fmt.Errorf("quota.Service.Report: usage.Usage: %w", err)
+	}
+	return Report{Plan: user.Role, Quota: s.planOf(user.Role), Usage: usage}, nil
+}
+
+// CheckArea returns why the user may not create one more automation chaining reactions, empty when allowed
+func (s *Service) CheckArea(ctx context.Context, userID uuid.UUID, reactions int) (string, error) {
+	quota, err := s.quotaOf(ctx, userID)
+	if err != nil {
+		return "", fmt.Errorf("quota.Service.CheckArea: %w", err)
+	}
+	if ok, reason := quota.AllowsReactions(reactions); !ok {
+		return reason, nil
+	}
+	if quota.MaxAreas <= 0 {
+		return "", nil
+	}
+	usage, err := s.usage.Usage(ctx, userID, s.clock.Now().UTC())
+	if err != nil {
+		return "", fmt.Errorf("quota.Service.CheckArea: usage.Usage: %w", err)
+	}
+	_, reason := quota.AllowsArea(usage)
+	return reason, nil
+}
+
+// CheckExecution returns why an automation run by the user may not execute once more, empty when allowed
+// Counts are loaded once per user every executionCounterTTL and bumped for each allowed execution in between
+// The limit is best effort: it is exact for a single instance only, replicas do not share their counters
+func (s *Service) CheckExecution(ctx context.Context, userID uuid.UUID) (string, error) {
+	now := s.clock.Now().UTC()
+	counter, ok := s.cachedCounter(userID, now)
+	if !ok {
+		quota, err := s.quotaOf(ctx, userID)
+		if err != nil {
+			return "", fmt.Errorf("quota.Service.CheckExecution: %w", err)
+		}
+		counter = executionCounter{quota: quota, loadedAt: now}
+		if quota.MaxExecutionsPerHour > 0 || quota.MaxExecutionsPerDay > 0 {
+			usage, err := s.usage.Usage(ctx, userID, now)
+			if err != nil {
+				return "", fmt.Errorf("quota.Service.CheckExecution: usage.Usage: %w", err)
+			}
+			counter.usage = usage
+		}
+		s.storeCounter(userID, counter, now)
+	}
+	if counter.quota.MaxExecutionsPerHour <= 0 && counter.quota.MaxExecutionsPerDay <= 0 {
+		return "", nil
+	}
+	return s.consumeExecution(userID, counter), nil
+}
+
+func (s *Service) cachedCounter(userID uuid.UUID, now time.Time) (executionCounter, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	counter, ok := s.counters[userID]
+	if !ok || now.Sub(counter.loadedAt) >= executionCounterTTL {
+		return executionCounter{}, false
+	}
+	return counter, true
+}
+
+func (s *Service) storeCounter(userID uuid.UUID, counter executionCounter, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if len(s.counters) >= executionCounterSweepSize {
+		for id, cached := range s.counters {
+			if now.Sub(cached.loadedAt) >= executionCounterTTL {
+				delete(s.counters, id)
+			}
+		}
+	}
+	s.counters[userID] = counter
+}
+
+// consumeExecution checks the cached counts and bumps them when the execution is allowed
+func (s *Service) consumeExecution(userID uuid.UUID, loaded executionCounter) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	counter, ok := s.counters[userID]
+	if !ok || counter.loadedAt.Before(loaded.loadedAt) {
+		counter = loaded
+	}
+	if allowed, reason := counter.quota.AllowsExecution(counter.usage); !allowed {
+		return reason
+	}
+	counter.usage.ExecutionsLastHour++
+	counter.usage.ExecutionsLastDay++
+	s.counters[userID] = counter
+	return ""
+}
+
+// PollingInterval raises interval to the minimum polling interval allowed to the user
+func (s *Service) PollingInterval(ctx context.Context, userID uuid.UUID, interval time.Duration) (time.Duration, error) {
+	quota, err := s.quotaOf(ctx, userID)
+	if err != nil {
+		return interval, fmt.Errorf("quota.Service.PollingInterval: %w", err)
+	}
+	return quota.PollingInterval(interval), nil
+}
+
+func (s *Service) quotaOf(ctx context.Context, userID uuid.UUID) (userdomain.Quota, error) {
+	if s.users == nil || s.usage == nil {
+		return userdomain.Quota{}, fmt.Errorf("repositories unavailable")
+	}
+	user, err := s.users.FindByID(ctx, userID)
+	if err != nil {
+		return userdomain.Quota{}, fmt.Errorf("users.FindByID: %w", err)
+	}
+	return s.planOf(user.Role), nil
+}
+
+func (s *Service) planOf(role userdomain.Role) userdomain.Quota {
+	if quota, ok := s.plans[role]; ok {
+		return quota
+	}
+	return s.plans[userdomain.RoleMember]
+}
@@ -0,0 +1,167 @@
+package quota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	userdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/user"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+type stubClock struct{ now time.Time }
+
+func (s *stubClock) Now() time.Time { return s.now }
+
+type memoryUsers struct {
+	items map[uuid.UUID]userdomain.User
+}
+
+func (m memoryUsers) Create(ctx context.Context, user userdomain.User) (userdomain.User, error) {
+	m.items[user.ID] = user
+	return user, nil
+}
+
+func (m memoryUsers) FindByEmail(ctx context.Context, email string) (userdomain.User, error) {
+	for _, user := range m.items {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return userdomain.User{}, outbound.ErrNotFound
+}
+
+func (m memoryUsers) FindByID(ctx context.Context, id uuid.UUID) (userdomain.User, error) {
+	user, ok := m.items[id]
+	if !ok {
+		return userdomain.User{}, outbound.ErrNotFound
+	}
+	return user, nil
+}
+
+func (m memoryUsers) Update(ctx context.Context, user userdomain.User) error {
+	m.items[user.ID] = user
+	return nil
+}
+
+type stubUsage struct {
+	usage userdomain.Usage
+	at    time.Time
+	calls int
+}
+
+func (s *stubUsage) Usage(ctx context.Context, userID uuid.UUID, at time.Time) (userdomain.Usage, error) {
+	s.at = at
+	s.calls++
+	return s.usage, nil
+}
+
+var testPlans = map[userdomain.Role]userdomain.Quota{
+	userdomain.RoleMember: {
+		MaxAreas:             2,
+		MaxExecutionsPerHour: 10,
+		MaxExecutionsPerDay:  50,
+		MinPollingInterval:   time.Minute,
+		MaxReactionsPerArea:  3,
+	},
+	userdomain.RoleAdmin: {},
+}
+
+func newFixture(role userdomain.Role) (*Service, *stubUsage, *stubClock, userdomain.User) {
+	user := userdomain.User{ID: uuid.New(), Role: role}
+	usage := &stubUsage{}
+	users := memoryUsers{items: map[uuid.UUID]userdomain.User{user.ID: user}}
+	clock := &stubClock{now: time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)}
+	svc := NewService(testPlans, users, usage, clock)
+	return svc, usage, clock, user
+}
+
+func TestServiceCheckArea(t *testing.T) {
+	ctx := context.Background()
+	svc, usage, _, user := newFixture(userdomain.RoleMember)
+
+	reason, err := svc.CheckArea(ctx, user.ID, 4)
+	if err != nil || reason != "limit of 3 reactions per area exceeded" {
+		t.Fatalf("expected reactions rejection got %q %v", reason, err)
+	}
+
+	usage.usage = userdomain.Usage{Areas: 1}
+	if reason, err := svc.CheckArea(ctx, user.ID, 3); err != nil || reason != "" {
+		t.Fatalf("expected area allowed got %q %v", reason, err)
+	}
+	usage.usage = userdomain.Usage{Areas: 2}
+	if reason, _ := svc.CheckArea(ctx, user.ID, 1); reason != "limit of 2 areas reached" {
+		t.Fatalf("expected areas rejection got %q", reason)
+	}
+}
+
+func TestServiceCheckExecution(t *testing.T) {
+	ctx := context.Background()
+	svc, usage, clock, user := newFixture(userdomain.RoleMember)
+
+	usage.usage = userdomain.Usage{ExecutionsLastHour: 9, ExecutionsLastDay: 20}
+	if reason, err := svc.CheckExecution(ctx, user.ID); err != nil || reason != "" {
+		t.Fatalf("expected execution allowed got %q %v", reason, err)
+	}
+	if !usage.at.Equal(time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC)) {
+		t.Fatalf("unexpected usage instant %s", usage.at)
+	}
+	if reason, _ := svc.CheckExecution(ctx, user.ID); reason != "limit of 10 executions per hour reached" {
+		t.Fatalf("expected the allowed execution counted, got %q", reason)
+	}
+	if usage.calls != 1 {
+		t.Fatalf("expected usage counted once within the counter lifetime, got %d", usage.calls)
+	}
+
+	clock.now = clock.now.Add(executionCounterTTL)
+	usage.usage = userdomain.Usage{ExecutionsLastHour: 1, ExecutionsLastDay: 50}
+	if reason, _ := svc.CheckExecution(ctx, user.ID); reason != "limit of 50 executions per day reached" {
+		t.Fatalf("expected daily rejection got %q", reason)
+	}
+	if usage.calls != 2 {
+		t.Fatalf("expected usage counted again once the counter expired, got %d", usage.calls)
+	}
+}
+
+func TestServiceUnlimitedPlanSkipsUsage(t *testing.T) {
+	ctx := context.Background()
+	svc, usage, _, user := newFixture(userdomain.RoleAdmin)
+	usage.usage = userdomain.Usage{Areas: 1000, ExecutionsLastHour: 1000, ExecutionsLastDay: 1000}
+
+	if reason, err := svc.CheckArea(ctx, user.ID, 100); err != nil || reason != "" {
+		t.Fatalf("expected admin area allowed got %q %v", reason, err)
+	}
+	if reason, err := svc.CheckExecution(ctx, user.ID); err != nil || reason != "" {
+		t.Fatalf("expected admin execution allowed got %q %v", reason, err)
+	}
+	if usage.calls != 0 {
+		t.Fatalf("expected unlimited plans not to count usage")
+	}
+	interval, err := svc.PollingInterval(ctx, user.ID, 10*time.Second)
+	if err != nil || interval != 10*time.Second {
+		t.Fatalf("expected interval untouched got %s %v", interval, err)
+	}
+}
+
+func TestServicePollingIntervalAndFallbackPlan(t *testing.T) {
+	ctx := context.Background()
+	svc, usage, _, user := newFixture(userdomain.Role("legacy"))
+
+	interval, err := svc.PollingInterval(ctx, user.ID, 10*time.Second)
+	if err != nil || interval != time.Minute {
+		t.Fatalf("expected interval raised to the member minimum got %s %v", interval, err)
+	}
+	if interval, _ := svc.PollingInterval(ctx, user.ID, time.Hour); interval != time.Hour {
+		t.Fatalf("expected longer interval kept got %s", interval)
+	}
+
+	usage.usage = userdomain.Usage{Areas: 1, ExecutionsLastHour: 4, ExecutionsLastDay: 7}
+	report, err := svc.Report(ctx, user)
+	if err != nil {
+		t.Fatalf("Report returned error: %v", err)
+	}
+	if report.Plan != "legacy" || report.Quota != testPlans[userdomain.RoleMember] || report.Usage != usage.usage {
+		t.Fatalf("unexpected report %+v", report)
+	}
+}
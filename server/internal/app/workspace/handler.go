@@ -0,0 +1,285 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/inbound/http/openapi"
+	areaauth "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/auth"
+	sessiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/session"
+	userdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/user"
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	openapitypes "github.com/oapi-codegen/runtime/types"
+	"go.uber.org/zap"
+)
+
+// CookieConfig mirrors the session cookie settings enforced by the authentication layer
+type CookieConfig struct {
+	Name     string
+	Domain   string
+	Path     string
+	Secure   bool
+	HTTPOnly bool
+	SameSite http.SameSite
+}
+
+// SessionResolver resolves a session identifier into the authenticated user
+type SessionResolver interface {
+	ResolveSession(ctx context.Context, sessionID uuid.UUID) (userdomain.User, sessiondomain.Session, error)
+}
+
+// Handler exposes workspace endpoints generated from the OpenAPI contract
+type Handler struct {
+	service  *Service
+	sessions SessionResolver
+	cookies  CookieConfig
+}
+
+// NewHandler constructs a workspace handler instance
+func NewHandler(service *Service, sessions SessionResolver, cookies CookieConfig) *Handler {
+	if cookies.Path == "" {
+		cookies.Path = "/"
+	}
+	if cookies.Name == "" {
+		cookies.Name = "area_session"
+	}
+	return &Handler{service: service, sessions: sessions, cookies: cookies}
+}
+
+// ListWorkspaces handles GET /v1/workspaces
+func (h *Handler) ListWorkspaces(c *gin.Context) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	memberships, err := h.service.List(c.Request.Context(), usr.ID)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	items := make([]openapi.Workspace, 0, len(memberships))
+	for _, membership := range memberships {
+		items = append(items, toOpenAPIWorkspace(membership.Workspace, membership.Role))
+	}
+	c.JSON(http.StatusOK, openapi.WorkspaceListResponse{Workspaces: items})
+}
+
+// CreateWorkspace handles POST /v1/workspaces
+func (h *Handler) CreateWorkspace(c *gin.Context) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	var payload openapi.CreateWorkspaceRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+
+	created, err := h.service.Create(c.Request.Context(), usr.ID, payload.Name)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, toOpenAPIWorkspace(created, workspacedomain.RoleOwner))
+}
+
+// GetWorkspace handles GET /v1/workspaces/{workspaceId}
+func (h *Handler) GetWorkspace(c *gin.Context, workspaceID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	details, err := h.service.Get(c.Request.Context(), usr.ID, workspaceID)
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+
+	members := make([]openapi.WorkspaceMember, 0, len(details.Members))
+	for _, member := range details.Members {
+		members = append(members, toOpenAPIMember(member))
+	}
+	c.JSON(http.StatusOK, openapi.WorkspaceDetail{
+		Workspace: toOpenAPIWorkspace(details.Workspace, details.Role),
+		Members:   members,
+	})
+}
+
+// DeleteWorkspace handles DELETE /v1/workspaces/{workspaceId}
+func (h *Handler) DeleteWorkspace(c *gin.Context, workspaceID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.Delete(c.Request.Context(), usr.ID, workspaceID); err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// AddWorkspaceMember handles POST /v1/workspaces/{workspaceId}/members
+func (h *Handler) AddWorkspaceMember(c *gin.Context, workspaceID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	var payload openapi.AddWorkspaceMemberRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+
+	member, err := h.service.AddMember(c.Request.Context(), usr.ID, workspaceID, string(payload.Email), workspacedomain.Role(payload.Role))
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusCreated, toOpenAPIMember(member))
+}
+
+// UpdateWorkspaceMember handles PATCH /v1/workspaces/{workspaceId}/members/{userId}
+func (h *Handler) UpdateWorkspaceMember(c *gin.Context, workspaceID openapitypes.UUID, userID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	var payload openapi.UpdateWorkspaceMemberRequest
+	if err := c.ShouldBindJSON(&payload); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request payload"})
+		return
+	}
+
+	member, err := h.service.UpdateMemberRole(c.Request.Context(), usr.ID, workspaceID, userID, workspacedomain.Role(payload.Role))
+	if err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, toOpenAPIMember(member))
+}
+
+// RemoveWorkspaceMember handles DELETE /v1/workspaces/{workspaceId}/members/{userId}
+func (h *Handler) RemoveWorkspaceMember(c *gin.Context, workspaceID openapitypes.UUID, userID openapitypes.UUID) {
+	usr, _, ok := h.authorize(c)
+	if !ok {
+		return
+	}
+
+	if err := h.service.RemoveMember(c.Request.Context(), usr.ID, workspaceID, userID); err != nil {
+		h.handleServiceError(c, err)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+func (h *Handler) authorize(c *gin.Context) (userdomain.User, sessiondomain.Session, bool) {
+	if h.service == nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "workspace service unavailable"})
+		return userdomain.User{}, sessiondomain.Session{}, false
+	}
+	if h.sessions == nil {
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "session resolver unavailable"})
+		return userdomain.User{}, sessiondomain.Session{}, false
+	}
+
+	value, err := c.Cookie(h.cookies.Name)
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "session missing"})
+		return userdomain.User{}, sessiondomain.Session{}, false
+	}
+	sessionID, err := uuid.Parse(strings.TrimSpace(value))
+	if err != nil {
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "session invalid"})
+		return userdomain.User{}, sessiondomain.Session{}, false
+	}
+
+	usr, sess, err := h.sessions.ResolveSession(c.Request.Context(), sessionID)
+	if err != nil {
+		h.handleSessionError(c, err)
+		return userdomain.User{}, sessiondomain.Session{}, false
+	}
+	h.refreshSessionCookie(c, sess)
+	return usr, sess, true
+}
+
+func (h *Handler) refreshSessionCookie(c *gin.Context, sess sessiondomain.Session) {
+	maxAge := int(time.Until(sess.ExpiresAt).Seconds())
+	if maxAge <= 0 {
+		maxAge = 0
+	}
+	c.SetSameSite(h.cookies.SameSite)
+	c.SetCookie(h.cookies.Name, sess.ID.String(), maxAge, h.cookies.Path, h.cookies.Domain, h.cookies.Secure, h.cookies.HTTPOnly)
+}
+
+func (h *Handler) handleServiceError(c *gin.Context, err error) {
+	switch {
+	case errors.Is(err, ErrNameRequired), errors.Is(err, ErrNameTooLong):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid workspace payload"})
+	case errors.Is(err, ErrRoleInvalid):
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid role"})
+	case errors.Is(err, ErrNotMember), errors.Is(err, outbound.ErrNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "workspace not found"})
+	case errors.Is(err, ErrOwnerRequired):
+		c.JSON(http.StatusForbidden, gin.H{"error": "owner role required"})
+	case errors.Is(err, ErrUserNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "user not found"})
+	case errors.Is(err, ErrMemberNotFound):
+		c.JSON(http.StatusNotFound, gin.H{"error": "member not found"})
+	case errors.Is(err, ErrMemberExists):
+		c.JSON(http.StatusConflict, gin.H{"error": "user already a member"})
+	case errors.Is(err, ErrLastOwner):
+		c.JSON(http.StatusConflict, gin.H{"error": "workspace needs another owner"})
+	case errors.Is(err, ErrMemberRunsAreas):
+		c.JSON(http.StatusConflict, gin.H{"error": "member still runs shared areas"})
+	default:
+		zap.L().Error("workspace service error", zap.Error(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "internal error"})
+	}
+}
+
+func (h *Handler) handleSessionError(c *gin.Context, err error) {
+	switch {
+	case err == nil:
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "session invalid"})
+	case errors.Is(err, areaauth.ErrSessionNotFound):
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "session invalid"})
+	case errors.Is(err, areaauth.ErrAccountNotVerified):
+		c.JSON(http.StatusForbidden, gin.H{"error": "account not verified"})
+	default:
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "failed to resolve session"})
+	}
+}
+
+func toOpenAPIWorkspace(workspace workspacedomain.Workspace, role workspacedomain.Role) openapi.Workspace {
+	return openapi.Workspace{
+		Id:        workspace.ID,
+		Name:      workspace.Name,
+		Role:      openapi.WorkspaceRole(role),
+		CreatedAt: workspace.CreatedAt,
+		UpdatedAt: workspace.UpdatedAt,
+	}
+}
+
+func toOpenAPIMember(member workspacedomain.Member) openapi.WorkspaceMember {
+	return openapi.WorkspaceMember{
+		UserId:    member.UserID,
+		Email:     openapitypes.Email(member.Email),
+		Role:      openapi.WorkspaceRole(member.Role),
+		CreatedAt: member.CreatedAt,
+	}
+}
@@ -0,0 +1,273 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+const nameMaxLength = 128
+
+var (
+	// ErrNameRequired indicates the workspace name is blank
+	ErrNameRequired = errors.New("workspace: name required")
+	// ErrNameTooLong indicates the workspace name exceeds the length limit
+	ErrNameTooLong = errors.New("workspace: name exceeds limit")
+	// ErrNotMember indicates the user does not belong to the workspace
+	ErrNotMember = errors.New("workspace: not a member")
+	// ErrOwnerRequired indicates the operation is reserved to workspace owners
+	ErrOwnerRequired = errors.New("workspace: owner role required")
+	// ErrRoleInvalid indicates the requested role is unsupported
+	ErrRoleInvalid = errors.New("workspace: invalid role")
+	// ErrUserNotFound indicates no account matches the invited email
+	ErrUserNotFound = errors.New("workspace: user not found")
+	// ErrMemberExists indicates the invited user already belongs to the workspace
+	ErrMemberExists = errors.New("workspace: member already exists")
+	// ErrMemberNotFound indicates the targeted user is not a member
+	ErrMemberNotFound = errors.New("workspace: member not found")
+	// ErrLastOwner prevents a workspace from losing its last owner
+	ErrLastOwner = errors.New("workspace: last owner cannot leave")
+	// ErrMemberRunsAreas prevents removing a member whose accounts still run shared automations
+	ErrMemberRunsAreas = errors.New("workspace: member still runs shared automations")
+)
+
+// Details bundles a workspace with its members and the role of the requesting user
+type Details struct {
+	Workspace workspacedomain.Workspace
+	Role      workspacedomain.Role
+	Members   []workspacedomain.Member
+}
+
+// Service manages shared workspaces and their members
+type Service struct {
+	repo  outbound.WorkspaceRepository
+	users outbound.UserRepository
+	areas outbound.AreaRepository
+}
+
+// NewService assembles a workspace service
+func NewService(repo outbound.WorkspaceRepository, users outbound.UserRepository, areas outbound.AreaRepository) *Service {
+	return &Service{repo: repo, users: users, areas: areas}
+}
+
+// Create registers a workspace owned by the user
+func (s *Service) Create(ctx context.Context, userID uuid.UUID, name string) (workspacedomain.Workspace, error) {
+	if s == nil || s.repo == nil {
+		return workspacedomain.Workspace{}, fmt.Errorf("workspace.Service.Create: repository unavailable")
+	}
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return workspacedomain.Workspace{}, fmt.Errorf("workspace.Service.Create: %w", ErrNameRequired)
+	}
+	if utf8.RuneCountInString(name) > nameMaxLength {
+		return workspacedomain.Workspace{}, fmt.Errorf("workspace.Service.Create: %w", ErrNameTooLong)
+	}
+
+	created, err := s.repo.Create(ctx, workspacedomain.Workspace{Name: name, CreatedBy: userID}, workspacedomain.Member{
+		UserID: userID,
+		Role:   workspacedomain.RoleOwner,
+	})
+	if err != nil {
+		return workspacedomain.Workspace{}, fmt.Errorf("workspace.Service.Create: repo.Create: %w", err)
+	}
+	return created, nil
+}
+
+// List returns the workspaces the user belongs to
+func (s *Service) List(ctx context.Context, userID uuid.UUID) ([]workspacedomain.Membership, error) {
+	if s == nil || s.repo == nil {
+		return nil, fmt.Errorf("workspace.Service.List: repository unavailable")
+	}
+	memberships, err := s.repo.ListByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("workspace.Service.List: repo.ListByUser: %w", err)
+	}
+	return memberships, nil
+}
+
+// Get returns a workspace and its members to one of its members
+func (s *Service) Get(ctx context.Context, userID uuid.UUID, workspaceID uuid.UUID) (Details, error) {
+	member, err := s.requireRole(ctx, userID, workspaceID, workspacedomain.RoleViewer)
+	if err != nil {
+		return Details{}, fmt.Errorf("workspace.Service.Get: %w", err)
+	}
+	workspace, err := s.repo.FindByID(ctx, workspaceID)
+	if err != nil {
+		return Details{}, fmt.Errorf("workspace.Service.Get: repo.FindByID: %w", err)
+	}
+	members, err := s.repo.ListMembers(ctx, workspaceID)
+	if err != nil {
+		return Details{}, fmt.Errorf("workspace.Service.Get: repo.ListMembers: %w", err)
+	}
+	return Details{Workspace: workspace, Role: member.Role, Members: members}, nil
+}
+
+// Delete removes a workspace, its automations become personal automations of the members running them
+func (s *Service) Delete(ctx context.Context, userID uuid.UUID, workspaceID uuid.UUID) error {
+	if _, err := s.requireRole(ctx, userID, workspaceID, workspacedomain.RoleOwner); err != nil {
+		return fmt.Errorf("workspace.Service.Delete: %w", err)
+	}
+	if err := s.repo.Delete(ctx, workspaceID); err != nil {
+		return fmt.Errorf("workspace.Service.Delete: repo.Delete: %w", err)
+	}
+	return nil
+}
+
+// AddMember invites the user registered with email into the workspace
+func (s *Service) AddMember(ctx context.Context, userID uuid.UUID, workspaceID uuid.UUID, email string, role workspacedomain.Role) (workspacedomain.Member, error) {
+	if !role.Valid() {
+		return workspacedomain.Member{}, fmt.Errorf("workspace.Service.AddMember: %w", ErrRoleInvalid)
+	}
+	if _, err := s.requireRole(ctx, userID, workspaceID, workspacedomain.RoleOwner); err != nil {
+		return workspacedomain.Member{}, fmt.Errorf("workspace.Service.AddMember: %w", err)
+	}
+	if s.users == nil {
+		return workspacedomain.Member{}, fmt.Errorf("workspace.Service.AddMember: user repository unavailable")
+	}
+
+	invited, err := s.users.FindByEmail(ctx, strings.ToLower(strings.TrimSpace(email)))
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return workspacedomain.Member{}, fmt.Errorf("workspace.Service.AddMember: %w", ErrUserNotFound)
+		}
+		return workspacedomain.Member{}, fmt.Errorf("workspace.Service.AddMember: users.FindByEmail: %w", err)
+	}
+	if _, err := s.repo.FindMember(ctx, workspaceID, invited.ID); err == nil {
+		return workspacedomain.Member{}, fmt.Errorf("workspace.Service.AddMember: %w", ErrMemberExists)
+	} else if !errors.Is(err, outbound.ErrNotFound) {
+		return workspacedomain.Member{}, fmt.Errorf("workspace.Service.AddMember: repo.FindMember: %w", err)
+	}
+
+	member := workspacedomain.Member{WorkspaceID: workspaceID, UserID: invited.ID, Email: invited.Email, Role: role}
+	if err := s.repo.SaveMember(ctx, member); err != nil {
+		if errors.Is(err, outbound.ErrConflict) {
+			return workspacedomain.Member{}, fmt.Errorf("workspace.Service.AddMember: %w", ErrMemberExists)
+		}
+		return workspacedomain.Member{}, fmt.Errorf("workspace.Service.AddMember: repo.SaveMember: %w", err)
+	}
+	return s.findMember(ctx, workspaceID, invited.ID)
+}
+
+// UpdateMemberRole changes the role of a member
+func (s *Service) UpdateMemberRole(ctx context.Context, userID uuid.UUID, workspaceID uuid.UUID, memberID uuid.UUID, role workspacedomain.Role) (workspacedomain.Member, error) {
+	if !role.Valid() {
+		return workspacedomain.Member{}, fmt.Errorf("workspace.Service.UpdateMemberRole: %w", ErrRoleInvalid)
+	}
+	if _, err := s.requireRole(ctx, userID, workspaceID, workspacedomain.RoleOwner); err != nil {
+		return workspacedomain.Member{}, fmt.Errorf("workspace.Service.UpdateMemberRole: %w", err)
+	}
+	member, err := s.findMember(ctx, workspaceID, memberID)
+	if err != nil {
+		return workspacedomain.Member{}, fmt.Errorf("workspace.Service.UpdateMemberRole: %w", err)
+	}
+	if member.Role == role {
+		return member, nil
+	}
+	if member.Role == workspacedomain.RoleOwner {
+		if err := s.ensureAnotherOwner(ctx, workspaceID, memberID); err != nil {
+			return workspacedomain.Member{}, fmt.Errorf("workspace.Service.UpdateMemberRole: %w", err)
+		}
+	}
+	if !role.Allows(workspacedomain.RoleEditor) {
+		if err := s.ensureRunsNoAreas(ctx, workspaceID, memberID); err != nil {
+			return workspacedomain.Member{}, fmt.Errorf("workspace.Service.UpdateMemberRole: %w", err)
+		}
+	}
+
+	member.Role = role
+	if err := s.repo.SaveMember(ctx, member); err != nil {
+		return workspacedomain.Member{}, fmt.Errorf("workspace.Service.UpdateMemberRole: repo.SaveMember: %w", err)
+	}
+	return s.findMember(ctx, workspaceID, memberID)
+}
+
+// RemoveMember removes a member, owners may remove anyone and members may leave on their own
+func (s *Service) RemoveMember(ctx context.Context, userID uuid.UUID, workspaceID uuid.UUID, memberID uuid.UUID) error {
+	required := workspacedomain.RoleOwner
+	if memberID == userID {
+		required = workspacedomain.RoleViewer
+	}
+	if _, err := s.requireRole(ctx, userID, workspaceID, required); err != nil {
+		return fmt.Errorf("workspace.Service.RemoveMember: %w", err)
+	}
+	member, err := s.findMember(ctx, workspaceID, memberID)
+	if err != nil {
+		return fmt.Errorf("workspace.Service.RemoveMember: %w", err)
+	}
+	if member.Role == workspacedomain.RoleOwner {
+		if err := s.ensureAnotherOwner(ctx, workspaceID, memberID); err != nil {
+			return fmt.Errorf("workspace.Service.RemoveMember: %w", err)
+		}
+	}
+	if err := s.ensureRunsNoAreas(ctx, workspaceID, memberID); err != nil {
+		return fmt.Errorf("workspace.Service.RemoveMember: %w", err)
+	}
+	if err := s.repo.DeleteMember(ctx, workspaceID, memberID); err != nil {
+		return fmt.Errorf("workspace.Service.RemoveMember: repo.DeleteMember: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) requireRole(ctx context.Context, userID uuid.UUID, workspaceID uuid.UUID, required workspacedomain.Role) (workspacedomain.Member, error) {
+	if s == nil || s.repo == nil {
+		return workspacedomain.Member{}, fmt.Errorf("repository unavailable")
+	}
+	member, err := s.repo.FindMember(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return workspacedomain.Member{}, ErrNotMember
+		}
+		return workspacedomain.Member{}, fmt.Errorf("repo.FindMember: %w", err)
+	}
+	if !member.Role.Allows(required) {
+		return workspacedomain.Member{}, ErrOwnerRequired
+	}
+	return member, nil
+}
+
+func (s *Service) findMember(ctx context.Context, workspaceID uuid.UUID, userID uuid.UUID) (workspacedomain.Member, error) {
+	member, err := s.repo.FindMember(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, outbound.ErrNotFound) {
+			return workspacedomain.Member{}, ErrMemberNotFound
+		}
+		return workspacedomain.Member{}, fmt.Errorf("repo.FindMember: %w", err)
+	}
+	return member, nil
+}
+
+func (s *Service) ensureAnotherOwner(ctx context.Context, workspaceID uuid.UUID, memberID uuid.UUID) error {
+	members, err := s.repo.ListMembers(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("repo.ListMembers: %w", err)
+	}
+	for _, member := range members {
+		if member.UserID != memberID && member.Role == workspacedomain.RoleOwner {
+			return nil
+		}
+	}
+	return ErrLastOwner
+}
+
+// ensureRunsNoAreas keeps shared automations runnable, their runner must stay an editor of the workspace
+func (s *Service) ensureRunsNoAreas(ctx context.Context, workspaceID uuid.UUID, memberID uuid.UUID) error {
+	if s.areas == nil {
+		return nil
+	}
+	areas, err := s.areas.ListByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return fmt.Errorf("areas.ListByWorkspace: %w", err)
+	}
+	for _, area := range areas {
+		if area.UserID == memberID {
+			return ErrMemberRunsAreas
+		}
+	}
+	return nil
+}
@@ -0,0 +1,277 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	userdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/user"
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound"
+	"github.com/google/uuid"
+)
+
+type memoryRepo struct {
+	workspaces map[uuid.UUID]workspacedomain.Workspace
+	members    map[uuid.UUID]map[uuid.UUID]workspacedomain.Member
+}
+
+func newMemoryRepo() *memoryRepo {
+	return &memoryRepo{
+		workspaces: map[uuid.UUID]workspacedomain.Workspace{},
+		members:    map[uuid.UUID]map[uuid.UUID]workspacedomain.Member{},
+	}
+}
+
+func (m *memoryRepo) Create(ctx context.Context, workspace workspacedomain.Workspace, owner workspacedomain.Member) (workspacedomain.Workspace, error) {
+	workspace.ID = uuid.New()
+	m.workspaces[workspace.ID] = workspace
+	owner.WorkspaceID = workspace.ID
+	m.members[workspace.ID] = map[uuid.UUID]workspacedomain.Member{owner.UserID: owner}
+	return workspace, nil
+}
+
+func (m *memoryRepo) FindByID(ctx context.Context, id uuid.UUID) (workspacedomain.Workspace, error) {
+	workspace, ok := m.workspaces[id]
+	if !ok {
+		return workspacedomain.Workspace{}, outbound.ErrNotFound
+	}
+	return workspace, nil
+}
+
+func (m *memoryRepo) ListByUser(ctx context.Context, userID uuid.UUID) ([]workspacedomain.Membership, error) {
+	var memberships []workspacedomain.Membership
+	for id, members := range m.members {
+		if member, ok := members[userID]; ok {
+			memberships = append(memberships, workspacedomain.Membership{Workspace: m.workspaces[id], Role: member.Role})
+		}
+	}
+	return memberships, nil
+}
+
+func (m *memoryRepo) Delete(ctx context.Context, id uuid.UUID) error {
+	delete(m.workspaces, id)
+	delete(m.members, id)
+	return nil
+}
+
+func (m *memoryRepo) FindMember(ctx context.Context, workspaceID uuid.UUID, userID uuid.UUID) (workspacedomain.Member, error) {
+	member, ok := m.members[workspaceID][userID]
+	if !ok {
+		return workspacedomain.Member{}, outbound.ErrNotFound
+	}
+	return member, nil
+}
+
+func (m *memoryRepo) ListMembers(ctx context.Context, workspaceID uuid.UUID) ([]workspacedomain.Member, error) {
+	members := make([]workspacedomain.Member, 0, len(m.members[workspaceID]))
+	for _, member := range m.members[workspaceID] {
+		members = append(members, member)
+	}
+	return members, nil
+}
+
+func (m *memoryRepo) SaveMember(ctx context.Context, member workspacedomain.Member) error {
+	m.members[member.WorkspaceID][member.UserID] = member
+	return nil
+}
+
+func (m *memoryRepo) DeleteMember(ctx context.Context, workspaceID uuid.UUID, userID uuid.UUID) error {
+	delete(m.members[workspaceID], userID)
+	return nil
+}
+
+type memoryUsers struct {
+	users []userdomain.User
+}
+
+func (m memoryUsers) Create(ctx context.Context, user userdomain.User) (userdomain.User, error) {
+	return user, nil
+}
+
+func (m memoryUsers) FindByEmail(ctx context.Context, email string) (userdomain.User, error) {
+	for _, user := range m.users {
+		if user.Email == email {
+			return user, nil
+		}
+	}
+	return userdomain.User{}, outbound.ErrNotFound
+}
+
+func (m memoryUsers) FindByID(ctx context.Context, id uuid.UUID) (userdomain.User, error) {
+	for _, user := range m.users {
+		if user.ID == id {
+			return user, nil
+		}
+	}
+	return userdomain.User{}, outbound.ErrNotFound
+}
+
+func (m memoryUsers) Update(ctx context.Context, user userdomain.User) error {
+	return nil
+}
+
+type stubAreas struct {
+	areas []areadomain.Area
+}
+
+func (s stubAreas) Create(ctx context.Context, area areadomain.Area, action areadomain.Link, reactions []areadomain.Link) (areadomain.Area, error) {
+	return area, nil
+}
+
+func (s stubAreas) FindByID(ctx context.Context, id uuid.UUID) (areadomain.Area, error) {
+	return areadomain.Area{}, outbound.ErrNotFound
+}
+
+func (s stubAreas) ListByUser(ctx context.Context, userID uuid.UUID) ([]areadomain.Area, error) {
+	return nil, nil
+}
+
+func (s stubAreas) ListByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]areadomain.Area, error) {
+	return s.areas, nil
+}
+
+func (s stubAreas) ListByWorkspaces(ctx context.Context, workspaceIDs []uuid.UUID) ([]areadomain.Area, error) {
+	return s.areas, nil
+}
+
+func (s stubAreas) ListPage(ctx context.Context, opts outbound.AreaListOptions) (outbound.AreaPage, error) {
+	return outbound.AreaPage{}, nil
+}
+
+func (s stubAreas) Delete(ctx context.Context, id uuid.UUID) error {
+	return nil
+}
+
+func (s stubAreas) UpdateMetadata(ctx context.Context, area areadomain.Area) error {
+	return nil
+}
+
+func (s stubAreas) UpdateConfig(ctx context.Context, config componentdomain.Config) error {
+	return nil
+}
+
+func (s stubAreas) UpdateOwnership(ctx context.Context, area areadomain.Area) error {
+	return nil
+}
+
+func (s stubAreas) UpdateActivitySchedule(ctx context.Context, areaID uuid.UUID, schedule *areadomain.ActivitySchedule) error {
+	return nil
+}
+
+func TestServiceCreateAndMembers(t *testing.T) {
+	ctx := context.Background()
+	owner := uuid.New()
+	invited := userdomain.User{ID: uuid.New(), Email: "teammate@example.com"}
+	repo := newMemoryRepo()
+	svc := NewService(repo, memoryUsers{users: []userdomain.User{invited}}, stubAreas{})
+
+	if _, err := svc.Create(ctx, owner, "   "); !errors.Is(err, ErrNameRequired) {
+		t.Fatalf("expected ErrNameRequired got %v", err)
+	}
+	created, err := svc.Create(ctx, owner, " Ops team ")
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	if created.Name != "Ops team" {
+		t.Fatalf("expected trimmed name got %q", created.Name)
+	}
+
+	if _, err := svc.AddMember(ctx, owner, created.ID, "nobody@example.com", workspacedomain.RoleEditor); !errors.Is(err, ErrUserNotFound) {
+		t.Fatalf("expected ErrUserNotFound got %v", err)
+	}
+	if _, err := svc.AddMember(ctx, owner, created.ID, invited.Email, "admin"); !errors.Is(err, ErrRoleInvalid) {
+		t.Fatalf("expected ErrRoleInvalid got %v", err)
+	}
+	member, err := svc.AddMember(ctx, owner, created.ID, " Teammate@example.com ", workspacedomain.RoleViewer)
+	if err != nil {
+		t.Fatalf("AddMember returned error: %v", err)
+	}
+	if member.UserID != invited.ID || member.Role != workspacedomain.RoleViewer {
+		t.Fatalf("unexpected member %+v", member)
+	}
+	if _, err := svc.AddMember(ctx, owner, created.ID, invited.Email, workspacedomain.RoleViewer); !errors.Is(err, ErrMemberExists) {
+		t.Fatalf("expected ErrMemberExists got %v", err)
+	}
+	if _, err := svc.AddMember(ctx, invited.ID, created.ID, invited.Email, workspacedomain.RoleOwner); !errors.Is(err, ErrOwnerRequired) {
+		t.Fatalf("expected ErrOwnerRequired got %v", err)
+	}
+
+	details, err := svc.Get(ctx, invited.ID, created.ID)
+	if err != nil {
+		t.Fatalf("Get returned error: %v", err)
+	}
+	if details.Role != workspacedomain.RoleViewer || len(details.Members) != 2 {
+		t.Fatalf("unexpected details %+v", details)
+	}
+	if _, err := svc.Get(ctx, uuid.New(), created.ID); !errors.Is(err, ErrNotMember) {
+		t.Fatalf("expected ErrNotMember got %v", err)
+	}
+
+	memberships, err := svc.List(ctx, invited.ID)
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(memberships) != 1 || memberships[0].Workspace.ID != created.ID {
+		t.Fatalf("unexpected memberships %+v", memberships)
+	}
+}
+
+func TestServiceKeepsAnOwner(t *testing.T) {
+	ctx := context.Background()
+	owner := uuid.New()
+	repo := newMemoryRepo()
+	svc := NewService(repo, memoryUsers{}, stubAreas{})
+	created, err := svc.Create(ctx, owner, "Ops")
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+
+	if _, err := svc.UpdateMemberRole(ctx, owner, created.ID, owner, workspacedomain.RoleEditor); !errors.Is(err, ErrLastOwner) {
+		t.Fatalf("expected ErrLastOwner on demotion got %v", err)
+	}
+	if err := svc.RemoveMember(ctx, owner, created.ID, owner); !errors.Is(err, ErrLastOwner) {
+		t.Fatalf("expected ErrLastOwner on leave got %v", err)
+	}
+
+	second := uuid.New()
+	repo.members[created.ID][second] = workspacedomain.Member{WorkspaceID: created.ID, UserID: second, Role: workspacedomain.RoleOwner}
+	if err := svc.RemoveMember(ctx, owner, created.ID, owner); err != nil {
+		t.Fatalf("RemoveMember returned error: %v", err)
+	}
+	if _, ok := repo.members[created.ID][owner]; ok {
+		t.Fatalf("expected owner to have left")
+	}
+}
+
+func TestServiceProtectsRunners(t *testing.T) {
+	ctx := context.Background()
+	owner := uuid.New()
+	runner := uuid.New()
+	repo := newMemoryRepo()
+	svc := NewService(repo, memoryUsers{}, stubAreas{areas: []areadomain.Area{{ID: uuid.New(), UserID: runner}}})
+	created, err := svc.Create(ctx, owner, "Ops")
+	if err != nil {
+		t.Fatalf("Create returned error: %v", err)
+	}
+	repo.members[created.ID][runner] = workspacedomain.Member{WorkspaceID: created.ID, UserID: runner, Role: workspacedomain.RoleEditor}
+
+	if err := svc.RemoveMember(ctx, runner, created.ID, owner); !errors.Is(err, ErrOwnerRequired) {
+		t.Fatalf("expected ErrOwnerRequired got %v", err)
+	}
+	if err := svc.RemoveMember(ctx, owner, created.ID, runner); !errors.Is(err, ErrMemberRunsAreas) {
+		t.Fatalf("expected ErrMemberRunsAreas on removal got %v", err)
+	}
+	if _, err := svc.UpdateMemberRole(ctx, owner, created.ID, runner, workspacedomain.RoleViewer); !errors.Is(err, ErrMemberRunsAreas) {
+		t.Fatalf("expected ErrMemberRunsAreas on demotion got %v", err)
+	}
+	promoted, err := svc.UpdateMemberRole(ctx, owner, created.ID, runner, workspacedomain.RoleOwner)
+	if err != nil {
+		t.Fatalf("UpdateMemberRole returned error: %v", err)
+	}
+	if promoted.Role != workspacedomain.RoleOwner {
+		t.Fatalf("unexpected role %s", promoted.Role)
+	}
+}
@@ -0,0 +1,12 @@
+package area
+
+import "time"
+
+// Activity summarizes the recent executions of an area
+type Activity struct {
+	LastTriggeredAt *time.Time
+	LastJobStatus   string
+	LastJobAt       *time.Time
+	// RecentFailures counts the reaction jobs that failed during the last 24 hours
+	RecentFailures int
+}
@@ -17,19 +17,34 @@ const (
 	StatusDisabled Status = "disabled"
 	// StatusArchived marks the automation as read-only and hidden from default listings
 	StatusArchived Status = "archived"
+	// StatusSuspended is set by the circuit breaker when the reactions keep failing, only the user can lift it
+	StatusSuspended Status = "suspended_by_system"
 )
 
 // Area represents an automation composed of an action and one or more reactions
+// UserID owns personal areas, for shared areas it is the member whose linked accounts run the reactions
 type Area struct {
 	ID          uuid.UUID
 	UserID      uuid.UUID
+	WorkspaceID *uuid.UUID
+	FolderID    *uuid.UUID
 	Name        string
 	Description *string
 	Status      Status
+	Revision    int
 	CreatedAt   time.Time
 	UpdatedAt   time.Time
 	Action      *Link
 	Reactions   []Link
+	Tags        []Tag
+	// HealthPolicy overrides the default circuit breaker policy when set
+	HealthPolicy *HealthPolicy
+	// Suspension is only set while the area is suspended by the system
+	Suspension *Suspension
+	// ActivitySchedule limits when the area reacts to events, nil keeps it always active
+	ActivitySchedule *ActivitySchedule
+	// Activity is only loaded by paged listings
+	Activity *Activity
 }
 
 // WithDescription returns a copy of the area with the provided description applied
@@ -48,6 +63,11 @@ func (a Area) OwnedBy(userID uuid.UUID) bool {
 	return a.UserID == userID
 }
 
+// Shared reports whether the area belongs to a workspace
+func (a Area) Shared() bool {
+	return a.WorkspaceID != nil && *a.WorkspaceID != uuid.Nil
+}
+
 // WithStatus returns a copy of the area carrying the provided status
 func (a Area) WithStatus(status Status) Area {
 	a.Status = status
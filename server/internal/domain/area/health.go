@@ -0,0 +1,56 @@
+package area
+
+import (
+	"fmt"
+	"time"
+)
+
+// HealthPolicy decides when repeated reaction failures trip the circuit breaker of an area
+// A zero threshold disables the matching rule
+type HealthPolicy struct {
+	// ConsecutiveFailures trips the breaker after that many failed jobs in a row
+	ConsecutiveFailures int
+	// FailureRate trips the breaker when the share of failed jobs finished within Window reaches it
+	FailureRate float64
+	Window      time.Duration
+	// MinJobs is the number of jobs that must finish within Window before FailureRate applies
+	MinJobs int
+}
+
+// DefaultHealthPolicy applies to areas that do not define their own policy
+func DefaultHealthPolicy() HealthPolicy {
+	return HealthPolicy{
+		ConsecutiveFailures: 5,
+		FailureRate:         0.8,
+		Window:              time.Hour,
+		MinJobs:             10,
+	}
+}
+
+// HealthStats summarises the reaction jobs an area finished since its breaker was last reset
+type HealthStats struct {
+	// ConsecutiveFailures counts the jobs that failed after the last successful one
+	ConsecutiveFailures int
+	WindowJobs          int
+	WindowFailures      int
+}
+
+// Suspension records when and why the circuit breaker suspended an area
+type Suspension struct {
+	At     time.Time
+	Reason string
+}
+
+// Trips reports whether the stats break the policy along with the reason shown to the owner
+func (p HealthPolicy) Trips(stats HealthStats) (bool, string) {
+	if p.ConsecutiveFailures > 0 && stats.ConsecutiveFailures >= p.ConsecutiveFailures {
+		return true, fmt.Sprintf("%d consecutive reaction failures", stats.ConsecutiveFailures)
+	}
+	if p.FailureRate > 0 && stats.WindowJobs > 0 && stats.WindowJobs >= p.MinJobs {
+		rate := float64(stats.WindowFailures) / float64(stats.WindowJobs)
+		if rate >= p.FailureRate {
+			return true, fmt.Sprintf("%d of %d reactions failed within %s", stats.WindowFailures, stats.WindowJobs, p.Window)
+		}
+	}
+	return false, ""
+}
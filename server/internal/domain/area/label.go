@@ -0,0 +1,26 @@
+package area
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Tag is a user-defined label attached to any number of areas
+type Tag struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	Color     *string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Folder groups areas of a user, an area sits in at most one folder
+type Folder struct {
+	ID        uuid.UUID
+	UserID    uuid.UUID
+	Name      string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
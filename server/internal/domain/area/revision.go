@@ -0,0 +1,85 @@
+package area
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RevisionReason explains which operation produced a revision
+type RevisionReason string
+
+const (
+	// RevisionReasonCreated marks the revision recorded when the automation is created
+	RevisionReasonCreated RevisionReason = "created"
+	// RevisionReasonUpdated marks revisions recorded after name, description or params edits
+	RevisionReasonUpdated RevisionReason = "updated"
+	// RevisionReasonStatus marks revisions recorded after a status change
+	RevisionReasonStatus RevisionReason = "status"
+	// RevisionReasonRollback marks revisions restoring an earlier snapshot
+	RevisionReasonRollback RevisionReason = "rollback"
+)
+
+// Revision is an immutable snapshot of an automation recorded after each change
+type Revision struct {
+	ID        uuid.UUID
+	AreaID    uuid.UUID
+	Number    int
+	AuthorID  uuid.UUID
+	Reason    RevisionReason
+	Snapshot  Snapshot
+	CreatedAt time.Time
+}
+
+// Snapshot captures the editable state of an automation
+type Snapshot struct {
+	Name        string
+	Description *string
+	Status      Status
+	Links       []SnapshotLink
+}
+
+// SnapshotLink captures the configuration of one action or reaction link
+type SnapshotLink struct {
+	Role        LinkRole
+	Position    int
+	ConfigID    uuid.UUID
+	ComponentID uuid.UUID
+	Name        string
+	Params      map[string]any
+}
+
+// Snapshot returns the current editable state of the automation
+func (a Area) Snapshot() Snapshot {
+	snapshot := Snapshot{
+		Name:   a.Name,
+		Status: a.Status,
+		Links:  make([]SnapshotLink, 0, len(a.Reactions)+1),
+	}
+	if a.Description != nil {
+		desc := *a.Description
+		snapshot.Description = &desc
+	}
+	if a.Action != nil {
+		snapshot.Links = append(snapshot.Links, snapshotLink(*a.Action))
+	}
+	for _, reaction := range a.Reactions {
+		snapshot.Links = append(snapshot.Links, snapshotLink(reaction))
+	}
+	return snapshot
+}
+
+func snapshotLink(link Link) SnapshotLink {
+	params := make(map[string]any, len(link.Config.Params))
+	for key, value := range link.Config.Params {
+		params[key] = value
+	}
+	return SnapshotLink{
+		Role:        link.Role,
+		Position:    link.Position,
+		ConfigID:    link.Config.ID,
+		ComponentID: link.Config.ComponentID,
+		Name:        link.Config.Name,
+		Params:      params,
+	}
+}
@@ -0,0 +1,91 @@
+package area
+
+import (
+	"fmt"
+	"time"
+)
+
+// ActivitySchedule restricts when an area reacts to events
+// Events outside of it are recorded as filtered triggers
+type ActivitySchedule struct {
+	// TimeZone is the IANA zone the windows are expressed in, UTC when empty
+	TimeZone string
+	// Windows lists the daily periods during which the area is active, none keeps it active all day
+	Windows  []ActivityWindow
+	StartsAt *time.Time
+	EndsAt   *time.Time
+}
+
+// ActivityWindow is a daily period expressed in minutes since midnight
+// A window ending before it starts spans midnight and End equal to Start covers the whole day
+type ActivityWindow struct {
+	// Days lists the weekdays the window opens on, none opens it every day
+	Days  []time.Weekday
+	Start int
+	End   int
+}
+
+// MinutesPerDay bounds the start and end of activity windows
+const MinutesPerDay = 24 * 60
+
+// Location resolves the time zone of the schedule, falling back to UTC
+func (s ActivitySchedule) Location() *time.Location {
+	if s.TimeZone == "" {
+		return time.UTC
+	}
+	location, err := time.LoadLocation(s.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return location
+}
+
+// Active reports whether the area reacts to events occurring at along with the reason when it does not
+func (s ActivitySchedule) Active(at time.Time) (bool, string) {
+	if s.StartsAt != nil && at.Before(*s.StartsAt) {
+		return false, fmt.Sprintf("area inactive until %s", s.StartsAt.UTC().Format(time.RFC3339))
+	}
+	if s.EndsAt != nil && !at.Before(*s.EndsAt) {
+		return false, fmt.Sprintf("area inactive since %s", s.EndsAt.UTC().Format(time.RFC3339))
+	}
+	if len(s.Windows) == 0 {
+		return true, ""
+	}
+	location := s.Location()
+	local := at.In(location)
+	for _, window := range s.Windows {
+		if window.covers(local) {
+			return true, ""
+		}
+	}
+	return false, fmt.Sprintf("outside activity windows (%s %s)", local.Format("Mon 15:04"), location)
+}
+
+func (w ActivityWindow) covers(local time.Time) bool {
+	minute := local.Hour()*60 + local.Minute()
+	weekday := local.Weekday()
+	switch {
+	case w.Start == w.End:
+		return w.opensOn(weekday)
+	case w.Start < w.End:
+		return w.opensOn(weekday) && minute >= w.Start && minute < w.End
+	case minute >= w.Start:
+		return w.opensOn(weekday)
+	case minute < w.End:
+		return w.opensOn((weekday + 6) % 7)
+	default:
+		return false
+	}
+}
+
+func (w ActivityWindow) opensOn(day time.Weekday) bool {
+	if len(w.Days) == 0 {
+		return true
+	}
+	for _, candidate := range w.Days {
+		if candidate == day {
+			return true
+		}
+	}
+	return false
+}
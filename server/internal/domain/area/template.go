@@ -0,0 +1,39 @@
+package area
+
+import (
+	"time"
+
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/google/uuid"
+)
+
+// Template is an automation blueprint published by administrators
+// Params missing from a slot are filled by the user when the template is instantiated
+type Template struct {
+	ID          uuid.UUID
+	Name        string
+	Description string
+	Action      TemplateSlot
+	Reactions   []TemplateSlot
+	CreatedBy   uuid.UUID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// TemplateSlot references a catalog component and the params preset by the template
+type TemplateSlot struct {
+	ComponentID uuid.UUID
+	Name        string
+	Params      map[string]any
+	Component   *componentdomain.Component
+}
+
+// ComponentIDs returns the catalog components referenced by the template, action first
+func (t Template) ComponentIDs() []uuid.UUID {
+	ids := make([]uuid.UUID, 0, len(t.Reactions)+1)
+	ids = append(ids, t.Action.ComponentID)
+	for _, reaction := range t.Reactions {
+		ids = append(ids, reaction.ComponentID)
+	}
+	return ids
+}
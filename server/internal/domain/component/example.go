@@ -0,0 +1,12 @@
+package component
+
+import "github.com/google/uuid"
+
+// Example holds a sample payload documented for a component
+// For actions the output is the event the action emits
+type Example struct {
+	ID          uuid.UUID
+	ComponentID uuid.UUID
+	Input       map[string]any
+	Output      map[string]any
+}
@@ -0,0 +1,59 @@
+package user
+
+import (
+	"fmt"
+	"time"
+)
+
+// Quota caps the resources an account may consume according to its role
+// A zero limit disables the matching rule
+type Quota struct {
+	MaxAreas             int
+	MaxExecutionsPerHour int
+	MaxExecutionsPerDay  int
+	// MinPollingInterval is the shortest delay allowed between two polls of an action source
+	MinPollingInterval  time.Duration
+	MaxReactionsPerArea int
+}
+
+// Usage counts the resources an account consumed, executions are counted over rolling windows
+type Usage struct {
+	Areas              int
+	ExecutionsLastHour int
+	ExecutionsLastDay  int
+}
+
+// AllowsArea reports whether one more automation fits the quota along with the rejection reason
+func (q Quota) AllowsArea(usage Usage) (bool, string) {
+	if q.MaxAreas > 0 && usage.Areas >= q.MaxAreas {
+		return false, fmt.Sprintf("limit of %d areas reached", q.MaxAreas)
+	}
+	return true, ""
+}
+
+// AllowsReactions reports whether an automation may chain count reactions along with the rejection reason
+func (q Quota) AllowsReactions(count int) (bool, string) {
+	if q.MaxReactionsPerArea > 0 && count > q.MaxReactionsPerArea {
+		return false, fmt.Sprintf("limit of %d reactions per area exceeded", q.MaxReactionsPerArea)
+	}
+	return true, ""
+}
+
+// AllowsExecution reports whether one more execution fits the quota along with the rejection reason
+func (q Quota) AllowsExecution(usage Usage) (bool, string) {
+	if q.MaxExecutionsPerHour > 0 && usage.ExecutionsLastHour >= q.MaxExecutionsPerHour {
+		return false, fmt.Sprintf("limit of %d executions per hour reached", q.MaxExecutionsPerHour)
+	}
+	if q.MaxExecutionsPerDay > 0 && usage.ExecutionsLastDay >= q.MaxExecutionsPerDay {
+		return false, fmt.Sprintf("limit of %d executions per day reached", q.MaxExecutionsPerDay)
+	}
+	return true, ""
+}
+
+// PollingInterval raises interval to the minimum polling interval of the quota
+func (q Quota) PollingInterval(interval time.Duration) time.Duration {
+	if q.MinPollingInterval > 0 && interval < q.MinPollingInterval {
+		return q.MinPollingInterval
+	}
+	return interval
+}
@@ -0,0 +1,67 @@
+package workspace
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Role grants a workspace member access to the shared automations
+type Role string
+
+const (
+	// RoleViewer can read shared automations and their history
+	RoleViewer Role = "viewer"
+	// RoleEditor can additionally edit, execute and create shared automations
+	RoleEditor Role = "editor"
+	// RoleOwner can additionally delete automations and manage members
+	RoleOwner Role = "owner"
+)
+
+// Valid reports whether the role is one of the supported roles
+func (r Role) Valid() bool {
+	return r.rank() > 0
+}
+
+// Allows reports whether the role grants at least the required role
+func (r Role) Allows(required Role) bool {
+	return r.rank() > 0 && r.rank() >= required.rank()
+}
+
+func (r Role) rank() int {
+	switch r {
+	case RoleViewer:
+		return 1
+	case RoleEditor:
+		return 2
+	case RoleOwner:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Workspace groups automations shared between several users
+type Workspace struct {
+	ID        uuid.UUID
+	Name      string
+	CreatedBy uuid.UUID
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// Member grants a user a role inside a workspace
+type Member struct {
+	WorkspaceID uuid.UUID
+	UserID      uuid.UUID
+	Email       string
+	Role        Role
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// Membership pairs a workspace with the role of the user listing it
+type Membership struct {
+	Workspace Workspace
+	Role      Role
+}
@@ -14,6 +14,8 @@ type Config struct {
 	OAuth           OAuthConfig           `mapstructure:"oauth"`
 	Security        SecurityConfig        `mapstructure:"security"`
 	ServicesCatalog ServicesCatalogConfig `mapstructure:"servicesCatalog"`
+	Endpoints       EndpointsConfig       `mapstructure:"endpoints"`
+	Quotas          QuotasConfig          `mapstructure:"quotas"`
 }
 
 // AppConfig controls global application parameters
@@ -136,11 +138,22 @@ type WebhookConfig struct {
 
 // MailerConfig holds email provider settings
 type MailerConfig struct {
-	Provider    string `mapstructure:"provider"`
-	FromEmail   string `mapstructure:"fromEmail"`
-	SandboxMode bool   `mapstructure:"sandboxMode"`
-	APIKeyEnv   string `mapstructure:"apiKeyEnv"`
-	APIKey      string `mapstructure:"-"`
+	Provider    string     `mapstructure:"provider"`
+	FromEmail   string     `mapstructure:"fromEmail"`
+	SandboxMode bool       `mapstructure:"sandboxMode"`
+	APIKeyEnv   string     `mapstructure:"apiKeyEnv"`
+	APIKey      string     `mapstructure:"-"`
+	SMTP        SMTPConfig `mapstructure:"smtp"`
+}
+
+// SMTPConfig configures the SMTP relay used when the mailer provider is smtp
+type SMTPConfig struct {
+	Host        string `mapstructure:"host"`
+	Port        int    `mapstructure:"port"`
+	Security    string `mapstructure:"security"`
+	Username    string `mapstructure:"username"`
+	PasswordEnv string `mapstructure:"passwordEnv"`
+	Password    string `mapstructure:"-"`
 }
 
 // SecretsConfig configures secret provider backends
@@ -156,11 +169,14 @@ type OAuthConfig struct {
 }
 
 // OAuthProviderConfig stores OAuth credentials and scopes
+// Type and BaseURL declare a self-hosted instance (for example a gitlab-corp entry of type gitlab)
 type OAuthProviderConfig struct {
 	ClientIDEnv     string   `mapstructure:"clientIDEnv"`
 	ClientSecretEnv string   `mapstructure:"clientSecretEnv"`
 	RedirectURI     string   `mapstructure:"redirectURI"`
 	Scopes          []string `mapstructure:"scopes"`
+	Type            string   `mapstructure:"type"`
+	BaseURL         string   `mapstructure:"baseURL"`
 	ClientID        string   `mapstructure:"-"`
 	ClientSecret    string   `mapstructure:"-"`
 }
@@ -214,6 +230,28 @@ type EncryptionConfig struct {
 	IdentitiesKey    string `mapstructure:"-"`
 }
 
+// EndpointsConfig redirects outbound provider APIs, typically towards local stand-ins
+// Overrides map a provider name (slack, github, ...) to the base URL replacing its public origins
+// AllowPrivateMailServers lets mailboxes reach loopback and private hosts such as the bundled fake mail server
+type EndpointsConfig struct {
+	Overrides               map[string]string `mapstructure:"overrides"`
+	AllowPrivateMailServers bool              `mapstructure:"allowPrivateMailServers"`
+}
+
+// QuotasConfig caps the resources each account may consume, plans are keyed by user role
+type QuotasConfig struct {
+	Plans map[string]QuotaPlanConfig `mapstructure:"plans"`
+}
+
+// QuotaPlanConfig lists the limits of a plan, a zero limit leaves the resource unlimited
+type QuotaPlanConfig struct {
+	MaxAreas             int           `mapstructure:"maxAreas"`
+	MaxExecutionsPerHour int           `mapstructure:"maxExecutionsPerHour"`
+	MaxExecutionsPerDay  int           `mapstructure:"maxExecutionsPerDay"`
+	MinPollingInterval   time.Duration `mapstructure:"minPollingInterval"`
+	MaxReactionsPerArea  int           `mapstructure:"maxReactionsPerArea"`
+}
+
 // ServicesCatalogConfig configures service discovery bootstrap
 type ServicesCatalogConfig struct {
 	RefreshInterval time.Duration `mapstructure:"refreshInterval"`
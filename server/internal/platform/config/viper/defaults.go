@@ -88,6 +88,11 @@ var _defaultConfig = Config{
 			FromEmail:   "noreply@area.local",
 			SandboxMode: true,
 			APIKeyEnv:   "SENDGRID_API_KEY",
+			SMTP: SMTPConfig{
+				Port:        587,
+				Security:    "starttls",
+				PasswordEnv: "SMTP_PASSWORD",
+			},
 		},
 	},
 	Secrets: SecretsConfig{
@@ -101,13 +106,13 @@ var _defaultConfig = Config{
 				ClientIDEnv:     "GOOGLE_OAUTH_CLIENT_ID",
 				ClientSecretEnv: "GOOGLE_OAUTH_CLIENT_SECRET",
 				RedirectURI:     "http://localhost:8080/oauth/google/callback",
-				Scopes:          []string{"email", "profile", "https://www.googleapis.com/auth/gmail.send"},
+				Scopes:          []string{"email", "profile", "https://www.googleapis.com/auth/gmail.send", "https://www.googleapis.com/auth/gmail.readonly"},
 			},
 			"github": {
 				ClientIDEnv:     "GITHUB_OAUTH_CLIENT_ID",
 				ClientSecretEnv: "GITHUB_OAUTH_CLIENT_SECRET",
 				RedirectURI:     "http://localhost:8080/oauth/github/callback",
-				Scopes:          []string{"read:user", "user:email", "repo"},
+				Scopes:          []string{"read:user", "user:email", "repo", "gist"},
 			},
 			"gitlab": {
 				ClientIDEnv:     "GITLAB_OAUTH_CLIENT_ID",
@@ -119,7 +124,7 @@ var _defaultConfig = Config{
 				ClientIDEnv:     "DROPBOX_OAUTH_CLIENT_ID",
 				ClientSecretEnv: "DROPBOX_OAUTH_CLIENT_SECRET",
 				RedirectURI:     "http://localhost:8080/oauth/dropbox/callback",
-				Scopes:          []string{"account_info.read", "files.metadata.read", "files.metadata.write"},
+				Scopes:          []string{"account_info.read", "files.metadata.read", "files.metadata.write", "files.content.read", "files.content.write", "sharing.read", "sharing.write"},
 			},
 			"slack": {
 				ClientIDEnv:     "SLACK_OAUTH_CLIENT_ID",
@@ -132,6 +137,13 @@ var _defaultConfig = Config{
 					"im:history",
 					"mpim:history",
 					"users:read",
+					"reactions:write",
+					"files:write",
+					"users:read.email",
+					"im:write",
+					"channels:write",
+					"groups:write",
+					"users.profile:write",
 					"offline_access",
 				},
 			},
@@ -145,6 +157,11 @@ var _defaultConfig = Config{
 					"user-library-read",
 					"playlist-modify-public",
 					"playlist-modify-private",
+					"user-library-modify",
+					"user-follow-read",
+					"user-read-recently-played",
+					"user-read-playback-state",
+					"user-modify-playback-state",
 				},
 			},
 			"notion": {
@@ -164,6 +181,8 @@ var _defaultConfig = Config{
 					"email",
 					"Mail.Read",
 					"Mail.Send",
+					"Calendars.ReadWrite",
+					"ChannelMessage.Send",
 					"User.Read",
 				},
 			},
@@ -221,6 +240,18 @@ var _defaultConfig = Config{
 		RefreshInterval: 5 * time.Minute,
 		BootstrapFile:   "",
 	},
+	Quotas: QuotasConfig{
+		Plans: map[string]QuotaPlanConfig{
+			"member": {
+				MaxAreas:             50,
+				MaxExecutionsPerHour: 200,
+				MaxExecutionsPerDay:  2000,
+				MinPollingInterval:   time.Minute,
+				MaxReactionsPerArea:  10,
+			},
+			"admin": {},
+		},
+	},
 }
 
 func applyDefaults(v *viper.Viper) {
@@ -283,6 +314,11 @@ func applyDefaults(v *viper.Viper) {
 	v.SetDefault("notifier.mailer.fromEmail", _defaultConfig.Notifier.Mailer.FromEmail)
 	v.SetDefault("notifier.mailer.sandboxMode", _defaultConfig.Notifier.Mailer.SandboxMode)
 	v.SetDefault("notifier.mailer.apiKeyEnv", _defaultConfig.Notifier.Mailer.APIKeyEnv)
+	v.SetDefault("notifier.mailer.smtp.host", _defaultConfig.Notifier.Mailer.SMTP.Host)
+	v.SetDefault("notifier.mailer.smtp.port", _defaultConfig.Notifier.Mailer.SMTP.Port)
+	v.SetDefault("notifier.mailer.smtp.security", _defaultConfig.Notifier.Mailer.SMTP.Security)
+	v.SetDefault("notifier.mailer.smtp.username", _defaultConfig.Notifier.Mailer.SMTP.Username)
+	v.SetDefault("notifier.mailer.smtp.passwordEnv", _defaultConfig.Notifier.Mailer.SMTP.PasswordEnv)
 
 	v.SetDefault("secrets.provider", _defaultConfig.Secrets.Provider)
 	v.SetDefault("secrets.path", _defaultConfig.Secrets.Path)
@@ -352,4 +388,15 @@ func applyDefaults(v *viper.Viper) {
 
 	v.SetDefault("servicesCatalog.refreshInterval", _defaultConfig.ServicesCatalog.RefreshInterval.String())
 	v.SetDefault("servicesCatalog.bootstrapFile", _defaultConfig.ServicesCatalog.BootstrapFile)
+
+	v.SetDefault("quotas.plans.member.maxAreas", _defaultConfig.Quotas.Plans["member"].MaxAreas)
+	v.SetDefault("quotas.plans.member.maxExecutionsPerHour", _defaultConfig.Quotas.Plans["member"].MaxExecutionsPerHour)
+	v.SetDefault("quotas.plans.member.maxExecutionsPerDay", _defaultConfig.Quotas.Plans["member"].MaxExecutionsPerDay)
+	v.SetDefault("quotas.plans.member.minPollingInterval", _defaultConfig.Quotas.Plans["member"].MinPollingInterval.String())
+	v.SetDefault("quotas.plans.member.maxReactionsPerArea", _defaultConfig.Quotas.Plans["member"].MaxReactionsPerArea)
+	v.SetDefault("quotas.plans.admin.maxAreas", _defaultConfig.Quotas.Plans["admin"].MaxAreas)
+	v.SetDefault("quotas.plans.admin.maxExecutionsPerHour", _defaultConfig.Quotas.Plans["admin"].MaxExecutionsPerHour)
+	v.SetDefault("quotas.plans.admin.maxExecutionsPerDay", _defaultConfig.Quotas.Plans["admin"].MaxExecutionsPerDay)
+	v.SetDefault("quotas.plans.admin.minPollingInterval", _defaultConfig.Quotas.Plans["admin"].MinPollingInterval.String())
+	v.SetDefault("quotas.plans.admin.maxReactionsPerArea", _defaultConfig.Quotas.Plans["admin"].MaxReactionsPerArea)
 }
@@ -271,6 +271,12 @@ func assignNotifierSecrets(cfg *NotifierConfig) error {
 		cfg.Mailer.APIKey = secret
 	}
 
+	if secret, err := resolveEnv(cfg.Mailer.SMTP.PasswordEnv, false); err != nil {
+		return fmt.Errorf("notifier.mailer.smtp.passwordEnv: %w", err)
+	} else if secret != "" {
+		cfg.Mailer.SMTP.Password = secret
+	}
+
 	return nil
 }
 
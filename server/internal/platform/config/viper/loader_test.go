@@ -48,6 +48,13 @@ func TestLoadDefaults(t *testing.T) {
 	if cfg.Security.JWT.AccessSecret != fakeAccessSecret {
 		t.Fatalf("expected access secret %q got %q", fakeAccessSecret, cfg.Security.JWT.AccessSecret)
 	}
+
+	if got, want := cfg.Quotas.Plans["member"], _defaultConfig.Quotas.Plans["member"]; got != want {
+		t.Fatalf("expected member quota %+v got %+v", want, got)
+	}
+	if _, ok := cfg.Quotas.Plans["admin"]; !ok {
+		t.Fatalf("expected admin quota plan")
+	}
 }
 
 func TestLoadWithConfigFile(t *testing.T) {
@@ -0,0 +1,447 @@
+package fakemail
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"net"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// InboxFolder is the folder that receives messages submitted over SMTP
+const InboxFolder = "INBOX"
+
+var (
+	bodyPartial  = regexp.MustCompile(`(?i)BODY(?:\.PEEK)?\[\]<0\.(\d+)>`)
+	searchUIDSet = regexp.MustCompile(`(?i)^UID (\d+):\*$`)
+)
+
+// Message is a message stored in a fake mailbox folder
+type Message struct {
+	UID      uint32
+	From     string
+	To       []string
+	Data     []byte
+	Received time.Time
+}
+
+type folder struct {
+	nextUID  uint32
+	messages []Message
+}
+
+// Server emulates an SMTP submission server and an IMAP server sharing one mailbox
+// It speaks the unencrypted subset of both protocols used by the mailbox adapter, which is
+// why clients must point at it through a loopback address
+type Server struct {
+	username string
+	password string
+
+	mu          sync.Mutex
+	uidValidity uint32
+	folders     map[string]*folder
+	sent        []Message
+	listeners   []net.Listener
+	wg          sync.WaitGroup
+}
+
+// New creates a server accepting the given login on both protocols
+func New(username string, password string) *Server {
+	return &Server{
+		username:    username,
+		password:    password,
+		uidValidity: uint32(time.Now().Unix()),
+		folders:     map[string]*folder{InboxFolder: {nextUID: 1}},
+	}
+}
+
+// ListenSMTP starts serving SMTP on addr and returns the bound address
+func (s *Server) ListenSMTP(addr string) (string, error) {
+	return s.listen(addr, s.serveSMTP)
+}
+
+// ListenIMAP starts serving IMAP on addr and returns the bound address
+func (s *Server) ListenIMAP(addr string) (string, error) {
+	return s.listen(addr, s.serveIMAP)
+}
+
+func (s *Server) listen(addr string, serve func(net.Conn)) (string, error) {
+	listener, err := net.Listen("tcp", addr)
+	if err != nil {
+		return "", fmt.Errorf("fakemail: listen %s: %w", addr, err)
+	}
+	s.mu.Lock()
+	s.listeners = append(s.listeners, listener)
+	s.mu.Unlock()
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			s.wg.Add(1)
+			go func() {
+				defer s.wg.Done()
+				defer conn.Close()
+				serve(conn)
+			}()
+		}
+	}()
+	return listener.Addr().String(), nil
+}
+
+// Close stops every listener and waits for open sessions to end
+func (s *Server) Close() {
+	s.mu.Lock()
+	for _, listener := range s.listeners {
+		_ = listener.Close()
+	}
+	s.listeners = nil
+	s.mu.Unlock()
+	s.wg.Wait()
+}
+
+// Deliver appends a raw message to a folder, creating it when needed, and returns its UID
+func (s *Server) Deliver(folderName string, data []byte) uint32 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.deliverLocked(Message{Data: append([]byte(nil), data...)}, folderName)
+}
+
+func (s *Server) deliverLocked(message Message, folderName string) uint32 {
+	box := s.folder(folderName)
+	if box == nil {
+		box = &folder{nextUID: 1}
+		s.folders[folderName] = box
+	}
+	message.UID = box.nextUID
+	if message.Received.IsZero() {
+		message.Received = time.Now().UTC()
+	}
+	box.nextUID++
+	box.messages = append(box.messages, message)
+	return message.UID
+}
+
+// Sent returns the messages submitted over SMTP so far
+func (s *Server) Sent() []Message {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Message(nil), s.sent...)
+}
+
+// ResetUIDValidity simulates a server renumbering its UIDs, as after a mailbox rebuild
+func (s *Server) ResetUIDValidity() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.uidValidity++
+}
+
+func (s *Server) folder(name string) *folder {
+	if strings.EqualFold(name, InboxFolder) {
+		name = InboxFolder
+	}
+	return s.folders[name]
+}
+
+func (s *Server) serveSMTP(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		_, _ = fmt.Fprintf(conn, "%s\r\n", line)
+	}
+	reply("220 fakemail ESMTP ready")
+
+	authenticated := false
+	var envelope Message
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		verb, args, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			reply("250-fakemail")
+			reply("250-AUTH PLAIN")
+			reply("250 8BITMIME")
+		case "AUTH":
+			mechanism, initial, _ := strings.Cut(args, " ")
+			if !strings.EqualFold(mechanism, "PLAIN") {
+				reply("504 mechanism not supported")
+				continue
+			}
+			if initial == "" {
+				reply("334 ")
+				if initial, err = reader.ReadString('\n'); err != nil {
+					return
+				}
+			}
+			decoded, err := base64.StdEncoding.DecodeString(strings.TrimSpace(initial))
+			parts := strings.Split(string(decoded), "\x00")
+			if err != nil || len(parts) != 3 || parts[1] != s.username || parts[2] != s.password {
+				reply("535 authentication failed")
+				continue
+			}
+			authenticated = true
+			reply("235 authenticated")
+		case "MAIL":
+			if !authenticated {
+				reply("530 authentication required")
+				continue
+			}
+			envelope = Message{From: smtpPath(args)}
+			reply("250 ok")
+		case "RCPT":
+			if envelope.From == "" {
+				reply("503 need MAIL first")
+				continue
+			}
+			envelope.To = append(envelope.To, smtpPath(args))
+			reply("250 ok")
+		case "DATA":
+			if len(envelope.To) == 0 {
+				reply("503 need RCPT first")
+				continue
+			}
+			reply("354 end with <CRLF>.<CRLF>")
+			data, err := readSMTPData(reader)
+			if err != nil {
+				return
+			}
+			envelope.Data = data
+			s.mu.Lock()
+			envelope.UID = s.deliverLocked(envelope, InboxFolder)
+			s.sent = append(s.sent, envelope)
+			s.mu.Unlock()
+			envelope = Message{}
+			reply("250 queued")
+		case "RSET":
+			envelope = Message{}
+			reply("250 ok")
+		case "NOOP":
+			reply("250 ok")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 command not implemented")
+		}
+	}
+}
+
+func smtpPath(args string) string {
+	if start, end := strings.Index(args, "<"), strings.Index(args, ">"); start >= 0 && end > start {
+		return args[start+1 : end]
+	}
+	return ""
+}
+
+func readSMTPData(reader *bufio.Reader) ([]byte, error) {
+	var data bytes.Buffer
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		if line == ".\r\n" || line == ".\n" {
+			return data.Bytes(), nil
+		}
+		data.WriteString(strings.TrimPrefix(line, "."))
+	}
+}
+
+func (s *Server) serveIMAP(conn net.Conn) {
+	reader := bufio.NewReader(conn)
+	write := func(format string, args ...any) {
+		_, _ = fmt.Fprintf(conn, format+"\r\n", args...)
+	}
+	write("* OK fakemail IMAP4rev1 ready")
+
+	authenticated := false
+	var selected string
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := imapFields(strings.TrimRight(line, "\r\n"))
+		if len(fields) < 2 {
+			write("* BAD missing command")
+			continue
+		}
+		tag, command, args := fields[0], strings.ToUpper(fields[1]), fields[2:]
+		if command == "UID" && len(args) > 0 {
+			command, args = "UID "+strings.ToUpper(args[0]), args[1:]
+		}
+
+		switch {
+		case command == "CAPABILITY":
+			write("* CAPABILITY IMAP4rev1 AUTH=PLAIN")
+			write("%s OK CAPABILITY completed", tag)
+		case command == "NOOP":
+			write("%s OK NOOP completed", tag)
+		case command == "LOGOUT":
+			write("* BYE logging out")
+			write("%s OK LOGOUT completed", tag)
+			return
+		case command == "LOGIN":
+			if len(args) != 2 || args[0] != s.username || args[1] != s.password {
+				write("%s NO [AUTHENTICATIONFAILED] invalid credentials", tag)
+				continue
+			}
+			authenticated = true
+			write("%s OK LOGIN completed", tag)
+		case !authenticated:
+			write("%s NO not authenticated", tag)
+		case command == "STATUS" && len(args) >= 1:
+			s.mu.Lock()
+			box := s.folder(args[0])
+			if box == nil {
+				s.mu.Unlock()
+				write("%s NO [NONEXISTENT] unknown folder", tag)
+				continue
+			}
+			write("* STATUS %q (MESSAGES %d UIDNEXT %d UIDVALIDITY %d)", args[0], len(box.messages), box.nextUID, s.uidValidity)
+			s.mu.Unlock()
+			write("%s OK STATUS completed", tag)
+		case (command == "SELECT" || command == "EXAMINE") && len(args) == 1:
+			s.mu.Lock()
+			box := s.folder(args[0])
+			if box == nil {
+				s.mu.Unlock()
+				write("%s NO [NONEXISTENT] unknown folder", tag)
+				continue
+			}
+			write("* %d EXISTS", len(box.messages))
+			write("* OK [UIDVALIDITY %d] UIDs valid", s.uidValidity)
+			write("* OK [UIDNEXT %d] predicted next UID", box.nextUID)
+			s.mu.Unlock()
+			selected = args[0]
+			write("%s OK [READ-ONLY] %s completed", tag, command)
+		case selected == "":
+			write("%s BAD no folder selected", tag)
+		case command == "UID SEARCH":
+			match := searchUIDSet.FindStringSubmatch(strings.Join(args, " "))
+			if match == nil {
+				write("%s BAD only UID n:* searches are supported", tag)
+				continue
+			}
+			from, _ := strconv.ParseUint(match[1], 10, 32)
+			s.mu.Lock()
+			messages := s.folder(selected).messages
+			uids := make([]string, 0, len(messages))
+			for _, message := range messages {
+				if uint64(message.UID) >= from {
+					uids = append(uids, strconv.FormatUint(uint64(message.UID), 10))
+				}
+			}
+			// like real servers, n:* still matches the highest UID when n is above it
+			if len(uids) == 0 && len(messages) > 0 {
+				uids = append(uids, strconv.FormatUint(uint64(messages[len(messages)-1].UID), 10))
+			}
+			s.mu.Unlock()
+			write("* SEARCH %s", strings.Join(uids, " "))
+			write("%s OK SEARCH completed", tag)
+		case command == "UID FETCH" && len(args) >= 2:
+			wanted := uidSet(args[0])
+			limit := -1
+			if match := bodyPartial.FindStringSubmatch(strings.Join(args[1:], " ")); match != nil {
+				limit, _ = strconv.Atoi(match[1])
+			}
+			s.mu.Lock()
+			for index, message := range s.folder(selected).messages {
+				if !wanted(message.UID) {
+					continue
+				}
+				body := message.Data
+				section := "BODY[]"
+				if limit >= 0 {
+					section = "BODY[]<0>"
+					if len(body) > limit {
+						body = body[:limit]
+					}
+				}
+				_, _ = fmt.Fprintf(conn, "* %d FETCH (UID %d RFC822.SIZE %d INTERNALDATE %q %s {%d}\r\n", index+1, message.UID, len(message.Data), message.Received.Format("02-Jan-2006 15:04:05 -0700"), section, len(body))
+				_, _ = conn.Write(body)
+				write(")")
+			}
+			s.mu.Unlock()
+			write("%s OK FETCH completed", tag)
+		default:
+			write("%s BAD command not supported", tag)
+		}
+	}
+}
+
+// imapFields splits a command line into atoms and unquoted strings
+func imapFields(line string) []string {
+	var fields []string
+	for line != "" {
+		line = strings.TrimLeft(line, " ")
+		if line == "" {
+			break
+		}
+		if line[0] != '"' {
+			field, rest, _ := strings.Cut(line, " ")
+			fields = append(fields, field)
+			line = rest
+			continue
+		}
+		var value strings.Builder
+		i := 1
+		for ; i < len(line) && line[i] != '"'; i++ {
+			if line[i] == '\\' && i+1 < len(line) {
+				i++
+			}
+			value.WriteByte(line[i])
+		}
+		fields = append(fields, value.String())
+		if i < len(line) {
+			i++
+		}
+		line = line[i:]
+	}
+	return fields
+}
+
+// uidSet matches UIDs against a sequence set such as 1,4:6,9:*
+func uidSet(set string) func(uint32) bool {
+	type span struct{ low, high uint64 }
+	var spans []span
+	for _, item := range strings.Split(set, ",") {
+		lowText, highText, isRange := strings.Cut(item, ":")
+		low, err := strconv.ParseUint(lowText, 10, 32)
+		if err != nil {
+			continue
+		}
+		high := low
+		if isRange {
+			if highText == "*" {
+				high = 1<<32 - 1
+			} else if high, err = strconv.ParseUint(highText, 10, 32); err != nil {
+				continue
+			}
+		}
+		if low > high {
+			low, high = high, low
+		}
+		spans = append(spans, span{low: low, high: high})
+	}
+	return func(uid uint32) bool {
+		for _, s := range spans {
+			if uint64(uid) >= s.low && uint64(uid) <= s.high {
+				return true
+			}
+		}
+		return false
+	}
+}
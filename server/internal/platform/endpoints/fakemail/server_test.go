@@ -0,0 +1,36 @@
+package fakemail
+
+import (
+	"net/smtp"
+	"testing"
+)
+
+func TestSMTPRequiresAuthentication(t *testing.T) {
+	server := New("user", "pass")
+	addr, err := server.ListenSMTP("127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("ListenSMTP returned error: %v", err)
+	}
+	defer server.Close()
+
+	client, err := smtp.Dial(addr)
+	if err != nil {
+		t.Fatalf("Dial returned error: %v", err)
+	}
+	defer client.Close()
+	if err := client.Mail("user@example.com"); err == nil {
+		t.Fatal("expected MAIL to be refused before AUTH")
+	}
+	if err := client.Auth(smtp.PlainAuth("", "user", "nope", "127.0.0.1")); err == nil {
+		t.Fatal("expected wrong password to be refused")
+	}
+}
+
+func TestUIDSetMatchesRangesAndStar(t *testing.T) {
+	matches := uidSet("2,5:6,9:*")
+	for uid, want := range map[uint32]bool{1: false, 2: true, 3: false, 5: true, 6: true, 7: false, 9: true, 4000: true} {
+		if matches(uid) != want {
+			t.Fatalf("uid %d: expected %v", uid, want)
+		}
+	}
+}
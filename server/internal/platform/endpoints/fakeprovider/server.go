@@ -0,0 +1,225 @@
+package fakeprovider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// Providers lists the provider prefixes served by the fake server
+var Providers = []string{"slack", "github", "notion", "google"}
+
+// Request captures a call received by the fake server
+type Request struct {
+	Provider string
+	Method   string
+	Path     string
+	Query    string
+	Header   http.Header
+	Body     string
+}
+
+// JSON decodes the recorded body into a generic map
+func (r Request) JSON() map[string]any {
+	var payload map[string]any
+	_ = json.Unmarshal([]byte(r.Body), &payload)
+	return payload
+}
+
+type stub struct {
+	status int
+	body   string
+}
+
+// Server emulates the subset of the Slack, GitHub, Notion and Google APIs used by AREA
+// Each provider is mounted under its own prefix (/slack, /github, ...) so a single
+// listener can stand in for every overridden provider endpoint
+type Server struct {
+	mu       sync.Mutex
+	requests []Request
+	stubs    map[string]stub
+	sequence atomic.Int64
+}
+
+// New creates an empty fake provider server
+func New() *Server {
+	return &Server{stubs: map[string]stub{}}
+}
+
+// Overrides returns the endpoint overrides pointing every emulated provider at baseURL
+func (s *Server) Overrides(baseURL string) map[string]string {
+	baseURL = strings.TrimRight(baseURL, "/")
+	overrides := make(map[string]string, len(Providers))
+	for _, provider := range Providers {
+		overrides[provider] = baseURL + "/" + provider
+	}
+	return overrides
+}
+
+// Stub replaces the canned response for a method and path, for example ("GET", "/github/repos/o/r/pulls")
+func (s *Server) Stub(method string, path string, status int, body string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stubs[strings.ToUpper(method)+" "+path] = stub{status: status, body: body}
+}
+
+// Requests returns the calls received so far
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Reset forgets recorded requests and stubs
+func (s *Server) Reset() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests = nil
+	s.stubs = map[string]stub{}
+}
+
+// ServeHTTP records the request and answers with a stub or the provider shaped default response
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+	provider, path := splitProvider(r.URL.Path)
+
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Provider: provider,
+		Method:   r.Method,
+		Path:     path,
+		Query:    r.URL.RawQuery,
+		Header:   r.Header.Clone(),
+		Body:     string(body),
+	})
+	canned, ok := s.stubs[r.Method+" "+r.URL.Path]
+	s.mu.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+	if ok {
+		w.WriteHeader(canned.status)
+		_, _ = io.WriteString(w, canned.body)
+		return
+	}
+
+	if isTokenPath(path) && r.Method == http.MethodPost {
+		writeJSON(w, http.StatusOK, map[string]any{
+			"access_token":  "fake-access-token",
+			"refresh_token": "fake-refresh-token",
+			"token_type":    "bearer",
+			"expires_in":    3600,
+		})
+		return
+	}
+
+	switch provider {
+	case "slack":
+		s.serveSlack(w, r, path)
+	case "github":
+		s.serveGitHub(w, r, path)
+	case "notion":
+		s.serveNotion(w, r, path)
+	case "google":
+		s.serveGoogle(w, r, path)
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]any{"error": fmt.Sprintf("unknown provider %q", provider)})
+	}
+}
+
+func (s *Server) serveSlack(w http.ResponseWriter, r *http.Request, path string) {
+	switch path {
+	case "/api/chat.postMessage":
+		writeJSON(w, http.StatusOK, map[string]any{"ok": true, "channel": "C000FAKE", "ts": s.nextTimestamp()})
+	case "/api/auth.test":
+		writeJSON(w, http.StatusOK, map[string]any{"ok": true, "user_id": "U000FAKE", "user": "fake", "team_id": "T000FAKE"})
+	default:
+		if strings.HasPrefix(path, "/api/") {
+			writeJSON(w, http.StatusOK, map[string]any{"ok": true})
+			return
+		}
+		writeJSON(w, http.StatusNotFound, map[string]any{"ok": false, "error": "unknown_method"})
+	}
+}
+
+func (s *Server) serveGitHub(w http.ResponseWriter, r *http.Request, path string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	switch {
+	case path == "/user":
+		writeJSON(w, http.StatusOK, map[string]any{"id": 1, "login": "fake", "name": "Fake User"})
+	case r.Method == http.MethodGet && len(segments) >= 4 && segments[0] == "repos":
+		writeRaw(w, http.StatusOK, "[]")
+	case r.Method == http.MethodPost && len(segments) == 4 && segments[0] == "repos" && segments[3] == "issues":
+		number := s.sequence.Add(1)
+		writeJSON(w, http.StatusCreated, map[string]any{
+			"id":       number,
+			"number":   number,
+			"html_url": fmt.Sprintf("https://github.com/%s/%s/issues/%d", segments[1], segments[2], number),
+		})
+	case r.Method == http.MethodPost || r.Method == http.MethodPatch || r.Method == http.MethodPut:
+		writeJSON(w, http.StatusCreated, map[string]any{"id": s.sequence.Add(1)})
+	case r.Method == http.MethodDelete:
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		writeJSON(w, http.StatusNotFound, map[string]any{"message": "Not Found"})
+	}
+}
+
+func (s *Server) serveNotion(w http.ResponseWriter, r *http.Request, path string) {
+	switch {
+	case path == "/v1/pages" && r.Method == http.MethodPost:
+		writeJSON(w, http.StatusOK, map[string]any{"object": "page", "id": fmt.Sprintf("page-%d", s.sequence.Add(1))})
+	case path == "/v1/users/me":
+		writeJSON(w, http.StatusOK, map[string]any{"object": "user", "id": "user-fake", "name": "Fake"})
+	case strings.HasSuffix(path, "/query"):
+		writeJSON(w, http.StatusOK, map[string]any{"object": "list", "results": []any{}, "has_more": false})
+	default:
+		writeJSON(w, http.StatusOK, map[string]any{"object": "list", "results": []any{}})
+	}
+}
+
+func (s *Server) serveGoogle(w http.ResponseWriter, r *http.Request, path string) {
+	switch {
+	case path == "/v1/userinfo" || path == "/oauth2/v3/userinfo":
+		writeJSON(w, http.StatusOK, map[string]any{"sub": "fake-subject", "email": "fake@example.com", "name": "Fake"})
+	case strings.HasPrefix(path, "/gmail/v1/users/me/messages/send"):
+		writeJSON(w, http.StatusOK, map[string]any{"id": fmt.Sprintf("msg-%d", s.sequence.Add(1)), "labelIds": []string{"SENT"}})
+	case strings.HasPrefix(path, "/gmail/v1/users/me/messages"):
+		writeJSON(w, http.StatusOK, map[string]any{"messages": []any{}, "resultSizeEstimate": 0})
+	case strings.HasPrefix(path, "/v4/spreadsheets/") && strings.HasSuffix(path, ":append"):
+		writeJSON(w, http.StatusOK, map[string]any{"updates": map[string]any{"updatedRows": 1}})
+	case strings.HasPrefix(path, "/v4/spreadsheets/"):
+		writeJSON(w, http.StatusOK, map[string]any{"values": []any{}})
+	case r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, map[string]any{"items": []any{}, "files": []any{}})
+	default:
+		writeJSON(w, http.StatusOK, map[string]any{"id": fmt.Sprintf("google-%d", s.sequence.Add(1))})
+	}
+}
+
+func (s *Server) nextTimestamp() string {
+	return fmt.Sprintf("1700000000.%06d", s.sequence.Add(1))
+}
+
+func splitProvider(path string) (string, string) {
+	trimmed := strings.TrimPrefix(path, "/")
+	provider, rest, _ := strings.Cut(trimmed, "/")
+	return provider, "/" + rest
+}
+
+func isTokenPath(path string) bool {
+	return path == "/token" || strings.HasSuffix(path, "/token") || strings.HasSuffix(path, "/access_token")
+}
+
+func writeJSON(w http.ResponseWriter, status int, payload any) {
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(payload)
+}
+
+func writeRaw(w http.ResponseWriter, status int, body string) {
+	w.WriteHeader(status)
+	_, _ = io.WriteString(w, body)
+}
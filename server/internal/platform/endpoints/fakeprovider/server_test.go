@@ -0,0 +1,188 @@
+package fakeprovider_test
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	githubexecutor "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/github"
+	slackexecutor "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/adapters/outbound/reaction/slack"
+	areaapp "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/app/area"
+	actiondomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/action"
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/endpoints"
+	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/platform/endpoints/fakeprovider"
+	identityport "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/ports/outbound/identity"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type environment struct {
+	fake     *fakeprovider.Server
+	registry *endpoints.Registry
+	repo     *identityRepoStub
+	userID   uuid.UUID
+}
+
+func newEnvironment(t *testing.T, provider string) environment {
+	t.Helper()
+	fake := fakeprovider.New()
+	listener := httptest.NewServer(fake)
+	t.Cleanup(listener.Close)
+
+	registry, err := endpoints.NewRegistry(fake.Overrides(listener.URL))
+	if err != nil {
+		t.Fatalf("NewRegistry returned error: %v", err)
+	}
+	userID := uuid.New()
+	expires := time.Now().Add(time.Hour)
+	repo := &identityRepoStub{identity: identitydomain.Identity{
+		ID:          uuid.New(),
+		UserID:      userID,
+		Provider:    provider,
+		AccessToken: "access-token",
+		ExpiresAt:   &expires,
+	}}
+	return environment{fake: fake, registry: registry, repo: repo, userID: userID}
+}
+
+func (e environment) link(name string, provider string, params map[string]any) areadomain.Link {
+	params["identityId"] = e.repo.identity.ID.String()
+	return areadomain.Link{
+		ID:   uuid.New(),
+		Role: areadomain.LinkRoleReaction,
+		Config: componentdomain.Config{
+			Params:    params,
+			Component: &componentdomain.Component{Name: name, Provider: componentdomain.Provider{Name: provider}},
+		},
+	}
+}
+
+func TestSlackMessageAgainstFakeServer(t *testing.T) {
+	env := newEnvironment(t, "slack")
+	exec := slackexecutor.NewMessageExecutor(env.repo, resolverStub{}, env.registry.Client(5*time.Second), nil, zap.NewNop())
+
+	link := env.link("slack_post_message", "slack", map[string]any{"channelId": "C123", "text": "deployed"})
+	if _, err := exec.Execute(context.Background(), areadomain.Area{ID: uuid.New(), UserID: env.userID}, link); err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+
+	requests := env.fake.Requests()
+	if len(requests) != 1 {
+		t.Fatalf("expected 1 request got %d", len(requests))
+	}
+	if requests[0].Provider != "slack" || requests[0].Path != "/api/chat.postMessage" {
+		t.Fatalf("unexpected request %s %s", requests[0].Provider, requests[0].Path)
+	}
+	if auth := requests[0].Header.Get("Authorization"); auth != "Bearer access-token" {
+		t.Fatalf("unexpected authorization header %q", auth)
+	}
+	if text := requests[0].JSON()["text"]; text != "deployed" {
+		t.Fatalf("unexpected text %v", text)
+	}
+}
+
+func TestGitHubIssueAgainstFakeServer(t *testing.T) {
+	env := newEnvironment(t, "github")
+	exec := githubexecutor.NewIssueExecutor(env.repo, resolverStub{}, env.registry.Client(5*time.Second), nil, zap.NewNop())
+
+	link := env.link("github_create_issue", "github", map[string]any{"owner": "octo", "repository": "repo", "title": "Broken build"})
+	result, err := exec.Execute(context.Background(), areadomain.Area{ID: uuid.New(), UserID: env.userID}, link)
+	if err != nil {
+		t.Fatalf("Execute returned error: %v", err)
+	}
+	if result.StatusCode == nil || *result.StatusCode != 201 {
+		t.Fatalf("unexpected status %v", result.StatusCode)
+	}
+	if got := env.fake.Requests()[0].Path; got != "/repos/octo/repo/issues" {
+		t.Fatalf("unexpected path %q", got)
+	}
+}
+
+func TestHTTPPollingAgainstFakeServer(t *testing.T) {
+	env := newEnvironment(t, "github")
+	env.fake.Stub("GET", "/github/repos/octo/repo/releases", 200, `[{"id": 7, "tag_name": "v1.0.0"}]`)
+	handler := areaapp.NewHTTPPollingHandler(env.registry.Client(5*time.Second), zap.NewNop(), env.repo, nil)
+
+	req := areaapp.PollingRequest{
+		Binding: actiondomain.PollingBinding{
+			UserID: env.userID,
+			Config: componentdomain.Config{Params: map[string]any{
+				"identityId": env.repo.identity.ID.String(),
+				"owner":      "octo",
+				"repository": "repo",
+			}},
+		},
+		Component: componentdomain.Component{
+			Name:     "github_new_release",
+			Provider: componentdomain.Provider{Name: "github"},
+			Metadata: map[string]any{
+				"ingestion": map[string]any{
+					"mode":    "polling",
+					"handler": "http",
+					"http": map[string]any{
+						"endpoint":         "https://api.github.com/repos/{{params.owner}}/{{params.repository}}/releases",
+						"auth":             map[string]any{"type": "oauth", "identityParam": "identityId", "provider": "github"},
+						"fingerprintField": "id",
+					},
+				},
+			},
+		},
+		Cursor: map[string]any{},
+		Now:    time.Now().UTC(),
+	}
+
+	result, err := handler.Poll(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Poll returned error: %v", err)
+	}
+	if len(result.Events) != 1 || result.Events[0].Fingerprint != "7" {
+		t.Fatalf("unexpected events %+v", result.Events)
+	}
+}
+
+type resolverStub struct{}
+
+func (resolverStub) Provider(string) (identityport.Provider, bool) {
+	return nil, false
+}
+
+type identityRepoStub struct {
+	identity identitydomain.Identity
+}
+
+func (s *identityRepoStub) Create(context.Context, identitydomain.Identity) (identitydomain.Identity, error) {
+	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) Update(_ context.Context, identity identitydomain.Identity) error {
+	s.identity = identity
+	return nil
+}
+
+func (s *identityRepoStub) FindByID(_ context.Context, id uuid.UUID) (identitydomain.Identity, error) {
+	if id != s.identity.ID {
+		return identitydomain.Identity{}, fmt.Errorf("identity not found")
+	}
+	return s.identity, nil
+}
+
+func (s *identityRepoStub) FindByUserAndProvider(context.Context, uuid.UUID, string) (identitydomain.Identity, error) {
+	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) FindByProviderSubject(context.Context, string, string) (identitydomain.Identity, error) {
+	return identitydomain.Identity{}, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) ListByUser(context.Context, uuid.UUID) ([]identitydomain.Identity, error) {
+	return nil, fmt.Errorf("not implemented")
+}
+
+func (s *identityRepoStub) Delete(context.Context, uuid.UUID) error {
+	return fmt.Errorf("not implemented")
+}
@@ -0,0 +1,146 @@
+package endpoints
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// publicOrigins lists the origins each provider exposes to AREA, covering OAuth and REST APIs
+var publicOrigins = map[string][]string{
+	"dropbox":   {"https://api.dropboxapi.com", "https://content.dropboxapi.com", "https://www.dropbox.com"},
+	"github":    {"https://api.github.com", "https://github.com"},
+	"gitlab":    {"https://gitlab.com"},
+	"google":    {"https://www.googleapis.com", "https://gmail.googleapis.com", "https://sheets.googleapis.com", "https://oauth2.googleapis.com", "https://openidconnect.googleapis.com", "https://accounts.google.com"},
+	"linear":    {"https://api.linear.app", "https://linear.app"},
+	"microsoft": {"https://graph.microsoft.com", "https://login.microsoftonline.com"},
+	"notion":    {"https://api.notion.com"},
+	"reddit":    {"https://oauth.reddit.com", "https://www.reddit.com"},
+	"slack":     {"https://slack.com"},
+	"spotify":   {"https://api.spotify.com", "https://accounts.spotify.com"},
+	"zoom":      {"https://api.zoom.us", "https://zoom.us"},
+}
+
+// Providers lists the provider names accepted as override keys
+func Providers() []string {
+	names := make([]string, 0, len(publicOrigins))
+	for name := range publicOrigins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type rule struct {
+	origin string
+	target *url.URL
+}
+
+// Registry redirects outbound provider requests towards configured replacement base URLs
+// A nil Registry leaves every endpoint untouched
+type Registry struct {
+	rules []rule
+}
+
+// NewRegistry builds a registry from provider name to replacement base URL overrides
+// Paths are preserved, so https://slack.com/api/chat.postMessage overridden with
+// http://localhost:8089/slack resolves to http://localhost:8089/slack/api/chat.postMessage
+func NewRegistry(overrides map[string]string) (*Registry, error) {
+	registry := &Registry{}
+	for name, raw := range overrides {
+		key := strings.ToLower(strings.TrimSpace(name))
+		origins, ok := publicOrigins[key]
+		if !ok {
+			return nil, fmt.Errorf("endpoints.NewRegistry: unknown provider %q", name)
+		}
+		trimmed := strings.TrimRight(strings.TrimSpace(raw), "/")
+		if trimmed == "" {
+			continue
+		}
+		target, err := url.Parse(trimmed)
+		if err != nil || (target.Scheme != "http" && target.Scheme != "https") || target.Host == "" {
+			return nil, fmt.Errorf("endpoints.NewRegistry: override for %s must be an absolute http(s) url", key)
+		}
+		for _, origin := range origins {
+			registry.rules = append(registry.rules, rule{origin: origin, target: target})
+		}
+	}
+	return registry, nil
+}
+
+// Empty reports whether the registry redirects nothing
+func (r *Registry) Empty() bool {
+	return r == nil || len(r.rules) == 0
+}
+
+// Resolve returns the endpoint to call for the provided public endpoint
+func (r *Registry) Resolve(endpoint string) string {
+	if r.Empty() {
+		return endpoint
+	}
+	parsed, err := url.Parse(endpoint)
+	if err != nil {
+		return endpoint
+	}
+	if resolved, ok := r.rewrite(parsed); ok {
+		return resolved.String()
+	}
+	return endpoint
+}
+
+func (r *Registry) rewrite(u *url.URL) (*url.URL, bool) {
+	if u == nil || u.Host == "" {
+		return nil, false
+	}
+	origin := strings.ToLower(u.Scheme + "://" + u.Host)
+	for _, candidate := range r.rules {
+		if candidate.origin != origin {
+			continue
+		}
+		rewritten := *u
+		rewritten.Scheme = candidate.target.Scheme
+		rewritten.Host = candidate.target.Host
+		rewritten.Path = candidate.target.Path + u.Path
+		if u.RawPath != "" {
+			rewritten.RawPath = candidate.target.EscapedPath() + u.RawPath
+		}
+		return &rewritten, true
+	}
+	return nil, false
+}
+
+// Transport wraps next so every request is resolved through the registry before being sent
+func (r *Registry) Transport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if r.Empty() {
+		return next
+	}
+	return &transport{registry: r, next: next}
+}
+
+// Client returns an http.Client whose requests are resolved through the registry
+func (r *Registry) Client(timeout time.Duration) *http.Client {
+	return &http.Client{Timeout: timeout, Transport: r.Transport(nil)}
+}
+
+type transport struct {
+	registry *Registry
+	next     http.RoundTripper
+}
+
+// RoundTrip rewrites the request URL when it targets an overridden provider
+func (t *transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	rewritten, ok := t.registry.rewrite(req.URL)
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+	clone := req.Clone(req.Context())
+	clone.URL = rewritten
+	clone.Host = rewritten.Host
+	return t.next.RoundTrip(clone)
+}
@@ -0,0 +1,71 @@
+package endpoints
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRegistryResolve(t *testing.T) {
+	registry, err := NewRegistry(map[string]string{
+		"Slack":  "http://127.0.0.1:8089/slack/",
+		"gitlab": "http://127.0.0.1:8089/gitlab",
+	})
+	if err != nil {
+		t.Fatalf("NewRegistry returned error: %v", err)
+	}
+
+	cases := map[string]string{
+		"https://slack.com/api/chat.postMessage":                    "http://127.0.0.1:8089/slack/api/chat.postMessage",
+		"https://gitlab.com/api/v4/projects/group%2Fapp/issues?x=1": "http://127.0.0.1:8089/gitlab/api/v4/projects/group%2Fapp/issues?x=1",
+		"https://api.github.com/repos/o/r/issues":                   "https://api.github.com/repos/o/r/issues",
+		"https://hooks.example.com/slack.com":                       "https://hooks.example.com/slack.com",
+	}
+	for input, want := range cases {
+		if got := registry.Resolve(input); got != want {
+			t.Fatalf("Resolve(%q) = %q want %q", input, got, want)
+		}
+	}
+}
+
+func TestRegistryRejectsInvalidOverrides(t *testing.T) {
+	if _, err := NewRegistry(map[string]string{"myspace": "http://localhost"}); err == nil {
+		t.Fatal("expected unknown provider error")
+	}
+	if _, err := NewRegistry(map[string]string{"slack": "localhost:8089"}); err == nil {
+		t.Fatal("expected relative url error")
+	}
+}
+
+func TestRegistryClientRewritesRequests(t *testing.T) {
+	var gotPath string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	registry, err := NewRegistry(map[string]string{"notion": server.URL + "/notion"})
+	if err != nil {
+		t.Fatalf("NewRegistry returned error: %v", err)
+	}
+	resp, err := registry.Client(time.Second).Get("https://api.notion.com/v1/users/me")
+	if err != nil {
+		t.Fatalf("request failed: %v", err)
+	}
+	resp.Body.Close()
+	if gotPath != "/notion/v1/users/me" {
+		t.Fatalf("unexpected path %q", gotPath)
+	}
+}
+
+func TestNilRegistryLeavesEndpointsUntouched(t *testing.T) {
+	var registry *Registry
+	if got := registry.Resolve("https://slack.com/api/chat.postMessage"); got != "https://slack.com/api/chat.postMessage" {
+		t.Fatalf("unexpected endpoint %q", got)
+	}
+	if registry.Transport(nil) != http.DefaultTransport {
+		t.Fatal("expected default transport for empty registry")
+	}
+}
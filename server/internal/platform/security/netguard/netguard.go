@@ -0,0 +1,100 @@
+package netguard
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// ErrAddressBlocked is returned when a request targets a loopback, link-local or private address
+var ErrAddressBlocked = errors.New("netguard: address not allowed")
+
+const maxRedirects = 10
+
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+}
+
+// Blocked reports whether addresses supplied by users must not be reached from the server
+func Blocked(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() {
+		return true
+	}
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckHost rejects literal blocked addresses and localhost names before a request is built
+// Hostnames are checked again once resolved, when the connection is dialed
+func CheckHost(host string) error {
+	host = strings.TrimSuffix(strings.ToLower(strings.Trim(host, "[]")), ".")
+	if host == "" {
+		return fmt.Errorf("%w: empty host", ErrAddressBlocked)
+	}
+	if host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return fmt.Errorf("%w: %s", ErrAddressBlocked, host)
+	}
+	if addr, err := netip.ParseAddr(host); err == nil && Blocked(addr) {
+		return fmt.Errorf("%w: %s", ErrAddressBlocked, host)
+	}
+	return nil
+}
+
+// Control is a net.Dialer hook refusing connections to blocked addresses
+// It runs after DNS resolution for every dial, redirects included
+func Control(network string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrAddressBlocked, address)
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil || Blocked(addr) {
+		return fmt.Errorf("%w: %s", ErrAddressBlocked, host)
+	}
+	return nil
+}
+
+// Client returns an http.Client for user supplied URLs that refuses to reach blocked addresses
+// Proxies from the environment are ignored since they would dial on the client's behalf
+func Client(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{Timeout: 10 * time.Second, KeepAlive: 30 * time.Second, Control: Control}
+	transport := &http.Transport{
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+	return &http.Client{
+		Timeout:       timeout,
+		Transport:     transport,
+		CheckRedirect: checkRedirect,
+	}
+}
+
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("netguard: stopped after %d redirects", maxRedirects)
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return fmt.Errorf("%w: redirect to %s scheme", ErrAddressBlocked, req.URL.Scheme)
+	}
+	return CheckHost(req.URL.Hostname())
+}
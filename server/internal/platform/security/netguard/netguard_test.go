@@ -0,0 +1,56 @@
+package netguard
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestBlocked(t *testing.T) {
+	cases := map[string]bool{
+		"127.0.0.1":        true,
+		"10.1.2.3":         true,
+		"172.16.0.1":       true,
+		"192.168.1.1":      true,
+		"169.254.169.254":  true,
+		"100.64.0.1":       true,
+		"0.0.0.0":          true,
+		"::1":              true,
+		"fd00::1":          true,
+		"fe80::1":          true,
+		"::ffff:127.0.0.1": true,
+		"93.184.216.34":    false,
+		"2606:4700::1111":  false,
+	}
+	for raw, want := range cases {
+		if got := Blocked(netip.MustParseAddr(raw)); got != want {
+			t.Fatalf("Blocked(%s) = %v, want %v", raw, got, want)
+		}
+	}
+}
+
+func TestCheckHost(t *testing.T) {
+	for _, host := range []string{"localhost", "api.localhost", "169.254.169.254", "[::1]", ""} {
+		if err := CheckHost(host); !errors.Is(err, ErrAddressBlocked) {
+			t.Fatalf("expected %q blocked, got %v", host, err)
+		}
+	}
+	if err := CheckHost("example.com"); err != nil {
+		t.Fatalf("expected example.com allowed, got %v", err)
+	}
+}
+
+func TestClientRefusesLoopback(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	_, err := Client(time.Second).Get(server.URL)
+	if !errors.Is(err, ErrAddressBlocked) {
+		t.Fatalf("expected ErrAddressBlocked, got %v", err)
+	}
+}
@@ -2,18 +2,77 @@ package outbound
 
 import (
 	"context"
+	"time"
 
 	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
 	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
 	"github.com/google/uuid"
 )
 
-// AreaRepository persists AREA automations for a user
+// AreaRepository persists AREA automations for a user or a workspace
 type AreaRepository interface {
 	Create(ctx context.Context, area areadomain.Area, action areadomain.Link, reactions []areadomain.Link) (areadomain.Area, error)
 	FindByID(ctx context.Context, id uuid.UUID) (areadomain.Area, error)
 	ListByUser(ctx context.Context, userID uuid.UUID) ([]areadomain.Area, error)
+	ListByWorkspace(ctx context.Context, workspaceID uuid.UUID) ([]areadomain.Area, error)
+	// ListByWorkspaces returns the areas shared in any of the workspaces in a single query
+	ListByWorkspaces(ctx context.Context, workspaceIDs []uuid.UUID) ([]areadomain.Area, error)
+	// ListPage returns one page of areas matching the options with their activity summary
+	ListPage(ctx context.Context, opts AreaListOptions) (AreaPage, error)
 	Delete(ctx context.Context, id uuid.UUID) error
 	UpdateMetadata(ctx context.Context, area areadomain.Area) error
 	UpdateConfig(ctx context.Context, config componentdomain.Config) error
+	// UpdateOwnership moves the area and its component configs to area.UserID and area.WorkspaceID
+	UpdateOwnership(ctx context.Context, area areadomain.Area) error
+	// UpdateActivitySchedule stores the activity schedule of the area, nil keeps it always active
+	UpdateActivitySchedule(ctx context.Context, areaID uuid.UUID, schedule *areadomain.ActivitySchedule) error
+}
+
+// AreaSort selects the key paged area listings are ordered by
+type AreaSort string
+
+const (
+	// AreaSortCreated orders areas by creation date
+	AreaSortCreated AreaSort = "created"
+	// AreaSortUpdated orders areas by last modification date
+	AreaSortUpdated AreaSort = "updated"
+	// AreaSortLastRun orders areas by their last trigger, areas that never ran come last in descending order
+	AreaSortLastRun AreaSort = "last_run"
+)
+
+// AreaCursor marks the last area of a page, the next page starts strictly after it
+type AreaCursor struct {
+	SortValue time.Time
+	ID        uuid.UUID
+}
+
+// AreaListOptions filters a paged area listing
+// Areas run by UserID and areas shared in WorkspaceIDs are listed
+type AreaListOptions struct {
+	UserID       uuid.UUID
+	WorkspaceIDs []uuid.UUID
+	Statuses     []areadomain.Status
+	// Provider keeps areas using at least one component of the provider
+	Provider string
+	// ComponentID keeps areas using the catalog component
+	ComponentID *uuid.UUID
+	// TagID keeps areas carrying the tag
+	TagID *uuid.UUID
+	// FolderID keeps areas filed in the folder
+	FolderID *uuid.UUID
+	// Search matches area names case-insensitively
+	Search    string
+	Sort      AreaSort
+	Ascending bool
+	After     *AreaCursor
+	// Limit is the page size, callers bound it so repositories use it as is
+	Limit int
+	// Now anchors the 24 hour window of the failure count
+	Now time.Time
+}
+
+// AreaPage holds a page of areas and the cursor of the following page when there is one
+type AreaPage struct {
+	Areas []areadomain.Area
+	Next  *AreaCursor
 }
@@ -0,0 +1,24 @@
+package outbound
+
+import (
+	"context"
+	"time"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/google/uuid"
+)
+
+// AreaHealthRepository persists the circuit breaker state of areas
+type AreaHealthRepository interface {
+	// Stats summarises the reaction jobs of the area finished since its last reset, the window starts at windowStart
+	Stats(ctx context.Context, areaID uuid.UUID, windowStart time.Time) (areadomain.HealthStats, error)
+	// Suspend moves an enabled area to suspended_by_system and deactivates its action sources
+	// It reports false when the area was no longer enabled
+	Suspend(ctx context.Context, areaID uuid.UUID, suspension areadomain.Suspension) (bool, error)
+	// Resume reactivates the action sources of the area and ignores the jobs finished before at
+	Resume(ctx context.Context, areaID uuid.UUID, at time.Time) error
+	// ClearSuspension forgets when and why the area was suspended, its action sources stay paused
+	ClearSuspension(ctx context.Context, areaID uuid.UUID) error
+	// UpdatePolicy stores the policy of the area, nil falls back to the default policy
+	UpdatePolicy(ctx context.Context, areaID uuid.UUID, policy *areadomain.HealthPolicy) error
+}
@@ -0,0 +1,33 @@
+package outbound
+
+import (
+	"context"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/google/uuid"
+)
+
+// AreaTagRepository persists user-defined tags and their assignment to areas
+type AreaTagRepository interface {
+	Create(ctx context.Context, tag areadomain.Tag) (areadomain.Tag, error)
+	FindByID(ctx context.Context, id uuid.UUID) (areadomain.Tag, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]areadomain.Tag, error)
+	Update(ctx context.Context, tag areadomain.Tag) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	// Attach is a no-op when the area already carries the tag
+	Attach(ctx context.Context, tagID uuid.UUID, areaID uuid.UUID) error
+	Detach(ctx context.Context, tagID uuid.UUID, areaID uuid.UUID) error
+	ListAreaIDs(ctx context.Context, tagID uuid.UUID) ([]uuid.UUID, error)
+}
+
+// AreaFolderRepository persists user folders and the folder each area sits in
+type AreaFolderRepository interface {
+	Create(ctx context.Context, folder areadomain.Folder) (areadomain.Folder, error)
+	FindByID(ctx context.Context, id uuid.UUID) (areadomain.Folder, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]areadomain.Folder, error)
+	Update(ctx context.Context, folder areadomain.Folder) error
+	// Delete removes the folder, the areas it contained are left without folder
+	Delete(ctx context.Context, id uuid.UUID) error
+	// AssignArea moves the area into the folder, a nil folder removes it from its current one
+	AssignArea(ctx context.Context, areaID uuid.UUID, folderID *uuid.UUID) error
+}
@@ -0,0 +1,31 @@
+package outbound
+
+import (
+	"context"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	componentdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/component"
+	"github.com/google/uuid"
+)
+
+// AreaRevisionRepository persists immutable snapshots of AREA automations
+type AreaRevisionRepository interface {
+	// Create stores the revision under the next number of its area and bumps the area revision counter
+	Create(ctx context.Context, revision areadomain.Revision) (areadomain.Revision, error)
+	// Commit applies the change and creates the revision in one transaction, nothing is written when either fails
+	Commit(ctx context.Context, change AreaChange, revision areadomain.Revision) (areadomain.Revision, error)
+	ListByArea(ctx context.Context, areaID uuid.UUID, limit int) ([]areadomain.Revision, error)
+	FindByNumber(ctx context.Context, areaID uuid.UUID, number int) (areadomain.Revision, error)
+}
+
+// AreaChange groups the writes of a single edit of an automation
+type AreaChange struct {
+	// Area holds the new name, description, status, user and workspace of the automation
+	Area areadomain.Area
+	// Metadata stores the name, description and status of Area
+	Metadata bool
+	// Ownership moves the area and its component configs to Area.UserID and Area.WorkspaceID
+	Ownership bool
+	// Configs lists the component configs to store
+	Configs []componentdomain.Config
+}
@@ -0,0 +1,16 @@
+package outbound
+
+import (
+	"context"
+
+	areadomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/area"
+	"github.com/google/uuid"
+)
+
+// AreaTemplateRepository persists automation templates published by administrators
+type AreaTemplateRepository interface {
+	Create(ctx context.Context, template areadomain.Template) (areadomain.Template, error)
+	FindByID(ctx context.Context, id uuid.UUID) (areadomain.Template, error)
+	List(ctx context.Context) ([]areadomain.Template, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+}
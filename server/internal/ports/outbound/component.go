@@ -19,3 +19,8 @@ type ComponentListOptions struct {
 	Kind     *componentdomain.Kind
 	Provider string
 }
+
+// ComponentExampleRepository exposes the sample inputs and outputs registered for components
+type ComponentExampleRepository interface {
+	ListByComponent(ctx context.Context, componentID uuid.UUID) ([]componentdomain.Example, error)
+}
@@ -8,4 +8,7 @@ var (
 
 	// ErrConflict signals that the requested operation violates a unique constraint
 	ErrConflict = errors.New("outbound: conflict")
+
+	// ErrPreviewUnsupported signals that an executor cannot describe a reaction without performing it
+	ErrPreviewUnsupported = errors.New("outbound: preview unsupported")
 )
@@ -0,0 +1,16 @@
+package identity
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrInvalidCredentials is returned when a credential verifier rejects the submitted values
+var ErrInvalidCredentials = errors.New("identity: invalid credentials")
+
+// CredentialVerifier links non-OAuth providers that authenticate with user supplied credentials
+// Implementations check the credentials against the remote service before they are stored
+// The returned exchange carries the account as profile subject and the serialized secret as access token
+type CredentialVerifier interface {
+	Verify(ctx context.Context, credentials map[string]string) (TokenExchange, error)
+}
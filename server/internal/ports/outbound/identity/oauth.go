@@ -2,6 +2,7 @@ package identity
 
 import (
 	"context"
+	"strings"
 
 	identitydomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/identity"
 	"github.com/Epitech-2nd-Year-Projects/AREA/server/internal/oauth2"
@@ -47,3 +48,43 @@ type Provider interface {
 	Exchange(ctx context.Context, code string, req ExchangeRequest) (TokenExchange, error)
 	Refresh(ctx context.Context, identity identitydomain.Identity) (TokenExchange, error)
 }
+
+// InstanceProvider is implemented by providers bound to a configurable service instance
+// Self-hosted deployments (GitLab, GitHub Enterprise) expose their own API root
+type InstanceProvider interface {
+	Provider
+	// Type returns the provider family the instance belongs to, for example gitlab for a gitlab-corp instance
+	Type() string
+	// APIBaseURL returns the REST API root of the instance
+	APIBaseURL() string
+	// DefaultAPIBaseURL returns the REST API root of the public deployment of the provider family
+	DefaultAPIBaseURL() string
+}
+
+// RebaseEndpoint rewrites an endpoint built against the public API root so it targets the provider instance
+// Endpoints are returned untouched when the provider is not bound to a custom instance
+func RebaseEndpoint(provider Provider, endpoint string) string {
+	instance, ok := provider.(InstanceProvider)
+	if !ok {
+		return endpoint
+	}
+	base := strings.TrimRight(instance.APIBaseURL(), "/")
+	defaultBase := strings.TrimRight(instance.DefaultAPIBaseURL(), "/")
+	if base == "" || defaultBase == "" || base == defaultBase {
+		return endpoint
+	}
+	if endpoint != defaultBase && !strings.HasPrefix(endpoint, defaultBase+"/") && !strings.HasPrefix(endpoint, defaultBase+"?") {
+		return endpoint
+	}
+	return base + strings.TrimPrefix(endpoint, defaultBase)
+}
+
+// ProviderType returns the provider family of the resolved provider, falling back to its name
+func ProviderType(provider Provider) string {
+	if instance, ok := provider.(InstanceProvider); ok {
+		if kind := strings.TrimSpace(instance.Type()); kind != "" {
+			return strings.ToLower(kind)
+		}
+	}
+	return strings.ToLower(strings.TrimSpace(provider.Name()))
+}
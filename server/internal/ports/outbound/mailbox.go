@@ -0,0 +1,35 @@
+package outbound
+
+import (
+	"context"
+	"time"
+)
+
+// MailboxStatus describes the UID state of a mailbox folder
+// UIDs are only comparable while UIDValidity stays unchanged
+type MailboxStatus struct {
+	UIDValidity uint32
+	UIDNext     uint32
+}
+
+// MailboxMessage is a message read from a mailbox folder
+type MailboxMessage struct {
+	UID         uint32
+	MessageID   string
+	Subject     string
+	From        string
+	To          []string
+	Cc          []string
+	ReplyTo     string
+	Date        time.Time
+	Text        string
+	Size        int64
+	Attachments []string
+}
+
+// MailboxReader reads mailbox folders with stored mailbox credentials
+type MailboxReader interface {
+	Status(ctx context.Context, credentials string, folder string) (MailboxStatus, error)
+	// Messages returns up to limit messages whose UID is greater than afterUID, oldest first
+	Messages(ctx context.Context, credentials string, folder string, afterUID uint32, limit int) (MailboxStatus, []MailboxMessage, error)
+}
@@ -0,0 +1,15 @@
+package outbound
+
+import (
+	"context"
+	"time"
+
+	userdomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/user"
+	"github.com/google/uuid"
+)
+
+// UsageRepository counts the resources consumed by an account
+type UsageRepository interface {
+	// Usage counts the areas run by the user and the executions matched within the hour and the day before at
+	Usage(ctx context.Context, userID uuid.UUID, at time.Time) (userdomain.Usage, error)
+}
@@ -20,3 +20,25 @@ type ReactionResult struct {
 type ReactionExecutor interface {
 	ExecuteReaction(ctx context.Context, area areadomain.Area, link areadomain.Link) (ReactionResult, error)
 }
+
+// ReactionPreviewer describes the request a reaction would send without contacting the provider
+// Implementations return ErrPreviewUnsupported when the reaction cannot be described safely
+type ReactionPreviewer interface {
+	PreviewReaction(ctx context.Context, area areadomain.Area, link areadomain.Link) (ReactionResult, error)
+}
+
+type triggerEventKey struct{}
+
+// WithTriggerEvent attaches the payload of the event that triggered a reaction to the context
+func WithTriggerEvent(ctx context.Context, payload map[string]any) context.Context {
+	if len(payload) == 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, triggerEventKey{}, payload)
+}
+
+// TriggerEvent returns the payload of the event that triggered the reaction being executed
+func TriggerEvent(ctx context.Context) (map[string]any, bool) {
+	payload, ok := ctx.Value(triggerEventKey{}).(map[string]any)
+	return payload, ok && len(payload) > 0
+}
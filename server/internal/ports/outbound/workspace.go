@@ -0,0 +1,22 @@
+package outbound
+
+import (
+	"context"
+
+	workspacedomain "github.com/Epitech-2nd-Year-Projects/AREA/server/internal/domain/workspace"
+	"github.com/google/uuid"
+)
+
+// WorkspaceRepository persists shared workspaces and their members
+type WorkspaceRepository interface {
+	// Create stores the workspace together with its first owner
+	Create(ctx context.Context, workspace workspacedomain.Workspace, owner workspacedomain.Member) (workspacedomain.Workspace, error)
+	FindByID(ctx context.Context, id uuid.UUID) (workspacedomain.Workspace, error)
+	ListByUser(ctx context.Context, userID uuid.UUID) ([]workspacedomain.Membership, error)
+	Delete(ctx context.Context, id uuid.UUID) error
+	FindMember(ctx context.Context, workspaceID uuid.UUID, userID uuid.UUID) (workspacedomain.Member, error)
+	ListMembers(ctx context.Context, workspaceID uuid.UUID) ([]workspacedomain.Member, error)
+	// SaveMember inserts the member or updates the role of an existing one
+	SaveMember(ctx context.Context, member workspacedomain.Member) error
+	DeleteMember(ctx context.Context, workspaceID uuid.UUID, userID uuid.UUID) error
+}
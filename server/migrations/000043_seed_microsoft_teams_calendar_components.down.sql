@@ -0,0 +1,3 @@
+DELETE FROM "service_components"
+WHERE "name" IN ('outlook_event_starting_soon', 'outlook_create_event', 'teams_post_channel_message')
+AND provider_id IN (SELECT id FROM "service_providers" WHERE name = 'microsoft');
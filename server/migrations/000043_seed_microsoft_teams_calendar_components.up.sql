@@ -0,0 +1,279 @@
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'microsoft'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'outlook_event_starting_soon',
+    'Outlook event starting soon',
+    'Triggers when an event in your Outlook calendar is about to start',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Microsoft identity',
+                'type', 'identity',
+                'provider', 'microsoft',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'minutesBefore',
+                'label', 'Minutes before event',
+                'type', 'integer',
+                'required', TRUE,
+                'minimum', 1,
+                'maximum', 1440,
+                'default', 15
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'intervalSeconds', 60,
+            'handler', 'http',
+            'http', jsonb_build_object(
+                'endpoint', 'https://graph.microsoft.com/v1.0/me/calendarView',
+                'method', 'GET',
+                'itemsPath', 'value',
+                'fingerprintField', 'id',
+                'occurredAtField', 'start.dateTime',
+                'query', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'startDateTime',
+                        'template', '{{now_rfc3339}}'
+                    ),
+                    jsonb_build_object(
+                        'name', 'endDateTime',
+                        'template', '{{now_plus_minutes params.minutesBefore}}'
+                    ),
+                    jsonb_build_object(
+                        'name', '$orderby',
+                        'value', 'start/dateTime'
+                    ),
+                    jsonb_build_object(
+                        'name', '$top',
+                        'value', '25'
+                    )
+                ),
+                'headers', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'Accept',
+                        'value', 'application/json'
+                    ),
+                    jsonb_build_object(
+                        'name', 'Prefer',
+                        'value', 'outlook.timezone="UTC"'
+                    ),
+                    jsonb_build_object(
+                        'name', 'Authorization',
+                        'template', 'Bearer {{identity.accessToken}}'
+                    )
+                ),
+                'auth', jsonb_build_object(
+                    'type', 'oauth',
+                    'identityParam', 'identityId',
+                    'provider', 'microsoft'
+                ),
+                'cursor', jsonb_build_object(
+                    'source', 'item',
+                    'itemPath', 'start.dateTime'
+                ),
+                'skipItems', jsonb_build_array(
+                    jsonb_build_object(
+                        'path', 'isCancelled',
+                        'equals', 'true'
+                    )
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'microsoft'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'outlook_create_event',
+    'Create Outlook event',
+    'Creates a new event in your Outlook calendar with specified title, date, and time',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Microsoft identity',
+                'type', 'identity',
+                'provider', 'microsoft',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'calendarId',
+                'label', 'Calendar',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Calendar ID (leave empty for your default calendar)'
+            ),
+            jsonb_build_object(
+                'key', 'subject',
+                'label', 'Event title',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 255
+            ),
+            jsonb_build_object(
+                'key', 'body',
+                'label', 'Event description',
+                'type', 'textarea',
+                'required', FALSE,
+                'maxLength', 8192
+            ),
+            jsonb_build_object(
+                'key', 'startTime',
+                'label', 'Start date and time',
+                'type', 'datetime',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'endTime',
+                'label', 'End date and time',
+                'type', 'datetime',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'location',
+                'label', 'Location',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 512
+            ),
+            jsonb_build_object(
+                'key', 'attendees',
+                'label', 'Attendees',
+                'type', 'emailList',
+                'required', FALSE
+            ),
+            jsonb_build_object(
+                'key', 'isOnlineMeeting',
+                'label', 'Create Teams meeting',
+                'type', 'boolean',
+                'required', FALSE,
+                'default', FALSE
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'microsoft'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'teams_post_channel_message',
+    'Post Teams channel message',
+    'Posts a message to a Microsoft Teams channel',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Microsoft identity',
+                'type', 'identity',
+                'provider', 'microsoft',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'teamId',
+                'label', 'Team ID',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'channelId',
+                'label', 'Channel ID',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'message',
+                'label', 'Message',
+                'type', 'textarea',
+                'required', TRUE,
+                'maxLength', 28000
+            ),
+            jsonb_build_object(
+                'key', 'contentType',
+                'label', 'Message format',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'text',
+                'options', jsonb_build_array(
+                    jsonb_build_object('value', 'text', 'label', 'Plain text'),
+                    jsonb_build_object('value', 'html', 'label', 'HTML')
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
@@ -0,0 +1,3 @@
+DELETE FROM "service_components"
+WHERE "name" = 'gmail_new_email'
+AND provider_id IN (SELECT id FROM "service_providers" WHERE name = 'google');
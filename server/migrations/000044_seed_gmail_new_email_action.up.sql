@@ -0,0 +1,77 @@
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'google'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'gmail_new_email',
+    'New Gmail email',
+    'Emits an event when a new email matching the selected labels and search query arrives in Gmail',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Google identity',
+                'type', 'identity',
+                'provider', 'google',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'labelIds',
+                'label', 'Labels',
+                'type', 'text',
+                'required', FALSE,
+                'default', 'INBOX',
+                'helperText', 'Comma separated Gmail label IDs the message must carry (for example INBOX, UNREAD)'
+            ),
+            jsonb_build_object(
+                'key', 'query',
+                'label', 'Search query',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 512,
+                'helperText', 'Gmail search syntax (for example from:billing@example.com has:attachment)'
+            ),
+            jsonb_build_object(
+                'key', 'maxResults',
+                'label', 'Messages per poll',
+                'type', 'integer',
+                'required', FALSE,
+                'minimum', 1,
+                'maximum', 50,
+                'default', 10
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'intervalSeconds', 30,
+            'handler', 'gmail',
+            'auth', jsonb_build_object(
+                'type', 'oauth',
+                'identityParam', 'identityId',
+                'provider', 'google'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
@@ -0,0 +1,11 @@
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'github')
+  AND "kind" = 'action'
+  AND "name" IN (
+    'github_new_pull_request',
+    'github_pull_request_merged',
+    'github_new_issue',
+    'github_new_release',
+    'github_push_to_branch',
+    'github_workflow_run_failed'
+  );
@@ -0,0 +1,971 @@
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'github_new_pull_request',
+    'New GitHub pull request',
+    'Emits an event when a pull request is opened in the selected GitHub repository',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'baseBranch',
+                'label', 'Base branch',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Only pull requests targeting this branch trigger the area'
+            ),
+            jsonb_build_object(
+                'key', 'deliveryMode',
+                'label', 'Delivery mode',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'polling',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'polling',
+                        'label', 'Polling'
+                    ),
+                    jsonb_build_object(
+                        'value', 'webhook',
+                        'label', 'Repository webhook'
+                    )
+                ),
+                'helperText', 'Webhook delivery requires a repository webhook with the application/json content type pointing at the area webhook URL and signed with its secret'
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'modes', jsonb_build_array(
+                'polling',
+                'webhook'
+            ),
+            'modeParam', 'deliveryMode',
+            'intervalSeconds', 60,
+            'handler', 'http',
+            'http', jsonb_build_object(
+                'endpoint', 'https://api.github.com/repos/{{params.owner}}/{{params.repository}}/pulls',
+                'method', 'GET',
+                'auth', jsonb_build_object(
+                    'type', 'oauth',
+                    'identityParam', 'identityId',
+                    'provider', 'github'
+                ),
+                'headers', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'Accept',
+                        'value', 'application/vnd.github+json'
+                    ),
+                    jsonb_build_object(
+                        'name', 'Authorization',
+                        'template', 'Bearer {{identity.accessToken}}'
+                    )
+                ),
+                'query', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'state',
+                        'value', 'open'
+                    ),
+                    jsonb_build_object(
+                        'name', 'sort',
+                        'value', 'created'
+                    ),
+                    jsonb_build_object(
+                        'name', 'direction',
+                        'value', 'desc'
+                    ),
+                    jsonb_build_object(
+                        'name', 'base',
+                        'template', '{{params.baseBranch}}',
+                        'skipIfEmpty', TRUE
+                    ),
+                    jsonb_build_object(
+                        'name', 'per_page',
+                        'value', '30'
+                    )
+                ),
+                'fingerprintField', 'id',
+                'occurredAtField', 'created_at',
+                'cursor', jsonb_build_object(
+                    'source', 'item',
+                    'itemPath', 'created_at'
+                ),
+                'normalize', 'github.pull_request'
+            ),
+            'webhook', jsonb_build_object(
+                'signature', jsonb_build_object(
+                    'header', 'X-Hub-Signature-256',
+                    'algorithm', 'hmac-sha256',
+                    'prefix', 'sha256='
+                ),
+                'eventHeader', 'X-GitHub-Event',
+                'deliveryHeader', 'X-GitHub-Delivery',
+                'events', jsonb_build_array(
+                    'pull_request'
+                ),
+                'normalize', 'github'
+            ),
+            'match', jsonb_build_array(
+                jsonb_build_object(
+                    'path', 'action',
+                    'equals', 'opened'
+                ),
+                jsonb_build_object(
+                    'path', 'pull_request.base.ref',
+                    'equals', '{{params.baseBranch}}',
+                    'skipIfEmpty', TRUE
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'github_pull_request_merged',
+    'GitHub pull request merged',
+    'Emits an event when a pull request is merged in the selected GitHub repository',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'baseBranch',
+                'label', 'Base branch',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Only pull requests merged into this branch trigger the area'
+            ),
+            jsonb_build_object(
+                'key', 'deliveryMode',
+                'label', 'Delivery mode',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'polling',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'polling',
+                        'label', 'Polling'
+                    ),
+                    jsonb_build_object(
+                        'value', 'webhook',
+                        'label', 'Repository webhook'
+                    )
+                ),
+                'helperText', 'Webhook delivery requires a repository webhook with the application/json content type pointing at the area webhook URL and signed with its secret'
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'modes', jsonb_build_array(
+                'polling',
+                'webhook'
+            ),
+            'modeParam', 'deliveryMode',
+            'intervalSeconds', 60,
+            'handler', 'http',
+            'http', jsonb_build_object(
+                'endpoint', 'https://api.github.com/repos/{{params.owner}}/{{params.repository}}/pulls',
+                'method', 'GET',
+                'auth', jsonb_build_object(
+                    'type', 'oauth',
+                    'identityParam', 'identityId',
+                    'provider', 'github'
+                ),
+                'headers', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'Accept',
+                        'value', 'application/vnd.github+json'
+                    ),
+                    jsonb_build_object(
+                        'name', 'Authorization',
+                        'template', 'Bearer {{identity.accessToken}}'
+                    )
+                ),
+                'query', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'state',
+                        'value', 'closed'
+                    ),
+                    jsonb_build_object(
+                        'name', 'sort',
+                        'value', 'updated'
+                    ),
+                    jsonb_build_object(
+                        'name', 'direction',
+                        'value', 'desc'
+                    ),
+                    jsonb_build_object(
+                        'name', 'base',
+                        'template', '{{params.baseBranch}}',
+                        'skipIfEmpty', TRUE
+                    ),
+                    jsonb_build_object(
+                        'name', 'per_page',
+                        'value', '30'
+                    )
+                ),
+                'fingerprintField', 'id',
+                'occurredAtField', 'merged_at',
+                'cursor', jsonb_build_object(
+                    'source', 'item',
+                    'itemPath', 'merged_at'
+                ),
+                'skipItems', jsonb_build_array(
+                    jsonb_build_object(
+                        'path', 'merged_at',
+                        'exists', FALSE
+                    )
+                ),
+                'normalize', 'github.pull_request'
+            ),
+            'webhook', jsonb_build_object(
+                'signature', jsonb_build_object(
+                    'header', 'X-Hub-Signature-256',
+                    'algorithm', 'hmac-sha256',
+                    'prefix', 'sha256='
+                ),
+                'eventHeader', 'X-GitHub-Event',
+                'deliveryHeader', 'X-GitHub-Delivery',
+                'events', jsonb_build_array(
+                    'pull_request'
+                ),
+                'normalize', 'github'
+            ),
+            'match', jsonb_build_array(
+                jsonb_build_object(
+                    'path', 'action',
+                    'equals', 'closed'
+                ),
+                jsonb_build_object(
+                    'path', 'pull_request.merged',
+                    'equals', 'true'
+                ),
+                jsonb_build_object(
+                    'path', 'pull_request.base.ref',
+                    'equals', '{{params.baseBranch}}',
+                    'skipIfEmpty', TRUE
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'github_new_issue',
+    'New GitHub issue',
+    'Emits an event when an issue is opened or labeled in the selected GitHub repository. Label changes on existing issues are only detected with webhook delivery',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'label',
+                'label', 'Label',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Only issues carrying this label trigger the area'
+            ),
+            jsonb_build_object(
+                'key', 'deliveryMode',
+                'label', 'Delivery mode',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'polling',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'polling',
+                        'label', 'Polling'
+                    ),
+                    jsonb_build_object(
+                        'value', 'webhook',
+                        'label', 'Repository webhook'
+                    )
+                ),
+                'helperText', 'Webhook delivery requires a repository webhook with the application/json content type pointing at the area webhook URL and signed with its secret'
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'modes', jsonb_build_array(
+                'polling',
+                'webhook'
+            ),
+            'modeParam', 'deliveryMode',
+            'intervalSeconds', 60,
+            'handler', 'http',
+            'http', jsonb_build_object(
+                'endpoint', 'https://api.github.com/repos/{{params.owner}}/{{params.repository}}/issues',
+                'method', 'GET',
+                'auth', jsonb_build_object(
+                    'type', 'oauth',
+                    'identityParam', 'identityId',
+                    'provider', 'github'
+                ),
+                'headers', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'Accept',
+                        'value', 'application/vnd.github+json'
+                    ),
+                    jsonb_build_object(
+                        'name', 'Authorization',
+                        'template', 'Bearer {{identity.accessToken}}'
+                    )
+                ),
+                'query', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'state',
+                        'value', 'open'
+                    ),
+                    jsonb_build_object(
+                        'name', 'sort',
+                        'value', 'created'
+                    ),
+                    jsonb_build_object(
+                        'name', 'direction',
+                        'value', 'desc'
+                    ),
+                    jsonb_build_object(
+                        'name', 'labels',
+                        'template', '{{params.label}}',
+                        'skipIfEmpty', TRUE
+                    ),
+                    jsonb_build_object(
+                        'name', 'per_page',
+                        'value', '30'
+                    )
+                ),
+                'fingerprintField', 'id',
+                'occurredAtField', 'created_at',
+                'cursor', jsonb_build_object(
+                    'source', 'item',
+                    'itemPath', 'created_at'
+                ),
+                'skipItems', jsonb_build_array(
+                    jsonb_build_object(
+                        'path', 'pull_request',
+                        'exists', TRUE
+                    )
+                ),
+                'normalize', 'github.issue'
+            ),
+            'webhook', jsonb_build_object(
+                'signature', jsonb_build_object(
+                    'header', 'X-Hub-Signature-256',
+                    'algorithm', 'hmac-sha256',
+                    'prefix', 'sha256='
+                ),
+                'eventHeader', 'X-GitHub-Event',
+                'deliveryHeader', 'X-GitHub-Delivery',
+                'events', jsonb_build_array(
+                    'issues'
+                ),
+                'normalize', 'github'
+            ),
+            'match', jsonb_build_array(
+                jsonb_build_object(
+                    'path', 'action',
+                    'oneOf', jsonb_build_array(
+                        'opened',
+                        'labeled'
+                    )
+                ),
+                jsonb_build_object(
+                    'path', 'labelNames',
+                    'equals', '{{params.label}}',
+                    'skipIfEmpty', TRUE
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'github_new_release',
+    'New GitHub release',
+    'Emits an event when a release is published in the selected GitHub repository',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'deliveryMode',
+                'label', 'Delivery mode',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'polling',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'polling',
+                        'label', 'Polling'
+                    ),
+                    jsonb_build_object(
+                        'value', 'webhook',
+                        'label', 'Repository webhook'
+                    )
+                ),
+                'helperText', 'Webhook delivery requires a repository webhook with the application/json content type pointing at the area webhook URL and signed with its secret'
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'modes', jsonb_build_array(
+                'polling',
+                'webhook'
+            ),
+            'modeParam', 'deliveryMode',
+            'intervalSeconds', 60,
+            'handler', 'http',
+            'http', jsonb_build_object(
+                'endpoint', 'https://api.github.com/repos/{{params.owner}}/{{params.repository}}/releases',
+                'method', 'GET',
+                'auth', jsonb_build_object(
+                    'type', 'oauth',
+                    'identityParam', 'identityId',
+                    'provider', 'github'
+                ),
+                'headers', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'Accept',
+                        'value', 'application/vnd.github+json'
+                    ),
+                    jsonb_build_object(
+                        'name', 'Authorization',
+                        'template', 'Bearer {{identity.accessToken}}'
+                    )
+                ),
+                'query', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'per_page',
+                        'value', '30'
+                    )
+                ),
+                'fingerprintField', 'id',
+                'occurredAtField', 'published_at',
+                'cursor', jsonb_build_object(
+                    'source', 'item',
+                    'itemPath', 'published_at'
+                ),
+                'skipItems', jsonb_build_array(
+                    jsonb_build_object(
+                        'path', 'draft',
+                        'equals', 'true'
+                    ),
+                    jsonb_build_object(
+                        'path', 'published_at',
+                        'exists', FALSE
+                    )
+                ),
+                'normalize', 'github.release'
+            ),
+            'webhook', jsonb_build_object(
+                'signature', jsonb_build_object(
+                    'header', 'X-Hub-Signature-256',
+                    'algorithm', 'hmac-sha256',
+                    'prefix', 'sha256='
+                ),
+                'eventHeader', 'X-GitHub-Event',
+                'deliveryHeader', 'X-GitHub-Delivery',
+                'events', jsonb_build_array(
+                    'release'
+                ),
+                'normalize', 'github'
+            ),
+            'match', jsonb_build_array(
+                jsonb_build_object(
+                    'path', 'action',
+                    'equals', 'published'
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'github_push_to_branch',
+    'GitHub push to branch',
+    'Emits an event when commits are pushed to the selected branch. Polling delivery emits one event per new commit',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'branch',
+                'label', 'Branch',
+                'type', 'text',
+                'required', TRUE,
+                'default', 'main'
+            ),
+            jsonb_build_object(
+                'key', 'deliveryMode',
+                'label', 'Delivery mode',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'polling',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'polling',
+                        'label', 'Polling'
+                    ),
+                    jsonb_build_object(
+                        'value', 'webhook',
+                        'label', 'Repository webhook'
+                    )
+                ),
+                'helperText', 'Webhook delivery requires a repository webhook with the application/json content type pointing at the area webhook URL and signed with its secret'
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'modes', jsonb_build_array(
+                'polling',
+                'webhook'
+            ),
+            'modeParam', 'deliveryMode',
+            'intervalSeconds', 60,
+            'handler', 'http',
+            'http', jsonb_build_object(
+                'endpoint', 'https://api.github.com/repos/{{params.owner}}/{{params.repository}}/commits',
+                'method', 'GET',
+                'auth', jsonb_build_object(
+                    'type', 'oauth',
+                    'identityParam', 'identityId',
+                    'provider', 'github'
+                ),
+                'headers', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'Accept',
+                        'value', 'application/vnd.github+json'
+                    ),
+                    jsonb_build_object(
+                        'name', 'Authorization',
+                        'template', 'Bearer {{identity.accessToken}}'
+                    )
+                ),
+                'query', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'sha',
+                        'template', '{{params.branch}}'
+                    ),
+                    jsonb_build_object(
+                        'name', 'per_page',
+                        'value', '30'
+                    )
+                ),
+                'fingerprintField', 'sha',
+                'occurredAtField', 'commit.committer.date',
+                'cursor', jsonb_build_object(
+                    'source', 'item',
+                    'itemPath', 'commit.committer.date'
+                ),
+                'normalize', 'github.push'
+            ),
+            'webhook', jsonb_build_object(
+                'signature', jsonb_build_object(
+                    'header', 'X-Hub-Signature-256',
+                    'algorithm', 'hmac-sha256',
+                    'prefix', 'sha256='
+                ),
+                'eventHeader', 'X-GitHub-Event',
+                'deliveryHeader', 'X-GitHub-Delivery',
+                'events', jsonb_build_array(
+                    'push'
+                ),
+                'normalize', 'github'
+            ),
+            'match', jsonb_build_array(
+                jsonb_build_object(
+                    'path', 'branch',
+                    'equals', '{{params.branch}}'
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'github_workflow_run_failed',
+    'GitHub workflow run failed',
+    'Emits an event when a GitHub Actions workflow run completes with a failure in the selected repository',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'workflowName',
+                'label', 'Workflow name',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Only runs of this workflow trigger the area'
+            ),
+            jsonb_build_object(
+                'key', 'branch',
+                'label', 'Branch',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Only runs on this branch trigger the area'
+            ),
+            jsonb_build_object(
+                'key', 'deliveryMode',
+                'label', 'Delivery mode',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'polling',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'polling',
+                        'label', 'Polling'
+                    ),
+                    jsonb_build_object(
+                        'value', 'webhook',
+                        'label', 'Repository webhook'
+                    )
+                ),
+                'helperText', 'Webhook delivery requires a repository webhook with the application/json content type pointing at the area webhook URL and signed with its secret'
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'modes', jsonb_build_array(
+                'polling',
+                'webhook'
+            ),
+            'modeParam', 'deliveryMode',
+            'intervalSeconds', 60,
+            'handler', 'http',
+            'http', jsonb_build_object(
+                'endpoint', 'https://api.github.com/repos/{{params.owner}}/{{params.repository}}/actions/runs',
+                'method', 'GET',
+                'itemsPath', 'workflow_runs',
+                'auth', jsonb_build_object(
+                    'type', 'oauth',
+                    'identityParam', 'identityId',
+                    'provider', 'github'
+                ),
+                'headers', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'Accept',
+                        'value', 'application/vnd.github+json'
+                    ),
+                    jsonb_build_object(
+                        'name', 'Authorization',
+                        'template', 'Bearer {{identity.accessToken}}'
+                    )
+                ),
+                'query', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'status',
+                        'value', 'failure'
+                    ),
+                    jsonb_build_object(
+                        'name', 'branch',
+                        'template', '{{params.branch}}',
+                        'skipIfEmpty', TRUE
+                    ),
+                    jsonb_build_object(
+                        'name', 'per_page',
+                        'value', '30'
+                    )
+                ),
+                'fingerprintField', 'id',
+                'occurredAtField', 'updated_at',
+                'cursor', jsonb_build_object(
+                    'source', 'item',
+                    'itemPath', 'updated_at'
+                ),
+                'normalize', 'github.workflow_run'
+            ),
+            'webhook', jsonb_build_object(
+                'signature', jsonb_build_object(
+                    'header', 'X-Hub-Signature-256',
+                    'algorithm', 'hmac-sha256',
+                    'prefix', 'sha256='
+                ),
+                'eventHeader', 'X-GitHub-Event',
+                'deliveryHeader', 'X-GitHub-Delivery',
+                'events', jsonb_build_array(
+                    'workflow_run'
+                ),
+                'normalize', 'github'
+            ),
+            'match', jsonb_build_array(
+                jsonb_build_object(
+                    'path', 'action',
+                    'equals', 'completed'
+                ),
+                jsonb_build_object(
+                    'path', 'workflow_run.conclusion',
+                    'oneOf', jsonb_build_array(
+                        'failure',
+                        'timed_out'
+                    )
+                ),
+                jsonb_build_object(
+                    'path', 'workflow_run.name',
+                    'equals', '{{params.workflowName}}',
+                    'skipIfEmpty', TRUE
+                ),
+                jsonb_build_object(
+                    'path', 'workflow_run.head_branch',
+                    'equals', '{{params.branch}}',
+                    'skipIfEmpty', TRUE
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
@@ -0,0 +1,27 @@
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'github')
+  AND "kind" = 'reaction'
+  AND "name" IN (
+    'github_comment',
+    'github_add_labels',
+    'github_remove_labels',
+    'github_close_issue',
+    'github_reopen_issue',
+    'github_create_release',
+    'github_dispatch_workflow',
+    'github_create_gist'
+  );
+
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'gitlab')
+  AND "kind" = 'reaction'
+  AND "name" IN (
+    'gitlab_comment',
+    'gitlab_add_labels',
+    'gitlab_remove_labels',
+    'gitlab_close_issue',
+    'gitlab_reopen_issue',
+    'gitlab_create_release',
+    'gitlab_trigger_pipeline',
+    'gitlab_create_snippet'
+  );
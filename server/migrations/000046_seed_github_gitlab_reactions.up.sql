@@ -0,0 +1,1224 @@
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'github_comment',
+    'Comment on GitHub issue or pull request',
+    'Posts a comment on an issue or pull request in the selected GitHub repository',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'number',
+                'label', 'Issue or pull request number',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Number of the issue or pull request, for example 42'
+            ),
+            jsonb_build_object(
+                'key', 'body',
+                'label', 'Comment',
+                'type', 'textarea',
+                'required', TRUE,
+                'maxLength', 65536
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'github_add_labels',
+    'Add labels to GitHub issue',
+    'Adds labels to an issue or pull request in the selected GitHub repository',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'number',
+                'label', 'Issue or pull request number',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Number of the issue or pull request, for example 42'
+            ),
+            jsonb_build_object(
+                'key', 'labels',
+                'label', 'Labels',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Comma separated list of labels to add'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'github_remove_labels',
+    'Remove labels from GitHub issue',
+    'Removes labels from an issue or pull request in the selected GitHub repository, ignoring labels that are not set',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'number',
+                'label', 'Issue or pull request number',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Number of the issue or pull request, for example 42'
+            ),
+            jsonb_build_object(
+                'key', 'labels',
+                'label', 'Labels',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Comma separated list of labels to remove'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'github_close_issue',
+    'Close GitHub issue',
+    'Closes an issue or pull request in the selected GitHub repository',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'number',
+                'label', 'Issue or pull request number',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Number of the issue or pull request, for example 42'
+            ),
+            jsonb_build_object(
+                'key', 'stateReason',
+                'label', 'Reason',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'completed',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'completed',
+                        'label', 'Completed'
+                    ),
+                    jsonb_build_object(
+                        'value', 'not_planned',
+                        'label', 'Not planned'
+                    )
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'github_reopen_issue',
+    'Reopen GitHub issue',
+    'Reopens a closed issue or pull request in the selected GitHub repository',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'number',
+                'label', 'Issue or pull request number',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Number of the issue or pull request, for example 42'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'github_create_release',
+    'Create GitHub release',
+    'Creates a release, and its tag when missing, in the selected GitHub repository',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'tagName',
+                'label', 'Tag name',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 255
+            ),
+            jsonb_build_object(
+                'key', 'target',
+                'label', 'Target',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Branch or commit SHA the tag is created from when it does not exist yet'
+            ),
+            jsonb_build_object(
+                'key', 'name',
+                'label', 'Release name',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 255
+            ),
+            jsonb_build_object(
+                'key', 'body',
+                'label', 'Release notes',
+                'type', 'textarea',
+                'required', FALSE,
+                'maxLength', 125000
+            ),
+            jsonb_build_object(
+                'key', 'draft',
+                'label', 'Draft',
+                'type', 'boolean',
+                'required', FALSE,
+                'default', FALSE
+            ),
+            jsonb_build_object(
+                'key', 'prerelease',
+                'label', 'Pre-release',
+                'type', 'boolean',
+                'required', FALSE,
+                'default', FALSE
+            ),
+            jsonb_build_object(
+                'key', 'generateNotes',
+                'label', 'Generate release notes',
+                'type', 'boolean',
+                'required', FALSE,
+                'default', FALSE
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'github_dispatch_workflow',
+    'Run GitHub workflow',
+    'Triggers a workflow_dispatch event for a GitHub Actions workflow',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Repository owner',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Repository name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'workflow',
+                'label', 'Workflow',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Workflow file name (for example deploy.yml) or workflow ID'
+            ),
+            jsonb_build_object(
+                'key', 'ref',
+                'label', 'Git reference',
+                'type', 'text',
+                'required', TRUE,
+                'default', 'main'
+            ),
+            jsonb_build_object(
+                'key', 'inputs',
+                'label', 'Inputs',
+                'type', 'textarea',
+                'required', FALSE,
+                'maxLength', 4000,
+                'helperText', 'Optional JSON object of workflow inputs'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'github'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'github_create_gist',
+    'Create GitHub gist',
+    'Creates a gist containing a single file using the linked identity',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitHub identity',
+                'type', 'identity',
+                'provider', 'github',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'filename',
+                'label', 'File name',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 255
+            ),
+            jsonb_build_object(
+                'key', 'content',
+                'label', 'Content',
+                'type', 'textarea',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'description',
+                'label', 'Description',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 256
+            ),
+            jsonb_build_object(
+                'key', 'public',
+                'label', 'Public',
+                'type', 'boolean',
+                'required', FALSE,
+                'default', FALSE
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'gitlab'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gitlab_comment',
+    'Comment on GitLab issue or merge request',
+    'Adds a note to an issue or merge request in the selected GitLab project',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitLab identity',
+                'type', 'identity',
+                'provider', 'gitlab',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Project namespace',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Project name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'targetType',
+                'label', 'Target',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'issue',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'issue',
+                        'label', 'Issue'
+                    ),
+                    jsonb_build_object(
+                        'value', 'merge_request',
+                        'label', 'Merge request'
+                    )
+                )
+            ),
+            jsonb_build_object(
+                'key', 'iid',
+                'label', 'Issue or merge request IID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Project level IID, for example 42'
+            ),
+            jsonb_build_object(
+                'key', 'body',
+                'label', 'Comment',
+                'type', 'textarea',
+                'required', TRUE,
+                'maxLength', 1000000
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'gitlab'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gitlab_add_labels',
+    'Add labels to GitLab issue or merge request',
+    'Adds labels to an issue or merge request in the selected GitLab project',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitLab identity',
+                'type', 'identity',
+                'provider', 'gitlab',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Project namespace',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Project name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'targetType',
+                'label', 'Target',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'issue',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'issue',
+                        'label', 'Issue'
+                    ),
+                    jsonb_build_object(
+                        'value', 'merge_request',
+                        'label', 'Merge request'
+                    )
+                )
+            ),
+            jsonb_build_object(
+                'key', 'iid',
+                'label', 'Issue or merge request IID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Project level IID, for example 42'
+            ),
+            jsonb_build_object(
+                'key', 'labels',
+                'label', 'Labels',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Comma separated list of labels to add'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'gitlab'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gitlab_remove_labels',
+    'Remove labels from GitLab issue or merge request',
+    'Removes labels from an issue or merge request in the selected GitLab project',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitLab identity',
+                'type', 'identity',
+                'provider', 'gitlab',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Project namespace',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Project name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'targetType',
+                'label', 'Target',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'issue',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'issue',
+                        'label', 'Issue'
+                    ),
+                    jsonb_build_object(
+                        'value', 'merge_request',
+                        'label', 'Merge request'
+                    )
+                )
+            ),
+            jsonb_build_object(
+                'key', 'iid',
+                'label', 'Issue or merge request IID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Project level IID, for example 42'
+            ),
+            jsonb_build_object(
+                'key', 'labels',
+                'label', 'Labels',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Comma separated list of labels to remove'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'gitlab'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gitlab_close_issue',
+    'Close GitLab issue',
+    'Closes an issue in the selected GitLab project',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitLab identity',
+                'type', 'identity',
+                'provider', 'gitlab',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Project namespace',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Project name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'iid',
+                'label', 'Issue IID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Project level issue IID, for example 42'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'gitlab'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gitlab_reopen_issue',
+    'Reopen GitLab issue',
+    'Reopens a closed issue in the selected GitLab project',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitLab identity',
+                'type', 'identity',
+                'provider', 'gitlab',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Project namespace',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Project name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'iid',
+                'label', 'Issue IID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Project level issue IID, for example 42'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'gitlab'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gitlab_create_release',
+    'Create GitLab release',
+    'Creates a release, and its tag when missing, in the selected GitLab project',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitLab identity',
+                'type', 'identity',
+                'provider', 'gitlab',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Project namespace',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Project name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'tagName',
+                'label', 'Tag name',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 255
+            ),
+            jsonb_build_object(
+                'key', 'ref',
+                'label', 'Git reference',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Branch or commit SHA the tag is created from when it does not exist yet'
+            ),
+            jsonb_build_object(
+                'key', 'name',
+                'label', 'Release name',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 255
+            ),
+            jsonb_build_object(
+                'key', 'description',
+                'label', 'Release notes',
+                'type', 'textarea',
+                'required', FALSE,
+                'maxLength', 100000
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'gitlab'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gitlab_trigger_pipeline',
+    'Run GitLab pipeline',
+    'Creates a new CI/CD pipeline for a reference of the selected GitLab project',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitLab identity',
+                'type', 'identity',
+                'provider', 'gitlab',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'owner',
+                'label', 'Project namespace',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'repository',
+                'label', 'Project name',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'ref',
+                'label', 'Git reference',
+                'type', 'text',
+                'required', TRUE,
+                'default', 'main'
+            ),
+            jsonb_build_object(
+                'key', 'variables',
+                'label', 'Variables',
+                'type', 'textarea',
+                'required', FALSE,
+                'maxLength', 4000,
+                'helperText', 'Optional JSON object of pipeline variables'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'gitlab'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gitlab_create_snippet',
+    'Create GitLab snippet',
+    'Creates a personal snippet containing a single file using the linked identity',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'GitLab identity',
+                'type', 'identity',
+                'provider', 'gitlab',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'title',
+                'label', 'Title',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 255
+            ),
+            jsonb_build_object(
+                'key', 'filename',
+                'label', 'File name',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 255
+            ),
+            jsonb_build_object(
+                'key', 'content',
+                'label', 'Content',
+                'type', 'textarea',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'description',
+                'label', 'Description',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 256
+            ),
+            jsonb_build_object(
+                'key', 'visibility',
+                'label', 'Visibility',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'private',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'private',
+                        'label', 'Private'
+                    ),
+                    jsonb_build_object(
+                        'value', 'internal',
+                        'label', 'Internal'
+                    ),
+                    jsonb_build_object(
+                        'value', 'public',
+                        'label', 'Public'
+                    )
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
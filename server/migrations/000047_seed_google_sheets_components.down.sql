@@ -0,0 +1,13 @@
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'google')
+  AND "kind" = 'reaction'
+  AND "name" IN (
+    'gsheets_append_row'
+  );
+
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'google')
+  AND "kind" = 'action'
+  AND "name" IN (
+    'gsheets_new_row'
+  );
@@ -0,0 +1,176 @@
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'google'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gsheets_append_row',
+    'Append Google Sheets row',
+    'Appends a row to a Google Sheets tab, placing each value in its mapped column',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Google identity',
+                'type', 'identity',
+                'provider', 'google',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'spreadsheetId',
+                'label', 'Spreadsheet ID',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 128,
+                'helperText', 'The identifier found in the spreadsheet URL between /d/ and /edit'
+            ),
+            jsonb_build_object(
+                'key', 'sheetName',
+                'label', 'Sheet name',
+                'type', 'text',
+                'required', FALSE,
+                'default', 'Sheet1',
+                'maxLength', 100
+            ),
+            jsonb_build_object(
+                'key', 'values',
+                'label', 'Values',
+                'type', 'textarea',
+                'required', TRUE,
+                'helperText', 'One value per line, in column order or in the order of the mapped columns'
+            ),
+            jsonb_build_object(
+                'key', 'columns',
+                'label', 'Columns',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 512,
+                'helperText', 'Optional comma separated header names or column letters (for example Name, Email, D) matching each value line'
+            ),
+            jsonb_build_object(
+                'key', 'valueInputOption',
+                'label', 'Value input',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'USER_ENTERED',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'USER_ENTERED',
+                        'label', 'Parse like typed input'
+                    ),
+                    jsonb_build_object(
+                        'value', 'RAW',
+                        'label', 'Store values as-is'
+                    )
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'google'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'gsheets_new_row',
+    'New Google Sheets row',
+    'Emits an event for every row appended to a Google Sheets tab since the last poll',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Google identity',
+                'type', 'identity',
+                'provider', 'google',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'spreadsheetId',
+                'label', 'Spreadsheet ID',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 128,
+                'helperText', 'The identifier found in the spreadsheet URL between /d/ and /edit'
+            ),
+            jsonb_build_object(
+                'key', 'sheetName',
+                'label', 'Sheet name',
+                'type', 'text',
+                'required', FALSE,
+                'default', 'Sheet1',
+                'maxLength', 100
+            ),
+            jsonb_build_object(
+                'key', 'hasHeader',
+                'label', 'First row is a header',
+                'type', 'boolean',
+                'required', FALSE,
+                'default', TRUE,
+                'helperText', 'Expose values keyed by header name in the event payload'
+            ),
+            jsonb_build_object(
+                'key', 'maxRows',
+                'label', 'Rows per poll',
+                'type', 'integer',
+                'required', FALSE,
+                'minimum', 1,
+                'maximum', 100,
+                'default', 20
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'intervalSeconds', 60,
+            'handler', 'gsheets',
+            'auth', jsonb_build_object(
+                'type', 'oauth',
+                'identityParam', 'identityId',
+                'provider', 'google'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
@@ -0,0 +1,12 @@
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'slack')
+  AND "kind" = 'reaction'
+  AND "name" IN (
+    'slack_post_blocks',
+    'slack_reply_in_thread',
+    'slack_add_reaction',
+    'slack_upload_file',
+    'slack_dm_user_by_email',
+    'slack_set_channel_topic',
+    'slack_set_user_status'
+  );
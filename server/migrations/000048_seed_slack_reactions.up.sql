@@ -0,0 +1,483 @@
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'slack'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'slack_post_blocks',
+    'Send Slack Block Kit message',
+    'Posts a Block Kit message to the selected Slack channel using the linked identity',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Slack identity',
+                'type', 'identity',
+                'provider', 'slack',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'channelId',
+                'label', 'Channel ID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Slack channel ID, for example C0123456789'
+            ),
+            jsonb_build_object(
+                'key', 'blocks',
+                'label', 'Blocks',
+                'type', 'textarea',
+                'required', TRUE,
+                'helperText', 'JSON array of Block Kit blocks, or a message object copied from the Block Kit Builder'
+            ),
+            jsonb_build_object(
+                'key', 'text',
+                'label', 'Fallback text',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 3000,
+                'helperText', 'Shown in notifications and by clients that cannot render blocks'
+            ),
+            jsonb_build_object(
+                'key', 'threadTs',
+                'label', 'Thread timestamp',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Optional thread timestamp (ts) to reply within a thread'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'slack'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'slack_reply_in_thread',
+    'Reply in Slack thread',
+    'Replies in the thread of the Slack message that triggered the area, or of the given timestamp',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Slack identity',
+                'type', 'identity',
+                'provider', 'slack',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'channelId',
+                'label', 'Channel ID',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Defaults to the channel of the triggering Slack event'
+            ),
+            jsonb_build_object(
+                'key', 'threadTs',
+                'label', 'Thread timestamp',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Defaults to the thread (or message) timestamp of the triggering Slack event'
+            ),
+            jsonb_build_object(
+                'key', 'text',
+                'label', 'Message text',
+                'type', 'textarea',
+                'required', TRUE,
+                'helperText', 'Supports Slack formatting and emoji codes'
+            ),
+            jsonb_build_object(
+                'key', 'broadcast',
+                'label', 'Also send to channel',
+                'type', 'boolean',
+                'required', FALSE,
+                'default', FALSE
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'slack'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'slack_add_reaction',
+    'Add Slack reaction',
+    'Adds an emoji reaction to a Slack message',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Slack identity',
+                'type', 'identity',
+                'provider', 'slack',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'channelId',
+                'label', 'Channel ID',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Defaults to the channel of the triggering Slack event'
+            ),
+            jsonb_build_object(
+                'key', 'timestamp',
+                'label', 'Message timestamp',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Defaults to the timestamp of the triggering Slack message'
+            ),
+            jsonb_build_object(
+                'key', 'emoji',
+                'label', 'Emoji',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 100,
+                'helperText', 'Emoji name, for example thumbsup or :eyes:'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'slack'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'slack_upload_file',
+    'Upload Slack file',
+    'Uploads a text file and shares it in the selected Slack channel',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Slack identity',
+                'type', 'identity',
+                'provider', 'slack',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'channelId',
+                'label', 'Channel ID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Slack channel ID, for example C0123456789'
+            ),
+            jsonb_build_object(
+                'key', 'filename',
+                'label', 'File name',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 255,
+                'helperText', 'For example report.csv'
+            ),
+            jsonb_build_object(
+                'key', 'content',
+                'label', 'Content',
+                'type', 'textarea',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'title',
+                'label', 'Title',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 255
+            ),
+            jsonb_build_object(
+                'key', 'initialComment',
+                'label', 'Message',
+                'type', 'textarea',
+                'required', FALSE,
+                'helperText', 'Optional message posted along with the file'
+            ),
+            jsonb_build_object(
+                'key', 'threadTs',
+                'label', 'Thread timestamp',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Optional thread timestamp (ts) to reply within a thread'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'slack'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'slack_dm_user_by_email',
+    'Send Slack direct message',
+    'Looks up a workspace member by email address and sends them a direct message',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Slack identity',
+                'type', 'identity',
+                'provider', 'slack',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'email',
+                'label', 'Email',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 320,
+                'helperText', 'Email address of the Slack workspace member'
+            ),
+            jsonb_build_object(
+                'key', 'text',
+                'label', 'Message text',
+                'type', 'textarea',
+                'required', TRUE,
+                'helperText', 'Supports Slack formatting and emoji codes'
+            ),
+            jsonb_build_object(
+                'key', 'blocks',
+                'label', 'Blocks',
+                'type', 'textarea',
+                'required', FALSE,
+                'helperText', 'Optional JSON array of Block Kit blocks'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'slack'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'slack_set_channel_topic',
+    'Set Slack channel topic',
+    'Replaces the topic of the selected Slack channel',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Slack identity',
+                'type', 'identity',
+                'provider', 'slack',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'channelId',
+                'label', 'Channel ID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Slack channel ID, for example C0123456789'
+            ),
+            jsonb_build_object(
+                'key', 'topic',
+                'label', 'Topic',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 250
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'slack'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'slack_set_user_status',
+    'Set Slack status',
+    'Updates the custom status of the linked Slack user',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Slack identity',
+                'type', 'identity',
+                'provider', 'slack',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'statusText',
+                'label', 'Status text',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 100,
+                'helperText', 'Leave text and emoji empty to clear the status'
+            ),
+            jsonb_build_object(
+                'key', 'statusEmoji',
+                'label', 'Status emoji',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 100,
+                'helperText', 'Emoji name, for example calendar'
+            ),
+            jsonb_build_object(
+                'key', 'expirationMinutes',
+                'label', 'Clear after (minutes)',
+                'type', 'integer',
+                'required', FALSE,
+                'minimum', 0,
+                'maximum', 10080,
+                'default', 0,
+                'helperText', '0 keeps the status until it is changed'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
@@ -0,0 +1,15 @@
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'notion')
+  AND "kind" = 'reaction'
+  AND "name" IN (
+    'notion_upsert_database_row',
+    'notion_update_page_property',
+    'notion_append_block'
+  );
+
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'notion')
+  AND "kind" = 'action'
+  AND "name" IN (
+    'notion_database_item_updated'
+  );
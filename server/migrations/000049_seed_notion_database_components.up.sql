@@ -0,0 +1,313 @@
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'notion'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'notion_upsert_database_row',
+    'Upsert Notion database row',
+    'Updates the database row whose property matches the given value, or creates it when no row matches',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Notion identity',
+                'type', 'identity',
+                'provider', 'notion',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'databaseId',
+                'label', 'Database ID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Paste the Notion database ID (32 characters, with or without dashes)'
+            ),
+            jsonb_build_object(
+                'key', 'matchProperty',
+                'label', 'Match property',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 100,
+                'helperText', 'Property used to find the existing row, for example Email'
+            ),
+            jsonb_build_object(
+                'key', 'matchValue',
+                'label', 'Match value',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 2000
+            ),
+            jsonb_build_object(
+                'key', 'properties',
+                'label', 'Properties',
+                'type', 'textarea',
+                'required', FALSE,
+                'helperText', 'One property per line as Name: value, or a JSON object. Multi-select and relation values are comma separated, date ranges use start/end'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'notion'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'notion_update_page_property',
+    'Update Notion page property',
+    'Updates one or more properties of a Notion page or database row',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Notion identity',
+                'type', 'identity',
+                'provider', 'notion',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'pageId',
+                'label', 'Page ID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Notion page ID, also used for database rows'
+            ),
+            jsonb_build_object(
+                'key', 'property',
+                'label', 'Property',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 100,
+                'helperText', 'Name of a single property to update'
+            ),
+            jsonb_build_object(
+                'key', 'value',
+                'label', 'Value',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 2000
+            ),
+            jsonb_build_object(
+                'key', 'properties',
+                'label', 'Properties',
+                'type', 'textarea',
+                'required', FALSE,
+                'helperText', 'One property per line as Name: value, or a JSON object. Multi-select and relation values are comma separated, date ranges use start/end'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'notion'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'notion_append_block',
+    'Append Notion block',
+    'Appends content to a Notion page, one block per line',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Notion identity',
+                'type', 'identity',
+                'provider', 'notion',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'pageId',
+                'label', 'Page ID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Notion page ID, also used for database rows'
+            ),
+            jsonb_build_object(
+                'key', 'content',
+                'label', 'Content',
+                'type', 'textarea',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'blockType',
+                'label', 'Block type',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'paragraph',
+                'options', jsonb_build_array(
+                    jsonb_build_object(
+                        'value', 'paragraph',
+                        'label', 'Paragraph'
+                    ),
+                    jsonb_build_object(
+                        'value', 'heading_1',
+                        'label', 'Heading 1'
+                    ),
+                    jsonb_build_object(
+                        'value', 'heading_2',
+                        'label', 'Heading 2'
+                    ),
+                    jsonb_build_object(
+                        'value', 'heading_3',
+                        'label', 'Heading 3'
+                    ),
+                    jsonb_build_object(
+                        'value', 'bulleted_list_item',
+                        'label', 'Bulleted list'
+                    ),
+                    jsonb_build_object(
+                        'value', 'numbered_list_item',
+                        'label', 'Numbered list'
+                    ),
+                    jsonb_build_object(
+                        'value', 'to_do',
+                        'label', 'To-do'
+                    ),
+                    jsonb_build_object(
+                        'value', 'quote',
+                        'label', 'Quote'
+                    ),
+                    jsonb_build_object(
+                        'value', 'code',
+                        'label', 'Code'
+                    )
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'notion'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'notion_database_item_updated',
+    'Database item updated',
+    'Emits an event when an existing row of the selected Notion database is edited',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Notion identity',
+                'type', 'identity',
+                'provider', 'notion',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'databaseId',
+                'label', 'Database ID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Paste the Notion database ID (32 characters, with or without dashes)'
+            ),
+            jsonb_build_object(
+                'key', 'maxItems',
+                'label', 'Rows per poll',
+                'type', 'integer',
+                'required', FALSE,
+                'minimum', 1,
+                'maximum', 100,
+                'default', 50
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'intervalSeconds', 60,
+            'handler', 'notion',
+            'auth', jsonb_build_object(
+                'type', 'oauth',
+                'identityParam', 'identityId',
+                'provider', 'notion'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
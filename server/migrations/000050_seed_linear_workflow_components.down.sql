@@ -0,0 +1,17 @@
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'linear')
+  AND "kind" = 'reaction'
+  AND "name" IN (
+    'linear_comment_issue',
+    'linear_move_issue_state',
+    'linear_assign_issue',
+    'linear_add_labels',
+    'linear_link_project'
+  );
+
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'linear')
+  AND "kind" = 'action'
+  AND "name" IN (
+    'linear_issue_state_changed'
+  );
@@ -0,0 +1,368 @@
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'linear'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'linear_comment_issue',
+    'Comment on Linear issue',
+    'Adds a comment to an existing Linear issue',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Linear identity',
+                'type', 'identity',
+                'provider', 'linear',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'issueId',
+                'label', 'Issue',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 100,
+                'helperText', 'Issue identifier such as ENG-123, or the issue ID'
+            ),
+            jsonb_build_object(
+                'key', 'body',
+                'label', 'Comment',
+                'type', 'textarea',
+                'required', TRUE,
+                'helperText', 'Markdown is supported'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'linear'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'linear_move_issue_state',
+    'Move Linear issue',
+    'Moves an issue to the workflow state with the given name',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Linear identity',
+                'type', 'identity',
+                'provider', 'linear',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'issueId',
+                'label', 'Issue',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 100,
+                'helperText', 'Issue identifier such as ENG-123, or the issue ID'
+            ),
+            jsonb_build_object(
+                'key', 'stateName',
+                'label', 'State',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 100,
+                'helperText', 'Workflow state name as shown in Linear, for example In Review'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'linear'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'linear_assign_issue',
+    'Assign Linear issue',
+    'Assigns an issue to the team member with the given email address',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Linear identity',
+                'type', 'identity',
+                'provider', 'linear',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'issueId',
+                'label', 'Issue',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 100,
+                'helperText', 'Issue identifier such as ENG-123, or the issue ID'
+            ),
+            jsonb_build_object(
+                'key', 'email',
+                'label', 'Assignee email',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 320
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'linear'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'linear_add_labels',
+    'Add labels to Linear issue',
+    'Adds labels to an issue while keeping the labels already applied',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Linear identity',
+                'type', 'identity',
+                'provider', 'linear',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'issueId',
+                'label', 'Issue',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 100,
+                'helperText', 'Issue identifier such as ENG-123, or the issue ID'
+            ),
+            jsonb_build_object(
+                'key', 'labels',
+                'label', 'Labels',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 500,
+                'helperText', 'Comma separated label names, team labels take precedence over workspace labels'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'linear'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'linear_link_project',
+    'Link Linear issue to project',
+    'Moves an issue into the project with the given name',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Linear identity',
+                'type', 'identity',
+                'provider', 'linear',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'issueId',
+                'label', 'Issue',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 100,
+                'helperText', 'Issue identifier such as ENG-123, or the issue ID'
+            ),
+            jsonb_build_object(
+                'key', 'projectName',
+                'label', 'Project',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 200
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'linear'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'linear_issue_state_changed',
+    'Issue state changed',
+    'Emits an event when an issue of the selected team moves to another workflow state',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Linear identity',
+                'type', 'identity',
+                'provider', 'linear',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'teamId',
+                'label', 'Team ID',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Linear team ID, for example team_0123456789abcdef'
+            ),
+            jsonb_build_object(
+                'key', 'stateName',
+                'label', 'Target state',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 100,
+                'helperText', 'Only trigger when the issue enters this state, leave empty for every transition'
+            ),
+            jsonb_build_object(
+                'key', 'maxItems',
+                'label', 'Issues per poll',
+                'type', 'integer',
+                'required', FALSE,
+                'minimum', 1,
+                'maximum', 100,
+                'default', 50
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'intervalSeconds', 60,
+            'handler', 'linear',
+            'auth', jsonb_build_object(
+                'type', 'oauth',
+                'identityParam', 'identityId',
+                'provider', 'linear'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
@@ -0,0 +1,19 @@
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'spotify')
+  AND "kind" = 'reaction'
+  AND "name" IN (
+    'spotify_create_playlist',
+    'spotify_remove_tracks_from_playlist',
+    'spotify_start_playback',
+    'spotify_pause_playback',
+    'spotify_queue_track',
+    'spotify_save_album'
+  );
+
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'spotify')
+  AND "kind" = 'action'
+  AND "name" IN (
+    'spotify_new_release_from_followed_artist',
+    'spotify_recently_played_track'
+  );
@@ -0,0 +1,501 @@
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'spotify'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'spotify_create_playlist',
+    'Create Spotify playlist',
+    'Creates a playlist owned by the linked Spotify account',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Spotify identity',
+                'type', 'identity',
+                'provider', 'spotify',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'name',
+                'label', 'Name',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 100
+            ),
+            jsonb_build_object(
+                'key', 'description',
+                'label', 'Description',
+                'type', 'textarea',
+                'required', FALSE,
+                'maxLength', 300
+            ),
+            jsonb_build_object(
+                'key', 'public',
+                'label', 'Public',
+                'type', 'boolean',
+                'required', FALSE,
+                'default', FALSE
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'spotify'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'spotify_remove_tracks_from_playlist',
+    'Remove tracks from Spotify playlist',
+    'Removes every occurrence of the listed tracks from a playlist',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Spotify identity',
+                'type', 'identity',
+                'provider', 'spotify',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'playlistId',
+                'label', 'Playlist',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Playlist ID, URI or open.spotify.com link'
+            ),
+            jsonb_build_object(
+                'key', 'trackUris',
+                'label', 'Tracks',
+                'type', 'textarea',
+                'required', TRUE,
+                'helperText', 'Track IDs, URIs or links separated by commas or new lines, up to 100'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'spotify'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'spotify_start_playback',
+    'Start Spotify playback',
+    'Resumes playback, or plays an album, playlist or list of tracks, on a device',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Spotify identity',
+                'type', 'identity',
+                'provider', 'spotify',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'device',
+                'label', 'Device',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 200,
+                'helperText', 'Device name or ID, leave empty to use the active device'
+            ),
+            jsonb_build_object(
+                'key', 'contextUri',
+                'label', 'Album or playlist',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Album, playlist, artist or show URI or link, leave empty to resume'
+            ),
+            jsonb_build_object(
+                'key', 'trackUris',
+                'label', 'Tracks',
+                'type', 'textarea',
+                'required', FALSE,
+                'helperText', 'Track IDs, URIs or links to play instead of a context'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'spotify'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'spotify_pause_playback',
+    'Pause Spotify playback',
+    'Pauses playback on a device, requires Spotify Premium',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Spotify identity',
+                'type', 'identity',
+                'provider', 'spotify',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'device',
+                'label', 'Device',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 200,
+                'helperText', 'Device name or ID, leave empty to use the active device'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'spotify'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'spotify_queue_track',
+    'Queue Spotify track',
+    'Adds a track to the end of the playback queue',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Spotify identity',
+                'type', 'identity',
+                'provider', 'spotify',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'device',
+                'label', 'Device',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 200,
+                'helperText', 'Device name or ID, leave empty to use the active device'
+            ),
+            jsonb_build_object(
+                'key', 'trackUri',
+                'label', 'Track',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Track ID, URI or open.spotify.com link'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'spotify'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'spotify_save_album',
+    'Save Spotify album',
+    'Saves an album to the library of the linked account',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Spotify identity',
+                'type', 'identity',
+                'provider', 'spotify',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'albumId',
+                'label', 'Album',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Album ID, URI or open.spotify.com link'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'spotify'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'spotify_new_release_from_followed_artist',
+    'New release from followed artist',
+    'Emits an event when an artist followed by the account releases an album or single',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Spotify identity',
+                'type', 'identity',
+                'provider', 'spotify',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'maxArtists',
+                'label', 'Artists checked per poll',
+                'type', 'integer',
+                'required', FALSE,
+                'minimum', 1,
+                'maximum', 200,
+                'default', 50
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'intervalSeconds', 3600,
+            'handler', 'spotify',
+            'auth', jsonb_build_object(
+                'type', 'oauth',
+                'identityParam', 'identityId',
+                'provider', 'spotify'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'spotify'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'spotify_recently_played_track',
+    'Recently played track',
+    'Emits an event for each track played by the account',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Spotify identity',
+                'type', 'identity',
+                'provider', 'spotify',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'limit',
+                'label', 'Tracks per poll',
+                'type', 'integer',
+                'required', FALSE,
+                'minimum', 1,
+                'maximum', 50,
+                'default', 20
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'intervalSeconds', 60,
+            'handler', 'http',
+            'http', jsonb_build_object(
+                'endpoint', 'https://api.spotify.com/v1/me/player/recently-played',
+                'method', 'GET',
+                'itemsPath', 'items',
+                'fingerprintField', 'played_at',
+                'occurredAtField', 'played_at',
+                'query', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'limit',
+                        'template', '{{params.limit}}',
+                        'default', '20',
+                        'skipIfEmpty', TRUE
+                    )
+                ),
+                'headers', jsonb_build_array(
+                    jsonb_build_object(
+                        'name', 'Accept',
+                        'value', 'application/json'
+                    ),
+                    jsonb_build_object(
+                        'name', 'Authorization',
+                        'template', 'Bearer {{identity.accessToken}}'
+                    )
+                ),
+                'auth', jsonb_build_object(
+                    'type', 'oauth',
+                    'identityParam', 'identityId',
+                    'provider', 'spotify',
+                    'scopes', jsonb_build_array(
+                        'user-read-recently-played'
+                    )
+                ),
+                'cursor', jsonb_build_object(
+                    'source', 'item',
+                    'itemPath', 'played_at',
+                    'key', 'spotify_recently_played_cursor'
+                )
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
@@ -0,0 +1,18 @@
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'dropbox')
+  AND "kind" = 'reaction'
+  AND "name" IN (
+    'dropbox_upload_file',
+    'dropbox_copy_file',
+    'dropbox_share_file'
+  );
+
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'google')
+  AND "kind" = 'reaction'
+  AND "name" IN (
+    'gdrive_upload_file',
+    'gdrive_copy_file',
+    'gdrive_share_file',
+    'gdrive_export_pdf'
+  );
@@ -0,0 +1,482 @@
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'dropbox'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'dropbox_upload_file',
+    'Upload file to Dropbox',
+    'Uploads a file downloaded from a URL or built from text or an event field',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Dropbox identity',
+                'type', 'identity',
+                'provider', 'dropbox',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'path',
+                'label', 'Destination path',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Full file path such as /Reports/summary.txt, or a folder ending with / to keep the source file name'
+            ),
+            jsonb_build_object(
+                'key', 'sourceUrl',
+                'label', 'Source URL',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Download the file from this http or https URL'
+            ),
+            jsonb_build_object(
+                'key', 'content',
+                'label', 'Text content',
+                'type', 'textarea',
+                'required', FALSE,
+                'helperText', 'Upload this text as the file content'
+            ),
+            jsonb_build_object(
+                'key', 'contentField',
+                'label', 'Event field',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Upload a field of the triggering event, for example attachment.text. Set exactly one of source URL, text content or event field'
+            ),
+            jsonb_build_object(
+                'key', 'overwrite',
+                'label', 'Overwrite existing file',
+                'type', 'boolean',
+                'required', FALSE,
+                'default', FALSE
+            ),
+            jsonb_build_object(
+                'key', 'autorename',
+                'label', 'Auto rename on conflict',
+                'type', 'boolean',
+                'required', FALSE,
+                'default', FALSE
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'dropbox'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'dropbox_copy_file',
+    'Copy Dropbox file',
+    'Copies a file or folder to another path in Dropbox',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Dropbox identity',
+                'type', 'identity',
+                'provider', 'dropbox',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'fromPath',
+                'label', 'Source path',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'toPath',
+                'label', 'Destination path',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'autorename',
+                'label', 'Auto rename on conflict',
+                'type', 'boolean',
+                'required', FALSE,
+                'default', FALSE
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'dropbox'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'dropbox_share_file',
+    'Share Dropbox file',
+    'Creates a public shared link for a Dropbox file, reusing the existing link when there is one',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Dropbox identity',
+                'type', 'identity',
+                'provider', 'dropbox',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'path',
+                'label', 'File path',
+                'type', 'text',
+                'required', TRUE
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'google'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gdrive_upload_file',
+    'Upload file to Google Drive',
+    'Uploads a file downloaded from a URL or built from text or an event field',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Google identity',
+                'type', 'identity',
+                'provider', 'google',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'name',
+                'label', 'File name',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 255,
+                'helperText', 'Defaults to the name of the downloaded file'
+            ),
+            jsonb_build_object(
+                'key', 'folderId',
+                'label', 'Folder ID',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Google Drive folder ID, the file lands in My Drive when empty'
+            ),
+            jsonb_build_object(
+                'key', 'sourceUrl',
+                'label', 'Source URL',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Download the file from this http or https URL'
+            ),
+            jsonb_build_object(
+                'key', 'content',
+                'label', 'Text content',
+                'type', 'textarea',
+                'required', FALSE,
+                'helperText', 'Upload this text as the file content'
+            ),
+            jsonb_build_object(
+                'key', 'contentField',
+                'label', 'Event field',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Upload a field of the triggering event, for example attachment.text. Set exactly one of source URL, text content or event field'
+            ),
+            jsonb_build_object(
+                'key', 'mimeType',
+                'label', 'MIME type',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Overrides the content type reported by the source'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'google'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gdrive_copy_file',
+    'Copy Google Drive file',
+    'Copies a Google Drive file, optionally renaming it or placing it in another folder',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Google identity',
+                'type', 'identity',
+                'provider', 'google',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'fileId',
+                'label', 'File ID',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'name',
+                'label', 'Copy name',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 255
+            ),
+            jsonb_build_object(
+                'key', 'destinationFolderId',
+                'label', 'Destination folder ID',
+                'type', 'text',
+                'required', FALSE
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'google'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gdrive_share_file',
+    'Share Google Drive file',
+    'Shares a Google Drive file with a user or with anyone holding the link and returns the link',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Google identity',
+                'type', 'identity',
+                'provider', 'google',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'fileId',
+                'label', 'File ID',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'role',
+                'label', 'Access',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'reader',
+                'options', jsonb_build_array(
+                    jsonb_build_object('value', 'reader', 'label', 'Viewer'),
+                    jsonb_build_object('value', 'commenter', 'label', 'Commenter'),
+                    jsonb_build_object('value', 'writer', 'label', 'Editor')
+                )
+            ),
+            jsonb_build_object(
+                'key', 'emailAddress',
+                'label', 'Email address',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Leave empty to share with anyone who has the link'
+            ),
+            jsonb_build_object(
+                'key', 'notify',
+                'label', 'Send notification email',
+                'type', 'boolean',
+                'required', FALSE,
+                'default', TRUE
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'google'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'gdrive_export_pdf',
+    'Convert Google Doc to PDF',
+    'Exports a Google Docs, Sheets or Slides file as PDF and saves it to Google Drive',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Google identity',
+                'type', 'identity',
+                'provider', 'google',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'fileId',
+                'label', 'File ID',
+                'type', 'text',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'name',
+                'label', 'PDF name',
+                'type', 'text',
+                'required', FALSE,
+                'maxLength', 255,
+                'helperText', 'Defaults to the document name with a .pdf extension'
+            ),
+            jsonb_build_object(
+                'key', 'folderId',
+                'label', 'Folder ID',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Defaults to the folder of the original document'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
@@ -0,0 +1,9 @@
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'email')
+  AND "name" IN (
+    'email_send_message',
+    'email_new_message_in_folder'
+  );
+
+DELETE FROM "service_providers"
+WHERE "name" = 'email';
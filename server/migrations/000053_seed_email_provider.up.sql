@@ -0,0 +1,194 @@
+INSERT INTO "service_providers" (
+    "id",
+    "name",
+    "display_name",
+    "category",
+    "oauth_type",
+    "auth_config",
+    "is_enabled"
+)
+VALUES (
+    gen_random_uuid(),
+    'email',
+    'Email (SMTP/IMAP)',
+    'communication',
+    'apikey',
+    jsonb_build_object(
+        'credentials', jsonb_build_array(
+            jsonb_build_object('key', 'address', 'label', 'Email address', 'type', 'text', 'required', TRUE),
+            jsonb_build_object('key', 'username', 'label', 'Username', 'type', 'text', 'required', FALSE, 'helperText', 'Defaults to the email address'),
+            jsonb_build_object('key', 'password', 'label', 'Password or app password', 'type', 'password', 'required', TRUE),
+            jsonb_build_object('key', 'smtpHost', 'label', 'SMTP host', 'type', 'text', 'required', TRUE),
+            jsonb_build_object('key', 'smtpPort', 'label', 'SMTP port', 'type', 'integer', 'required', FALSE, 'default', 587),
+            jsonb_build_object('key', 'smtpSecurity', 'label', 'SMTP security', 'type', 'text', 'required', FALSE, 'default', 'starttls', 'helperText', 'tls, starttls or none'),
+            jsonb_build_object('key', 'imapHost', 'label', 'IMAP host', 'type', 'text', 'required', FALSE, 'helperText', 'Required to watch folders for new messages'),
+            jsonb_build_object('key', 'imapPort', 'label', 'IMAP port', 'type', 'integer', 'required', FALSE, 'default', 993),
+            jsonb_build_object('key', 'imapSecurity', 'label', 'IMAP security', 'type', 'text', 'required', FALSE, 'default', 'tls', 'helperText', 'tls, starttls or none')
+        )
+    ),
+    TRUE
+)
+ON CONFLICT ("name") DO UPDATE
+    SET "display_name" = EXCLUDED."display_name",
+        "category" = EXCLUDED."category",
+        "oauth_type" = EXCLUDED."oauth_type",
+        "auth_config" = EXCLUDED."auth_config",
+        "is_enabled" = TRUE,
+        "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'email'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'reaction',
+    'email_send_message',
+    'Send email',
+    'Sends an email through the SMTP server of a linked mailbox',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Mailbox',
+                'type', 'identity',
+                'provider', 'email',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'to',
+                'label', 'To',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'Comma separated list of recipients'
+            ),
+            jsonb_build_object(
+                'key', 'cc',
+                'label', 'Cc',
+                'type', 'text',
+                'required', FALSE
+            ),
+            jsonb_build_object(
+                'key', 'bcc',
+                'label', 'Bcc',
+                'type', 'text',
+                'required', FALSE
+            ),
+            jsonb_build_object(
+                'key', 'replyTo',
+                'label', 'Reply-To',
+                'type', 'text',
+                'required', FALSE
+            ),
+            jsonb_build_object(
+                'key', 'subject',
+                'label', 'Subject',
+                'type', 'text',
+                'required', TRUE,
+                'maxLength', 998
+            ),
+            jsonb_build_object(
+                'key', 'body',
+                'label', 'Text body',
+                'type', 'textarea',
+                'required', FALSE
+            ),
+            jsonb_build_object(
+                'key', 'html',
+                'label', 'HTML body',
+                'type', 'textarea',
+                'required', FALSE,
+                'helperText', 'Set a text body, an HTML body or both'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'email'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'email_new_message_in_folder',
+    'New message in folder',
+    'Emits an event when a new message arrives in an IMAP folder',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'identityId',
+                'label', 'Mailbox',
+                'type', 'identity',
+                'provider', 'email',
+                'required', TRUE
+            ),
+            jsonb_build_object(
+                'key', 'folder',
+                'label', 'Folder',
+                'type', 'text',
+                'required', FALSE,
+                'default', 'INBOX'
+            ),
+            jsonb_build_object(
+                'key', 'maxResults',
+                'label', 'Messages per poll',
+                'type', 'integer',
+                'required', FALSE,
+                'minimum', 1,
+                'maximum', 50,
+                'default', 10
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'intervalSeconds', 60,
+            'handler', 'imap',
+            'auth', jsonb_build_object(
+                'type', 'credentials',
+                'identityParam', 'identityId',
+                'provider', 'email'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
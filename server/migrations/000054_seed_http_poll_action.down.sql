@@ -0,0 +1,6 @@
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'http')
+  AND "name" = 'http_poll';
+
+DELETE FROM "service_providers"
+WHERE "name" = 'http';
@@ -0,0 +1,195 @@
+INSERT INTO "service_providers" (
+    "id",
+    "name",
+    "display_name",
+    "category",
+    "oauth_type",
+    "auth_config",
+    "is_enabled"
+)
+VALUES (
+    gen_random_uuid(),
+    'http',
+    'HTTP',
+    'utility',
+    'none',
+    '{}'::jsonb,
+    TRUE
+)
+ON CONFLICT ("name") DO UPDATE
+    SET "display_name" = EXCLUDED."display_name",
+        "category" = EXCLUDED."category",
+        "oauth_type" = EXCLUDED."oauth_type",
+        "auth_config" = EXCLUDED."auth_config",
+        "is_enabled" = TRUE,
+        "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'http'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'http_poll',
+    'Poll a JSON API',
+    'Polls a JSON endpoint of your choice and emits an event for every new item',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'url',
+                'label', 'URL',
+                'type', 'text',
+                'required', TRUE,
+                'helperText', 'http or https URL. {{cursor.last_seen_ts}} and {{now_rfc3339}} placeholders are supported'
+            ),
+            jsonb_build_object(
+                'key', 'method',
+                'label', 'Method',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'GET',
+                'options', jsonb_build_array(
+                    jsonb_build_object('value', 'GET', 'label', 'GET'),
+                    jsonb_build_object('value', 'POST', 'label', 'POST')
+                )
+            ),
+            jsonb_build_object(
+                'key', 'headers',
+                'label', 'Headers',
+                'type', 'textarea',
+                'required', FALSE,
+                'helperText', 'One "Name: value" header per line'
+            ),
+            jsonb_build_object(
+                'key', 'body',
+                'label', 'JSON body',
+                'type', 'textarea',
+                'required', FALSE,
+                'helperText', 'Sent with POST requests'
+            ),
+            jsonb_build_object(
+                'key', 'authType',
+                'label', 'Authentication',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'none',
+                'options', jsonb_build_array(
+                    jsonb_build_object('value', 'none', 'label', 'None'),
+                    jsonb_build_object('value', 'basic', 'label', 'Basic'),
+                    jsonb_build_object('value', 'bearer', 'label', 'Bearer token'),
+                    jsonb_build_object('value', 'apiKey', 'label', 'API key')
+                )
+            ),
+            jsonb_build_object(
+                'key', 'username',
+                'label', 'Username',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Used with basic authentication'
+            ),
+            jsonb_build_object(
+                'key', 'password',
+                'label', 'Password',
+                'type', 'password',
+                'required', FALSE,
+                'helperText', 'Used with basic authentication'
+            ),
+            jsonb_build_object(
+                'key', 'token',
+                'label', 'Bearer token',
+                'type', 'password',
+                'required', FALSE
+            ),
+            jsonb_build_object(
+                'key', 'apiKey',
+                'label', 'API key',
+                'type', 'password',
+                'required', FALSE
+            ),
+            jsonb_build_object(
+                'key', 'apiKeyName',
+                'label', 'API key name',
+                'type', 'text',
+                'required', FALSE,
+                'default', 'X-API-Key'
+            ),
+            jsonb_build_object(
+                'key', 'apiKeyIn',
+                'label', 'Send API key in',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'header',
+                'options', jsonb_build_array(
+                    jsonb_build_object('value', 'header', 'label', 'Header'),
+                    jsonb_build_object('value', 'query', 'label', 'Query string')
+                )
+            ),
+            jsonb_build_object(
+                'key', 'itemsPath',
+                'label', 'Items path',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Path to the array of items, such as $.data.items. Leave empty when the response is an array'
+            ),
+            jsonb_build_object(
+                'key', 'fingerprintField',
+                'label', 'Unique field',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Field identifying an item, such as id. Defaults to a hash of the whole item'
+            ),
+            jsonb_build_object(
+                'key', 'timestampField',
+                'label', 'Timestamp field',
+                'type', 'text',
+                'required', FALSE,
+                'helperText', 'Field holding the item date or a numeric sequence; only items newer than the last poll are emitted'
+            ),
+            jsonb_build_object(
+                'key', 'skipField',
+                'label', 'Skip items where field',
+                'type', 'text',
+                'required', FALSE
+            ),
+            jsonb_build_object(
+                'key', 'skipEquals',
+                'label', 'equals',
+                'type', 'text',
+                'required', FALSE
+            ),
+            jsonb_build_object(
+                'key', 'skipContains',
+                'label', 'or contains',
+                'type', 'text',
+                'required', FALSE
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'polling',
+            'intervalSeconds', 60,
+            'handler', 'http',
+            'configSource', 'params'
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
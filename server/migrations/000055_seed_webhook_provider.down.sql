@@ -0,0 +1,6 @@
+DELETE FROM "service_components"
+WHERE "provider_id" = (SELECT id FROM "service_providers" WHERE name = 'webhook')
+  AND "name" = 'webhook_incoming';
+
+DELETE FROM "service_providers"
+WHERE "name" = 'webhook';
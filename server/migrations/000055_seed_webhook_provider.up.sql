@@ -0,0 +1,84 @@
+INSERT INTO "service_providers" (
+    "id",
+    "name",
+    "display_name",
+    "category",
+    "oauth_type",
+    "auth_config",
+    "is_enabled"
+)
+VALUES (
+    gen_random_uuid(),
+    'webhook',
+    'Webhook',
+    'utility',
+    'none',
+    '{}'::jsonb,
+    TRUE
+)
+ON CONFLICT ("name") DO UPDATE
+    SET "display_name" = EXCLUDED."display_name",
+        "category" = EXCLUDED."category",
+        "oauth_type" = EXCLUDED."oauth_type",
+        "auth_config" = EXCLUDED."auth_config",
+        "is_enabled" = TRUE,
+        "updated_at" = NOW();
+
+WITH provider AS (
+    SELECT id FROM "service_providers" WHERE name = 'webhook'
+)
+INSERT INTO "service_components" (
+    "id",
+    "provider_id",
+    "kind",
+    "name",
+    "display_name",
+    "description",
+    "version",
+    "metadata",
+    "is_enabled"
+)
+SELECT
+    gen_random_uuid(),
+    provider.id,
+    'action',
+    'webhook_incoming',
+    'Incoming webhook',
+    'Triggers whenever a request is POSTed to the URL generated for this automation',
+    1,
+    jsonb_build_object(
+        'parameters', jsonb_build_array(
+            jsonb_build_object(
+                'key', 'schema',
+                'label', 'JSON Schema',
+                'type', 'textarea',
+                'required', FALSE,
+                'helperText', 'Optional JSON Schema the request body must match'
+            ),
+            jsonb_build_object(
+                'key', 'onInvalid',
+                'label', 'When the body does not match',
+                'type', 'enum',
+                'required', FALSE,
+                'default', 'reject',
+                'options', jsonb_build_array('reject', 'mark'),
+                'helperText', 'reject answers 422 without triggering; mark triggers with validation.valid set to false'
+            )
+        ),
+        'ingestion', jsonb_build_object(
+            'mode', 'webhook',
+            'schema', jsonb_build_object(
+                'param', 'schema',
+                'onInvalidParam', 'onInvalid'
+            )
+        )
+    ),
+    TRUE
+FROM provider
+ON CONFLICT ("provider_id", "kind", "name", "version")
+DO UPDATE SET
+    "display_name" = EXCLUDED."display_name",
+    "description" = EXCLUDED."description",
+    "metadata" = EXCLUDED."metadata",
+    "is_enabled" = EXCLUDED."is_enabled",
+    "updated_at" = NOW();
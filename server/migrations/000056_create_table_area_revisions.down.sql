@@ -0,0 +1,6 @@
+ALTER TABLE "area_revisions" DROP CONSTRAINT IF EXISTS "fk_area_revisions_author";
+ALTER TABLE "area_revisions" DROP CONSTRAINT IF EXISTS "fk_area_revisions_area";
+DROP INDEX IF EXISTS "area_revisions_index_area_number";
+DROP TABLE IF EXISTS "area_revisions";
+
+ALTER TABLE "areas" DROP COLUMN IF EXISTS "revision";
@@ -0,0 +1,23 @@
+ALTER TABLE "areas" ADD COLUMN "revision" INT NOT NULL DEFAULT 0;
+
+CREATE TABLE "area_revisions" (
+                                  "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+                                  "area_id" UUID NOT NULL,
+                                  "number" INT NOT NULL,
+                                  "author_id" UUID,
+                                  "reason" VARCHAR(32) NOT NULL,
+                                  "snapshot" JSONB NOT NULL,
+                                  "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "area_revisions_index_area_number" ON "area_revisions" ("area_id","number");
+
+ALTER TABLE "area_revisions"
+    ADD CONSTRAINT "fk_area_revisions_area"
+        FOREIGN KEY ("area_id") REFERENCES "areas"("id")
+            ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE "area_revisions"
+    ADD CONSTRAINT "fk_area_revisions_author"
+        FOREIGN KEY ("author_id") REFERENCES "users"("id")
+            ON DELETE SET NULL ON UPDATE NO ACTION;
@@ -0,0 +1,7 @@
+DELETE FROM "service_component_examples"
+WHERE "component_id" IN (
+    SELECT c.id
+    FROM "service_components" c
+    JOIN "service_providers" p ON p.id = c.provider_id
+    WHERE p.name = 'webhook' AND c.kind = 'action' AND c.name = 'webhook_incoming'
+);
@@ -0,0 +1,20 @@
+WITH component AS (
+    SELECT c.id
+    FROM "service_components" c
+    JOIN "service_providers" p ON p.id = c.provider_id
+    WHERE p.name = 'webhook' AND c.kind = 'action' AND c.name = 'webhook_incoming'
+)
+INSERT INTO "service_component_examples" ("component_id", "example_input", "example_output")
+SELECT component.id,
+       '{}'::jsonb,
+       jsonb_build_object(
+           'id', 'ord_1042',
+           'status', 'paid',
+           'total', 42.5,
+           'currency', 'EUR',
+           'customer', jsonb_build_object('email', 'jane@example.com', 'name', 'Jane Doe')
+       )
+FROM component
+WHERE NOT EXISTS (
+    SELECT 1 FROM "service_component_examples" e WHERE e.component_id = component.id
+);
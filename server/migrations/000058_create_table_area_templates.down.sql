@@ -0,0 +1,3 @@
+ALTER TABLE "area_templates" DROP CONSTRAINT IF EXISTS "fk_area_templates_created_by";
+DROP INDEX IF EXISTS "area_templates_index_name";
+DROP TABLE IF EXISTS "area_templates";
@@ -0,0 +1,78 @@
+CREATE TABLE "area_templates" (
+                                  "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+                                  "name" VARCHAR(128) NOT NULL,
+                                  "description" TEXT NOT NULL DEFAULT '',
+                                  "definition" JSONB NOT NULL,
+                                  "created_by" UUID,
+                                  "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                  "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                  PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "area_templates_index_name" ON "area_templates" ("name");
+
+ALTER TABLE "area_templates"
+    ADD CONSTRAINT "fk_area_templates_created_by"
+        FOREIGN KEY ("created_by") REFERENCES "users"("id")
+            ON DELETE SET NULL ON UPDATE NO ACTION;
+
+WITH action AS (
+    SELECT c.id
+    FROM "service_components" c
+    JOIN "service_providers" p ON p.id = c.provider_id
+    WHERE p.name = 'github' AND c.kind = 'action' AND c.name = 'repo_new_stars'
+    ORDER BY c.version DESC
+    LIMIT 1
+), reaction AS (
+    SELECT c.id
+    FROM "service_components" c
+    JOIN "service_providers" p ON p.id = c.provider_id
+    WHERE p.name = 'slack' AND c.kind = 'reaction' AND c.name = 'slack_post_message'
+    ORDER BY c.version DESC
+    LIMIT 1
+)
+INSERT INTO "area_templates" ("name", "description", "definition")
+SELECT 'GitHub star to Slack message',
+       'Posts a Slack message each time one of your repositories gains a star',
+       jsonb_build_object(
+           'action', jsonb_build_object('componentId', action.id),
+           'reactions', jsonb_build_array(jsonb_build_object(
+               'componentId', reaction.id,
+               'params', jsonb_build_object('text', 'Your repository just received a new star')
+           ))
+       )
+FROM action, reaction
+ON CONFLICT ("name") DO NOTHING;
+
+WITH action AS (
+    SELECT c.id
+    FROM "service_components" c
+    JOIN "service_providers" p ON p.id = c.provider_id
+    WHERE p.name = 'google' AND c.kind = 'action' AND c.name = 'gcalendar_event_starting_soon'
+    ORDER BY c.version DESC
+    LIMIT 1
+), reaction AS (
+    SELECT c.id
+    FROM "service_components" c
+    JOIN "service_providers" p ON p.id = c.provider_id
+    WHERE p.name = 'google' AND c.kind = 'reaction' AND c.name = 'gmail_send_email'
+    ORDER BY c.version DESC
+    LIMIT 1
+)
+INSERT INTO "area_templates" ("name", "description", "definition")
+SELECT 'Calendar event soon to Gmail',
+       'Emails a reminder shortly before your next Google Calendar event starts',
+       jsonb_build_object(
+           'action', jsonb_build_object(
+               'componentId', action.id,
+               'params', jsonb_build_object('calendarId', 'primary', 'minutesBefore', 15)
+           ),
+           'reactions', jsonb_build_array(jsonb_build_object(
+               'componentId', reaction.id,
+               'params', jsonb_build_object(
+                   'subject', 'Upcoming event',
+                   'body', 'One of your calendar events starts in 15 minutes'
+               )
+           ))
+       )
+FROM action, reaction
+ON CONFLICT ("name") DO NOTHING;
@@ -0,0 +1,11 @@
+ALTER TABLE "areas" DROP CONSTRAINT IF EXISTS "fk_areas_workspace";
+DROP INDEX IF EXISTS "areas_index_workspace";
+ALTER TABLE "areas" DROP COLUMN IF EXISTS "workspace_id";
+
+ALTER TABLE "workspace_members" DROP CONSTRAINT IF EXISTS "fk_workspace_members_user";
+ALTER TABLE "workspace_members" DROP CONSTRAINT IF EXISTS "fk_workspace_members_workspace";
+DROP INDEX IF EXISTS "workspace_members_index_user";
+DROP TABLE IF EXISTS "workspace_members";
+
+ALTER TABLE "workspaces" DROP CONSTRAINT IF EXISTS "fk_workspaces_created_by";
+DROP TABLE IF EXISTS "workspaces";
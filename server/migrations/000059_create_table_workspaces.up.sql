@@ -0,0 +1,42 @@
+CREATE TABLE "workspaces" (
+                              "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+                              "name" VARCHAR(128) NOT NULL,
+                              "created_by" UUID,
+                              "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                              "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                              PRIMARY KEY ("id")
+);
+
+ALTER TABLE "workspaces"
+    ADD CONSTRAINT "fk_workspaces_created_by"
+        FOREIGN KEY ("created_by") REFERENCES "users"("id")
+            ON DELETE SET NULL ON UPDATE NO ACTION;
+
+CREATE TABLE "workspace_members" (
+                                     "workspace_id" UUID NOT NULL,
+                                     "user_id" UUID NOT NULL,
+                                     "role" VARCHAR(16) NOT NULL,
+                                     "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                     "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                     PRIMARY KEY ("workspace_id", "user_id"),
+                                     CONSTRAINT "workspace_members_role_check" CHECK ("role" IN ('viewer', 'editor', 'owner'))
+);
+CREATE INDEX "workspace_members_index_user" ON "workspace_members" ("user_id");
+
+ALTER TABLE "workspace_members"
+    ADD CONSTRAINT "fk_workspace_members_workspace"
+        FOREIGN KEY ("workspace_id") REFERENCES "workspaces"("id")
+            ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE "workspace_members"
+    ADD CONSTRAINT "fk_workspace_members_user"
+        FOREIGN KEY ("user_id") REFERENCES "users"("id")
+            ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE "areas" ADD COLUMN "workspace_id" UUID;
+CREATE INDEX "areas_index_workspace" ON "areas" ("workspace_id");
+
+ALTER TABLE "areas"
+    ADD CONSTRAINT "fk_areas_workspace"
+        FOREIGN KEY ("workspace_id") REFERENCES "workspaces"("id")
+            ON DELETE SET NULL ON UPDATE NO ACTION;
@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS "areas_index_user_created";
+DROP INDEX IF EXISTS "jobs_index_area_link_created";
+DROP INDEX IF EXISTS "triggers_index_area_created";
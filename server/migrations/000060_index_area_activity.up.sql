@@ -0,0 +1,3 @@
+CREATE INDEX "triggers_index_area_created" ON "triggers" ("area_id", "created_at" DESC);
+CREATE INDEX "jobs_index_area_link_created" ON "jobs" ("area_link_id", "created_at" DESC);
+CREATE INDEX "areas_index_user_created" ON "areas" ("user_id", "created_at" DESC, "id" DESC);
@@ -0,0 +1,7 @@
+ALTER TABLE "areas" DROP CONSTRAINT IF EXISTS "fk_areas_folder";
+DROP INDEX IF EXISTS "areas_index_folder";
+ALTER TABLE "areas" DROP COLUMN IF EXISTS "folder_id";
+
+DROP TABLE IF EXISTS "area_folders";
+DROP TABLE IF EXISTS "area_tags";
+DROP TABLE IF EXISTS "tags";
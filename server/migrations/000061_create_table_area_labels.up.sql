@@ -0,0 +1,56 @@
+CREATE TABLE "tags" (
+                        "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+                        "user_id" UUID NOT NULL,
+                        "name" VARCHAR(64) NOT NULL,
+                        "color" VARCHAR(7),
+                        "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                        "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                        PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "uq_tags_user_name" ON "tags" ("user_id", lower("name"));
+
+ALTER TABLE "tags"
+    ADD CONSTRAINT "fk_tags_user"
+        FOREIGN KEY ("user_id") REFERENCES "users"("id")
+            ON DELETE CASCADE ON UPDATE NO ACTION;
+
+CREATE TABLE "area_tags" (
+                             "area_id" UUID NOT NULL,
+                             "tag_id" UUID NOT NULL,
+                             "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                             PRIMARY KEY ("area_id", "tag_id")
+);
+CREATE INDEX "area_tags_index_tag" ON "area_tags" ("tag_id");
+
+ALTER TABLE "area_tags"
+    ADD CONSTRAINT "fk_area_tags_area"
+        FOREIGN KEY ("area_id") REFERENCES "areas"("id")
+            ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE "area_tags"
+    ADD CONSTRAINT "fk_area_tags_tag"
+        FOREIGN KEY ("tag_id") REFERENCES "tags"("id")
+            ON DELETE CASCADE ON UPDATE NO ACTION;
+
+CREATE TABLE "area_folders" (
+                                "id" UUID NOT NULL DEFAULT gen_random_uuid(),
+                                "user_id" UUID NOT NULL,
+                                "name" VARCHAR(128) NOT NULL,
+                                "created_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                "updated_at" TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+                                PRIMARY KEY ("id")
+);
+CREATE UNIQUE INDEX "uq_area_folders_user_name" ON "area_folders" ("user_id", lower("name"));
+
+ALTER TABLE "area_folders"
+    ADD CONSTRAINT "fk_area_folders_user"
+        FOREIGN KEY ("user_id") REFERENCES "users"("id")
+            ON DELETE CASCADE ON UPDATE NO ACTION;
+
+ALTER TABLE "areas" ADD COLUMN "folder_id" UUID;
+CREATE INDEX "areas_index_folder" ON "areas" ("folder_id");
+
+ALTER TABLE "areas"
+    ADD CONSTRAINT "fk_areas_folder"
+        FOREIGN KEY ("folder_id") REFERENCES "area_folders"("id")
+            ON DELETE SET NULL ON UPDATE NO ACTION;
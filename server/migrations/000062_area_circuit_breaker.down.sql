@@ -0,0 +1,16 @@
+DROP INDEX IF EXISTS "jobs_index_area_link_status_updated";
+
+ALTER TABLE "areas"
+    DROP COLUMN IF EXISTS "suspension_reason",
+    DROP COLUMN IF EXISTS "suspended_at",
+    DROP COLUMN IF EXISTS "health_reset_at",
+    DROP COLUMN IF EXISTS "health_policy";
+
+UPDATE "areas" SET "status" = 'disabled' WHERE "status" = 'suspended_by_system';
+
+ALTER TYPE "area_status" RENAME TO "area_status_old";
+CREATE TYPE "area_status" AS ENUM ('enabled','disabled','archived');
+ALTER TABLE "areas" ALTER COLUMN "status" DROP DEFAULT;
+ALTER TABLE "areas" ALTER COLUMN "status" TYPE "area_status" USING "status"::text::"area_status";
+ALTER TABLE "areas" ALTER COLUMN "status" SET DEFAULT 'enabled';
+DROP TYPE "area_status_old";
@@ -0,0 +1,9 @@
+ALTER TYPE "area_status" ADD VALUE IF NOT EXISTS 'suspended_by_system';
+
+ALTER TABLE "areas"
+    ADD COLUMN "health_policy" JSONB,
+    ADD COLUMN "health_reset_at" TIMESTAMPTZ,
+    ADD COLUMN "suspended_at" TIMESTAMPTZ,
+    ADD COLUMN "suspension_reason" TEXT;
+
+CREATE INDEX "jobs_index_area_link_status_updated" ON "jobs" ("area_link_id", "status", "updated_at" DESC);
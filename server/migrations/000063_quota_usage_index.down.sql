@@ -0,0 +1 @@
+DROP INDEX IF EXISTS "triggers_index_matched_area_created";
@@ -0,0 +1 @@
+CREATE INDEX "triggers_index_matched_area_created" ON "triggers" ("area_id", "created_at" DESC) WHERE "status" = 'matched';
@@ -0,0 +1 @@
+ALTER TABLE "areas" DROP COLUMN IF EXISTS "activity_schedule";
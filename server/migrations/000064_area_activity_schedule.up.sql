@@ -0,0 +1 @@
+ALTER TABLE "areas" ADD COLUMN "activity_schedule" JSONB;